@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent"
+	"github.com/skr1ms/CTFBoard/internal/usecase/competition"
+	"github.com/skr1ms/CTFBoard/pkg/vault"
+)
+
+//nolint:gocognit,gocyclo
+func main() {
+	fix := flag.Bool("fix", false, "write the recounted solve counts and values back")
+	excludeBanned := flag.Bool("exclude-banned", false, "do not count solves of banned teams")
+	excludeHidden := flag.Bool("exclude-hidden", false, "do not count solves of hidden teams")
+	flag.Parse()
+
+	envPaths := []string{".env", "../.env", "/app/.env", "/etc/ctfboard/.env"}
+	for _, path := range envPaths {
+		if err := godotenv.Load(path); err == nil {
+			log.Printf("Loaded .env from %s", path)
+			break
+		}
+	}
+
+	host := getEnv("POSTGRES_HOST", "localhost")
+	port := getEnv("POSTGRES_PORT", "5432")
+	user := getEnv("POSTGRES_USER", "postgres")
+	password := getEnv("POSTGRES_PASSWORD", "postgres")
+	dbname := getEnv("POSTGRES_DB", "ctfboard")
+
+	vaultAddr := os.Getenv("VAULT_ADDR")
+	vaultToken := os.Getenv("VAULT_TOKEN")
+
+	if vaultAddr != "" && vaultToken != "" {
+		log.Println("Attempting to fetch secrets from Vault...")
+		vaultClient, err := vault.New(vaultAddr, vaultToken)
+		if err == nil {
+			dbSecrets, err := vaultClient.GetSecret("ctfboard/database")
+			if err == nil {
+				log.Println("Database secrets loaded from Vault")
+				if u, ok := dbSecrets[entity.RoleUser].(string); ok && u != "" {
+					user = u
+				}
+				if p, ok := dbSecrets["password"].(string); ok && p != "" {
+					password = p
+				}
+				if db, ok := dbSecrets["dbname"].(string); ok && db != "" {
+					dbname = db
+				}
+			} else {
+				log.Printf("Failed to load database secrets from Vault: %v", err)
+			}
+		} else {
+			log.Printf("Failed to initialize vault client: %v", err)
+		}
+	}
+
+	dbURL := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", user, password, host, port, dbname)
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		log.Fatalf("Unable to connect to database: %v\n", err)
+	}
+	defer pool.Close()
+
+	if err := pool.Ping(ctx); err != nil {
+		log.Fatalf("Failed to ping database: %v", err)
+	}
+
+	solveUC := competition.NewSolveUseCase(competition.SolveDeps{
+		CompetitionRepo: persistent.NewCompetitionRepo(pool),
+		TxRepo:          persistent.NewTxRepo(pool),
+		ScoringRepo:     persistent.NewChallengeScoringRepo(pool),
+		RescoreRepo:     persistent.NewRescoreRepo(pool),
+	})
+	opts := entity.RescoreOptions{ExcludeBanned: *excludeBanned, ExcludeHidden: *excludeHidden}
+
+	var report *entity.RescoreReport
+	if *fix {
+		log.Println("Rescoring all challenges")
+		report, err = solveUC.Rescore(ctx, opts, nil, "")
+	} else {
+		log.Println("Checking scoring consistency")
+		report, err = solveUC.CheckConsistency(ctx, opts)
+	}
+	if err != nil {
+		log.Fatalf("Rescore failed: %v", err)
+	}
+
+	for _, d := range report.Drifts {
+		log.Printf("%s (%s): solve_count %d -> %d, points %d -> %d",
+			d.Title, d.ChallengeID, d.StoredSolveCount, d.SolveCount, d.StoredPoints, d.Points)
+	}
+	log.Printf("Checked %d challenges, %d drifted", report.Checked, len(report.Drifts))
+
+	if len(report.Drifts) > 0 && !report.Fixed {
+		log.Println("Run with -fix to correct them")
+		os.Exit(1)
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if value, exists := os.LookupEnv(key); exists {
+		return value
+	}
+	return fallback
+}
@@ -25,6 +25,7 @@ type (
 		Resend      `yaml:"resend"`
 		Storage     `yaml:"storage"`
 		Competition `yaml:"competition"`
+		Instances   `yaml:"instances"`
 	}
 
 	App struct {
@@ -68,6 +69,11 @@ type (
 	RateLimit struct {
 		SubmitFlag         int
 		SubmitFlagDuration time.Duration
+		LoginAttempts      int
+		LoginIPAttempts    int
+		LoginWindow        time.Duration
+		LoginLockout       time.Duration
+		LoginMaxLockout    time.Duration
 	}
 
 	Resend struct {
@@ -97,6 +103,15 @@ type (
 		AllowTeamSwitch bool
 		MinTeamSize     int
 		MaxTeamSize     int
+		// ScoringRefreshInterval is how often challenges with time-based scoring are revalued.
+		ScoringRefreshInterval time.Duration
+	}
+
+	Instances struct {
+		InstanceProvider     string
+		InstanceMaxPerTeam   int
+		InstanceReapInterval time.Duration
+		InstanceFakeHost     string
 	}
 )
 
@@ -150,6 +165,11 @@ func New() (*Config, error) {
 
 	rateLimitSubmitFlag := getEnvInt("RATE_LIMIT_SUBMIT_FLAG", 10)
 	rateLimitSubmitFlagDuration := time.Duration(getEnvInt("RATE_LIMIT_SUBMIT_FLAG_DURATION", 1)) * time.Minute
+	rateLimitLoginAttempts := getEnvInt("RATE_LIMIT_LOGIN_ATTEMPTS", 5)
+	rateLimitLoginIPAttempts := getEnvInt("RATE_LIMIT_LOGIN_IP_ATTEMPTS", 30)
+	rateLimitLoginWindow := time.Duration(getEnvInt("RATE_LIMIT_LOGIN_WINDOW", 15)) * time.Minute
+	rateLimitLoginLockout := time.Duration(getEnvInt("RATE_LIMIT_LOGIN_LOCKOUT", 1)) * time.Minute
+	rateLimitLoginMaxLockout := time.Duration(getEnvInt("RATE_LIMIT_LOGIN_MAX_LOCKOUT", 60)) * time.Minute
 
 	resendFromEmail := getEnv("RESEND_FROM_EMAIL", "noreply@ctfboard.local")
 	resendFromName := getEnv("RESEND_FROM_NAME", "CTFBoard")
@@ -170,6 +190,12 @@ func New() (*Config, error) {
 	allowTeamSwitch := getEnvBool("ALLOW_TEAM_SWITCH", true)
 	minTeamSize := getEnvInt("MIN_TEAM_SIZE", 1)
 	maxTeamSize := getEnvInt("MAX_TEAM_SIZE", 10)
+	scoringRefreshInterval := time.Duration(getEnvInt("SCORING_REFRESH_INTERVAL_SECONDS", 60)) * time.Second
+
+	instanceProvider := getEnv("INSTANCE_PROVIDER", "")
+	instanceMaxPerTeam := getEnvInt("INSTANCE_MAX_PER_TEAM", 1)
+	instanceReapInterval := time.Duration(getEnvInt("INSTANCE_REAP_INTERVAL_SECONDS", 30)) * time.Second
+	instanceFakeHost := getEnv("INSTANCE_FAKE_HOST", "localhost")
 
 	var lvl logger.Level
 	switch logLevel {
@@ -318,6 +344,11 @@ func New() (*Config, error) {
 		RateLimit: RateLimit{
 			SubmitFlag:         rateLimitSubmitFlag,
 			SubmitFlagDuration: rateLimitSubmitFlagDuration,
+			LoginAttempts:      rateLimitLoginAttempts,
+			LoginIPAttempts:    rateLimitLoginIPAttempts,
+			LoginWindow:        rateLimitLoginWindow,
+			LoginLockout:       rateLimitLoginLockout,
+			LoginMaxLockout:    rateLimitLoginMaxLockout,
 		},
 		Resend: Resend{
 			APIKey:      resendAPIKey,
@@ -340,10 +371,17 @@ func New() (*Config, error) {
 			PresignedExpiry:  storagePresignedExpiry,
 		},
 		Competition: Competition{
-			Mode:            competitionMode,
-			AllowTeamSwitch: allowTeamSwitch,
-			MinTeamSize:     minTeamSize,
-			MaxTeamSize:     maxTeamSize,
+			Mode:                   competitionMode,
+			AllowTeamSwitch:        allowTeamSwitch,
+			MinTeamSize:            minTeamSize,
+			MaxTeamSize:            maxTeamSize,
+			ScoringRefreshInterval: scoringRefreshInterval,
+		},
+		Instances: Instances{
+			InstanceProvider:     instanceProvider,
+			InstanceMaxPerTeam:   instanceMaxPerTeam,
+			InstanceReapInterval: instanceReapInterval,
+			InstanceFakeHost:     instanceFakeHost,
 		},
 	}
 
@@ -1,11 +1,14 @@
 package e2e_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,3 +68,83 @@ func TestAPIToken_Delete_NotFound(t *testing.T) {
 
 	h.DeleteUserToken(tokenUser, uuid.New().String(), http.StatusNoContent)
 }
+
+// POST /user/tokens with scopes: the token reaches only routes covered by its scopes.
+func TestAPIToken_Scopes_Enforced(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	_, _, userToken := h.RegisterUserAndLogin("apitok_scope_" + suffix)
+	createResp := h.CreateScopedUserToken(userToken, []openapi.RequestCreateAPITokenRequestScopes{
+		openapi.RequestCreateAPITokenRequestScopesChallengesRead,
+	}, http.StatusCreated)
+	require.NotNil(t, createResp.JSON201)
+	apiToken := helper.APIToken(createResp.JSON201.Token)
+
+	h.GetChallengesExpectStatus(apiToken, http.StatusOK)
+	meResp := h.MeWithClient(ctx, h.Client(), apiToken)
+	assert.Equal(t, http.StatusForbidden, meResp.StatusCode())
+	h.GetUserTokens(apiToken, http.StatusForbidden)
+
+	reqs := h.GetUserTokenRequests(userToken, *createResp.JSON201.ID, http.StatusOK)
+	require.NotNil(t, reqs.JSON200)
+	assert.Len(t, *reqs.JSON200, 3)
+}
+
+// POST /user/tokens: admin scopes are rejected for non-admins.
+func TestAPIToken_AdminScope_Forbidden(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, userToken := h.RegisterUserAndLogin("apitok_admscope_" + suffix)
+
+	h.CreateScopedUserToken(userToken, []openapi.RequestCreateAPITokenRequestScopes{
+		openapi.RequestCreateAPITokenRequestScopesAdmin,
+	}, http.StatusBadRequest)
+}
+
+// POST /admin/tokens: a service token with admin:backup can export but not change settings.
+func TestAPIToken_ServiceToken_Success(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("apitok_svc_" + suffix)
+	createResp := h.CreateServiceToken(adminToken, "ctftime sync", []openapi.RequestCreateServiceTokenRequestScopes{
+		openapi.RequestCreateServiceTokenRequestScopesAdminBackup,
+	}, http.StatusCreated)
+	require.NotNil(t, createResp.JSON201)
+	serviceToken := helper.APIToken(createResp.JSON201.Token)
+
+	h.AdminExportExpectStatus(serviceToken, false, false, http.StatusOK)
+	h.CreateNotification(serviceToken, "title", "content", "info", false, http.StatusForbidden)
+	h.GetServiceTokens(serviceToken, http.StatusForbidden)
+
+	listResp := h.GetServiceTokens(adminToken, http.StatusOK)
+	require.NotNil(t, listResp.JSON200)
+	require.Len(t, *listResp.JSON200, 1)
+	assert.True(t, *(*listResp.JSON200)[0].Service)
+
+	h.DeleteServiceToken(adminToken, *createResp.JSON201.ID, http.StatusNoContent)
+	h.AdminExportExpectStatus(serviceToken, false, false, http.StatusUnauthorized)
+}
+
+// POST /admin/tokens: service tokens require admin scopes.
+func TestAPIToken_ServiceToken_InvalidScope(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("apitok_svcbad_" + suffix)
+
+	h.CreateServiceToken(adminToken, "bot", []openapi.RequestCreateServiceTokenRequestScopes{
+		openapi.RequestCreateServiceTokenRequestScopes("submit"),
+	}, http.StatusBadRequest)
+}
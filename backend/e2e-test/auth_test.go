@@ -198,3 +198,69 @@ func TestAuth_ResetPassword_InvalidToken(t *testing.T) {
 
 	h.ResetPasswordExpectStatus("invalid-token", "newpass123", http.StatusNotFound)
 }
+
+// POST /auth/refresh: refresh token rotates and the new access token works.
+func TestAuth_Refresh_Rotates(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	email := "refresher@example.com"
+	h.Register("refresher", email, "password123")
+	login := h.Login(email, "password123", http.StatusOK)
+	require.NotNil(t, login.JSON200)
+
+	refreshed := h.Refresh(*login.JSON200.RefreshToken, http.StatusOK)
+	require.NotNil(t, refreshed.JSON200)
+	assert.NotEqual(t, *login.JSON200.RefreshToken, *refreshed.JSON200.RefreshToken)
+
+	me := helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), *refreshed.JSON200.AccessToken))
+	assert.Equal(t, email, *me.Email)
+}
+
+// POST /auth/refresh: reusing a rotated refresh token revokes the whole family.
+func TestAuth_Refresh_ReuseRevokesFamily(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	email := "reuser@example.com"
+	h.Register("reuser", email, "password123")
+	login := h.Login(email, "password123", http.StatusOK)
+	require.NotNil(t, login.JSON200)
+	oldRefresh := *login.JSON200.RefreshToken
+
+	refreshed := h.Refresh(oldRefresh, http.StatusOK)
+	require.NotNil(t, refreshed.JSON200)
+
+	h.Refresh(oldRefresh, http.StatusUnauthorized)
+	h.Refresh(*refreshed.JSON200.RefreshToken, http.StatusUnauthorized)
+}
+
+// POST /auth/refresh: garbage token returns 401.
+func TestAuth_Refresh_InvalidToken(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	h.Refresh("not-a-token", http.StatusUnauthorized)
+}
+
+// POST /auth/logout: revokes the family so the refresh token can no longer be used; other logins stay valid.
+func TestAuth_Logout(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	email := "leaver@example.com"
+	h.Register("leaver", email, "password123")
+	first := h.Login(email, "password123", http.StatusOK)
+	second := h.Login(email, "password123", http.StatusOK)
+	require.NotNil(t, first.JSON200)
+	require.NotNil(t, second.JSON200)
+
+	h.Logout(*first.JSON200.RefreshToken, http.StatusNoContent)
+	h.Refresh(*first.JSON200.RefreshToken, http.StatusUnauthorized)
+	h.Refresh(*second.JSON200.RefreshToken, http.StatusOK)
+}
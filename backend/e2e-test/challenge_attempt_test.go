@@ -0,0 +1,115 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+// Attempt limits: a team that used up its wrong submissions is locked out until an admin
+// resets its attempts.
+func TestChallengeAttempts_LimitAndReset(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_attempts_limit")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Two Tries", "flag{tries}", 100)
+
+	h.GetAttemptLimit(tokenAdmin, challID, http.StatusNotFound)
+	h.SetAttemptLimit(tokenAdmin, challID, openapi.PutAdminChallengesChallengeIDAttemptLimitJSONRequestBody{}, http.StatusBadRequest)
+	maxAttempts := 2
+	limit := h.SetAttemptLimit(tokenAdmin, challID, openapi.PutAdminChallengesChallengeIDAttemptLimitJSONRequestBody{
+		MaxAttempts: &maxAttempts,
+	}, http.StatusOK)
+	require.NotNil(t, limit.MaxAttempts)
+	require.Equal(t, 2, *limit.MaxAttempts)
+
+	_, _, tokenUser := h.RegisterUserAndLogin("user_attempts_limit")
+	h.CreateTeam(tokenUser, "AttemptsLimit", http.StatusCreated)
+	teamID := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenUser, http.StatusOK))
+
+	listed := h.FindChallengeInList(tokenUser, challID)
+	require.NotNil(t, listed.AttemptsRemaining)
+	require.Equal(t, 2, *listed.AttemptsRemaining)
+
+	h.SubmitFlag(tokenUser, challID, "flag{one}", http.StatusBadRequest)
+	h.SubmitFlag(tokenUser, challID, "flag{two}", http.StatusBadRequest)
+	listed = h.FindChallengeInList(tokenUser, challID)
+	require.Equal(t, 0, *listed.AttemptsRemaining)
+	h.SubmitFlag(tokenUser, challID, "flag{tries}", http.StatusForbidden)
+
+	h.ResetTeamAttempts(tokenUser, challID, teamID, http.StatusForbidden)
+	h.ResetTeamAttempts(tokenAdmin, challID, teamID, http.StatusNoContent)
+	listed = h.FindChallengeInList(tokenUser, challID)
+	require.Equal(t, 2, *listed.AttemptsRemaining)
+	h.SubmitFlag(tokenUser, challID, "flag{tries}", http.StatusOK)
+
+	h.DeleteAttemptLimit(tokenAdmin, challID)
+	listed = h.FindChallengeInList(tokenUser, challID)
+	require.Nil(t, listed.AttemptsRemaining)
+}
+
+// Wrong submission penalties: every wrong flag on a penalized challenge costs the team points.
+func TestChallengeAttempts_WrongPenalty(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_attempts_penalty")
+	freeID := h.CreateBasicChallenge(tokenAdmin, "Free Points", "flag{free}", 100)
+	penalizedID := h.CreateBasicChallenge(tokenAdmin, "Costly Guess", "flag{costly}", 200)
+	penalty := 15
+	h.SetAttemptLimit(tokenAdmin, penalizedID, openapi.PutAdminChallengesChallengeIDAttemptLimitJSONRequestBody{
+		WrongPenalty: &penalty,
+	}, http.StatusOK)
+
+	_, _, tokenUser := h.RegisterUserAndLogin("user_attempts_penalty")
+	h.CreateTeam(tokenUser, "AttemptsPenalty", http.StatusCreated)
+
+	h.SubmitFlag(tokenUser, freeID, "flag{free}", http.StatusOK)
+	h.AssertTeamScore("AttemptsPenalty", 100)
+
+	listed := h.FindChallengeInList(tokenUser, penalizedID)
+	require.Nil(t, listed.AttemptsRemaining)
+	require.NotNil(t, listed.WrongPenalty)
+	require.Equal(t, 15, *listed.WrongPenalty)
+
+	h.SubmitFlag(tokenUser, penalizedID, "flag{guess1}", http.StatusBadRequest)
+	h.SubmitFlag(tokenUser, penalizedID, "flag{guess2}", http.StatusBadRequest)
+	h.AssertTeamScore("AttemptsPenalty", 70)
+
+	h.SubmitFlag(tokenUser, penalizedID, "flag{costly}", http.StatusOK)
+	h.AssertTeamScore("AttemptsPenalty", 270)
+}
+
+// Submissions rejected before the flag is judged, here because the challenge is still locked,
+// do not use up attempts.
+func TestChallengeAttempts_RejectedSubmissionsNotCounted(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_attempts_rejected")
+	gateID := h.CreateBasicChallenge(tokenAdmin, "Gate", "flag{gate}", 100)
+	lockedID := h.CreateBasicChallenge(tokenAdmin, "Behind Gate", "flag{behind}", 200)
+	h.SetChallengeRequirements(tokenAdmin, lockedID, []string{gateID}, 0, http.StatusOK)
+	maxAttempts := 1
+	h.SetAttemptLimit(tokenAdmin, lockedID, openapi.PutAdminChallengesChallengeIDAttemptLimitJSONRequestBody{
+		MaxAttempts: &maxAttempts,
+	}, http.StatusOK)
+
+	_, _, tokenUser := h.RegisterUserAndLogin("user_attempts_rejected")
+	h.CreateTeam(tokenUser, "AttemptsRejected", http.StatusCreated)
+
+	h.SubmitFlag(tokenUser, lockedID, "flag{early}", http.StatusForbidden)
+	h.SubmitFlag(tokenUser, gateID, "flag{gate}", http.StatusOK)
+
+	listed := h.FindChallengeInList(tokenUser, lockedID)
+	require.NotNil(t, listed.AttemptsRemaining)
+	require.Equal(t, 1, *listed.AttemptsRemaining)
+	h.SubmitFlag(tokenUser, lockedID, "flag{behind}", http.StatusOK)
+}
@@ -0,0 +1,58 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/require"
+)
+
+// Additional flags: a static case-insensitive flag and a regex flag are accepted besides the primary one.
+func TestChallengeFlag_AlternativeFlagsAccepted(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_flags")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Many Flags", "flag{primary}", 100)
+	h.CreateChallengeFlag(tokenAdmin, challID, "static", "flag{Alt}", true)
+	h.CreateChallengeFlag(tokenAdmin, challID, "regex", "^flag\\{[0-9]{4}\\}$", false)
+
+	flags := h.GetChallengeFlags(tokenAdmin, challID)
+	require.Len(t, flags, 2)
+	require.Equal(t, "static", flags[0].Type)
+	require.True(t, flags[0].IsCaseInsensitive)
+
+	_, _, user1 := h.RegisterUserAndLogin("user_flags1")
+	h.CreateTeam(user1, "FlagsTeam1", http.StatusCreated)
+	h.SubmitFlag(user1, challID, "flag{wrong}", http.StatusBadRequest)
+	h.SubmitFlag(user1, challID, "FLAG{ALT}", http.StatusOK)
+
+	_, _, user2 := h.RegisterUserAndLogin("user_flags2")
+	h.CreateTeam(user2, "FlagsTeam2", http.StatusCreated)
+	h.SubmitFlag(user2, challID, "flag{1337}", http.StatusOK)
+}
+
+// Additional flags: updating and deleting a flag changes which submissions are accepted.
+func TestChallengeFlag_UpdateAndDelete(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_flags_upd")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Flag Update", "flag{primary}", 100)
+	flagID := h.CreateChallengeFlag(tokenAdmin, challID, "static", "flag{old}", false)
+
+	h.UpdateChallengeFlag(tokenAdmin, flagID, "static", "flag{new}", false, http.StatusOK)
+	h.UpdateChallengeFlag(tokenAdmin, flagID, "regex", "flag{(", false, http.StatusBadRequest)
+
+	_, _, userToken := h.RegisterUserAndLogin("user_flags_upd")
+	h.CreateTeam(userToken, "FlagsUpdTeam", http.StatusCreated)
+	h.SubmitFlag(userToken, challID, "flag{old}", http.StatusBadRequest)
+
+	h.DeleteChallengeFlag(tokenAdmin, flagID, http.StatusNoContent)
+	h.DeleteChallengeFlag(tokenAdmin, flagID, http.StatusNotFound)
+	h.SubmitFlag(userToken, challID, "flag{new}", http.StatusBadRequest)
+	require.Empty(t, h.GetChallengeFlags(tokenAdmin, challID))
+}
@@ -0,0 +1,69 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/require"
+)
+
+// Prerequisites: a challenge stays locked until its prerequisite is solved.
+func TestChallengeRequirement_UnlockAfterPrerequisite(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_req")
+	firstID := h.CreateBasicChallenge(tokenAdmin, "Stage One", "flag{one}", 100)
+	secondID := h.CreateBasicChallenge(tokenAdmin, "Stage Two", "flag{two}", 100)
+	h.SetChallengeRequirements(tokenAdmin, secondID, []string{firstID}, 0, http.StatusOK)
+
+	req := h.GetChallengeRequirements(tokenAdmin, secondID)
+	require.Equal(t, []string{firstID}, req.Prerequisites)
+
+	_, _, userToken := h.RegisterUserAndLogin("user_req")
+	h.CreateTeam(userToken, "ReqTeam", http.StatusCreated)
+
+	locked := h.FindChallengeInList(userToken, secondID)
+	require.NotNil(t, locked.Locked)
+	require.True(t, *locked.Locked)
+	h.SubmitFlag(userToken, secondID, "flag{two}", http.StatusForbidden)
+
+	h.SubmitFlag(userToken, firstID, "flag{one}", http.StatusOK)
+	unlocked := h.FindChallengeInList(userToken, secondID)
+	require.False(t, *unlocked.Locked)
+	h.SubmitFlag(userToken, secondID, "flag{two}", http.StatusOK)
+}
+
+// Prerequisites: a score threshold locks a challenge until the team has enough points.
+func TestChallengeRequirement_MinScore(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_req_score")
+	easyID := h.CreateBasicChallenge(tokenAdmin, "Easy", "flag{easy}", 200)
+	hardID := h.CreateBasicChallenge(tokenAdmin, "Hard", "flag{hard}", 500)
+	h.SetChallengeRequirements(tokenAdmin, hardID, nil, 150, http.StatusOK)
+
+	_, _, userToken := h.RegisterUserAndLogin("user_req_score")
+	h.CreateTeam(userToken, "ReqScoreTeam", http.StatusCreated)
+	h.SubmitFlag(userToken, hardID, "flag{hard}", http.StatusForbidden)
+	h.SubmitFlag(userToken, easyID, "flag{easy}", http.StatusOK)
+	h.SubmitFlag(userToken, hardID, "flag{hard}", http.StatusOK)
+}
+
+// Prerequisites: cycles and self references are rejected.
+func TestChallengeRequirement_RejectsCycle(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_req_cycle")
+	aID := h.CreateBasicChallenge(tokenAdmin, "Cycle A", "flag{a}", 100)
+	bID := h.CreateBasicChallenge(tokenAdmin, "Cycle B", "flag{b}", 100)
+	h.SetChallengeRequirements(tokenAdmin, bID, []string{aID}, 0, http.StatusOK)
+	h.SetChallengeRequirements(tokenAdmin, aID, []string{bID}, 0, http.StatusBadRequest)
+	h.SetChallengeRequirements(tokenAdmin, aID, []string{aID}, 0, http.StatusBadRequest)
+}
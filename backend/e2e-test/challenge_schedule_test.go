@@ -0,0 +1,85 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/require"
+)
+
+// Scheduled release: a challenge is hidden and rejects submissions until release_at, then goes live.
+func TestChallengeSchedule_ReleaseWindow(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_sched")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Scheduled", "flag{later}", 100)
+	releaseAt := time.Now().Add(time.Hour)
+	sched := h.SetChallengeSchedule(tokenAdmin, challID, &releaseAt, nil, false, http.StatusOK)
+	require.Equal(t, "scheduled", string(sched.Status))
+
+	_, _, userToken := h.RegisterUserAndLogin("user_sched")
+	h.CreateTeam(userToken, "SchedTeam", http.StatusCreated)
+	require.False(t, h.IsChallengeListed(userToken, challID))
+	h.SubmitFlag(userToken, challID, "flag{later}", http.StatusNotFound)
+
+	timeline := h.GetReleaseTimeline(tokenAdmin)
+	require.Len(t, timeline, 1)
+	require.Equal(t, challID, timeline[0].ChallengeID)
+	require.Equal(t, "scheduled", string(timeline[0].Status))
+
+	released := time.Now().Add(-time.Minute)
+	hideAt := time.Now().Add(time.Hour)
+	h.SetChallengeSchedule(tokenAdmin, challID, &released, &hideAt, false, http.StatusOK)
+	require.True(t, h.IsChallengeListed(userToken, challID))
+	h.SubmitFlag(userToken, challID, "flag{later}", http.StatusOK)
+
+	ended := time.Now().Add(-time.Second)
+	h.SetChallengeSchedule(tokenAdmin, challID, &released, &ended, false, http.StatusOK)
+	require.False(t, h.IsChallengeListed(userToken, challID))
+}
+
+// Scheduled release: a due release is announced once with a global notification.
+func TestChallengeSchedule_ReleaseAnnouncement(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_sched_notif")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Announced", "flag{soon}", 100)
+	releaseAt := time.Now().Add(2 * time.Second)
+	h.SetChallengeSchedule(tokenAdmin, challID, &releaseAt, nil, true, http.StatusOK)
+
+	_, _, userToken := h.RegisterUserAndLogin("user_sched_notif")
+	require.False(t, h.IsChallengeListed(userToken, challID))
+
+	time.Sleep(3 * time.Second)
+	require.True(t, h.IsChallengeListed(userToken, challID))
+	require.True(t, h.IsChallengeListed(userToken, challID))
+
+	resp := h.GetNotifications(1, 20, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	count := 0
+	for _, n := range *resp.JSON200 {
+		if n.Title != nil && *n.Title == "New challenge released" {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+}
+
+// Scheduled release: hide_at must come after release_at.
+func TestChallengeSchedule_InvalidWindow(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_sched_invalid")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Invalid Window", "flag{x}", 100)
+	releaseAt := time.Now().Add(2 * time.Hour)
+	hideAt := time.Now().Add(time.Hour)
+	h.SetChallengeSchedule(tokenAdmin, challID, &releaseAt, &hideAt, false, http.StatusBadRequest)
+}
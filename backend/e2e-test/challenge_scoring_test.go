@@ -0,0 +1,80 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+// Scoring functions: admins list the functions and preview a curve without touching any challenge.
+func TestChallengeScoring_FunctionsAndPreview(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_scoring_preview")
+
+	functions := h.ListScoringFunctions(tokenAdmin)
+	names := make([]string, len(functions))
+	for i, f := range functions {
+		names[i] = f.Name
+	}
+	require.Equal(t, []string{"exponential", "linear", "logarithmic", "quadratic", "static", "time"}, names)
+
+	points := h.PreviewScoring(tokenAdmin, openapi.PostAdminScoringPreviewJSONRequestBody{
+		Function: "linear",
+		Params:   map[string]float64{"initial": 300, "minimum": 200, "decay": 50},
+		Solves:   4,
+	}, http.StatusOK)
+	require.Equal(t, []int{300, 250, 200, 200}, points)
+
+	h.PreviewScoring(tokenAdmin, openapi.PostAdminScoringPreviewJSONRequestBody{
+		Function: "sigmoid",
+		Params:   map[string]float64{"initial": 300},
+		Solves:   4,
+	}, http.StatusBadRequest)
+	h.PreviewScoring(tokenAdmin, openapi.PostAdminScoringPreviewJSONRequestBody{
+		Function: "linear",
+		Params:   map[string]float64{"initial": 300},
+		Solves:   4,
+	}, http.StatusBadRequest)
+}
+
+// Scoring functions: a challenge switched to linear decay loses value on every solve and
+// returns to its static value when reset.
+func TestChallengeScoring_LinearDecay(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_scoring_linear")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Linear Decay", "flag{linear}", 300)
+
+	state := h.GetChallengeScoring(tokenAdmin, challID)
+	require.True(t, state.IsDefault)
+	require.Equal(t, "static", state.Function)
+
+	h.SetChallengeScoring(tokenAdmin, challID, "linear", map[string]float64{"initial": 300, "minimum": 100}, http.StatusBadRequest)
+	state = h.SetChallengeScoring(tokenAdmin, challID, "linear", map[string]float64{"initial": 300, "minimum": 100, "decay": 50}, http.StatusOK)
+	require.False(t, state.IsDefault)
+	require.Equal(t, 300, state.Points)
+
+	_, _, firstToken := h.RegisterUserAndLogin("user_scoring_first")
+	h.CreateTeam(firstToken, "ScoringFirst", http.StatusCreated)
+	_, _, secondToken := h.RegisterUserAndLogin("user_scoring_second")
+	h.CreateTeam(secondToken, "ScoringSecond", http.StatusCreated)
+
+	h.SubmitFlag(firstToken, challID, "flag{linear}", http.StatusOK)
+	h.SubmitFlag(secondToken, challID, "flag{linear}", http.StatusOK)
+	h.AssertTeamScore("ScoringFirst", 250)
+	h.AssertTeamScore("ScoringSecond", 250)
+	require.Equal(t, 250, h.GetChallengeScoring(tokenAdmin, challID).Points)
+
+	state = h.ResetChallengeScoring(tokenAdmin, challID)
+	require.True(t, state.IsDefault)
+	require.Equal(t, "static", state.Function)
+	require.Equal(t, 250, state.Points)
+}
@@ -0,0 +1,127 @@
+package e2e_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+const specWarmup = `name: Spec Warmup
+category: Misc
+description: Say hi
+value: 50
+flags:
+  - flag{spec_warmup}
+tags:
+  - easy
+`
+
+const specWeb = `name: Spec Web
+category: Web
+description: Look around
+value: 200
+type: dynamic
+extra:
+  initial: 200
+  decay: 10
+  minimum: 50
+flags:
+  - type: regex
+    content: flag\{web_[0-9]+\}
+files:
+  - dist/source.txt
+hints:
+  - content: Check the source
+    cost: 10
+requirements:
+  - spec-warmup
+state: visible
+`
+
+// Challenge specs: a dry run changes nothing, an import creates the challenges with their
+// flags, files and requirements, and importing the same bundle or its export again leaves
+// everything unchanged.
+func TestChallengeSpec_ImportExportResync(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_spec")
+	bundle := h.SpecZip(map[string]string{
+		"misc/warmup/challenge.yml":  specWarmup,
+		"web/web/challenge.yml":      specWeb,
+		"web/web/dist/source.txt":    "<!-- flag{web_1} -->",
+		"__MACOSX/web/challenge.yml": "ignored",
+	})
+
+	plan := h.ImportChallengeSpecs(tokenAdmin, bundle, "specs.zip", true, http.StatusOK)
+	require.True(t, plan.DryRun)
+	require.Equal(t, 2, plan.Created)
+	h.ExportChallengeSpecs(tokenAdmin, nil, http.StatusNotFound)
+
+	result := h.ImportChallengeSpecs(tokenAdmin, bundle, "specs.zip", false, http.StatusOK)
+	require.Equal(t, 2, result.Created)
+	require.Zero(t, result.Failed)
+	ids := map[string]string{}
+	for _, item := range result.Items {
+		require.NotNil(t, item.ChallengeID)
+		ids[item.Slug] = *item.ChallengeID
+	}
+
+	again := h.ImportChallengeSpecs(tokenAdmin, bundle, "specs.zip", false, http.StatusOK)
+	require.Equal(t, 2, again.Unchanged)
+
+	_, _, token := h.RegisterUserAndLogin("user_spec")
+	h.CreateTeam(token, "SpecTeam", http.StatusCreated)
+	web := h.FindChallengeInList(token, ids["spec-web"])
+	require.True(t, *web.Locked)
+	h.SubmitFlag(token, ids["spec-warmup"], "flag{spec_warmup}", http.StatusOK)
+	h.SubmitFlag(token, ids["spec-web"], "flag{web_42}", http.StatusOK)
+
+	exported := h.ExportChallengeSpecs(tokenAdmin, nil, http.StatusOK)
+	zr, err := zip.NewReader(bytes.NewReader(exported), int64(len(exported)))
+	require.NoError(t, err)
+	names := make([]string, 0, len(zr.File))
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	require.ElementsMatch(t, []string{
+		"misc/spec-warmup/challenge.yml",
+		"web/spec-web/files/source.txt",
+		"web/spec-web/challenge.yml",
+	}, names)
+
+	resync := h.ImportChallengeSpecs(tokenAdmin, exported, "export.zip", false, http.StatusOK)
+	require.Equal(t, 2, resync.Unchanged)
+
+	category := "Misc"
+	single := h.ExportChallengeSpecs(tokenAdmin, &openapi.GetAdminChallengeSpecsExportParams{Category: &category}, http.StatusOK)
+	zr, err = zip.NewReader(bytes.NewReader(single), int64(len(single)))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 1)
+}
+
+// Challenge specs: an invalid bundle is rejected as a whole with its problems listed.
+func TestChallengeSpec_ImportProblems(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_spec_problems")
+	bundle := h.SpecZip(map[string]string{
+		"a/challenge.yml": "name: Broken\ncategory: Misc\nvalue: -1\nflags: []\nfiles: [missing.bin]\n",
+		"b/challenge.yml": specWarmup + "requirements: [nothing-here]\n",
+	})
+
+	result := h.ImportChallengeSpecs(tokenAdmin, bundle, "specs.zip", false, http.StatusUnprocessableEntity)
+	require.Len(t, result.Problems, 3)
+	require.Empty(t, result.Items)
+
+	h.ImportChallengeSpecs(tokenAdmin, h.SpecZip(map[string]string{"readme.md": "hi"}), "specs.zip", false, http.StatusBadRequest)
+	h.ExportChallengeSpecs(tokenAdmin, nil, http.StatusNotFound)
+}
@@ -0,0 +1,77 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+// Multi-stage challenge: each stage is credited in order and the last one solves the challenge.
+func TestChallengeStage_ProgressAndScore(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_stages")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Exploit Chain", "flag{unused}", 100)
+	h.CreateChallengeStage(tokenAdmin, challID, "Leak", 30, helper.StaticStageFlag("flag{leak}"))
+	h.CreateChallengeStage(tokenAdmin, challID, "Shell", 50, helper.StaticStageFlag("flag{shell}"))
+
+	_, _, userToken := h.RegisterUserAndLogin("user_stages")
+	teamName := "StagesTeam"
+	h.CreateTeam(userToken, teamName, http.StatusCreated)
+
+	h.SubmitFlag(userToken, challID, "flag{shell}", http.StatusBadRequest)
+	h.SubmitFlag(userToken, challID, "flag{leak}", http.StatusOK)
+	h.AssertTeamScore(teamName, 30)
+
+	c := h.FindChallengeInList(userToken, challID)
+	require.NotNil(t, c.StagesCompleted)
+	require.Equal(t, 1, *c.StagesCompleted)
+	require.Equal(t, 2, *c.StagesTotal)
+	require.False(t, *c.Solved)
+
+	stages := h.GetChallengeStages(userToken, challID, http.StatusOK)
+	require.Len(t, stages, 2)
+	require.True(t, stages[0].Completed)
+	require.NotNil(t, stages[0].FirstBlood)
+	require.Equal(t, teamName, *stages[0].FirstBlood.TeamName)
+	require.Nil(t, stages[1].FirstBlood)
+
+	h.SubmitFlag(userToken, challID, "flag{shell}", http.StatusOK)
+	h.AssertTeamScore(teamName, 180)
+	require.True(t, *h.FindChallengeInList(userToken, challID).Solved)
+	h.SubmitFlag(userToken, challID, "flag{shell}", http.StatusConflict)
+}
+
+// Multi-stage challenge: admins reorder, update and delete stages.
+func TestChallengeStage_AdminManage(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_stages_manage")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Stage Admin", "flag{unused}", 100)
+	first := h.CreateChallengeStage(tokenAdmin, challID, "First", 10, helper.StaticStageFlag("flag{a}"))
+	second := h.CreateChallengeStage(tokenAdmin, challID, "Second", 20, helper.StaticStageFlag("flag{b}"))
+	h.CreateChallengeStageExpectStatus(tokenAdmin, challID, "No Flags", 10, []openapi.RequestChallengeStageFlagRequest{}, http.StatusBadRequest)
+
+	orderIndex := 0
+	resp := h.UpdateChallengeStage(tokenAdmin, second, openapi.PutAdminStagesIDJSONRequestBody{Title: "Second", Points: 25, OrderIndex: &orderIndex}, http.StatusOK)
+	require.Equal(t, 0, resp.JSON200.OrderIndex)
+	require.Len(t, resp.JSON200.Flags, 1)
+
+	stages := h.GetAdminChallengeStages(tokenAdmin, challID)
+	require.Len(t, stages, 2)
+	require.Equal(t, second, stages[0].ID)
+	require.Equal(t, first, stages[1].ID)
+
+	h.DeleteChallengeStage(tokenAdmin, second, http.StatusNoContent)
+	h.DeleteChallengeStage(tokenAdmin, second, http.StatusNotFound)
+	stages = h.GetAdminChallengeStages(tokenAdmin, challID)
+	require.Len(t, stages, 1)
+	require.Equal(t, 0, stages[0].OrderIndex)
+}
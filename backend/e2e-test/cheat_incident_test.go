@@ -0,0 +1,105 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+// Anti-cheat analytics: two teams guessing the same wrong flag and solving seconds apart are
+// reported once, and each incident can be dismissed or escalated into a team ban.
+func TestCheatIncident_AnalyzeDismissEscalate(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_anticheat")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Leaky", "flag{leaky}", 100)
+
+	_, _, tokenA := h.RegisterUserAndLogin("user_anticheat_a")
+	h.CreateTeam(tokenA, "AntiCheatA", http.StatusCreated)
+	teamA := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenA, http.StatusOK))
+	_, _, tokenB := h.RegisterUserAndLogin("user_anticheat_b")
+	h.CreateTeam(tokenB, "AntiCheatB", http.StatusCreated)
+	teamB := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenB, http.StatusOK))
+
+	h.SubmitFlag(tokenA, challID, "flag{guess}", http.StatusBadRequest)
+	h.SubmitFlag(tokenB, challID, "flag{guess}", http.StatusBadRequest)
+	h.SubmitFlag(tokenA, challID, "flag{leaky}", http.StatusOK)
+	h.SubmitFlag(tokenB, challID, "flag{leaky}", http.StatusOK)
+
+	h.AnalyzeCheating(tokenA, openapi.PostAdminCheatIncidentsAnalyzeJSONRequestBody{}, http.StatusForbidden)
+	tooWide := 7200
+	h.AnalyzeCheating(tokenAdmin, openapi.PostAdminCheatIncidentsAnalyzeJSONRequestBody{WindowSeconds: &tooWide}, http.StatusBadRequest)
+
+	window := 60
+	result := h.AnalyzeCheating(tokenAdmin, openapi.PostAdminCheatIncidentsAnalyzeJSONRequestBody{WindowSeconds: &window}, http.StatusOK)
+	require.NotNil(t, result)
+	require.Equal(t, 2, result.SharedWrongFlag)
+	require.Equal(t, 1, result.CloseSolve)
+	require.Equal(t, 0, result.NoDownload)
+
+	again := h.AnalyzeCheating(tokenAdmin, openapi.PostAdminCheatIncidentsAnalyzeJSONRequestBody{WindowSeconds: &window}, http.StatusOK)
+	require.Equal(t, 0, again.Total, "findings already on record are not reported twice")
+
+	wrongFlag := openapi.GetAdminCheatIncidentsParamsKind("shared_wrong_flag")
+	list := h.ListCheatIncidents(tokenAdmin, &openapi.GetAdminCheatIncidentsParams{Kind: &wrongFlag}, http.StatusOK)
+	require.NotNil(t, list.Items)
+	require.Len(t, *list.Items, 2)
+	incident := (*list.Items)[0]
+	require.Equal(t, openapi.ResponseCheatIncidentResponseStatus("open"), incident.Status)
+	require.NotNil(t, incident.Evidence.Flag)
+	require.Equal(t, "flag{guess}", *incident.Evidence.Flag)
+
+	detail := h.GetCheatIncident(tokenAdmin, incident.ID, http.StatusOK)
+	require.Len(t, detail.Submissions, 2)
+	h.GetCheatIncident(tokenAdmin, "00000000-0000-0000-0000-000000000000", http.StatusNotFound)
+
+	dismissed := h.DismissCheatIncident(tokenAdmin, incident.ID, http.StatusOK)
+	require.Equal(t, openapi.ResponseCheatIncidentResponseStatus("dismissed"), dismissed.Status)
+	require.NotNil(t, dismissed.ResolvedAt)
+	h.DismissCheatIncident(tokenAdmin, incident.ID, http.StatusConflict)
+
+	closeSolve := openapi.GetAdminCheatIncidentsParamsKind("close_solve")
+	list = h.ListCheatIncidents(tokenAdmin, &openapi.GetAdminCheatIncidentsParams{Kind: &closeSolve}, http.StatusOK)
+	require.Len(t, *list.Items, 1)
+	copied := (*list.Items)[0]
+	require.Equal(t, teamB, copied.TeamID)
+	require.NotNil(t, copied.SourceTeamID)
+	require.Equal(t, teamA, *copied.SourceTeamID)
+
+	reason := "copied flag from AntiCheatA"
+	h.EscalateCheatIncident(tokenB, copied.ID, openapi.PostAdminCheatIncidentsIDEscalateJSONRequestBody{Reason: &reason}, http.StatusForbidden)
+	escalated := h.EscalateCheatIncident(tokenAdmin, copied.ID, openapi.PostAdminCheatIncidentsIDEscalateJSONRequestBody{Reason: &reason}, http.StatusOK)
+	require.Equal(t, openapi.ResponseCheatIncidentResponseStatus("escalated"), escalated.Status)
+
+	bannedTeam := h.GetMyTeam(tokenB, http.StatusOK)
+	require.NotNil(t, bannedTeam.JSON200.IsBanned)
+	require.True(t, *bannedTeam.JSON200.IsBanned)
+
+	open := openapi.GetAdminCheatIncidentsParamsStatus("open")
+	list = h.ListCheatIncidents(tokenAdmin, &openapi.GetAdminCheatIncidentsParams{Status: &open, Kind: &wrongFlag}, http.StatusOK)
+	require.Len(t, *list.Items, 1)
+	invalid := openapi.GetAdminCheatIncidentsParamsStatus("bogus")
+	h.ListCheatIncidents(tokenAdmin, &openapi.GetAdminCheatIncidentsParams{Status: &invalid}, http.StatusBadRequest)
+}
+
+// Analysis writes incidents, so support staff who only read submissions may list incidents but
+// not run it; moderators may.
+func TestCheatIncident_Analyze_RequiresTeamsModerate(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	h.SetupCompetition("admin_analyze_perm")
+	_, _, tokenSupport := h.RegisterWithRole("support_analyze_perm", entity.RoleSupport)
+	_, _, tokenModerator := h.RegisterWithRole("moderator_analyze_perm", entity.RoleModerator)
+
+	h.ListCheatIncidents(tokenSupport, nil, http.StatusOK)
+	h.AnalyzeCheating(tokenSupport, openapi.PostAdminCheatIncidentsAnalyzeJSONRequestBody{}, http.StatusForbidden)
+	h.AnalyzeCheating(tokenModerator, openapi.PostAdminCheatIncidentsAnalyzeJSONRequestBody{}, http.StatusOK)
+}
@@ -0,0 +1,57 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+// Decoy flags: a team submitting a planted decoy gets the same answer as for any wrong flag,
+// while a high severity incident is recorded and the decoy policy bans the team.
+func TestDecoyFlag_SubmitRecordsIncidentAndBans(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_decoy")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Honeypot", "flag{real}", 100)
+
+	h.CreateDecoyFlag(tokenAdmin, challID, "flag{real}", "", http.StatusBadRequest)
+	decoyID := h.CreateDecoyFlag(tokenAdmin, challID, "flag{leaked_writeup}", "fake writeup", http.StatusCreated)
+	h.CreateDecoyFlag(tokenAdmin, challID, "flag{leaked_writeup}", "", http.StatusConflict)
+	decoys := h.GetDecoyFlags(tokenAdmin, challID)
+	require.Len(t, decoys, 1)
+	require.Equal(t, decoyID, decoys[0].ID)
+	require.Equal(t, "fake writeup", decoys[0].Note)
+
+	h.SetDecoyPolicy(tokenAdmin, "ban", 0, http.StatusBadRequest)
+	policy := h.SetDecoyPolicy(tokenAdmin, "ban", 1, http.StatusOK)
+	require.Equal(t, openapi.ResponseDecoyPolicyResponseAction("ban"), policy.Action)
+
+	_, _, tokenUser := h.RegisterUserAndLogin("user_decoy")
+	h.CreateTeam(tokenUser, "DecoyTeam", http.StatusCreated)
+	teamID := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenUser, http.StatusOK))
+	h.SetDecoyPolicy(tokenUser, "none", 1, http.StatusForbidden)
+
+	wrong := h.SubmitFlag(tokenUser, challID, "flag{guess}", http.StatusBadRequest)
+	decoy := h.SubmitFlag(tokenUser, challID, "flag{leaked_writeup}", http.StatusBadRequest)
+	require.Equal(t, string(wrong.Body), string(decoy.Body), "a decoy is answered like any wrong flag")
+
+	kind := openapi.GetAdminCheatIncidentsParamsKind("decoy_flag")
+	list := h.ListCheatIncidents(tokenAdmin, &openapi.GetAdminCheatIncidentsParams{Kind: &kind}, http.StatusOK)
+	require.NotNil(t, list.Items)
+	require.Len(t, *list.Items, 1)
+	incident := (*list.Items)[0]
+	require.Equal(t, teamID, incident.TeamID)
+	require.Equal(t, openapi.ResponseCheatIncidentResponseSeverity("high"), incident.Severity)
+
+	team := h.GetMyTeam(tokenUser, http.StatusOK)
+	require.NotNil(t, team.JSON200.IsBanned)
+	require.True(t, *team.JSON200.IsBanned)
+
+	h.DeleteDecoyFlag(tokenAdmin, decoyID, http.StatusNoContent)
+	h.DeleteDecoyFlag(tokenAdmin, decoyID, http.StatusNotFound)
+}
@@ -0,0 +1,39 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) GetAttemptLimit(token, challengeID string, expectStatus int) *openapi.ResponseAttemptLimitResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengesChallengeIDAttemptLimitWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get attempt limit")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) SetAttemptLimit(token, challengeID string, body openapi.PutAdminChallengesChallengeIDAttemptLimitJSONRequestBody, expectStatus int) *openapi.ResponseAttemptLimitResponse {
+	h.t.Helper()
+	resp, err := h.client.PutAdminChallengesChallengeIDAttemptLimitWithResponse(context.Background(), challengeID, body, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "set attempt limit")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) DeleteAttemptLimit(token, challengeID string) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminChallengesChallengeIDAttemptLimitWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusNoContent, resp.StatusCode(), resp.Body, "delete attempt limit")
+}
+
+func (h *E2EHelper) ResetTeamAttempts(token, challengeID, teamID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminChallengesChallengeIDAttemptsTeamIDWithResponse(context.Background(), challengeID, teamID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "reset team attempts")
+}
@@ -0,0 +1,56 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) CreateChallengeFlag(token, challengeID, flagType, flag string, isCaseInsensitive bool) string {
+	h.t.Helper()
+	return h.CreateChallengeFlagExpectStatus(token, challengeID, flagType, flag, isCaseInsensitive, http.StatusCreated)
+}
+
+func (h *E2EHelper) CreateChallengeFlagExpectStatus(token, challengeID, flagType, flag string, isCaseInsensitive bool, expectStatus int) string {
+	h.t.Helper()
+	resp, err := h.client.PostAdminChallengesChallengeIDFlagsWithResponse(context.Background(), challengeID, openapi.PostAdminChallengesChallengeIDFlagsJSONRequestBody{
+		Type:              openapi.RequestChallengeFlagRequestType(flagType),
+		Flag:              flag,
+		IsCaseInsensitive: &isCaseInsensitive,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "create challenge flag")
+	if resp.JSON201 != nil {
+		return resp.JSON201.ID
+	}
+	return ""
+}
+
+func (h *E2EHelper) GetChallengeFlags(token, challengeID string) []openapi.ResponseChallengeFlagResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengesChallengeIDFlagsWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "get challenge flags")
+	require.NotNil(h.t, resp.JSON200)
+	return *resp.JSON200
+}
+
+func (h *E2EHelper) UpdateChallengeFlag(token, flagID, flagType, flag string, isCaseInsensitive bool, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.PutAdminFlagsIDWithResponse(context.Background(), flagID, openapi.PutAdminFlagsIDJSONRequestBody{
+		Type:              openapi.RequestChallengeFlagRequestType(flagType),
+		Flag:              flag,
+		IsCaseInsensitive: &isCaseInsensitive,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "update challenge flag")
+}
+
+func (h *E2EHelper) DeleteChallengeFlag(token, flagID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminFlagsIDWithResponse(context.Background(), flagID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "delete challenge flag")
+}
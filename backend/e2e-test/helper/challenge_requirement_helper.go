@@ -0,0 +1,33 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) SetChallengeRequirements(token, challengeID string, prerequisites []string, minScore, expectStatus int) {
+	h.t.Helper()
+	ids := make([]uuid.UUID, 0, len(prerequisites))
+	for _, p := range prerequisites {
+		ids = append(ids, uuid.MustParse(p))
+	}
+	resp, err := h.client.PutAdminChallengesChallengeIDRequirementsWithResponse(context.Background(), challengeID, openapi.PutAdminChallengesChallengeIDRequirementsJSONRequestBody{
+		Prerequisites: ids,
+		MinScore:      &minScore,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "set challenge requirements")
+}
+
+func (h *E2EHelper) GetChallengeRequirements(token, challengeID string) *openapi.ResponseChallengeRequirementsResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengesChallengeIDRequirementsWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "get challenge requirements")
+	require.NotNil(h.t, resp.JSON200)
+	return resp.JSON200
+}
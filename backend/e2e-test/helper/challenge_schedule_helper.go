@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) SetChallengeSchedule(token, challengeID string, releaseAt, hideAt *time.Time, notifyOnRelease bool, expectStatus int) *openapi.ResponseChallengeScheduleResponse {
+	h.t.Helper()
+	resp, err := h.client.PutAdminChallengesChallengeIDScheduleWithResponse(context.Background(), challengeID, openapi.PutAdminChallengesChallengeIDScheduleJSONRequestBody{
+		ReleaseAt:       releaseAt,
+		HideAt:          hideAt,
+		NotifyOnRelease: &notifyOnRelease,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "set challenge schedule")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) GetReleaseTimeline(token string) []openapi.ResponseReleaseTimelineEntryResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminReleaseTimelineWithResponse(context.Background(), WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "get release timeline")
+	require.NotNil(h.t, resp.JSON200)
+	return *resp.JSON200
+}
+
+func (h *E2EHelper) IsChallengeListed(token, challengeID string) bool {
+	h.t.Helper()
+	resp := h.GetChallengesExpectStatus(token, http.StatusOK)
+	require.NotNil(h.t, resp.JSON200)
+	for _, c := range *resp.JSON200 {
+		if c.ID != nil && *c.ID == challengeID {
+			return true
+		}
+	}
+	return false
+}
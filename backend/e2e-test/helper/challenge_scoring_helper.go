@@ -0,0 +1,58 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) ListScoringFunctions(token string) []openapi.ResponseScoringFunctionResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminScoringFunctionsWithResponse(context.Background(), WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "list scoring functions")
+	require.NotNil(h.t, resp.JSON200)
+	return *resp.JSON200
+}
+
+func (h *E2EHelper) PreviewScoring(token string, body openapi.PostAdminScoringPreviewJSONRequestBody, expectStatus int) []int {
+	h.t.Helper()
+	resp, err := h.client.PostAdminScoringPreviewWithResponse(context.Background(), body, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "preview scoring")
+	if resp.JSON200 == nil {
+		return nil
+	}
+	return resp.JSON200.Points
+}
+
+func (h *E2EHelper) GetChallengeScoring(token, challengeID string) *openapi.ResponseChallengeScoringResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengesChallengeIDScoringWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "get challenge scoring")
+	require.NotNil(h.t, resp.JSON200)
+	return resp.JSON200
+}
+
+func (h *E2EHelper) SetChallengeScoring(token, challengeID, function string, params map[string]float64, expectStatus int) *openapi.ResponseChallengeScoringResponse {
+	h.t.Helper()
+	resp, err := h.client.PutAdminChallengesChallengeIDScoringWithResponse(context.Background(), challengeID, openapi.PutAdminChallengesChallengeIDScoringJSONRequestBody{
+		Function: function,
+		Params:   params,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "set challenge scoring")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) ResetChallengeScoring(token, challengeID string) *openapi.ResponseChallengeScoringResponse {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminChallengesChallengeIDScoringWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "reset challenge scoring")
+	require.NotNil(h.t, resp.JSON200)
+	return resp.JSON200
+}
@@ -0,0 +1,59 @@
+package helper
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+// SpecZip packs challenge.yml files and attachments, keyed by their path in the archive.
+func (h *E2EHelper) SpecZip(files map[string]string) []byte {
+	h.t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := zw.Create(name)
+		require.NoError(h.t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(h.t, err)
+	}
+	require.NoError(h.t, zw.Close())
+	return buf.Bytes()
+}
+
+func (h *E2EHelper) ImportChallengeSpecs(token string, fileContent []byte, fileName string, dryRun bool, expectStatus int) *openapi.EntitySpecSyncResult {
+	h.t.Helper()
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	part, err := w.CreateFormFile("file", fileName)
+	require.NoError(h.t, err)
+	_, err = part.Write(fileContent)
+	require.NoError(h.t, err)
+	require.NoError(h.t, w.WriteField("dry_run", strconv.FormatBool(dryRun)))
+	contentType := w.FormDataContentType()
+	require.NoError(h.t, w.Close())
+	resp, err := h.client.PostAdminChallengeSpecsImportWithBodyWithResponse(context.Background(), contentType, &buf, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "import challenge specs")
+	if resp.JSON422 != nil {
+		return resp.JSON422
+	}
+	return resp.JSON200
+}
+
+func (h *E2EHelper) ExportChallengeSpecs(token string, params *openapi.GetAdminChallengeSpecsExportParams, expectStatus int) []byte {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengeSpecsExportWithResponse(context.Background(), params, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "export challenge specs")
+	if expectStatus == http.StatusOK {
+		require.Equal(h.t, "application/zip", resp.HTTPResponse.Header.Get("Content-Type"))
+	}
+	return resp.Body
+}
@@ -0,0 +1,68 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func StaticStageFlag(flag string) openapi.RequestChallengeStageFlagRequest {
+	return openapi.RequestChallengeStageFlagRequest{Type: openapi.RequestChallengeStageFlagRequestType("static"), Flag: flag}
+}
+
+func (h *E2EHelper) CreateChallengeStage(token, challengeID, title string, points int, flags ...openapi.RequestChallengeStageFlagRequest) string {
+	h.t.Helper()
+	return h.CreateChallengeStageExpectStatus(token, challengeID, title, points, flags, http.StatusCreated)
+}
+
+func (h *E2EHelper) CreateChallengeStageExpectStatus(token, challengeID, title string, points int, flags []openapi.RequestChallengeStageFlagRequest, expectStatus int) string {
+	h.t.Helper()
+	resp, err := h.client.PostAdminChallengesChallengeIDStagesWithResponse(context.Background(), challengeID, openapi.PostAdminChallengesChallengeIDStagesJSONRequestBody{
+		Title:  title,
+		Points: points,
+		Flags:  flags,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "create challenge stage")
+	if resp.JSON201 != nil {
+		return resp.JSON201.ID
+	}
+	return ""
+}
+
+func (h *E2EHelper) GetAdminChallengeStages(token, challengeID string) []openapi.ResponseChallengeStageAdminResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengesChallengeIDStagesWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "get admin challenge stages")
+	require.NotNil(h.t, resp.JSON200)
+	return *resp.JSON200
+}
+
+func (h *E2EHelper) UpdateChallengeStage(token, stageID string, body openapi.PutAdminStagesIDJSONRequestBody, expectStatus int) *openapi.PutAdminStagesIDResponse {
+	h.t.Helper()
+	resp, err := h.client.PutAdminStagesIDWithResponse(context.Background(), stageID, body, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "update challenge stage")
+	return resp
+}
+
+func (h *E2EHelper) DeleteChallengeStage(token, stageID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminStagesIDWithResponse(context.Background(), stageID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "delete challenge stage")
+}
+
+func (h *E2EHelper) GetChallengeStages(token, challengeID string, expectStatus int) []openapi.ResponseChallengeStageResponse {
+	h.t.Helper()
+	resp, err := h.client.GetChallengesIDStagesWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get challenge stages")
+	if resp.JSON200 == nil {
+		return nil
+	}
+	return *resp.JSON200
+}
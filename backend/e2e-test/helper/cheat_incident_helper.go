@@ -0,0 +1,48 @@
+package helper
+
+import (
+	"context"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) AnalyzeCheating(token string, body openapi.PostAdminCheatIncidentsAnalyzeJSONRequestBody, expectStatus int) *openapi.ResponseCheatAnalysisResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminCheatIncidentsAnalyzeWithResponse(context.Background(), body, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "analyze cheating")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) ListCheatIncidents(token string, params *openapi.GetAdminCheatIncidentsParams, expectStatus int) *openapi.ResponseCheatIncidentListResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminCheatIncidentsWithResponse(context.Background(), params, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "list cheat incidents")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) GetCheatIncident(token, id string, expectStatus int) *openapi.ResponseCheatIncidentDetailResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminCheatIncidentsIDWithResponse(context.Background(), id, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get cheat incident")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) DismissCheatIncident(token, id string, expectStatus int) *openapi.ResponseCheatIncidentResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminCheatIncidentsIDDismissWithResponse(context.Background(), id, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "dismiss cheat incident")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) EscalateCheatIncident(token, id string, body openapi.PostAdminCheatIncidentsIDEscalateJSONRequestBody, expectStatus int) *openapi.ResponseCheatIncidentResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminCheatIncidentsIDEscalateWithResponse(context.Background(), id, body, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "escalate cheat incident")
+	return resp.JSON200
+}
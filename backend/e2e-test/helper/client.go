@@ -65,9 +65,10 @@ func GetAPIClient(t *testing.T, baseURL string) *openapi.ClientWithResponses {
 	return client
 }
 
+// WithBearerToken sets the Authorization header; values from APIToken are sent as they are.
 func WithBearerToken(token string) openapi.RequestEditorFn {
 	return func(_ context.Context, req *http.Request) error {
-		if token != "" && !strings.HasPrefix(token, "Bearer ") {
+		if token != "" && !strings.HasPrefix(token, "Bearer ") && !strings.HasPrefix(token, "Token ") {
 			token = "Bearer " + token
 		}
 		req.Header.Set("Authorization", token)
@@ -75,6 +76,11 @@ func WithBearerToken(token string) openapi.RequestEditorFn {
 	}
 }
 
+// APIToken turns a plaintext API token into an Authorization value accepted by the helpers.
+func APIToken(plaintext string) string {
+	return "Token " + plaintext
+}
+
 func RequireStatus(t *testing.T, expect, actual int, body []byte, label string) {
 	t.Helper()
 	require.Equal(t, expect, actual, "%s: %s", label, body)
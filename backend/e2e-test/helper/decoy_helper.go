@@ -0,0 +1,50 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) CreateDecoyFlag(token, challengeID, flag, note string, expectStatus int) string {
+	h.t.Helper()
+	resp, err := h.client.PostAdminChallengesChallengeIDDecoysWithResponse(context.Background(), challengeID, openapi.PostAdminChallengesChallengeIDDecoysJSONRequestBody{
+		Flag: flag,
+		Note: &note,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "create decoy flag")
+	if resp.JSON201 != nil {
+		return resp.JSON201.ID
+	}
+	return ""
+}
+
+func (h *E2EHelper) GetDecoyFlags(token, challengeID string) []openapi.ResponseDecoyFlagResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengesChallengeIDDecoysWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "get decoy flags")
+	require.NotNil(h.t, resp.JSON200)
+	return *resp.JSON200
+}
+
+func (h *E2EHelper) DeleteDecoyFlag(token, decoyID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminDecoysIDWithResponse(context.Background(), decoyID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "delete decoy flag")
+}
+
+func (h *E2EHelper) SetDecoyPolicy(token, action string, threshold, expectStatus int) *openapi.ResponseDecoyPolicyResponse {
+	h.t.Helper()
+	resp, err := h.client.PutAdminDecoyPolicyWithResponse(context.Background(), openapi.PutAdminDecoyPolicyJSONRequestBody{
+		Action:    openapi.RequestDecoyPolicyRequestAction(action),
+		Threshold: threshold,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "set decoy policy")
+	return resp.JSON200
+}
@@ -0,0 +1,52 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) SetInstanceConfig(token, challengeID, image string, ttlSeconds, expectStatus int) *openapi.ResponseInstanceConfigResponse {
+	h.t.Helper()
+	resp, err := h.client.PutAdminChallengesChallengeIDInstanceConfigWithResponse(context.Background(), challengeID, openapi.PutAdminChallengesChallengeIDInstanceConfigJSONRequestBody{
+		Image:      image,
+		TTLSeconds: ttlSeconds,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "set instance config")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) StartInstance(token, challengeID string, expectStatus int) *openapi.ResponseChallengeInstanceResponse {
+	h.t.Helper()
+	resp, err := h.client.PostChallengesIDInstanceWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "start instance")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) GetInstance(token, challengeID string, expectStatus int) *openapi.ResponseChallengeInstanceResponse {
+	h.t.Helper()
+	resp, err := h.client.GetChallengesIDInstanceWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get instance")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) ExtendInstance(token, challengeID string) *openapi.ResponseChallengeInstanceResponse {
+	h.t.Helper()
+	resp, err := h.client.PostChallengesIDInstanceExtendWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "extend instance")
+	require.NotNil(h.t, resp.JSON200)
+	return resp.JSON200
+}
+
+func (h *E2EHelper) StopInstance(token, challengeID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteChallengesIDInstanceWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "stop instance")
+}
@@ -0,0 +1,23 @@
+package helper
+
+import (
+	"context"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) GetUserLockout(token, userID string, expectStatus int) *openapi.GetAdminUsersIDLockoutResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminUsersIDLockoutWithResponse(context.Background(), userID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get user lockout")
+	return resp
+}
+
+func (h *E2EHelper) UnlockUser(token, userID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminUsersIDLockoutWithResponse(context.Background(), userID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "unlock user")
+}
@@ -0,0 +1,131 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/pkg/oidc/oidctest"
+	"github.com/stretchr/testify/require"
+)
+
+const OAuthRedirectURL = "http://localhost:3000/oauth/callback"
+
+func (h *E2EHelper) PutOAuthProvider(token, name string, body openapi.PutAdminOauthProvidersProviderJSONRequestBody, expectStatus int) *openapi.PutAdminOauthProvidersProviderResponse {
+	h.t.Helper()
+	resp, err := h.client.PutAdminOauthProvidersProviderWithResponse(context.Background(), name, body, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "put oauth provider")
+	return resp
+}
+
+func (h *E2EHelper) GetAdminOAuthProviders(token string, expectStatus int) *openapi.GetAdminOauthProvidersResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminOauthProvidersWithResponse(context.Background(), WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get admin oauth providers")
+	return resp
+}
+
+func (h *E2EHelper) DeleteOAuthProvider(token, name string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminOauthProvidersProviderWithResponse(context.Background(), name, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "delete oauth provider")
+}
+
+func (h *E2EHelper) GetOAuthProviders(expectStatus int) *openapi.GetAuthOauthProvidersResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAuthOauthProvidersWithResponse(context.Background())
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get oauth providers")
+	return resp
+}
+
+func (h *E2EHelper) OAuthAuthorize(name string, expectStatus int) *openapi.GetAuthOauthProviderAuthorizeResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAuthOauthProviderAuthorizeWithResponse(context.Background(), name)
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "oauth authorize")
+	return resp
+}
+
+func (h *E2EHelper) OAuthCallback(name, code, state string, expectStatus int) *openapi.PostAuthOauthProviderCallbackResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAuthOauthProviderCallbackWithResponse(context.Background(), name, openapi.PostAuthOauthProviderCallbackJSONRequestBody{
+		Code:  code,
+		State: state,
+	})
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "oauth callback")
+	return resp
+}
+
+func (h *E2EHelper) GetUserIdentities(token string, expectStatus int) *openapi.GetUserIdentitiesResponse {
+	h.t.Helper()
+	resp, err := h.client.GetUserIdentitiesWithResponse(context.Background(), WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get user identities")
+	return resp
+}
+
+func (h *E2EHelper) StartIdentityLink(token, name string, expectStatus int) *openapi.PostUserIdentitiesProviderAuthorizeResponse {
+	h.t.Helper()
+	resp, err := h.client.PostUserIdentitiesProviderAuthorizeWithResponse(context.Background(), name, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "start identity link")
+	return resp
+}
+
+func (h *E2EHelper) LinkIdentity(token, name, code, state string, expectStatus int) *openapi.PostUserIdentitiesProviderLinkResponse {
+	h.t.Helper()
+	resp, err := h.client.PostUserIdentitiesProviderLinkWithResponse(context.Background(), name, openapi.PostUserIdentitiesProviderLinkJSONRequestBody{
+		Code:  code,
+		State: state,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "link identity")
+	return resp
+}
+
+func (h *E2EHelper) UnlinkIdentity(token, name string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteUserIdentitiesProviderWithResponse(context.Background(), name, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "unlink identity")
+}
+
+// NewOIDCIssuer starts a mock OIDC issuer that is closed when the test ends.
+func (h *E2EHelper) NewOIDCIssuer() *oidctest.Issuer {
+	h.t.Helper()
+	iss, err := oidctest.NewIssuer("ctfboard-e2e", "e2e-secret")
+	require.NoError(h.t, err)
+	h.t.Cleanup(iss.Close)
+	return iss
+}
+
+// CreateOIDCProvider registers iss as an enabled provider named name.
+func (h *E2EHelper) CreateOIDCProvider(adminToken, name string, iss *oidctest.Issuer, autoProvision bool) {
+	h.t.Helper()
+	displayName, enabled, issuer, secret := "University SSO", true, iss.URL, iss.ClientSecret
+	h.PutOAuthProvider(adminToken, name, openapi.PutAdminOauthProvidersProviderJSONRequestBody{
+		DisplayName:   &displayName,
+		Enabled:       &enabled,
+		Issuer:        &issuer,
+		ClientID:      iss.ClientID,
+		ClientSecret:  &secret,
+		RedirectURL:   OAuthRedirectURL,
+		AutoProvision: &autoProvision,
+	}, http.StatusOK)
+}
+
+// OAuthLogin runs authorize, consent at the issuer and the callback, returning the callback response.
+func (h *E2EHelper) OAuthLogin(name string, iss *oidctest.Issuer, expectStatus int) *openapi.PostAuthOauthProviderCallbackResponse {
+	h.t.Helper()
+	auth := h.OAuthAuthorize(name, http.StatusOK)
+	require.NotNil(h.t, auth.JSON200)
+	require.NotNil(h.t, auth.JSON200.AuthorizationURL)
+	code, state, err := iss.Authorize(*auth.JSON200.AuthorizationURL)
+	require.NoError(h.t, err)
+	return h.OAuthCallback(name, code, state, expectStatus)
+}
@@ -0,0 +1,51 @@
+package helper
+
+import (
+	"context"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) GetRegistrationStatus(expectStatus int) *openapi.GetAuthRegistrationResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAuthRegistrationWithResponse(context.Background())
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get registration status")
+	return resp
+}
+
+func (h *E2EHelper) RegisterWithInvite(username, email, password, inviteCode string, expectStatus int) *openapi.PostAuthRegisterResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAuthRegisterWithResponse(context.Background(), openapi.PostAuthRegisterJSONRequestBody{
+		Username:   &username,
+		Email:      &email,
+		Password:   &password,
+		InviteCode: &inviteCode,
+	})
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "register with invite")
+	return resp
+}
+
+func (h *E2EHelper) CreateRegistrationInvite(token, code string, maxUses, expectStatus int) *openapi.PostAdminRegistrationInvitesResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminRegistrationInvitesWithResponse(context.Background(), openapi.PostAdminRegistrationInvitesJSONRequestBody{
+		Code:    &code,
+		MaxUses: &maxUses,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "create registration invite")
+	return resp
+}
+
+func (h *E2EHelper) CreateRegistrationDomainRule(token, domain, action string, expectStatus int) *openapi.PostAdminRegistrationDomainsResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminRegistrationDomainsWithResponse(context.Background(), openapi.PostAdminRegistrationDomainsJSONRequestBody{
+		Domain: domain,
+		Action: openapi.RequestCreateRegistrationDomainRuleRequestAction(action),
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "create registration domain rule")
+	return resp
+}
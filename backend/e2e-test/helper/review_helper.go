@@ -0,0 +1,48 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) EnableManualReview(token, challengeID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.PutAdminChallengesChallengeIDManualReviewWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "enable manual review")
+}
+
+func (h *E2EHelper) GetPendingReviews(token string) []openapi.ResponseSubmissionReviewResponse {
+	h.t.Helper()
+	status := openapi.GetAdminReviewsParamsStatus("pending")
+	resp, err := h.client.GetAdminReviewsWithResponse(context.Background(), &openapi.GetAdminReviewsParams{Status: &status}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "get reviews")
+	require.NotNil(h.t, resp.JSON200)
+	require.NotNil(h.t, resp.JSON200.Items)
+	return *resp.JSON200.Items
+}
+
+func (h *E2EHelper) ApproveReview(token, reviewID string, points *int, comment string, expectStatus int) *openapi.ResponseSubmissionReviewResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminReviewsIDApproveWithResponse(context.Background(), reviewID, openapi.PostAdminReviewsIDApproveJSONRequestBody{
+		Points:  points,
+		Comment: &comment,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "approve review")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) RejectReview(token, reviewID, comment string, expectStatus int) *openapi.ResponseSubmissionReviewResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminReviewsIDRejectWithResponse(context.Background(), reviewID, openapi.PostAdminReviewsIDRejectJSONRequestBody{
+		Comment: &comment,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "reject review")
+	return resp.JSON200
+}
@@ -0,0 +1,37 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) GetChallengeRevisions(token, challengeID string) []openapi.ResponseChallengeRevisionResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengesChallengeIDRevisionsWithResponse(context.Background(), challengeID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "get challenge revisions")
+	require.NotNil(h.t, resp.JSON200)
+	return *resp.JSON200
+}
+
+func (h *E2EHelper) DiffChallengeRevisions(token, challengeID string, from, to, expectStatus int) *openapi.ResponseRevisionDiffResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminChallengesChallengeIDRevisionsDiffWithResponse(context.Background(), challengeID, &openapi.GetAdminChallengesChallengeIDRevisionsDiffParams{
+		From: from,
+		To:   to,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "diff challenge revisions")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) RollbackChallenge(token, challengeID string, version, expectStatus int) *openapi.ResponseChallengeRevisionResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminChallengesChallengeIDRevisionsVersionRollbackWithResponse(context.Background(), challengeID, version, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "rollback challenge")
+	return resp.JSON200
+}
@@ -0,0 +1,39 @@
+package helper
+
+import (
+	"context"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) GetUserSessions(token string, expectStatus int) *openapi.GetUserSessionsResponse {
+	h.t.Helper()
+	resp, err := h.client.GetUserSessionsWithResponse(context.Background(), WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get user sessions")
+	return resp
+}
+
+func (h *E2EHelper) DeleteUserSession(token, id string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteUserSessionsIDWithResponse(context.Background(), id, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "delete user session")
+}
+
+func (h *E2EHelper) RevokeUserSessions(token, userID string, expectStatus int) *openapi.DeleteAdminUsersIDSessionsResponse {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminUsersIDSessionsWithResponse(context.Background(), userID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "revoke user sessions")
+	return resp
+}
+
+func (h *E2EHelper) RevokeTeamSessions(token, teamID string, expectStatus int) *openapi.DeleteAdminTeamsIDSessionsResponse {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminTeamsIDSessionsWithResponse(context.Background(), teamID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "revoke team sessions")
+	return resp
+}
@@ -51,6 +51,9 @@ func (h *E2EHelper) PutAdminSettings(token string, body map[string]any, expectSt
 	if v, ok := body["resend_enabled"].(bool); ok {
 		req.ResendEnabled = &v
 	}
+	if v, ok := body["require_2fa_for_admins"].(bool); ok {
+		req.Require2FaForAdmins = &v
+	}
 	if v, ok := body["scoreboard_visible"].(string); ok {
 		req.ScoreboardVisible = (*openapi.RequestUpdateAppSettingsRequestScoreboardVisible)(&v)
 	}
@@ -0,0 +1,39 @@
+package helper
+
+import (
+	"context"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) GrantSolve(token, challengeID string, body openapi.PostAdminChallengesChallengeIDSolvesJSONRequestBody, expectStatus int) *openapi.ResponseSolveGrantResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminChallengesChallengeIDSolvesWithResponse(context.Background(), challengeID, body, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "grant solve")
+	return resp.JSON201
+}
+
+func (h *E2EHelper) RevokeSolve(token, challengeID, teamID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminChallengesChallengeIDSolvesTeamIDWithResponse(context.Background(), challengeID, teamID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "revoke solve")
+}
+
+func (h *E2EHelper) CheckScoringConsistency(token string, params *openapi.GetAdminScoringConsistencyParams, expectStatus int) *openapi.ResponseRescoreReportResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminScoringConsistencyWithResponse(context.Background(), params, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "check scoring consistency")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) Rescore(token string, body openapi.PostAdminScoringRescoreJSONRequestBody, expectStatus int) *openapi.ResponseRescoreReportResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminScoringRescoreWithResponse(context.Background(), body, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "rescore")
+	return resp.JSON200
+}
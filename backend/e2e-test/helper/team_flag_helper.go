@@ -0,0 +1,40 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) SetTeamFlag(token, challengeID, template string, expectStatus int) *openapi.ResponseTeamFlagResponse {
+	h.t.Helper()
+	resp, err := h.client.PutAdminChallengesChallengeIDTeamFlagWithResponse(context.Background(), challengeID, openapi.PutAdminChallengesChallengeIDTeamFlagJSONRequestBody{
+		Template: template,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "set team flag")
+	return resp.JSON200
+}
+
+func (h *E2EHelper) RenderTeamFlag(token, challengeID, teamID, text string) *openapi.ResponseTeamFlagRenderResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAdminChallengesChallengeIDTeamFlagRenderWithResponse(context.Background(), challengeID, openapi.PostAdminChallengesChallengeIDTeamFlagRenderJSONRequestBody{
+		TeamID: uuid.MustParse(teamID),
+		Text:   &text,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, http.StatusOK, resp.StatusCode(), resp.Body, "render team flag")
+	require.NotNil(h.t, resp.JSON200)
+	return resp.JSON200
+}
+
+func (h *E2EHelper) GetCheatIncidents(token string, expectStatus int) *openapi.GetAdminCheatIncidentsResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminCheatIncidentsWithResponse(context.Background(), &openapi.GetAdminCheatIncidentsParams{}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get cheat incidents")
+	return resp
+}
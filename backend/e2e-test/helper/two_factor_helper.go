@@ -0,0 +1,85 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/pkg/totp"
+	"github.com/stretchr/testify/require"
+)
+
+func (h *E2EHelper) GetUserTwoFactor(token string, expectStatus int) *openapi.GetUser2FaResponse {
+	h.t.Helper()
+	resp, err := h.client.GetUser2FaWithResponse(context.Background(), WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get user 2fa")
+	return resp
+}
+
+func (h *E2EHelper) SetupTwoFactor(token string, expectStatus int) *openapi.PostUser2FaSetupResponse {
+	h.t.Helper()
+	resp, err := h.client.PostUser2FaSetupWithResponse(context.Background(), WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "setup 2fa")
+	return resp
+}
+
+func (h *E2EHelper) EnableTwoFactor(token, code string, expectStatus int) *openapi.PostUser2FaEnableResponse {
+	h.t.Helper()
+	resp, err := h.client.PostUser2FaEnableWithResponse(context.Background(), openapi.PostUser2FaEnableJSONRequestBody{
+		Code: code,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "enable 2fa")
+	return resp
+}
+
+func (h *E2EHelper) DisableTwoFactor(token, code string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.PostUser2FaDisableWithResponse(context.Background(), openapi.PostUser2FaDisableJSONRequestBody{
+		Code: code,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "disable 2fa")
+}
+
+func (h *E2EHelper) LoginTwoFactor(challengeToken, code string, expectStatus int) *openapi.PostAuthLogin2FaResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAuthLogin2FaWithResponse(context.Background(), openapi.PostAuthLogin2FaJSONRequestBody{
+		ChallengeToken: challengeToken,
+		Code:           code,
+	})
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "login 2fa")
+	return resp
+}
+
+func (h *E2EHelper) ResetUserTwoFactor(token, userID string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminUsersID2FaWithResponse(context.Background(), userID, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "reset user 2fa")
+}
+
+// EnrollTwoFactor runs setup and enable for the user and returns the TOTP secret and recovery codes.
+func (h *E2EHelper) EnrollTwoFactor(token string) (secret string, recoveryCodes []string) {
+	h.t.Helper()
+	setup := h.SetupTwoFactor(token, http.StatusOK)
+	require.NotNil(h.t, setup.JSON200)
+	require.NotNil(h.t, setup.JSON200.Secret)
+	secret = *setup.JSON200.Secret
+
+	enable := h.EnableTwoFactor(token, TOTPCode(h.t, secret, time.Now()), http.StatusOK)
+	require.NotNil(h.t, enable.JSON200)
+	require.NotNil(h.t, enable.JSON200.RecoveryCodes)
+	return secret, *enable.JSON200.RecoveryCodes
+}
+
+// TOTPCode computes the code for the given secret at t.
+func TOTPCode(t require.TestingT, secret string, at time.Time) string {
+	code, err := totp.Code(secret, totp.Step(at))
+	require.NoError(t, err)
+	return code
+}
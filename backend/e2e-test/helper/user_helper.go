@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/skr1ms/CTFBoard/internal/entity"
 	"github.com/skr1ms/CTFBoard/internal/openapi"
 	"github.com/stretchr/testify/require"
 )
@@ -70,6 +71,25 @@ func (h *E2EHelper) Login(email, password string, expectStatus int) *openapi.Pos
 	return resp
 }
 
+func (h *E2EHelper) Refresh(refreshToken string, expectStatus int) *openapi.PostAuthRefreshResponse {
+	h.t.Helper()
+	resp, err := h.client.PostAuthRefreshWithResponse(context.Background(), openapi.PostAuthRefreshJSONRequestBody{
+		RefreshToken: refreshToken,
+	})
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "refresh")
+	return resp
+}
+
+func (h *E2EHelper) Logout(refreshToken string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.PostAuthLogoutWithResponse(context.Background(), openapi.PostAuthLogoutJSONRequestBody{
+		RefreshToken: refreshToken,
+	})
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "logout")
+}
+
 func (h *E2EHelper) ForgotPassword(email string, expectStatus int) {
 	h.t.Helper()
 	resp, err := h.client.PostAuthForgotPasswordWithResponse(context.Background(), openapi.PostAuthForgotPasswordJSONRequestBody{
@@ -140,6 +160,51 @@ func (h *E2EHelper) CreateUserToken(token, description string, expectStatus int)
 	return resp
 }
 
+func (h *E2EHelper) CreateScopedUserToken(token string, scopes []openapi.RequestCreateAPITokenRequestScopes, expectStatus int) *openapi.PostUserTokensResponse {
+	h.t.Helper()
+	resp, err := h.client.PostUserTokensWithResponse(context.Background(), openapi.PostUserTokensJSONRequestBody{
+		Scopes: &scopes,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "create scoped user token")
+	return resp
+}
+
+func (h *E2EHelper) GetUserTokenRequests(token, id string, expectStatus int) *openapi.GetUserTokensIDRequestsResponse {
+	h.t.Helper()
+	resp, err := h.client.GetUserTokensIDRequestsWithResponse(context.Background(), id, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get user token requests")
+	return resp
+}
+
+func (h *E2EHelper) CreateServiceToken(token, description string, scopes []openapi.RequestCreateServiceTokenRequestScopes, expectStatus int) *openapi.PostAdminTokensResponse {
+	h.t.Helper()
+	desc := description
+	resp, err := h.client.PostAdminTokensWithResponse(context.Background(), openapi.PostAdminTokensJSONRequestBody{
+		Description: &desc,
+		Scopes:      scopes,
+	}, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "create service token")
+	return resp
+}
+
+func (h *E2EHelper) GetServiceTokens(token string, expectStatus int) *openapi.GetAdminTokensResponse {
+	h.t.Helper()
+	resp, err := h.client.GetAdminTokensWithResponse(context.Background(), WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "get service tokens")
+	return resp
+}
+
+func (h *E2EHelper) DeleteServiceToken(token, id string, expectStatus int) {
+	h.t.Helper()
+	resp, err := h.client.DeleteAdminTokensIDWithResponse(context.Background(), id, WithBearerToken(token))
+	require.NoError(h.t, err)
+	RequireStatus(h.t, expectStatus, resp.StatusCode(), resp.Body, "delete service token")
+}
+
 func (h *E2EHelper) DeleteUserToken(token, id string, expectStatus int) *openapi.DeleteUserTokensIDResponse {
 	h.t.Helper()
 	resp, err := h.client.DeleteUserTokensIDWithResponse(context.Background(), id, WithBearerToken(token))
@@ -166,6 +231,12 @@ func (h *E2EHelper) RegisterUser(username string) (email, password string) {
 }
 
 func (h *E2EHelper) RegisterAdmin(username string) (email, password, token string) {
+	h.t.Helper()
+	return h.RegisterWithRole(username, entity.RoleAdmin)
+}
+
+// RegisterWithRole registers username, gives it role and returns a token issued with that role.
+func (h *E2EHelper) RegisterWithRole(username, role string) (email, password, token string) {
 	h.t.Helper()
 	email, password, token = h.RegisterUserAndLogin(username)
 	meResp := h.MeWithClient(context.Background(), h.client, token)
@@ -173,7 +244,7 @@ func (h *E2EHelper) RegisterAdmin(username string) (email, password, token strin
 	require.NotNil(h.t, meResp.JSON200)
 	require.NotNil(h.t, meResp.JSON200.ID)
 	userID := *meResp.JSON200.ID
-	_, err := h.pool.Exec(context.Background(), "UPDATE users SET role = 'admin' WHERE ID = $1", userID)
+	_, err := h.pool.Exec(context.Background(), "UPDATE users SET role = $1 WHERE ID = $2", role, userID)
 	require.NoError(h.t, err)
 	resp := h.Login(email, password, http.StatusOK)
 	require.NotNil(h.t, resp.JSON200)
@@ -0,0 +1,44 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/require"
+)
+
+// Challenge instances: a team starts its own environment, is held to one running instance,
+// extends it and stops it again.
+func TestInstance_Lifecycle(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_instance")
+	heapID := h.CreateBasicChallenge(tokenAdmin, "Heap", "flag{heap}", 300)
+	webID := h.CreateBasicChallenge(tokenAdmin, "Web", "flag{web}", 200)
+	h.SetInstanceConfig(tokenAdmin, heapID, "", 600, http.StatusBadRequest)
+	cfg := h.SetInstanceConfig(tokenAdmin, heapID, "pwn-heap:latest", 600, http.StatusOK)
+	require.Equal(t, 600, cfg.TTLSeconds)
+	h.SetInstanceConfig(tokenAdmin, webID, "web:latest", 600, http.StatusOK)
+
+	_, _, token := h.RegisterUserAndLogin("user_instance")
+	h.CreateTeam(token, "InstanceTeam", http.StatusCreated)
+
+	h.GetInstance(token, heapID, http.StatusNotFound)
+	started := h.StartInstance(token, heapID, http.StatusOK)
+	require.Equal(t, "running", string(started.Status))
+	require.NotEmpty(t, started.ConnectionInfo)
+
+	again := h.StartInstance(token, heapID, http.StatusOK)
+	require.Equal(t, started.ID, again.ID)
+	h.StartInstance(token, webID, http.StatusConflict)
+
+	extended := h.ExtendInstance(token, heapID)
+	require.False(t, extended.ExpiresAt.Before(started.ExpiresAt))
+
+	h.StopInstance(token, heapID, http.StatusNoContent)
+	h.GetInstance(token, heapID, http.StatusNotFound)
+	h.StartInstance(token, webID, http.StatusOK)
+}
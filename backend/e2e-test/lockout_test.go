@@ -0,0 +1,82 @@
+package e2e_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// POST /auth/login: the account is locked after repeated failures, even for the correct password.
+func TestLockout_Login_LocksAccount(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	email, password := h.RegisterUser("lock_user_" + suffix)
+
+	for range 5 {
+		h.Login(email, "wrong-password", http.StatusUnauthorized)
+	}
+	h.Login(email, password, http.StatusTooManyRequests)
+}
+
+// POST /auth/login: unknown emails are locked the same way as existing accounts.
+func TestLockout_Login_UnknownEmail(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	email := "ghost_" + uuid.New().String()[:8] + "@example.com"
+	for range 5 {
+		h.Login(email, "wrong-password", http.StatusUnauthorized)
+	}
+	h.Login(email, "wrong-password", http.StatusTooManyRequests)
+}
+
+// GET + DELETE /admin/users/{ID}/lockout: admin sees the lockout and lifts it.
+func TestLockout_AdminUnlock_Success(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("lock_admin_" + suffix)
+	email, password, userToken := h.RegisterUserAndLogin("lock_target_" + suffix)
+	me := helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), userToken))
+
+	for range 5 {
+		h.Login(email, "wrong-password", http.StatusUnauthorized)
+	}
+
+	resp := h.GetUserLockout(adminToken, *me.ID, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	require.NotNil(t, resp.JSON200.Locked)
+	assert.True(t, *resp.JSON200.Locked)
+	assert.NotNil(t, resp.JSON200.LockedUntil)
+
+	h.UnlockUser(adminToken, *me.ID, http.StatusNoContent)
+
+	resp = h.GetUserLockout(adminToken, *me.ID, http.StatusOK)
+	require.NotNil(t, resp.JSON200.Locked)
+	assert.False(t, *resp.JSON200.Locked)
+	h.Login(email, password, http.StatusOK)
+}
+
+// DELETE /admin/users/{ID}/lockout: non-admin gets 403.
+func TestLockout_AdminUnlock_Forbidden(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, userToken := h.RegisterUserAndLogin("lock_forbid_" + suffix)
+
+	h.UnlockUser(userToken, uuid.New().String(), http.StatusForbidden)
+}
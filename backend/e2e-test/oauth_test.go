@@ -0,0 +1,146 @@
+package e2e_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// PUT /admin/oauth/providers/{provider}: the secret is stored but never returned; the public list shows the provider.
+func TestOAuth_AdminProvider_CRUD(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("oauth_admin_" + suffix)
+	iss := h.NewOIDCIssuer()
+	h.CreateOIDCProvider(adminToken, "uni", iss, false)
+
+	list := h.GetAdminOAuthProviders(adminToken, http.StatusOK)
+	require.NotNil(t, list.JSON200)
+	require.Len(t, *list.JSON200, 1)
+	assert.True(t, *(*list.JSON200)[0].HasClientSecret)
+	assert.NotContains(t, string(list.Body), iss.ClientSecret)
+
+	public := h.GetOAuthProviders(http.StatusOK)
+	require.NotNil(t, public.JSON200)
+	require.Len(t, *public.JSON200, 1)
+	assert.Equal(t, "University SSO", *(*public.JSON200)[0].DisplayName)
+
+	h.DeleteOAuthProvider(adminToken, "uni", http.StatusNoContent)
+	h.DeleteOAuthProvider(adminToken, "uni", http.StatusNotFound)
+	h.OAuthAuthorize("uni", http.StatusNotFound)
+}
+
+// POST /auth/oauth/{provider}/callback: first login provisions an account, later logins reuse it.
+func TestOAuth_Login_AutoProvision(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("oauth_prov_" + suffix)
+	iss := h.NewOIDCIssuer()
+	h.CreateOIDCProvider(adminToken, "uni", iss, true)
+	iss.SetClaims(map[string]any{
+		"sub": "sso-" + suffix, "email": "sso_" + suffix + "@uni.edu", "email_verified": true,
+		"preferred_username": "sso_" + suffix,
+	})
+
+	resp := h.OAuthLogin("uni", iss, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	require.NotNil(t, resp.JSON200.AccessToken)
+	me := h.MeWithClient(ctx, h.Client(), *resp.JSON200.AccessToken)
+	helper.RequireMeOK(t, me)
+	assert.Equal(t, "sso_"+suffix, *me.JSON200.Username)
+
+	identities := h.GetUserIdentities(*resp.JSON200.AccessToken, http.StatusOK)
+	require.NotNil(t, identities.JSON200)
+	require.Len(t, *identities.JSON200, 1)
+	assert.Equal(t, "uni", *(*identities.JSON200)[0].Provider)
+
+	again := h.OAuthLogin("uni", iss, http.StatusOK)
+	require.NotNil(t, again.JSON200)
+	againMe := h.MeWithClient(ctx, h.Client(), *again.JSON200.AccessToken)
+	helper.RequireMeOK(t, againMe)
+	assert.Equal(t, *me.JSON200.ID, *againMe.JSON200.ID)
+
+	// The provisioned account has no password, so its only identity cannot be unlinked.
+	h.UnlinkIdentity(*resp.JSON200.AccessToken, "uni", http.StatusConflict)
+}
+
+// POST /auth/oauth/{provider}/callback: without auto-provisioning an unknown identity is refused.
+func TestOAuth_Login_NotLinked(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("oauth_nolink_" + suffix)
+	iss := h.NewOIDCIssuer()
+	h.CreateOIDCProvider(adminToken, "uni", iss, false)
+	iss.SetClaims(map[string]any{"sub": "stranger-" + suffix, "email": "stranger_" + suffix + "@uni.edu"})
+
+	h.OAuthLogin("uni", iss, http.StatusForbidden)
+}
+
+// POST /auth/oauth/{provider}/callback: state is single-use.
+func TestOAuth_Callback_StateReplay(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("oauth_replay_" + suffix)
+	iss := h.NewOIDCIssuer()
+	h.CreateOIDCProvider(adminToken, "uni", iss, true)
+	iss.SetClaims(map[string]any{"sub": "replay-" + suffix, "email": "replay_" + suffix + "@uni.edu"})
+
+	auth := h.OAuthAuthorize("uni", http.StatusOK)
+	require.NotNil(t, auth.JSON200)
+	code, state, err := iss.Authorize(*auth.JSON200.AuthorizationURL)
+	require.NoError(t, err)
+
+	h.OAuthCallback("uni", code, state, http.StatusOK)
+	h.OAuthCallback("uni", code, state, http.StatusBadRequest)
+	h.OAuthCallback("uni", code, "forged", http.StatusBadRequest)
+}
+
+// POST /user/identities/{provider}/link: a password user links an identity and can then log in with it.
+func TestOAuth_LinkAndUnlink(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("oauth_link_admin_" + suffix)
+	iss := h.NewOIDCIssuer()
+	h.CreateOIDCProvider(adminToken, "uni", iss, false)
+
+	_, _, token := h.RegisterUserAndLogin("oauth_link_" + suffix)
+	iss.SetClaims(map[string]any{"sub": "link-" + suffix, "email": "other_" + suffix + "@uni.edu"})
+
+	start := h.StartIdentityLink(token, "uni", http.StatusOK)
+	require.NotNil(t, start.JSON200)
+	code, state, err := iss.Authorize(*start.JSON200.AuthorizationURL)
+	require.NoError(t, err)
+	linked := h.LinkIdentity(token, "uni", code, state, http.StatusCreated)
+	require.NotNil(t, linked.JSON201)
+	assert.Equal(t, "uni", *linked.JSON201.Provider)
+
+	login := h.OAuthLogin("uni", iss, http.StatusOK)
+	require.NotNil(t, login.JSON200)
+	helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), *login.JSON200.AccessToken))
+
+	h.UnlinkIdentity(token, "uni", http.StatusNoContent)
+	h.UnlinkIdentity(token, "uni", http.StatusNotFound)
+	h.OAuthLogin("uni", iss, http.StatusForbidden)
+}
@@ -0,0 +1,66 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/require"
+)
+
+func closeRegistration(h *helper.E2EHelper, tokenAdmin string) {
+	h.PutAdminSettings(tokenAdmin, map[string]any{
+		"app_name":          "CTFBoard",
+		"frontend_url":      "http://localhost:3000",
+		"cors_origins":      "http://localhost:3000",
+		"resend_from_email": "noreply@test.local",
+		"resend_from_name":  "CTFBoard",
+		"registration_open": false,
+	}, http.StatusOK)
+}
+
+// POST /auth/register: closed registration admits only holders of an invite code, up to its max uses.
+func TestRegistration_ClosedRequiresInvite(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	_, tokenAdmin := h.SetupCompetition("admin_registration_invite")
+
+	closeRegistration(h, tokenAdmin)
+	status := h.GetRegistrationStatus(http.StatusOK)
+	require.NotNil(t, status.JSON200)
+	require.False(t, status.JSON200.Open)
+
+	h.RegisterExpectStatus("closed_user", "closed@example.com", "password123", http.StatusForbidden)
+
+	invite := h.CreateRegistrationInvite(tokenAdmin, "e2e-invite", 1, http.StatusCreated)
+	require.NotNil(t, invite.JSON201)
+	require.Equal(t, "e2e-invite", invite.JSON201.Code)
+
+	h.RegisterWithInvite("invited_user", "invited@example.com", "password123", "e2e-invite", http.StatusCreated)
+	h.RegisterWithInvite("second_user", "second@example.com", "password123", "e2e-invite", http.StatusForbidden)
+}
+
+// POST /auth/register: a deny rule blocks the domain and its subdomains.
+func TestRegistration_DeniedDomain(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	_, tokenAdmin := h.SetupCompetition("admin_registration_domain")
+
+	h.CreateRegistrationDomainRule(tokenAdmin, "blocked.example", "deny", http.StatusCreated)
+	h.CreateRegistrationDomainRule(tokenAdmin, "blocked.example", "deny", http.StatusConflict)
+
+	h.RegisterExpectStatus("blocked_user", "user@mail.blocked.example", "password123", http.StatusForbidden)
+	h.RegisterExpectStatus("allowed_user", "user@example.com", "password123", http.StatusCreated)
+}
+
+// POST /admin/registration/invites: non-admin gets 403.
+func TestRegistration_CreateInvite_Forbidden(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	_, _, tokenUser := h.RegisterUserAndLogin("registration_forbidden")
+
+	h.CreateRegistrationInvite(tokenUser, "nope", 1, http.StatusForbidden)
+}
@@ -0,0 +1,49 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/require"
+)
+
+// Manual grading: an answer is queued instead of checked, a rejection lets the team try again
+// and an approval with partial points solves the challenge for less than its value.
+func TestReview_ManualGrading(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_review")
+	challengeID := h.CreateBasicChallenge(tokenAdmin, "OSINT Report", "flag{unused}", 200)
+	h.EnableManualReview(tokenAdmin, challengeID, http.StatusOK)
+
+	_, _, token := h.RegisterUserAndLogin("user_review")
+	h.CreateTeam(token, "ReviewTeam", http.StatusCreated)
+
+	h.SubmitFlag(token, challengeID, "The photo was taken in Lisbon", http.StatusAccepted)
+	h.SubmitFlag(token, challengeID, "Or maybe Porto", http.StatusConflict)
+
+	pending := h.GetPendingReviews(tokenAdmin)
+	require.Len(t, pending, 1)
+	h.RejectReview(tokenAdmin, pending[0].ID, "Wrong city", http.StatusOK)
+	h.RejectReview(tokenAdmin, pending[0].ID, "", http.StatusConflict)
+
+	h.SubmitFlag(token, challengeID, "Porto, Ribeira district", http.StatusAccepted)
+	pending = h.GetPendingReviews(tokenAdmin)
+	require.Len(t, pending, 1)
+	tooMany := 500
+	h.ApproveReview(tokenAdmin, pending[0].ID, &tooMany, "", http.StatusBadRequest)
+	partial := 120
+	approved := h.ApproveReview(tokenAdmin, pending[0].ID, &partial, "Missing the street", http.StatusOK)
+	require.NotNil(t, approved.Points)
+	require.Equal(t, 120, *approved.Points)
+
+	h.AssertTeamScore("ReviewTeam", 120)
+	h.SubmitFlag(token, challengeID, "again", http.StatusConflict)
+
+	notifs := h.GetUserNotifications(token, 1, 20, http.StatusOK)
+	require.NotNil(t, notifs.JSON200)
+	require.Len(t, *notifs.JSON200, 2)
+}
@@ -0,0 +1,58 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/require"
+)
+
+// Revisions: every edit of a challenge or its hints is recorded, diffs never carry flag
+// values and a rollback restores the old flag, points and hints as a new revision.
+func TestRevision_HistoryDiffRollback(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_revision")
+	challengeID := h.CreateBasicChallenge(tokenAdmin, "Revisioned", "flag{original}", 300)
+	h.UpdateChallenge(tokenAdmin, challengeID, map[string]any{
+		"title":       "Revisioned",
+		"description": "Standard basic challenge",
+		"points":      50,
+		"flag":        "flag{typo}",
+	})
+	h.CreateHint(tokenAdmin, challengeID, "Read the source", 10)
+
+	revisions := h.GetChallengeRevisions(tokenAdmin, challengeID)
+	require.Len(t, revisions, 3)
+	require.Equal(t, 3, revisions[0].Version)
+	require.Equal(t, []string{"hints"}, revisions[0].ChangedFields)
+	require.Contains(t, revisions[1].ChangedFields, "points")
+	require.Contains(t, revisions[1].ChangedFields, "flag")
+
+	diff := h.DiffChallengeRevisions(tokenAdmin, challengeID, 1, 2, http.StatusOK)
+	require.NotNil(t, diff)
+	for _, change := range diff.Changes {
+		if change.Field == "flag" {
+			require.Nil(t, change.From)
+			require.Nil(t, change.To)
+		}
+	}
+	h.DiffChallengeRevisions(tokenAdmin, challengeID, 1, 9, http.StatusNotFound)
+
+	restored := h.RollbackChallenge(tokenAdmin, challengeID, 1, http.StatusOK)
+	require.NotNil(t, restored)
+	require.Equal(t, 4, restored.Version)
+	require.NotNil(t, restored.RestoredFrom)
+	require.Equal(t, 1, *restored.RestoredFrom)
+	require.Equal(t, 300, restored.Snapshot.Points)
+	require.Empty(t, restored.Snapshot.Hints)
+	h.RollbackChallenge(tokenAdmin, challengeID, 1, http.StatusConflict)
+
+	_, _, token := h.RegisterUserAndLogin("user_revision")
+	h.CreateTeam(token, "RevisionTeam", http.StatusCreated)
+	h.SubmitFlag(token, challengeID, "flag{typo}", http.StatusBadRequest)
+	h.SubmitFlag(token, challengeID, "flag{original}", http.StatusOK)
+}
@@ -0,0 +1,176 @@
+package e2e_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// GET /user/sessions: every login is listed and the calling session is marked current.
+func TestSession_List_Success(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	email, password, _ := h.RegisterUserAndLogin("sess_list_" + suffix)
+	token := "Bearer " + helper.RequireLoginOK(t, h.Login(email, password, http.StatusOK))
+
+	resp := h.GetUserSessions(token, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	require.Len(t, *resp.JSON200, 2)
+
+	current := 0
+	for _, s := range *resp.JSON200 {
+		require.NotNil(t, s.IP)
+		require.NotEmpty(t, *s.IP)
+		require.NotNil(t, s.LastSeenAt)
+		if s.Current != nil && *s.Current {
+			current++
+		}
+	}
+	assert.Equal(t, 1, current)
+}
+
+// GET /user/sessions: without auth returns 401.
+func TestSession_List_Unauthorized(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	h.GetUserSessions("", http.StatusUnauthorized)
+}
+
+// DELETE /user/sessions/{ID}: the revoked session's access token stops working immediately.
+func TestSession_Delete_RevokesAccessToken(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	email, password, firstToken := h.RegisterUserAndLogin("sess_del_" + suffix)
+	secondToken := "Bearer " + helper.RequireLoginOK(t, h.Login(email, password, http.StatusOK))
+
+	resp := h.GetUserSessions(secondToken, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	var firstID string
+	for _, s := range *resp.JSON200 {
+		if s.Current != nil && !*s.Current {
+			firstID = *s.ID
+		}
+	}
+	require.NotEmpty(t, firstID)
+
+	h.DeleteUserSession(secondToken, firstID, http.StatusNoContent)
+	helper.RequireMeUnauthorized(t, h.MeWithClient(ctx, h.Client(), firstToken))
+	helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), secondToken))
+}
+
+// DELETE /user/sessions/{ID}: another user's session returns 404.
+func TestSession_Delete_Foreign(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, ownerToken := h.RegisterUserAndLogin("sess_owner_" + suffix)
+	_, _, otherToken := h.RegisterUserAndLogin("sess_other_" + suffix)
+
+	resp := h.GetUserSessions(ownerToken, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	require.NotEmpty(t, *resp.JSON200)
+
+	h.DeleteUserSession(otherToken, *(*resp.JSON200)[0].ID, http.StatusNotFound)
+}
+
+// POST /auth/refresh: after rotation the previous access token is rejected.
+func TestSession_Refresh_InvalidatesOldAccessToken(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	email, password := h.RegisterUser("sess_rot_" + suffix)
+	login := h.Login(email, password, http.StatusOK)
+	require.NotNil(t, login.JSON200)
+
+	refreshed := h.Refresh(*login.JSON200.RefreshToken, http.StatusOK)
+	require.NotNil(t, refreshed.JSON200)
+
+	helper.RequireMeUnauthorized(t, h.MeWithClient(ctx, h.Client(), *login.JSON200.AccessToken))
+	helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), *refreshed.JSON200.AccessToken))
+}
+
+// DELETE /admin/users/{ID}/sessions: admin kills every session of a user.
+func TestSession_AdminRevokeUser_Success(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("sess_admin_" + suffix)
+	email, password, userToken := h.RegisterUserAndLogin("sess_target_" + suffix)
+	h.Login(email, password, http.StatusOK)
+	me := helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), userToken))
+
+	resp := h.RevokeUserSessions(adminToken, *me.ID, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	require.NotNil(t, resp.JSON200.Revoked)
+	assert.Equal(t, 2, *resp.JSON200.Revoked)
+
+	helper.RequireMeUnauthorized(t, h.MeWithClient(ctx, h.Client(), userToken))
+	helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), adminToken))
+}
+
+// DELETE /admin/users/{ID}/sessions: non-admin gets 403.
+func TestSession_AdminRevokeUser_Forbidden(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, userToken := h.RegisterUserAndLogin("sess_forbid_" + suffix)
+
+	h.RevokeUserSessions(userToken, uuid.New().String(), http.StatusForbidden)
+}
+
+// DELETE /admin/users/{ID}/sessions: unknown user returns 404.
+func TestSession_AdminRevokeUser_NotFound(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("sess_admin_nf_" + suffix)
+
+	h.RevokeUserSessions(adminToken, uuid.New().String(), http.StatusNotFound)
+}
+
+// DELETE /admin/teams/{ID}/sessions: admin kills the sessions of every team member.
+func TestSession_AdminRevokeTeam_Success(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("sess_admin_team_" + suffix)
+	_, _, captainToken := h.RegisterUserAndLogin("sess_captain_" + suffix)
+	h.CreateTeam(captainToken, "sess_team_"+suffix, http.StatusCreated)
+	teamID := helper.RequireMyTeamOK(t, h.GetMyTeam(captainToken, http.StatusOK))
+
+	resp := h.RevokeTeamSessions(adminToken, teamID, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	require.NotNil(t, resp.JSON200.Revoked)
+	assert.Equal(t, 1, *resp.JSON200.Revoked)
+
+	helper.RequireMeUnauthorized(t, h.MeWithClient(ctx, h.Client(), captainToken))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
 	wsV1 "github.com/skr1ms/CTFBoard/internal/controller/websocket/v1"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/instance"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/internal/repo/persistent"
 	"github.com/skr1ms/CTFBoard/internal/storage"
@@ -40,6 +41,7 @@ import (
 	"github.com/skr1ms/CTFBoard/pkg/jwt"
 	"github.com/skr1ms/CTFBoard/pkg/logger"
 	"github.com/skr1ms/CTFBoard/pkg/mailer"
+	"github.com/skr1ms/CTFBoard/pkg/oidc"
 	"github.com/skr1ms/CTFBoard/pkg/validator"
 	"github.com/skr1ms/CTFBoard/pkg/websocket"
 	"github.com/testcontainers/testcontainers-go"
@@ -128,10 +130,10 @@ func setupE2E(t *testing.T) {
 func truncateE2EDB(ctx context.Context, t *testing.T) {
 	t.Helper()
 	_, err := TestPool.Exec(ctx, `TRUNCATE TABLE
-		global_ratings, team_ratings, ctf_events, configs, comments, api_tokens,
+		registration_invites, registration_domain_rules, challenge_flags, challenge_prerequisites, challenge_unlock_scores, challenge_schedules, challenge_team_flags, cheat_incidents, challenge_instances, challenge_instance_configs, submission_reviews, challenge_manual_reviews, challenge_revisions, challenge_specs, challenge_stage_solves, challenge_stage_flags, challenge_stages, challenge_scoring, challenge_attempt_resets, challenge_attempt_limits, challenge_authors, roles, user_identities, user_recovery_codes, user_two_factor, user_sessions, global_ratings, team_ratings, ctf_events, configs, comments, api_token_requests, api_tokens,
 		field_values, fields, brackets, pages, user_notifications, notifications,
 		submissions, challenge_tags, tags, audit_logs, team_audit_log, app_settings,
-		files, verification_tokens, awards, hint_unlocks, hints, solves,
+		file_downloads, files, verification_tokens, awards, hint_unlocks, hints, solves,
 		challenges, teams, users, competition
 		RESTART IDENTITY CASCADE`)
 	if err != nil {
@@ -320,32 +322,53 @@ type testDeps struct {
 }
 
 type testRepos struct {
-	apiTokenRepo     *persistent.APITokenRepo
-	appSettingsRepo  *persistent.AppSettingsRepo
-	auditLogRepo     *persistent.AuditLogRepo
-	awardRepo        *persistent.AwardRepo
-	backupRepo       *persistent.BackupRepo
-	bracketRepo      *persistent.BracketRepo
-	challengeRepo    *persistent.ChallengeRepo
-	commentRepo      *persistent.CommentRepo
-	compRepo         *persistent.CompetitionRepo
-	configRepo       *persistent.ConfigRepo
-	fieldRepo        *persistent.FieldRepo
-	fieldValueRepo   *persistent.FieldValueRepo
-	fileRepo         *persistent.FileRepository
-	hintRepo         *persistent.HintRepo
-	hintUnlockRepo   *persistent.HintUnlockRepo
-	notificationRepo *persistent.NotificationRepo
-	pageRepo         *persistent.PageRepo
-	ratingRepo       *persistent.RatingRepo
-	solveRepo        *persistent.SolveRepo
-	statsRepo        *persistent.StatisticsRepository
-	submissionRepo   *persistent.SubmissionRepo
-	tagRepo          *persistent.TagRepo
-	teamRepo         *persistent.TeamRepo
-	tokenRepo        *persistent.VerificationTokenRepo
-	txRepo           *persistent.TxRepo
-	userRepo         *persistent.UserRepo
+	apiTokenRepo        *persistent.APITokenRepo
+	appSettingsRepo     *persistent.AppSettingsRepo
+	auditLogRepo        *persistent.AuditLogRepo
+	awardRepo           *persistent.AwardRepo
+	backupRepo          *persistent.BackupRepo
+	bracketRepo         *persistent.BracketRepo
+	challengeRepo       *persistent.ChallengeRepo
+	challengeFlagRepo   *persistent.ChallengeFlagRepo
+	requirementRepo     *persistent.ChallengeRequirementRepo
+	scheduleRepo        *persistent.ChallengeScheduleRepo
+	teamFlagRepo        *persistent.TeamFlagRepo
+	cheatRepo           *persistent.CheatIncidentRepo
+	decoyRepo           *persistent.DecoyFlagRepo
+	cheatAnalyticsRepo  *persistent.CheatAnalyticsRepo
+	instanceRepo        *persistent.InstanceRepo
+	reviewRepo          *persistent.ReviewRepo
+	revisionRepo        *persistent.RevisionRepo
+	specRepo            *persistent.ChallengeSpecRepo
+	stageRepo           *persistent.ChallengeStageRepo
+	scoringRepo         *persistent.ChallengeScoringRepo
+	attemptRepo         *persistent.AttemptLimitRepo
+	rescoreRepo         *persistent.RescoreRepo
+	commentRepo         *persistent.CommentRepo
+	compRepo            *persistent.CompetitionRepo
+	configRepo          *persistent.ConfigRepo
+	fieldRepo           *persistent.FieldRepo
+	fieldValueRepo      *persistent.FieldValueRepo
+	fileRepo            *persistent.FileRepository
+	hintRepo            *persistent.HintRepo
+	hintUnlockRepo      *persistent.HintUnlockRepo
+	notificationRepo    *persistent.NotificationRepo
+	pageRepo            *persistent.PageRepo
+	ratingRepo          *persistent.RatingRepo
+	registrationRepo    *persistent.RegistrationRepo
+	sessionRepo         *persistent.SessionRepo
+	solveRepo           *persistent.SolveRepo
+	statsRepo           *persistent.StatisticsRepository
+	submissionRepo      *persistent.SubmissionRepo
+	tagRepo             *persistent.TagRepo
+	teamRepo            *persistent.TeamRepo
+	tokenRepo           *persistent.VerificationTokenRepo
+	twoFactorRepo       *persistent.TwoFactorRepo
+	txRepo              *persistent.TxRepo
+	identityRepo        *persistent.UserIdentityRepo
+	roleRepo            *persistent.RoleRepo
+	challengeAuthorRepo *persistent.ChallengeAuthorRepo
+	userRepo            *persistent.UserRepo
 }
 
 type testUseCases struct {
@@ -363,6 +386,7 @@ type testUseCases struct {
 	settings        *settings.SettingsUseCase
 	ws              *wsV1.Controller
 	submissionUC    *competition.SubmissionUseCase
+	antiCheatUC     *competition.AntiCheatUseCase
 	tagUC           *challenge.TagUseCase
 	fieldUC         *settings.FieldUseCase
 	pageUC          *page.PageUseCase
@@ -370,8 +394,19 @@ type testUseCases struct {
 	ratingUC        *competition.RatingUseCase
 	notifUC         usecase.NotificationUseCase
 	apiTokenUC      usecase.APITokenUseCase
+	sessionUC       *user.SessionUseCase
+	twoFactorUC     *user.TwoFactorUseCase
+	oauthUC         *user.OAuthUseCase
+	lockoutUC       *user.LockoutUseCase
+	roleUC          *user.RoleUseCase
+	adminUserUC     *user.AdminUserUseCase
+	accountUC       *user.AccountUseCase
+	registrationUC  *user.RegistrationUseCase
 	dynamicConfigUC *competition.DynamicConfigUseCase
 	commentUC       *challenge.CommentUseCase
+	instanceUC      *challenge.InstanceUseCase
+	revisionUC      *challenge.RevisionUseCase
+	specUC          *challenge.SpecUseCase
 }
 
 func startTestServer() (func(), error) {
@@ -443,32 +478,53 @@ func initTestDeps() (*testDeps, error) {
 
 func initTestRepos() *testRepos {
 	return &testRepos{
-		userRepo:         persistent.NewUserRepo(TestPool),
-		challengeRepo:    persistent.NewChallengeRepo(TestPool),
-		solveRepo:        persistent.NewSolveRepo(TestPool),
-		teamRepo:         persistent.NewTeamRepo(TestPool),
-		compRepo:         persistent.NewCompetitionRepo(TestPool),
-		hintRepo:         persistent.NewHintRepo(TestPool),
-		hintUnlockRepo:   persistent.NewHintUnlockRepo(TestPool),
-		awardRepo:        persistent.NewAwardRepo(TestPool),
-		txRepo:           persistent.NewTxRepo(TestPool),
-		tokenRepo:        persistent.NewVerificationTokenRepo(TestPool),
-		auditLogRepo:     persistent.NewAuditLogRepo(TestPool),
-		statsRepo:        persistent.NewStatisticsRepository(TestPool),
-		fileRepo:         persistent.NewFileRepository(TestPool),
-		backupRepo:       persistent.NewBackupRepo(TestPool),
-		appSettingsRepo:  persistent.NewAppSettingsRepo(TestPool),
-		tagRepo:          persistent.NewTagRepo(TestPool),
-		fieldRepo:        persistent.NewFieldRepo(TestPool),
-		fieldValueRepo:   persistent.NewFieldValueRepo(TestPool),
-		submissionRepo:   persistent.NewSubmissionRepo(TestPool),
-		pageRepo:         persistent.NewPageRepo(TestPool),
-		bracketRepo:      persistent.NewBracketRepo(TestPool),
-		ratingRepo:       persistent.NewRatingRepo(TestPool),
-		notificationRepo: persistent.NewNotificationRepo(TestPool),
-		apiTokenRepo:     persistent.NewAPITokenRepo(TestPool),
-		configRepo:       persistent.NewConfigRepo(TestPool),
-		commentRepo:      persistent.NewCommentRepo(TestPool),
+		userRepo:            persistent.NewUserRepo(TestPool),
+		challengeRepo:       persistent.NewChallengeRepo(TestPool),
+		solveRepo:           persistent.NewSolveRepo(TestPool),
+		teamRepo:            persistent.NewTeamRepo(TestPool),
+		compRepo:            persistent.NewCompetitionRepo(TestPool),
+		hintRepo:            persistent.NewHintRepo(TestPool),
+		hintUnlockRepo:      persistent.NewHintUnlockRepo(TestPool),
+		awardRepo:           persistent.NewAwardRepo(TestPool),
+		txRepo:              persistent.NewTxRepo(TestPool),
+		tokenRepo:           persistent.NewVerificationTokenRepo(TestPool),
+		auditLogRepo:        persistent.NewAuditLogRepo(TestPool),
+		statsRepo:           persistent.NewStatisticsRepository(TestPool),
+		fileRepo:            persistent.NewFileRepository(TestPool),
+		backupRepo:          persistent.NewBackupRepo(TestPool),
+		appSettingsRepo:     persistent.NewAppSettingsRepo(TestPool),
+		tagRepo:             persistent.NewTagRepo(TestPool),
+		fieldRepo:           persistent.NewFieldRepo(TestPool),
+		fieldValueRepo:      persistent.NewFieldValueRepo(TestPool),
+		submissionRepo:      persistent.NewSubmissionRepo(TestPool),
+		pageRepo:            persistent.NewPageRepo(TestPool),
+		bracketRepo:         persistent.NewBracketRepo(TestPool),
+		ratingRepo:          persistent.NewRatingRepo(TestPool),
+		notificationRepo:    persistent.NewNotificationRepo(TestPool),
+		apiTokenRepo:        persistent.NewAPITokenRepo(TestPool),
+		configRepo:          persistent.NewConfigRepo(TestPool),
+		commentRepo:         persistent.NewCommentRepo(TestPool),
+		sessionRepo:         persistent.NewSessionRepo(TestPool),
+		twoFactorRepo:       persistent.NewTwoFactorRepo(TestPool),
+		identityRepo:        persistent.NewUserIdentityRepo(TestPool),
+		roleRepo:            persistent.NewRoleRepo(TestPool),
+		challengeAuthorRepo: persistent.NewChallengeAuthorRepo(TestPool),
+		registrationRepo:    persistent.NewRegistrationRepo(TestPool),
+		challengeFlagRepo:   persistent.NewChallengeFlagRepo(TestPool),
+		requirementRepo:     persistent.NewChallengeRequirementRepo(TestPool),
+		scheduleRepo:        persistent.NewChallengeScheduleRepo(TestPool),
+		teamFlagRepo:        persistent.NewTeamFlagRepo(TestPool),
+		cheatRepo:           persistent.NewCheatIncidentRepo(TestPool),
+		decoyRepo:           persistent.NewDecoyFlagRepo(TestPool),
+		cheatAnalyticsRepo:  persistent.NewCheatAnalyticsRepo(TestPool),
+		instanceRepo:        persistent.NewInstanceRepo(TestPool),
+		reviewRepo:          persistent.NewReviewRepo(TestPool),
+		revisionRepo:        persistent.NewRevisionRepo(TestPool),
+		specRepo:            persistent.NewChallengeSpecRepo(TestPool),
+		stageRepo:           persistent.NewChallengeStageRepo(TestPool),
+		scoringRepo:         persistent.NewChallengeScoringRepo(TestPool),
+		attemptRepo:         persistent.NewAttemptLimitRepo(TestPool),
+		rescoreRepo:         persistent.NewRescoreRepo(TestPool),
 	}
 }
 
@@ -491,9 +547,13 @@ func initTestStorageAndHub() (string, storage.Provider, *websocket.Hub, error) {
 func buildTestUseCases(deps *testDeps, repos *testRepos, fileStorage storage.Provider, hub *websocket.Hub) *testUseCases {
 	fieldValidator := settings.NewFieldValidator(repos.fieldRepo)
 	broadcaster := websocket.NewBroadcaster(hub)
+	lockoutUC := user.NewLockoutUseCase(TestRedis, repos.userRepo, repos.auditLogRepo, user.DefaultLockoutPolicy())
+	registrationUC := user.NewRegistrationUseCase(repos.appSettingsRepo, repos.registrationRepo, repos.bracketRepo, repos.auditLogRepo)
 	userUC := user.NewUserUseCase(user.UserDeps{
 		UserRepo: repos.userRepo, TeamRepo: repos.teamRepo, SolveRepo: repos.solveRepo, TxRepo: repos.txRepo,
 		JWTService: deps.jwt, FieldValidator: fieldValidator, FieldValueRepo: repos.fieldValueRepo,
+		SessionRepo: repos.sessionRepo, TwoFactorRepo: repos.twoFactorRepo, Crypto: deps.crypto, Lockout: lockoutUC,
+		Registration: registrationUC,
 	})
 	compUC := competition.NewCompetitionUseCase(repos.compRepo, repos.auditLogRepo, TestRedis)
 	testCache := cache.New(TestRedis)
@@ -510,16 +570,31 @@ func buildTestUseCases(deps *testDeps, repos *testRepos, fileStorage storage.Pro
 		challenge.WithBroadcaster(broadcaster),
 		challenge.WithAuditLogRepo(repos.auditLogRepo),
 		challenge.WithCrypto(deps.crypto),
+		challenge.WithFlagRepo(repos.challengeFlagRepo),
+		challenge.WithRequirementRepo(repos.requirementRepo),
+		challenge.WithScheduleRepo(repos.scheduleRepo),
+		challenge.WithNotificationRepo(repos.notificationRepo),
+		challenge.WithTeamFlagRepo(repos.teamFlagRepo),
+		challenge.WithCheatIncidentRepo(repos.cheatRepo),
+		challenge.WithDecoyRepo(repos.decoyRepo),
+		challenge.WithConfigRepo(repos.configRepo),
+		challenge.WithAdminBroadcaster(broadcaster),
+		challenge.WithReviewRepo(repos.reviewRepo),
+		challenge.WithStageRepo(repos.stageRepo),
+		challenge.WithScoringRepo(repos.scoringRepo),
+		challenge.WithAttemptLimitRepo(repos.attemptRepo),
+		challenge.WithSubmissionRepo(repos.submissionRepo),
+		challenge.WithAwardRepo(repos.awardRepo),
 	)
 	solveUC := competition.NewSolveUseCase(competition.SolveDeps{
 		SolveRepo: repos.solveRepo, ChallengeRepo: repos.challengeRepo, CompetitionRepo: repos.compRepo,
-		UserRepo: repos.userRepo, TeamRepo: repos.teamRepo, TxRepo: repos.txRepo,
-		Cache: testCache, ScoreboardCache: scoreboardCache, Broadcaster: broadcaster,
+		UserRepo: repos.userRepo, TeamRepo: repos.teamRepo, TxRepo: repos.txRepo, ScoringRepo: repos.scoringRepo,
+		RescoreRepo: repos.rescoreRepo, Cache: testCache, ScoreboardCache: scoreboardCache, Broadcaster: broadcaster,
 	})
-	teamUC := team.NewTeamUseCase(repos.teamRepo, repos.userRepo, repos.compRepo, repos.txRepo, scoreboardCache)
+	teamUC := team.NewTeamUseCase(repos.teamRepo, repos.userRepo, repos.compRepo, repos.txRepo, scoreboardCache, registrationUC)
 	hintUC := challenge.NewHintUseCase(challenge.HintDeps{
 		HintRepo: repos.hintRepo, HintUnlockRepo: repos.hintUnlockRepo, AwardRepo: repos.awardRepo,
-		TxRepo: repos.txRepo, SolveRepo: repos.solveRepo, ScoreboardCache: scoreboardCache,
+		TxRepo: repos.txRepo, SolveRepo: repos.solveRepo, ScoreboardCache: scoreboardCache, Access: challengeUC,
 	})
 	awardUC := team.NewAwardUseCase(repos.awardRepo, repos.txRepo, scoreboardCache)
 	emailUC := email.NewEmailUseCase(email.EmailDeps{
@@ -527,31 +602,60 @@ func buildTestUseCases(deps *testDeps, repos *testRepos, fileStorage storage.Pro
 		VerifyTTL: 24 * time.Hour, ResetTTL: 1 * time.Hour, FrontendURL: "http://localhost:3000", Enabled: true,
 	})
 	statsUC := competition.NewStatisticsUseCase(repos.statsRepo, testCache)
-	submissionUC := competition.NewSubmissionUseCase(repos.submissionRepo)
+	submissionUC := competition.NewSubmissionUseCase(repos.submissionRepo, repos.cheatRepo)
+	antiCheatUC := competition.NewAntiCheatUseCase(competition.AntiCheatDeps{
+		CheatRepo: repos.cheatRepo, AnalyticsRepo: repos.cheatAnalyticsRepo, SubmissionRepo: repos.submissionRepo, Teams: teamUC,
+	})
 	tagUC := challenge.NewTagUseCase(repos.tagRepo)
 	fieldUC := settings.NewFieldUseCase(repos.fieldRepo)
 	pageUC := page.NewPageUseCase(repos.pageRepo)
 	bracketUC := competition.NewBracketUseCase(repos.bracketRepo)
 	ratingUC := competition.NewRatingUseCase(repos.ratingRepo, repos.solveRepo, repos.teamRepo)
 	notifUC := notification.NewNotificationUseCase(repos.notificationRepo)
-	apiTokenUC := user.NewAPITokenUseCase(repos.apiTokenRepo)
+	apiTokenUC := user.NewAPITokenUseCase(repos.apiTokenRepo, repos.userRepo, repos.auditLogRepo)
+	sessionUC := user.NewSessionUseCase(repos.sessionRepo, repos.userRepo, repos.teamRepo, repos.auditLogRepo)
+	twoFactorUC := user.NewTwoFactorUseCase(repos.twoFactorRepo, repos.userRepo, repos.appSettingsRepo, repos.auditLogRepo, deps.crypto)
+	oauthUC := user.NewOAuthUseCase(user.OAuthDeps{
+		ConfigRepo: repos.configRepo, IdentityRepo: repos.identityRepo, UserRepo: repos.userRepo, TxRepo: repos.txRepo,
+		FieldValueRepo: repos.fieldValueRepo, AuditLogRepo: repos.auditLogRepo, Crypto: deps.crypto,
+		Redis: TestRedis, OIDC: oidc.NewClient(nil), Users: userUC,
+	})
 	backupUC := competition.NewBackupUseCase(competition.BackupDeps{
 		CompetitionRepo: repos.compRepo, ChallengeRepo: repos.challengeRepo, HintRepo: repos.hintRepo,
-		TeamRepo: repos.teamRepo, UserRepo: repos.userRepo, AwardRepo: repos.awardRepo,
+		FlagRepo: repos.challengeFlagRepo, DecoyRepo: repos.decoyRepo, RequirementRepo: repos.requirementRepo, ScheduleRepo: repos.scheduleRepo, TeamFlagRepo: repos.teamFlagRepo, InstanceRepo: repos.instanceRepo, ReviewRepo: repos.reviewRepo, StageRepo: repos.stageRepo, ScoringRepo: repos.scoringRepo, AttemptRepo: repos.attemptRepo, TeamRepo: repos.teamRepo, UserRepo: repos.userRepo, AwardRepo: repos.awardRepo,
 		SolveRepo: repos.solveRepo, FileRepo: repos.fileRepo, BackupRepo: repos.backupRepo,
 		Storage: fileStorage, TxRepo: repos.txRepo, Logger: deps.logger,
 	})
 	settingsUC := settings.NewSettingsUseCase(repos.appSettingsRepo, repos.auditLogRepo, TestRedis)
 	dynamicConfigUC := competition.NewDynamicConfigUseCase(repos.configRepo, repos.auditLogRepo)
 	commentUC := challenge.NewCommentUseCase(repos.commentRepo, repos.challengeRepo)
+	roleUC := user.NewRoleUseCase(repos.roleRepo, repos.userRepo, repos.challengeAuthorRepo, repos.txRepo)
+	adminUserUC := user.NewAdminUserUseCase(user.AdminUserDeps{
+		UserRepo: repos.userRepo, TxRepo: repos.txRepo, SessionRepo: repos.sessionRepo, ScoreboardCache: scoreboardCache,
+	})
+	accountUC := user.NewAccountUseCase(user.AccountDeps{
+		UserRepo: repos.userRepo, TxRepo: repos.txRepo, SessionRepo: repos.sessionRepo, Email: emailUC, ScoreboardCache: scoreboardCache,
+	})
 	ws := wsV1.NewController(hub, deps.logger, []string{"*"})
-	fileUC := challenge.NewFileUseCase(repos.fileRepo, fileStorage, 1*time.Hour)
+	fileUC := challenge.NewFileUseCase(repos.fileRepo, fileStorage, 1*time.Hour, challengeUC)
+	instanceUC := challenge.NewInstanceUseCase(repos.challengeRepo, repos.instanceRepo, instance.NewFakeProvider("localhost"), challengeUC, 1, deps.logger)
+	revisionUC := challenge.NewRevisionUseCase(challenge.RevisionDeps{
+		ChallengeRepo: repos.challengeRepo, TagRepo: repos.tagRepo, HintRepo: repos.hintRepo,
+		RevisionRepo: repos.revisionRepo, TxRepo: repos.txRepo, ScoreboardCache: scoreboardCache,
+	})
+	specUC := challenge.NewSpecUseCase(challenge.SpecDeps{
+		SpecRepo: repos.specRepo, ChallengeRepo: repos.challengeRepo, TagRepo: repos.tagRepo, HintRepo: repos.hintRepo,
+		FlagRepo: repos.challengeFlagRepo, RequirementRepo: repos.requirementRepo, FileRepo: repos.fileRepo, Crypto: deps.crypto,
+		Challenges: challengeUC, Files: fileUC, Revisions: revisionUC, ScoreboardCache: scoreboardCache,
+	})
 	return &testUseCases{
 		user: userUC, challenge: challengeUC, solve: solveUC, team: teamUC, competition: compUC,
 		hint: hintUC, award: awardUC, email: emailUC, file: fileUC, stats: statsUC, backup: backupUC,
-		settings: settingsUC, ws: ws, submissionUC: submissionUC, tagUC: tagUC, fieldUC: fieldUC,
+		settings: settingsUC, ws: ws, submissionUC: submissionUC, antiCheatUC: antiCheatUC, tagUC: tagUC, fieldUC: fieldUC,
 		pageUC: pageUC, bracketUC: bracketUC, ratingUC: ratingUC, notifUC: notifUC, apiTokenUC: apiTokenUC,
-		dynamicConfigUC: dynamicConfigUC, commentUC: commentUC,
+		sessionUC: sessionUC, twoFactorUC: twoFactorUC, oauthUC: oauthUC, lockoutUC: lockoutUC,
+		roleUC: roleUC, adminUserUC: adminUserUC, accountUC: accountUC, registrationUC: registrationUC, dynamicConfigUC: dynamicConfigUC, commentUC: commentUC,
+		instanceUC: instanceUC, revisionUC: revisionUC, specUC: specUC,
 	}
 }
 
@@ -578,11 +682,11 @@ func setupTestRouter(l logger.Logger, uc *testUseCases, validatorService validat
 
 	deps := &helper.ServerDeps{
 		Challenge: helper.ChallengeDeps{
-			ChallengeUC: uc.challenge, HintUC: uc.hint, FileUC: uc.file, TagUC: uc.tagUC, CommentUC: uc.commentUC,
+			ChallengeUC: uc.challenge, HintUC: uc.hint, FileUC: uc.file, TagUC: uc.tagUC, CommentUC: uc.commentUC, InstanceUC: uc.instanceUC, RevisionUC: uc.revisionUC, SpecUC: uc.specUC,
 		},
 		Team:  helper.TeamDeps{TeamUC: uc.team, AwardUC: uc.award},
-		User:  helper.UserDeps{UserUC: uc.user, EmailUC: uc.email, APITokenUC: uc.apiTokenUC},
-		Comp:  helper.CompetitionDeps{CompetitionUC: uc.competition, SolveUC: uc.solve, StatsUC: uc.stats, SubmissionUC: uc.submissionUC, BracketUC: uc.bracketUC, RatingUC: uc.ratingUC},
+		User:  helper.UserDeps{UserUC: uc.user, EmailUC: uc.email, APITokenUC: uc.apiTokenUC, SessionUC: uc.sessionUC, TwoFactorUC: uc.twoFactorUC, OAuthUC: uc.oauthUC, LockoutUC: uc.lockoutUC, RoleUC: uc.roleUC, AdminUserUC: uc.adminUserUC, AccountUC: uc.accountUC, RegistrationUC: uc.registrationUC},
+		Comp:  helper.CompetitionDeps{CompetitionUC: uc.competition, SolveUC: uc.solve, StatsUC: uc.stats, SubmissionUC: uc.submissionUC, AntiCheatUC: uc.antiCheatUC, BracketUC: uc.bracketUC, RatingUC: uc.ratingUC},
 		Admin: helper.AdminDeps{BackupUC: uc.backup, SettingsUC: uc.settings, DynamicConfigUC: uc.dynamicConfigUC, FieldUC: uc.fieldUC, PageUC: uc.pageUC, NotifUC: uc.notifUC},
 		Infra: helper.InfraDeps{JWTService: jwtService, RedisClient: TestRedis, WSController: uc.ws, Validator: validatorService, Logger: l},
 	}
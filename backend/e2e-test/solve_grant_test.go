@@ -0,0 +1,122 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+// Solve grants: an admin credits a team hit by a checker bug and the solve counts like a
+// submitted flag on the scoreboard and for first blood.
+func TestSolveGrant_CreditsTeam(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_solve_grant")
+	freeID := h.CreateBasicChallenge(tokenAdmin, "Grant Warmup", "flag{warmup}", 50)
+	challID := h.CreateBasicChallenge(tokenAdmin, "Broken Checker", "flag{broken}", 200)
+
+	_, _, tokenUser := h.RegisterUserAndLogin("user_solve_grant")
+	h.CreateTeam(tokenUser, "GrantedTeam", http.StatusCreated)
+	teamID := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenUser, http.StatusOK))
+	h.SubmitFlag(tokenUser, freeID, "flag{warmup}", http.StatusOK)
+
+	h.GrantSolve(tokenUser, challID, openapi.PostAdminChallengesChallengeIDSolvesJSONRequestBody{TeamID: teamID}, http.StatusForbidden)
+	h.GrantSolve(tokenAdmin, challID, openapi.PostAdminChallengesChallengeIDSolvesJSONRequestBody{TeamID: "not-a-uuid"}, http.StatusBadRequest)
+
+	granted := h.GrantSolve(tokenAdmin, challID, openapi.PostAdminChallengesChallengeIDSolvesJSONRequestBody{TeamID: teamID}, http.StatusCreated)
+	require.NotNil(t, granted)
+	require.True(t, granted.FirstBlood)
+	require.Equal(t, teamID, granted.TeamID)
+	h.AssertTeamScore("GrantedTeam", 250)
+	h.AssertFirstBlood(challID, "user_solve_grant", "GrantedTeam")
+
+	h.GrantSolve(tokenAdmin, challID, openapi.PostAdminChallengesChallengeIDSolvesJSONRequestBody{TeamID: teamID}, http.StatusConflict)
+	h.SubmitFlag(tokenUser, challID, "flag{broken}", http.StatusConflict)
+}
+
+// Solve revocation: taking a solve away from a caught team lowers its score and hands first
+// blood to the next solver.
+func TestSolveGrant_RevokePassesFirstBlood(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_solve_revoke")
+	freeID := h.CreateBasicChallenge(tokenAdmin, "Revoke Warmup", "flag{warmup}", 50)
+	challID := h.CreateBasicChallenge(tokenAdmin, "Shared Flag", "flag{shared}", 300)
+
+	_, _, tokenCheater := h.RegisterUserAndLogin("user_solve_cheater")
+	h.CreateTeam(tokenCheater, "CheaterTeam", http.StatusCreated)
+	cheaterTeamID := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenCheater, http.StatusOK))
+	_, _, tokenHonest := h.RegisterUserAndLogin("user_solve_honest")
+	h.CreateTeam(tokenHonest, "HonestTeam", http.StatusCreated)
+
+	h.SubmitFlag(tokenCheater, freeID, "flag{warmup}", http.StatusOK)
+	h.SubmitFlag(tokenCheater, challID, "flag{shared}", http.StatusOK)
+	h.SubmitFlag(tokenHonest, challID, "flag{shared}", http.StatusOK)
+	h.AssertFirstBlood(challID, "user_solve_cheater", "CheaterTeam")
+	h.AssertTeamScore("CheaterTeam", 350)
+
+	h.RevokeSolve(tokenCheater, challID, cheaterTeamID, http.StatusForbidden)
+	h.RevokeSolve(tokenAdmin, challID, cheaterTeamID, http.StatusNoContent)
+	h.RevokeSolve(tokenAdmin, challID, cheaterTeamID, http.StatusNotFound)
+
+	h.AssertTeamScore("CheaterTeam", 50)
+	h.AssertTeamScore("HonestTeam", 300)
+	h.AssertFirstBlood(challID, "user_solve_honest", "HonestTeam")
+	listed := h.FindChallengeInList(tokenHonest, challID)
+	require.NotNil(t, listed.SolveCount)
+	require.Equal(t, 1, *listed.SolveCount)
+}
+
+// Rescoring: once a team is banned its solves can be dropped from the challenge counts, first
+// reported by the consistency check and then written back by a rescore.
+func TestSolveGrant_RescoreExcludesBannedTeams(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_rescore")
+	challID := h.CreateBasicChallenge(tokenAdmin, "Rescored", "flag{rescored}", 300)
+
+	_, _, tokenBanned := h.RegisterUserAndLogin("user_rescore_banned")
+	h.CreateTeam(tokenBanned, "RescoreBannedTeam", http.StatusCreated)
+	bannedTeamID := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenBanned, http.StatusOK))
+	_, _, tokenHonest := h.RegisterUserAndLogin("user_rescore_honest")
+	h.CreateTeam(tokenHonest, "RescoreHonestTeam", http.StatusCreated)
+
+	h.SubmitFlag(tokenBanned, challID, "flag{rescored}", http.StatusOK)
+	h.SubmitFlag(tokenHonest, challID, "flag{rescored}", http.StatusOK)
+	h.BanTeam(tokenAdmin, bannedTeamID, "shared flags", http.StatusOK)
+
+	clean := h.CheckScoringConsistency(tokenAdmin, &openapi.GetAdminScoringConsistencyParams{}, http.StatusOK)
+	require.NotNil(t, clean)
+	require.Empty(t, clean.Drifts)
+
+	excludeBanned := true
+	h.CheckScoringConsistency(tokenHonest, &openapi.GetAdminScoringConsistencyParams{ExcludeBanned: &excludeBanned}, http.StatusForbidden)
+	drifted := h.CheckScoringConsistency(tokenAdmin, &openapi.GetAdminScoringConsistencyParams{ExcludeBanned: &excludeBanned}, http.StatusOK)
+	require.NotNil(t, drifted)
+	require.False(t, drifted.Fixed)
+	require.Len(t, drifted.Drifts, 1)
+	require.Equal(t, challID, drifted.Drifts[0].ChallengeID)
+	require.Equal(t, 2, drifted.Drifts[0].StoredSolveCount)
+	require.Equal(t, 1, drifted.Drifts[0].SolveCount)
+
+	h.Rescore(tokenHonest, openapi.PostAdminScoringRescoreJSONRequestBody{ExcludeBanned: &excludeBanned}, http.StatusForbidden)
+	fixed := h.Rescore(tokenAdmin, openapi.PostAdminScoringRescoreJSONRequestBody{ExcludeBanned: &excludeBanned}, http.StatusOK)
+	require.NotNil(t, fixed)
+	require.True(t, fixed.Fixed)
+	require.Len(t, fixed.Drifts, 1)
+
+	listed := h.FindChallengeInList(tokenHonest, challID)
+	require.NotNil(t, listed.SolveCount)
+	require.Equal(t, 1, *listed.SolveCount)
+	after := h.CheckScoringConsistency(tokenAdmin, &openapi.GetAdminScoringConsistencyParams{ExcludeBanned: &excludeBanned}, http.StatusOK)
+	require.Empty(t, after.Drifts)
+}
@@ -0,0 +1,50 @@
+package e2e_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/stretchr/testify/require"
+)
+
+// Per-team flags: each team solves with its own flag, and submitting another team's flag is
+// rejected and recorded as a cheat incident.
+func TestTeamFlag_SharedFlagIsRecorded(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	_, tokenAdmin := h.SetupCompetition("admin_team_flag")
+	challID := h.CreateBasicChallenge(tokenAdmin, "PerTeam", "flag{shared}", 100)
+	cfg := h.SetTeamFlag(tokenAdmin, challID, "CTF{per_team_<hmac8>}", http.StatusOK)
+	require.Equal(t, "CTF{per_team_<hmac8>}", cfg.Template)
+	h.SetTeamFlag(tokenAdmin, challID, "CTF{no_token}", http.StatusBadRequest)
+
+	_, _, tokenA := h.RegisterUserAndLogin("user_team_flag_a")
+	h.CreateTeam(tokenA, "TeamFlagA", http.StatusCreated)
+	teamA := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenA, http.StatusOK))
+	_, _, tokenB := h.RegisterUserAndLogin("user_team_flag_b")
+	h.CreateTeam(tokenB, "TeamFlagB", http.StatusCreated)
+	teamB := helper.RequireMyTeamOK(t, h.GetMyTeam(tokenB, http.StatusOK))
+
+	renderedA := h.RenderTeamFlag(tokenAdmin, challID, teamA, "flag: {{flag}}")
+	require.Equal(t, "flag: "+renderedA.Flag, renderedA.Text)
+	renderedB := h.RenderTeamFlag(tokenAdmin, challID, teamB, "")
+	require.NotEqual(t, renderedA.Flag, renderedB.Flag)
+
+	h.SubmitFlag(tokenA, challID, "flag{shared}", http.StatusBadRequest)
+	h.SubmitFlag(tokenB, challID, renderedA.Flag, http.StatusBadRequest)
+	h.SubmitFlag(tokenA, challID, renderedA.Flag, http.StatusOK)
+
+	incidents := h.GetCheatIncidents(tokenAdmin, http.StatusOK)
+	require.NotNil(t, incidents.JSON200)
+	require.Len(t, *incidents.JSON200.Items, 1)
+	incident := (*incidents.JSON200.Items)[0]
+	require.Equal(t, "shared_flag", string(incident.Kind))
+	require.Equal(t, teamB, incident.TeamID)
+	require.NotNil(t, incident.SourceTeamID)
+	require.Equal(t, teamA, *incident.SourceTeamID)
+
+	h.GetCheatIncidents(tokenB, http.StatusForbidden)
+}
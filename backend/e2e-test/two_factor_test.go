@@ -0,0 +1,192 @@
+package e2e_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/e2e-test/helper"
+	"github.com/skr1ms/CTFBoard/pkg/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// POST /user/2fa/setup + /user/2fa/enable: enrollment returns recovery codes and status reports enabled.
+func TestTwoFactor_Enroll_Success(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, token := h.RegisterUserAndLogin("tfa_enroll_" + suffix)
+
+	status := h.GetUserTwoFactor(token, http.StatusOK)
+	require.NotNil(t, status.JSON200)
+	assert.False(t, *status.JSON200.Enabled)
+
+	_, codes := h.EnrollTwoFactor(token)
+	assert.Len(t, codes, 10)
+
+	status = h.GetUserTwoFactor(token, http.StatusOK)
+	require.NotNil(t, status.JSON200)
+	assert.True(t, *status.JSON200.Enabled)
+	assert.Equal(t, 10, *status.JSON200.RecoveryCodesLeft)
+}
+
+// POST /user/2fa/enable: a wrong code is rejected and 2FA stays disabled.
+func TestTwoFactor_Enable_InvalidCode(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, token := h.RegisterUserAndLogin("tfa_badcode_" + suffix)
+
+	h.SetupTwoFactor(token, http.StatusOK)
+	h.EnableTwoFactor(token, "000000", http.StatusUnauthorized)
+
+	status := h.GetUserTwoFactor(token, http.StatusOK)
+	require.NotNil(t, status.JSON200)
+	assert.False(t, *status.JSON200.Enabled)
+}
+
+// POST /auth/login + /auth/login/2fa: password login returns a challenge that a TOTP code exchanges for tokens.
+func TestTwoFactor_Login_TOTP(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	email, password, token := h.RegisterUserAndLogin("tfa_login_" + suffix)
+	secret, _ := h.EnrollTwoFactor(token)
+
+	login := h.Login(email, password, http.StatusOK)
+	require.NotNil(t, login.JSON200)
+	require.NotNil(t, login.JSON200.TwoFactorRequired)
+	assert.True(t, *login.JSON200.TwoFactorRequired)
+	assert.Nil(t, login.JSON200.AccessToken)
+	require.NotNil(t, login.JSON200.ChallengeToken)
+
+	// The enrollment consumed the current step, so use the next one.
+	code := helper.TOTPCode(t, secret, time.Now().Add(totp.Period))
+	resp := h.LoginTwoFactor(*login.JSON200.ChallengeToken, code, http.StatusOK)
+	require.NotNil(t, resp.JSON200)
+	require.NotNil(t, resp.JSON200.AccessToken)
+	helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), *resp.JSON200.AccessToken))
+
+	// Replaying the same code is rejected.
+	login = h.Login(email, password, http.StatusOK)
+	require.NotNil(t, login.JSON200)
+	h.LoginTwoFactor(*login.JSON200.ChallengeToken, code, http.StatusUnauthorized)
+}
+
+// POST /auth/login/2fa: a recovery code works once.
+func TestTwoFactor_Login_RecoveryCode(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	email, password, token := h.RegisterUserAndLogin("tfa_recovery_" + suffix)
+	_, codes := h.EnrollTwoFactor(token)
+
+	login := h.Login(email, password, http.StatusOK)
+	require.NotNil(t, login.JSON200)
+	h.LoginTwoFactor(*login.JSON200.ChallengeToken, codes[0], http.StatusOK)
+
+	login = h.Login(email, password, http.StatusOK)
+	require.NotNil(t, login.JSON200)
+	h.LoginTwoFactor(*login.JSON200.ChallengeToken, codes[0], http.StatusUnauthorized)
+
+	status := h.GetUserTwoFactor(token, http.StatusOK)
+	require.NotNil(t, status.JSON200)
+	assert.Equal(t, 9, *status.JSON200.RecoveryCodesLeft)
+}
+
+// POST /auth/login/2fa: an access token is not accepted as a challenge token.
+func TestTwoFactor_Login_InvalidChallenge(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, token := h.RegisterUserAndLogin("tfa_chal_" + suffix)
+
+	h.LoginTwoFactor(token[len("Bearer "):], "123456", http.StatusUnauthorized)
+}
+
+// POST /user/2fa/disable: after disabling, password login returns tokens directly.
+func TestTwoFactor_Disable_Success(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	email, password, token := h.RegisterUserAndLogin("tfa_disable_" + suffix)
+	_, codes := h.EnrollTwoFactor(token)
+
+	h.DisableTwoFactor(token, codes[0], http.StatusNoContent)
+	helper.RequireLoginOK(t, h.Login(email, password, http.StatusOK))
+}
+
+// DELETE /admin/users/{ID}/2fa: admin resets a user's enrollment; non-admin gets 403.
+func TestTwoFactor_AdminReset(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+	ctx := context.Background()
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("tfa_admin_" + suffix)
+	email, password, userToken := h.RegisterUserAndLogin("tfa_target_" + suffix)
+	h.EnrollTwoFactor(userToken)
+	me := helper.RequireMeOK(t, h.MeWithClient(ctx, h.Client(), userToken))
+
+	h.ResetUserTwoFactor(userToken, *me.ID, http.StatusForbidden)
+	h.ResetUserTwoFactor(adminToken, *me.ID, http.StatusNoContent)
+	h.ResetUserTwoFactor(adminToken, *me.ID, http.StatusNotFound)
+
+	helper.RequireLoginOK(t, h.Login(email, password, http.StatusOK))
+}
+
+// require_2fa_for_admins: admin routes return 403 until the admin enrolls.
+func TestTwoFactor_RequiredForAdmins(t *testing.T) {
+	t.Helper()
+	setupE2E(t)
+	h := helper.NewE2EHelper(t, nil, TestPool, GetTestBaseURL())
+
+	suffix := uuid.New().String()[:8]
+	_, _, adminToken := h.RegisterAdmin("tfa_policy_" + suffix)
+
+	body := map[string]any{
+		"app_name":                  "CTFBoard",
+		"verify_emails":             true,
+		"frontend_url":              "http://localhost:3000",
+		"cors_origins":              "http://localhost:3000",
+		"resend_enabled":            false,
+		"resend_from_email":         "noreply@ctfboard.local",
+		"resend_from_name":          "CTFBoard",
+		"verify_ttl_hours":          24,
+		"reset_ttl_hours":           1,
+		"submit_limit_per_user":     10,
+		"submit_limit_duration_min": 1,
+		"scoreboard_visible":        "public",
+		"registration_open":         true,
+		"require_2fa_for_admins":    true,
+	}
+	h.PutAdminSettings(adminToken, body, http.StatusOK)
+
+	h.GetAdminSettingsExpectStatus(adminToken, http.StatusForbidden)
+
+	status := h.GetUserTwoFactor(adminToken, http.StatusOK)
+	require.NotNil(t, status.JSON200)
+	assert.True(t, *status.JSON200.Required)
+
+	h.EnrollTwoFactor(adminToken)
+	resp := h.GetAdminSettings(adminToken)
+	require.NotNil(t, resp.JSON200)
+	assert.True(t, *resp.JSON200.Require2FaForAdmins)
+}
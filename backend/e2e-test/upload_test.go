@@ -120,7 +120,7 @@ func TestFile_Delete_NotFound(t *testing.T) {
 	h.DeleteChallengeFile(tokenAdmin, "00000000-0000-0000-0000-000000000000", http.StatusNotFound)
 }
 
-// GET /challenges/{ID}/files: non-existent challenge returns 200 with empty array.
+// GET /challenges/{ID}/files: non-existent challenge returns 404.
 func TestChallenge_GetChallengeFiles_NotFound(t *testing.T) {
 	t.Helper()
 	setupE2E(t)
@@ -128,12 +128,10 @@ func TestChallenge_GetChallengeFiles_NotFound(t *testing.T) {
 
 	_, _, token := h.RegisterUserAndLogin("files_404_" + uuid.New().String()[:8])
 	h.CreateSoloTeam(token, http.StatusCreated)
-	resp := h.GetChallengeFilesExpectStatus(token, "00000000-0000-0000-0000-000000000000", http.StatusOK)
-	require.NotNil(t, resp.JSON200)
-	require.Empty(t, *resp.JSON200)
+	h.GetChallengeFilesExpectStatus(token, "00000000-0000-0000-0000-000000000000", http.StatusNotFound)
 }
 
-// GET /challenges/{ID}/hints: non-existent challenge returns 200 with empty array.
+// GET /challenges/{ID}/hints: non-existent challenge returns 404.
 func TestChallenge_GetHints_NotFound(t *testing.T) {
 	t.Helper()
 	setupE2E(t)
@@ -141,9 +139,7 @@ func TestChallenge_GetHints_NotFound(t *testing.T) {
 
 	_, _, token := h.RegisterUserAndLogin("hints_404_" + uuid.New().String()[:8])
 	h.CreateSoloTeam(token, http.StatusCreated)
-	resp := h.GetChallengesChallengeIDHintsExpectStatus(token, "00000000-0000-0000-0000-000000000000", http.StatusOK)
-	require.NotNil(t, resp.JSON200)
-	require.Empty(t, *resp.JSON200)
+	h.GetChallengesChallengeIDHintsExpectStatus(token, "00000000-0000-0000-0000-000000000000", http.StatusNotFound)
 }
 
 // GET /files/{ID}/download: non-existent file returns 404.
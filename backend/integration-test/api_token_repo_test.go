@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -17,7 +18,7 @@ func TestAPITokenRepo_Create_Success(t *testing.T) {
 	ctx := context.Background()
 
 	user := f.CreateUser(t, "apitok")
-	token := &entity.APIToken{UserID: user.ID, TokenHash: "hash_" + uuid.New().String(), Description: "test"}
+	token := &entity.APIToken{UserID: &user.ID, TokenHash: "hash_" + uuid.New().String(), Description: "test"}
 	err := f.APITokenRepo.Create(ctx, token)
 	require.NoError(t, err)
 	assert.NotEmpty(t, token.ID)
@@ -29,7 +30,8 @@ func TestAPITokenRepo_Create_Error_InvalidUserID(t *testing.T) {
 	f := NewTestFixture(testPool.Pool)
 	ctx := context.Background()
 
-	token := &entity.APIToken{UserID: uuid.New(), TokenHash: "hash_xyz", Description: "x"}
+	userID := uuid.New()
+	token := &entity.APIToken{UserID: &userID, TokenHash: "hash_xyz", Description: "x"}
 	err := f.APITokenRepo.Create(ctx, token)
 	assert.Error(t, err)
 }
@@ -41,7 +43,7 @@ func TestAPITokenRepo_GetByUserID_Success(t *testing.T) {
 	ctx := context.Background()
 
 	user := f.CreateUser(t, "gbu")
-	token := &entity.APIToken{UserID: user.ID, TokenHash: "hash_" + uuid.New().String()}
+	token := &entity.APIToken{UserID: &user.ID, TokenHash: "hash_" + uuid.New().String()}
 	err := f.APITokenRepo.Create(ctx, token)
 	require.NoError(t, err)
 	list, err := f.APITokenRepo.GetByUserID(ctx, user.ID)
@@ -70,13 +72,14 @@ func TestAPITokenRepo_GetByTokenHash_Success(t *testing.T) {
 
 	user := f.CreateUser(t, "gbh")
 	hash := "hash_" + uuid.New().String()
-	token := &entity.APIToken{UserID: user.ID, TokenHash: hash}
+	token := &entity.APIToken{UserID: &user.ID, TokenHash: hash, Scopes: []string{entity.ScopeChallengesRead, entity.ScopeSubmit}}
 	err := f.APITokenRepo.Create(ctx, token)
 	require.NoError(t, err)
 	got, err := f.APITokenRepo.GetByTokenHash(ctx, hash)
 	require.NoError(t, err)
 	require.NotNil(t, got)
 	assert.Equal(t, token.ID, got.ID)
+	assert.Equal(t, []string{entity.ScopeChallengesRead, entity.ScopeSubmit}, got.Scopes)
 }
 
 func TestAPITokenRepo_GetByTokenHash_Error_NotFound(t *testing.T) {
@@ -97,7 +100,7 @@ func TestAPITokenRepo_Delete_Success(t *testing.T) {
 	ctx := context.Background()
 
 	user := f.CreateUser(t, "del")
-	token := &entity.APIToken{UserID: user.ID, TokenHash: "hash_" + uuid.New().String()}
+	token := &entity.APIToken{UserID: &user.ID, TokenHash: "hash_" + uuid.New().String()}
 	err := f.APITokenRepo.Create(ctx, token)
 	require.NoError(t, err)
 	err = f.APITokenRepo.Delete(ctx, token.ID, user.ID)
@@ -114,7 +117,7 @@ func TestAPITokenRepo_Delete_Error_WrongUser(t *testing.T) {
 	ctx := context.Background()
 
 	user := f.CreateUser(t, "delerr")
-	token := &entity.APIToken{UserID: user.ID, TokenHash: "hash_" + uuid.New().String()}
+	token := &entity.APIToken{UserID: &user.ID, TokenHash: "hash_" + uuid.New().String()}
 	err := f.APITokenRepo.Create(ctx, token)
 	require.NoError(t, err)
 	err = f.APITokenRepo.Delete(ctx, token.ID, uuid.New())
@@ -131,7 +134,7 @@ func TestAPITokenRepo_UpdateLastUsedAt_Success(t *testing.T) {
 	ctx := context.Background()
 
 	user := f.CreateUser(t, "updlast")
-	token := &entity.APIToken{UserID: user.ID, TokenHash: "hash_" + uuid.New().String()}
+	token := &entity.APIToken{UserID: &user.ID, TokenHash: "hash_" + uuid.New().String()}
 	err := f.APITokenRepo.Create(ctx, token)
 	require.NoError(t, err)
 	err = f.APITokenRepo.UpdateLastUsedAt(ctx, token.ID, f.GetDefaultAppSettings(t).UpdatedAt)
@@ -147,3 +150,62 @@ func TestAPITokenRepo_UpdateLastUsedAt_Error_NotFound(t *testing.T) {
 	err := f.APITokenRepo.UpdateLastUsedAt(ctx, uuid.New(), f.GetDefaultAppSettings(t).UpdatedAt)
 	assert.NoError(t, err)
 }
+
+func TestAPITokenRepo_ServiceToken_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	admin := f.CreateUser(t, "svcadmin")
+	token := &entity.APIToken{TokenHash: "hash_" + uuid.New().String(), Scopes: []string{entity.ScopeAdminRead}, CreatedBy: &admin.ID}
+	require.NoError(t, f.APITokenRepo.Create(ctx, token))
+
+	list, err := f.APITokenRepo.GetServiceTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.True(t, list[0].IsService())
+	require.NotNil(t, list[0].CreatedBy)
+	assert.Equal(t, admin.ID, *list[0].CreatedBy)
+
+	require.NoError(t, f.APITokenRepo.DeleteServiceToken(ctx, token.ID))
+	_, err = f.APITokenRepo.GetByID(ctx, token.ID)
+	assert.ErrorIs(t, err, entityError.ErrAPITokenNotFound)
+}
+
+func TestAPITokenRepo_DeleteServiceToken_Error_UserToken(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "svcdelerr")
+	token := &entity.APIToken{UserID: &user.ID, TokenHash: "hash_" + uuid.New().String()}
+	require.NoError(t, f.APITokenRepo.Create(ctx, token))
+
+	err := f.APITokenRepo.DeleteServiceToken(ctx, token.ID)
+	assert.ErrorIs(t, err, entityError.ErrAPITokenNotFound)
+}
+
+func TestAPITokenRepo_Requests_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "tokreq")
+	token := &entity.APIToken{UserID: &user.ID, TokenHash: "hash_" + uuid.New().String()}
+	require.NoError(t, f.APITokenRepo.Create(ctx, token))
+
+	for _, status := range []int{200, 403, 200} {
+		require.NoError(t, f.APITokenRepo.CreateRequest(ctx, &entity.APITokenRequest{
+			TokenID: token.ID, Method: "GET", Path: "/api/v1/challenges", Status: status, IP: "10.0.0.1",
+		}))
+	}
+
+	reqs, err := f.APITokenRepo.GetRequests(ctx, token.ID, 2)
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	assert.Equal(t, "/api/v1/challenges", reqs[0].Path)
+	assert.Equal(t, "10.0.0.1", reqs[0].IP)
+}
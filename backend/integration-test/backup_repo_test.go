@@ -133,6 +133,43 @@ func TestBackupRepo_ImportChallengesTx_Success(t *testing.T) {
 	assert.Equal(t, 150, got.Points)
 }
 
+func TestBackupRepo_ImportChallengesTx_Decoys(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challengeID, decoyID := uuid.New(), uuid.New()
+	data := &entity.BackupData{
+		Challenges: []entity.ChallengeExport{
+			{
+				Challenge: entity.Challenge{
+					ID: challengeID, Title: "Decoy Chall", Description: "Desc", Category: "Web",
+					Points: 100, FlagHash: "hash", InitialValue: 100, MinValue: 100,
+				},
+				Decoys: []entity.DecoyFlag{
+					{ID: decoyID, ChallengeID: challengeID, FlagHash: "decoy-hash", IsCaseInsensitive: true, Note: "pastebin"},
+				},
+			},
+		},
+	}
+
+	tx, err := f.TxRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback(ctx) }() //nolint:errcheck
+
+	require.NoError(t, f.BackupRepo.ImportChallengesTx(ctx, tx, data))
+	require.NoError(t, tx.Commit(ctx))
+
+	decoys, err := f.DecoyFlagRepo.GetByChallengeID(ctx, challengeID)
+	require.NoError(t, err)
+	require.Len(t, decoys, 1)
+	assert.Equal(t, decoyID, decoys[0].ID)
+	assert.Equal(t, "decoy-hash", decoys[0].FlagHash)
+	assert.True(t, decoys[0].IsCaseInsensitive)
+	assert.Equal(t, "pastebin", decoys[0].Note)
+}
+
 func TestBackupRepo_ImportChallengesTx_Error_InvalidHintChallengeID(t *testing.T) {
 	t.Helper()
 	testPool := SetupTestPool(t)
@@ -0,0 +1,189 @@
+package integration_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/scoring"
+	"github.com/skr1ms/CTFBoard/internal/usecase/competition"
+	"github.com/skr1ms/CTFBoard/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBackupUseCase(f *TestFixture) *competition.BackupUseCase {
+	return competition.NewBackupUseCase(competition.BackupDeps{
+		CompetitionRepo: f.CompetitionRepo, ChallengeRepo: f.ChallengeRepo, HintRepo: f.HintRepo,
+		FlagRepo: f.ChallengeFlagRepo, DecoyRepo: f.DecoyFlagRepo, RequirementRepo: f.ChallengeRequirementRepo,
+		ScheduleRepo: f.ChallengeScheduleRepo, TeamFlagRepo: f.TeamFlagRepo, InstanceRepo: f.InstanceRepo, ReviewRepo: f.ReviewRepo,
+		StageRepo: f.StageRepo, ScoringRepo: f.ScoringRepo, AttemptRepo: f.AttemptRepo,
+		TeamRepo: f.TeamRepo, UserRepo: f.UserRepo, AwardRepo: f.AwardRepo, SolveRepo: f.SolveRepo,
+		FileRepo: f.FileRepo, BackupRepo: f.BackupRepo, TxRepo: f.TxRepo,
+		Logger: logger.New(&logger.Options{Level: logger.ErrorLevel, Output: logger.ConsoleOutput}),
+	})
+}
+
+// roundTripBackup exports everything but files, deletes every challenge together with the rows
+// hanging off it and restores the ZIP. Teams and users are kept so only challenge data has to
+// come back from the backup.
+func roundTripBackup(t *testing.T, f *TestFixture, uc *competition.BackupUseCase) {
+	t.Helper()
+	ctx := context.Background()
+
+	rc, err := uc.ExportZIP(ctx, entity.ExportOptions{IncludeUsers: true, IncludeTeams: true, IncludeSolves: true, IncludeAwards: true})
+	require.NoError(t, err)
+	archive, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+
+	_, err = f.Pool.Exec(ctx, "DELETE FROM challenges")
+	require.NoError(t, err)
+
+	_, err = uc.ImportZIP(ctx, bytes.NewReader(archive), int64(len(archive)), entity.ImportOptions{ConflictMode: entity.ConflictModeOverwrite})
+	require.NoError(t, err)
+}
+
+func TestBackupUseCase_RoundTrip_TeamFlag(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "backup_team_flag", 100)
+	require.NoError(t, f.TeamFlagRepo.Upsert(ctx, &entity.TeamFlagConfig{
+		ChallengeID: challenge.ID, Template: "CTF{team_<hmac8>}", Secret: "encrypted-secret",
+	}))
+
+	roundTripBackup(t, f, newBackupUseCase(f))
+
+	cfg, err := f.TeamFlagRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "CTF{team_<hmac8>}", cfg.Template)
+	assert.Equal(t, "encrypted-secret", cfg.Secret)
+}
+
+func TestBackupUseCase_RoundTrip_InstanceConfig(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "backup_instance", 100)
+	require.NoError(t, f.InstanceRepo.UpsertConfig(ctx, &entity.InstanceConfig{
+		ChallengeID: challenge.ID, Image: "ctf/pwn:latest", TTLSeconds: 1800,
+	}))
+
+	roundTripBackup(t, f, newBackupUseCase(f))
+
+	cfg, err := f.InstanceRepo.GetConfig(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "ctf/pwn:latest", cfg.Image)
+	assert.Equal(t, 1800, cfg.TTLSeconds)
+}
+
+func TestBackupUseCase_RoundTrip_ManualReview(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "backup_review")
+	challenge := f.CreateChallenge(t, "backup_review", 100)
+	require.NoError(t, f.ReviewRepo.UpsertConfig(ctx, challenge.ID))
+	review := &entity.SubmissionReview{ChallengeID: challenge.ID, TeamID: team.ID, UserID: user.ID, Answer: "writeup"}
+	require.NoError(t, f.ReviewRepo.Create(ctx, review))
+
+	roundTripBackup(t, f, newBackupUseCase(f))
+
+	_, err := f.ReviewRepo.GetConfig(ctx, challenge.ID)
+	require.NoError(t, err)
+	got, err := f.ReviewRepo.GetByID(ctx, review.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ReviewStatusPending, got.Status)
+	assert.Equal(t, "writeup", got.Answer)
+	assert.Equal(t, team.ID, got.TeamID)
+}
+
+func TestBackupUseCase_RoundTrip_Stages(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "backup_stages")
+	challenge := f.CreateChallenge(t, "backup_stages", 100)
+	first := createStage(t, f, challenge.ID, "leak", 50)
+	createStage(t, f, challenge.ID, "shell", 75)
+	_, err := f.StageRepo.CreateSolve(ctx, &entity.StageSolve{StageID: first.ID, TeamID: team.ID, UserID: user.ID})
+	require.NoError(t, err)
+
+	roundTripBackup(t, f, newBackupUseCase(f))
+
+	stages, err := f.StageRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.Len(t, stages, 2)
+	assert.Equal(t, "leak", stages[0].Title)
+	assert.Equal(t, 75, stages[1].Points)
+	require.Len(t, stages[1].Flags, 1)
+	assert.Equal(t, "shell_hash", stages[1].Flags[0].FlagHash)
+	assert.Equal(t, 1, stages[0].SolveCount)
+
+	completed, err := f.StageRepo.GetCompletedStageIDs(ctx, team.ID, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{first.ID}, completed)
+}
+
+func TestBackupUseCase_RoundTrip_Scoring(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "backup_scoring", 500)
+	require.NoError(t, f.ScoringRepo.Set(ctx, &entity.ChallengeScoring{
+		ChallengeID: challenge.ID,
+		Function:    scoring.Exponential,
+		Params:      map[string]float64{"initial": 500, "minimum": 100, "half_life": 2.5},
+	}))
+
+	roundTripBackup(t, f, newBackupUseCase(f))
+
+	got, err := f.ScoringRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, scoring.Exponential, got.Function)
+	assert.Equal(t, map[string]float64{"initial": 500, "minimum": 100, "half_life": 2.5}, got.Params)
+}
+
+func TestBackupUseCase_RoundTrip_AttemptLimit(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	_, team := f.CreateUserWithTeam(t, "backup_attempts")
+	challenge := f.CreateChallenge(t, "backup_attempts", 100)
+	maxAttempts := 3
+	require.NoError(t, f.AttemptRepo.Set(ctx, &entity.AttemptLimit{ChallengeID: challenge.ID, MaxAttempts: &maxAttempts, WrongPenalty: 10}))
+	resetAt := time.Now().UTC().Truncate(time.Millisecond)
+	require.NoError(t, f.AttemptRepo.ResetTeam(ctx, challenge.ID, team.ID, resetAt))
+
+	roundTripBackup(t, f, newBackupUseCase(f))
+
+	limit, err := f.AttemptRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.NotNil(t, limit.MaxAttempts)
+	assert.Equal(t, 3, *limit.MaxAttempts)
+	assert.Equal(t, 10, limit.WrongPenalty)
+
+	resets, err := f.AttemptRepo.GetAllResets(ctx)
+	require.NoError(t, err)
+	require.Len(t, resets, 1)
+	assert.Equal(t, team.ID, resets[0].TeamID)
+	assert.WithinDuration(t, resetAt, resets[0].ResetAt, time.Millisecond)
+}
@@ -0,0 +1,144 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttemptLimitRepo_SetGetDelete(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "attempt_crud", 100)
+
+	_, err := f.AttemptRepo.GetByChallengeID(ctx, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrAttemptLimitNotConfigured)
+
+	maxAttempts := 3
+	limit := &entity.AttemptLimit{ChallengeID: challenge.ID, MaxAttempts: &maxAttempts, WrongPenalty: 10}
+	require.NoError(t, f.AttemptRepo.Set(ctx, limit))
+	assert.False(t, limit.UpdatedAt.IsZero())
+
+	limit.MaxAttempts = nil
+	limit.WrongPenalty = 25
+	require.NoError(t, f.AttemptRepo.Set(ctx, limit))
+
+	got, err := f.AttemptRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.MaxAttempts)
+	assert.Equal(t, 25, got.WrongPenalty)
+
+	all, err := f.AttemptRepo.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+
+	require.NoError(t, f.AttemptRepo.Delete(ctx, challenge.ID))
+	_, err = f.AttemptRepo.GetByChallengeID(ctx, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrAttemptLimitNotConfigured)
+}
+
+func TestSubmissionRepo_CountFailed_RespectsReset(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "attempt_count")
+	challenge := f.CreateChallenge(t, "attempt_count", 100)
+	other := f.CreateChallenge(t, "attempt_count_other", 100)
+
+	base := time.Now().Add(-time.Hour)
+	for i, sub := range []struct {
+		challengeID uuid.UUID
+		correct     bool
+	}{
+		{challenge.ID, false},
+		{challenge.ID, false},
+		{challenge.ID, true},
+		{other.ID, false},
+	} {
+		require.NoError(t, f.SubmissionRepo.Create(ctx, &entity.Submission{
+			UserID:        user.ID,
+			TeamID:        &team.ID,
+			ChallengeID:   sub.challengeID,
+			SubmittedFlag: "flag{guess}",
+			IsCorrect:     sub.correct,
+			CreatedAt:     base.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	failed, err := f.SubmissionRepo.CountFailedByTeamAndChallenge(ctx, team.ID, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), failed)
+
+	require.NoError(t, f.AttemptRepo.ResetTeam(ctx, challenge.ID, team.ID, base.Add(30*time.Second)))
+	failed, err = f.SubmissionRepo.CountFailedByTeamAndChallenge(ctx, team.ID, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), failed)
+
+	byChallenge, err := f.SubmissionRepo.CountFailedByTeam(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int{challenge.ID: 1, other.ID: 1}, byChallenge)
+
+	require.NoError(t, f.AttemptRepo.ResetTeam(ctx, challenge.ID, team.ID, time.Now()))
+	failed, err = f.SubmissionRepo.CountFailedByTeamAndChallenge(ctx, team.ID, challenge.ID)
+	require.NoError(t, err)
+	assert.Zero(t, failed)
+}
+
+func TestSubmissionRepo_CountFailed_SkipsQueuedReviews(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "attempt_review")
+	challenge := f.CreateChallenge(t, "attempt_review", 100)
+	require.NoError(t, f.ReviewRepo.UpsertConfig(ctx, challenge.ID))
+
+	submit := func(answer string) uuid.UUID {
+		sub := &entity.Submission{
+			UserID: user.ID, TeamID: &team.ID, ChallengeID: challenge.ID, SubmittedFlag: answer, CreatedAt: time.Now(),
+		}
+		require.NoError(t, f.SubmissionRepo.Create(ctx, sub))
+		return sub.ID
+	}
+	rejected := &entity.SubmissionReview{ChallengeID: challenge.ID, TeamID: team.ID, UserID: user.ID, Answer: "first essay"}
+	require.NoError(t, f.ReviewRepo.Create(ctx, rejected))
+	require.NoError(t, f.ReviewRepo.LinkSubmission(ctx, challenge.ID, team.ID, submit("first essay")))
+	rejected.Status = entity.ReviewStatusRejected
+	rejected.ReviewedBy = &user.ID
+	require.NoError(t, f.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		return f.ReviewRepo.ResolveTx(ctx, tx, rejected)
+	}))
+	assert.ErrorIs(t, f.ReviewRepo.LinkSubmission(ctx, challenge.ID, team.ID, submit("first essay")), entityError.ErrReviewNotFound)
+
+	pending := &entity.SubmissionReview{ChallengeID: challenge.ID, TeamID: team.ID, UserID: user.ID, Answer: "second essay"}
+	require.NoError(t, f.ReviewRepo.Create(ctx, pending))
+	pendingSubmission := submit(" second essay\n")
+	require.NoError(t, f.ReviewRepo.LinkSubmission(ctx, challenge.ID, team.ID, pendingSubmission))
+	submit("second essay")
+
+	got, err := f.ReviewRepo.GetByID(ctx, pending.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.SubmissionID)
+	assert.Equal(t, pendingSubmission, *got.SubmissionID)
+
+	failed, err := f.SubmissionRepo.CountFailedByTeamAndChallenge(ctx, team.ID, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), failed)
+
+	byChallenge, err := f.SubmissionRepo.CountFailedByTeam(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int{challenge.ID: 3}, byChallenge)
+}
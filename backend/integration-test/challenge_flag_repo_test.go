@@ -0,0 +1,70 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeFlagRepo_CRUD(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "flags_crud", 100)
+	static := &entity.ChallengeFlag{ChallengeID: challenge.ID, Type: entity.FlagTypeStatic, FlagHash: "hash", IsCaseInsensitive: true}
+	require.NoError(t, f.ChallengeFlagRepo.Create(ctx, static))
+	regex := &entity.ChallengeFlag{ChallengeID: challenge.ID, Type: entity.FlagTypeRegex, FlagRegex: "encrypted"}
+	require.NoError(t, f.ChallengeFlagRepo.Create(ctx, regex))
+	assert.NotEqual(t, uuid.Nil, static.ID)
+	assert.False(t, static.CreatedAt.IsZero())
+
+	flags, err := f.ChallengeFlagRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+	assert.Equal(t, static.ID, flags[0].ID)
+	assert.Equal(t, "hash", flags[0].FlagHash)
+	assert.True(t, flags[0].IsCaseInsensitive)
+	assert.Equal(t, "encrypted", flags[1].FlagRegex)
+
+	static.Type = entity.FlagTypeRegex
+	static.FlagHash = ""
+	static.FlagRegex = "other"
+	require.NoError(t, f.ChallengeFlagRepo.Update(ctx, static))
+	got, err := f.ChallengeFlagRepo.GetByID(ctx, static.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.FlagTypeRegex, got.Type)
+	assert.Equal(t, "other", got.FlagRegex)
+
+	require.NoError(t, f.ChallengeFlagRepo.Delete(ctx, static.ID))
+	_, err = f.ChallengeFlagRepo.GetByID(ctx, static.ID)
+	assert.ErrorIs(t, err, entityError.ErrChallengeFlagNotFound)
+}
+
+func TestChallengeFlagRepo_NotFound(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	err := f.ChallengeFlagRepo.Update(ctx, &entity.ChallengeFlag{ID: uuid.New(), Type: entity.FlagTypeStatic, FlagHash: "hash"})
+	assert.ErrorIs(t, err, entityError.ErrChallengeFlagNotFound)
+	assert.ErrorIs(t, f.ChallengeFlagRepo.Delete(ctx, uuid.New()), entityError.ErrChallengeFlagNotFound)
+}
+
+func TestChallengeFlagRepo_Create_RequiresValueForType(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "flags_check", 100)
+	err := f.ChallengeFlagRepo.Create(ctx, &entity.ChallengeFlag{ChallengeID: challenge.ID, Type: entity.FlagTypeRegex, FlagHash: "hash"})
+	assert.Error(t, err)
+}
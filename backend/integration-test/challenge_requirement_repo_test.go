@@ -0,0 +1,75 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeRequirementRepo_SetAndGet(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	first := f.CreateChallenge(t, "req_first", 100)
+	second := f.CreateChallenge(t, "req_second", 100)
+	final := f.CreateChallenge(t, "req_final", 100)
+
+	require.NoError(t, f.ChallengeRequirementRepo.Set(ctx, &entity.ChallengeRequirements{
+		ChallengeID:   final.ID,
+		Prerequisites: []uuid.UUID{first.ID, second.ID},
+		MinScore:      150,
+	}))
+
+	got, err := f.ChallengeRequirementRepo.GetByChallengeID(ctx, final.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{first.ID, second.ID}, got.Prerequisites)
+	assert.Equal(t, 150, got.MinScore)
+
+	all, err := f.ChallengeRequirementRepo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, final.ID, all[0].ChallengeID)
+
+	require.NoError(t, f.ChallengeRequirementRepo.Set(ctx, &entity.ChallengeRequirements{
+		ChallengeID:   final.ID,
+		Prerequisites: []uuid.UUID{second.ID},
+	}))
+	got, err = f.ChallengeRequirementRepo.GetByChallengeID(ctx, final.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{second.ID}, got.Prerequisites)
+	assert.Equal(t, 0, got.MinScore)
+}
+
+func TestChallengeRequirementRepo_Empty(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "req_empty", 100)
+	got, err := f.ChallengeRequirementRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.True(t, got.IsEmpty())
+	assert.NotNil(t, got.Prerequisites)
+}
+
+func TestSolveRepo_GetSolvedChallengeIDs(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "solved_ids")
+	challenge := f.CreateChallenge(t, "solved_ids", 100)
+	f.CreateSolve(t, user.ID, team.ID, challenge.ID)
+
+	ids, err := f.SolveRepo.GetSolvedChallengeIDs(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{challenge.ID}, ids)
+}
@@ -0,0 +1,96 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeScheduleRepo_SetGetDelete(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "schedule_crud", 100)
+	releaseAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	hideAt := releaseAt.Add(2 * time.Hour)
+	require.NoError(t, f.ChallengeScheduleRepo.Set(ctx, &entity.ChallengeSchedule{
+		ChallengeID:     challenge.ID,
+		ReleaseAt:       &releaseAt,
+		HideAt:          &hideAt,
+		NotifyOnRelease: true,
+	}))
+
+	got, err := f.ChallengeScheduleRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.ReleaseAt)
+	assert.True(t, releaseAt.Equal(*got.ReleaseAt))
+	assert.True(t, got.NotifyOnRelease)
+	assert.Nil(t, got.AnnouncedAt)
+
+	all, err := f.ChallengeScheduleRepo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, challenge.Title, all[0].Title)
+
+	require.NoError(t, f.ChallengeScheduleRepo.Delete(ctx, challenge.ID))
+	got, err = f.ChallengeScheduleRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.True(t, got.IsEmpty())
+}
+
+func TestChallengeRepo_GetAll_HidesOutsideReleaseWindow(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	live := f.CreateChallenge(t, "window_live", 100)
+	upcoming := f.CreateChallenge(t, "window_upcoming", 100)
+	ended := f.CreateChallenge(t, "window_ended", 100)
+	require.NoError(t, f.ChallengeScheduleRepo.Set(ctx, &entity.ChallengeSchedule{ChallengeID: live.ID, ReleaseAt: &past, HideAt: &future}))
+	require.NoError(t, f.ChallengeScheduleRepo.Set(ctx, &entity.ChallengeSchedule{ChallengeID: upcoming.ID, ReleaseAt: &future}))
+	require.NoError(t, f.ChallengeScheduleRepo.Set(ctx, &entity.ChallengeSchedule{ChallengeID: ended.ID, HideAt: &past}))
+
+	list, err := f.ChallengeRepo.GetAll(ctx, nil, nil)
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, 0, len(list))
+	for _, c := range list {
+		ids = append(ids, c.Challenge.ID)
+	}
+	assert.Contains(t, ids, live.ID)
+	assert.NotContains(t, ids, upcoming.ID)
+	assert.NotContains(t, ids, ended.ID)
+}
+
+func TestChallengeScheduleRepo_ClaimDueReleases_Once(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	due := f.CreateChallenge(t, "claim_due", 100)
+	pending := f.CreateChallenge(t, "claim_pending", 100)
+	past, future := time.Now().UTC().Add(-time.Minute), time.Now().UTC().Add(time.Hour)
+	require.NoError(t, f.ChallengeScheduleRepo.Set(ctx, &entity.ChallengeSchedule{ChallengeID: due.ID, ReleaseAt: &past, NotifyOnRelease: true}))
+	require.NoError(t, f.ChallengeScheduleRepo.Set(ctx, &entity.ChallengeSchedule{ChallengeID: pending.ID, ReleaseAt: &future}))
+
+	claimed, err := f.ChallengeScheduleRepo.ClaimDueReleases(ctx)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, due.ID, claimed[0].Schedule.ChallengeID)
+	assert.True(t, claimed[0].Schedule.NotifyOnRelease)
+	assert.NotNil(t, claimed[0].Schedule.AnnouncedAt)
+
+	claimed, err = f.ChallengeScheduleRepo.ClaimDueReleases(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+}
@@ -0,0 +1,70 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/scoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeScoringRepo_SetGetDelete(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "scoring_crud", 500)
+
+	got, err := f.ScoringRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	s := &entity.ChallengeScoring{
+		ChallengeID: challenge.ID,
+		Function:    scoring.Exponential,
+		Params:      map[string]float64{"initial": 500, "minimum": 100, "half_life": 2.5},
+	}
+	require.NoError(t, f.ScoringRepo.Set(ctx, s))
+	assert.False(t, s.UpdatedAt.IsZero())
+
+	s.Function = scoring.Linear
+	s.Params = map[string]float64{"initial": 500, "minimum": 100, "decay": 10}
+	require.NoError(t, f.ScoringRepo.Set(ctx, s))
+
+	got, err = f.ScoringRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, scoring.Linear, got.Function)
+	assert.Equal(t, s.Params, got.Params)
+
+	require.NoError(t, f.ScoringRepo.Delete(ctx, challenge.ID))
+	got, err = f.ScoringRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestChallengeScoringRepo_GetByFunctions(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	timed := f.CreateChallenge(t, "scoring_timed", 400)
+	linear := f.CreateChallenge(t, "scoring_linear", 300)
+	require.NoError(t, f.ScoringRepo.Set(ctx, &entity.ChallengeScoring{
+		ChallengeID: timed.ID, Function: scoring.Time, Params: map[string]float64{"initial": 400, "minimum": 100},
+	}))
+	require.NoError(t, f.ScoringRepo.Set(ctx, &entity.ChallengeScoring{
+		ChallengeID: linear.ID, Function: scoring.Linear, Params: map[string]float64{"initial": 300, "minimum": 100, "decay": 10},
+	}))
+
+	scored, err := f.ScoringRepo.GetByFunctions(ctx, []string{scoring.Time})
+	require.NoError(t, err)
+	require.Len(t, scored, 1)
+	assert.Equal(t, timed.ID, scored[0].Scoring.ChallengeID)
+	assert.Equal(t, 400, scored[0].Points)
+	assert.Equal(t, 0, scored[0].SolveCount)
+}
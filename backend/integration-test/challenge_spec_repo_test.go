@@ -0,0 +1,70 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeSpecRepo_LinkGetAll(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	linked := f.CreateChallenge(t, "spec_linked", 100)
+	unlinked := f.CreateChallenge(t, "spec_unlinked", 200)
+
+	require.NoError(t, f.SpecRepo.Link(ctx, linked.ID, "spec-linked"))
+	require.NoError(t, f.SpecRepo.Link(ctx, linked.ID, "spec-renamed"))
+
+	all, err := f.SpecRepo.GetAll(ctx)
+	require.NoError(t, err)
+	slugs := make(map[string]string, len(all))
+	for _, lc := range all {
+		slugs[lc.Challenge.Title] = lc.Slug
+	}
+	assert.Equal(t, "spec-renamed", slugs[linked.Title])
+	assert.Equal(t, "", slugs[unlinked.Title])
+}
+
+func TestChallengeSpecRepo_Link_SlugTaken(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	first := f.CreateChallenge(t, "spec_first", 100)
+	second := f.CreateChallenge(t, "spec_second", 100)
+	require.NoError(t, f.SpecRepo.Link(ctx, first.ID, "shared"))
+
+	err := f.SpecRepo.Link(ctx, second.ID, "shared")
+
+	assert.ErrorIs(t, err, entityError.ErrSpecSlugTaken)
+}
+
+func TestChallengeSpecRepo_GetAll_IncludesHidden(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	hidden := f.CreateChallenge(t, "spec_hidden", 100)
+	hidden.IsHidden = true
+	require.NoError(t, f.ChallengeRepo.Update(ctx, hidden))
+
+	all, err := f.SpecRepo.GetAll(ctx)
+	require.NoError(t, err)
+	var found *entity.LinkedChallenge
+	for _, lc := range all {
+		if lc.Challenge.ID == hidden.ID {
+			found = lc
+		}
+	}
+	require.NotNil(t, found)
+	assert.True(t, found.Challenge.IsHidden)
+}
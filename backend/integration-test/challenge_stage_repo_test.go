@@ -0,0 +1,120 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStage(t *testing.T, f *TestFixture, challengeID uuid.UUID, title string, points int) *entity.ChallengeStage {
+	t.Helper()
+	stage := &entity.ChallengeStage{
+		ChallengeID: challengeID,
+		Title:       title,
+		Points:      points,
+		Flags:       []*entity.ChallengeFlag{{Type: entity.FlagTypeStatic, FlagHash: title + "_hash"}},
+	}
+	require.NoError(t, f.StageRepo.Create(context.Background(), stage))
+	return stage
+}
+
+func TestChallengeStageRepo_CRUD(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "stages_crud", 100)
+	first := createStage(t, f, challenge.ID, "leak", 50)
+	second := createStage(t, f, challenge.ID, "shell", 75)
+	assert.Equal(t, 0, first.OrderIndex)
+	assert.Equal(t, 1, second.OrderIndex)
+
+	stages, err := f.StageRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.Len(t, stages, 2)
+	assert.Equal(t, first.ID, stages[0].ID)
+	require.Len(t, stages[0].Flags, 1)
+	assert.Equal(t, "leak_hash", stages[0].Flags[0].FlagHash)
+
+	first.Title = "info leak"
+	first.Flags = []*entity.ChallengeFlag{{Type: entity.FlagTypeRegex, FlagRegex: "encrypted"}}
+	require.NoError(t, f.StageRepo.Update(ctx, first))
+	require.NoError(t, f.StageRepo.Reorder(ctx, []uuid.UUID{second.ID, first.ID}))
+	got, err := f.StageRepo.GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "info leak", got.Title)
+	assert.Equal(t, 1, got.OrderIndex)
+	require.Len(t, got.Flags, 1)
+	assert.Equal(t, "encrypted", got.Flags[0].FlagRegex)
+
+	require.NoError(t, f.StageRepo.Delete(ctx, first.ID))
+	_, err = f.StageRepo.GetByID(ctx, first.ID)
+	assert.ErrorIs(t, err, entityError.ErrStageNotFound)
+	assert.ErrorIs(t, f.StageRepo.Delete(ctx, first.ID), entityError.ErrStageNotFound)
+}
+
+func TestChallengeStageRepo_SolvesAndProgress(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "stages_progress")
+	challenge := f.CreateChallenge(t, "stages_progress", 100)
+	first := createStage(t, f, challenge.ID, "leak", 50)
+	createStage(t, f, challenge.ID, "shell", 75)
+
+	solve := &entity.StageSolve{StageID: first.ID, TeamID: team.ID, UserID: user.ID}
+	count, err := f.StageRepo.CreateSolve(ctx, solve)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	_, err = f.StageRepo.CreateSolve(ctx, solve)
+	assert.ErrorIs(t, err, entityError.ErrAlreadySolved)
+
+	ids, err := f.StageRepo.GetCompletedStageIDs(ctx, team.ID, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{first.ID}, ids)
+
+	progress, err := f.StageRepo.GetProgress(ctx, &team.ID)
+	require.NoError(t, err)
+	require.Len(t, progress, 1)
+	assert.Equal(t, entity.StageProgress{ChallengeID: challenge.ID, Completed: 1, Total: 2}, *progress[0])
+
+	bloods, err := f.StageRepo.GetFirstBloods(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.Len(t, bloods, 1)
+	assert.Equal(t, team.Name, bloods[0].TeamName)
+
+	scoreboard, err := f.SolveRepo.GetScoreboard(ctx)
+	require.NoError(t, err)
+	require.Len(t, scoreboard, 1)
+	assert.Equal(t, 50, scoreboard[0].Points)
+}
+
+func TestChallengeStageRepo_CreateSolve_CountsTeams(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	firstUser, firstTeam := f.CreateUserWithTeam(t, "stages_count_1")
+	secondUser, secondTeam := f.CreateUserWithTeam(t, "stages_count_2")
+	challenge := f.CreateChallenge(t, "stages_count", 100)
+	stage := createStage(t, f, challenge.ID, "leak", 50)
+
+	count, err := f.StageRepo.CreateSolve(ctx, &entity.StageSolve{StageID: stage.ID, TeamID: firstTeam.ID, UserID: firstUser.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	count, err = f.StageRepo.CreateSolve(ctx, &entity.StageSolve{StageID: stage.ID, TeamID: secondTeam.ID, UserID: secondUser.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = f.StageRepo.CreateSolve(ctx, &entity.StageSolve{StageID: uuid.New(), TeamID: firstTeam.ID, UserID: firstUser.ID})
+	assert.ErrorIs(t, err, entityError.ErrStageNotFound)
+}
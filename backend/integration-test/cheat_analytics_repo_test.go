@@ -0,0 +1,184 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCheatSubmission(t *testing.T, f *TestFixture, user *entity.User, team *entity.Team, challengeID uuid.UUID, flag string, correct bool, ip string, at time.Time) *entity.Submission {
+	t.Helper()
+	sub := &entity.Submission{
+		UserID:        user.ID,
+		TeamID:        &team.ID,
+		ChallengeID:   challengeID,
+		SubmittedFlag: flag,
+		IsCorrect:     correct,
+		IP:            ip,
+		CreatedAt:     at,
+	}
+	require.NoError(t, f.SubmissionRepo.Create(context.Background(), sub))
+	return sub
+}
+
+func TestCheatAnalyticsRepo_GetSharedIPSubmissions(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	ua, ta := f.CreateUserWithTeam(t, "ip_a")
+	ub, tb := f.CreateUserWithTeam(t, "ip_b")
+	uc, tc := f.CreateUserWithTeam(t, "ip_c")
+	challenge := f.CreateChallenge(t, "ip_challenge", 100)
+	now := time.Now()
+
+	first := createCheatSubmission(t, f, ua, ta, challenge.ID, "a", false, "10.1.1.1", now)
+	createCheatSubmission(t, f, ua, ta, challenge.ID, "b", false, "10.1.1.1", now.Add(time.Second))
+	second := createCheatSubmission(t, f, ub, tb, challenge.ID, "c", false, "10.1.1.1", now.Add(time.Minute))
+	createCheatSubmission(t, f, uc, tc, challenge.ID, "d", false, "10.2.2.2", now)
+
+	subs, err := f.CheatAnalyticsRepo.GetSharedIPSubmissions(ctx)
+	require.NoError(t, err)
+	require.Len(t, subs, 2, "one submission per team on the shared IP")
+	ids := []uuid.UUID{subs[0].ID, subs[1].ID}
+	assert.ElementsMatch(t, []uuid.UUID{first.ID, second.ID}, ids)
+	assert.Equal(t, "10.1.1.1", subs[0].IP)
+}
+
+func TestCheatAnalyticsRepo_GetSharedWrongFlagSubmissions(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	ua, ta := f.CreateUserWithTeam(t, "wrong_a")
+	ub, tb := f.CreateUserWithTeam(t, "wrong_b")
+	uc, tc := f.CreateUserWithTeam(t, "wrong_c")
+	challenge := f.CreateChallenge(t, "wrong_challenge", 100)
+	now := time.Now()
+
+	createCheatSubmission(t, f, ua, ta, challenge.ID, "flag{guess}", false, "", now)
+	createCheatSubmission(t, f, ub, tb, challenge.ID, "flag{guess}", false, "", now.Add(time.Minute))
+	createCheatSubmission(t, f, uc, tc, challenge.ID, "flag{other}", false, "", now)
+
+	subs, err := f.CheatAnalyticsRepo.GetSharedWrongFlagSubmissions(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+	assert.Equal(t, "flag{guess}", subs[0].SubmittedFlag)
+	assert.Equal(t, "flag{guess}", subs[1].SubmittedFlag)
+
+	subs, err = f.CheatAnalyticsRepo.GetSharedWrongFlagSubmissions(ctx, 3)
+	require.NoError(t, err)
+	assert.Empty(t, subs)
+}
+
+func TestCheatAnalyticsRepo_GetCloseSolves(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	ua, ta := f.CreateUserWithTeam(t, "close_a")
+	ub, tb := f.CreateUserWithTeam(t, "close_b")
+	uc, tc := f.CreateUserWithTeam(t, "close_c")
+	challenge := f.CreateChallenge(t, "close_challenge", 100)
+	now := time.Now()
+
+	first := createCheatSubmission(t, f, ua, ta, challenge.ID, "flag{ok}", true, "", now)
+	copied := createCheatSubmission(t, f, ub, tb, challenge.ID, "flag{ok}", true, "", now.Add(3*time.Second))
+	createCheatSubmission(t, f, uc, tc, challenge.ID, "flag{ok}", true, "", now.Add(time.Minute))
+
+	pairs, err := f.CheatAnalyticsRepo.GetCloseSolves(ctx, 10*time.Second)
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	assert.Equal(t, copied.ID, pairs[0].Solve.ID)
+	assert.Equal(t, first.ID, pairs[0].Previous.ID)
+	assert.Equal(t, ta.ID, *pairs[0].Previous.TeamID)
+}
+
+func TestCheatAnalyticsRepo_GetSolvesWithoutDownload(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	ua, ta := f.CreateUserWithTeam(t, "dl_a")
+	ub, tb := f.CreateUserWithTeam(t, "dl_b")
+	challenge := f.CreateChallenge(t, "dl_challenge", 100)
+	noFiles := f.CreateChallenge(t, "dl_no_files", 100)
+	file := &entity.File{
+		Type:        entity.FileTypeChallenge,
+		ChallengeID: challenge.ID,
+		Location:    "challenges/dl.zip",
+		Filename:    "dl.zip",
+		Size:        1,
+		SHA256:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		CreatedAt:   time.Now().Add(-24 * time.Hour),
+	}
+	require.NoError(t, f.FileRepo.Create(ctx, file))
+	require.NoError(t, f.FileRepo.RecordDownload(ctx, &entity.FileDownload{FileID: file.ID, UserID: ua.ID, TeamID: &ta.ID}))
+
+	later := time.Now().Add(time.Hour)
+	createCheatSubmission(t, f, ua, ta, challenge.ID, "flag{ok}", true, "", later)
+	blind := createCheatSubmission(t, f, ub, tb, challenge.ID, "flag{ok}", true, "", later)
+	createCheatSubmission(t, f, ub, tb, noFiles.ID, "flag{ok}", true, "", later)
+
+	subs, err := f.CheatAnalyticsRepo.GetSolvesWithoutDownload(ctx)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.Equal(t, blind.ID, subs[0].ID)
+}
+
+func TestCheatIncidentRepo_FingerprintAndResolve(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	admin := f.CreateUser(t, "incident_admin")
+	_, team := f.CreateUserWithTeam(t, "incident_team")
+	subID := uuid.New()
+
+	newIncident := func() *entity.CheatIncident {
+		return &entity.CheatIncident{
+			Kind:        entity.CheatIncidentSharedIP,
+			TeamID:      team.ID,
+			Evidence:    entity.CheatEvidence{SubmissionIDs: []uuid.UUID{subID}, IP: "10.0.0.9"},
+			Fingerprint: "shared_ip:10.0.0.9:" + team.ID.String(),
+		}
+	}
+	incident := newIncident()
+	require.NoError(t, f.CheatIncidentRepo.Create(ctx, incident))
+	assert.Equal(t, entity.CheatIncidentOpen, incident.Status)
+	assert.ErrorIs(t, f.CheatIncidentRepo.Create(ctx, newIncident()), entityError.ErrCheatIncidentExists)
+
+	got, err := f.CheatIncidentRepo.GetByID(ctx, incident.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.ChallengeID)
+	assert.Empty(t, got.ChallengeTitle)
+	assert.Equal(t, incident.Evidence, got.Evidence)
+
+	require.NoError(t, f.CheatIncidentRepo.Resolve(ctx, incident.ID, entity.CheatIncidentDismissed, &admin.ID))
+	assert.ErrorIs(t, f.CheatIncidentRepo.Resolve(ctx, incident.ID, entity.CheatIncidentEscalated, nil), entityError.ErrCheatIncidentResolved)
+
+	got, err = f.CheatIncidentRepo.GetByID(ctx, incident.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.CheatIncidentDismissed, got.Status)
+	assert.Equal(t, admin.ID, *got.ResolvedBy)
+	assert.NotNil(t, got.ResolvedAt)
+
+	open := entity.CheatIncidentOpen
+	total, err := f.CheatIncidentRepo.CountAll(ctx, entity.CheatIncidentFilter{Status: &open})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+
+	_, err = f.CheatIncidentRepo.GetByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, entityError.ErrCheatIncidentNotFound)
+}
@@ -0,0 +1,65 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoyFlagRepo_CRUD(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "decoys_crud", 100)
+	decoy := &entity.DecoyFlag{ChallengeID: challenge.ID, FlagHash: "decoy-hash", IsCaseInsensitive: true, Note: "pastebin"}
+	require.NoError(t, f.DecoyFlagRepo.Create(ctx, decoy))
+	assert.NotEqual(t, uuid.Nil, decoy.ID)
+	assert.False(t, decoy.CreatedAt.IsZero())
+
+	err := f.DecoyFlagRepo.Create(ctx, &entity.DecoyFlag{ChallengeID: challenge.ID, FlagHash: "decoy-hash"})
+	assert.ErrorIs(t, err, entityError.ErrDecoyFlagExists)
+
+	decoys, err := f.DecoyFlagRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.Len(t, decoys, 1)
+	assert.Equal(t, "decoy-hash", decoys[0].FlagHash)
+	assert.Equal(t, "pastebin", decoys[0].Note)
+
+	got, err := f.DecoyFlagRepo.GetByID(ctx, decoy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, challenge.ID, got.ChallengeID)
+
+	require.NoError(t, f.DecoyFlagRepo.Delete(ctx, decoy.ID))
+	_, err = f.DecoyFlagRepo.GetByID(ctx, decoy.ID)
+	assert.ErrorIs(t, err, entityError.ErrDecoyFlagNotFound)
+	assert.ErrorIs(t, f.DecoyFlagRepo.Delete(ctx, decoy.ID), entityError.ErrDecoyFlagNotFound)
+}
+
+func TestCheatIncidentRepo_CountByTeamAndKind(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	_, team := f.CreateUserWithTeam(t, "decoy_counter")
+	challenge := f.CreateChallenge(t, "decoy_count", 100)
+	for _, fp := range []string{"decoy_flag:a", "decoy_flag:b"} {
+		require.NoError(t, f.CheatIncidentRepo.Create(ctx, &entity.CheatIncident{
+			Kind: entity.CheatIncidentDecoyFlag, ChallengeID: &challenge.ID, TeamID: team.ID, Fingerprint: fp,
+		}))
+	}
+	require.NoError(t, f.CheatIncidentRepo.Create(ctx, &entity.CheatIncident{
+		Kind: entity.CheatIncidentSharedIP, TeamID: team.ID, Fingerprint: "shared_ip:a",
+	}))
+
+	count, err := f.CheatIncidentRepo.CountByTeamAndKind(ctx, team.ID, entity.CheatIncidentDecoyFlag)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
@@ -14,64 +14,106 @@ import (
 )
 
 type TestFixture struct {
-	Pool                  *pgxpool.Pool
-	UserRepo              *persistent.UserRepo
-	TeamRepo              *persistent.TeamRepo
-	ChallengeRepo         *persistent.ChallengeRepo
-	SolveRepo             *persistent.SolveRepo
-	HintRepo              *persistent.HintRepo
-	HintUnlockRepo        *persistent.HintUnlockRepo
-	AwardRepo             *persistent.AwardRepo
-	TxRepo                *persistent.TxRepo
-	CompetitionRepo       *persistent.CompetitionRepo
-	VerificationTokenRepo *persistent.VerificationTokenRepo
-	FileRepo              *persistent.FileRepository
-	AuditLogRepo          *persistent.AuditLogRepo
-	StatisticsRepo        *persistent.StatisticsRepository
-	BackupRepo            *persistent.BackupRepo
-	AppSettingsRepo       *persistent.AppSettingsRepo
-	TagRepo               *persistent.TagRepo
-	CommentRepo           *persistent.CommentRepo
-	BracketRepo           *persistent.BracketRepo
-	ConfigRepo            *persistent.ConfigRepo
-	FieldRepo             *persistent.FieldRepo
-	FieldValueRepo        *persistent.FieldValueRepo
-	NotificationRepo      *persistent.NotificationRepo
-	PageRepo              *persistent.PageRepo
-	RatingRepo            *persistent.RatingRepo
-	SubmissionRepo        *persistent.SubmissionRepo
-	APITokenRepo          *persistent.APITokenRepo
+	Pool                     *pgxpool.Pool
+	UserRepo                 *persistent.UserRepo
+	TeamRepo                 *persistent.TeamRepo
+	ChallengeRepo            *persistent.ChallengeRepo
+	SolveRepo                *persistent.SolveRepo
+	HintRepo                 *persistent.HintRepo
+	ChallengeFlagRepo        *persistent.ChallengeFlagRepo
+	ChallengeRequirementRepo *persistent.ChallengeRequirementRepo
+	ChallengeScheduleRepo    *persistent.ChallengeScheduleRepo
+	TeamFlagRepo             *persistent.TeamFlagRepo
+	CheatIncidentRepo        *persistent.CheatIncidentRepo
+	DecoyFlagRepo            *persistent.DecoyFlagRepo
+	CheatAnalyticsRepo       *persistent.CheatAnalyticsRepo
+	InstanceRepo             *persistent.InstanceRepo
+	ReviewRepo               *persistent.ReviewRepo
+	RevisionRepo             *persistent.RevisionRepo
+	SpecRepo                 *persistent.ChallengeSpecRepo
+	StageRepo                *persistent.ChallengeStageRepo
+	ScoringRepo              *persistent.ChallengeScoringRepo
+	AttemptRepo              *persistent.AttemptLimitRepo
+	RescoreRepo              *persistent.RescoreRepo
+	HintUnlockRepo           *persistent.HintUnlockRepo
+	AwardRepo                *persistent.AwardRepo
+	TxRepo                   *persistent.TxRepo
+	CompetitionRepo          *persistent.CompetitionRepo
+	VerificationTokenRepo    *persistent.VerificationTokenRepo
+	FileRepo                 *persistent.FileRepository
+	AuditLogRepo             *persistent.AuditLogRepo
+	StatisticsRepo           *persistent.StatisticsRepository
+	BackupRepo               *persistent.BackupRepo
+	AppSettingsRepo          *persistent.AppSettingsRepo
+	TagRepo                  *persistent.TagRepo
+	CommentRepo              *persistent.CommentRepo
+	BracketRepo              *persistent.BracketRepo
+	ConfigRepo               *persistent.ConfigRepo
+	FieldRepo                *persistent.FieldRepo
+	FieldValueRepo           *persistent.FieldValueRepo
+	NotificationRepo         *persistent.NotificationRepo
+	PageRepo                 *persistent.PageRepo
+	RatingRepo               *persistent.RatingRepo
+	SubmissionRepo           *persistent.SubmissionRepo
+	APITokenRepo             *persistent.APITokenRepo
+	SessionRepo              *persistent.SessionRepo
+	TwoFactorRepo            *persistent.TwoFactorRepo
+	UserIdentityRepo         *persistent.UserIdentityRepo
+	RoleRepo                 *persistent.RoleRepo
+	ChallengeAuthorRepo      *persistent.ChallengeAuthorRepo
+	RegistrationRepo         *persistent.RegistrationRepo
 }
 
 func NewTestFixture(Pool *pgxpool.Pool) *TestFixture {
 	return &TestFixture{
-		Pool:                  Pool,
-		UserRepo:              persistent.NewUserRepo(Pool),
-		TeamRepo:              persistent.NewTeamRepo(Pool),
-		ChallengeRepo:         persistent.NewChallengeRepo(Pool),
-		SolveRepo:             persistent.NewSolveRepo(Pool),
-		HintRepo:              persistent.NewHintRepo(Pool),
-		HintUnlockRepo:        persistent.NewHintUnlockRepo(Pool),
-		AwardRepo:             persistent.NewAwardRepo(Pool),
-		TxRepo:                persistent.NewTxRepo(Pool),
-		CompetitionRepo:       persistent.NewCompetitionRepo(Pool),
-		VerificationTokenRepo: persistent.NewVerificationTokenRepo(Pool),
-		FileRepo:              persistent.NewFileRepository(Pool),
-		AuditLogRepo:          persistent.NewAuditLogRepo(Pool),
-		StatisticsRepo:        persistent.NewStatisticsRepository(Pool),
-		BackupRepo:            persistent.NewBackupRepo(Pool),
-		AppSettingsRepo:       persistent.NewAppSettingsRepo(Pool),
-		TagRepo:               persistent.NewTagRepo(Pool),
-		CommentRepo:           persistent.NewCommentRepo(Pool),
-		BracketRepo:           persistent.NewBracketRepo(Pool),
-		ConfigRepo:            persistent.NewConfigRepo(Pool),
-		FieldRepo:             persistent.NewFieldRepo(Pool),
-		FieldValueRepo:        persistent.NewFieldValueRepo(Pool),
-		NotificationRepo:      persistent.NewNotificationRepo(Pool),
-		PageRepo:              persistent.NewPageRepo(Pool),
-		RatingRepo:            persistent.NewRatingRepo(Pool),
-		SubmissionRepo:        persistent.NewSubmissionRepo(Pool),
-		APITokenRepo:          persistent.NewAPITokenRepo(Pool),
+		Pool:                     Pool,
+		UserRepo:                 persistent.NewUserRepo(Pool),
+		TeamRepo:                 persistent.NewTeamRepo(Pool),
+		ChallengeRepo:            persistent.NewChallengeRepo(Pool),
+		SolveRepo:                persistent.NewSolveRepo(Pool),
+		HintRepo:                 persistent.NewHintRepo(Pool),
+		ChallengeFlagRepo:        persistent.NewChallengeFlagRepo(Pool),
+		ChallengeRequirementRepo: persistent.NewChallengeRequirementRepo(Pool),
+		ChallengeScheduleRepo:    persistent.NewChallengeScheduleRepo(Pool),
+		TeamFlagRepo:             persistent.NewTeamFlagRepo(Pool),
+		CheatIncidentRepo:        persistent.NewCheatIncidentRepo(Pool),
+		DecoyFlagRepo:            persistent.NewDecoyFlagRepo(Pool),
+		CheatAnalyticsRepo:       persistent.NewCheatAnalyticsRepo(Pool),
+		InstanceRepo:             persistent.NewInstanceRepo(Pool),
+		ReviewRepo:               persistent.NewReviewRepo(Pool),
+		RevisionRepo:             persistent.NewRevisionRepo(Pool),
+		SpecRepo:                 persistent.NewChallengeSpecRepo(Pool),
+		StageRepo:                persistent.NewChallengeStageRepo(Pool),
+		ScoringRepo:              persistent.NewChallengeScoringRepo(Pool),
+		AttemptRepo:              persistent.NewAttemptLimitRepo(Pool),
+		RescoreRepo:              persistent.NewRescoreRepo(Pool),
+		HintUnlockRepo:           persistent.NewHintUnlockRepo(Pool),
+		AwardRepo:                persistent.NewAwardRepo(Pool),
+		TxRepo:                   persistent.NewTxRepo(Pool),
+		CompetitionRepo:          persistent.NewCompetitionRepo(Pool),
+		VerificationTokenRepo:    persistent.NewVerificationTokenRepo(Pool),
+		FileRepo:                 persistent.NewFileRepository(Pool),
+		AuditLogRepo:             persistent.NewAuditLogRepo(Pool),
+		StatisticsRepo:           persistent.NewStatisticsRepository(Pool),
+		BackupRepo:               persistent.NewBackupRepo(Pool),
+		AppSettingsRepo:          persistent.NewAppSettingsRepo(Pool),
+		TagRepo:                  persistent.NewTagRepo(Pool),
+		CommentRepo:              persistent.NewCommentRepo(Pool),
+		BracketRepo:              persistent.NewBracketRepo(Pool),
+		ConfigRepo:               persistent.NewConfigRepo(Pool),
+		FieldRepo:                persistent.NewFieldRepo(Pool),
+		FieldValueRepo:           persistent.NewFieldValueRepo(Pool),
+		NotificationRepo:         persistent.NewNotificationRepo(Pool),
+		PageRepo:                 persistent.NewPageRepo(Pool),
+		RatingRepo:               persistent.NewRatingRepo(Pool),
+		SubmissionRepo:           persistent.NewSubmissionRepo(Pool),
+		APITokenRepo:             persistent.NewAPITokenRepo(Pool),
+		SessionRepo:              persistent.NewSessionRepo(Pool),
+		TwoFactorRepo:            persistent.NewTwoFactorRepo(Pool),
+		UserIdentityRepo:         persistent.NewUserIdentityRepo(Pool),
+		RoleRepo:                 persistent.NewRoleRepo(Pool),
+		ChallengeAuthorRepo:      persistent.NewChallengeAuthorRepo(Pool),
+		RegistrationRepo:         persistent.NewRegistrationRepo(Pool),
 	}
 }
 
@@ -348,6 +390,7 @@ func (f *TestFixture) ResetAppSettings(t *testing.T) {
 			submit_limit_duration_min = 1,
 			scoreboard_visible = 'public',
 			registration_open = TRUE,
+			require_2fa_for_admins = FALSE,
 			updated_at = CURRENT_TIMESTAMP
 		WHERE id = 1
 	`)
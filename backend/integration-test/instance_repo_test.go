@@ -0,0 +1,139 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceRepo_ConfigUpsertGetDelete(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "instance_config_crud", 100)
+	_, err := f.InstanceRepo.GetConfig(ctx, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrInstanceNotConfigured)
+
+	cfg := &entity.InstanceConfig{ChallengeID: challenge.ID, Image: "pwn:1", TTLSeconds: 600}
+	require.NoError(t, f.InstanceRepo.UpsertConfig(ctx, cfg))
+	cfg.Image, cfg.TTLSeconds = "pwn:2", 900
+	require.NoError(t, f.InstanceRepo.UpsertConfig(ctx, cfg))
+
+	got, err := f.InstanceRepo.GetConfig(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pwn:2", got.Image)
+	assert.Equal(t, 900, got.TTLSeconds)
+	assert.False(t, got.CreatedAt.IsZero())
+
+	require.NoError(t, f.InstanceRepo.DeleteConfig(ctx, challenge.ID))
+	_, err = f.InstanceRepo.GetConfig(ctx, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrInstanceNotConfigured)
+}
+
+func TestInstanceRepo_CreateRespectsLimit(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	_, team := f.CreateUserWithTeam(t, "instance_limit")
+	first := f.CreateChallenge(t, "instance_limit_1", 100)
+	second := f.CreateChallenge(t, "instance_limit_2", 100)
+	expiresAt := time.Now().UTC().Add(time.Hour)
+
+	inst := &entity.ChallengeInstance{ChallengeID: first.ID, TeamID: team.ID, ExpiresAt: expiresAt}
+	require.NoError(t, f.InstanceRepo.Create(ctx, inst, 1))
+	assert.NotEqual(t, uuid.Nil, inst.ID)
+
+	err := f.InstanceRepo.Create(ctx, &entity.ChallengeInstance{ChallengeID: first.ID, TeamID: team.ID, ExpiresAt: expiresAt}, 5)
+	assert.ErrorIs(t, err, entityError.ErrInstanceLimitReached)
+
+	err = f.InstanceRepo.Create(ctx, &entity.ChallengeInstance{ChallengeID: second.ID, TeamID: team.ID, ExpiresAt: expiresAt}, 1)
+	assert.ErrorIs(t, err, entityError.ErrInstanceLimitReached)
+
+	require.NoError(t, f.InstanceRepo.Create(ctx, &entity.ChallengeInstance{ChallengeID: second.ID, TeamID: team.ID, ExpiresAt: expiresAt}, 2))
+}
+
+func TestInstanceRepo_UpdateAndExpire(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	_, team := f.CreateUserWithTeam(t, "instance_expire")
+	challenge := f.CreateChallenge(t, "instance_expire", 100)
+
+	inst := &entity.ChallengeInstance{ChallengeID: challenge.ID, TeamID: team.ID, ExpiresAt: time.Now().UTC().Add(-time.Minute)}
+	require.NoError(t, f.InstanceRepo.Create(ctx, inst, 1))
+	require.NoError(t, f.InstanceRepo.UpdateConnectionInfo(ctx, inst.ID, "localhost:30000"))
+
+	expired, err := f.InstanceRepo.GetExpired(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, "localhost:30000", expired[0].ConnectionInfo)
+
+	require.NoError(t, f.InstanceRepo.UpdateExpiry(ctx, inst.ID, time.Now().UTC().Add(time.Hour)))
+	expired, err = f.InstanceRepo.GetExpired(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+
+	got, err := f.InstanceRepo.GetByChallengeAndTeam(ctx, challenge.ID, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, inst.ID, got.ID)
+
+	require.NoError(t, f.InstanceRepo.Delete(ctx, inst.ID))
+	_, err = f.InstanceRepo.GetByChallengeAndTeam(ctx, challenge.ID, team.ID)
+	assert.ErrorIs(t, err, entityError.ErrInstanceNotFound)
+}
+
+func TestInstanceRepo_Create_ConcurrentDifferentChallenges(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	_, team := f.CreateUserWithTeam(t, "instance_race")
+	const starts, limit = 6, 2
+	challengeIDs := make([]uuid.UUID, starts)
+	for i := range challengeIDs {
+		challengeIDs[i] = f.CreateChallenge(t, fmt.Sprintf("instance_race_%d", i), 100).ID
+	}
+	expiresAt := time.Now().UTC().Add(time.Hour)
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, starts)
+	for _, challengeID := range challengeIDs {
+		wg.Add(1)
+		go func(challengeID uuid.UUID) {
+			defer wg.Done()
+			errCh <- f.InstanceRepo.Create(ctx, &entity.ChallengeInstance{ChallengeID: challengeID, TeamID: team.ID, ExpiresAt: expiresAt}, limit)
+		}(challengeID)
+	}
+	wg.Wait()
+	close(errCh)
+
+	created := 0
+	for err := range errCh {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.ErrorIs(t, err, entityError.ErrInstanceLimitReached)
+	}
+	assert.Equal(t, limit, created)
+
+	var count int
+	err := f.Pool.QueryRow(ctx, "SELECT count(*) FROM challenge_instances WHERE team_id = $1", team.ID).Scan(&count)
+	require.NoError(t, err)
+	assert.Equal(t, limit, count)
+}
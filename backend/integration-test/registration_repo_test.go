@@ -0,0 +1,67 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationRepo_ConsumeInvite_LimitsUses(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	maxUses := 1
+	invite := &entity.RegistrationInvite{Code: "one-shot", MaxUses: &maxUses}
+	require.NoError(t, f.RegistrationRepo.CreateInvite(ctx, invite))
+	assert.ErrorIs(t, f.RegistrationRepo.CreateInvite(ctx, &entity.RegistrationInvite{Code: "one-shot"}), entityError.ErrInviteCodeConflict)
+
+	consume := func() (*entity.RegistrationInvite, error) {
+		var got *entity.RegistrationInvite
+		err := f.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+			var err error
+			got, err = f.TxRepo.ConsumeRegistrationInviteTx(ctx, tx, "one-shot")
+			return err
+		})
+		return got, err
+	}
+
+	got, err := consume()
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.Uses)
+
+	_, err = consume()
+	assert.ErrorIs(t, err, entityError.ErrInvalidInviteCode)
+
+	invites, err := f.RegistrationRepo.GetInvites(ctx)
+	require.NoError(t, err)
+	require.Len(t, invites, 1)
+	require.NoError(t, f.RegistrationRepo.DeleteInvite(ctx, invite.ID))
+	assert.ErrorIs(t, f.RegistrationRepo.DeleteInvite(ctx, invite.ID), entityError.ErrInviteNotFound)
+}
+
+func TestRegistrationRepo_DomainRules_CRUD(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	rule := &entity.RegistrationDomainRule{Domain: "uni.edu", Action: entity.DomainRuleAllow}
+	require.NoError(t, f.RegistrationRepo.CreateDomainRule(ctx, rule))
+	err := f.RegistrationRepo.CreateDomainRule(ctx, &entity.RegistrationDomainRule{Domain: "uni.edu", Action: entity.DomainRuleDeny})
+	assert.ErrorIs(t, err, entityError.ErrDomainRuleConflict)
+
+	rules, err := f.RegistrationRepo.GetDomainRules(ctx)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, entity.DomainRuleAllow, rules[0].Action)
+
+	require.NoError(t, f.RegistrationRepo.DeleteDomainRule(ctx, rule.ID))
+	assert.ErrorIs(t, f.RegistrationRepo.DeleteDomainRule(ctx, rule.ID), entityError.ErrDomainRuleNotFound)
+}
@@ -0,0 +1,80 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/usecase/competition"
+	"github.com/skr1ms/CTFBoard/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRescoreUseCase(f *TestFixture) *competition.SolveUseCase {
+	db, _ := redismock.NewClientMock()
+	return competition.NewSolveUseCase(competition.SolveDeps{
+		SolveRepo: f.SolveRepo, ChallengeRepo: f.ChallengeRepo, CompetitionRepo: f.CompetitionRepo,
+		TxRepo: f.TxRepo, ScoringRepo: f.ScoringRepo, RescoreRepo: f.RescoreRepo,
+		Cache: cache.New(db), ScoreboardCache: nil, Broadcaster: nil,
+	})
+}
+
+func TestSolveUseCase_Rescore_RepairsDrift(t *testing.T) {
+	t.Helper()
+	pool := SetupTestPool(t)
+	f := NewTestFixture(pool.Pool)
+	ctx := context.Background()
+	uc := newRescoreUseCase(f)
+
+	admin := f.CreateUser(t, "rescore_admin")
+	u1, t1 := f.CreateUserWithTeam(t, "rescore_1")
+	u2, t2 := f.CreateUserWithTeam(t, "rescore_2")
+	u3, t3 := f.CreateUserWithTeam(t, "rescore_3")
+	challenge := f.CreateDynamicChallenge(t, "RescoreDecay", 500, 100, 10)
+	static := f.CreateChallenge(t, "RescoreStatic", 100)
+
+	// Solves written straight to the table skip the solve counter, like imported solves do.
+	f.CreateSolve(t, u1.ID, t1.ID, challenge.ID)
+	f.CreateSolve(t, u2.ID, t2.ID, challenge.ID)
+	f.CreateSolve(t, u3.ID, t3.ID, challenge.ID)
+	_, err := f.Pool.Exec(ctx, "UPDATE teams SET is_banned = true WHERE id = $1", t2.ID)
+	require.NoError(t, err)
+	f.BackdateTeamDeletedAt(t, t3.ID, time.Now())
+
+	report, err := uc.CheckConsistency(ctx, entity.RescoreOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Checked)
+	require.Len(t, report.Drifts, 1)
+	assert.Equal(t, challenge.ID, report.Drifts[0].ChallengeID)
+	assert.Equal(t, 0, report.Drifts[0].StoredSolveCount)
+	assert.Equal(t, 2, report.Drifts[0].SolveCount, "solves of deleted teams never count")
+	assert.Equal(t, competition.CalculateDynamicScore(500, 100, 10, 2), report.Drifts[0].Points)
+
+	opts := entity.RescoreOptions{ExcludeBanned: true}
+	report, err = uc.Rescore(ctx, opts, &admin.ID, "127.0.0.1")
+	require.NoError(t, err)
+	assert.True(t, report.Fixed)
+	require.Len(t, report.Drifts, 1)
+	assert.Equal(t, 1, report.Drifts[0].SolveCount)
+
+	fixed, err := f.ChallengeRepo.GetByID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fixed.SolveCount)
+	assert.Equal(t, competition.CalculateDynamicScore(500, 100, 10, 1), fixed.Points)
+
+	untouched, err := f.ChallengeRepo.GetByID(ctx, static.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 100, untouched.Points)
+
+	report, err = uc.CheckConsistency(ctx, opts)
+	require.NoError(t, err)
+	assert.Empty(t, report.Drifts)
+
+	var audited int
+	err = f.Pool.QueryRow(ctx, "SELECT count(*) FROM audit_logs WHERE action = 'rescore'").Scan(&audited)
+	require.NoError(t, err)
+	assert.Equal(t, 1, audited)
+}
@@ -0,0 +1,90 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewRepo_ConfigUpsertGetDelete(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "manual_review_config", 100)
+	_, err := f.ReviewRepo.GetConfig(ctx, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrManualReviewNotConfigured)
+
+	require.NoError(t, f.ReviewRepo.UpsertConfig(ctx, challenge.ID))
+	require.NoError(t, f.ReviewRepo.UpsertConfig(ctx, challenge.ID))
+	got, err := f.ReviewRepo.GetConfig(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, challenge.ID, got.ChallengeID)
+
+	require.NoError(t, f.ReviewRepo.DeleteConfig(ctx, challenge.ID))
+	_, err = f.ReviewRepo.GetConfig(ctx, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrManualReviewNotConfigured)
+}
+
+func TestReviewRepo_CreateResolve(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "review_submitter")
+	challenge := f.CreateChallenge(t, "review_queue", 100)
+
+	review := &entity.SubmissionReview{ChallengeID: challenge.ID, TeamID: team.ID, UserID: user.ID, Answer: "writeup"}
+	require.NoError(t, f.ReviewRepo.Create(ctx, review))
+	assert.NotEqual(t, uuid.Nil, review.ID)
+	assert.Equal(t, entity.ReviewStatusPending, review.Status)
+
+	duplicate := &entity.SubmissionReview{ChallengeID: challenge.ID, TeamID: team.ID, UserID: user.ID, Answer: "again"}
+	assert.ErrorIs(t, f.ReviewRepo.Create(ctx, duplicate), entityError.ErrReviewAlreadyPending)
+
+	pending := entity.ReviewStatusPending
+	items, err := f.ReviewRepo.GetAll(ctx, &pending, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, challenge.Title, items[0].ChallengeTitle)
+	assert.Equal(t, team.Name, items[0].TeamName)
+	assert.Equal(t, user.Username, items[0].Username)
+
+	points := 40
+	review.Status = entity.ReviewStatusApproved
+	review.Points = &points
+	review.Comment = "partial"
+	review.ReviewedBy = &user.ID
+	require.NoError(t, f.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		return f.ReviewRepo.ResolveTx(ctx, tx, review)
+	}))
+	err = f.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		return f.ReviewRepo.ResolveTx(ctx, tx, review)
+	})
+	assert.ErrorIs(t, err, entityError.ErrReviewAlreadyResolved)
+
+	got, err := f.ReviewRepo.GetByID(ctx, review.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ReviewStatusApproved, got.Status)
+	require.NotNil(t, got.Points)
+	assert.Equal(t, 40, *got.Points)
+	assert.Equal(t, "partial", got.Comment)
+	assert.NotNil(t, got.ReviewedAt)
+
+	total, err := f.ReviewRepo.CountAll(ctx, &pending)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+	total, err = f.ReviewRepo.CountAll(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	require.NoError(t, f.ReviewRepo.Create(ctx, duplicate))
+}
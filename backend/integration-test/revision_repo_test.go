@@ -0,0 +1,114 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevisionRepo_CreateListGet(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, _ := f.CreateUserWithTeam(t, "revision_author")
+	challenge := f.CreateChallenge(t, "revision_list", 100)
+	tag := f.CreateTag(t, "revision_list")
+	hint := f.CreateHint(t, challenge.ID, 10, 0)
+
+	err := f.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		_, err := f.RevisionRepo.GetLatestTx(ctx, tx, challenge.ID)
+		return err
+	})
+	assert.ErrorIs(t, err, entityError.ErrRevisionNotFound)
+
+	first := &entity.ChallengeRevision{
+		ChallengeID:   challenge.ID,
+		AuthorID:      &user.ID,
+		Snapshot:      *entity.NewChallengeSnapshot(challenge, []*entity.Tag{tag}, []*entity.Hint{hint}),
+		ChangedFields: []string{entity.RevisionFieldTitle},
+	}
+	second := &entity.ChallengeRevision{ChallengeID: challenge.ID, Snapshot: first.Snapshot}
+	second.Snapshot.Points = 300
+	require.NoError(t, f.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := f.RevisionRepo.CreateTx(ctx, tx, first); err != nil {
+			return err
+		}
+		return f.RevisionRepo.CreateTx(ctx, tx, second)
+	}))
+	assert.Equal(t, 1, first.Version)
+	assert.Equal(t, 2, second.Version)
+	assert.Empty(t, second.ChangedFields)
+
+	list, err := f.RevisionRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, 2, list[0].Version)
+	assert.Equal(t, user.Username, list[1].AuthorName)
+	assert.Equal(t, []uuid.UUID{tag.ID}, list[1].Snapshot.TagIDs)
+	assert.Equal(t, hint.ID, list[1].Snapshot.Hints[0].ID)
+
+	got, err := f.RevisionRepo.GetByVersion(ctx, challenge.ID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 300, got.Snapshot.Points)
+
+	_, err = f.RevisionRepo.GetByVersion(ctx, challenge.ID, 3)
+	assert.ErrorIs(t, err, entityError.ErrRevisionNotFound)
+}
+
+func TestRevisionRepo_RestoreTx(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "revision_restore", 100)
+	oldTag := f.CreateTag(t, "revision_restore_old")
+	newTag := f.CreateTag(t, "revision_restore_new")
+	require.NoError(t, f.TagRepo.SetChallengeTags(ctx, challenge.ID, []uuid.UUID{oldTag.ID}))
+	kept := f.CreateHint(t, challenge.ID, 10, 0)
+	removed := f.CreateHint(t, challenge.ID, 20, 1)
+
+	tags, err := f.TagRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	snapshot := entity.NewChallengeSnapshot(challenge, tags, []*entity.Hint{kept, removed})
+
+	challenge.Title = "renamed"
+	challenge.Points = 999
+	require.NoError(t, f.ChallengeRepo.Update(ctx, challenge))
+	require.NoError(t, f.TagRepo.SetChallengeTags(ctx, challenge.ID, []uuid.UUID{newTag.ID}))
+	require.NoError(t, f.HintRepo.Delete(ctx, removed.ID))
+	kept.Content = "edited"
+	require.NoError(t, f.HintRepo.Update(ctx, kept))
+	extra := f.CreateHint(t, challenge.ID, 30, 2)
+
+	require.NoError(t, f.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		return f.RevisionRepo.RestoreTx(ctx, tx, challenge.ID, snapshot)
+	}))
+
+	got, err := f.ChallengeRepo.GetByID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.Title, got.Title)
+	assert.Equal(t, 100, got.Points)
+
+	gotTags, err := f.TagRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.Len(t, gotTags, 1)
+	assert.Equal(t, oldTag.ID, gotTags[0].ID)
+
+	hints, err := f.HintRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	require.Len(t, hints, 2)
+	assert.Equal(t, kept.ID, hints[0].ID)
+	assert.Equal(t, "Hint content", hints[0].Content)
+	assert.Equal(t, removed.ID, hints[1].ID)
+	_, err = f.HintRepo.GetByID(ctx, extra.ID)
+	assert.ErrorIs(t, err, entityError.ErrHintNotFound)
+}
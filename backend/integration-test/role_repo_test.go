@@ -0,0 +1,105 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleRepo_CRUD_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	role := &entity.Role{Name: "reviewer", Description: "Reviews", Permissions: []string{entity.PermSubmissionsRead}}
+	require.NoError(t, f.RoleRepo.Create(ctx, role))
+	assert.False(t, role.CreatedAt.IsZero())
+
+	got, err := f.RoleRepo.GetByName(ctx, "reviewer")
+	require.NoError(t, err)
+	assert.Equal(t, []string{entity.PermSubmissionsRead}, got.Permissions)
+
+	got.Permissions = []string{entity.PermSubmissionsRead, entity.PermFlagsRead}
+	got.Description = "Reviews flags"
+	require.NoError(t, f.RoleRepo.Update(ctx, got))
+
+	list, err := f.RoleRepo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "Reviews flags", list[0].Description)
+	assert.Len(t, list[0].Permissions, 2)
+
+	require.NoError(t, f.RoleRepo.Delete(ctx, "reviewer"))
+	_, err = f.RoleRepo.GetByName(ctx, "reviewer")
+	assert.ErrorIs(t, err, entityError.ErrRoleNotFound)
+}
+
+func TestRoleRepo_Create_Duplicate(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	require.NoError(t, f.RoleRepo.Create(ctx, &entity.Role{Name: "reviewer"}))
+	err := f.RoleRepo.Create(ctx, &entity.Role{Name: "reviewer"})
+	assert.ErrorIs(t, err, entityError.ErrRoleAlreadyExists)
+}
+
+func TestRoleRepo_NotFound(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	err := f.RoleRepo.Update(ctx, &entity.Role{Name: "missing"})
+	assert.ErrorIs(t, err, entityError.ErrRoleNotFound)
+	err = f.RoleRepo.Delete(ctx, "missing")
+	assert.ErrorIs(t, err, entityError.ErrRoleNotFound)
+}
+
+func TestRoleRepo_Delete_ResetsUsers(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	require.NoError(t, f.RoleRepo.Create(ctx, &entity.Role{Name: "reviewer"}))
+	user := f.CreateUser(t, "role_reset")
+	require.NoError(t, f.UserRepo.UpdateRole(ctx, user.ID, "reviewer"))
+
+	got, err := f.UserRepo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "reviewer", got.Role)
+
+	require.NoError(t, f.RoleRepo.Delete(ctx, "reviewer"))
+	got, err = f.UserRepo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoleUser, got.Role)
+}
+
+func TestChallengeAuthorRepo_AddAndIsAuthor(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	author := f.CreateUser(t, "chal_author")
+	other := f.CreateUser(t, "chal_other")
+	challenge := f.CreateChallenge(t, "authored", 100)
+
+	require.NoError(t, f.ChallengeAuthorRepo.Add(ctx, challenge.ID, author.ID))
+	require.NoError(t, f.ChallengeAuthorRepo.Add(ctx, challenge.ID, author.ID))
+
+	ok, err := f.ChallengeAuthorRepo.IsAuthor(ctx, challenge.ID, author.ID)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = f.ChallengeAuthorRepo.IsAuthor(ctx, challenge.ID, other.ID)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,184 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSession(userID uuid.UUID, refreshJTI string) *entity.Session {
+	return &entity.Session{
+		UserID:     userID,
+		RefreshJTI: refreshJTI,
+		AccessJTI:  "access-" + refreshJTI,
+		IP:         "10.0.0.1",
+		UserAgent:  "integration-test",
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+}
+
+func TestSessionRepo_Create_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "session_create")
+	session := newTestSession(user.ID, "jti-1")
+	err := f.SessionRepo.Create(ctx, session)
+	require.NoError(t, err)
+
+	got, err := f.SessionRepo.GetByID(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.UserID)
+	assert.Equal(t, "jti-1", got.RefreshJTI)
+	assert.Equal(t, "access-jti-1", got.AccessJTI)
+	assert.Equal(t, "10.0.0.1", got.IP)
+	assert.Equal(t, "integration-test", got.UserAgent)
+	assert.False(t, got.LastSeenAt.IsZero())
+	assert.Nil(t, got.RevokedAt)
+}
+
+func TestSessionRepo_GetByID_NotFound(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+
+	_, err := f.SessionRepo.GetByID(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, entityError.ErrSessionNotFound)
+}
+
+func TestSessionRepo_GetActiveByUserID_SkipsRevokedAndExpired(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "session_active")
+	active := newTestSession(user.ID, "jti-1")
+	require.NoError(t, f.SessionRepo.Create(ctx, active))
+	revoked := newTestSession(user.ID, "jti-2")
+	require.NoError(t, f.SessionRepo.Create(ctx, revoked))
+	require.NoError(t, f.SessionRepo.Revoke(ctx, revoked.ID))
+	expired := newTestSession(user.ID, "jti-3")
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	require.NoError(t, f.SessionRepo.Create(ctx, expired))
+
+	list, err := f.SessionRepo.GetActiveByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, active.ID, list[0].ID)
+}
+
+func TestSessionRepo_Rotate_CompareAndSwap(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "session_rotate")
+	session := newTestSession(user.ID, "jti-1")
+	require.NoError(t, f.SessionRepo.Create(ctx, session))
+
+	ok, err := f.SessionRepo.Rotate(ctx, session.ID, "jti-1", "jti-2", "access-jti-2", time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = f.SessionRepo.Rotate(ctx, session.ID, "jti-1", "jti-3", "access-jti-3", time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	got, err := f.SessionRepo.GetByID(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "jti-2", got.RefreshJTI)
+	assert.Equal(t, "access-jti-2", got.AccessJTI)
+}
+
+func TestSessionRepo_Revoke_BlocksRotation(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "session_revoke")
+	session := newTestSession(user.ID, "jti-1")
+	require.NoError(t, f.SessionRepo.Create(ctx, session))
+	require.NoError(t, f.SessionRepo.Revoke(ctx, session.ID))
+
+	got, err := f.SessionRepo.GetByID(ctx, session.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, got.RevokedAt)
+
+	ok, err := f.SessionRepo.Rotate(ctx, session.ID, "jti-1", "jti-2", "access-jti-2", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestSessionRepo_Touch_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "session_touch")
+	session := newTestSession(user.ID, "jti-1")
+	require.NoError(t, f.SessionRepo.Create(ctx, session))
+
+	seen := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, f.SessionRepo.Touch(ctx, session.ID, seen))
+
+	got, err := f.SessionRepo.GetByID(ctx, session.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, seen, got.LastSeenAt, time.Second)
+}
+
+func TestSessionRepo_RevokeAllByUserID_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "session_revoke_user")
+	other := f.CreateUser(t, "session_revoke_other")
+	require.NoError(t, f.SessionRepo.Create(ctx, newTestSession(user.ID, "jti-1")))
+	require.NoError(t, f.SessionRepo.Create(ctx, newTestSession(user.ID, "jti-2")))
+	require.NoError(t, f.SessionRepo.Create(ctx, newTestSession(other.ID, "jti-3")))
+
+	n, err := f.SessionRepo.RevokeAllByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	list, err := f.SessionRepo.GetActiveByUserID(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+}
+
+func TestSessionRepo_RevokeAllByTeamID_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	captain, team := f.CreateUserWithTeam(t, "session_revoke_team")
+	member := f.CreateUser(t, "session_revoke_member")
+	f.AddUserToTeam(t, captain.ID, team.ID)
+	f.AddUserToTeam(t, member.ID, team.ID)
+	outsider := f.CreateUser(t, "session_revoke_outsider")
+	require.NoError(t, f.SessionRepo.Create(ctx, newTestSession(captain.ID, "jti-1")))
+	require.NoError(t, f.SessionRepo.Create(ctx, newTestSession(member.ID, "jti-2")))
+	require.NoError(t, f.SessionRepo.Create(ctx, newTestSession(outsider.ID, "jti-3")))
+
+	n, err := f.SessionRepo.RevokeAllByTeamID(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	list, err := f.SessionRepo.GetActiveByUserID(ctx, outsider.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+}
@@ -147,7 +147,14 @@ func truncateTables(t *testing.T, Pool *pgxpool.Pool) {
 		"team_ratings",
 		"global_ratings",
 		"field_values",
+		"challenge_authors",
+		"roles",
+		"api_token_requests",
 		"api_tokens",
+		"user_sessions",
+		"user_identities",
+		"user_recovery_codes",
+		"user_two_factor",
 		"tags",
 		"notifications",
 		"fields",
@@ -159,6 +166,7 @@ func truncateTables(t *testing.T, Pool *pgxpool.Pool) {
 		"awards",
 		"solves",
 		"hints",
+		"file_downloads",
 		"files",
 		"challenges",
 		"verification_tokens",
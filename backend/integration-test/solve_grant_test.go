@@ -0,0 +1,91 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/usecase/competition"
+	"github.com/skr1ms/CTFBoard/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGrantSolveUseCase(f *TestFixture) *competition.SolveUseCase {
+	db, _ := redismock.NewClientMock()
+	return competition.NewSolveUseCase(competition.SolveDeps{
+		SolveRepo: f.SolveRepo, ChallengeRepo: f.ChallengeRepo, CompetitionRepo: f.CompetitionRepo,
+		UserRepo: f.UserRepo, TeamRepo: f.TeamRepo, TxRepo: f.TxRepo,
+		Cache: cache.New(db), ScoreboardCache: nil, Broadcaster: nil,
+	})
+}
+
+func TestSolveUseCase_GrantRevoke_KeepsChallengeConsistent(t *testing.T) {
+	t.Helper()
+	pool := SetupTestPool(t)
+	f := NewTestFixture(pool.Pool)
+	ctx := context.Background()
+	uc := newGrantSolveUseCase(f)
+
+	admin := f.CreateUser(t, "grant_admin")
+	_, early := f.CreateUserWithTeam(t, "grant_early")
+	_, late := f.CreateUserWithTeam(t, "grant_late")
+	challenge := f.CreateDynamicChallenge(t, "GrantDecay", 500, 100, 10)
+
+	firstBlood, err := uc.Grant(ctx, &entity.Solve{TeamID: late.ID, ChallengeID: challenge.ID}, admin.ID, "127.0.0.1")
+	require.NoError(t, err)
+	assert.True(t, firstBlood)
+
+	backdated := &entity.Solve{TeamID: early.ID, ChallengeID: challenge.ID, SolvedAt: time.Now().Add(-time.Hour)}
+	firstBlood, err = uc.Grant(ctx, backdated, admin.ID, "127.0.0.1")
+	require.NoError(t, err)
+	assert.True(t, firstBlood, "a backdated grant takes first blood")
+	assert.Equal(t, early.CaptainID, backdated.UserID)
+
+	_, err = uc.Grant(ctx, &entity.Solve{TeamID: early.ID, ChallengeID: challenge.ID}, admin.ID, "127.0.0.1")
+	assert.ErrorIs(t, err, entityError.ErrAlreadySolved)
+
+	decayed, err := f.ChallengeRepo.GetByID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, decayed.SolveCount)
+	assert.Less(t, decayed.Points, 500)
+
+	require.NoError(t, uc.Revoke(ctx, challenge.ID, early.ID, admin.ID, "127.0.0.1"))
+	assert.ErrorIs(t, uc.Revoke(ctx, challenge.ID, early.ID, admin.ID, "127.0.0.1"), entityError.ErrSolveNotFound)
+
+	recovered, err := f.ChallengeRepo.GetByID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, recovered.SolveCount)
+	assert.Equal(t, 500, recovered.Points)
+
+	entry, err := uc.GetFirstBlood(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, late.ID, entry.TeamID)
+
+	var audited int
+	err = f.Pool.QueryRow(ctx, "SELECT count(*) FROM audit_logs WHERE entity_id = $1 AND action IN ('grant_solve', 'revoke_solve')", challenge.ID.String()).Scan(&audited)
+	require.NoError(t, err)
+	assert.Equal(t, 3, audited)
+}
+
+func TestSolveUseCase_Grant_SolverNotInTeam(t *testing.T) {
+	t.Helper()
+	pool := SetupTestPool(t)
+	f := NewTestFixture(pool.Pool)
+	ctx := context.Background()
+	uc := newGrantSolveUseCase(f)
+
+	outsider, _ := f.CreateUserWithTeam(t, "grant_outsider")
+	_, team := f.CreateUserWithTeam(t, "grant_team")
+	challenge := f.CreateChallenge(t, "GrantOutsider", 100)
+
+	_, err := uc.Grant(ctx, &entity.Solve{TeamID: team.ID, UserID: outsider.ID, ChallengeID: challenge.ID}, uuid.New(), "127.0.0.1")
+	assert.ErrorIs(t, err, entityError.ErrSolverNotInTeam)
+
+	_, err = f.SolveRepo.GetByTeamAndChallenge(ctx, team.ID, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrSolveNotFound)
+}
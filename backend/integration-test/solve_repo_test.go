@@ -238,6 +238,78 @@ func TestSolveRepo_GetScoreboard_Success(t *testing.T) {
 	assert.True(t, t2Found)
 }
 
+func TestSolveRepo_GetScoreboard_RevaluesPastSolves(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "score_revalue")
+	challenge := f.CreateChallenge(t, "score_revalue", 500)
+	f.CreateSolve(t, user.ID, team.ID, challenge.ID)
+
+	require.NoError(t, f.ChallengeRepo.UpdatePoints(ctx, challenge.ID, 300))
+
+	scoreboard, err := f.SolveRepo.GetScoreboard(ctx)
+	require.NoError(t, err)
+	require.Len(t, scoreboard, 1)
+	assert.Equal(t, 300, scoreboard[0].Points)
+}
+
+func TestSolveRepo_GetScoreboard_PinnedPoints(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	u1, t1 := f.CreateUserWithTeam(t, "pinned_early")
+	u2, t2 := f.CreateUserWithTeam(t, "pinned_late")
+	challenge := f.CreateChallenge(t, "pinned", 500)
+	early, late := 400, 200
+	require.NoError(t, f.SolveRepo.Create(ctx, &entity.Solve{UserID: u1.ID, TeamID: t1.ID, ChallengeID: challenge.ID, Points: &early}))
+	require.NoError(t, f.SolveRepo.Create(ctx, &entity.Solve{UserID: u2.ID, TeamID: t2.ID, ChallengeID: challenge.ID, Points: &late}))
+
+	require.NoError(t, f.ChallengeRepo.UpdatePoints(ctx, challenge.ID, 100))
+
+	scoreboard, err := f.SolveRepo.GetScoreboard(ctx)
+	require.NoError(t, err)
+	points := make(map[uuid.UUID]int, len(scoreboard))
+	for _, entry := range scoreboard {
+		points[entry.TeamID] = entry.Points
+	}
+	assert.Equal(t, 400, points[t1.ID])
+	assert.Equal(t, 200, points[t2.ID])
+
+	score, err := f.SolveRepo.GetTeamScore(ctx, t1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 400, score)
+}
+
+func TestSolveRepo_PinPoints_KeepsPinnedSolves(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	u1, t1 := f.CreateUserWithTeam(t, "pin_open")
+	u2, t2 := f.CreateUserWithTeam(t, "pin_fixed")
+	challenge := f.CreateChallenge(t, "pin", 500)
+	fixed := 200
+	f.CreateSolve(t, u1.ID, t1.ID, challenge.ID)
+	require.NoError(t, f.SolveRepo.Create(ctx, &entity.Solve{UserID: u2.ID, TeamID: t2.ID, ChallengeID: challenge.ID, Points: &fixed}))
+
+	require.NoError(t, f.SolveRepo.PinPoints(ctx, challenge.ID, 450))
+
+	open, err := f.SolveRepo.GetByTeamAndChallenge(ctx, t1.ID, challenge.ID)
+	require.NoError(t, err)
+	require.NotNil(t, open.Points)
+	assert.Equal(t, 450, *open.Points)
+	pinned, err := f.SolveRepo.GetByTeamAndChallenge(ctx, t2.ID, challenge.ID)
+	require.NoError(t, err)
+	require.NotNil(t, pinned.Points)
+	assert.Equal(t, 200, *pinned.Points)
+}
+
 func TestSolveRepo_GetScoreboard_Error_CancelledContext(t *testing.T) {
 	t.Helper()
 	testPool := SetupTestPool(t)
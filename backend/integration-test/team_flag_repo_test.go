@@ -0,0 +1,73 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamFlagRepo_UpsertGetDelete(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	challenge := f.CreateChallenge(t, "team_flag_crud", 100)
+	_, err := f.TeamFlagRepo.GetByChallengeID(ctx, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrTeamFlagNotFound)
+
+	cfg := &entity.TeamFlagConfig{ChallengeID: challenge.ID, Template: "CTF{a_<hmac8>}", Secret: "secret"}
+	require.NoError(t, f.TeamFlagRepo.Upsert(ctx, cfg))
+	cfg.Template = "CTF{b_<hmac8>}"
+	require.NoError(t, f.TeamFlagRepo.Upsert(ctx, cfg))
+
+	got, err := f.TeamFlagRepo.GetByChallengeID(ctx, challenge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "CTF{b_<hmac8>}", got.Template)
+	assert.Equal(t, "secret", got.Secret)
+	assert.False(t, got.CreatedAt.IsZero())
+
+	require.NoError(t, f.TeamFlagRepo.Delete(ctx, challenge.ID))
+	_, err = f.TeamFlagRepo.GetByChallengeID(ctx, challenge.ID)
+	assert.ErrorIs(t, err, entityError.ErrTeamFlagNotFound)
+}
+
+func TestCheatIncidentRepo_CreateGetAll(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "cheat_copier")
+	_, source := f.CreateUserWithTeam(t, "cheat_source")
+	challenge := f.CreateChallenge(t, "cheat_incident", 100)
+
+	incident := &entity.CheatIncident{
+		Kind:         entity.CheatIncidentSharedFlag,
+		ChallengeID:  &challenge.ID,
+		TeamID:       team.ID,
+		UserID:       &user.ID,
+		SourceTeamID: &source.ID,
+	}
+	require.NoError(t, f.CheatIncidentRepo.Create(ctx, incident))
+	assert.NotEqual(t, uuid.Nil, incident.ID)
+
+	items, err := f.CheatIncidentRepo.GetAll(ctx, entity.CheatIncidentFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, entity.CheatIncidentSharedFlag, items[0].Kind)
+	assert.Equal(t, entity.CheatIncidentOpen, items[0].Status)
+	assert.Equal(t, team.Name, items[0].TeamName)
+	assert.Equal(t, source.Name, items[0].SourceTeamName)
+	assert.Equal(t, user.Username, items[0].Username)
+	assert.Equal(t, challenge.Title, items[0].ChallengeTitle)
+
+	total, err := f.CheatIncidentRepo.CountAll(ctx, entity.CheatIncidentFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+}
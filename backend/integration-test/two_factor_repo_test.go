@@ -0,0 +1,119 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoFactorRepo_UpsertAndEnable_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "2fa_enable")
+	err := f.TwoFactorRepo.Upsert(ctx, &entity.TwoFactor{UserID: user.ID, SecretEncrypted: "enc-1"})
+	require.NoError(t, err)
+
+	got, err := f.TwoFactorRepo.GetByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "enc-1", got.SecretEncrypted)
+	assert.False(t, got.IsEnabled())
+
+	err = f.TwoFactorRepo.Enable(ctx, user.ID, 100, []string{"h1", "h2", "h3"})
+	require.NoError(t, err)
+
+	got, err = f.TwoFactorRepo.GetByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.True(t, got.IsEnabled())
+	assert.Equal(t, int64(100), got.LastUsedStep)
+
+	n, err := f.TwoFactorRepo.CountRecoveryCodes(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
+
+func TestTwoFactorRepo_GetByUserID_NotEnrolled(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+
+	user := f.CreateUser(t, "2fa_none")
+	_, err := f.TwoFactorRepo.GetByUserID(context.Background(), user.ID)
+	assert.ErrorIs(t, err, entityError.ErrTwoFactorNotEnrolled)
+}
+
+func TestTwoFactorRepo_ConsumeStep_RejectsReplay(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "2fa_step")
+	require.NoError(t, f.TwoFactorRepo.Upsert(ctx, &entity.TwoFactor{UserID: user.ID, SecretEncrypted: "enc"}))
+	require.NoError(t, f.TwoFactorRepo.Enable(ctx, user.ID, 10, nil))
+
+	ok, err := f.TwoFactorRepo.ConsumeStep(ctx, user.ID, 11)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = f.TwoFactorRepo.ConsumeStep(ctx, user.ID, 11)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = f.TwoFactorRepo.ConsumeStep(ctx, user.ID, 10)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestTwoFactorRepo_UseRecoveryCode_SingleUse(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "2fa_recovery")
+	require.NoError(t, f.TwoFactorRepo.Upsert(ctx, &entity.TwoFactor{UserID: user.ID, SecretEncrypted: "enc"}))
+	require.NoError(t, f.TwoFactorRepo.Enable(ctx, user.ID, 1, []string{"h1", "h2"}))
+
+	used, err := f.TwoFactorRepo.UseRecoveryCode(ctx, user.ID, "h1")
+	require.NoError(t, err)
+	assert.True(t, used)
+
+	used, err = f.TwoFactorRepo.UseRecoveryCode(ctx, user.ID, "h1")
+	require.NoError(t, err)
+	assert.False(t, used)
+
+	n, err := f.TwoFactorRepo.CountRecoveryCodes(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	require.NoError(t, f.TwoFactorRepo.ReplaceRecoveryCodes(ctx, user.ID, []string{"h3", "h4", "h5"}))
+	n, err = f.TwoFactorRepo.CountRecoveryCodes(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
+
+func TestTwoFactorRepo_Delete_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "2fa_delete")
+	require.NoError(t, f.TwoFactorRepo.Upsert(ctx, &entity.TwoFactor{UserID: user.ID, SecretEncrypted: "enc"}))
+	require.NoError(t, f.TwoFactorRepo.Enable(ctx, user.ID, 1, []string{"h1"}))
+
+	require.NoError(t, f.TwoFactorRepo.Delete(ctx, user.ID))
+
+	_, err := f.TwoFactorRepo.GetByUserID(ctx, user.ID)
+	assert.ErrorIs(t, err, entityError.ErrTwoFactorNotEnrolled)
+	n, err := f.TwoFactorRepo.CountRecoveryCodes(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
@@ -0,0 +1,67 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserIdentityRepo_CreateAndGet_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "identity_get")
+	identity := &entity.UserIdentity{UserID: user.ID, Provider: "uni", Subject: "sub-1", Email: "a@uni.edu"}
+	require.NoError(t, f.UserIdentityRepo.Create(ctx, identity))
+
+	got, err := f.UserIdentityRepo.GetByProviderSubject(ctx, "uni", "sub-1")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.UserID)
+	assert.Equal(t, "a@uni.edu", got.Email)
+	assert.Nil(t, got.LastLoginAt)
+
+	require.NoError(t, f.UserIdentityRepo.TouchLogin(ctx, identity.ID, time.Now()))
+	list, err := f.UserIdentityRepo.GetByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.NotNil(t, list[0].LastLoginAt)
+}
+
+func TestUserIdentityRepo_Create_Duplicate(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	alice := f.CreateUser(t, "identity_dup_a")
+	bob := f.CreateUser(t, "identity_dup_b")
+	require.NoError(t, f.UserIdentityRepo.Create(ctx, &entity.UserIdentity{UserID: alice.ID, Provider: "uni", Subject: "sub-1"}))
+
+	err := f.UserIdentityRepo.Create(ctx, &entity.UserIdentity{UserID: bob.ID, Provider: "uni", Subject: "sub-1"})
+	assert.ErrorIs(t, err, entityError.ErrIdentityAlreadyLinked)
+
+	err = f.UserIdentityRepo.Create(ctx, &entity.UserIdentity{UserID: alice.ID, Provider: "uni", Subject: "sub-2"})
+	assert.ErrorIs(t, err, entityError.ErrIdentityAlreadyLinked)
+}
+
+func TestUserIdentityRepo_Delete(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "identity_delete")
+	require.NoError(t, f.UserIdentityRepo.Create(ctx, &entity.UserIdentity{UserID: user.ID, Provider: "uni", Subject: "sub-1"}))
+
+	require.NoError(t, f.UserIdentityRepo.Delete(ctx, user.ID, "uni"))
+	assert.ErrorIs(t, f.UserIdentityRepo.Delete(ctx, user.ID, "uni"), entityError.ErrIdentityNotFound)
+	_, err := f.UserIdentityRepo.GetByProviderSubject(ctx, "uni", "sub-1")
+	assert.ErrorIs(t, err, entityError.ErrIdentityNotFound)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
 	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/internal/repo/persistent"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -273,3 +274,138 @@ func TestUserRepo_Role_Persistence(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, entity.RoleAdmin, gotUser.Role)
 }
+
+func TestUserRepo_ListAndCount_Filters(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	alice, team := f.CreateUserWithTeam(t, "alice_list")
+	f.CreateUser(t, "bob_list")
+	banned := f.CreateUser(t, "carol_list")
+	err := f.TxRepo.RunTransaction(ctx, func(txCtx context.Context, tx repo.Transaction) error {
+		return f.TxRepo.BanUserTx(txCtx, tx, banned.ID, "spam")
+	})
+	require.NoError(t, err)
+
+	users, err := f.UserRepo.List(ctx, &entity.UserFilter{Search: "ALICE"}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, alice.ID, users[0].ID)
+
+	isBanned := true
+	users, err = f.UserRepo.List(ctx, &entity.UserFilter{Banned: &isBanned}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.True(t, users[0].IsBanned)
+	require.NotNil(t, users[0].BannedReason)
+	assert.Equal(t, "spam", *users[0].BannedReason)
+
+	total, err := f.UserRepo.Count(ctx, &entity.UserFilter{TeamID: &team.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	total, err = f.UserRepo.Count(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+
+	users, err = f.UserRepo.List(ctx, nil, 2, 2)
+	require.NoError(t, err)
+	assert.Len(t, users, 1)
+}
+
+func TestUserRepo_AdminTx_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "admin_tx")
+	err := f.TxRepo.RunTransaction(ctx, func(txCtx context.Context, tx repo.Transaction) error {
+		user.Username = "admin_tx_renamed"
+		user.IsVerified = true
+		if err := f.TxRepo.UpdateUserTx(txCtx, tx, user); err != nil {
+			return err
+		}
+		if err := f.TxRepo.BanUserTx(txCtx, tx, user.ID, "cheating"); err != nil {
+			return err
+		}
+		return f.TxRepo.UnbanUserTx(txCtx, tx, user.ID)
+	})
+	require.NoError(t, err)
+
+	got, err := f.UserRepo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "admin_tx_renamed", got.Username)
+	assert.True(t, got.IsVerified)
+	assert.False(t, got.IsBanned)
+	assert.Nil(t, got.BannedReason)
+
+	err = f.TxRepo.RunTransaction(ctx, func(txCtx context.Context, tx repo.Transaction) error {
+		return f.TxRepo.DeleteUserTx(txCtx, tx, user.ID)
+	})
+	require.NoError(t, err)
+
+	_, err = f.UserRepo.GetByID(ctx, user.ID)
+	assert.ErrorIs(t, err, entityError.ErrUserNotFound)
+
+	err = f.TxRepo.RunTransaction(ctx, func(txCtx context.Context, tx repo.Transaction) error {
+		return f.TxRepo.DeleteUserTx(txCtx, tx, user.ID)
+	})
+	assert.ErrorIs(t, err, entityError.ErrUserNotFound)
+}
+
+func TestUserRepo_UpdateEmail_Success(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "email_change")
+	other := f.CreateUser(t, "email_taken")
+
+	require.NoError(t, f.UserRepo.UpdateEmail(ctx, user.ID, "email_change_new@x.com"))
+	got, err := f.UserRepo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "email_change_new@x.com", got.Email)
+	assert.True(t, got.IsVerified)
+
+	err = f.UserRepo.UpdateEmail(ctx, user.ID, other.Email)
+	assert.ErrorIs(t, err, entityError.ErrUserAlreadyExists)
+}
+
+func TestUserRepo_AnonymizeUserTx_KeepsSolves(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user, team := f.CreateUserWithTeam(t, "anon")
+	challenge := f.CreateChallenge(t, "anon_chal", 100)
+	f.CreateSolve(t, user.ID, team.ID, challenge.ID)
+	require.NoError(t, f.UserIdentityRepo.Create(ctx, &entity.UserIdentity{UserID: user.ID, Provider: "uni", Subject: "anon-sub"}))
+	require.NoError(t, f.APITokenRepo.Create(ctx, &entity.APIToken{UserID: &user.ID, TokenHash: "hash_" + uuid.New().String()}))
+
+	err := f.TxRepo.RunTransaction(ctx, func(txCtx context.Context, tx repo.Transaction) error {
+		return f.TxRepo.AnonymizeUserTx(txCtx, tx, user.ID, "deleted_anon", "deleted_anon@invalid")
+	})
+	require.NoError(t, err)
+
+	got, err := f.UserRepo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "deleted_anon", got.Username)
+	assert.Equal(t, "deleted_anon@invalid", got.Email)
+	assert.Empty(t, got.PasswordHash)
+
+	identities, err := f.UserIdentityRepo.GetByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, identities)
+	tokens, err := f.APITokenRepo.GetByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	solves, err := f.SolveRepo.GetByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Len(t, solves, 1)
+}
@@ -102,3 +102,27 @@ func TestVerificationTokenRepo_MarkUsed(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, fetchedUsed.UsedAt)
 }
+
+func TestVerificationTokenRepo_EmailChange_KeepsNewEmail(t *testing.T) {
+	t.Helper()
+	testPool := SetupTestPool(t)
+	f := NewTestFixture(testPool.Pool)
+	ctx := context.Background()
+
+	user := f.CreateUser(t, "vt_change")
+	newEmail := "vt_change_new@x.com"
+	token := &entity.VerificationToken{
+		UserID:    user.ID,
+		Token:     "email_change_token",
+		Type:      entity.TokenTypeEmailChange,
+		ExpiresAt: time.Now().Add(time.Hour),
+		NewEmail:  &newEmail,
+	}
+	require.NoError(t, f.VerificationTokenRepo.Create(ctx, token))
+
+	fetched, err := f.VerificationTokenRepo.GetByToken(ctx, token.Token)
+	require.NoError(t, err)
+	assert.Equal(t, entity.TokenTypeEmailChange, fetched.Type)
+	require.NotNil(t, fetched.NewEmail)
+	assert.Equal(t, newEmail, *fetched.NewEmail)
+}
@@ -3,12 +3,14 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/skr1ms/CTFBoard/config"
+	"github.com/skr1ms/CTFBoard/internal/instance"
 	"github.com/skr1ms/CTFBoard/internal/storage"
 	"github.com/skr1ms/CTFBoard/internal/wire"
 	"github.com/skr1ms/CTFBoard/pkg/cache"
@@ -64,6 +66,12 @@ func Run(cfg *config.Config, l logger.Logger) {
 		}()
 	}
 
+	instanceProvider, err := provideInstances(cfg, l)
+	if err != nil {
+		l.WithError(err).Error("failed to create instance provider")
+		return
+	}
+
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
@@ -77,12 +85,15 @@ func Run(cfg *config.Config, l logger.Logger) {
 	asyncMailer.Start()
 	defer asyncMailer.Stop()
 
-	app, err := wire.InitializeApp(cfg, l, pool, redisClient, storageProvider, jwtService, wsHub, asyncMailer)
+	app, err := wire.InitializeApp(cfg, l, pool, redisClient, storageProvider, instanceProvider, jwtService, wsHub, asyncMailer)
 	if err != nil {
 		l.WithError(err).Error("failed to initialize app")
 		return
 	}
 
+	go app.InstanceUC.RunReaper(ctx, cfg.InstanceReapInterval)
+	go app.ChallengeUC.RunScoringRefresh(ctx, cfg.ScoringRefreshInterval)
+
 	runSeed(cfg, app, l)
 	runServerUntilShutdown(ctx, app.Server, cfg.HTTP.Port, l)
 }
@@ -146,3 +157,18 @@ func provideStorage(cfg *config.Config, l logger.Logger) (storage.Provider, erro
 	l.Info("Using filesystem storage provider", map[string]any{"path": cfg.LocalPath})
 	return fsProvider, nil
 }
+
+// provideInstances returns nil when INSTANCE_PROVIDER is empty, which disables per-team
+// challenge instances.
+func provideInstances(cfg *config.Config, l logger.Logger) (instance.Provider, error) {
+	switch cfg.InstanceProvider {
+	case "":
+		l.Info("Challenge instances disabled")
+		return nil, nil
+	case "fake":
+		l.Info("Using fake instance provider", map[string]any{"host": cfg.InstanceFakeHost})
+		return instance.NewFakeProvider(cfg.InstanceFakeHost), nil
+	default:
+		return nil, fmt.Errorf("unknown instance provider %q", cfg.InstanceProvider)
+	}
+}
@@ -0,0 +1,201 @@
+package challengespec
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+)
+
+// ErrNoSpecs is returned for archives without a single challenge.yml.
+var ErrNoSpecs = errors.New("challengespec: no challenge.yml found")
+
+// Entry is one challenge.yml of a bundle. Dir is its directory inside the bundle, empty at the
+// root.
+type Entry struct {
+	Path string
+	Dir  string
+	Spec *Spec
+}
+
+// Bundle is an uploaded set of challenge specs and the files next to them.
+type Bundle struct {
+	Entries []*Entry
+	files   map[string]*zip.File
+}
+
+// Attachment returns the archive member a files entry of e refers to.
+func (b *Bundle) Attachment(e *Entry, name string) (*zip.File, bool) {
+	p, ok := FilePath(e.Dir, name)
+	if !ok {
+		return nil, false
+	}
+	f, ok := b.files[p]
+	return f, ok
+}
+
+// ReadBundle reads either a zip archive of challenge directories or a single challenge.yml.
+// Entries come back sorted by path. Specs that fail to parse or validate, files entries
+// missing from the archive and slugs used twice are reported as problems rather than errors.
+func ReadBundle(r io.ReaderAt, size int64) (*Bundle, []entity.SpecProblem, error) {
+	zr, err := zip.NewReader(r, size)
+	if errors.Is(err, zip.ErrFormat) {
+		return readSingle(r, size)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := &Bundle{files: make(map[string]*zip.File, len(zr.File))}
+	var specFiles []*zip.File
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || strings.HasPrefix(f.Name, "__MACOSX/") {
+			continue
+		}
+		name := path.Clean(f.Name)
+		b.files[name] = f
+		if base := path.Base(name); base == FileName || base == "challenge.yaml" {
+			specFiles = append(specFiles, f)
+		}
+	}
+	if len(specFiles) == 0 {
+		return nil, nil, ErrNoSpecs
+	}
+	sort.Slice(specFiles, func(i, j int) bool { return specFiles[i].Name < specFiles[j].Name })
+
+	var problems []entity.SpecProblem
+	for _, f := range specFiles {
+		name := path.Clean(f.Name)
+		data, err := readZipFile(f)
+		if err != nil {
+			return nil, nil, fmt.Errorf("challengespec - ReadBundle - read %s: %w", name, err)
+		}
+		dir := path.Dir(name)
+		if dir == "." {
+			dir = ""
+		}
+		entry, entryProblems := parseEntry(name, dir, data)
+		problems = append(problems, entryProblems...)
+		if entry != nil {
+			b.Entries = append(b.Entries, entry)
+		}
+	}
+	for _, e := range b.Entries {
+		for _, name := range e.Spec.Files {
+			if _, ok := b.Attachment(e, name); !ok {
+				problems = append(problems, entity.SpecProblem{Path: e.Path, Message: fmt.Sprintf("file %q is not in the archive", name)})
+			}
+		}
+	}
+	problems = append(problems, duplicateSlugs(b.Entries)...)
+	return b, problems, nil
+}
+
+func readSingle(r io.ReaderAt, size int64) (*Bundle, []entity.SpecProblem, error) {
+	data, err := io.ReadAll(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return nil, nil, fmt.Errorf("challengespec - ReadBundle - read: %w", err)
+	}
+	b := &Bundle{}
+	entry, problems := parseEntry(FileName, "", data)
+	if entry != nil {
+		b.Entries = append(b.Entries, entry)
+		for _, name := range entry.Spec.Files {
+			problems = append(problems, entity.SpecProblem{Path: entry.Path, Message: fmt.Sprintf("file %q needs a zip archive", name)})
+		}
+	}
+	return b, problems, nil
+}
+
+// parseEntry returns a nil entry when the spec cannot be parsed at all; a spec that parses
+// but fails validation still yields an entry so later checks can refer to it.
+func parseEntry(name, dir string, data []byte) (*Entry, []entity.SpecProblem) {
+	spec, err := Parse(data)
+	if err != nil {
+		return nil, []entity.SpecProblem{{Path: name, Message: fmt.Sprintf("invalid yaml: %v", err)}}
+	}
+	var problems []entity.SpecProblem
+	for _, msg := range spec.Validate() {
+		problems = append(problems, entity.SpecProblem{Path: name, Message: msg})
+	}
+	return &Entry{Path: name, Dir: dir, Spec: spec}, problems
+}
+
+func duplicateSlugs(entries []*Entry) []entity.SpecProblem {
+	var problems []entity.SpecProblem
+	first := make(map[string]string, len(entries))
+	for _, e := range entries {
+		slug := e.Spec.StableSlug()
+		if prev, ok := first[slug]; ok {
+			problems = append(problems, entity.SpecProblem{Path: e.Path, Message: fmt.Sprintf("slug %q is already used by %s", slug, prev)})
+			continue
+		}
+		first[slug] = e.Path
+	}
+	return problems
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// Writer lays challenge specs out in a zip archive as <category>/<slug>/challenge.yml with
+// their attachments under files/, the layout ReadBundle and ctfcli expect.
+type Writer struct {
+	zw *zip.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{zw: zip.NewWriter(w)}
+}
+
+// Dir is the directory of s inside the archive.
+func Dir(s *Spec) string {
+	category := Slugify(s.Category)
+	if category == "" {
+		category = "uncategorized"
+	}
+	return path.Join(category, s.StableSlug())
+}
+
+// AddFile stores an attachment of s and lists it in s.Files. Attachments have to be added
+// before the spec itself.
+func (w *Writer) AddFile(s *Spec, name string, r io.Reader) error {
+	rel := path.Join("files", path.Base(name))
+	f, err := w.zw.Create(path.Join(Dir(s), rel))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	s.Files = append(s.Files, rel)
+	return nil
+}
+
+func (w *Writer) AddSpec(s *Spec) error {
+	data, err := Marshal(s)
+	if err != nil {
+		return err
+	}
+	f, err := w.zw.Create(path.Join(Dir(s), FileName))
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	return err
+}
+
+func (w *Writer) Close() error {
+	return w.zw.Close()
+}
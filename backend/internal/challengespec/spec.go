@@ -0,0 +1,409 @@
+// Package challengespec reads and writes challenges in the challenge.yml layout of ctfcli, so
+// challenge sources can be kept in git next to their attachments.
+package challengespec
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name ctfcli gives challenge specs.
+const FileName = "challenge.yml"
+
+type Type string
+
+const (
+	TypeStandard Type = "standard"
+	TypeDynamic  Type = "dynamic"
+)
+
+type State string
+
+const (
+	StateVisible State = "visible"
+	StateHidden  State = "hidden"
+)
+
+type FlagType string
+
+const (
+	FlagTypeStatic FlagType = "static"
+	FlagTypeRegex  FlagType = "regex"
+	// FlagTypeHash never appears under flags, which ctfcli would reject; it is how AllFlags
+	// hands over the entries of flag_hashes.
+	FlagTypeHash FlagType = "hash"
+)
+
+const (
+	FlagDataCaseInsensitive = "case_insensitive"
+	FlagDataCaseSensitive   = "case_sensitive"
+)
+
+const (
+	maxNameLength     = 100
+	maxCategoryLength = 50
+	maxSlugLength     = 100
+)
+
+var (
+	slugPattern     = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	slugUnsafeChars = regexp.MustCompile(`[^a-z0-9]+`)
+	sha256Pattern   = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+// Spec is one challenge.yml. Keys ctfcli knows but CTFBoard has no use for, such as image or
+// connection_info, are ignored on import. Slug is a CTFBoard extension that keeps a challenge
+// matched across renames; without it the slug is derived from the name. FlagHashes is another
+// one, which ctfcli skips like any key it does not know.
+type Spec struct {
+	Name         string       `yaml:"name"`
+	Slug         string       `yaml:"slug,omitempty"`
+	Author       string       `yaml:"author,omitempty"`
+	Category     string       `yaml:"category"`
+	Description  string       `yaml:"description"`
+	Value        int          `yaml:"value"`
+	Type         Type         `yaml:"type,omitempty"`
+	Extra        *Extra       `yaml:"extra,omitempty"`
+	Flags        []Flag       `yaml:"flags"`
+	FlagHashes   []FlagHash   `yaml:"flag_hashes,omitempty"`
+	Tags         []string     `yaml:"tags,omitempty"`
+	Files        []string     `yaml:"files,omitempty"`
+	Hints        []Hint       `yaml:"hints,omitempty"`
+	Requirements Requirements `yaml:"requirements,omitempty"`
+	State        State        `yaml:"state,omitempty"`
+	Version      string       `yaml:"version,omitempty"`
+}
+
+// Extra holds the scoring of dynamic challenges.
+type Extra struct {
+	Initial int `yaml:"initial"`
+	Decay   int `yaml:"decay"`
+	Minimum int `yaml:"minimum"`
+}
+
+// Flag is written either as a plain string, which is a case-sensitive static flag, or as a
+// mapping with type, content and data.
+type Flag struct {
+	Type    FlagType `yaml:"type"`
+	Content string   `yaml:"content"`
+	Data    string   `yaml:"data,omitempty"`
+}
+
+func (f *Flag) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*f = Flag{Type: FlagTypeStatic}
+		return node.Decode(&f.Content)
+	}
+	type plain Flag
+	var p plain
+	if err := node.Decode(&p); err != nil {
+		return err
+	}
+	*f = Flag(p)
+	if f.Type == "" {
+		f.Type = FlagTypeStatic
+	}
+	return nil
+}
+
+func (f Flag) MarshalYAML() (any, error) {
+	if f.Type == FlagTypeStatic && f.Data == "" {
+		return f.Content, nil
+	}
+	type plain Flag
+	return plain(f), nil
+}
+
+func (f Flag) IsCaseInsensitive() bool {
+	return f.Data == FlagDataCaseInsensitive
+}
+
+// FlagHash is the SHA-256 of a static flag whose plain value was never stored, so such
+// challenges can still be exported and re-imported. For case-insensitive flags the hash is of
+// the trimmed, lower-cased value.
+type FlagHash struct {
+	SHA256 string `yaml:"sha256"`
+	Data   string `yaml:"data,omitempty"`
+}
+
+// AddFlag lists f under flags, or under flag_hashes when it is a hash.
+func (s *Spec) AddFlag(f Flag) {
+	if f.Type == FlagTypeHash {
+		s.FlagHashes = append(s.FlagHashes, FlagHash{SHA256: f.Content, Data: f.Data})
+		return
+	}
+	s.Flags = append(s.Flags, f)
+}
+
+// AllFlags returns flags followed by flag_hashes as hash flags.
+func (s *Spec) AllFlags() []Flag {
+	all := slices.Clone(s.Flags)
+	for _, h := range s.FlagHashes {
+		all = append(all, Flag{Type: FlagTypeHash, Content: h.SHA256, Data: h.Data})
+	}
+	return all
+}
+
+// Hint is written either as a plain string, which is a free hint, or as a mapping with content
+// and cost.
+type Hint struct {
+	Content string `yaml:"content"`
+	Cost    int    `yaml:"cost"`
+}
+
+func (h *Hint) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*h = Hint{}
+		return node.Decode(&h.Content)
+	}
+	type plain Hint
+	var p plain
+	if err := node.Decode(&p); err != nil {
+		return err
+	}
+	*h = Hint(p)
+	return nil
+}
+
+func (h Hint) MarshalYAML() (any, error) {
+	if h.Cost == 0 {
+		return h.Content, nil
+	}
+	type plain Hint
+	return plain(h), nil
+}
+
+// Requirements lists the challenges that must be solved first, by slug or by name. Both the
+// plain list and the ctfcli mapping with a prerequisites key are accepted.
+type Requirements []string
+
+func (r *Requirements) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.MappingNode {
+		var m struct {
+			Prerequisites []string `yaml:"prerequisites"`
+		}
+		if err := node.Decode(&m); err != nil {
+			return err
+		}
+		*r = m.Prerequisites
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	*r = list
+	return nil
+}
+
+// Parse decodes a challenge.yml. It does not validate the result.
+func Parse(data []byte) (*Spec, error) {
+	var s Spec
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Marshal encodes s as a challenge.yml.
+func Marshal(s *Spec) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Slugify lower-cases s and joins its letters and digits with dashes.
+func Slugify(s string) string {
+	slug := strings.Trim(slugUnsafeChars.ReplaceAllString(strings.ToLower(s), "-"), "-")
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
+
+// StableSlug is the slug the spec is matched by: its slug key, or else its slugified name.
+func (s *Spec) StableSlug() string {
+	if s.Slug != "" {
+		return s.Slug
+	}
+	return Slugify(s.Name)
+}
+
+func (s *Spec) IsHidden() bool {
+	return s.State == StateHidden
+}
+
+// Scoring maps the spec onto the challenge scoring columns. Standard challenges keep their
+// value: they start and end at it and never decay.
+func (s *Spec) Scoring() (initialValue, minValue, decay int) {
+	if s.Type == TypeDynamic && s.Extra != nil {
+		return s.Extra.Initial, s.Extra.Minimum, s.Extra.Decay
+	}
+	return s.Value, s.Value, 0
+}
+
+// FilePath resolves a files entry relative to the directory of the challenge.yml. ok is false
+// when the entry is empty, absolute or points outside that directory.
+func FilePath(dir, name string) (string, bool) {
+	if name == "" || path.IsAbs(name) || strings.Contains(name, `\`) {
+		return "", false
+	}
+	cleaned := path.Clean(name)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", false
+	}
+	return path.Join(dir, cleaned), true
+}
+
+// Validate lists what is wrong with s, or nothing when it can be imported. Requirements are
+// only checked for form; whether they name existing challenges is up to the importer.
+func (s *Spec) Validate() []string {
+	var problems []string
+	problems = append(problems, s.validateHeader()...)
+	problems = append(problems, s.validateScoring()...)
+	if len(s.Flags) == 0 && len(s.FlagHashes) == 0 {
+		problems = append(problems, "at least one flag is required")
+	}
+	for i, f := range s.Flags {
+		at := fmt.Sprintf("flags[%d]", i)
+		if f.Type == FlagTypeHash {
+			problems = append(problems, at+": ctfcli has no hash flags, list them under flag_hashes")
+			continue
+		}
+		problems = append(problems, f.validate(at)...)
+	}
+	for i, h := range s.FlagHashes {
+		f := Flag{Type: FlagTypeHash, Content: h.SHA256, Data: h.Data}
+		problems = append(problems, f.validate(fmt.Sprintf("flag_hashes[%d]", i))...)
+	}
+	problems = append(problems, s.validateLists()...)
+	problems = append(problems, s.validateFiles()...)
+	return problems
+}
+
+func (s *Spec) validateHeader() []string {
+	var problems []string
+	switch name := strings.TrimSpace(s.Name); {
+	case name == "":
+		problems = append(problems, "name is required")
+	case len(name) > maxNameLength:
+		problems = append(problems, fmt.Sprintf("name must be at most %d characters", maxNameLength))
+	}
+	switch category := strings.TrimSpace(s.Category); {
+	case category == "":
+		problems = append(problems, "category is required")
+	case len(category) > maxCategoryLength:
+		problems = append(problems, fmt.Sprintf("category must be at most %d characters", maxCategoryLength))
+	}
+	if slug := s.StableSlug(); !slugPattern.MatchString(slug) || len(slug) > maxSlugLength {
+		problems = append(problems, fmt.Sprintf("slug %q must be lower-case letters, digits and single dashes, at most %d characters", slug, maxSlugLength))
+	}
+	switch s.State {
+	case "", StateVisible, StateHidden:
+	default:
+		problems = append(problems, fmt.Sprintf("state %q is not supported, use visible or hidden", s.State))
+	}
+	return problems
+}
+
+func (s *Spec) validateScoring() []string {
+	var problems []string
+	if s.Value < 0 {
+		problems = append(problems, "value must not be negative")
+	}
+	switch s.Type {
+	case "", TypeStandard:
+	case TypeDynamic:
+		switch {
+		case s.Extra == nil:
+			problems = append(problems, "dynamic challenges need extra.initial, extra.decay and extra.minimum")
+		case s.Extra.Initial <= 0 || s.Extra.Decay <= 0:
+			problems = append(problems, "extra.initial and extra.decay must be positive")
+		case s.Extra.Minimum < 0 || s.Extra.Minimum > s.Extra.Initial:
+			problems = append(problems, "extra.minimum must be between 0 and extra.initial")
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("type %q is not supported, use standard or dynamic", s.Type))
+	}
+	return problems
+}
+
+func (s *Spec) validateLists() []string {
+	var problems []string
+	for i, h := range s.Hints {
+		if strings.TrimSpace(h.Content) == "" {
+			problems = append(problems, fmt.Sprintf("hints[%d]: content is required", i))
+		}
+		if h.Cost < 0 {
+			problems = append(problems, fmt.Sprintf("hints[%d]: cost must not be negative", i))
+		}
+	}
+	for i, tag := range s.Tags {
+		if strings.TrimSpace(tag) == "" {
+			problems = append(problems, fmt.Sprintf("tags[%d]: must not be empty", i))
+		}
+	}
+	for i, req := range s.Requirements {
+		if strings.TrimSpace(req) == "" {
+			problems = append(problems, fmt.Sprintf("requirements[%d]: must not be empty", i))
+		}
+	}
+	return problems
+}
+
+func (s *Spec) validateFiles() []string {
+	var problems []string
+	seen := make(map[string]bool, len(s.Files))
+	for i, name := range s.Files {
+		p, ok := FilePath("", name)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("files[%d]: %q must be a relative path inside the challenge directory", i, name))
+			continue
+		}
+		base := path.Base(p)
+		if seen[base] {
+			problems = append(problems, fmt.Sprintf("files[%d]: another file is already named %q", i, base))
+		}
+		seen[base] = true
+	}
+	return problems
+}
+
+func (f Flag) validate(at string) []string {
+	var problems []string
+	if strings.TrimSpace(f.Content) == "" {
+		problems = append(problems, fmt.Sprintf("%s: content is required", at))
+	}
+	switch f.Data {
+	case "", FlagDataCaseInsensitive, FlagDataCaseSensitive:
+	default:
+		problems = append(problems, fmt.Sprintf("%s: data %q is not supported, use case_insensitive or case_sensitive", at, f.Data))
+	}
+	switch f.Type {
+	case FlagTypeStatic:
+	case FlagTypeRegex:
+		if _, err := regexp.Compile(f.Content); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: invalid regex: %v", at, err))
+		}
+	case FlagTypeHash:
+		if !sha256Pattern.MatchString(f.Content) {
+			problems = append(problems, fmt.Sprintf("%s: hash must be a lower-case hex SHA-256", at))
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("%s: type %q is not supported, use static or regex", at, f.Type))
+	}
+	return problems
+}
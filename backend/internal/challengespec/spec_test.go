@@ -0,0 +1,282 @@
+package challengespec_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/internal/challengespec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const ctfcliSpec = `
+name: Baby Web
+author: someone
+category: Web
+description: Find the flag
+value: 100
+type: standard
+image: null
+connection_info: http://example.com
+flags:
+  - flag{plain}
+  - type: regex
+    content: flag\{[0-9]+\}
+    data: case_insensitive
+tags:
+  - easy
+files:
+  - dist/app.zip
+hints:
+  - free hint
+  - content: paid hint
+    cost: 10
+requirements:
+  - Warmup
+state: hidden
+version: "0.1"
+`
+
+func TestParse_CtfcliSpec(t *testing.T) {
+	s, err := challengespec.Parse([]byte(ctfcliSpec))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Baby Web", s.Name)
+	assert.Equal(t, "baby-web", s.StableSlug())
+	assert.True(t, s.IsHidden())
+	require.Len(t, s.Flags, 2)
+	assert.Equal(t, challengespec.Flag{Type: challengespec.FlagTypeStatic, Content: "flag{plain}"}, s.Flags[0])
+	assert.Equal(t, challengespec.FlagTypeRegex, s.Flags[1].Type)
+	assert.True(t, s.Flags[1].IsCaseInsensitive())
+	assert.Equal(t, []challengespec.Hint{{Content: "free hint"}, {Content: "paid hint", Cost: 10}}, s.Hints)
+	assert.Equal(t, challengespec.Requirements{"Warmup"}, s.Requirements)
+	assert.Empty(t, s.Validate())
+}
+
+func TestParse_RequirementsMapping(t *testing.T) {
+	s, err := challengespec.Parse([]byte("requirements:\n  prerequisites:\n    - warmup\n  anonymize: true\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, challengespec.Requirements{"warmup"}, s.Requirements)
+}
+
+func TestMarshal_RoundTrip(t *testing.T) {
+	s, err := challengespec.Parse([]byte(ctfcliSpec))
+	require.NoError(t, err)
+
+	data, err := challengespec.Marshal(s)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "- flag{plain}\n")
+	assert.Contains(t, string(data), "- free hint\n")
+
+	again, err := challengespec.Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, s, again)
+}
+
+func TestSpec_AddFlag_KeepsFlagsCtfcliCompatible(t *testing.T) {
+	hash := strings.Repeat("a", 64)
+	s := &challengespec.Spec{Name: "Warmup", Category: "Misc", Value: 50}
+	s.AddFlag(challengespec.Flag{Type: challengespec.FlagTypeHash, Content: hash, Data: challengespec.FlagDataCaseInsensitive})
+	s.AddFlag(challengespec.Flag{Type: challengespec.FlagTypeRegex, Content: `flag\{\d+\}`})
+
+	data, err := challengespec.Marshal(s)
+	require.NoError(t, err)
+	assertCtfcliFlags(t, data)
+
+	again, err := challengespec.Parse(data)
+	require.NoError(t, err)
+	assert.Empty(t, again.Validate())
+	assert.Equal(t, []challengespec.Flag{
+		{Type: challengespec.FlagTypeRegex, Content: `flag\{\d+\}`},
+		{Type: challengespec.FlagTypeHash, Content: hash, Data: challengespec.FlagDataCaseInsensitive},
+	}, again.AllFlags())
+}
+
+func TestSpec_Validate(t *testing.T) {
+	valid := func() *challengespec.Spec {
+		return &challengespec.Spec{
+			Name: "Warmup", Category: "Misc", Value: 50,
+			Flags: []challengespec.Flag{{Type: challengespec.FlagTypeStatic, Content: "flag{x}"}},
+		}
+	}
+	tests := []struct {
+		name   string
+		modify func(s *challengespec.Spec)
+		want   string
+	}{
+		{"Success", func(*challengespec.Spec) {}, ""},
+		{"MissingName", func(s *challengespec.Spec) { s.Name, s.Slug = " ", "warmup" }, "name is required"},
+		{"MissingCategory", func(s *challengespec.Spec) { s.Category = "" }, "category is required"},
+		{"InvalidSlug", func(s *challengespec.Spec) { s.Slug = "Not A Slug" }, "slug"},
+		{"NoFlags", func(s *challengespec.Spec) { s.Flags = nil }, "at least one flag is required"},
+		{"BadRegex", func(s *challengespec.Spec) {
+			s.Flags[0] = challengespec.Flag{Type: challengespec.FlagTypeRegex, Content: "("}
+		}, "invalid regex"},
+		{"OnlyFlagHashes", func(s *challengespec.Spec) {
+			s.Flags, s.FlagHashes = nil, []challengespec.FlagHash{{SHA256: strings.Repeat("a", 64)}}
+		}, ""},
+		{"BadHash", func(s *challengespec.Spec) {
+			s.FlagHashes = []challengespec.FlagHash{{SHA256: "abc"}}
+		}, "flag_hashes[0]: hash must be a lower-case hex SHA-256"},
+		{"HashUnderFlags", func(s *challengespec.Spec) {
+			s.Flags[0] = challengespec.Flag{Type: challengespec.FlagTypeHash, Content: strings.Repeat("a", 64)}
+		}, "list them under flag_hashes"},
+		{"UnknownType", func(s *challengespec.Spec) { s.Type = "container" }, `type "container" is not supported`},
+		{"DynamicWithoutExtra", func(s *challengespec.Spec) { s.Type = challengespec.TypeDynamic }, "extra.initial"},
+		{"NegativeHintCost", func(s *challengespec.Spec) { s.Hints = []challengespec.Hint{{Content: "h", Cost: -1}} }, "cost must not be negative"},
+		{"EscapingFile", func(s *challengespec.Spec) { s.Files = []string{"../secret"} }, "relative path"},
+		{"DuplicateFileName", func(s *challengespec.Spec) { s.Files = []string{"a/x.txt", "b/x.txt"} }, "already named"},
+		{"UnknownState", func(s *challengespec.Spec) { s.State = "locked" }, `state "locked"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid()
+			tt.modify(s)
+			problems := s.Validate()
+			if tt.want == "" {
+				assert.Empty(t, problems)
+				return
+			}
+			require.Len(t, problems, 1)
+			assert.Contains(t, problems[0], tt.want)
+		})
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "baby-s-first-rop", challengespec.Slugify("  Baby's First ROP!! "))
+	assert.Equal(t, "", challengespec.Slugify("???"))
+	assert.Len(t, challengespec.Slugify(strings.Repeat("a", 150)), 100)
+}
+
+func TestSpec_Scoring(t *testing.T) {
+	standard := &challengespec.Spec{Value: 100}
+	initial, minimum, decay := standard.Scoring()
+	assert.Equal(t, []int{100, 100, 0}, []int{initial, minimum, decay})
+
+	dynamic := &challengespec.Spec{Value: 500, Type: challengespec.TypeDynamic, Extra: &challengespec.Extra{Initial: 500, Decay: 20, Minimum: 100}}
+	initial, minimum, decay = dynamic.Scoring()
+	assert.Equal(t, []int{500, 100, 20}, []int{initial, minimum, decay})
+}
+
+func TestFilePath(t *testing.T) {
+	p, ok := challengespec.FilePath("web/baby", "dist/../app.zip")
+	assert.True(t, ok)
+	assert.Equal(t, "web/baby/app.zip", p)
+
+	for _, name := range []string{"", "/etc/passwd", "../x", "..", `dist\app.zip`} {
+		_, ok := challengespec.FilePath("web/baby", name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestReadBundle_Zip(t *testing.T) {
+	data := zipOf(t, map[string]string{
+		"web/baby/challenge.yml":     ctfcliSpec,
+		"web/baby/dist/app.zip":      "payload",
+		"misc/warmup/challenge.yml":  "name: Warmup\ncategory: Misc\nvalue: 50\nflags: ['flag{w}']\n",
+		"__MACOSX/web/challenge.yml": "garbage",
+	})
+
+	b, problems, err := challengespec.ReadBundle(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+	require.Len(t, b.Entries, 2)
+	assert.Equal(t, "misc/warmup/challenge.yml", b.Entries[0].Path)
+	assert.Equal(t, "web/baby", b.Entries[1].Dir)
+
+	f, ok := b.Attachment(b.Entries[1], "dist/app.zip")
+	require.True(t, ok)
+	assert.Equal(t, "web/baby/dist/app.zip", f.Name)
+}
+
+func TestReadBundle_Problems(t *testing.T) {
+	data := zipOf(t, map[string]string{
+		"a/challenge.yml": "name: Same\ncategory: Misc\nflags: [x]\nfiles: [missing.txt]\n",
+		"b/challenge.yml": "name: Same\ncategory: Misc\nflags: [y]\n",
+		"c/challenge.yml": "name: [unterminated\n",
+	})
+
+	b, problems, err := challengespec.ReadBundle(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	assert.Len(t, b.Entries, 2)
+	require.Len(t, problems, 3)
+	assert.Equal(t, "c/challenge.yml", problems[0].Path)
+	assert.Contains(t, problems[0].Message, "invalid yaml")
+	assert.Contains(t, problems[1].Message, `file "missing.txt" is not in the archive`)
+	assert.Contains(t, problems[2].Message, `slug "same" is already used by a/challenge.yml`)
+}
+
+func TestReadBundle_SingleYAML(t *testing.T) {
+	data := []byte("name: Warmup\ncategory: Misc\nvalue: 50\nflags: ['flag{w}']\n")
+
+	b, problems, err := challengespec.ReadBundle(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+	require.Len(t, b.Entries, 1)
+	assert.Equal(t, challengespec.FileName, b.Entries[0].Path)
+}
+
+func TestReadBundle_NoSpecs(t *testing.T) {
+	data := zipOf(t, map[string]string{"readme.md": "hello"})
+
+	_, _, err := challengespec.ReadBundle(bytes.NewReader(data), int64(len(data)))
+	assert.ErrorIs(t, err, challengespec.ErrNoSpecs)
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	s := &challengespec.Spec{
+		Name: "Baby Web", Category: "Web", Value: 100,
+		Flags: []challengespec.Flag{{Type: challengespec.FlagTypeStatic, Content: "flag{x}"}},
+	}
+	var buf bytes.Buffer
+	w := challengespec.NewWriter(&buf)
+	require.NoError(t, w.AddFile(s, "app.zip", strings.NewReader("payload")))
+	require.NoError(t, w.AddSpec(s))
+	require.NoError(t, w.Close())
+
+	b, problems, err := challengespec.ReadBundle(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+	require.Len(t, b.Entries, 1)
+	assert.Equal(t, "web/baby-web/challenge.yml", b.Entries[0].Path)
+	assert.Equal(t, []string{"files/app.zip"}, b.Entries[0].Spec.Files)
+	_, ok := b.Attachment(b.Entries[0], "files/app.zip")
+	assert.True(t, ok)
+}
+
+func zipOf(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// assertCtfcliFlags checks that every entry under flags of a challenge.yml is one ctfcli can
+// sync: a plain string or a mapping of type static or regex.
+func assertCtfcliFlags(t *testing.T, data []byte) {
+	t.Helper()
+	var doc struct {
+		Flags []any `yaml:"flags"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &doc))
+	for i, f := range doc.Flags {
+		if _, ok := f.(string); ok {
+			continue
+		}
+		m, ok := f.(map[string]any)
+		require.True(t, ok, "flags[%d] is neither a string nor a mapping", i)
+		assert.Contains(t, []any{"static", "regex"}, m["type"], "flags[%d]", i)
+	}
+}
@@ -6,34 +6,61 @@ import (
 	"encoding/hex"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/pkg/httputil"
 	"github.com/skr1ms/CTFBoard/pkg/jwt"
 )
 
 type contextKey string
 
-const UserRoleKey contextKey = "role"
+const (
+	UserRoleKey  contextKey = "role"
+	SessionIDKey contextKey = "session_id"
+	APITokenKey  contextKey = "api_token"
+)
+
+type SessionValidator interface {
+	ValidateAccess(ctx context.Context, sessionID, userID uuid.UUID, accessJTI string) error
+}
 
 type APITokenAuther interface {
 	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.APIToken, error)
 	UpdateLastUsedAt(ctx context.Context, id uuid.UUID) error
 	ValidateToken(t *entity.APIToken) bool
+	RecordRequest(ctx context.Context, req *entity.APITokenRequest) error
 }
 
 type UserByIDGetter interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 }
 
-func authBearer(jwtService *jwt.JWTService, r *http.Request, token string) (context.Context, bool) {
+func authBearer(jwtService *jwt.JWTService, sessionUC SessionValidator, r *http.Request, token string) (context.Context, bool) {
 	claims, err := jwtService.ValidateAccessToken(token)
 	if err != nil {
 		return nil, false
 	}
 	ctx := context.WithValue(r.Context(), httputil.UserIDKey, claims.UserID)
 	ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
+	if sessionUC == nil {
+		return ctx, true
+	}
+	sessionID, err := uuid.Parse(claims.FamilyID)
+	if err != nil {
+		return nil, false
+	}
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return nil, false
+	}
+	if err := sessionUC.ValidateAccess(r.Context(), sessionID, userID, claims.ID); err != nil {
+		return nil, false
+	}
+	ctx = context.WithValue(ctx, SessionIDKey, sessionID)
 	return ctx, true
 }
 
@@ -51,17 +78,42 @@ func authAPIToken(apiTokenUC APITokenAuther, userUC UserByIDGetter, r *http.Requ
 	if err != nil || token == nil || !apiTokenUC.ValidateToken(token) {
 		return nil, false
 	}
-	user, err := userUC.GetByID(r.Context(), token.UserID)
-	if err != nil || user == nil {
-		return nil, false
+	ctx := context.WithValue(r.Context(), APITokenKey, token)
+	if token.IsService() {
+		// Service tokens have no user; they only carry admin scopes.
+		ctx = context.WithValue(ctx, UserRoleKey, entity.RoleAdmin)
+	} else {
+		user, err := userUC.GetByID(r.Context(), *token.UserID)
+		if err != nil || user == nil {
+			return nil, false
+		}
+		ctx = context.WithValue(ctx, httputil.UserIDKey, user.ID.String())
+		ctx = context.WithValue(ctx, UserRoleKey, user.Role)
 	}
 	_ = apiTokenUC.UpdateLastUsedAt(r.Context(), token.ID) //nolint:errcheck // best-effort update
-	ctx := context.WithValue(r.Context(), httputil.UserIDKey, user.ID.String())
-	ctx = context.WithValue(ctx, UserRoleKey, user.Role)
 	return ctx, true
 }
 
-func Auth(jwtService *jwt.JWTService, apiTokenUC APITokenAuther, userUC UserByIDGetter) func(http.Handler) http.Handler {
+// serveAPIToken serves a token-authenticated request and records it in the token's request trail.
+func serveAPIToken(apiTokenUC APITokenAuther, next http.Handler, w http.ResponseWriter, r *http.Request, token *entity.APIToken) {
+	ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+	next.ServeHTTP(ww, r)
+
+	status := ww.Status()
+	if status == 0 {
+		status = http.StatusOK
+	}
+	_ = apiTokenUC.RecordRequest(context.WithoutCancel(r.Context()), &entity.APITokenRequest{ //nolint:errcheck // best-effort audit
+		TokenID:   token.ID,
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Status:    status,
+		IP:        httputil.GetClientIP(r),
+		CreatedAt: time.Now(),
+	})
+}
+
+func Auth(jwtService *jwt.JWTService, sessionUC SessionValidator, apiTokenUC APITokenAuther, userUC UserByIDGetter) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
@@ -78,7 +130,7 @@ func Auth(jwtService *jwt.JWTService, apiTokenUC APITokenAuther, userUC UserByID
 			var ok bool
 			switch parts[0] {
 			case "Bearer":
-				ctx, ok = authBearer(jwtService, r, parts[1])
+				ctx, ok = authBearer(jwtService, sessionUC, r, parts[1])
 			case "Token":
 				ctx, ok = authAPIToken(apiTokenUC, userUC, r, parts[1])
 			default:
@@ -89,22 +141,54 @@ func Auth(jwtService *jwt.JWTService, apiTokenUC APITokenAuther, userUC UserByID
 				httputil.RenderError(w, r, http.StatusUnauthorized, "invalid token")
 				return
 			}
+			if token, ok := GetAPIToken(ctx); ok {
+				serveAPIToken(apiTokenUC, next, w, r.WithContext(ctx), token)
+				return
+			}
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
-func Admin(next http.Handler) http.Handler {
+// RequireScope rejects API token requests whose token lacks scope. Session requests pass through.
+func RequireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token, ok := GetAPIToken(r.Context()); ok && !token.HasScope(scope) {
+				renderInsufficientScope(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireAdminScope lets admin:read tokens through read-only requests and requires admin otherwise.
+func RequireAdminScope(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope := entity.ScopeAdmin
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			scope = entity.ScopeAdminRead
+		}
+		RequireScope(scope)(next).ServeHTTP(w, r)
+	})
+}
+
+// SessionOnly rejects API token requests, e.g. for managing tokens, sessions and 2FA.
+func SessionOnly(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		role, ok := r.Context().Value(UserRoleKey).(string)
-		if !ok || role != entity.RoleAdmin {
-			httputil.RenderError(w, r, http.StatusForbidden, "admin access required")
+		if _, ok := GetAPIToken(r.Context()); ok {
+			httputil.RenderErrorWithCode(w, r, http.StatusForbidden, "not available for api tokens", entityError.ErrInsufficientScope.Code)
 			return
 		}
 		next.ServeHTTP(w, r)
 	})
 }
 
+func renderInsufficientScope(w http.ResponseWriter, r *http.Request) {
+	httputil.RenderErrorWithCode(w, r, http.StatusForbidden, entityError.ErrInsufficientScope.Error(), entityError.ErrInsufficientScope.Code)
+}
+
 func GetUserID(ctx context.Context) string {
 	return httputil.GetUserID(ctx)
 }
@@ -115,3 +199,15 @@ func GetUserRole(ctx context.Context) string {
 	}
 	return ""
 }
+
+// GetAPIToken returns the token of an API token request.
+func GetAPIToken(ctx context.Context) (*entity.APIToken, bool) {
+	token, ok := ctx.Value(APITokenKey).(*entity.APIToken)
+	return token, ok
+}
+
+// GetSessionID returns the session of a bearer-authenticated request; API token requests have none.
+func GetSessionID(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(SessionIDKey).(uuid.UUID)
+	return id, ok
+}
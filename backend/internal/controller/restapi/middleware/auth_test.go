@@ -11,7 +11,6 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
-	"github.com/skr1ms/CTFBoard/pkg/httputil"
 	"github.com/skr1ms/CTFBoard/pkg/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,7 +19,7 @@ import (
 func TestAuth_NoHeader_Error(t *testing.T) {
 	svc := jwt.NewJWTService("access-secret-min-32-chars-long", "refresh-secret-min-32-chars-long", time.Hour, time.Hour)
 	r := chi.NewRouter()
-	r.Use(Auth(svc, nil, nil))
+	r.Use(Auth(svc, nil, nil, nil))
 	r.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -37,7 +36,7 @@ func TestAuth_BearerSuccess(t *testing.T) {
 	require.NoError(t, err)
 
 	r := chi.NewRouter()
-	r.Use(Auth(svc, nil, nil))
+	r.Use(Auth(svc, nil, nil, nil))
 	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, userID.String(), GetUserID(r.Context()))
 		assert.Equal(t, entity.RoleAdmin, GetUserRole(r.Context()))
@@ -52,76 +51,93 @@ func TestAuth_BearerSuccess(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 }
 
-func TestAuth_BearerInvalid_Error(t *testing.T) {
+type mockSessionValidator struct {
+	err       error
+	sessionID uuid.UUID
+	accessJTI string
+}
+
+func (m *mockSessionValidator) ValidateAccess(_ context.Context, sessionID, _ uuid.UUID, accessJTI string) error {
+	m.sessionID = sessionID
+	m.accessJTI = accessJTI
+	return m.err
+}
+
+func TestAuth_BearerSession_Success(t *testing.T) {
 	svc := jwt.NewJWTService("access-secret-min-32-chars-long", "refresh-secret-min-32-chars-long", time.Hour, time.Hour)
+	token, err := svc.GenerateTokenPair(uuid.New(), "a@b.c", "Name", entity.RoleUser)
+	require.NoError(t, err)
+	sessions := &mockSessionValidator{}
+
 	r := chi.NewRouter()
-	r.Use(Auth(svc, nil, nil))
-	r.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	r.Use(Auth(svc, sessions, nil, nil))
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		sessionID, ok := GetSessionID(r.Context())
+		assert.True(t, ok)
+		assert.Equal(t, token.FamilyID, sessionID)
+		w.WriteHeader(http.StatusOK)
+	})
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
-	req.Header.Set("Authorization", "Bearer invalid-token")
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
 	rr := httptest.NewRecorder()
 	r.ServeHTTP(rr, req)
 
-	require.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, token.FamilyID, sessions.sessionID)
+	assert.Equal(t, token.AccessTokenID, sessions.accessJTI)
 }
 
-func TestAuth_InvalidFormat_Error(t *testing.T) {
+func TestAuth_BearerSessionRevoked_Error(t *testing.T) {
 	svc := jwt.NewJWTService("access-secret-min-32-chars-long", "refresh-secret-min-32-chars-long", time.Hour, time.Hour)
+	token, err := svc.GenerateTokenPair(uuid.New(), "a@b.c", "Name", entity.RoleUser)
+	require.NoError(t, err)
+
 	r := chi.NewRouter()
-	r.Use(Auth(svc, nil, nil))
+	r.Use(Auth(svc, &mockSessionValidator{err: errors.New("session revoked")}, nil, nil))
 	r.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
-	req.Header.Set("Authorization", "InvalidScheme token")
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
 	rr := httptest.NewRecorder()
 	r.ServeHTTP(rr, req)
 
 	require.Equal(t, http.StatusUnauthorized, rr.Code)
 }
 
-func TestAdmin_Success(t *testing.T) {
+func TestAuth_BearerInvalid_Error(t *testing.T) {
+	svc := jwt.NewJWTService("access-secret-min-32-chars-long", "refresh-secret-min-32-chars-long", time.Hour, time.Hour)
 	r := chi.NewRouter()
-	r.Use(func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ctx := context.WithValue(r.Context(), UserRoleKey, entity.RoleAdmin)
-			ctx = context.WithValue(ctx, httputil.UserIDKey, uuid.New().String())
-			next.ServeHTTP(w, r.WithContext(ctx))
-		})
-	})
-	r.Use(Admin)
+	r.Use(Auth(svc, nil, nil, nil))
 	r.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer invalid-token")
 	rr := httptest.NewRecorder()
 	r.ServeHTTP(rr, req)
 
-	assert.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
 }
 
-func TestAdmin_Error(t *testing.T) {
+func TestAuth_InvalidFormat_Error(t *testing.T) {
+	svc := jwt.NewJWTService("access-secret-min-32-chars-long", "refresh-secret-min-32-chars-long", time.Hour, time.Hour)
 	r := chi.NewRouter()
-	r.Use(func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ctx := context.WithValue(r.Context(), UserRoleKey, entity.RoleUser)
-			ctx = context.WithValue(ctx, httputil.UserIDKey, uuid.New().String())
-			next.ServeHTTP(w, r.WithContext(ctx))
-		})
-	})
-	r.Use(Admin)
+	r.Use(Auth(svc, nil, nil, nil))
 	r.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "InvalidScheme token")
 	rr := httptest.NewRecorder()
 	r.ServeHTTP(rr, req)
 
-	require.Equal(t, http.StatusForbidden, rr.Code)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
 }
 
 type mockAPITokenAuther struct {
-	token *entity.APIToken
-	err   error
-	valid bool
+	token    *entity.APIToken
+	err      error
+	valid    bool
+	requests []*entity.APITokenRequest
 }
 
 func (m *mockAPITokenAuther) GetByTokenHash(_ context.Context, _ string) (*entity.APIToken, error) {
@@ -132,6 +148,11 @@ func (m *mockAPITokenAuther) UpdateLastUsedAt(_ context.Context, _ uuid.UUID) er
 	return nil
 }
 
+func (m *mockAPITokenAuther) RecordRequest(_ context.Context, req *entity.APITokenRequest) error {
+	m.requests = append(m.requests, req)
+	return nil
+}
+
 func (m *mockAPITokenAuther) ValidateToken(t *entity.APIToken) bool {
 	if t == nil {
 		return false
@@ -151,14 +172,14 @@ func (m *mockUserByIDGetter) GetByID(_ context.Context, _ uuid.UUID) (*entity.Us
 func TestAuth_TokenSuccess(t *testing.T) {
 	userID := uuid.New()
 	tokenID := uuid.New()
-	apiToken := &entity.APIToken{ID: tokenID, UserID: userID}
+	apiToken := &entity.APIToken{ID: tokenID, UserID: &userID}
 	user := &entity.User{ID: userID, Role: entity.RoleUser}
 
 	apiAuth := &mockAPITokenAuther{token: apiToken, err: nil, valid: true}
 	userGet := &mockUserByIDGetter{user: user, err: nil}
 
 	r := chi.NewRouter()
-	r.Use(Auth(nil, apiAuth, userGet))
+	r.Use(Auth(nil, nil, apiAuth, userGet))
 	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, userID.String(), GetUserID(r.Context()))
 		assert.Equal(t, entity.RoleUser, GetUserRole(r.Context()))
@@ -178,7 +199,7 @@ func TestAuth_TokenError(t *testing.T) {
 	userGet := &mockUserByIDGetter{user: nil, err: nil}
 
 	r := chi.NewRouter()
-	r.Use(Auth(nil, apiAuth, userGet))
+	r.Use(Auth(nil, nil, apiAuth, userGet))
 	r.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -188,3 +209,79 @@ func TestAuth_TokenError(t *testing.T) {
 
 	require.Equal(t, http.StatusUnauthorized, rr.Code)
 }
+
+func TestAuth_Token_RecordsRequest(t *testing.T) {
+	userID := uuid.New()
+	apiToken := &entity.APIToken{ID: uuid.New(), UserID: &userID, Scopes: []string{entity.ScopeChallengesRead}}
+	apiAuth := &mockAPITokenAuther{token: apiToken, valid: true}
+	userGet := &mockUserByIDGetter{user: &entity.User{ID: userID, Role: entity.RoleUser}}
+
+	r := chi.NewRouter()
+	r.Use(Auth(nil, nil, apiAuth, userGet))
+	r.Get("/challenges", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusTeapot) })
+
+	req := httptest.NewRequest(http.MethodGet, "/challenges", nil)
+	req.Header.Set("Authorization", "Token my-api-token")
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	require.Len(t, apiAuth.requests, 1)
+	assert.Equal(t, apiToken.ID, apiAuth.requests[0].TokenID)
+	assert.Equal(t, http.MethodGet, apiAuth.requests[0].Method)
+	assert.Equal(t, "/challenges", apiAuth.requests[0].Path)
+	assert.Equal(t, http.StatusTeapot, apiAuth.requests[0].Status)
+}
+
+func TestAuth_ServiceToken_Success(t *testing.T) {
+	apiToken := &entity.APIToken{ID: uuid.New(), Scopes: []string{entity.ScopeAdminRead}}
+	apiAuth := &mockAPITokenAuther{token: apiToken, valid: true}
+
+	r := chi.NewRouter()
+	r.Use(Auth(nil, nil, apiAuth, &mockUserByIDGetter{}))
+	r.Use(RequirePermission(stubPermissionResolver{}, entity.PermPagesManage))
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, GetUserID(r.Context()))
+		assert.Equal(t, entity.RoleAdmin, GetUserRole(r.Context()))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Token service-token")
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestRequireScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      *entity.APIToken
+		method     string
+		middleware func(http.Handler) http.Handler
+		wantStatus int
+	}{
+		{"SessionPasses", nil, http.MethodPost, RequireScope(entity.ScopeSubmit), http.StatusOK},
+		{"TokenWithScope", &entity.APIToken{Scopes: []string{entity.ScopeSubmit}}, http.MethodPost, RequireScope(entity.ScopeSubmit), http.StatusOK},
+		{"TokenWithoutScope", &entity.APIToken{Scopes: []string{entity.ScopeChallengesRead}}, http.MethodPost, RequireScope(entity.ScopeSubmit), http.StatusForbidden},
+		{"AdminImpliesAdminBackup", &entity.APIToken{Scopes: []string{entity.ScopeAdmin}}, http.MethodGet, RequireScope(entity.ScopeAdminBackup), http.StatusOK},
+		{"AdminReadOnGet", &entity.APIToken{Scopes: []string{entity.ScopeAdminRead}}, http.MethodGet, RequireAdminScope, http.StatusOK},
+		{"AdminReadOnPut", &entity.APIToken{Scopes: []string{entity.ScopeAdminRead}}, http.MethodPut, RequireAdminScope, http.StatusForbidden},
+		{"SessionOnlyWithSession", nil, http.MethodGet, SessionOnly, http.StatusOK},
+		{"SessionOnlyWithToken", &entity.APIToken{Scopes: []string{entity.ScopeAdmin}}, http.MethodGet, SessionOnly, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := tt.middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }))
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			if tt.token != nil {
+				req = req.WithContext(context.WithValue(req.Context(), APITokenKey, tt.token))
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/pkg/httputil"
+)
+
+type PermissionResolver interface {
+	HasPermission(ctx context.Context, role, permission string) (bool, error)
+}
+
+// RequirePermission lets the request through when the caller's role grants any of permissions.
+// API tokens additionally need at least one admin scope.
+func RequirePermission(resolver PermissionResolver, permissions ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role := GetUserRole(r.Context())
+			allowed := false
+			for _, p := range permissions {
+				ok, err := resolver.HasPermission(r.Context(), role, p)
+				if err != nil {
+					httputil.RenderError(w, r, http.StatusInternalServerError, "failed to check permissions")
+					return
+				}
+				if ok {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				httputil.RenderErrorWithCode(w, r, http.StatusForbidden, entityError.ErrPermissionDenied.Error(), entityError.ErrPermissionDenied.Code)
+				return
+			}
+			if token, ok := GetAPIToken(r.Context()); ok && !slices.ContainsFunc(token.Scopes, entity.IsAdminScope) {
+				renderInsufficientScope(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubPermissionResolver struct {
+	err error
+}
+
+func (s stubPermissionResolver) HasPermission(_ context.Context, role, permission string) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	r, ok := entity.BuiltInRole(role)
+	return ok && r.HasPermission(permission), nil
+}
+
+func TestRequirePermission(t *testing.T) {
+	tests := []struct {
+		name        string
+		role        string
+		token       *entity.APIToken
+		permissions []string
+		resolverErr error
+		wantStatus  int
+	}{
+		{"AdminAllowed", entity.RoleAdmin, nil, []string{entity.PermBackupManage}, nil, http.StatusOK},
+		{"UserForbidden", entity.RoleUser, nil, []string{entity.PermChallengesManage}, nil, http.StatusForbidden},
+		{"NoRoleForbidden", "", nil, []string{entity.PermChallengesManage}, nil, http.StatusForbidden},
+		{"AuthorAnyOf", entity.RoleAuthor, nil, []string{entity.PermChallengesManage, entity.PermChallengesAuthor}, nil, http.StatusOK},
+		{"AuthorForbidden", entity.RoleAuthor, nil, []string{entity.PermBackupManage}, nil, http.StatusForbidden},
+		{"SupportSubmissions", entity.RoleSupport, nil, []string{entity.PermSubmissionsRead}, nil, http.StatusOK},
+		{"ModeratorTeams", entity.RoleModerator, nil, []string{entity.PermTeamsModerate}, nil, http.StatusOK},
+		{"TokenWithAdminScope", entity.RoleAdmin, &entity.APIToken{Scopes: []string{entity.ScopeAdminRead}}, []string{entity.PermPagesManage}, nil, http.StatusOK},
+		{"TokenWithoutAdminScope", entity.RoleAdmin, &entity.APIToken{Scopes: []string{entity.ScopeProfileRead}}, []string{entity.PermPagesManage}, nil, http.StatusForbidden},
+		{"ResolverError", entity.RoleAdmin, nil, []string{entity.PermPagesManage}, errors.New("db down"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := chi.NewRouter()
+			r.Use(func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					ctx := context.WithValue(r.Context(), UserRoleKey, tt.role)
+					if tt.token != nil {
+						ctx = context.WithValue(ctx, APITokenKey, tt.token)
+					}
+					next.ServeHTTP(w, r.WithContext(ctx))
+				})
+			})
+			r.Use(RequirePermission(stubPermissionResolver{err: tt.resolverErr}, tt.permissions...))
+			r.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/pkg/httputil"
+)
+
+type TwoFactorPolicy interface {
+	RequiredButMissing(ctx context.Context, user *entity.User) (bool, error)
+}
+
+// RequireTwoFactor blocks users whom the 2FA policy applies to until they enable it.
+func RequireTwoFactor(policy TwoFactorPolicy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Service tokens have no user and can only be minted from a session that passed this check.
+			if token, ok := GetAPIToken(r.Context()); ok && token.IsService() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			user, ok := GetUser(r.Context())
+			if !ok {
+				httputil.RenderError(w, r, http.StatusUnauthorized, "unauthorized")
+				return
+			}
+
+			missing, err := policy.RequiredButMissing(r.Context(), user)
+			if err != nil {
+				httputil.RenderError(w, r, http.StatusInternalServerError, "failed to check two-factor policy")
+				return
+			}
+			if missing {
+				httputil.RenderErrorWithCode(w, r, http.StatusForbidden, entityError.ErrTwoFactorRequired.Error(), entityError.ErrTwoFactorRequired.Code)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
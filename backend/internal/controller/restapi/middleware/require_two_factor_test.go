@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubTwoFactorPolicy struct {
+	missing bool
+	err     error
+}
+
+func (s stubTwoFactorPolicy) RequiredButMissing(_ context.Context, _ *entity.User) (bool, error) {
+	return s.missing, s.err
+}
+
+func serveWithTwoFactorPolicy(policy TwoFactorPolicy, u *entity.User) *httptest.ResponseRecorder {
+	r := chi.NewRouter()
+	if u != nil {
+		r.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				next.ServeHTTP(w, r.WithContext(withUser(r.Context(), u)))
+			})
+		})
+	}
+	r.Use(RequireTwoFactor(policy))
+	r.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestRequireTwoFactor_Compliant_Success(t *testing.T) {
+	u := &entity.User{ID: uuid.New(), Role: entity.RoleAdmin}
+	rr := serveWithTwoFactorPolicy(stubTwoFactorPolicy{}, u)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestRequireTwoFactor_Missing_Error(t *testing.T) {
+	u := &entity.User{ID: uuid.New(), Role: entity.RoleAdmin}
+	rr := serveWithTwoFactorPolicy(stubTwoFactorPolicy{missing: true}, u)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Contains(t, rr.Body.String(), "TWO_FACTOR_REQUIRED")
+}
+
+func TestRequireTwoFactor_PolicyError(t *testing.T) {
+	u := &entity.User{ID: uuid.New(), Role: entity.RoleAdmin}
+	rr := serveWithTwoFactorPolicy(stubTwoFactorPolicy{err: errors.New("db down")}, u)
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestRequireTwoFactor_NoUser_Error(t *testing.T) {
+	rr := serveWithTwoFactorPolicy(stubTwoFactorPolicy{}, nil)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
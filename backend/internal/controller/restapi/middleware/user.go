@@ -34,8 +34,14 @@ func InjectUser(userUC *user.UserUseCase) func(http.Handler) http.Handler {
 				httputil.RenderError(w, r, http.StatusUnauthorized, "user not found")
 				return
 			}
+			if user.IsBanned {
+				httputil.RenderError(w, r, http.StatusForbidden, "user is banned")
+				return
+			}
 
 			ctx := context.WithValue(r.Context(), userContextKey, user)
+			// The stored role wins over the one in the access token so role changes apply immediately.
+			ctx = context.WithValue(ctx, UserRoleKey, user.Role)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/middleware"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Change password
+// (PUT /user/password)
+func (h *Server) PutUserPassword(w http.ResponseWriter, r *http.Request) {
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	// Rate Limit: 5 attempts per hour per user, so a hijacked session cannot guess the current password
+	if !h.accountRateLimit(w, r, "account:password", user.ID.String(), 5, time.Hour, "PutUserPassword") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChangePasswordRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutUserPassword",
+	)
+	if !ok {
+		return
+	}
+
+	currentPassword, newPassword := request.ChangePasswordRequestParams(&req)
+	sessionID, _ := middleware.GetSessionID(r.Context())
+	err := h.user.AccountUC.ChangePassword(r.Context(), user.ID, sessionID, currentPassword, newPassword, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PutUserPassword", "ChangePassword") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Change username
+// (PUT /user/username)
+func (h *Server) PutUserUsername(w http.ResponseWriter, r *http.Request) {
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	// Rate Limit: 3 changes per day per user
+	if !h.accountRateLimit(w, r, "account:username", user.ID.String(), 3, 24*time.Hour, "PutUserUsername") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChangeUsernameRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutUserUsername",
+	)
+	if !ok {
+		return
+	}
+
+	updated, err := h.user.AccountUC.ChangeUsername(r.Context(), user.ID, req.Username, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PutUserUsername", "ChangeUsername") {
+		return
+	}
+
+	h.renderMe(w, r, updated)
+}
+
+// Change email
+// (PUT /user/email)
+func (h *Server) PutUserEmail(w http.ResponseWriter, r *http.Request) {
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	// Rate Limit: 3 requests per hour per user, each one may send an email
+	if !h.accountRateLimit(w, r, "account:email", user.ID.String(), 3, time.Hour, "PutUserEmail") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChangeEmailRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutUserEmail",
+	)
+	if !ok {
+		return
+	}
+
+	email, password := request.ChangeEmailRequestParams(&req)
+	pending, err := h.user.AccountUC.RequestEmailChange(r.Context(), user.ID, email, password, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PutUserEmail", "RequestEmailChange") {
+		return
+	}
+
+	current := user.Email
+	if !pending {
+		current = email
+	}
+	helper.RenderOK(w, r, openapi.ResponseEmailChangeResponse{Pending: pending, Email: current})
+}
+
+// Delete account
+// (DELETE /user/me)
+func (h *Server) DeleteUserMe(w http.ResponseWriter, r *http.Request) {
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	// Rate Limit: 5 attempts per hour per user, so a hijacked session cannot guess the current password
+	if !h.accountRateLimit(w, r, "account:delete", user.ID.String(), 5, time.Hour, "DeleteUserMe") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestDeleteAccountRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "DeleteUserMe",
+	)
+	if !ok {
+		return
+	}
+
+	err := h.user.AccountUC.Delete(r.Context(), user.ID, request.DeleteAccountRequestPassword(&req), helper.GetClientIP(r))
+	if h.OnError(w, r, err, "DeleteUserMe", "Delete") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+func (h *Server) accountRateLimit(w http.ResponseWriter, r *http.Request, prefix, key string, limit int64, window time.Duration, op string) bool {
+	allowed, err := middleware.CheckRateLimit(r.Context(), h.infra.RedisClient, prefix, key, limit, window)
+	if err != nil {
+		h.infra.Logger.WithError(err).Error("restapi - v1 - " + op + " - CheckRateLimit")
+		helper.RenderError(w, r, http.StatusInternalServerError, "rate limit check failed")
+		return false
+	}
+	if !allowed {
+		helper.RenderError(w, r, http.StatusTooManyRequests, "too many requests")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,192 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/internal/usecase/user"
+)
+
+// List users
+// (GET /admin/users)
+func (h *Server) GetAdminUsers(w http.ResponseWriter, r *http.Request, params openapi.GetAdminUsersParams) {
+	page, perPage := getPagePerPage(params.Page, params.PerPage)
+
+	users, total, err := h.user.AdminUserUC.List(r.Context(), request.AdminUsersParamsToFilter(params), page, perPage)
+	if h.OnError(w, r, err, "GetAdminUsers", "List") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAdminUserList(users, total, page, perPage))
+}
+
+// Get user
+// (GET /admin/users/{ID})
+func (h *Server) GetAdminUsersID(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	u, err := h.user.AdminUserUC.Get(r.Context(), userID)
+	if h.OnError(w, r, err, "GetAdminUsersID", "Get") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAdminUser(u))
+}
+
+// Update user
+// (PATCH /admin/users/{ID})
+func (h *Server) PatchAdminUsersID(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestAdminUpdateUserRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PatchAdminUsersID",
+	)
+	if !ok {
+		return
+	}
+
+	username, email, verified := request.AdminUpdateUserRequestToParams(&req)
+	upd := user.AdminUserUpdate{Username: username, Email: email, Verified: verified}
+	u, err := h.user.AdminUserUC.Update(r.Context(), userID, upd, admin.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PatchAdminUsersID", "Update") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAdminUser(u))
+}
+
+// Delete user
+// (DELETE /admin/users/{ID})
+func (h *Server) DeleteAdminUsersID(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.AdminUserUC.Delete(r.Context(), userID, admin.ID, helper.GetClientIP(r)), "DeleteAdminUsersID", "Delete") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Ban user
+// (POST /admin/users/{ID}/ban)
+func (h *Server) PostAdminUsersIDBan(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestBanUserRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminUsersIDBan",
+	)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.AdminUserUC.Ban(r.Context(), userID, req.Reason, admin.ID, helper.GetClientIP(r)), "PostAdminUsersIDBan", "Ban") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Unban user
+// (DELETE /admin/users/{ID}/ban)
+func (h *Server) DeleteAdminUsersIDBan(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.AdminUserUC.Unban(r.Context(), userID, admin.ID, helper.GetClientIP(r)), "DeleteAdminUsersIDBan", "Unban") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Reset user password
+// (PUT /admin/users/{ID}/password)
+func (h *Server) PutAdminUsersIDPassword(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestAdminResetPasswordRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminUsersIDPassword",
+	)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.AdminUserUC.ResetPassword(r.Context(), userID, req.Password, admin.ID, helper.GetClientIP(r)), "PutAdminUsersIDPassword", "ResetPassword") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Move user to team
+// (PUT /admin/users/{ID}/team)
+func (h *Server) PutAdminUsersIDTeam(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestMoveUserTeamRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminUsersIDTeam",
+	)
+	if !ok {
+		return
+	}
+
+	u, err := h.user.AdminUserUC.MoveTeam(r.Context(), userID, req.TeamID, admin.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PutAdminUsersIDTeam", "MoveTeam") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAdminUser(u))
+}
@@ -22,11 +22,7 @@ func (h *Server) GetUserTokens(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res := make([]openapi.ResponseAPITokenResponse, len(tokens))
-	for i, t := range tokens {
-		res[i] = response.FromAPIToken(t)
-	}
-	helper.RenderOK(w, r, res)
+	helper.RenderOK(w, r, response.FromAPITokenList(tokens))
 }
 
 // Create API token
@@ -44,8 +40,8 @@ func (h *Server) PostUserTokens(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	description, expiresAt := request.CreateAPITokenParams(&req)
-	plaintext, token, err := h.user.APITokenUC.Create(r.Context(), userID, description, expiresAt)
+	description, scopes, expiresAt := request.CreateAPITokenParams(&req)
+	plaintext, token, err := h.user.APITokenUC.Create(r.Context(), userID, description, scopes, expiresAt)
 	if h.OnError(w, r, err, "PostUserTokens", "Create") {
 		return
 	}
@@ -72,3 +68,96 @@ func (h *Server) DeleteUserTokensID(w http.ResponseWriter, r *http.Request, id s
 
 	helper.RenderNoContent(w, r)
 }
+
+// List API token requests
+// (GET /user/tokens/{ID}/requests)
+func (h *Server) GetUserTokensIDRequests(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	tokenID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	reqs, err := h.user.APITokenUC.ListRequests(r.Context(), tokenID, userID)
+	if h.OnError(w, r, err, "GetUserTokensIDRequests", "ListRequests") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAPITokenRequestList(reqs))
+}
+
+// List service tokens
+// (GET /admin/tokens)
+func (h *Server) GetAdminTokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := h.user.APITokenUC.ListService(r.Context())
+	if h.OnError(w, r, err, "GetAdminTokens", "ListService") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAPITokenList(tokens))
+}
+
+// Create service token
+// (POST /admin/tokens)
+func (h *Server) PostAdminTokens(w http.ResponseWriter, r *http.Request) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestCreateServiceTokenRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminTokens",
+	)
+	if !ok {
+		return
+	}
+
+	description, scopes, expiresAt := request.CreateServiceTokenParams(&req)
+	plaintext, token, err := h.user.APITokenUC.CreateService(r.Context(), admin.ID, description, scopes, expiresAt, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PostAdminTokens", "CreateService") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromAPITokenCreated(plaintext, token))
+}
+
+// Revoke service token
+// (DELETE /admin/tokens/{ID})
+func (h *Server) DeleteAdminTokensID(w http.ResponseWriter, r *http.Request, id string) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	tokenID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	err := h.user.APITokenUC.DeleteService(r.Context(), tokenID, admin.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "DeleteAdminTokensID", "DeleteService") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// List token requests
+// (GET /admin/tokens/{ID}/requests)
+func (h *Server) GetAdminTokensIDRequests(w http.ResponseWriter, r *http.Request, id string) {
+	tokenID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	reqs, err := h.user.APITokenUC.ListTokenRequests(r.Context(), tokenID)
+	if h.OnError(w, r, err, "GetAdminTokensIDRequests", "ListTokenRequests") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAPITokenRequestList(reqs))
+}
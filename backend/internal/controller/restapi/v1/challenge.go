@@ -1,14 +1,17 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/middleware"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/internal/openapi"
 )
 
@@ -58,19 +61,36 @@ func (h *Server) PostChallengesIDSubmit(w http.ResponseWriter, r *http.Request,
 	flag := request.SubmitFlagRequestToFlag(&req)
 	valid, err := h.challenge.ChallengeUC.SubmitFlag(r.Context(), challengeuuid, flag, user.ID, user.TeamID)
 
-	sub := &entity.Submission{
-		UserID:        user.ID,
-		ChallengeID:   challengeuuid,
-		SubmittedFlag: flag,
-		IsCorrect:     valid,
-		IP:            helper.GetClientIP(r),
-		CreatedAt:     time.Now(),
+	// Failed submissions count against attempt limits, so only flags that were judged are logged
+	if err == nil || errors.Is(err, entityError.ErrSubmissionPendingReview) || errors.Is(err, entityError.ErrStageCompleted) {
+		sub := &entity.Submission{
+			UserID:        user.ID,
+			ChallengeID:   challengeuuid,
+			SubmittedFlag: flag,
+			IsCorrect:     valid,
+			IP:            helper.GetClientIP(r),
+			CreatedAt:     time.Now(),
+		}
+		if user.TeamID != nil {
+			sub.TeamID = user.TeamID
+		}
+		if logErr := h.comp.SubmissionUC.LogSubmission(r.Context(), sub); logErr != nil {
+			h.infra.Logger.WithError(logErr).Error("restapi - v1 - PostChallengesIDSubmit - LogSubmission")
+		} else if errors.Is(err, entityError.ErrSubmissionPendingReview) && user.TeamID != nil {
+			if linkErr := h.challenge.ChallengeUC.LinkReviewSubmission(r.Context(), challengeuuid, *user.TeamID, sub.ID); linkErr != nil {
+				h.infra.Logger.WithError(linkErr).Error("restapi - v1 - PostChallengesIDSubmit - LinkReviewSubmission")
+			}
+		}
 	}
-	if user.TeamID != nil {
-		sub.TeamID = user.TeamID
+
+	if errors.Is(err, entityError.ErrSubmissionPendingReview) {
+		helper.RenderJSON(w, r, http.StatusAccepted, map[string]string{"message": "submission queued for review"})
+		return
 	}
-	if logErr := h.comp.SubmissionUC.LogSubmission(r.Context(), sub); logErr != nil {
-		h.infra.Logger.WithError(logErr).Error("restapi - v1 - PostChallengesIDSubmit - LogSubmission")
+
+	if errors.Is(err, entityError.ErrStageCompleted) {
+		helper.RenderOK(w, r, map[string]string{"message": "stage completed"})
+		return
 	}
 
 	if h.OnError(w, r, err, "PostChallengesIDSubmit", "SubmitFlag") {
@@ -105,6 +125,16 @@ func (h *Server) PostAdminChallenges(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Service tokens have no user to own the challenge
+	if user, ok := middleware.GetUser(r.Context()); ok {
+		if h.OnError(w, r, h.user.RoleUC.AddChallengeAuthor(r.Context(), challenge.ID, user.ID), "PostAdminChallenges", "AddChallengeAuthor") {
+			return
+		}
+	}
+	if !h.recordRevision(w, r, challenge.ID, "PostAdminChallenges") {
+		return
+	}
+
 	helper.RenderCreated(w, r, response.FromChallenge(challenge))
 }
 
@@ -116,6 +146,10 @@ func (h *Server) DeleteAdminChallengesID(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	if !h.authorizeChallenge(w, r, challengeuuid, "DeleteAdminChallengesID") {
+		return
+	}
+
 	user, ok := helper.RequireUser(w, r)
 	if !ok {
 		return
@@ -139,6 +173,10 @@ func (h *Server) PutAdminChallengesID(w http.ResponseWriter, r *http.Request, ID
 		return
 	}
 
+	if !h.authorizeChallenge(w, r, challengeuuid, "PutAdminChallengesID") {
+		return
+	}
+
 	req, ok := helper.DecodeAndValidate[openapi.RequestUpdateChallengeRequest](
 		w, r, h.infra.Validator, h.infra.Logger, "PutAdminChallengesID",
 	)
@@ -156,6 +194,9 @@ func (h *Server) PutAdminChallengesID(w http.ResponseWriter, r *http.Request, ID
 	if h.OnError(w, r, err, "PutAdminChallengesID", "Update") {
 		return
 	}
+	if !h.recordRevision(w, r, challengeuuid, "PutAdminChallengesID") {
+		return
+	}
 
 	helper.RenderOK(w, r, response.FromChallenge(challenge))
 }
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Get attempt limit
+// (GET /admin/challenges/{challengeID}/attempt-limit)
+func (h *Server) GetAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDAttemptLimit") {
+		return
+	}
+
+	limit, err := h.challenge.ChallengeUC.GetAttemptLimit(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDAttemptLimit", "GetAttemptLimit") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAttemptLimit(limit))
+}
+
+// Set attempt limit
+// (PUT /admin/challenges/{challengeID}/attempt-limit)
+func (h *Server) PutAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PutAdminChallengesChallengeIDAttemptLimit") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestAttemptLimitRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminChallengesChallengeIDAttemptLimit",
+	)
+	if !ok {
+		return
+	}
+
+	maxAttempts, wrongPenalty := request.AttemptLimitRequestToParams(&req)
+	limit, err := h.challenge.ChallengeUC.SetAttemptLimit(r.Context(), challengeuuid, maxAttempts, wrongPenalty)
+	if h.OnError(w, r, err, "PutAdminChallengesChallengeIDAttemptLimit", "SetAttemptLimit") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromAttemptLimit(limit))
+}
+
+// Delete attempt limit
+// (DELETE /admin/challenges/{challengeID}/attempt-limit)
+func (h *Server) DeleteAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "DeleteAdminChallengesChallengeIDAttemptLimit") {
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.ChallengeUC.DeleteAttemptLimit(r.Context(), challengeuuid), "DeleteAdminChallengesChallengeIDAttemptLimit", "DeleteAttemptLimit") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Reset team attempts
+// (DELETE /admin/challenges/{challengeID}/attempts/{teamID})
+func (h *Server) DeleteAdminChallengesChallengeIDAttemptsTeamID(w http.ResponseWriter, r *http.Request, challengeID, teamID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	teamuuid, ok := helper.ParseUUID(w, r, teamID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "DeleteAdminChallengesChallengeIDAttemptsTeamID") {
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.ChallengeUC.ResetAttempts(r.Context(), challengeuuid, teamuuid), "DeleteAdminChallengesChallengeIDAttemptsTeamID", "ResetAttempts") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
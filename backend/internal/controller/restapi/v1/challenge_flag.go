@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// List challenge flags
+// (GET /admin/challenges/{challengeID}/flags)
+func (h *Server) GetAdminChallengesChallengeIDFlags(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDFlags") {
+		return
+	}
+
+	flags, err := h.challenge.ChallengeUC.ListFlags(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDFlags", "ListFlags") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeFlagList(flags))
+}
+
+// Create challenge flag
+// (POST /admin/challenges/{challengeID}/flags)
+func (h *Server) PostAdminChallengesChallengeIDFlags(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PostAdminChallengesChallengeIDFlags") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChallengeFlagRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminChallengesChallengeIDFlags",
+	)
+	if !ok {
+		return
+	}
+
+	flagType, value, isCaseInsensitive := request.ChallengeFlagRequestToParams(&req)
+	flag, err := h.challenge.ChallengeUC.CreateFlag(r.Context(), challengeuuid, flagType, value, isCaseInsensitive)
+	if h.OnError(w, r, err, "PostAdminChallengesChallengeIDFlags", "CreateFlag") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromChallengeFlag(flag))
+}
+
+// Update challenge flag
+// (PUT /admin/flags/{ID})
+func (h *Server) PutAdminFlagsID(w http.ResponseWriter, r *http.Request, ID string) {
+	flaguuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	flag, err := h.challenge.ChallengeUC.GetFlag(r.Context(), flaguuid)
+	if h.OnError(w, r, err, "PutAdminFlagsID", "GetFlag") {
+		return
+	}
+	if !h.authorizeChallenge(w, r, flag.ChallengeID, "PutAdminFlagsID") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChallengeFlagRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminFlagsID",
+	)
+	if !ok {
+		return
+	}
+
+	flagType, value, isCaseInsensitive := request.ChallengeFlagRequestToParams(&req)
+	flag, err = h.challenge.ChallengeUC.UpdateFlag(r.Context(), flaguuid, flagType, value, isCaseInsensitive)
+	if h.OnError(w, r, err, "PutAdminFlagsID", "UpdateFlag") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeFlag(flag))
+}
+
+// Delete challenge flag
+// (DELETE /admin/flags/{ID})
+func (h *Server) DeleteAdminFlagsID(w http.ResponseWriter, r *http.Request, ID string) {
+	flaguuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	flag, err := h.challenge.ChallengeUC.GetFlag(r.Context(), flaguuid)
+	if h.OnError(w, r, err, "DeleteAdminFlagsID", "GetFlag") {
+		return
+	}
+	if !h.authorizeChallenge(w, r, flag.ChallengeID, "DeleteAdminFlagsID") {
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.ChallengeUC.DeleteFlag(r.Context(), flaguuid), "DeleteAdminFlagsID", "DeleteFlag") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Get challenge unlock requirements
+// (GET /admin/challenges/{challengeID}/requirements)
+func (h *Server) GetAdminChallengesChallengeIDRequirements(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDRequirements") {
+		return
+	}
+
+	req, err := h.challenge.ChallengeUC.GetRequirements(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDRequirements", "GetRequirements") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeRequirements(req))
+}
+
+// Set challenge unlock requirements
+// (PUT /admin/challenges/{challengeID}/requirements)
+func (h *Server) PutAdminChallengesChallengeIDRequirements(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PutAdminChallengesChallengeIDRequirements") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChallengeRequirementsRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminChallengesChallengeIDRequirements",
+	)
+	if !ok {
+		return
+	}
+
+	prerequisites, minScore := request.ChallengeRequirementsRequestToParams(&req)
+	requirements, err := h.challenge.ChallengeUC.SetRequirements(r.Context(), challengeuuid, prerequisites, minScore)
+	if h.OnError(w, r, err, "PutAdminChallengesChallengeIDRequirements", "SetRequirements") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeRequirements(requirements))
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Get challenge release schedule
+// (GET /admin/challenges/{challengeID}/schedule)
+func (h *Server) GetAdminChallengesChallengeIDSchedule(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDSchedule") {
+		return
+	}
+
+	schedule, err := h.challenge.ChallengeUC.GetSchedule(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDSchedule", "GetSchedule") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeSchedule(schedule))
+}
+
+// Set challenge release schedule
+// (PUT /admin/challenges/{challengeID}/schedule)
+func (h *Server) PutAdminChallengesChallengeIDSchedule(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PutAdminChallengesChallengeIDSchedule") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChallengeScheduleRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminChallengesChallengeIDSchedule",
+	)
+	if !ok {
+		return
+	}
+
+	releaseAt, hideAt, notifyOnRelease := request.ChallengeScheduleRequestToParams(&req)
+	schedule, err := h.challenge.ChallengeUC.SetSchedule(r.Context(), challengeuuid, releaseAt, hideAt, notifyOnRelease)
+	if h.OnError(w, r, err, "PutAdminChallengesChallengeIDSchedule", "SetSchedule") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeSchedule(schedule))
+}
+
+// Get challenge release timeline
+// (GET /admin/release-timeline)
+func (h *Server) GetAdminReleaseTimeline(w http.ResponseWriter, r *http.Request) {
+	timeline, err := h.challenge.ChallengeUC.GetReleaseTimeline(r.Context())
+	if h.OnError(w, r, err, "GetAdminReleaseTimeline", "GetReleaseTimeline") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromReleaseTimeline(timeline))
+}
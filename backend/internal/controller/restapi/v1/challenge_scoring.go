@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// List scoring functions
+// (GET /admin/scoring/functions)
+func (h *Server) GetAdminScoringFunctions(w http.ResponseWriter, r *http.Request) {
+	helper.RenderOK(w, r, response.FromScoringFunctionList(h.challenge.ChallengeUC.ListScoringFunctions()))
+}
+
+// Preview scoring function
+// (POST /admin/scoring/preview)
+func (h *Server) PostAdminScoringPreview(w http.ResponseWriter, r *http.Request) {
+	req, ok := helper.DecodeAndValidate[openapi.RequestScoringPreviewRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminScoringPreview",
+	)
+	if !ok {
+		return
+	}
+
+	function, params, solves, elapsed := request.ScoringPreviewRequestToParams(&req)
+	points, err := h.challenge.ChallengeUC.PreviewScoring(function, params, solves, elapsed)
+	if h.OnError(w, r, err, "PostAdminScoringPreview", "PreviewScoring") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromScoringPreview(function, points))
+}
+
+// Get challenge scoring
+// (GET /admin/challenges/{challengeID}/scoring)
+func (h *Server) GetAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDScoring") {
+		return
+	}
+
+	state, err := h.challenge.ChallengeUC.GetScoring(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDScoring", "GetScoring") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeScoring(state))
+}
+
+// Set challenge scoring
+// (PUT /admin/challenges/{challengeID}/scoring)
+func (h *Server) PutAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PutAdminChallengesChallengeIDScoring") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChallengeScoringRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminChallengesChallengeIDScoring",
+	)
+	if !ok {
+		return
+	}
+
+	state, err := h.challenge.ChallengeUC.SetScoring(r.Context(), challengeuuid, req.Function, req.Params)
+	if h.OnError(w, r, err, "PutAdminChallengesChallengeIDScoring", "SetScoring") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeScoring(state))
+}
+
+// Reset challenge scoring
+// (DELETE /admin/challenges/{challengeID}/scoring)
+func (h *Server) DeleteAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "DeleteAdminChallengesChallengeIDScoring") {
+		return
+	}
+
+	state, err := h.challenge.ChallengeUC.ResetScoring(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "DeleteAdminChallengesChallengeIDScoring", "ResetScoring") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeScoring(state))
+}
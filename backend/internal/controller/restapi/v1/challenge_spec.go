@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Export challenge specs
+// (GET /admin/challenge-specs/export)
+func (h *Server) GetAdminChallengeSpecsExport(w http.ResponseWriter, r *http.Request, params openapi.GetAdminChallengeSpecsExportParams) {
+	filter := entity.SpecExportFilter{ChallengeID: params.Challenge}
+	if params.Category != nil {
+		filter.Category = *params.Category
+	}
+
+	rc, err := h.challenge.SpecUC.Export(r.Context(), filter)
+	if h.OnError(w, r, err, "GetAdminChallengeSpecsExport", "Export") {
+		return
+	}
+	defer rc.Close()
+
+	filename := fmt.Sprintf("challenges-%s.zip", time.Now().UTC().Format("20060102T150405Z"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, rc); err != nil {
+		h.infra.Logger.WithError(err).Error("restapi - v1 - GetAdminChallengeSpecsExport - copy")
+	}
+}
+
+// Import challenge specs
+// (POST /admin/challenge-specs/import)
+func (h *Server) PostAdminChallengeSpecsImport(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(500 << 20); err != nil {
+		helper.RenderError(w, r, http.StatusBadRequest, "failed to parse form")
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		helper.RenderError(w, r, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		helper.RenderError(w, r, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	dryRun := r.FormValue("dry_run") == "true"
+	result, err := h.challenge.SpecUC.Import(r.Context(), bytes.NewReader(data), header.Size, dryRun, optionalUserID(r))
+	if h.OnError(w, r, err, "PostAdminChallengeSpecsImport", "Import") {
+		return
+	}
+
+	status := http.StatusOK
+	if len(result.Problems) > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	helper.RenderJSON(w, r, status, result)
+}
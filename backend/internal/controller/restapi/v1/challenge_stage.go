@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// List challenge stages
+// (GET /admin/challenges/{challengeID}/stages)
+func (h *Server) GetAdminChallengesChallengeIDStages(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDStages") {
+		return
+	}
+
+	stages, err := h.challenge.ChallengeUC.ListStages(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDStages", "ListStages") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeStageAdminList(stages))
+}
+
+// Create challenge stage
+// (POST /admin/challenges/{challengeID}/stages)
+func (h *Server) PostAdminChallengesChallengeIDStages(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PostAdminChallengesChallengeIDStages") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestChallengeStageRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminChallengesChallengeIDStages",
+	)
+	if !ok {
+		return
+	}
+
+	title, points, flags := request.ChallengeStageRequestToParams(&req)
+	stage, err := h.challenge.ChallengeUC.CreateStage(r.Context(), challengeuuid, title, points, flags)
+	if h.OnError(w, r, err, "PostAdminChallengesChallengeIDStages", "CreateStage") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromChallengeStageAdmin(stage))
+}
+
+// Update challenge stage
+// (PUT /admin/stages/{ID})
+func (h *Server) PutAdminStagesID(w http.ResponseWriter, r *http.Request, ID string) {
+	stageuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	stage, err := h.challenge.ChallengeUC.GetStage(r.Context(), stageuuid)
+	if h.OnError(w, r, err, "PutAdminStagesID", "GetStage") {
+		return
+	}
+	if !h.authorizeChallenge(w, r, stage.ChallengeID, "PutAdminStagesID") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestUpdateChallengeStageRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminStagesID",
+	)
+	if !ok {
+		return
+	}
+
+	title, points, orderIndex, flags := request.UpdateChallengeStageRequestToParams(&req)
+	stage, err = h.challenge.ChallengeUC.UpdateStage(r.Context(), stageuuid, title, points, orderIndex, flags)
+	if h.OnError(w, r, err, "PutAdminStagesID", "UpdateStage") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeStageAdmin(stage))
+}
+
+// Delete challenge stage
+// (DELETE /admin/stages/{ID})
+func (h *Server) DeleteAdminStagesID(w http.ResponseWriter, r *http.Request, ID string) {
+	stageuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	stage, err := h.challenge.ChallengeUC.GetStage(r.Context(), stageuuid)
+	if h.OnError(w, r, err, "DeleteAdminStagesID", "GetStage") {
+		return
+	}
+	if !h.authorizeChallenge(w, r, stage.ChallengeID, "DeleteAdminStagesID") {
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.ChallengeUC.DeleteStage(r.Context(), stageuuid), "DeleteAdminStagesID", "DeleteStage") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Get challenge stages
+// (GET /challenges/{ID}/stages)
+func (h *Server) GetChallengesIDStages(w http.ResponseWriter, r *http.Request, ID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	stages, err := h.challenge.ChallengeUC.GetStages(r.Context(), challengeuuid, user.TeamID)
+	if h.OnError(w, r, err, "GetChallengesIDStages", "GetStages") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeStageList(stages))
+}
@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Analyze submissions for cheating
+// (POST /admin/cheat-incidents/analyze)
+func (h *Server) PostAdminCheatIncidentsAnalyze(w http.ResponseWriter, r *http.Request) {
+	req, ok := helper.DecodeAndValidate[openapi.RequestCheatAnalysisRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminCheatIncidentsAnalyze",
+	)
+	if !ok {
+		return
+	}
+
+	result, err := h.comp.AntiCheatUC.Analyze(r.Context(), request.CheatAnalysisRequestToOptions(&req))
+	if h.OnError(w, r, err, "PostAdminCheatIncidentsAnalyze", "Analyze") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromCheatAnalysisResult(result))
+}
+
+// Get cheat incident
+// (GET /admin/cheat-incidents/{ID})
+func (h *Server) GetAdminCheatIncidentsID(w http.ResponseWriter, r *http.Request, ID string) {
+	incidentuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	incident, subs, err := h.comp.AntiCheatUC.GetIncident(r.Context(), incidentuuid)
+	if h.OnError(w, r, err, "GetAdminCheatIncidentsID", "GetIncident") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromCheatIncidentDetail(incident, subs, h.hasPermission(r, entity.PermFlagsRead)))
+}
+
+// Dismiss cheat incident
+// (POST /admin/cheat-incidents/{ID}/dismiss)
+func (h *Server) PostAdminCheatIncidentsIDDismiss(w http.ResponseWriter, r *http.Request, ID string) {
+	incidentuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	incident, err := h.comp.AntiCheatUC.Dismiss(r.Context(), incidentuuid, optionalUserID(r))
+	if h.OnError(w, r, err, "PostAdminCheatIncidentsIDDismiss", "Dismiss") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromCheatIncident(incident, h.hasPermission(r, entity.PermFlagsRead)))
+}
+
+// Escalate cheat incident
+// (POST /admin/cheat-incidents/{ID}/escalate)
+func (h *Server) PostAdminCheatIncidentsIDEscalate(w http.ResponseWriter, r *http.Request, ID string) {
+	incidentuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestEscalateCheatIncidentRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminCheatIncidentsIDEscalate",
+	)
+	if !ok {
+		return
+	}
+
+	incident, err := h.comp.AntiCheatUC.Escalate(r.Context(), incidentuuid, optionalUserID(r), request.EscalateCheatIncidentRequestReason(&req))
+	if h.OnError(w, r, err, "PostAdminCheatIncidentsIDEscalate", "Escalate") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromCheatIncident(incident, h.hasPermission(r, entity.PermFlagsRead)))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/entity"
 	"github.com/skr1ms/CTFBoard/internal/openapi"
 )
 
@@ -55,7 +56,14 @@ func (h *Server) DeleteCommentsID(w http.ResponseWriter, r *http.Request, id str
 	if !ok {
 		return
 	}
-	if h.OnError(w, r, h.challenge.CommentUC.Delete(r.Context(), commentID, user.ID), "DeleteCommentsID", "Delete") {
+	// Moderators may remove any comment, everyone else only their own
+	var err error
+	if h.hasPermission(r, entity.PermCommentsModerate) {
+		err = h.challenge.CommentUC.DeleteAny(r.Context(), commentID)
+	} else {
+		err = h.challenge.CommentUC.Delete(r.Context(), commentID, user.ID)
+	}
+	if h.OnError(w, r, err, "DeleteCommentsID", "Delete") {
 		return
 	}
 	helper.RenderNoContent(w, r)
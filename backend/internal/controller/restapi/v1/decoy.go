@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// List decoy flags
+// (GET /admin/challenges/{challengeID}/decoys)
+func (h *Server) GetAdminChallengesChallengeIDDecoys(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	decoys, err := h.challenge.ChallengeUC.ListDecoys(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDDecoys", "ListDecoys") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromDecoyFlagList(decoys))
+}
+
+// Create decoy flag
+// (POST /admin/challenges/{challengeID}/decoys)
+func (h *Server) PostAdminChallengesChallengeIDDecoys(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestDecoyFlagRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminChallengesChallengeIDDecoys",
+	)
+	if !ok {
+		return
+	}
+
+	value, isCaseInsensitive, note := request.DecoyFlagRequestToParams(&req)
+	decoy, err := h.challenge.ChallengeUC.CreateDecoy(r.Context(), challengeuuid, value, isCaseInsensitive, note)
+	if h.OnError(w, r, err, "PostAdminChallengesChallengeIDDecoys", "CreateDecoy") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromDecoyFlag(decoy))
+}
+
+// Delete decoy flag
+// (DELETE /admin/decoys/{ID})
+func (h *Server) DeleteAdminDecoysID(w http.ResponseWriter, r *http.Request, ID string) {
+	decoyuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.ChallengeUC.DeleteDecoy(r.Context(), decoyuuid), "DeleteAdminDecoysID", "DeleteDecoy") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Get decoy policy
+// (GET /admin/decoy-policy)
+func (h *Server) GetAdminDecoyPolicy(w http.ResponseWriter, r *http.Request) {
+	policy, err := h.challenge.ChallengeUC.GetDecoyPolicy(r.Context())
+	if h.OnError(w, r, err, "GetAdminDecoyPolicy", "GetDecoyPolicy") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromDecoyPolicy(policy))
+}
+
+// Update decoy policy
+// (PUT /admin/decoy-policy)
+func (h *Server) PutAdminDecoyPolicy(w http.ResponseWriter, r *http.Request) {
+	req, ok := helper.DecodeAndValidate[openapi.RequestDecoyPolicyRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminDecoyPolicy",
+	)
+	if !ok {
+		return
+	}
+
+	policy, err := h.challenge.ChallengeUC.SetDecoyPolicy(r.Context(), request.DecoyPolicyRequestToEntity(&req))
+	if h.OnError(w, r, err, "PutAdminDecoyPolicy", "SetDecoyPolicy") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromDecoyPolicy(policy))
+}
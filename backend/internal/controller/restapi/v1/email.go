@@ -3,6 +3,7 @@ package v1
 import (
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/middleware"
@@ -39,6 +40,35 @@ func (h *Server) GetAuthVerifyEmail(w http.ResponseWriter, r *http.Request, para
 	helper.RenderOK(w, r, map[string]string{"message": "email verified successfully"})
 }
 
+// Confirm email change
+// (GET /auth/confirm-email)
+func (h *Server) GetAuthConfirmEmail(w http.ResponseWriter, r *http.Request, params openapi.GetAuthConfirmEmailParams) {
+	if params.Token == "" {
+		helper.RenderError(w, r, http.StatusBadRequest, "token is required")
+		return
+	}
+
+	err := h.user.EmailUC.ConfirmEmailChange(r.Context(), params.Token)
+	if err != nil {
+		h.infra.Logger.WithError(err).Error("restapi - v1 - GetAuthConfirmEmail")
+
+		if errors.Is(err, entityError.ErrTokenNotFound) {
+			helper.RenderError(w, r, http.StatusNotFound, "invalid token")
+		} else if errors.Is(err, entityError.ErrTokenExpired) {
+			helper.RenderError(w, r, http.StatusGone, "token expired")
+		} else if errors.Is(err, entityError.ErrTokenAlreadyUsed) {
+			helper.RenderError(w, r, http.StatusConflict, "token already used")
+		} else if errors.Is(err, entityError.ErrUserAlreadyExists) {
+			helper.RenderError(w, r, http.StatusConflict, "email already in use")
+		} else {
+			helper.RenderError(w, r, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	helper.RenderOK(w, r, map[string]string{"message": "email changed successfully"})
+}
+
 // Request password reset
 // (POST /auth/forgot-password)
 func (h *Server) PostAuthForgotPassword(w http.ResponseWriter, r *http.Request) {
@@ -63,8 +93,19 @@ func (h *Server) PostAuthForgotPassword(w http.ResponseWriter, r *http.Request)
 	}
 
 	email := request.ForgotPasswordRequestEmail(&req)
-	if err := h.user.EmailUC.SendPasswordResetEmail(r.Context(), email); err != nil {
-		h.infra.Logger.WithError(err).Error("restapi - v1 - PostAuthForgotPassword - SendPasswordResetEmail")
+
+	// Rate Limit: 3 requests per hour per target email, so rotating IPs cannot flood one inbox.
+	// Over the limit the response stays the same to avoid revealing anything about the account.
+	allowed, err = middleware.CheckRateLimit(r.Context(), h.infra.RedisClient, "forgot:email", strings.ToLower(email), 3, time.Hour)
+	if err != nil {
+		h.infra.Logger.WithError(err).Error("restapi - v1 - PostAuthForgotPassword - CheckRateLimit email")
+		helper.RenderError(w, r, http.StatusInternalServerError, "rate limit check failed")
+		return
+	}
+	if allowed {
+		if err := h.user.EmailUC.SendPasswordResetEmail(r.Context(), email); err != nil {
+			h.infra.Logger.WithError(err).Error("restapi - v1 - PostAuthForgotPassword - SendPasswordResetEmail")
+		}
 	}
 
 	helper.RenderOK(w, r, map[string]string{"message": "if an account exists with this email, a password reset link has been sent"})
@@ -108,8 +149,11 @@ func (h *Server) PostAuthResendVerification(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Rate Limit: 10 requests per day per user (resend)
+	// Rate Limit: 10 requests per day per user and 20 per day per IP (resend)
 	allowed, err := middleware.CheckRateLimit(r.Context(), h.infra.RedisClient, "resend", useruuid.String(), 10, 24*time.Hour)
+	if err == nil && allowed {
+		allowed, err = middleware.CheckRateLimit(r.Context(), h.infra.RedisClient, "resend:ip", helper.GetClientIP(r), 20, 24*time.Hour)
+	}
 	if err != nil {
 		h.infra.Logger.WithError(err).Error("restapi - v1 - PostAuthResendVerification - CheckRateLimit")
 		helper.RenderError(w, r, http.StatusInternalServerError, "rate limit check failed")
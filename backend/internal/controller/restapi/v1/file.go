@@ -20,6 +20,10 @@ func (h *Server) PostAdminChallengesChallengeIDFiles(w http.ResponseWriter, r *h
 		return
 	}
 
+	if !h.authorizeChallenge(w, r, challengeuuid, "PostAdminChallengesChallengeIDFiles") {
+		return
+	}
+
 	if err := r.ParseMultipartForm(100 << 20); err != nil {
 		h.infra.Logger.WithError(err).Error("restapi - v1 - PostAdminChallengesChallengeIDFiles - ParseMultipartForm")
 		helper.RenderError(w, r, http.StatusBadRequest, "failed to parse form")
@@ -66,7 +70,15 @@ func (h *Server) DeleteAdminFilesID(w http.ResponseWriter, r *http.Request, ID s
 		return
 	}
 
-	err := h.challenge.FileUC.Delete(r.Context(), fileuuid)
+	file, err := h.challenge.FileUC.GetByID(r.Context(), fileuuid)
+	if h.OnError(w, r, err, "DeleteAdminFilesID", "GetByID") {
+		return
+	}
+	if !h.authorizeChallenge(w, r, file.ChallengeID, "DeleteAdminFilesID") {
+		return
+	}
+
+	err = h.challenge.FileUC.Delete(r.Context(), fileuuid)
 	if err != nil {
 		if errors.Is(err, entityError.ErrFileNotFound) {
 			helper.RenderError(w, r, http.StatusNotFound, "file not found")
@@ -89,7 +101,12 @@ func (h *Server) GetFilesIDDownload(w http.ResponseWriter, r *http.Request, ID s
 		return
 	}
 
-	url, err := h.challenge.FileUC.GetDownloadURL(r.Context(), fileuuid)
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	url, err := h.challenge.FileUC.GetDownloadURL(r.Context(), fileuuid, user.TeamID)
 	if err != nil {
 		if errors.Is(err, entityError.ErrFileNotFound) {
 			helper.RenderError(w, r, http.StatusNotFound, "file not found")
@@ -100,6 +117,11 @@ func (h *Server) GetFilesIDDownload(w http.ResponseWriter, r *http.Request, ID s
 		return
 	}
 
+	download := &entity.FileDownload{FileID: fileuuid, UserID: user.ID, TeamID: user.TeamID, IP: helper.GetClientIP(r)}
+	if err := h.challenge.FileUC.RecordDownload(r.Context(), download); err != nil {
+		h.infra.Logger.WithError(err).Error("restapi - v1 - GetFilesIDDownload - RecordDownload")
+	}
+
 	helper.RenderOK(w, r, map[string]string{"url": url})
 }
 
@@ -111,12 +133,17 @@ func (h *Server) GetChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
 	fileType := entity.FileTypeChallenge
 	if params.Type != nil && *params.Type == "writeup" {
 		fileType = entity.FileTypeWriteup
 	}
 
-	files, err := h.challenge.FileUC.GetByChallengeID(r.Context(), challengeuuid, fileType)
+	files, err := h.challenge.FileUC.GetByChallengeID(r.Context(), challengeuuid, user.TeamID, fileType)
 	if h.OnError(w, r, err, "GetChallengesChallengeIDFiles", "GetByChallengeID") {
 		return
 	}
@@ -22,6 +22,9 @@ type ChallengeDeps struct {
 	FileUC      *challenge.FileUseCase
 	TagUC       *challenge.TagUseCase
 	CommentUC   *challenge.CommentUseCase
+	InstanceUC  *challenge.InstanceUseCase
+	RevisionUC  *challenge.RevisionUseCase
+	SpecUC      *challenge.SpecUseCase
 }
 
 type TeamDeps struct {
@@ -30,9 +33,17 @@ type TeamDeps struct {
 }
 
 type UserDeps struct {
-	UserUC     *user.UserUseCase
-	EmailUC    *email.EmailUseCase
-	APITokenUC usecase.APITokenUseCase
+	UserUC         *user.UserUseCase
+	EmailUC        *email.EmailUseCase
+	APITokenUC     usecase.APITokenUseCase
+	SessionUC      *user.SessionUseCase
+	TwoFactorUC    *user.TwoFactorUseCase
+	OAuthUC        *user.OAuthUseCase
+	LockoutUC      *user.LockoutUseCase
+	RoleUC         *user.RoleUseCase
+	AdminUserUC    *user.AdminUserUseCase
+	AccountUC      *user.AccountUseCase
+	RegistrationUC *user.RegistrationUseCase
 }
 
 type CompetitionDeps struct {
@@ -40,6 +51,7 @@ type CompetitionDeps struct {
 	SolveUC       *competition.SolveUseCase
 	StatsUC       *competition.StatisticsUseCase
 	SubmissionUC  *competition.SubmissionUseCase
+	AntiCheatUC   *competition.AntiCheatUseCase
 	BracketUC     *competition.BracketUseCase
 	RatingUC      *competition.RatingUseCase
 }
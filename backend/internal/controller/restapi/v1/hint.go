@@ -64,6 +64,10 @@ func (h *Server) PostAdminChallengesChallengeIDHints(w http.ResponseWriter, r *h
 		return
 	}
 
+	if !h.authorizeChallenge(w, r, challengeuuid, "PostAdminChallengesChallengeIDHints") {
+		return
+	}
+
 	req, ok := helper.DecodeAndValidate[openapi.RequestCreateHintRequest](
 		w, r, h.infra.Validator, h.infra.Logger, "PostAdminChallengesChallengeIDHints",
 	)
@@ -76,6 +80,9 @@ func (h *Server) PostAdminChallengesChallengeIDHints(w http.ResponseWriter, r *h
 	if h.OnError(w, r, err, "PostAdminChallengesChallengeIDHints", "Create") {
 		return
 	}
+	if !h.recordRevision(w, r, challengeuuid, "PostAdminChallengesChallengeIDHints") {
+		return
+	}
 
 	helper.RenderCreated(w, r, response.FromHint(hint))
 }
@@ -88,6 +95,14 @@ func (h *Server) PutAdminHintsID(w http.ResponseWriter, r *http.Request, ID stri
 		return
 	}
 
+	hint, err := h.challenge.HintUC.GetByID(r.Context(), hintuuid)
+	if h.OnError(w, r, err, "PutAdminHintsID", "GetByID") {
+		return
+	}
+	if !h.authorizeChallenge(w, r, hint.ChallengeID, "PutAdminHintsID") {
+		return
+	}
+
 	req, ok := helper.DecodeAndValidate[openapi.RequestUpdateHintRequest](
 		w, r, h.infra.Validator, h.infra.Logger, "PutAdminHintsID",
 	)
@@ -96,10 +111,13 @@ func (h *Server) PutAdminHintsID(w http.ResponseWriter, r *http.Request, ID stri
 	}
 
 	content, cost, orderIndex := request.UpdateHintRequestToParams(&req)
-	hint, err := h.challenge.HintUC.Update(r.Context(), hintuuid, content, cost, orderIndex)
+	hint, err = h.challenge.HintUC.Update(r.Context(), hintuuid, content, cost, orderIndex)
 	if h.OnError(w, r, err, "PutAdminHintsID", "Update") {
 		return
 	}
+	if !h.recordRevision(w, r, hint.ChallengeID, "PutAdminHintsID") {
+		return
+	}
 
 	helper.RenderOK(w, r, response.FromHint(hint))
 }
@@ -112,9 +130,20 @@ func (h *Server) DeleteAdminHintsID(w http.ResponseWriter, r *http.Request, ID s
 		return
 	}
 
+	hint, err := h.challenge.HintUC.GetByID(r.Context(), hintuuid)
+	if h.OnError(w, r, err, "DeleteAdminHintsID", "GetByID") {
+		return
+	}
+	if !h.authorizeChallenge(w, r, hint.ChallengeID, "DeleteAdminHintsID") {
+		return
+	}
+
 	if h.OnError(w, r, h.challenge.HintUC.Delete(r.Context(), hintuuid), "DeleteAdminHintsID", "Delete") {
 		return
 	}
+	if !h.recordRevision(w, r, hint.ChallengeID, "DeleteAdminHintsID") {
+		return
+	}
 
 	helper.RenderNoContent(w, r)
 }
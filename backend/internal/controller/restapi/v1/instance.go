@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Get instance configuration
+// (GET /admin/challenges/{challengeID}/instance-config)
+func (h *Server) GetAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDInstanceConfig") {
+		return
+	}
+
+	cfg, err := h.challenge.InstanceUC.GetConfig(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDInstanceConfig", "GetConfig") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromInstanceConfig(cfg))
+}
+
+// Set instance configuration
+// (PUT /admin/challenges/{challengeID}/instance-config)
+func (h *Server) PutAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PutAdminChallengesChallengeIDInstanceConfig") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestInstanceConfigRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminChallengesChallengeIDInstanceConfig",
+	)
+	if !ok {
+		return
+	}
+
+	image, ttlSeconds := request.InstanceConfigRequestToParams(&req)
+	cfg, err := h.challenge.InstanceUC.SetConfig(r.Context(), challengeuuid, image, ttlSeconds)
+	if h.OnError(w, r, err, "PutAdminChallengesChallengeIDInstanceConfig", "SetConfig") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromInstanceConfig(cfg))
+}
+
+// Delete instance configuration
+// (DELETE /admin/challenges/{challengeID}/instance-config)
+func (h *Server) DeleteAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "DeleteAdminChallengesChallengeIDInstanceConfig") {
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.InstanceUC.DeleteConfig(r.Context(), challengeuuid), "DeleteAdminChallengesChallengeIDInstanceConfig", "DeleteConfig") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Get challenge instance
+// (GET /challenges/{ID}/instance)
+func (h *Server) GetChallengesIDInstance(w http.ResponseWriter, r *http.Request, ID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if user.TeamID == nil {
+		helper.RenderError(w, r, http.StatusBadRequest, "user must be in a team")
+		return
+	}
+
+	inst, err := h.challenge.InstanceUC.Get(r.Context(), challengeuuid, *user.TeamID)
+	if h.OnError(w, r, err, "GetChallengesIDInstance", "Get") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeInstance(inst))
+}
+
+// Start challenge instance
+// (POST /challenges/{ID}/instance)
+func (h *Server) PostChallengesIDInstance(w http.ResponseWriter, r *http.Request, ID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if user.TeamID == nil {
+		helper.RenderError(w, r, http.StatusBadRequest, "user must be in a team")
+		return
+	}
+
+	inst, err := h.challenge.InstanceUC.Start(r.Context(), challengeuuid, *user.TeamID)
+	if h.OnError(w, r, err, "PostChallengesIDInstance", "Start") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeInstance(inst))
+}
+
+// Stop challenge instance
+// (DELETE /challenges/{ID}/instance)
+func (h *Server) DeleteChallengesIDInstance(w http.ResponseWriter, r *http.Request, ID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if user.TeamID == nil {
+		helper.RenderError(w, r, http.StatusBadRequest, "user must be in a team")
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.InstanceUC.Stop(r.Context(), challengeuuid, *user.TeamID), "DeleteChallengesIDInstance", "Stop") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Extend challenge instance
+// (POST /challenges/{ID}/instance/extend)
+func (h *Server) PostChallengesIDInstanceExtend(w http.ResponseWriter, r *http.Request, ID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if user.TeamID == nil {
+		helper.RenderError(w, r, http.StatusBadRequest, "user must be in a team")
+		return
+	}
+
+	inst, err := h.challenge.InstanceUC.Extend(r.Context(), challengeuuid, *user.TeamID)
+	if h.OnError(w, r, err, "PostChallengesIDInstanceExtend", "Extend") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeInstance(inst))
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+)
+
+// Get user lockout
+// (GET /admin/users/{ID}/lockout)
+func (h *Server) GetAdminUsersIDLockout(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	status, err := h.user.LockoutUC.Status(r.Context(), userID)
+	if h.OnError(w, r, err, "GetAdminUsersIDLockout", "Status") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromLockoutStatus(status))
+}
+
+// Unlock user
+// (DELETE /admin/users/{ID}/lockout)
+func (h *Server) DeleteAdminUsersIDLockout(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.LockoutUC.Unlock(r.Context(), userID, admin.ID, helper.GetClientIP(r)), "DeleteAdminUsersIDLockout", "Unlock") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// List login providers
+// (GET /auth/oauth/providers)
+func (h *Server) GetAuthOauthProviders(w http.ResponseWriter, r *http.Request) {
+	providers, err := h.user.OAuthUC.ListProviders(r.Context(), true)
+	if h.OnError(w, r, err, "GetAuthOauthProviders", "ListProviders") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromOAuthProviderPublicList(providers))
+}
+
+// Start external login
+// (GET /auth/oauth/{provider}/authorize)
+func (h *Server) GetAuthOauthProviderAuthorize(w http.ResponseWriter, r *http.Request, provider string) {
+	authURL, err := h.user.OAuthUC.Authorize(r.Context(), provider, nil)
+	if h.OnError(w, r, err, "GetAuthOauthProviderAuthorize", "Authorize") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromOAuthAuthorizeURL(authURL))
+}
+
+// Complete external login
+// (POST /auth/oauth/{provider}/callback)
+func (h *Server) PostAuthOauthProviderCallback(w http.ResponseWriter, r *http.Request, provider string) {
+	req, ok := helper.DecodeAndValidate[openapi.RequestOAuthCallbackRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAuthOauthProviderCallback",
+	)
+	if !ok {
+		return
+	}
+
+	result, err := h.user.OAuthUC.Callback(r.Context(), provider, req.Code, req.State, helper.GetClientIP(r), r.UserAgent())
+	if h.OnError(w, r, err, "PostAuthOauthProviderCallback", "Callback") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromLoginResult(result))
+}
+
+// List my identities
+// (GET /user/identities)
+func (h *Server) GetUserIdentities(w http.ResponseWriter, r *http.Request) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	identities, err := h.user.OAuthUC.Identities(r.Context(), userID)
+	if h.OnError(w, r, err, "GetUserIdentities", "Identities") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromUserIdentityList(identities))
+}
+
+// Start identity linking
+// (POST /user/identities/{provider}/authorize)
+func (h *Server) PostUserIdentitiesProviderAuthorize(w http.ResponseWriter, r *http.Request, provider string) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	authURL, err := h.user.OAuthUC.Authorize(r.Context(), provider, &userID)
+	if h.OnError(w, r, err, "PostUserIdentitiesProviderAuthorize", "Authorize") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromOAuthAuthorizeURL(authURL))
+}
+
+// Link identity
+// (POST /user/identities/{provider}/link)
+func (h *Server) PostUserIdentitiesProviderLink(w http.ResponseWriter, r *http.Request, provider string) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestOAuthCallbackRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostUserIdentitiesProviderLink",
+	)
+	if !ok {
+		return
+	}
+
+	identity, err := h.user.OAuthUC.Link(r.Context(), userID, provider, req.Code, req.State)
+	if h.OnError(w, r, err, "PostUserIdentitiesProviderLink", "Link") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromUserIdentity(identity))
+}
+
+// Unlink identity
+// (DELETE /user/identities/{provider})
+func (h *Server) DeleteUserIdentitiesProvider(w http.ResponseWriter, r *http.Request, provider string) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.OAuthUC.Unlink(r.Context(), userID, provider), "DeleteUserIdentitiesProvider", "Unlink") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// List login providers (admin)
+// (GET /admin/oauth/providers)
+func (h *Server) GetAdminOauthProviders(w http.ResponseWriter, r *http.Request) {
+	providers, err := h.user.OAuthUC.ListProviders(r.Context(), false)
+	if h.OnError(w, r, err, "GetAdminOauthProviders", "ListProviders") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromOAuthProviderList(providers))
+}
+
+// Save login provider
+// (PUT /admin/oauth/providers/{provider})
+func (h *Server) PutAdminOauthProvidersProvider(w http.ResponseWriter, r *http.Request, provider string) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestOAuthProviderRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminOauthProvidersProvider",
+	)
+	if !ok {
+		return
+	}
+
+	p, clientSecret := request.OAuthProviderRequestToEntity(&req, provider)
+	if h.OnError(w, r, h.user.OAuthUC.SaveProvider(r.Context(), p, clientSecret, admin.ID, helper.GetClientIP(r)), "PutAdminOauthProvidersProvider", "SaveProvider") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromOAuthProvider(p))
+}
+
+// Delete login provider
+// (DELETE /admin/oauth/providers/{provider})
+func (h *Server) DeleteAdminOauthProvidersProvider(w http.ResponseWriter, r *http.Request, provider string) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.OAuthUC.DeleteProvider(r.Context(), provider, admin.ID, helper.GetClientIP(r)), "DeleteAdminOauthProvidersProvider", "DeleteProvider") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
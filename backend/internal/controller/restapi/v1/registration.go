@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Registration status
+// (GET /auth/registration)
+func (h *Server) GetAuthRegistration(w http.ResponseWriter, r *http.Request) {
+	status, err := h.user.RegistrationUC.Status(r.Context())
+	if h.OnError(w, r, err, "GetAuthRegistration", "Status") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRegistrationStatus(status))
+}
+
+// List registration invites
+// (GET /admin/registration/invites)
+func (h *Server) GetAdminRegistrationInvites(w http.ResponseWriter, r *http.Request) {
+	invites, err := h.user.RegistrationUC.ListInvites(r.Context())
+	if h.OnError(w, r, err, "GetAdminRegistrationInvites", "ListInvites") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRegistrationInviteList(invites))
+}
+
+// Create registration invite
+// (POST /admin/registration/invites)
+func (h *Server) PostAdminRegistrationInvites(w http.ResponseWriter, r *http.Request) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestCreateRegistrationInviteRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminRegistrationInvites",
+	)
+	if !ok {
+		return
+	}
+
+	invite := request.CreateRegistrationInviteRequestToEntity(&req)
+	if h.OnError(w, r, h.user.RegistrationUC.CreateInvite(r.Context(), invite, admin.ID, helper.GetClientIP(r)), "PostAdminRegistrationInvites", "CreateInvite") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromRegistrationInvite(invite))
+}
+
+// Delete registration invite
+// (DELETE /admin/registration/invites/{ID})
+func (h *Server) DeleteAdminRegistrationInvitesID(w http.ResponseWriter, r *http.Request, id string) {
+	inviteID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.RegistrationUC.DeleteInvite(r.Context(), inviteID, admin.ID, helper.GetClientIP(r)), "DeleteAdminRegistrationInvitesID", "DeleteInvite") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// List registration domain rules
+// (GET /admin/registration/domains)
+func (h *Server) GetAdminRegistrationDomains(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.user.RegistrationUC.ListDomainRules(r.Context())
+	if h.OnError(w, r, err, "GetAdminRegistrationDomains", "ListDomainRules") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRegistrationDomainRuleList(rules))
+}
+
+// Create registration domain rule
+// (POST /admin/registration/domains)
+func (h *Server) PostAdminRegistrationDomains(w http.ResponseWriter, r *http.Request) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestCreateRegistrationDomainRuleRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminRegistrationDomains",
+	)
+	if !ok {
+		return
+	}
+
+	rule := request.CreateRegistrationDomainRuleRequestToEntity(&req)
+	if h.OnError(w, r, h.user.RegistrationUC.CreateDomainRule(r.Context(), rule, admin.ID, helper.GetClientIP(r)), "PostAdminRegistrationDomains", "CreateDomainRule") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromRegistrationDomainRule(rule))
+}
+
+// Delete registration domain rule
+// (DELETE /admin/registration/domains/{ID})
+func (h *Server) DeleteAdminRegistrationDomainsID(w http.ResponseWriter, r *http.Request, id string) {
+	ruleID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.RegistrationUC.DeleteDomainRule(r.Context(), ruleID, admin.ID, helper.GetClientIP(r)), "DeleteAdminRegistrationDomainsID", "DeleteDomainRule") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
@@ -0,0 +1,24 @@
+package request
+
+import "github.com/skr1ms/CTFBoard/internal/openapi"
+
+func ChangePasswordRequestParams(req *openapi.RequestChangePasswordRequest) (currentPassword, newPassword string) {
+	if req.CurrentPassword != nil {
+		currentPassword = *req.CurrentPassword
+	}
+	return currentPassword, req.NewPassword
+}
+
+func ChangeEmailRequestParams(req *openapi.RequestChangeEmailRequest) (email, password string) {
+	if req.Password != nil {
+		password = *req.Password
+	}
+	return string(req.Email), password
+}
+
+func DeleteAccountRequestPassword(req *openapi.RequestDeleteAccountRequest) string {
+	if req.Password != nil {
+		return *req.Password
+	}
+	return ""
+}
@@ -0,0 +1,33 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func AdminUsersParamsToFilter(params openapi.GetAdminUsersParams) *entity.UserFilter {
+	filter := &entity.UserFilter{
+		FieldID:  params.Field,
+		Verified: params.Verified,
+		Banned:   params.Banned,
+		TeamID:   params.Team,
+	}
+	if params.Search != nil {
+		filter.Search = *params.Search
+	}
+	if params.FieldValue != nil {
+		filter.FieldValue = *params.FieldValue
+	}
+	if params.Role != nil {
+		filter.Role = *params.Role
+	}
+	return filter
+}
+
+func AdminUpdateUserRequestToParams(req *openapi.RequestAdminUpdateUserRequest) (username, email *string, verified *bool) {
+	if req.Email != nil {
+		e := string(*req.Email)
+		email = &e
+	}
+	return req.Username, email, req.IsVerified
+}
@@ -6,12 +6,30 @@ import (
 	"github.com/skr1ms/CTFBoard/internal/openapi"
 )
 
-func CreateAPITokenParams(req *openapi.RequestCreateAPITokenRequest) (description string, expiresAt *time.Time) {
+func CreateAPITokenParams(req *openapi.RequestCreateAPITokenRequest) (description string, scopes []string, expiresAt *time.Time) {
 	if req.Description != nil {
 		description = *req.Description
 	}
+	if req.Scopes != nil {
+		scopes = scopeStrings(*req.Scopes)
+	}
 	if req.ExpiresAt != nil {
 		expiresAt = req.ExpiresAt
 	}
-	return description, expiresAt
+	return description, scopes, expiresAt
+}
+
+func CreateServiceTokenParams(req *openapi.RequestCreateServiceTokenRequest) (description string, scopes []string, expiresAt *time.Time) {
+	if req.Description != nil {
+		description = *req.Description
+	}
+	return description, scopeStrings(req.Scopes), req.ExpiresAt
+}
+
+func scopeStrings[T ~string](in []T) []string {
+	out := make([]string, len(in))
+	for i, s := range in {
+		out[i] = string(s)
+	}
+	return out
 }
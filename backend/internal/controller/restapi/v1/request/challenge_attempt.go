@@ -0,0 +1,13 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// AttemptLimitRequestToParams treats a missing wrong_penalty as free wrong submissions.
+func AttemptLimitRequestToParams(req *openapi.RequestAttemptLimitRequest) (maxAttempts *int, wrongPenalty int) {
+	if req.WrongPenalty != nil {
+		wrongPenalty = *req.WrongPenalty
+	}
+	return req.MaxAttempts, wrongPenalty
+}
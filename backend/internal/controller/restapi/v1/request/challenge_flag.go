@@ -0,0 +1,13 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func ChallengeFlagRequestToParams(req *openapi.RequestChallengeFlagRequest) (flagType entity.FlagType, value string, isCaseInsensitive bool) {
+	if req.IsCaseInsensitive != nil {
+		isCaseInsensitive = *req.IsCaseInsensitive
+	}
+	return entity.FlagType(req.Type), req.Flag, isCaseInsensitive
+}
@@ -0,0 +1,15 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func ChallengeRequirementsRequestToParams(req *openapi.RequestChallengeRequirementsRequest) (prerequisites []uuid.UUID, minScore int) {
+	prerequisites = make([]uuid.UUID, len(req.Prerequisites))
+	copy(prerequisites, req.Prerequisites)
+	if req.MinScore != nil {
+		minScore = *req.MinScore
+	}
+	return prerequisites, minScore
+}
@@ -0,0 +1,14 @@
+package request
+
+import (
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func ChallengeScheduleRequestToParams(req *openapi.RequestChallengeScheduleRequest) (releaseAt, hideAt *time.Time, notifyOnRelease bool) {
+	if req.NotifyOnRelease != nil {
+		notifyOnRelease = *req.NotifyOnRelease
+	}
+	return req.ReleaseAt, req.HideAt, notifyOnRelease
+}
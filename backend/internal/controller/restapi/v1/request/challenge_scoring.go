@@ -0,0 +1,13 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// ScoringPreviewRequestToParams treats a missing elapsed as the start of the event.
+func ScoringPreviewRequestToParams(req *openapi.RequestScoringPreviewRequest) (function string, params map[string]float64, solves int, elapsed float64) {
+	if req.Elapsed != nil {
+		elapsed = *req.Elapsed
+	}
+	return req.Function, req.Params, req.Solves, elapsed
+}
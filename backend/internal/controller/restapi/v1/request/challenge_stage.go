@@ -0,0 +1,30 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/internal/usecase/challenge"
+)
+
+func ChallengeStageRequestToParams(req *openapi.RequestChallengeStageRequest) (title string, points int, flags []challenge.StageFlag) {
+	return req.Title, req.Points, stageFlagsToParams(req.Flags)
+}
+
+// UpdateChallengeStageRequestToParams leaves flags nil when the request keeps the stage flags.
+func UpdateChallengeStageRequestToParams(req *openapi.RequestUpdateChallengeStageRequest) (title string, points int, orderIndex *int, flags []challenge.StageFlag) {
+	if req.Flags != nil {
+		flags = stageFlagsToParams(*req.Flags)
+	}
+	return req.Title, req.Points, req.OrderIndex, flags
+}
+
+func stageFlagsToParams(items []openapi.RequestChallengeStageFlagRequest) []challenge.StageFlag {
+	flags := make([]challenge.StageFlag, len(items))
+	for i, f := range items {
+		flags[i] = challenge.StageFlag{Type: entity.FlagType(f.Type), Value: f.Flag}
+		if f.IsCaseInsensitive != nil {
+			flags[i].IsCaseInsensitive = *f.IsCaseInsensitive
+		}
+	}
+	return flags
+}
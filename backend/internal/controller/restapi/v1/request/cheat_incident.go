@@ -0,0 +1,39 @@
+package request
+
+import (
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func CheatIncidentFilterParams(params *openapi.GetAdminCheatIncidentsParams) entity.CheatIncidentFilter {
+	var filter entity.CheatIncidentFilter
+	if params.Status != nil {
+		status := entity.CheatIncidentStatus(*params.Status)
+		filter.Status = &status
+	}
+	if params.Kind != nil {
+		kind := entity.CheatIncidentKind(*params.Kind)
+		filter.Kind = &kind
+	}
+	return filter
+}
+
+func CheatAnalysisRequestToOptions(req *openapi.RequestCheatAnalysisRequest) entity.CheatAnalysisOptions {
+	var opts entity.CheatAnalysisOptions
+	if req.WindowSeconds != nil {
+		opts.SolveWindow = time.Duration(*req.WindowSeconds) * time.Second
+	}
+	if req.MinTeams != nil {
+		opts.MinTeams = *req.MinTeams
+	}
+	return opts
+}
+
+func EscalateCheatIncidentRequestReason(req *openapi.RequestEscalateCheatIncidentRequest) string {
+	if req.Reason == nil {
+		return ""
+	}
+	return *req.Reason
+}
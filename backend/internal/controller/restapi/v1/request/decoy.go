@@ -0,0 +1,23 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func DecoyFlagRequestToParams(req *openapi.RequestDecoyFlagRequest) (value string, isCaseInsensitive bool, note string) {
+	if req.IsCaseInsensitive != nil {
+		isCaseInsensitive = *req.IsCaseInsensitive
+	}
+	if req.Note != nil {
+		note = *req.Note
+	}
+	return req.Flag, isCaseInsensitive, note
+}
+
+func DecoyPolicyRequestToEntity(req *openapi.RequestDecoyPolicyRequest) entity.DecoyPolicy {
+	return entity.DecoyPolicy{
+		Action:    entity.DecoyAction(req.Action),
+		Threshold: req.Threshold,
+	}
+}
@@ -0,0 +1,7 @@
+package request
+
+import "github.com/skr1ms/CTFBoard/internal/openapi"
+
+func InstanceConfigRequestToParams(req *openapi.RequestInstanceConfigRequest) (image string, ttlSeconds int) {
+	return req.Image, req.TTLSeconds
+}
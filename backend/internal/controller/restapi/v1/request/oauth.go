@@ -0,0 +1,55 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func OAuthProviderRequestToEntity(req *openapi.RequestOAuthProviderRequest, name string) (*entity.OAuthProvider, string) {
+	p := &entity.OAuthProvider{
+		Name:        name,
+		ClientID:    req.ClientID,
+		RedirectURL: req.RedirectURL,
+	}
+	if req.DisplayName != nil {
+		p.DisplayName = *req.DisplayName
+	}
+	if req.Enabled != nil {
+		p.Enabled = *req.Enabled
+	}
+	if req.Issuer != nil {
+		p.Issuer = *req.Issuer
+	}
+	if req.AuthURL != nil {
+		p.AuthURL = *req.AuthURL
+	}
+	if req.TokenURL != nil {
+		p.TokenURL = *req.TokenURL
+	}
+	if req.UserinfoURL != nil {
+		p.UserInfoURL = *req.UserinfoURL
+	}
+	if req.Scopes != nil {
+		p.Scopes = *req.Scopes
+	}
+	if req.SubjectClaim != nil {
+		p.SubjectClaim = *req.SubjectClaim
+	}
+	if req.EmailClaim != nil {
+		p.EmailClaim = *req.EmailClaim
+	}
+	if req.UsernameClaim != nil {
+		p.UsernameClaim = *req.UsernameClaim
+	}
+	if req.FieldClaims != nil {
+		p.FieldClaims = *req.FieldClaims
+	}
+	if req.AutoProvision != nil {
+		p.AutoProvision = *req.AutoProvision
+	}
+	var clientSecret string
+	if req.ClientSecret != nil {
+		clientSecret = *req.ClientSecret
+	}
+	return p, clientSecret
+}
@@ -0,0 +1,25 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func CreateRegistrationInviteRequestToEntity(req *openapi.RequestCreateRegistrationInviteRequest) *entity.RegistrationInvite {
+	invite := &entity.RegistrationInvite{
+		MaxUses:   req.MaxUses,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if req.Code != nil {
+		invite.Code = *req.Code
+	}
+	return invite
+}
+
+func CreateRegistrationDomainRuleRequestToEntity(req *openapi.RequestCreateRegistrationDomainRuleRequest) *entity.RegistrationDomainRule {
+	return &entity.RegistrationDomainRule{
+		Domain:    req.Domain,
+		Action:    entity.DomainRuleAction(req.Action),
+		BracketID: req.BracketID,
+	}
+}
@@ -0,0 +1,28 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func ReviewStatusParam(status *openapi.GetAdminReviewsParamsStatus) *entity.ReviewStatus {
+	if status == nil {
+		return nil
+	}
+	s := entity.ReviewStatus(*status)
+	return &s
+}
+
+func ApproveReviewRequestToParams(req *openapi.RequestApproveReviewRequest) (points *int, comment string) {
+	if req.Comment != nil {
+		comment = *req.Comment
+	}
+	return req.Points, comment
+}
+
+func RejectReviewRequestToParams(req *openapi.RequestRejectReviewRequest) (comment string) {
+	if req.Comment != nil {
+		comment = *req.Comment
+	}
+	return comment
+}
@@ -0,0 +1,24 @@
+package request
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func CreateRoleRequestToEntity(req *openapi.RequestCreateRoleRequest) *entity.Role {
+	role := &entity.Role{
+		Name:        req.Name,
+		Permissions: req.Permissions,
+	}
+	if req.Description != nil {
+		role.Description = *req.Description
+	}
+	return role
+}
+
+func UpdateRoleRequestToParams(req *openapi.RequestUpdateRoleRequest) (description string, permissions []string) {
+	if req.Description != nil {
+		description = *req.Description
+	}
+	return description, req.Permissions
+}
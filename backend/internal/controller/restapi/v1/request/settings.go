@@ -6,7 +6,7 @@ import (
 )
 
 func UpdateAppSettingsRequestToEntity(req *openapi.RequestUpdateAppSettingsRequest, id int) *entity.AppSettings {
-	var verifyEmails, resendEnabled, registrationOpen bool
+	var verifyEmails, resendEnabled, registrationOpen, require2FAForAdmins bool
 	var verifyTTLHours, resetTTLHours, submitLimitPerUser, submitLimitDurationMin int
 	scoreboardVisible := "public"
 	if req.VerifyEmails != nil {
@@ -18,6 +18,9 @@ func UpdateAppSettingsRequestToEntity(req *openapi.RequestUpdateAppSettingsReque
 	if req.RegistrationOpen != nil {
 		registrationOpen = *req.RegistrationOpen
 	}
+	if req.Require2FaForAdmins != nil {
+		require2FAForAdmins = *req.Require2FaForAdmins
+	}
 	if req.VerifyTTLHours != nil {
 		verifyTTLHours = *req.VerifyTTLHours
 	} else {
@@ -56,5 +59,8 @@ func UpdateAppSettingsRequestToEntity(req *openapi.RequestUpdateAppSettingsReque
 		SubmitLimitDurationMin: submitLimitDurationMin,
 		ScoreboardVisible:      scoreboardVisible,
 		RegistrationOpen:       registrationOpen,
+		Require2FAForAdmins:    require2FAForAdmins,
+		RegistrationOpensAt:    req.RegistrationOpensAt,
+		RegistrationClosesAt:   req.RegistrationClosesAt,
 	}
 }
@@ -0,0 +1,31 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func SolveGrantRequestToEntity(challengeID uuid.UUID, req *openapi.RequestSolveGrantRequest) (*entity.Solve, error) {
+	teamID, err := uuid.Parse(req.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	solve := &entity.Solve{TeamID: teamID, ChallengeID: challengeID}
+	if req.UserID != nil {
+		if solve.UserID, err = uuid.Parse(*req.UserID); err != nil {
+			return nil, err
+		}
+	}
+	if req.SolvedAt != nil {
+		solve.SolvedAt = *req.SolvedAt
+	}
+	return solve, nil
+}
+
+func RescoreRequestToOptions(req *openapi.RequestRescoreRequest) entity.RescoreOptions {
+	return entity.RescoreOptions{
+		ExcludeBanned: req.ExcludeBanned != nil && *req.ExcludeBanned,
+		ExcludeHidden: req.ExcludeHidden != nil && *req.ExcludeHidden,
+	}
+}
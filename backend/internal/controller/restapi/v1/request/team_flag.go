@@ -0,0 +1,20 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func TeamFlagRequestToParams(req *openapi.RequestTeamFlagRequest) (template string, rotateSecret bool) {
+	if req.RotateSecret != nil {
+		rotateSecret = *req.RotateSecret
+	}
+	return req.Template, rotateSecret
+}
+
+func RenderTeamFlagRequestToParams(req *openapi.RequestRenderTeamFlagRequest) (teamID uuid.UUID, text string) {
+	if req.Text != nil {
+		text = *req.Text
+	}
+	return uuid.UUID(req.TeamID), text
+}
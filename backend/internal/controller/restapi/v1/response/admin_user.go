@@ -0,0 +1,39 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromAdminUser(u *entity.User) openapi.ResponseAdminUserResponse {
+	var teamIDStr *string
+	if u.TeamID != nil {
+		teamIDStr = ptr(u.TeamID.String())
+	}
+	return openapi.ResponseAdminUserResponse{
+		ID:           u.ID.String(),
+		Username:     u.Username,
+		Email:        u.Email,
+		Role:         u.Role,
+		TeamID:       teamIDStr,
+		IsVerified:   u.IsVerified,
+		VerifiedAt:   u.VerifiedAt,
+		IsBanned:     u.IsBanned,
+		BannedAt:     u.BannedAt,
+		BannedReason: u.BannedReason,
+		CreatedAt:    u.CreatedAt,
+	}
+}
+
+func FromAdminUserList(items []*entity.User, total int64, page, perPage int) openapi.ResponseAdminUserListResponse {
+	resItems := make([]openapi.ResponseAdminUserResponse, len(items))
+	for i, item := range items {
+		resItems[i] = FromAdminUser(item)
+	}
+	return openapi.ResponseAdminUserListResponse{
+		Items:   &resItems,
+		Total:   ptr(int(total)),
+		Page:    ptr(page),
+		PerPage: ptr(perPage),
+	}
+}
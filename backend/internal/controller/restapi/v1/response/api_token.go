@@ -10,11 +10,16 @@ import (
 func FromAPIToken(t *entity.APIToken) openapi.ResponseAPITokenResponse {
 	res := openapi.ResponseAPITokenResponse{
 		ID:        ptr(t.ID.String()),
+		Scopes:    ptr(t.Scopes),
+		Service:   ptr(t.IsService()),
 		CreatedAt: ptr(t.CreatedAt.Format(time.RFC3339)),
 	}
 	if t.Description != "" {
 		res.Description = ptr(t.Description)
 	}
+	if t.CreatedBy != nil {
+		res.CreatedBy = ptr(t.CreatedBy.String())
+	}
 	if t.ExpiresAt != nil {
 		res.ExpiresAt = t.ExpiresAt
 	}
@@ -24,10 +29,19 @@ func FromAPIToken(t *entity.APIToken) openapi.ResponseAPITokenResponse {
 	return res
 }
 
+func FromAPITokenList(tokens []*entity.APIToken) []openapi.ResponseAPITokenResponse {
+	res := make([]openapi.ResponseAPITokenResponse, len(tokens))
+	for i, t := range tokens {
+		res[i] = FromAPIToken(t)
+	}
+	return res
+}
+
 func FromAPITokenCreated(plaintext string, t *entity.APIToken) openapi.ResponseAPITokenCreatedResponse {
 	res := openapi.ResponseAPITokenCreatedResponse{
 		ID:        ptr(t.ID.String()),
 		Token:     plaintext,
+		Scopes:    ptr(t.Scopes),
 		CreatedAt: ptr(t.CreatedAt.Format(time.RFC3339)),
 	}
 	if t.Description != "" {
@@ -38,3 +52,19 @@ func FromAPITokenCreated(plaintext string, t *entity.APIToken) openapi.ResponseA
 	}
 	return res
 }
+
+func FromAPITokenRequestList(reqs []*entity.APITokenRequest) []openapi.ResponseAPITokenRequestResponse {
+	res := make([]openapi.ResponseAPITokenRequestResponse, len(reqs))
+	for i, req := range reqs {
+		res[i] = openapi.ResponseAPITokenRequestResponse{
+			Method:    ptr(req.Method),
+			Path:      ptr(req.Path),
+			Status:    ptr(req.Status),
+			CreatedAt: ptr(req.CreatedAt.Format(time.RFC3339)),
+		}
+		if req.IP != "" {
+			res[i].IP = ptr(req.IP)
+		}
+	}
+	return res
+}
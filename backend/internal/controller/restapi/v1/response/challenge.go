@@ -27,8 +27,24 @@ func FromChallengeWithSolved(cws *repo.ChallengeWithSolved) openapi.ResponseChal
 	return res
 }
 
+// FromChallengeWithTags withholds the description of a challenge the team has not unlocked
 func FromChallengeWithTags(cwt *usecase.ChallengeWithTags) openapi.ResponseChallengeResponse {
 	res := FromChallengeWithSolved(cwt.ChallengeWithSolved)
+	res.Locked = ptr(cwt.Locked)
+	if cwt.Locked {
+		res.Description = ptr("")
+	}
+	if cwt.Stages != nil {
+		res.StagesCompleted = ptr(cwt.Stages.Completed)
+		res.StagesTotal = ptr(cwt.Stages.Total)
+	}
+	if a := cwt.Attempts; a != nil {
+		res.MaxAttempts = a.MaxAttempts
+		res.AttemptsRemaining = a.Remaining
+		if a.WrongPenalty > 0 {
+			res.WrongPenalty = ptr(a.WrongPenalty)
+		}
+	}
 	if len(cwt.Tags) > 0 {
 		tags := make([]openapi.ResponseTagResponse, len(cwt.Tags))
 		for i, t := range cwt.Tags {
@@ -0,0 +1,15 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromAttemptLimit(l *entity.AttemptLimit) openapi.ResponseAttemptLimitResponse {
+	return openapi.ResponseAttemptLimitResponse{
+		ChallengeID:  l.ChallengeID.String(),
+		MaxAttempts:  l.MaxAttempts,
+		WrongPenalty: l.WrongPenalty,
+		UpdatedAt:    &l.UpdatedAt,
+	}
+}
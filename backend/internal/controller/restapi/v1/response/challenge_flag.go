@@ -0,0 +1,25 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// FromChallengeFlag creates ChallengeFlagResponse without the stored hash or pattern
+func FromChallengeFlag(f *entity.ChallengeFlag) openapi.ResponseChallengeFlagResponse {
+	return openapi.ResponseChallengeFlagResponse{
+		ID:                f.ID.String(),
+		ChallengeID:       f.ChallengeID.String(),
+		Type:              string(f.Type),
+		IsCaseInsensitive: f.IsCaseInsensitive,
+		CreatedAt:         f.CreatedAt,
+	}
+}
+
+func FromChallengeFlagList(flags []*entity.ChallengeFlag) []openapi.ResponseChallengeFlagResponse {
+	res := make([]openapi.ResponseChallengeFlagResponse, len(flags))
+	for i, f := range flags {
+		res[i] = FromChallengeFlag(f)
+	}
+	return res
+}
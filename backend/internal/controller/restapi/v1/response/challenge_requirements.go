@@ -0,0 +1,18 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromChallengeRequirements(req *entity.ChallengeRequirements) openapi.ResponseChallengeRequirementsResponse {
+	prerequisites := make([]string, len(req.Prerequisites))
+	for i, id := range req.Prerequisites {
+		prerequisites[i] = id.String()
+	}
+	return openapi.ResponseChallengeRequirementsResponse{
+		ChallengeID:   req.ChallengeID.String(),
+		Prerequisites: prerequisites,
+		MinScore:      req.MinScore,
+	}
+}
@@ -0,0 +1,40 @@
+package response
+
+import (
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+)
+
+func FromChallengeSchedule(s *entity.ChallengeSchedule) openapi.ResponseChallengeScheduleResponse {
+	return openapi.ResponseChallengeScheduleResponse{
+		ChallengeID:     s.ChallengeID.String(),
+		ReleaseAt:       s.ReleaseAt,
+		HideAt:          s.HideAt,
+		NotifyOnRelease: s.NotifyOnRelease,
+		AnnouncedAt:     s.AnnouncedAt,
+		Status:          openapi.ResponseChallengeScheduleResponseStatus(s.StatusAt(time.Now().UTC())),
+	}
+}
+
+func FromReleaseTimeline(items []*repo.ScheduledChallenge) []openapi.ResponseReleaseTimelineEntryResponse {
+	now := time.Now().UTC()
+	res := make([]openapi.ResponseReleaseTimelineEntryResponse, 0, len(items))
+	for _, c := range items {
+		res = append(res, openapi.ResponseReleaseTimelineEntryResponse{
+			ChallengeID:     c.Schedule.ChallengeID.String(),
+			Title:           c.Title,
+			Category:        c.Category,
+			Points:          c.Points,
+			IsHidden:        c.IsHidden,
+			ReleaseAt:       c.Schedule.ReleaseAt,
+			HideAt:          c.Schedule.HideAt,
+			NotifyOnRelease: c.Schedule.NotifyOnRelease,
+			AnnouncedAt:     c.Schedule.AnnouncedAt,
+			Status:          openapi.ResponseReleaseTimelineEntryResponseStatus(c.Schedule.StatusAt(now)),
+		})
+	}
+	return res
+}
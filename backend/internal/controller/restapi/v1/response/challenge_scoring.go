@@ -0,0 +1,53 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/internal/scoring"
+	"github.com/skr1ms/CTFBoard/internal/usecase"
+)
+
+func FromScoringFunction(f *scoring.Function) openapi.ResponseScoringFunctionResponse {
+	params := make([]openapi.ResponseScoringParamResponse, len(f.Params))
+	for i, p := range f.Params {
+		params[i] = openapi.ResponseScoringParamResponse{
+			Name:        p.Name,
+			Description: p.Description,
+			Min:         p.Min,
+			Max:         p.Max,
+			Integer:     p.Integer,
+		}
+	}
+	return openapi.ResponseScoringFunctionResponse{
+		Name:        f.Name,
+		Description: f.Description,
+		TimeBased:   f.TimeBased,
+		Params:      params,
+	}
+}
+
+func FromScoringFunctionList(items []*scoring.Function) []openapi.ResponseScoringFunctionResponse {
+	res := make([]openapi.ResponseScoringFunctionResponse, len(items))
+	for i, item := range items {
+		res[i] = FromScoringFunction(item)
+	}
+	return res
+}
+
+func FromScoringPreview(function string, points []int) openapi.ResponseScoringPreviewResponse {
+	return openapi.ResponseScoringPreviewResponse{Function: function, Points: points}
+}
+
+// FromChallengeScoring leaves updated_at out for challenges that keep the default decay
+func FromChallengeScoring(s *usecase.ChallengeScoringState) openapi.ResponseChallengeScoringResponse {
+	res := openapi.ResponseChallengeScoringResponse{
+		ChallengeID: s.ChallengeID.String(),
+		Function:    s.Function,
+		Params:      s.Params,
+		IsDefault:   s.IsDefault,
+		Points:      s.Points,
+	}
+	if !s.UpdatedAt.IsZero() {
+		res.UpdatedAt = &s.UpdatedAt
+	}
+	return res
+}
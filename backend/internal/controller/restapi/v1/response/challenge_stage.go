@@ -0,0 +1,63 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/usecase"
+)
+
+// FromChallengeStageAdmin creates ChallengeStageAdminResponse without the stored flag hashes or patterns
+func FromChallengeStageAdmin(s *entity.ChallengeStage) openapi.ResponseChallengeStageAdminResponse {
+	flags := make([]openapi.ResponseStageFlagResponse, len(s.Flags))
+	for i, f := range s.Flags {
+		flags[i] = openapi.ResponseStageFlagResponse{Type: string(f.Type), IsCaseInsensitive: f.IsCaseInsensitive}
+	}
+	return openapi.ResponseChallengeStageAdminResponse{
+		ID:          s.ID.String(),
+		ChallengeID: s.ChallengeID.String(),
+		OrderIndex:  s.OrderIndex,
+		Title:       s.Title,
+		Points:      s.Points,
+		SolveCount:  s.SolveCount,
+		Flags:       flags,
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+func FromChallengeStageAdminList(items []*entity.ChallengeStage) []openapi.ResponseChallengeStageAdminResponse {
+	res := make([]openapi.ResponseChallengeStageAdminResponse, len(items))
+	for i, item := range items {
+		res[i] = FromChallengeStageAdmin(item)
+	}
+	return res
+}
+
+func FromChallengeStage(s *usecase.StageWithProgress) openapi.ResponseChallengeStageResponse {
+	res := openapi.ResponseChallengeStageResponse{
+		ID:         s.ID.String(),
+		OrderIndex: s.OrderIndex,
+		Title:      s.Title,
+		Points:     s.Points,
+		SolveCount: s.SolveCount,
+		Completed:  s.Completed,
+	}
+	if fb := s.FirstBlood; fb != nil {
+		res.FirstBlood = ptr(FromFirstBlood(&repo.FirstBloodEntry{
+			UserID:   fb.UserID,
+			Username: fb.Username,
+			TeamID:   fb.TeamID,
+			TeamName: fb.TeamName,
+			SolvedAt: fb.SolvedAt,
+		}))
+	}
+	return res
+}
+
+func FromChallengeStageList(items []*usecase.StageWithProgress) []openapi.ResponseChallengeStageResponse {
+	res := make([]openapi.ResponseChallengeStageResponse, len(items))
+	for i, item := range items {
+		res[i] = FromChallengeStage(item)
+	}
+	return res
+}
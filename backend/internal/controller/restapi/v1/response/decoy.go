@@ -0,0 +1,32 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// FromDecoyFlag creates DecoyFlagResponse without the stored hash
+func FromDecoyFlag(d *entity.DecoyFlag) openapi.ResponseDecoyFlagResponse {
+	return openapi.ResponseDecoyFlagResponse{
+		ID:                d.ID.String(),
+		ChallengeID:       d.ChallengeID.String(),
+		IsCaseInsensitive: d.IsCaseInsensitive,
+		Note:              d.Note,
+		CreatedAt:         d.CreatedAt,
+	}
+}
+
+func FromDecoyFlagList(decoys []*entity.DecoyFlag) []openapi.ResponseDecoyFlagResponse {
+	res := make([]openapi.ResponseDecoyFlagResponse, len(decoys))
+	for i, d := range decoys {
+		res[i] = FromDecoyFlag(d)
+	}
+	return res
+}
+
+func FromDecoyPolicy(p entity.DecoyPolicy) openapi.ResponseDecoyPolicyResponse {
+	return openapi.ResponseDecoyPolicyResponse{
+		Action:    openapi.ResponseDecoyPolicyResponseAction(p.Action),
+		Threshold: p.Threshold,
+	}
+}
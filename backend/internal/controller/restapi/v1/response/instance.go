@@ -0,0 +1,27 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromInstanceConfig(cfg *entity.InstanceConfig) openapi.ResponseInstanceConfigResponse {
+	return openapi.ResponseInstanceConfigResponse{
+		ChallengeID: cfg.ChallengeID.String(),
+		Image:       cfg.Image,
+		TTLSeconds:  cfg.TTLSeconds,
+		CreatedAt:   cfg.CreatedAt,
+	}
+}
+
+func FromChallengeInstance(inst *entity.ChallengeInstance) openapi.ResponseChallengeInstanceResponse {
+	return openapi.ResponseChallengeInstanceResponse{
+		ID:             inst.ID.String(),
+		ChallengeID:    inst.ChallengeID.String(),
+		TeamID:         inst.TeamID.String(),
+		ConnectionInfo: inst.ConnectionInfo,
+		Status:         openapi.ResponseChallengeInstanceResponseStatus(inst.Status),
+		ExpiresAt:      inst.ExpiresAt,
+		CreatedAt:      inst.CreatedAt,
+	}
+}
@@ -0,0 +1,14 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/internal/usecase/user"
+)
+
+func FromLockoutStatus(s *user.LockoutStatus) openapi.ResponseLockoutStatusResponse {
+	return openapi.ResponseLockoutStatusResponse{
+		Locked:         ptr(s.Locked),
+		LockedUntil:    s.LockedUntil,
+		FailedAttempts: ptr(s.FailedAttempts),
+	}
+}
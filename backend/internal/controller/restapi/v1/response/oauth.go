@@ -0,0 +1,77 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromOAuthProvider(p *entity.OAuthProvider) openapi.ResponseOAuthProviderResponse {
+	res := openapi.ResponseOAuthProviderResponse{
+		Name:            ptr(p.Name),
+		DisplayName:     ptr(p.DisplayName),
+		Enabled:         ptr(p.Enabled),
+		Issuer:          ptr(p.Issuer),
+		AuthURL:         ptr(p.AuthURL),
+		TokenURL:        ptr(p.TokenURL),
+		UserinfoURL:     ptr(p.UserInfoURL),
+		ClientID:        ptr(p.ClientID),
+		HasClientSecret: ptr(p.ClientSecretEncrypted != ""),
+		RedirectURL:     ptr(p.RedirectURL),
+		Scopes:          ptr(p.Scopes),
+		SubjectClaim:    ptr(p.SubjectClaim),
+		EmailClaim:      ptr(p.EmailClaim),
+		UsernameClaim:   ptr(p.UsernameClaim),
+		AutoProvision:   ptr(p.AutoProvision),
+	}
+	if len(p.FieldClaims) > 0 {
+		res.FieldClaims = ptr(p.FieldClaims)
+	}
+	return res
+}
+
+func FromOAuthProviderList(providers []*entity.OAuthProvider) []openapi.ResponseOAuthProviderResponse {
+	res := make([]openapi.ResponseOAuthProviderResponse, len(providers))
+	for i, p := range providers {
+		res[i] = FromOAuthProvider(p)
+	}
+	return res
+}
+
+func FromOAuthProviderPublicList(providers []*entity.OAuthProvider) []openapi.ResponseOAuthProviderPublicResponse {
+	res := make([]openapi.ResponseOAuthProviderPublicResponse, len(providers))
+	for i, p := range providers {
+		displayName := p.DisplayName
+		if displayName == "" {
+			displayName = p.Name
+		}
+		res[i] = openapi.ResponseOAuthProviderPublicResponse{
+			Name:        ptr(p.Name),
+			DisplayName: ptr(displayName),
+		}
+	}
+	return res
+}
+
+func FromOAuthAuthorizeURL(authURL string) openapi.ResponseOAuthAuthorizeResponse {
+	return openapi.ResponseOAuthAuthorizeResponse{AuthorizationURL: ptr(authURL)}
+}
+
+func FromUserIdentity(i *entity.UserIdentity) openapi.ResponseUserIdentityResponse {
+	res := openapi.ResponseUserIdentityResponse{
+		Provider:    ptr(i.Provider),
+		CreatedAt:   ptr(i.CreatedAt),
+		LastLoginAt: i.LastLoginAt,
+	}
+	if i.Email != "" {
+		res.Email = ptr(i.Email)
+	}
+	return res
+}
+
+func FromUserIdentityList(identities []*entity.UserIdentity) []openapi.ResponseUserIdentityResponse {
+	res := make([]openapi.ResponseUserIdentityResponse, len(identities))
+	for i, identity := range identities {
+		res[i] = FromUserIdentity(identity)
+	}
+	return res
+}
@@ -0,0 +1,55 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/internal/usecase/user"
+)
+
+func FromRegistrationStatus(s *user.RegistrationStatus) openapi.ResponseRegistrationStatusResponse {
+	return openapi.ResponseRegistrationStatusResponse{
+		Open:     s.Open,
+		OpensAt:  s.OpensAt,
+		ClosesAt: s.ClosesAt,
+	}
+}
+
+func FromRegistrationInvite(i *entity.RegistrationInvite) openapi.ResponseRegistrationInviteResponse {
+	return openapi.ResponseRegistrationInviteResponse{
+		ID:        i.ID.String(),
+		Code:      i.Code,
+		MaxUses:   i.MaxUses,
+		Uses:      i.Uses,
+		ExpiresAt: i.ExpiresAt,
+		CreatedAt: i.CreatedAt,
+	}
+}
+
+func FromRegistrationInviteList(items []*entity.RegistrationInvite) []openapi.ResponseRegistrationInviteResponse {
+	res := make([]openapi.ResponseRegistrationInviteResponse, len(items))
+	for i, item := range items {
+		res[i] = FromRegistrationInvite(item)
+	}
+	return res
+}
+
+func FromRegistrationDomainRule(r *entity.RegistrationDomainRule) openapi.ResponseRegistrationDomainRuleResponse {
+	res := openapi.ResponseRegistrationDomainRuleResponse{
+		ID:        r.ID.String(),
+		Domain:    r.Domain,
+		Action:    string(r.Action),
+		CreatedAt: r.CreatedAt,
+	}
+	if r.BracketID != nil {
+		res.BracketID = ptr(r.BracketID.String())
+	}
+	return res
+}
+
+func FromRegistrationDomainRuleList(items []*entity.RegistrationDomainRule) []openapi.ResponseRegistrationDomainRuleResponse {
+	res := make([]openapi.ResponseRegistrationDomainRuleResponse, len(items))
+	for i, item := range items {
+		res[i] = FromRegistrationDomainRule(item)
+	}
+	return res
+}
@@ -0,0 +1,48 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromManualReviewConfig(cfg *entity.ManualReviewConfig) openapi.ResponseManualReviewResponse {
+	return openapi.ResponseManualReviewResponse{
+		ChallengeID: cfg.ChallengeID.String(),
+		CreatedAt:   cfg.CreatedAt,
+	}
+}
+
+func FromSubmissionReview(r *entity.SubmissionReview) openapi.ResponseSubmissionReviewResponse {
+	res := openapi.ResponseSubmissionReviewResponse{
+		ID:          r.ID.String(),
+		ChallengeID: r.ChallengeID.String(),
+		TeamID:      r.TeamID.String(),
+		UserID:      r.UserID.String(),
+		Answer:      r.Answer,
+		Status:      openapi.ResponseSubmissionReviewResponseStatus(r.Status),
+		Points:      r.Points,
+		Comment:     r.Comment,
+		ReviewedAt:  r.ReviewedAt,
+		CreatedAt:   r.CreatedAt,
+	}
+	if r.ReviewedBy != nil {
+		res.ReviewedBy = ptr(r.ReviewedBy.String())
+	}
+	return res
+}
+
+func FromSubmissionReviewList(items []*entity.SubmissionReviewWithDetails, total int64, page, perPage int) openapi.ResponseSubmissionReviewListResponse {
+	resItems := make([]openapi.ResponseSubmissionReviewResponse, len(items))
+	for i, item := range items {
+		resItems[i] = FromSubmissionReview(&item.SubmissionReview)
+		resItems[i].ChallengeTitle = ptr(item.ChallengeTitle)
+		resItems[i].TeamName = ptr(item.TeamName)
+		resItems[i].Username = ptr(item.Username)
+	}
+	return openapi.ResponseSubmissionReviewListResponse{
+		Items:   &resItems,
+		Total:   ptr(int(total)),
+		Page:    ptr(page),
+		PerPage: ptr(perPage),
+	}
+}
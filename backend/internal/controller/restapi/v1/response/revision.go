@@ -0,0 +1,85 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromChallengeRevision(r *entity.ChallengeRevision) openapi.ResponseChallengeRevisionResponse {
+	res := openapi.ResponseChallengeRevisionResponse{
+		ID:            r.ID.String(),
+		ChallengeID:   r.ChallengeID.String(),
+		Version:       r.Version,
+		ChangedFields: r.ChangedFields,
+		RestoredFrom:  r.RestoredFrom,
+		Snapshot:      fromChallengeSnapshot(&r.Snapshot),
+		CreatedAt:     r.CreatedAt,
+	}
+	if r.ChangedFields == nil {
+		res.ChangedFields = []string{}
+	}
+	if r.AuthorID != nil {
+		res.AuthorID = ptr(r.AuthorID.String())
+	}
+	if r.AuthorName != "" {
+		res.AuthorName = ptr(r.AuthorName)
+	}
+	return res
+}
+
+func FromChallengeRevisionList(items []*entity.ChallengeRevision) []openapi.ResponseChallengeRevisionResponse {
+	res := make([]openapi.ResponseChallengeRevisionResponse, len(items))
+	for i, item := range items {
+		res[i] = FromChallengeRevision(item)
+	}
+	return res
+}
+
+// fromChallengeSnapshot leaves out the flag hash and encrypted regex.
+func fromChallengeSnapshot(s *entity.ChallengeSnapshot) openapi.ResponseChallengeSnapshotResponse {
+	tagIDs := make([]string, len(s.TagIDs))
+	for i, id := range s.TagIDs {
+		tagIDs[i] = id.String()
+	}
+	hints := make([]openapi.ResponseRevisionHintResponse, len(s.Hints))
+	for i, h := range s.Hints {
+		hints[i] = openapi.ResponseRevisionHintResponse{
+			ID:         h.ID.String(),
+			Content:    h.Content,
+			Cost:       h.Cost,
+			OrderIndex: h.OrderIndex,
+		}
+	}
+	return openapi.ResponseChallengeSnapshotResponse{
+		Title:             s.Title,
+		Description:       s.Description,
+		Category:          s.Category,
+		Points:            s.Points,
+		InitialValue:      s.InitialValue,
+		MinValue:          s.MinValue,
+		Decay:             s.Decay,
+		IsHidden:          s.IsHidden,
+		IsRegex:           s.IsRegex,
+		IsCaseInsensitive: s.IsCaseInsensitive,
+		FlagFormatRegex:   s.FlagFormatRegex,
+		TagIds:            tagIDs,
+		Hints:             hints,
+	}
+}
+
+func FromRevisionDiff(d *entity.RevisionDiff) openapi.ResponseRevisionDiffResponse {
+	changes := make([]openapi.ResponseRevisionChangeResponse, len(d.Changes))
+	for i, c := range d.Changes {
+		changes[i] = openapi.ResponseRevisionChangeResponse{
+			Field: c.Field,
+			From:  c.From,
+			To:    c.To,
+		}
+	}
+	return openapi.ResponseRevisionDiffResponse{
+		ChallengeID: d.ChallengeID.String(),
+		From:        d.From,
+		To:          d.To,
+		Changes:     changes,
+	}
+}
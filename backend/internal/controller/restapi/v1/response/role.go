@@ -0,0 +1,29 @@
+package response
+
+import (
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromRole(r *entity.Role) openapi.ResponseRoleResponse {
+	res := openapi.ResponseRoleResponse{
+		Name:        ptr(r.Name),
+		Description: ptr(r.Description),
+		Permissions: ptr(r.Permissions),
+		BuiltIn:     ptr(r.BuiltIn),
+	}
+	if !r.CreatedAt.IsZero() {
+		res.CreatedAt = ptr(r.CreatedAt.Format(time.RFC3339))
+	}
+	return res
+}
+
+func FromRoleList(roles []*entity.Role) []openapi.ResponseRoleResponse {
+	res := make([]openapi.ResponseRoleResponse, len(roles))
+	for i, r := range roles {
+		res[i] = FromRole(r)
+	}
+	return res
+}
@@ -0,0 +1,31 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromSession(s *entity.Session, currentID uuid.UUID) openapi.ResponseSessionResponse {
+	return openapi.ResponseSessionResponse{
+		ID:         ptr(s.ID.String()),
+		IP:         ptr(s.IP),
+		UserAgent:  ptr(s.UserAgent),
+		Current:    ptr(s.ID == currentID),
+		CreatedAt:  ptr(s.CreatedAt),
+		LastSeenAt: ptr(s.LastSeenAt),
+		ExpiresAt:  ptr(s.ExpiresAt),
+	}
+}
+
+func FromSessionList(sessions []*entity.Session, currentID uuid.UUID) []openapi.ResponseSessionResponse {
+	res := make([]openapi.ResponseSessionResponse, len(sessions))
+	for i, s := range sessions {
+		res[i] = FromSession(s, currentID)
+	}
+	return res
+}
+
+func FromRevokedSessions(n int64) openapi.ResponseRevokeSessionsResponse {
+	return openapi.ResponseRevokeSessionsResponse{Revoked: ptr(int(n))}
+}
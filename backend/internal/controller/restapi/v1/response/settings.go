@@ -20,6 +20,9 @@ func FromAppSettings(s *entity.AppSettings) openapi.ResponseAppSettingsResponse
 		CorsOrigins:            &corsOrigins,
 		FrontendURL:            &frontendURL,
 		RegistrationOpen:       &s.RegistrationOpen,
+		RegistrationOpensAt:    s.RegistrationOpensAt,
+		RegistrationClosesAt:   s.RegistrationClosesAt,
+		Require2FaForAdmins:    &s.Require2FAForAdmins,
 		ResendEnabled:          &s.ResendEnabled,
 		ResendFromEmail:        &resendFromEmail,
 		ResendFromName:         &resendFromName,
@@ -0,0 +1,38 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromSolveGrant(s *entity.Solve, firstBlood bool) openapi.ResponseSolveGrantResponse {
+	return openapi.ResponseSolveGrantResponse{
+		ID:          s.ID.String(),
+		ChallengeID: s.ChallengeID.String(),
+		TeamID:      s.TeamID.String(),
+		UserID:      s.UserID.String(),
+		SolvedAt:    s.SolvedAt,
+		FirstBlood:  firstBlood,
+	}
+}
+
+func FromRescoreReport(r *entity.RescoreReport) openapi.ResponseRescoreReportResponse {
+	drifts := make([]openapi.ResponseScoreDriftResponse, 0, len(r.Drifts))
+	for _, d := range r.Drifts {
+		drifts = append(drifts, openapi.ResponseScoreDriftResponse{
+			ChallengeID:      d.ChallengeID.String(),
+			Title:            d.Title,
+			StoredSolveCount: d.StoredSolveCount,
+			SolveCount:       d.SolveCount,
+			StoredPoints:     d.StoredPoints,
+			Points:           d.Points,
+		})
+	}
+	return openapi.ResponseRescoreReportResponse{
+		Checked:       r.Checked,
+		Drifts:        drifts,
+		ExcludeBanned: r.Options.ExcludeBanned,
+		ExcludeHidden: r.Options.ExcludeHidden,
+		Fixed:         r.Fixed,
+	}
+}
@@ -29,10 +29,14 @@ func FromSubmission(s *entity.SubmissionWithDetails) openapi.ResponseSubmissionR
 	return res
 }
 
-func FromSubmissionList(items []*entity.SubmissionWithDetails, total int64, page, perPage int) openapi.ResponseSubmissionListResponse {
+// FromSubmissionList maps submissions; without showFlags the submitted flags are left out.
+func FromSubmissionList(items []*entity.SubmissionWithDetails, total int64, page, perPage int, showFlags bool) openapi.ResponseSubmissionListResponse {
 	resItems := make([]openapi.ResponseSubmissionResponse, len(items))
 	for i, item := range items {
 		resItems[i] = FromSubmission(item)
+		if !showFlags {
+			resItems[i].SubmittedFlag = nil
+		}
 	}
 	return openapi.ResponseSubmissionListResponse{
 		Items:   &resItems,
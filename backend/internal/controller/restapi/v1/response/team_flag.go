@@ -0,0 +1,111 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+func FromTeamFlagConfig(cfg *entity.TeamFlagConfig) openapi.ResponseTeamFlagResponse {
+	return openapi.ResponseTeamFlagResponse{
+		ChallengeID: cfg.ChallengeID.String(),
+		Template:    cfg.Template,
+		CreatedAt:   cfg.CreatedAt,
+	}
+}
+
+func FromTeamFlagRender(teamID uuid.UUID, flag, text string) openapi.ResponseTeamFlagRenderResponse {
+	return openapi.ResponseTeamFlagRenderResponse{
+		TeamID: teamID.String(),
+		Flag:   flag,
+		Text:   text,
+	}
+}
+
+// FromCheatIncident maps an incident; without showFlags a flag in the evidence is left out.
+func FromCheatIncident(i *entity.CheatIncidentWithDetails, showFlags bool) openapi.ResponseCheatIncidentResponse {
+	res := openapi.ResponseCheatIncidentResponse{
+		ID:        i.ID.String(),
+		Kind:      openapi.ResponseCheatIncidentResponseKind(i.Kind),
+		Severity:  openapi.ResponseCheatIncidentResponseSeverity(i.Kind.Severity()),
+		Status:    openapi.ResponseCheatIncidentResponseStatus(i.Status),
+		Evidence:  fromCheatEvidence(i.Evidence, showFlags),
+		TeamID:    i.TeamID.String(),
+		TeamName:  i.TeamName,
+		CreatedAt: i.CreatedAt,
+	}
+	if i.ChallengeID != nil {
+		res.ChallengeID = ptr(i.ChallengeID.String())
+		res.ChallengeTitle = ptr(i.ChallengeTitle)
+	}
+	if i.UserID != nil {
+		res.UserID = ptr(i.UserID.String())
+		res.Username = ptr(i.Username)
+	}
+	if i.SourceTeamID != nil {
+		res.SourceTeamID = ptr(i.SourceTeamID.String())
+		res.SourceTeamName = ptr(i.SourceTeamName)
+	}
+	if i.ResolvedBy != nil {
+		res.ResolvedBy = ptr(i.ResolvedBy.String())
+	}
+	res.ResolvedAt = i.ResolvedAt
+	return res
+}
+
+func fromCheatEvidence(e entity.CheatEvidence, showFlags bool) openapi.ResponseCheatEvidenceResponse {
+	ids := make([]string, len(e.SubmissionIDs))
+	for i, id := range e.SubmissionIDs {
+		ids[i] = id.String()
+	}
+	res := openapi.ResponseCheatEvidenceResponse{
+		SubmissionIds: ids,
+		SecondsApart:  e.SecondsApart,
+	}
+	if e.IP != "" {
+		res.IP = ptr(e.IP)
+	}
+	if e.Flag != "" && showFlags {
+		res.Flag = ptr(e.Flag)
+	}
+	return res
+}
+
+func FromCheatIncidentList(items []*entity.CheatIncidentWithDetails, total int64, page, perPage int, showFlags bool) openapi.ResponseCheatIncidentListResponse {
+	resItems := make([]openapi.ResponseCheatIncidentResponse, len(items))
+	for i, item := range items {
+		resItems[i] = FromCheatIncident(item, showFlags)
+	}
+	return openapi.ResponseCheatIncidentListResponse{
+		Items:   &resItems,
+		Total:   ptr(int(total)),
+		Page:    ptr(page),
+		PerPage: ptr(perPage),
+	}
+}
+
+// FromCheatIncidentDetail maps an incident with its evidence submissions; without showFlags
+// submitted flags are left out.
+func FromCheatIncidentDetail(i *entity.CheatIncidentWithDetails, subs []*entity.SubmissionWithDetails, showFlags bool) openapi.ResponseCheatIncidentDetailResponse {
+	resSubs := make([]openapi.ResponseSubmissionResponse, len(subs))
+	for j, sub := range subs {
+		resSubs[j] = FromSubmission(sub)
+		if !showFlags {
+			resSubs[j].SubmittedFlag = nil
+		}
+	}
+	return openapi.ResponseCheatIncidentDetailResponse{
+		Incident:    FromCheatIncident(i, showFlags),
+		Submissions: resSubs,
+	}
+}
+
+func FromCheatAnalysisResult(r *entity.CheatAnalysisResult) openapi.ResponseCheatAnalysisResponse {
+	return openapi.ResponseCheatAnalysisResponse{
+		SharedIP:        r.SharedIP,
+		SharedWrongFlag: r.SharedWrongFlag,
+		CloseSolve:      r.CloseSolve,
+		NoDownload:      r.NoDownload,
+		Total:           r.Total(),
+	}
+}
@@ -0,0 +1,25 @@
+package response
+
+import (
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+	"github.com/skr1ms/CTFBoard/internal/usecase/user"
+)
+
+func FromTwoFactorStatus(s *user.TwoFactorStatus) openapi.ResponseTwoFactorStatusResponse {
+	return openapi.ResponseTwoFactorStatusResponse{
+		Enabled:           ptr(s.Enabled),
+		Required:          ptr(s.Required),
+		RecoveryCodesLeft: ptr(s.RecoveryCodesLeft),
+	}
+}
+
+func FromTwoFactorSetup(s *user.TwoFactorSetup) openapi.ResponseTwoFactorSetupResponse {
+	return openapi.ResponseTwoFactorSetupResponse{
+		Secret:     ptr(s.Secret),
+		OtpauthURL: ptr(s.URL),
+	}
+}
+
+func FromRecoveryCodes(codes []string) openapi.ResponseRecoveryCodesResponse {
+	return openapi.ResponseRecoveryCodesResponse{RecoveryCodes: &codes}
+}
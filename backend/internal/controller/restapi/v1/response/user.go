@@ -22,19 +22,20 @@ func FromUserForRegister(u *entity.User) openapi.ResponseRegisterResponse {
 	}
 }
 
-func FromUserForMe(u *entity.User) openapi.ResponseMeResponse {
+func FromUserForMe(u *entity.User, permissions []string) openapi.ResponseMeResponse {
 	var teamIDStr *string
 	if u.TeamID != nil {
 		s := u.TeamID.String()
 		teamIDStr = &s
 	}
 	return openapi.ResponseMeResponse{
-		ID:        ptr(u.ID.String()),
-		Username:  ptr(u.Username),
-		Email:     ptr(u.Email),
-		Role:      ptr(u.Role),
-		TeamID:    teamIDStr,
-		CreatedAt: ptr(u.CreatedAt.Format(time.RFC3339)),
+		ID:          ptr(u.ID.String()),
+		Username:    ptr(u.Username),
+		Email:       ptr(u.Email),
+		Role:        ptr(u.Role),
+		Permissions: ptr(permissions),
+		TeamID:      teamIDStr,
+		CreatedAt:   ptr(u.CreatedAt.Format(time.RFC3339)),
 	}
 }
 
@@ -81,6 +82,23 @@ func FromSolve(s *entity.Solve) openapi.ResponseSolveResponse {
 	}
 }
 
+func FromLoginResult(res *user.LoginResult) openapi.ResponseLoginResponse {
+	if res.Tokens == nil {
+		return openapi.ResponseLoginResponse{
+			TwoFactorRequired:  ptr(true),
+			ChallengeToken:     ptr(res.ChallengeToken),
+			ChallengeExpiresAt: ptr(int(res.ChallengeExpiresAt)),
+		}
+	}
+	return openapi.ResponseLoginResponse{
+		TwoFactorRequired: ptr(false),
+		AccessToken:       ptr(res.Tokens.AccessToken),
+		AccessExpiresAt:   ptr(int(res.Tokens.AccessExpiresAt)),
+		RefreshToken:      ptr(res.Tokens.RefreshToken),
+		RefreshExpiresAt:  ptr(int(res.Tokens.RefreshExpiresAt)),
+	}
+}
+
 func FromTokenPair(p *jwt.TokenPair) openapi.JwtTokenPair {
 	return openapi.JwtTokenPair{
 		AccessToken:      ptr(p.AccessToken),
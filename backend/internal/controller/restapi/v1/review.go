@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/middleware"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Get manual grading
+// (GET /admin/challenges/{challengeID}/manual-review)
+func (h *Server) GetAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDManualReview") {
+		return
+	}
+
+	cfg, err := h.challenge.ChallengeUC.GetManualReview(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDManualReview", "GetManualReview") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromManualReviewConfig(cfg))
+}
+
+// Enable manual grading
+// (PUT /admin/challenges/{challengeID}/manual-review)
+func (h *Server) PutAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PutAdminChallengesChallengeIDManualReview") {
+		return
+	}
+
+	cfg, err := h.challenge.ChallengeUC.EnableManualReview(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "PutAdminChallengesChallengeIDManualReview", "EnableManualReview") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromManualReviewConfig(cfg))
+}
+
+// Disable manual grading
+// (DELETE /admin/challenges/{challengeID}/manual-review)
+func (h *Server) DeleteAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "DeleteAdminChallengesChallengeIDManualReview") {
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.ChallengeUC.DisableManualReview(r.Context(), challengeuuid), "DeleteAdminChallengesChallengeIDManualReview", "DisableManualReview") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Get review queue
+// (GET /admin/reviews)
+func (h *Server) GetAdminReviews(w http.ResponseWriter, r *http.Request, params openapi.GetAdminReviewsParams) {
+	page, perPage := getPagePerPage(params.Page, params.PerPage)
+
+	items, total, err := h.challenge.ChallengeUC.GetReviews(r.Context(), request.ReviewStatusParam(params.Status), page, perPage)
+	if h.OnError(w, r, err, "GetAdminReviews", "GetReviews") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromSubmissionReviewList(items, total, page, perPage))
+}
+
+// Approve submission
+// (POST /admin/reviews/{ID}/approve)
+func (h *Server) PostAdminReviewsIDApprove(w http.ResponseWriter, r *http.Request, ID string) {
+	reviewuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestApproveReviewRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminReviewsIDApprove",
+	)
+	if !ok {
+		return
+	}
+
+	points, comment := request.ApproveReviewRequestToParams(&req)
+	review, err := h.challenge.ChallengeUC.ApproveReview(r.Context(), reviewuuid, optionalUserID(r), points, comment)
+	if h.OnError(w, r, err, "PostAdminReviewsIDApprove", "ApproveReview") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromSubmissionReview(review))
+}
+
+// Reject submission
+// (POST /admin/reviews/{ID}/reject)
+func (h *Server) PostAdminReviewsIDReject(w http.ResponseWriter, r *http.Request, ID string) {
+	reviewuuid, ok := helper.ParseUUID(w, r, ID)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestRejectReviewRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminReviewsIDReject",
+	)
+	if !ok {
+		return
+	}
+
+	review, err := h.challenge.ChallengeUC.RejectReview(r.Context(), reviewuuid, optionalUserID(r), request.RejectReviewRequestToParams(&req))
+	if h.OnError(w, r, err, "PostAdminReviewsIDReject", "RejectReview") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromSubmissionReview(review))
+}
+
+// optionalUserID is nil for service tokens, which have no user behind them.
+func optionalUserID(r *http.Request) *uuid.UUID {
+	user, ok := middleware.GetUser(r.Context())
+	if !ok {
+		return nil
+	}
+	return &user.ID
+}
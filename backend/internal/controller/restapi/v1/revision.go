@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Get challenge revisions
+// (GET /admin/challenges/{challengeID}/revisions)
+func (h *Server) GetAdminChallengesChallengeIDRevisions(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDRevisions") {
+		return
+	}
+
+	revisions, err := h.challenge.RevisionUC.GetByChallengeID(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDRevisions", "GetByChallengeID") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeRevisionList(revisions))
+}
+
+// Diff challenge revisions
+// (GET /admin/challenges/{challengeID}/revisions/diff)
+func (h *Server) GetAdminChallengesChallengeIDRevisionsDiff(w http.ResponseWriter, r *http.Request, challengeID string, params openapi.GetAdminChallengesChallengeIDRevisionsDiffParams) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDRevisionsDiff") {
+		return
+	}
+
+	diff, err := h.challenge.RevisionUC.Diff(r.Context(), challengeuuid, params.From, params.To)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDRevisionsDiff", "Diff") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRevisionDiff(diff))
+}
+
+// Roll back challenge
+// (POST /admin/challenges/{challengeID}/revisions/{version}/rollback)
+func (h *Server) PostAdminChallengesChallengeIDRevisionsVersionRollback(w http.ResponseWriter, r *http.Request, challengeID string, version int) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PostAdminChallengesChallengeIDRevisionsVersionRollback") {
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	revision, err := h.challenge.RevisionUC.Rollback(r.Context(), challengeuuid, version, user.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PostAdminChallengesChallengeIDRevisionsVersionRollback", "Rollback") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromChallengeRevision(revision))
+}
+
+// recordRevision stores the state of a challenge after an edit and reports whether the
+// request may continue.
+func (h *Server) recordRevision(w http.ResponseWriter, r *http.Request, challengeID uuid.UUID, op string) bool {
+	_, err := h.challenge.RevisionUC.Record(r.Context(), challengeID, optionalUserID(r))
+	return !h.OnError(w, r, err, op, "RecordRevision")
+}
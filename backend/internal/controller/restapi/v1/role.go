@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/middleware"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// List permissions
+// (GET /admin/permissions)
+func (h *Server) GetAdminPermissions(w http.ResponseWriter, r *http.Request) {
+	helper.RenderOK(w, r, entity.Permissions)
+}
+
+// List roles
+// (GET /admin/roles)
+func (h *Server) GetAdminRoles(w http.ResponseWriter, r *http.Request) {
+	roles, err := h.user.RoleUC.List(r.Context())
+	if h.OnError(w, r, err, "GetAdminRoles", "List") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRoleList(roles))
+}
+
+// Create role
+// (POST /admin/roles)
+func (h *Server) PostAdminRoles(w http.ResponseWriter, r *http.Request) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestCreateRoleRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminRoles",
+	)
+	if !ok {
+		return
+	}
+
+	role := request.CreateRoleRequestToEntity(&req)
+	if h.OnError(w, r, h.user.RoleUC.Create(r.Context(), role, admin.ID, helper.GetClientIP(r)), "PostAdminRoles", "Create") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromRole(role))
+}
+
+// Update role
+// (PUT /admin/roles/{name})
+func (h *Server) PutAdminRolesName(w http.ResponseWriter, r *http.Request, name string) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestUpdateRoleRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminRolesName",
+	)
+	if !ok {
+		return
+	}
+
+	description, permissions := request.UpdateRoleRequestToParams(&req)
+	role, err := h.user.RoleUC.Update(r.Context(), name, description, permissions, admin.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PutAdminRolesName", "Update") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRole(role))
+}
+
+// Delete role
+// (DELETE /admin/roles/{name})
+func (h *Server) DeleteAdminRolesName(w http.ResponseWriter, r *http.Request, name string) {
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.RoleUC.Delete(r.Context(), name, admin.ID, helper.GetClientIP(r)), "DeleteAdminRolesName", "Delete") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Set user role
+// (PUT /admin/users/{ID}/role)
+func (h *Server) PutAdminUsersIDRole(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestSetUserRoleRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminUsersIDRole",
+	)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.RoleUC.SetUserRole(r.Context(), userID, req.Role, admin.ID, helper.GetClientIP(r)), "PutAdminUsersIDRole", "SetUserRole") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// hasPermission reports whether the caller's role grants permission. Errors count as not granted.
+func (h *Server) hasPermission(r *http.Request, permission string) bool {
+	ok, err := h.user.RoleUC.HasPermission(r.Context(), middleware.GetUserRole(r.Context()), permission)
+	if err != nil {
+		h.infra.Logger.WithError(err).Error("restapi - v1 - hasPermission")
+		return false
+	}
+	return ok
+}
+
+// authorizeChallenge writes an error response and returns false unless the caller may change the
+// challenge: roles with challenges.manage may change any challenge, authors only their own.
+func (h *Server) authorizeChallenge(w http.ResponseWriter, r *http.Request, challengeID uuid.UUID, handler string) bool {
+	var userID uuid.UUID
+	if user, ok := middleware.GetUser(r.Context()); ok {
+		userID = user.ID
+	}
+	err := h.user.RoleUC.AuthorizeChallenge(r.Context(), middleware.GetUserRole(r.Context()), userID, challengeID)
+	return !h.OnError(w, r, err, handler, "AuthorizeChallenge")
+}
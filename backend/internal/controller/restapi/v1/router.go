@@ -8,6 +8,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	restapimiddleware "github.com/skr1ms/CTFBoard/internal/controller/restapi/middleware"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/entity"
 	"github.com/skr1ms/CTFBoard/internal/openapi"
 	"github.com/skr1ms/CTFBoard/internal/usecase"
 	"github.com/skr1ms/CTFBoard/internal/usecase/challenge"
@@ -32,7 +33,7 @@ func NewRouter(
 		},
 	}
 	setupPublicRoutes(router, server, wrapper, deps.Infra.RedisClient, deps.Infra.Logger)
-	setupAuthOnlyRoutes(router, deps.Infra.JWTService, deps.User.APITokenUC, deps.User.UserUC, wrapper)
+	setupAuthOnlyRoutes(router, deps.Infra.JWTService, deps.User.SessionUC, deps.User.APITokenUC, deps.User.UserUC, wrapper)
 	setupProtectedRoutes(router, deps, wrapper, submitLimit, durationLimit, verifyEmails)
 }
 
@@ -40,13 +41,27 @@ func setupPublicRoutes(router chi.Router, server *Server, wrapper openapi.Server
 	scoreboardLimit := restapimiddleware.RateLimit(redisClient, "scoreboard:ip", 30, time.Minute, func(r *http.Request) (string, error) {
 		return helper.GetClientIP(r), nil
 	}, logger)
+	twoFactorLimit := restapimiddleware.RateLimit(redisClient, "2fa:ip", 10, time.Minute, func(r *http.Request) (string, error) {
+		return helper.GetClientIP(r), nil
+	}, logger)
+	oauthLimit := restapimiddleware.RateLimit(redisClient, "oauth:ip", 20, time.Minute, func(r *http.Request) (string, error) {
+		return helper.GetClientIP(r), nil
+	}, logger)
 
 	router.Group(func(r chi.Router) {
 		r.Post("/auth/login", wrapper.PostAuthLogin)
+		r.With(twoFactorLimit).Post("/auth/login/2fa", wrapper.PostAuthLogin2fa)
+		r.Post("/auth/refresh", wrapper.PostAuthRefresh)
+		r.Post("/auth/logout", wrapper.PostAuthLogout)
 		r.Post("/auth/register", wrapper.PostAuthRegister)
+		r.Get("/auth/registration", wrapper.GetAuthRegistration)
 		r.Get("/auth/verify-email", wrapper.GetAuthVerifyEmail)
+		r.Get("/auth/confirm-email", wrapper.GetAuthConfirmEmail)
 		r.Post("/auth/forgot-password", wrapper.PostAuthForgotPassword)
 		r.Post("/auth/reset-password", wrapper.PostAuthResetPassword)
+		r.Get("/auth/oauth/providers", wrapper.GetAuthOauthProviders)
+		r.With(oauthLimit).Get("/auth/oauth/{provider}/authorize", wrapper.GetAuthOauthProviderAuthorize)
+		r.With(oauthLimit).Post("/auth/oauth/{provider}/callback", wrapper.PostAuthOauthProviderCallback)
 
 		r.Get("/competition/status", wrapper.GetCompetitionStatus)
 		r.With(scoreboardLimit).Get("/scoreboard", wrapper.GetScoreboard)
@@ -74,11 +89,18 @@ func setupPublicRoutes(router chi.Router, server *Server, wrapper openapi.Server
 	})
 }
 
-func setupAuthOnlyRoutes(router chi.Router, jwtService *jwt.JWTService, apiTokenUC usecase.APITokenUseCase, userUC *user.UserUseCase, wrapper openapi.ServerInterfaceWrapper) {
+func setupAuthOnlyRoutes(
+	router chi.Router,
+	jwtService *jwt.JWTService,
+	sessionUC *user.SessionUseCase,
+	apiTokenUC usecase.APITokenUseCase,
+	userUC *user.UserUseCase,
+	wrapper openapi.ServerInterfaceWrapper,
+) {
 	router.Group(func(r chi.Router) {
-		r.Use(restapimiddleware.Auth(jwtService, apiTokenUC, userUC))
+		r.Use(restapimiddleware.Auth(jwtService, sessionUC, apiTokenUC, userUC))
 
-		r.Post("/auth/resend-verification", wrapper.PostAuthResendVerification)
+		r.With(restapimiddleware.RequireScope(entity.ScopeProfileWrite)).Post("/auth/resend-verification", wrapper.PostAuthResendVerification)
 	})
 }
 
@@ -91,36 +113,58 @@ func setupProtectedRoutes(
 	verifyEmails bool,
 ) {
 	router.Group(func(r chi.Router) {
-		r.Use(restapimiddleware.Auth(deps.Infra.JWTService, deps.User.APITokenUC, deps.User.UserUC))
+		r.Use(restapimiddleware.Auth(deps.Infra.JWTService, deps.User.SessionUC, deps.User.APITokenUC, deps.User.UserUC))
 		r.Use(restapimiddleware.InjectUser(deps.User.UserUC))
 
-		r.Get("/auth/me", wrapper.GetAuthMe)
-
-		r.Get("/user/notifications", wrapper.GetUserNotifications)
-		r.Patch("/user/notifications/{ID}/read", wrapper.PatchUserNotificationsIDRead)
-		r.Get("/user/tokens", wrapper.GetUserTokens)
-		r.Post("/user/tokens", wrapper.PostUserTokens)
-		r.Delete("/user/tokens/{ID}", wrapper.DeleteUserTokensID)
+		profileRead := r.With(restapimiddleware.RequireScope(entity.ScopeProfileRead))
+		profileRead.Get("/auth/me", wrapper.GetAuthMe)
+		profileRead.Get("/user/notifications", wrapper.GetUserNotifications)
+		r.With(restapimiddleware.RequireScope(entity.ScopeProfileWrite)).Patch("/user/notifications/{ID}/read", wrapper.PatchUserNotificationsIDRead)
+
+		// Account security is not reachable with API tokens
+		acct := r.With(restapimiddleware.SessionOnly)
+		acct.Put("/user/password", wrapper.PutUserPassword)
+		acct.Put("/user/username", wrapper.PutUserUsername)
+		acct.Put("/user/email", wrapper.PutUserEmail)
+		acct.Delete("/user/me", wrapper.DeleteUserMe)
+		acct.Get("/user/tokens", wrapper.GetUserTokens)
+		acct.Post("/user/tokens", wrapper.PostUserTokens)
+		acct.Delete("/user/tokens/{ID}", wrapper.DeleteUserTokensID)
+		acct.Get("/user/tokens/{ID}/requests", wrapper.GetUserTokensIDRequests)
+		acct.Get("/user/sessions", wrapper.GetUserSessions)
+		acct.Delete("/user/sessions/{ID}", wrapper.DeleteUserSessionsID)
+		acct.Get("/user/2fa", wrapper.GetUser2fa)
+		acct.Post("/user/2fa/setup", wrapper.PostUser2faSetup)
+		acct.Post("/user/2fa/enable", wrapper.PostUser2faEnable)
+		acct.Post("/user/2fa/disable", wrapper.PostUser2faDisable)
+		acct.Post("/user/2fa/recovery-codes", wrapper.PostUser2faRecoveryCodes)
+		acct.Get("/user/identities", wrapper.GetUserIdentities)
+		acct.Delete("/user/identities/{provider}", wrapper.DeleteUserIdentitiesProvider)
+		acct.Post("/user/identities/{provider}/authorize", wrapper.PostUserIdentitiesProviderAuthorize)
+		acct.Post("/user/identities/{provider}/link", wrapper.PostUserIdentitiesProviderLink)
 
 		setupTeamRoutes(r, wrapper, verifyEmails)
 		setupChallengeRoutes(r, wrapper, deps.Comp.CompetitionUC, deps.Challenge.CommentUC, deps.Infra.RedisClient, submitLimit, durationLimit, verifyEmails, deps.Infra.Logger)
 
-		r.Get("/files/{ID}/download", wrapper.GetFilesIDDownload)
+		r.With(restapimiddleware.RequireScope(entity.ScopeChallengesRead)).Get("/files/{ID}/download", wrapper.GetFilesIDDownload)
 
-		setupAdminRoutes(r, wrapper)
+		setupAdminRoutes(r, wrapper, deps.User.TwoFactorUC, deps.User.RoleUC)
 	})
 }
 
 func setupTeamRoutes(r chi.Router, wrapper openapi.ServerInterfaceWrapper, verifyEmails bool) {
 	// Team
-	r.Get("/teams/my", wrapper.GetTeamsMy)
-	r.Get("/teams/{ID}", wrapper.GetTeamsID)
-	r.Post("/teams/leave", wrapper.PostTeamsLeave)
-	r.Delete("/teams/me", wrapper.DeleteTeamsMe)
-	r.Delete("/teams/members/{ID}", wrapper.DeleteTeamsMembersID)
-	r.Post("/teams/transfer-captain", wrapper.PostTeamsTransferCaptain)
-
-	verified := r.With(restapimiddleware.RequireVerified(verifyEmails))
+	read := r.With(restapimiddleware.RequireScope(entity.ScopeProfileRead))
+	read.Get("/teams/my", wrapper.GetTeamsMy)
+	read.Get("/teams/{ID}", wrapper.GetTeamsID)
+
+	write := r.With(restapimiddleware.RequireScope(entity.ScopeTeamsWrite))
+	write.Post("/teams/leave", wrapper.PostTeamsLeave)
+	write.Delete("/teams/me", wrapper.DeleteTeamsMe)
+	write.Delete("/teams/members/{ID}", wrapper.DeleteTeamsMembersID)
+	write.Post("/teams/transfer-captain", wrapper.PostTeamsTransferCaptain)
+
+	verified := write.With(restapimiddleware.RequireVerified(verifyEmails))
 	verified.Post("/teams", wrapper.PostTeams)
 	verified.Post("/teams/join", wrapper.PostTeamsJoin)
 	verified.Post("/teams/solo", wrapper.PostTeamsSolo)
@@ -137,19 +181,23 @@ func setupChallengeRoutes(
 	verifyEmails bool,
 	log logger.Logger,
 ) {
-	r.Get("/challenges", wrapper.GetChallenges)
-	r.Get("/challenges/{challengeID}/files", wrapper.GetChallengesChallengeIDFiles)
-	r.Get("/challenges/{challengeID}/hints", wrapper.GetChallengesChallengeIDHints)
+	read := r.With(restapimiddleware.RequireScope(entity.ScopeChallengesRead))
+	read.Get("/challenges", wrapper.GetChallenges)
+	read.Get("/challenges/{challengeID}/files", wrapper.GetChallengesChallengeIDFiles)
+	read.Get("/challenges/{challengeID}/hints", wrapper.GetChallengesChallengeIDHints)
+	read.Get("/challenges/{ID}/stages", wrapper.GetChallengesIDStages)
 
 	r.Group(func(comments chi.Router) {
 		comments.Use(restapimiddleware.CompetitionEnded(competitionUC))
-		comments.Get("/challenges/{challengeID}/comments", wrapper.GetChallengesChallengeIDComments)
-		comments.Post("/challenges/{challengeID}/comments", wrapper.PostChallengesChallengeIDComments)
-		comments.Delete("/comments/{ID}", wrapper.DeleteCommentsID)
+		comments.With(restapimiddleware.RequireScope(entity.ScopeChallengesRead)).Get("/challenges/{challengeID}/comments", wrapper.GetChallengesChallengeIDComments)
+		write := comments.With(restapimiddleware.RequireScope(entity.ScopeCommentsWrite))
+		write.Post("/challenges/{challengeID}/comments", wrapper.PostChallengesChallengeIDComments)
+		write.Delete("/comments/{ID}", wrapper.DeleteCommentsID)
 	})
 
 	// Submit Flag (Rate Limited + Verification + Team)
 	r.Group(func(sub chi.Router) {
+		sub.Use(restapimiddleware.RequireScope(entity.ScopeSubmit))
 		sub.Use(restapimiddleware.CompetitionActive(competitionUC))
 		sub.Use(restapimiddleware.RequireVerified(verifyEmails))
 		sub.Use(restapimiddleware.RequireTeam(""))
@@ -169,92 +217,240 @@ func setupChallengeRoutes(
 	})
 
 	// Unlock Hints
-	sub := r.With(restapimiddleware.RequireVerified(verifyEmails), restapimiddleware.RequireTeam(""))
+	sub := r.With(restapimiddleware.RequireScope(entity.ScopeSubmit), restapimiddleware.RequireVerified(verifyEmails), restapimiddleware.RequireTeam(""))
 	sub.Post("/challenges/{challengeID}/hints/{hintID}/unlock", wrapper.PostChallengesChallengeIDHintsHintIDUnlock)
+
+	// Challenge Instances (starting and extending only while the competition runs)
+	sub.Get("/challenges/{ID}/instance", wrapper.GetChallengesIDInstance)
+	sub.Delete("/challenges/{ID}/instance", wrapper.DeleteChallengesIDInstance)
+	running := sub.With(restapimiddleware.CompetitionActive(competitionUC))
+	running.Post("/challenges/{ID}/instance", wrapper.PostChallengesIDInstance)
+	running.Post("/challenges/{ID}/instance/extend", wrapper.PostChallengesIDInstanceExtend)
 }
 
-func setupAdminRoutes(r chi.Router, wrapper openapi.ServerInterfaceWrapper) {
-	// Admin Routes
+func setupAdminRoutes(r chi.Router, wrapper openapi.ServerInterfaceWrapper, twoFactorUC *user.TwoFactorUseCase, roleUC *user.RoleUseCase) {
+	perm := func(permissions ...string) func(http.Handler) http.Handler {
+		return restapimiddleware.RequirePermission(roleUC, permissions...)
+	}
+
+	// Admin Backup
+	r.Group(func(bk chi.Router) {
+		bk.Use(perm(entity.PermBackupManage))
+		bk.Use(restapimiddleware.RequireTwoFactor(twoFactorUC))
+		bk.Use(restapimiddleware.RequireScope(entity.ScopeAdminBackup))
+
+		bk.Get("/admin/export", wrapper.GetAdminExport)
+		bk.Get("/admin/export/zip", wrapper.GetAdminExportZip)
+		bk.Post("/admin/import", wrapper.PostAdminImport)
+	})
+
+	// Admin Service Tokens
+	r.Group(func(tok chi.Router) {
+		tok.Use(restapimiddleware.SessionOnly)
+		tok.Use(perm(entity.PermTokensManage))
+		tok.Use(restapimiddleware.RequireTwoFactor(twoFactorUC))
+
+		tok.Get("/admin/tokens", wrapper.GetAdminTokens)
+		tok.Post("/admin/tokens", wrapper.PostAdminTokens)
+		tok.Delete("/admin/tokens/{ID}", wrapper.DeleteAdminTokensID)
+		tok.Get("/admin/tokens/{ID}/requests", wrapper.GetAdminTokensIDRequests)
+	})
+
+	// Admin Routes, each guarded by the permission it needs
 	r.Group(func(adm chi.Router) {
-		adm.Use(restapimiddleware.Admin)
-
-		adm.Get("/admin/competition", wrapper.GetAdminCompetition)
-		adm.Put("/admin/competition", wrapper.PutAdminCompetition)
-		adm.Get("/admin/settings", wrapper.GetAdminSettings)
-		adm.Put("/admin/settings", wrapper.PutAdminSettings)
-		adm.Get("/admin/configs", wrapper.GetAdminConfigs)
-		adm.Get("/admin/configs/{key}", wrapper.GetAdminConfigsKey)
-		adm.Put("/admin/configs/{key}", wrapper.PutAdminConfigsKey)
-		adm.Delete("/admin/configs/{key}", wrapper.DeleteAdminConfigsKey)
-
-		// Admin Challenges
-		adm.Post("/admin/challenges", wrapper.PostAdminChallenges)
-		adm.Put("/admin/challenges/{ID}", wrapper.PutAdminChallengesID)
-		adm.Delete("/admin/challenges/{ID}", wrapper.DeleteAdminChallengesID)
-		adm.Post("/admin/challenges/{challengeID}/files", wrapper.PostAdminChallengesChallengeIDFiles)
-		adm.Post("/admin/challenges/{challengeID}/hints", wrapper.PostAdminChallengesChallengeIDHints)
-
-		// Admin Hints
-		adm.Put("/admin/hints/{ID}", wrapper.PutAdminHintsID)
-		adm.Delete("/admin/hints/{ID}", wrapper.DeleteAdminHintsID)
-
-		// Admin Files
-		adm.Delete("/admin/files/{ID}", wrapper.DeleteAdminFilesID)
+		adm.Use(restapimiddleware.RequireTwoFactor(twoFactorUC))
+		adm.Use(restapimiddleware.RequireAdminScope)
+
+		competition := adm.With(perm(entity.PermCompetitionManage))
+		competition.Get("/admin/competition", wrapper.GetAdminCompetition)
+		competition.Put("/admin/competition", wrapper.PutAdminCompetition)
+		competition.Get("/admin/settings", wrapper.GetAdminSettings)
+		competition.Put("/admin/settings", wrapper.PutAdminSettings)
+		competition.Get("/admin/configs", wrapper.GetAdminConfigs)
+		competition.Get("/admin/configs/{key}", wrapper.GetAdminConfigsKey)
+		competition.Put("/admin/configs/{key}", wrapper.PutAdminConfigsKey)
+		competition.Delete("/admin/configs/{key}", wrapper.DeleteAdminConfigsKey)
+
+		// Admin Registration Controls
+		competition.Get("/admin/registration/invites", wrapper.GetAdminRegistrationInvites)
+		competition.Post("/admin/registration/invites", wrapper.PostAdminRegistrationInvites)
+		competition.Delete("/admin/registration/invites/{ID}", wrapper.DeleteAdminRegistrationInvitesID)
+		competition.Get("/admin/registration/domains", wrapper.GetAdminRegistrationDomains)
+		competition.Post("/admin/registration/domains", wrapper.PostAdminRegistrationDomains)
+		competition.Delete("/admin/registration/domains/{ID}", wrapper.DeleteAdminRegistrationDomainsID)
+
+		// Admin Login Providers
+		competition.Get("/admin/oauth/providers", wrapper.GetAdminOauthProviders)
+		competition.Put("/admin/oauth/providers/{provider}", wrapper.PutAdminOauthProvidersProvider)
+		competition.Delete("/admin/oauth/providers/{provider}", wrapper.DeleteAdminOauthProvidersProvider)
+
+		// Admin Challenges, Flags, Stages, Requirements, Schedules, Scoring, Attempt Limits, Grading, Revisions, Hints and Files; authors are limited to their own challenges by the handlers
+		challenges := adm.With(perm(entity.PermChallengesManage, entity.PermChallengesAuthor))
+		challenges.Post("/admin/challenges", wrapper.PostAdminChallenges)
+		challenges.Put("/admin/challenges/{ID}", wrapper.PutAdminChallengesID)
+		challenges.Delete("/admin/challenges/{ID}", wrapper.DeleteAdminChallengesID)
+		challenges.Post("/admin/challenges/{challengeID}/files", wrapper.PostAdminChallengesChallengeIDFiles)
+		challenges.Get("/admin/challenges/{challengeID}/flags", wrapper.GetAdminChallengesChallengeIDFlags)
+		challenges.Post("/admin/challenges/{challengeID}/flags", wrapper.PostAdminChallengesChallengeIDFlags)
+		challenges.Put("/admin/flags/{ID}", wrapper.PutAdminFlagsID)
+		challenges.Delete("/admin/flags/{ID}", wrapper.DeleteAdminFlagsID)
+		challenges.Get("/admin/challenges/{challengeID}/stages", wrapper.GetAdminChallengesChallengeIDStages)
+		challenges.Post("/admin/challenges/{challengeID}/stages", wrapper.PostAdminChallengesChallengeIDStages)
+		challenges.Put("/admin/stages/{ID}", wrapper.PutAdminStagesID)
+		challenges.Delete("/admin/stages/{ID}", wrapper.DeleteAdminStagesID)
+		challenges.Get("/admin/challenges/{challengeID}/requirements", wrapper.GetAdminChallengesChallengeIDRequirements)
+		challenges.Put("/admin/challenges/{challengeID}/requirements", wrapper.PutAdminChallengesChallengeIDRequirements)
+		challenges.Get("/admin/challenges/{challengeID}/schedule", wrapper.GetAdminChallengesChallengeIDSchedule)
+		challenges.Put("/admin/challenges/{challengeID}/schedule", wrapper.PutAdminChallengesChallengeIDSchedule)
+		challenges.Get("/admin/challenges/{challengeID}/scoring", wrapper.GetAdminChallengesChallengeIDScoring)
+		challenges.Put("/admin/challenges/{challengeID}/scoring", wrapper.PutAdminChallengesChallengeIDScoring)
+		challenges.Delete("/admin/challenges/{challengeID}/scoring", wrapper.DeleteAdminChallengesChallengeIDScoring)
+		challenges.Get("/admin/scoring/functions", wrapper.GetAdminScoringFunctions)
+		challenges.Post("/admin/scoring/preview", wrapper.PostAdminScoringPreview)
+		challenges.Get("/admin/challenges/{challengeID}/attempt-limit", wrapper.GetAdminChallengesChallengeIDAttemptLimit)
+		challenges.Put("/admin/challenges/{challengeID}/attempt-limit", wrapper.PutAdminChallengesChallengeIDAttemptLimit)
+		challenges.Delete("/admin/challenges/{challengeID}/attempt-limit", wrapper.DeleteAdminChallengesChallengeIDAttemptLimit)
+		challenges.Delete("/admin/challenges/{challengeID}/attempts/{teamID}", wrapper.DeleteAdminChallengesChallengeIDAttemptsTeamID)
+		challenges.Get("/admin/challenges/{challengeID}/manual-review", wrapper.GetAdminChallengesChallengeIDManualReview)
+		challenges.Put("/admin/challenges/{challengeID}/manual-review", wrapper.PutAdminChallengesChallengeIDManualReview)
+		challenges.Delete("/admin/challenges/{challengeID}/manual-review", wrapper.DeleteAdminChallengesChallengeIDManualReview)
+		challenges.Get("/admin/challenges/{challengeID}/team-flag", wrapper.GetAdminChallengesChallengeIDTeamFlag)
+		challenges.Put("/admin/challenges/{challengeID}/team-flag", wrapper.PutAdminChallengesChallengeIDTeamFlag)
+		challenges.Delete("/admin/challenges/{challengeID}/team-flag", wrapper.DeleteAdminChallengesChallengeIDTeamFlag)
+		challenges.Post("/admin/challenges/{challengeID}/team-flag/render", wrapper.PostAdminChallengesChallengeIDTeamFlagRender)
+		challenges.Get("/admin/challenges/{challengeID}/instance-config", wrapper.GetAdminChallengesChallengeIDInstanceConfig)
+		challenges.Put("/admin/challenges/{challengeID}/instance-config", wrapper.PutAdminChallengesChallengeIDInstanceConfig)
+		challenges.Delete("/admin/challenges/{challengeID}/instance-config", wrapper.DeleteAdminChallengesChallengeIDInstanceConfig)
+		challenges.Get("/admin/challenges/{challengeID}/revisions", wrapper.GetAdminChallengesChallengeIDRevisions)
+		challenges.Get("/admin/challenges/{challengeID}/revisions/diff", wrapper.GetAdminChallengesChallengeIDRevisionsDiff)
+		challenges.Post("/admin/challenges/{challengeID}/revisions/{version}/rollback", wrapper.PostAdminChallengesChallengeIDRevisionsVersionRollback)
+		challenges.Post("/admin/challenges/{challengeID}/hints", wrapper.PostAdminChallengesChallengeIDHints)
+		challenges.Put("/admin/hints/{ID}", wrapper.PutAdminHintsID)
+		challenges.Delete("/admin/hints/{ID}", wrapper.DeleteAdminHintsID)
+		challenges.Delete("/admin/files/{ID}", wrapper.DeleteAdminFilesID)
+
+		// Admin Release Timeline covers every challenge, so authors are not allowed
+		releases := adm.With(perm(entity.PermChallengesManage))
+		releases.Get("/admin/release-timeline", wrapper.GetAdminReleaseTimeline)
+
+		// Admin Review Queue spans every manually graded challenge, so authors are not allowed
+		reviews := adm.With(perm(entity.PermChallengesManage))
+		reviews.Get("/admin/reviews", wrapper.GetAdminReviews)
+		reviews.Post("/admin/reviews/{ID}/approve", wrapper.PostAdminReviewsIDApprove)
+		reviews.Post("/admin/reviews/{ID}/reject", wrapper.PostAdminReviewsIDReject)
+
+		// Admin Solves grant and revoke credit for any team, so authors are not allowed
+		solves := adm.With(perm(entity.PermChallengesManage))
+		solves.Post("/admin/challenges/{challengeID}/solves", wrapper.PostAdminChallengesChallengeIDSolves)
+		solves.Delete("/admin/challenges/{challengeID}/solves/{teamID}", wrapper.DeleteAdminChallengesChallengeIDSolvesTeamID)
+
+		// Admin Scoring checks and rescoring cover every challenge, so authors are not allowed
+		rescore := adm.With(perm(entity.PermChallengesManage))
+		rescore.Get("/admin/scoring/consistency", wrapper.GetAdminScoringConsistency)
+		rescore.Post("/admin/scoring/rescore", wrapper.PostAdminScoringRescore)
+
+		// Admin Challenge Specs import and export many challenges at once, so authors are not allowed
+		specs := adm.With(perm(entity.PermChallengesManage))
+		specs.Get("/admin/challenge-specs/export", wrapper.GetAdminChallengeSpecsExport)
+		specs.Post("/admin/challenge-specs/import", wrapper.PostAdminChallengeSpecsImport)
+
+		// Admin Decoy Flags are anti-cheat traps, so authors are not allowed
+		decoys := adm.With(perm(entity.PermChallengesManage))
+		decoys.Get("/admin/challenges/{challengeID}/decoys", wrapper.GetAdminChallengesChallengeIDDecoys)
+		decoys.Post("/admin/challenges/{challengeID}/decoys", wrapper.PostAdminChallengesChallengeIDDecoys)
+		decoys.Delete("/admin/decoys/{ID}", wrapper.DeleteAdminDecoysID)
 
 		// Admin Awards
-		adm.Post("/admin/awards", wrapper.PostAdminAwards)
-		adm.Get("/admin/awards/team/{teamID}", wrapper.GetAdminAwardsTeamTeamID)
+		awards := adm.With(perm(entity.PermAwardsManage))
+		awards.Post("/admin/awards", wrapper.PostAdminAwards)
+		awards.Get("/admin/awards/team/{teamID}", wrapper.GetAdminAwardsTeamTeamID)
 
 		// Admin Teams
-		adm.Post("/admin/teams/{ID}/ban", wrapper.PostAdminTeamsIDBan)
-		adm.Delete("/admin/teams/{ID}/ban", wrapper.DeleteAdminTeamsIDBan)
-		adm.Patch("/admin/teams/{ID}/hidden", wrapper.PatchAdminTeamsIDHidden)
-		adm.Patch("/admin/teams/{ID}/bracket", wrapper.PatchAdminTeamsIDBracket)
+		teams := adm.With(perm(entity.PermTeamsModerate))
+		teams.Post("/admin/teams/{ID}/ban", wrapper.PostAdminTeamsIDBan)
+		teams.Delete("/admin/teams/{ID}/ban", wrapper.DeleteAdminTeamsIDBan)
+		teams.Patch("/admin/teams/{ID}/hidden", wrapper.PatchAdminTeamsIDHidden)
+		teams.Delete("/admin/teams/{ID}/sessions", wrapper.DeleteAdminTeamsIDSessions)
+		teams.Post("/admin/cheat-incidents/{ID}/dismiss", wrapper.PostAdminCheatIncidentsIDDismiss)
+		teams.Post("/admin/cheat-incidents/{ID}/escalate", wrapper.PostAdminCheatIncidentsIDEscalate)
+		teams.Post("/admin/cheat-incidents/analyze", wrapper.PostAdminCheatIncidentsAnalyze)
+		teams.Get("/admin/decoy-policy", wrapper.GetAdminDecoyPolicy)
+		teams.Put("/admin/decoy-policy", wrapper.PutAdminDecoyPolicy)
+		teams.Get("/admin/ws", wrapper.GetAdminWs)
+
+		// Admin Users
+		users := adm.With(perm(entity.PermUsersManage))
+		users.Get("/admin/users", wrapper.GetAdminUsers)
+		users.Get("/admin/users/{ID}", wrapper.GetAdminUsersID)
+		users.Patch("/admin/users/{ID}", wrapper.PatchAdminUsersID)
+		users.Delete("/admin/users/{ID}", wrapper.DeleteAdminUsersID)
+		users.Post("/admin/users/{ID}/ban", wrapper.PostAdminUsersIDBan)
+		users.Delete("/admin/users/{ID}/ban", wrapper.DeleteAdminUsersIDBan)
+		users.Put("/admin/users/{ID}/password", wrapper.PutAdminUsersIDPassword)
+		users.Put("/admin/users/{ID}/team", wrapper.PutAdminUsersIDTeam)
+		users.Delete("/admin/users/{ID}/sessions", wrapper.DeleteAdminUsersIDSessions)
+		users.Delete("/admin/users/{ID}/2fa", wrapper.DeleteAdminUsersID2fa)
+		users.Get("/admin/users/{ID}/lockout", wrapper.GetAdminUsersIDLockout)
+		users.Delete("/admin/users/{ID}/lockout", wrapper.DeleteAdminUsersIDLockout)
+
+		// Admin Roles
+		roles := adm.With(perm(entity.PermRolesManage))
+		roles.Get("/admin/permissions", wrapper.GetAdminPermissions)
+		roles.Get("/admin/roles", wrapper.GetAdminRoles)
+		roles.Post("/admin/roles", wrapper.PostAdminRoles)
+		roles.Put("/admin/roles/{name}", wrapper.PutAdminRolesName)
+		roles.Delete("/admin/roles/{name}", wrapper.DeleteAdminRolesName)
+		roles.Put("/admin/users/{ID}/role", wrapper.PutAdminUsersIDRole)
 
 		// Admin Brackets
-		adm.Post("/admin/brackets", wrapper.PostAdminBrackets)
-		adm.Get("/admin/brackets/{ID}", wrapper.GetAdminBracketsID)
-		adm.Put("/admin/brackets/{ID}", wrapper.PutAdminBracketsID)
-		adm.Delete("/admin/brackets/{ID}", wrapper.DeleteAdminBracketsID)
+		brackets := adm.With(perm(entity.PermBracketsManage))
+		brackets.Post("/admin/brackets", wrapper.PostAdminBrackets)
+		brackets.Get("/admin/brackets/{ID}", wrapper.GetAdminBracketsID)
+		brackets.Put("/admin/brackets/{ID}", wrapper.PutAdminBracketsID)
+		brackets.Delete("/admin/brackets/{ID}", wrapper.DeleteAdminBracketsID)
+		brackets.Patch("/admin/teams/{ID}/bracket", wrapper.PatchAdminTeamsIDBracket)
 
 		// Admin CTF Events / Ratings
-		adm.Get("/admin/ctf-events", wrapper.GetAdminCtfEvents)
-		adm.Post("/admin/ctf-events", wrapper.PostAdminCtfEvents)
-		adm.Post("/admin/ctf-events/{ID}/finalize", wrapper.PostAdminCtfEventsIDFinalize)
+		ratings := adm.With(perm(entity.PermRatingsManage))
+		ratings.Get("/admin/ctf-events", wrapper.GetAdminCtfEvents)
+		ratings.Post("/admin/ctf-events", wrapper.PostAdminCtfEvents)
+		ratings.Post("/admin/ctf-events/{ID}/finalize", wrapper.PostAdminCtfEventsIDFinalize)
 
 		// Admin Tags
-		adm.Post("/admin/tags", wrapper.PostAdminTags)
-		adm.Put("/admin/tags/{ID}", wrapper.PutAdminTagsID)
-		adm.Delete("/admin/tags/{ID}", wrapper.DeleteAdminTagsID)
+		tags := adm.With(perm(entity.PermTagsManage))
+		tags.Post("/admin/tags", wrapper.PostAdminTags)
+		tags.Put("/admin/tags/{ID}", wrapper.PutAdminTagsID)
+		tags.Delete("/admin/tags/{ID}", wrapper.DeleteAdminTagsID)
 
 		// Admin Fields
-		adm.Post("/admin/fields", wrapper.PostAdminFields)
-		adm.Put("/admin/fields/{ID}", wrapper.PutAdminFieldsID)
-		adm.Delete("/admin/fields/{ID}", wrapper.DeleteAdminFieldsID)
+		fields := adm.With(perm(entity.PermFieldsManage))
+		fields.Post("/admin/fields", wrapper.PostAdminFields)
+		fields.Put("/admin/fields/{ID}", wrapper.PutAdminFieldsID)
+		fields.Delete("/admin/fields/{ID}", wrapper.DeleteAdminFieldsID)
 
 		// Admin Pages
-		adm.Get("/admin/pages", wrapper.GetAdminPages)
-		adm.Post("/admin/pages", wrapper.PostAdminPages)
-		adm.Get("/admin/pages/{ID}", wrapper.GetAdminPagesID)
-		adm.Put("/admin/pages/{ID}", wrapper.PutAdminPagesID)
-		adm.Delete("/admin/pages/{ID}", wrapper.DeleteAdminPagesID)
+		pages := adm.With(perm(entity.PermPagesManage))
+		pages.Get("/admin/pages", wrapper.GetAdminPages)
+		pages.Post("/admin/pages", wrapper.PostAdminPages)
+		pages.Get("/admin/pages/{ID}", wrapper.GetAdminPagesID)
+		pages.Put("/admin/pages/{ID}", wrapper.PutAdminPagesID)
+		pages.Delete("/admin/pages/{ID}", wrapper.DeleteAdminPagesID)
 
 		// Admin Notifications
-		adm.Post("/admin/notifications", wrapper.PostAdminNotifications)
-		adm.Post("/admin/notifications/user/{userID}", wrapper.PostAdminNotificationsUserUserID)
-		adm.Put("/admin/notifications/{ID}", wrapper.PutAdminNotificationsID)
-		adm.Delete("/admin/notifications/{ID}", wrapper.DeleteAdminNotificationsID)
-
-		// Admin Submissions
-		adm.Get("/admin/submissions", wrapper.GetAdminSubmissions)
-		adm.Get("/admin/submissions/challenge/{challengeID}", wrapper.GetAdminSubmissionsChallengeChallengeID)
-		adm.Get("/admin/submissions/challenge/{challengeID}/stats", wrapper.GetAdminSubmissionsChallengeChallengeIDStats)
-		adm.Get("/admin/submissions/user/{userID}", wrapper.GetAdminSubmissionsUserUserID)
-		adm.Get("/admin/submissions/team/{teamID}", wrapper.GetAdminSubmissionsTeamTeamID)
-
-		// Admin Backup
-		adm.Get("/admin/export", wrapper.GetAdminExport)
-		adm.Get("/admin/export/zip", wrapper.GetAdminExportZip)
-		adm.Post("/admin/import", wrapper.PostAdminImport)
+		notifications := adm.With(perm(entity.PermNotificationsManage))
+		notifications.Post("/admin/notifications", wrapper.PostAdminNotifications)
+		notifications.Post("/admin/notifications/user/{userID}", wrapper.PostAdminNotificationsUserUserID)
+		notifications.Put("/admin/notifications/{ID}", wrapper.PutAdminNotificationsID)
+		notifications.Delete("/admin/notifications/{ID}", wrapper.DeleteAdminNotificationsID)
+
+		// Admin Submissions; submitted flags are only shown with flags.read
+		submissions := adm.With(perm(entity.PermSubmissionsRead))
+		submissions.Get("/admin/submissions", wrapper.GetAdminSubmissions)
+		submissions.Get("/admin/submissions/challenge/{challengeID}", wrapper.GetAdminSubmissionsChallengeChallengeID)
+		submissions.Get("/admin/submissions/challenge/{challengeID}/stats", wrapper.GetAdminSubmissionsChallengeChallengeIDStats)
+		submissions.Get("/admin/submissions/user/{userID}", wrapper.GetAdminSubmissionsUserUserID)
+		submissions.Get("/admin/submissions/team/{teamID}", wrapper.GetAdminSubmissionsTeamTeamID)
+		submissions.Get("/admin/cheat-incidents", wrapper.GetAdminCheatIncidents)
+		submissions.Get("/admin/cheat-incidents/{ID}", wrapper.GetAdminCheatIncidentsID)
 	})
 }
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/middleware"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+)
+
+// List my sessions
+// (GET /user/sessions)
+func (h *Server) GetUserSessions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	sessions, err := h.user.SessionUC.List(r.Context(), userID)
+	if h.OnError(w, r, err, "GetUserSessions", "List") {
+		return
+	}
+
+	currentID, _ := middleware.GetSessionID(r.Context())
+	helper.RenderOK(w, r, response.FromSessionList(sessions, currentID))
+}
+
+// Revoke session
+// (DELETE /user/sessions/{ID})
+func (h *Server) DeleteUserSessionsID(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	sessionID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.SessionUC.Revoke(r.Context(), sessionID, userID), "DeleteUserSessionsID", "Revoke") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Revoke user sessions
+// (DELETE /admin/users/{ID}/sessions)
+func (h *Server) DeleteAdminUsersIDSessions(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	n, err := h.user.SessionUC.RevokeAllForUser(r.Context(), userID, admin.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "DeleteAdminUsersIDSessions", "RevokeAllForUser") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRevokedSessions(n))
+}
+
+// Revoke team sessions
+// (DELETE /admin/teams/{ID}/sessions)
+func (h *Server) DeleteAdminTeamsIDSessions(w http.ResponseWriter, r *http.Request, id string) {
+	teamID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	n, err := h.user.SessionUC.RevokeAllForTeam(r.Context(), teamID, admin.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "DeleteAdminTeamsIDSessions", "RevokeAllForTeam") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRevokedSessions(n))
+}
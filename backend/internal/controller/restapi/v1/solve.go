@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Grant solve
+// (POST /admin/challenges/{challengeID}/solves)
+func (h *Server) PostAdminChallengesChallengeIDSolves(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestSolveGrantRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminChallengesChallengeIDSolves",
+	)
+	if !ok {
+		return
+	}
+
+	solve, err := request.SolveGrantRequestToEntity(challengeuuid, &req)
+	if err != nil {
+		helper.RenderError(w, r, http.StatusBadRequest, "invalid team or user ID")
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	firstBlood, err := h.comp.SolveUC.Grant(r.Context(), solve, user.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PostAdminChallengesChallengeIDSolves", "Grant") {
+		return
+	}
+
+	helper.RenderCreated(w, r, response.FromSolveGrant(solve, firstBlood))
+}
+
+// Revoke solve
+// (DELETE /admin/challenges/{challengeID}/solves/{teamID})
+func (h *Server) DeleteAdminChallengesChallengeIDSolvesTeamID(w http.ResponseWriter, r *http.Request, challengeID, teamID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	teamuuid, ok := helper.ParseUUID(w, r, teamID)
+	if !ok {
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.comp.SolveUC.Revoke(r.Context(), challengeuuid, teamuuid, user.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "DeleteAdminChallengesChallengeIDSolvesTeamID", "Revoke") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Check scoring consistency
+// (GET /admin/scoring/consistency)
+func (h *Server) GetAdminScoringConsistency(w http.ResponseWriter, r *http.Request, params openapi.GetAdminScoringConsistencyParams) {
+	opts := entity.RescoreOptions{
+		ExcludeBanned: params.ExcludeBanned != nil && *params.ExcludeBanned,
+		ExcludeHidden: params.ExcludeHidden != nil && *params.ExcludeHidden,
+	}
+
+	report, err := h.comp.SolveUC.CheckConsistency(r.Context(), opts)
+	if h.OnError(w, r, err, "GetAdminScoringConsistency", "CheckConsistency") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRescoreReport(report))
+}
+
+// Rescore all challenges
+// (POST /admin/scoring/rescore)
+func (h *Server) PostAdminScoringRescore(w http.ResponseWriter, r *http.Request) {
+	req, ok := helper.DecodeAndValidate[openapi.RequestRescoreRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminScoringRescore",
+	)
+	if !ok {
+		return
+	}
+
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.comp.SolveUC.Rescore(r.Context(), request.RescoreRequestToOptions(&req), &user.ID, helper.GetClientIP(r))
+	if h.OnError(w, r, err, "PostAdminScoringRescore", "Rescore") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRescoreReport(report))
+}
@@ -4,7 +4,9 @@ import (
 	"net/http"
 
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/entity"
 	"github.com/skr1ms/CTFBoard/internal/openapi"
 )
 
@@ -18,7 +20,7 @@ func (h *Server) GetAdminSubmissions(w http.ResponseWriter, r *http.Request, par
 		return
 	}
 
-	helper.RenderOK(w, r, response.FromSubmissionList(items, total, page, perPage))
+	helper.RenderOK(w, r, response.FromSubmissionList(items, total, page, perPage, h.hasPermission(r, entity.PermFlagsRead)))
 }
 
 // Get submissions by challenge (admin)
@@ -36,7 +38,7 @@ func (h *Server) GetAdminSubmissionsChallengeChallengeID(w http.ResponseWriter,
 		return
 	}
 
-	helper.RenderOK(w, r, response.FromSubmissionList(items, total, page, perPage))
+	helper.RenderOK(w, r, response.FromSubmissionList(items, total, page, perPage, h.hasPermission(r, entity.PermFlagsRead)))
 }
 
 // Get submission stats by challenge (admin)
@@ -70,7 +72,7 @@ func (h *Server) GetAdminSubmissionsUserUserID(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	helper.RenderOK(w, r, response.FromSubmissionList(items, total, page, perPage))
+	helper.RenderOK(w, r, response.FromSubmissionList(items, total, page, perPage, h.hasPermission(r, entity.PermFlagsRead)))
 }
 
 // Get submissions by team (admin)
@@ -88,7 +90,7 @@ func (h *Server) GetAdminSubmissionsTeamTeamID(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	helper.RenderOK(w, r, response.FromSubmissionList(items, total, page, perPage))
+	helper.RenderOK(w, r, response.FromSubmissionList(items, total, page, perPage, h.hasPermission(r, entity.PermFlagsRead)))
 }
 
 func getPagePerPage(page, perPage *int) (int, int) {
@@ -102,3 +104,16 @@ func getPagePerPage(page, perPage *int) (int, int) {
 	}
 	return p, pp
 }
+
+// Get cheat incidents
+// (GET /admin/cheat-incidents)
+func (h *Server) GetAdminCheatIncidents(w http.ResponseWriter, r *http.Request, params openapi.GetAdminCheatIncidentsParams) {
+	page, perPage := getPagePerPage(params.Page, params.PerPage)
+
+	items, total, err := h.comp.SubmissionUC.GetCheatIncidents(r.Context(), request.CheatIncidentFilterParams(&params), page, perPage)
+	if h.OnError(w, r, err, "GetAdminCheatIncidents", "GetCheatIncidents") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromCheatIncidentList(items, total, page, perPage, h.hasPermission(r, entity.PermFlagsRead)))
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Get per-team flag
+// (GET /admin/challenges/{challengeID}/team-flag)
+func (h *Server) GetAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "GetAdminChallengesChallengeIDTeamFlag") {
+		return
+	}
+
+	cfg, err := h.challenge.ChallengeUC.GetTeamFlagConfig(r.Context(), challengeuuid)
+	if h.OnError(w, r, err, "GetAdminChallengesChallengeIDTeamFlag", "GetTeamFlagConfig") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromTeamFlagConfig(cfg))
+}
+
+// Set per-team flag
+// (PUT /admin/challenges/{challengeID}/team-flag)
+func (h *Server) PutAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PutAdminChallengesChallengeIDTeamFlag") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestTeamFlagRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PutAdminChallengesChallengeIDTeamFlag",
+	)
+	if !ok {
+		return
+	}
+
+	template, rotateSecret := request.TeamFlagRequestToParams(&req)
+	cfg, err := h.challenge.ChallengeUC.SetTeamFlag(r.Context(), challengeuuid, template, rotateSecret)
+	if h.OnError(w, r, err, "PutAdminChallengesChallengeIDTeamFlag", "SetTeamFlag") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromTeamFlagConfig(cfg))
+}
+
+// Delete per-team flag
+// (DELETE /admin/challenges/{challengeID}/team-flag)
+func (h *Server) DeleteAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "DeleteAdminChallengesChallengeIDTeamFlag") {
+		return
+	}
+
+	if h.OnError(w, r, h.challenge.ChallengeUC.DeleteTeamFlag(r.Context(), challengeuuid), "DeleteAdminChallengesChallengeIDTeamFlag", "DeleteTeamFlag") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Render per-team flag
+// (POST /admin/challenges/{challengeID}/team-flag/render)
+func (h *Server) PostAdminChallengesChallengeIDTeamFlagRender(w http.ResponseWriter, r *http.Request, challengeID string) {
+	challengeuuid, ok := helper.ParseUUID(w, r, challengeID)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeChallenge(w, r, challengeuuid, "PostAdminChallengesChallengeIDTeamFlagRender") {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestRenderTeamFlagRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAdminChallengesChallengeIDTeamFlagRender",
+	)
+	if !ok {
+		return
+	}
+
+	teamID, text := request.RenderTeamFlagRequestToParams(&req)
+	flag, rendered, err := h.challenge.ChallengeUC.RenderTeamFlag(r.Context(), challengeuuid, teamID, text)
+	if h.OnError(w, r, err, "PostAdminChallengesChallengeIDTeamFlagRender", "RenderTeamFlag") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromTeamFlagRender(teamID, flag, rendered))
+}
@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
+	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/openapi"
+)
+
+// Complete two-factor login
+// (POST /auth/login/2fa)
+func (h *Server) PostAuthLogin2fa(w http.ResponseWriter, r *http.Request) {
+	req, ok := helper.DecodeAndValidate[openapi.RequestLoginTwoFactorRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAuthLogin2fa",
+	)
+	if !ok {
+		return
+	}
+
+	tokenPair, err := h.user.UserUC.LoginTwoFactor(r.Context(), req.ChallengeToken, req.Code, helper.GetClientIP(r), r.UserAgent())
+	if h.OnError(w, r, err, "PostAuthLogin2fa", "LoginTwoFactor") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromTokenPair(tokenPair))
+}
+
+// Get my 2FA status
+// (GET /user/2fa)
+func (h *Server) GetUser2fa(w http.ResponseWriter, r *http.Request) {
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	status, err := h.user.TwoFactorUC.Status(r.Context(), user)
+	if h.OnError(w, r, err, "GetUser2fa", "Status") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromTwoFactorStatus(status))
+}
+
+// Start 2FA setup
+// (POST /user/2fa/setup)
+func (h *Server) PostUser2faSetup(w http.ResponseWriter, r *http.Request) {
+	user, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	setup, err := h.user.TwoFactorUC.Setup(r.Context(), user)
+	if h.OnError(w, r, err, "PostUser2faSetup", "Setup") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromTwoFactorSetup(setup))
+}
+
+// Enable 2FA
+// (POST /user/2fa/enable)
+func (h *Server) PostUser2faEnable(w http.ResponseWriter, r *http.Request) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestTwoFactorCodeRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostUser2faEnable",
+	)
+	if !ok {
+		return
+	}
+
+	codes, err := h.user.TwoFactorUC.Enable(r.Context(), userID, req.Code)
+	if h.OnError(w, r, err, "PostUser2faEnable", "Enable") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRecoveryCodes(codes))
+}
+
+// Disable 2FA
+// (POST /user/2fa/disable)
+func (h *Server) PostUser2faDisable(w http.ResponseWriter, r *http.Request) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestTwoFactorCodeRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostUser2faDisable",
+	)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.TwoFactorUC.Disable(r.Context(), userID, req.Code), "PostUser2faDisable", "Disable") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
+// Regenerate recovery codes
+// (POST /user/2fa/recovery-codes)
+func (h *Server) PostUser2faRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	userID, ok := helper.ParseAuthUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := helper.DecodeAndValidate[openapi.RequestTwoFactorCodeRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostUser2faRecoveryCodes",
+	)
+	if !ok {
+		return
+	}
+
+	codes, err := h.user.TwoFactorUC.RegenerateRecoveryCodes(r.Context(), userID, req.Code)
+	if h.OnError(w, r, err, "PostUser2faRecoveryCodes", "RegenerateRecoveryCodes") {
+		return
+	}
+
+	helper.RenderOK(w, r, response.FromRecoveryCodes(codes))
+}
+
+// Reset user 2FA
+// (DELETE /admin/users/{ID}/2fa)
+func (h *Server) DeleteAdminUsersID2fa(w http.ResponseWriter, r *http.Request, id string) {
+	userID, ok := helper.ParseUUID(w, r, id)
+	if !ok {
+		return
+	}
+
+	admin, ok := helper.RequireUser(w, r)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.TwoFactorUC.Reset(r.Context(), userID, admin.ID, helper.GetClientIP(r)), "DeleteAdminUsersID2fa", "Reset") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
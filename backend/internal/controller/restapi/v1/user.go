@@ -6,6 +6,7 @@ import (
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/request"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/response"
+	"github.com/skr1ms/CTFBoard/internal/entity"
 	"github.com/skr1ms/CTFBoard/internal/openapi"
 )
 
@@ -20,14 +21,49 @@ func (h *Server) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	email, password := request.LoginRequestCredentials(&req)
-	tokenPair, err := h.user.UserUC.Login(r.Context(), email, password)
+	res, err := h.user.UserUC.Login(r.Context(), email, password, helper.GetClientIP(r), r.UserAgent())
 	if h.OnError(w, r, err, "PostAuthLogin", "Login") {
 		return
 	}
 
+	helper.RenderOK(w, r, response.FromLoginResult(res))
+}
+
+// Refresh tokens
+// (POST /auth/refresh)
+func (h *Server) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	req, ok := helper.DecodeAndValidate[openapi.RequestRefreshTokenRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAuthRefresh",
+	)
+	if !ok {
+		return
+	}
+
+	tokenPair, err := h.user.UserUC.Refresh(r.Context(), req.RefreshToken)
+	if h.OnError(w, r, err, "PostAuthRefresh", "Refresh") {
+		return
+	}
+
 	helper.RenderOK(w, r, response.FromTokenPair(tokenPair))
 }
 
+// User logout
+// (POST /auth/logout)
+func (h *Server) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+	req, ok := helper.DecodeAndValidate[openapi.RequestRefreshTokenRequest](
+		w, r, h.infra.Validator, h.infra.Logger, "PostAuthLogout",
+	)
+	if !ok {
+		return
+	}
+
+	if h.OnError(w, r, h.user.UserUC.Logout(r.Context(), req.RefreshToken), "PostAuthLogout", "Logout") {
+		return
+	}
+
+	helper.RenderNoContent(w, r)
+}
+
 // Register new user
 // (POST /auth/register)
 func (h *Server) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
@@ -38,8 +74,8 @@ func (h *Server) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	username, email, password, customFields := request.RegisterRequestCredentials(&req)
-	user, err := h.user.UserUC.Register(r.Context(), username, email, password, customFields)
+	username, email, password, inviteCode, customFields := request.RegisterRequestCredentials(&req)
+	user, err := h.user.UserUC.Register(r.Context(), username, email, password, inviteCode, customFields)
 	if h.OnError(w, r, err, "PostAuthRegister", "Register") {
 		return
 	}
@@ -59,7 +95,16 @@ func (h *Server) GetAuthMe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	helper.RenderOK(w, r, response.FromUserForMe(user))
+	h.renderMe(w, r, user)
+}
+
+func (h *Server) renderMe(w http.ResponseWriter, r *http.Request, user *entity.User) {
+	permissions := []string{}
+	if role, err := h.user.RoleUC.Get(r.Context(), user.Role); err == nil {
+		permissions = role.Permissions
+	}
+
+	helper.RenderOK(w, r, response.FromUserForMe(user, permissions))
 }
 
 // Get user profile
@@ -9,3 +9,9 @@ import (
 func (h *Server) GetWs(w http.ResponseWriter, r *http.Request) {
 	h.infra.WSController.HandleWS(w, r)
 }
+
+// Admin WebSocket connection
+// (GET /admin/ws)
+func (h *Server) GetAdminWs(w http.ResponseWriter, r *http.Request) {
+	h.infra.WSController.HandleAdminWS(w, r)
+}
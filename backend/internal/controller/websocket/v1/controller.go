@@ -33,6 +33,24 @@ func (c *Controller) RegisterRoutes(router chi.Router) {
 }
 
 func (c *Controller) HandleWS(w http.ResponseWriter, r *http.Request) {
+	conn, ok := c.accept(w, r, "HandleWS")
+	if !ok {
+		return
+	}
+	c.serve(pkgWS.NewClient(c.hub, conn))
+}
+
+// HandleAdminWS serves the admin channel, which also carries admin-only events such as cheat
+// alerts. Routing must only let admins reach it.
+func (c *Controller) HandleAdminWS(w http.ResponseWriter, r *http.Request) {
+	conn, ok := c.accept(w, r, "HandleAdminWS")
+	if !ok {
+		return
+	}
+	c.serve(pkgWS.NewAdminClient(c.hub, conn))
+}
+
+func (c *Controller) accept(w http.ResponseWriter, r *http.Request, handler string) (*websocket.Conn, bool) {
 	opts := &websocket.AcceptOptions{
 		OriginPatterns: c.allowedOrigins,
 	}
@@ -43,11 +61,13 @@ func (c *Controller) HandleWS(w http.ResponseWriter, r *http.Request) {
 
 	conn, err := websocket.Accept(w, r, opts)
 	if err != nil {
-		c.logger.WithError(err).Error("ws - HandleWS - Accept")
-		return
+		c.logger.WithError(err).Error("ws - " + handler + " - Accept")
+		return nil, false
 	}
+	return conn, true
+}
 
-	client := pkgWS.NewClient(c.hub, conn)
+func (c *Controller) serve(client *pkgWS.Client) {
 	c.hub.Register(client)
 
 	go client.WritePump()
@@ -1,17 +1,79 @@
 package entity
 
 import (
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// API token scopes. A token can only reach routes whose scope it carries; session (JWT) requests
+// are not restricted by scopes.
+const (
+	ScopeProfileRead    = "profile:read"
+	ScopeProfileWrite   = "profile:write"
+	ScopeTeamsWrite     = "teams:write"
+	ScopeChallengesRead = "challenges:read"
+	ScopeCommentsWrite  = "comments:write"
+	ScopeSubmit         = "submit"
+
+	// ScopeAdmin grants every admin route and implies every other admin:* scope.
+	ScopeAdmin       = "admin"
+	ScopeAdminRead   = "admin:read"
+	ScopeAdminBackup = "admin:backup"
+)
+
+// UserScopes are the scopes any user may put on a token; AdminScopes additionally require the admin role.
+var (
+	UserScopes  = []string{ScopeProfileRead, ScopeProfileWrite, ScopeTeamsWrite, ScopeChallengesRead, ScopeCommentsWrite, ScopeSubmit}
+	AdminScopes = []string{ScopeAdmin, ScopeAdminRead, ScopeAdminBackup}
+)
+
+func IsAdminScope(scope string) bool {
+	return slices.Contains(AdminScopes, scope)
+}
+
+func IsValidScope(scope string) bool {
+	return slices.Contains(UserScopes, scope) || IsAdminScope(scope)
+}
+
+// HasScope reports whether scopes grant want, treating ScopeAdmin as a superset of admin:* scopes.
+func HasScope(scopes []string, want string) bool {
+	if slices.Contains(scopes, want) {
+		return true
+	}
+	return strings.HasPrefix(want, ScopeAdmin+":") && slices.Contains(scopes, ScopeAdmin)
+}
+
+// APIToken is a personal token of UserID, or a service token minted by an admin when UserID is nil.
 type APIToken struct {
 	ID          uuid.UUID  `json:"id"`
-	UserID      uuid.UUID  `json:"user_id"`
+	UserID      *uuid.UUID `json:"user_id,omitempty"`
 	TokenHash   string     `json:"-"`
 	Description string     `json:"description,omitempty"`
+	Scopes      []string   `json:"scopes"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
 	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
 	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
 	CreatedAt   time.Time  `json:"created_at"`
 }
+
+func (t *APIToken) IsService() bool {
+	return t.UserID == nil
+}
+
+func (t *APIToken) HasScope(scope string) bool {
+	return HasScope(t.Scopes, scope)
+}
+
+// APITokenRequest is one request authenticated with an API token.
+type APITokenRequest struct {
+	ID        uuid.UUID `json:"id"`
+	TokenID   uuid.UUID `json:"token_id"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Status    int       `json:"status"`
+	IP        string    `json:"ip,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
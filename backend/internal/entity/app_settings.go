@@ -26,19 +26,37 @@ const (
 )
 
 type AppSettings struct {
-	ID                     int       `json:"id"`
-	AppName                string    `json:"app_name"`
-	VerifyEmails           bool      `json:"verify_emails"`
-	FrontendURL            string    `json:"frontend_url"`
-	CORSOrigins            string    `json:"cors_origins"`
-	ResendEnabled          bool      `json:"resend_enabled"`
-	ResendFromEmail        string    `json:"resend_from_email"`
-	ResendFromName         string    `json:"resend_from_name"`
-	VerifyTTLHours         int       `json:"verify_ttl_hours"`
-	ResetTTLHours          int       `json:"reset_ttl_hours"`
-	SubmitLimitPerUser     int       `json:"submit_limit_per_user"`
-	SubmitLimitDurationMin int       `json:"submit_limit_duration_min"`
-	ScoreboardVisible      string    `json:"scoreboard_visible"`
-	RegistrationOpen       bool      `json:"registration_open"`
-	UpdatedAt              time.Time `json:"updated_at"`
+	ID                     int        `json:"id"`
+	AppName                string     `json:"app_name"`
+	VerifyEmails           bool       `json:"verify_emails"`
+	FrontendURL            string     `json:"frontend_url"`
+	CORSOrigins            string     `json:"cors_origins"`
+	ResendEnabled          bool       `json:"resend_enabled"`
+	ResendFromEmail        string     `json:"resend_from_email"`
+	ResendFromName         string     `json:"resend_from_name"`
+	VerifyTTLHours         int        `json:"verify_ttl_hours"`
+	ResetTTLHours          int        `json:"reset_ttl_hours"`
+	SubmitLimitPerUser     int        `json:"submit_limit_per_user"`
+	SubmitLimitDurationMin int        `json:"submit_limit_duration_min"`
+	ScoreboardVisible      string     `json:"scoreboard_visible"`
+	RegistrationOpen       bool       `json:"registration_open"`
+	Require2FAForAdmins    bool       `json:"require_2fa_for_admins"`
+	RegistrationOpensAt    *time.Time `json:"registration_opens_at,omitempty"`
+	RegistrationClosesAt   *time.Time `json:"registration_closes_at,omitempty"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+}
+
+// IsRegistrationOpen reports whether anyone may sign up at now: registration must be switched on
+// and now must fall inside the optional opens/closes window.
+func (s *AppSettings) IsRegistrationOpen(now time.Time) bool {
+	if !s.RegistrationOpen {
+		return false
+	}
+	if s.RegistrationOpensAt != nil && now.Before(*s.RegistrationOpensAt) {
+		return false
+	}
+	if s.RegistrationClosesAt != nil && !now.Before(*s.RegistrationClosesAt) {
+		return false
+	}
+	return true
 }
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttemptLimit caps the wrong submissions a team may make on a challenge and sets the points
+// each of them costs. MaxAttempts is nil when the number of attempts is unlimited.
+type AttemptLimit struct {
+	ChallengeID  uuid.UUID `json:"challenge_id"`
+	MaxAttempts  *int      `json:"max_attempts,omitempty"`
+	WrongPenalty int       `json:"wrong_penalty"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// AttemptReset is the moment a team's wrong submissions on a challenge stopped counting
+// towards its attempt limit.
+type AttemptReset struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	TeamID      uuid.UUID `json:"team_id"`
+	ResetAt     time.Time `json:"reset_at"`
+}
@@ -18,11 +18,30 @@ const (
 	AuditActionBan    AuditAction = "ban"
 	AuditActionUnban  AuditAction = "unban"
 
+	AuditActionRevokeSessions AuditAction = "revoke_sessions"
+	AuditActionResetTwoFactor AuditAction = "reset_2fa"
+	AuditActionLock           AuditAction = "lock"
+	AuditActionUnlock         AuditAction = "unlock"
+	AuditActionSetRole        AuditAction = "set_role"
+	AuditActionResetPassword  AuditAction = "reset_password"
+	AuditActionChangePassword AuditAction = "change_password"
+	AuditActionMoveTeam       AuditAction = "move_team"
+	AuditActionRollback       AuditAction = "rollback"
+	AuditActionGrantSolve     AuditAction = "grant_solve"
+	AuditActionRevokeSolve    AuditAction = "revoke_solve"
+	AuditActionRescore        AuditAction = "rescore"
+
 	AuditEntityChallenge   AuditEntityType = "challenge"
 	AuditEntityCompetition AuditEntityType = "competition"
 	AuditEntityTeam        AuditEntityType = "team"
 	AuditEntityUser        AuditEntityType = RoleUser
 	AuditEntityAppSettings AuditEntityType = "app_settings"
+	AuditEntityOAuth       AuditEntityType = "oauth_provider"
+	AuditEntityIP          AuditEntityType = "ip_address"
+	AuditEntityAPIToken    AuditEntityType = "api_token"
+	AuditEntityRole        AuditEntityType = "role"
+	AuditEntityInvite      AuditEntityType = "registration_invite"
+	AuditEntityDomainRule  AuditEntityType = "registration_domain_rule"
 )
 
 type AuditLog struct {
@@ -17,20 +17,43 @@ const (
 )
 
 type BackupData struct {
-	Version     string            `json:"version"`
-	ExportedAt  time.Time         `json:"exported_at"`
-	Competition *Competition      `json:"competition"`
-	Challenges  []ChallengeExport `json:"challenges"`
-	Teams       []TeamExport      `json:"teams,omitempty"`
-	Users       []UserExport      `json:"users,omitempty"`
-	Awards      []Award           `json:"awards,omitempty"`
-	Solves      []Solve           `json:"solves,omitempty"`
-	Files       []File            `json:"files,omitempty"`
+	Version       string             `json:"version"`
+	ExportedAt    time.Time          `json:"exported_at"`
+	Competition   *Competition       `json:"competition"`
+	Challenges    []ChallengeExport  `json:"challenges"`
+	Teams         []TeamExport       `json:"teams,omitempty"`
+	Users         []UserExport       `json:"users,omitempty"`
+	Awards        []Award            `json:"awards,omitempty"`
+	Solves        []Solve            `json:"solves,omitempty"`
+	Reviews       []SubmissionReview `json:"reviews,omitempty"`
+	StageSolves   []StageSolve       `json:"stage_solves,omitempty"`
+	AttemptResets []AttemptReset     `json:"attempt_resets,omitempty"`
+	Files         []File             `json:"files,omitempty"`
 }
 
 type ChallengeExport struct {
 	Challenge
-	Hints []Hint `json:"hints,omitempty"`
+	Hints []Hint          `json:"hints,omitempty"`
+	Flags []ChallengeFlag `json:"flags,omitempty"`
+	// Decoys are only ever exported in backups, so a restore keeps the traps in place.
+	Decoys []DecoyFlag `json:"decoys,omitempty"`
+
+	Requirements *ChallengeRequirements `json:"requirements,omitempty"`
+	Schedule     *ChallengeSchedule     `json:"schedule,omitempty"`
+	TeamFlag     *TeamFlagExport        `json:"team_flag,omitempty"`
+	Instance     *InstanceConfig        `json:"instance,omitempty"`
+	ManualReview bool                   `json:"manual_review,omitempty"`
+	Stages       []ChallengeStage       `json:"stages,omitempty"`
+	Scoring      *ChallengeScoring      `json:"scoring,omitempty"`
+	AttemptLimit *AttemptLimit          `json:"attempt_limit,omitempty"`
+}
+
+// TeamFlagExport keeps the encrypted secret TeamFlagConfig hides from JSON, so flags already
+// handed out stay valid after a restore with the same encryption key.
+type TeamFlagExport struct {
+	Template  string    `json:"template"`
+	Secret    string    `json:"secret"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type TeamExport struct {
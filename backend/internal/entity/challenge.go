@@ -1,6 +1,15 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Challenge struct {
 	ID                uuid.UUID `json:"id"`
@@ -19,3 +28,119 @@ type Challenge struct {
 	FlagRegex         string    `json:"flag_regex,omitempty"`
 	FlagFormatRegex   *string   `json:"flag_format_regex,omitempty"`
 }
+
+type FlagType string
+
+const (
+	FlagTypeStatic FlagType = "static"
+	FlagTypeRegex  FlagType = "regex"
+)
+
+func (t FlagType) IsValid() bool {
+	return t == FlagTypeStatic || t == FlagTypeRegex
+}
+
+// ChallengeFlag is a flag a challenge accepts besides its primary one. Static flags keep only
+// the SHA-256 of the (optionally lower-cased) value, regex flags the encrypted pattern.
+type ChallengeFlag struct {
+	ID                uuid.UUID `json:"id"`
+	ChallengeID       uuid.UUID `json:"challenge_id"`
+	Type              FlagType  `json:"type"`
+	FlagHash          string    `json:"flag_hash,omitempty"`
+	FlagRegex         string    `json:"flag_regex,omitempty"`
+	IsCaseInsensitive bool      `json:"is_case_insensitive"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// ChallengeRequirements decide when a challenge unlocks for a team: once it has solved every
+// prerequisite and, when MinScore is positive, reached that score.
+type ChallengeRequirements struct {
+	ChallengeID   uuid.UUID   `json:"challenge_id"`
+	Prerequisites []uuid.UUID `json:"prerequisites"`
+	MinScore      int         `json:"min_score"`
+}
+
+func (r *ChallengeRequirements) IsEmpty() bool {
+	return len(r.Prerequisites) == 0 && r.MinScore <= 0
+}
+
+type ChallengeScheduleStatus string
+
+const (
+	ChallengeScheduleStatusScheduled ChallengeScheduleStatus = "scheduled"
+	ChallengeScheduleStatusLive      ChallengeScheduleStatus = "live"
+	ChallengeScheduleStatusEnded     ChallengeScheduleStatus = "ended"
+)
+
+// ChallengeSchedule makes a challenge visible from ReleaseAt until HideAt; either bound may be
+// nil. AnnouncedAt is set once the release has been broadcast, or up front when there is
+// nothing left to announce.
+type ChallengeSchedule struct {
+	ChallengeID     uuid.UUID  `json:"challenge_id"`
+	ReleaseAt       *time.Time `json:"release_at,omitempty"`
+	HideAt          *time.Time `json:"hide_at,omitempty"`
+	NotifyOnRelease bool       `json:"notify_on_release"`
+	AnnouncedAt     *time.Time `json:"announced_at,omitempty"`
+}
+
+func (s *ChallengeSchedule) IsEmpty() bool {
+	return s.ReleaseAt == nil && s.HideAt == nil
+}
+
+func (s *ChallengeSchedule) StatusAt(t time.Time) ChallengeScheduleStatus {
+	if s.ReleaseAt != nil && t.Before(*s.ReleaseAt) {
+		return ChallengeScheduleStatusScheduled
+	}
+	if s.HideAt != nil && !t.Before(*s.HideAt) {
+		return ChallengeScheduleStatusEnded
+	}
+	return ChallengeScheduleStatusLive
+}
+
+func (s *ChallengeSchedule) IsLiveAt(t time.Time) bool {
+	return s.StatusAt(t) == ChallengeScheduleStatusLive
+}
+
+// TeamFlagConfig switches a challenge to per-team flags: each team's flag is Template with its
+// <hmacN> token replaced by the first N hex characters of HMAC-SHA256(secret, team ID).
+// Secret holds the encrypted key and never leaves the server.
+type TeamFlagConfig struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	Template    string    `json:"template"`
+	Secret      string    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+const (
+	teamFlagMinHexLen = 4
+	teamFlagMaxHexLen = sha256.Size * 2
+)
+
+var teamFlagTokenRe = regexp.MustCompile(`<hmac(\d+)>`)
+
+// IsValidTeamFlagTemplate reports whether template holds exactly one <hmacN> token with N
+// between 4 and 64.
+func IsValidTeamFlagTemplate(template string) bool {
+	matches := teamFlagTokenRe.FindAllStringSubmatch(template, -1)
+	if len(matches) != 1 {
+		return false
+	}
+	n, err := strconv.Atoi(matches[0][1])
+	return err == nil && n >= teamFlagMinHexLen && n <= teamFlagMaxHexLen
+}
+
+// RenderTeamFlag derives the flag of teamID from a template that passed IsValidTeamFlagTemplate
+// and the decrypted secret.
+func RenderTeamFlag(template, secret string, teamID uuid.UUID) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(teamID.String()))
+	sum := hex.EncodeToString(mac.Sum(nil))
+
+	loc := teamFlagTokenRe.FindStringSubmatchIndex(template)
+	if loc == nil {
+		return template
+	}
+	n, _ := strconv.Atoi(template[loc[2]:loc[3]])
+	n = min(max(n, teamFlagMinHexLen), teamFlagMaxHexLen)
+	return template[:loc[0]] + sum[:n] + template[loc[1]:]
+}
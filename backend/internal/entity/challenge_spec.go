@@ -0,0 +1,66 @@
+package entity
+
+import "github.com/google/uuid"
+
+// SpecAction is what syncing a challenge spec does to the challenge it matches.
+type SpecAction string
+
+const (
+	SpecActionCreate    SpecAction = "create"
+	SpecActionUpdate    SpecAction = "update"
+	SpecActionUnchanged SpecAction = "unchanged"
+	SpecActionFailed    SpecAction = "failed"
+)
+
+// Fields a spec sync reports besides the revision fields of the challenge itself.
+const (
+	SpecFieldFlags        = "flags"
+	SpecFieldFiles        = "files"
+	SpecFieldRequirements = "requirements"
+	SpecFieldSlug         = "slug"
+)
+
+// LinkedChallenge is a challenge with the spec slug it is synced under. Slug is empty for
+// challenges that were never imported from a spec.
+type LinkedChallenge struct {
+	Challenge *Challenge
+	Slug      string
+}
+
+// SpecProblem is a validation error in an uploaded spec bundle. Path is the challenge.yml it
+// was found in.
+type SpecProblem struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// SpecSyncItem is the outcome, or for a dry run the plan, for one challenge.yml.
+type SpecSyncItem struct {
+	Path          string     `json:"path"`
+	Slug          string     `json:"slug"`
+	Name          string     `json:"name"`
+	Category      string     `json:"category"`
+	Action        SpecAction `json:"action"`
+	ChallengeID   *uuid.UUID `json:"challenge_id,omitempty"`
+	ChangedFields []string   `json:"changed_fields"`
+	Error         string     `json:"error,omitempty"`
+}
+
+// SpecSyncResult summarizes a spec import. Nothing is written when Problems is not empty or
+// DryRun is set.
+type SpecSyncResult struct {
+	DryRun    bool           `json:"dry_run"`
+	Created   int            `json:"created"`
+	Updated   int            `json:"updated"`
+	Unchanged int            `json:"unchanged"`
+	Failed    int            `json:"failed"`
+	Items     []SpecSyncItem `json:"items"`
+	Problems  []SpecProblem  `json:"problems"`
+}
+
+// SpecExportFilter narrows a spec export to one challenge or one category; the zero value
+// exports every challenge.
+type SpecExportFilter struct {
+	ChallengeID *uuid.UUID
+	Category    string
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChallengeSchedule_StatusAt(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	assert.Equal(t, ChallengeScheduleStatusLive, (&ChallengeSchedule{}).StatusAt(now))
+	assert.Equal(t, ChallengeScheduleStatusScheduled, (&ChallengeSchedule{ReleaseAt: &future}).StatusAt(now))
+	assert.Equal(t, ChallengeScheduleStatusLive, (&ChallengeSchedule{ReleaseAt: &past, HideAt: &future}).StatusAt(now))
+	assert.Equal(t, ChallengeScheduleStatusEnded, (&ChallengeSchedule{HideAt: &past}).StatusAt(now))
+	assert.True(t, (&ChallengeSchedule{ReleaseAt: &now}).IsLiveAt(now))
+	assert.False(t, (&ChallengeSchedule{HideAt: &now}).IsLiveAt(now))
+}
+
+func TestIsValidTeamFlagTemplate(t *testing.T) {
+	assert.True(t, IsValidTeamFlagTemplate("CTF{prefix_<hmac8>}"))
+	assert.True(t, IsValidTeamFlagTemplate("<hmac64>"))
+	assert.False(t, IsValidTeamFlagTemplate("CTF{static}"))
+	assert.False(t, IsValidTeamFlagTemplate("CTF{<hmac3>}"))
+	assert.False(t, IsValidTeamFlagTemplate("CTF{<hmac65>}"))
+	assert.False(t, IsValidTeamFlagTemplate("CTF{<hmac8>_<hmac8>}"))
+}
+
+func TestRenderTeamFlag(t *testing.T) {
+	teamA, teamB := uuid.New(), uuid.New()
+
+	flagA := RenderTeamFlag("CTF{prefix_<hmac8>}", "secret", teamA)
+	assert.Regexp(t, `^CTF\{prefix_[0-9a-f]{8}\}$`, flagA)
+	assert.Equal(t, flagA, RenderTeamFlag("CTF{prefix_<hmac8>}", "secret", teamA))
+	assert.NotEqual(t, flagA, RenderTeamFlag("CTF{prefix_<hmac8>}", "secret", teamB))
+	assert.NotEqual(t, flagA, RenderTeamFlag("CTF{prefix_<hmac8>}", "other", teamA))
+	assert.Len(t, RenderTeamFlag("<hmac64>", "secret", teamA), 64)
+}
@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CheatIncidentKind string
+
+const (
+	// CheatIncidentSharedFlag is recorded when a team submits a per-team flag derived for
+	// SourceTeamID.
+	CheatIncidentSharedFlag CheatIncidentKind = "shared_flag"
+	// CheatIncidentSharedIP is recorded when a user of the team submitted from an IP that
+	// SourceTeamID submitted from first.
+	CheatIncidentSharedIP CheatIncidentKind = "shared_ip"
+	// CheatIncidentSharedWrongFlag is recorded when the team submitted the same wrong flag for
+	// a challenge as SourceTeamID did before it.
+	CheatIncidentSharedWrongFlag CheatIncidentKind = "shared_wrong_flag"
+	// CheatIncidentCloseSolve is recorded when the team solved a challenge within the analysis
+	// window after SourceTeamID solved it.
+	CheatIncidentCloseSolve CheatIncidentKind = "close_solve"
+	// CheatIncidentNoDownload is recorded when a user solved a challenge without having
+	// downloaded any of its files.
+	CheatIncidentNoDownload CheatIncidentKind = "no_download"
+	// CheatIncidentDecoyFlag is recorded when the team submitted one of the decoy flags planted
+	// for a challenge.
+	CheatIncidentDecoyFlag CheatIncidentKind = "decoy_flag"
+)
+
+func (k CheatIncidentKind) IsValid() bool {
+	switch k {
+	case CheatIncidentSharedFlag, CheatIncidentSharedIP, CheatIncidentSharedWrongFlag,
+		CheatIncidentCloseSolve, CheatIncidentNoDownload, CheatIncidentDecoyFlag:
+		return true
+	}
+	return false
+}
+
+type CheatSeverity string
+
+const (
+	CheatSeverityLow    CheatSeverity = "low"
+	CheatSeverityMedium CheatSeverity = "medium"
+	CheatSeverityHigh   CheatSeverity = "high"
+)
+
+// Severity ranks how strongly an incident of kind k points at cheating. Flags that only
+// another team or a decoy could have produced are high; coincidences the analytics pick up
+// are lower.
+func (k CheatIncidentKind) Severity() CheatSeverity {
+	switch k {
+	case CheatIncidentSharedFlag, CheatIncidentDecoyFlag:
+		return CheatSeverityHigh
+	case CheatIncidentSharedWrongFlag, CheatIncidentCloseSolve:
+		return CheatSeverityMedium
+	}
+	return CheatSeverityLow
+}
+
+type CheatIncidentStatus string
+
+const (
+	CheatIncidentOpen      CheatIncidentStatus = "open"
+	CheatIncidentDismissed CheatIncidentStatus = "dismissed"
+	CheatIncidentEscalated CheatIncidentStatus = "escalated"
+)
+
+func (s CheatIncidentStatus) IsValid() bool {
+	switch s {
+	case CheatIncidentOpen, CheatIncidentDismissed, CheatIncidentEscalated:
+		return true
+	}
+	return false
+}
+
+// CheatEvidence points at the submissions an incident was inferred from, together with the
+// value they had in common.
+type CheatEvidence struct {
+	SubmissionIDs []uuid.UUID `json:"submission_ids,omitempty"`
+	IP            string      `json:"ip,omitempty"`
+	Flag          string      `json:"flag,omitempty"`
+	SecondsApart  *float64    `json:"seconds_apart,omitempty"`
+}
+
+// CheatIncident is a suspicion against TeamID. ChallengeID is nil for incidents not tied to a
+// challenge, such as a shared IP. Fingerprint identifies the finding so that analysing the
+// same submissions again records it only once.
+type CheatIncident struct {
+	ID           uuid.UUID           `json:"id"`
+	Kind         CheatIncidentKind   `json:"kind"`
+	ChallengeID  *uuid.UUID          `json:"challenge_id,omitempty"`
+	TeamID       uuid.UUID           `json:"team_id"`
+	UserID       *uuid.UUID          `json:"user_id,omitempty"`
+	SourceTeamID *uuid.UUID          `json:"source_team_id,omitempty"`
+	Status       CheatIncidentStatus `json:"status"`
+	Evidence     CheatEvidence       `json:"evidence"`
+	Fingerprint  string              `json:"-"`
+	ResolvedBy   *uuid.UUID          `json:"resolved_by,omitempty"`
+	ResolvedAt   *time.Time          `json:"resolved_at,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+}
+
+type CheatIncidentWithDetails struct {
+	CheatIncident
+	TeamName       string `json:"team_name"`
+	SourceTeamName string `json:"source_team_name,omitempty"`
+	Username       string `json:"username,omitempty"`
+	ChallengeTitle string `json:"challenge_title,omitempty"`
+}
+
+// CheatIncidentFilter narrows the incident report; nil fields match every incident.
+type CheatIncidentFilter struct {
+	Status *CheatIncidentStatus
+	Kind   *CheatIncidentKind
+}
+
+const (
+	DefaultCheatSolveWindow = 10 * time.Second
+	DefaultCheatMinTeams    = 2
+)
+
+// CheatAnalysisOptions tunes the analysis: SolveWindow is how close two solves of a challenge
+// must be to look copied, MinTeams how many teams must share a wrong flag.
+type CheatAnalysisOptions struct {
+	SolveWindow time.Duration `json:"solve_window"`
+	MinTeams    int           `json:"min_teams"`
+}
+
+// CheatAnalysisResult counts the incidents an analysis recorded, per kind. Findings already
+// recorded by an earlier analysis are not counted again.
+type CheatAnalysisResult struct {
+	SharedIP        int `json:"shared_ip"`
+	SharedWrongFlag int `json:"shared_wrong_flag"`
+	CloseSolve      int `json:"close_solve"`
+	NoDownload      int `json:"no_download"`
+}
+
+func (r *CheatAnalysisResult) Total() int {
+	return r.SharedIP + r.SharedWrongFlag + r.CloseSolve + r.NoDownload
+}
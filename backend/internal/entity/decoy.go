@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DecoyPolicyConfigKey is the configs table key the decoy policy is stored under.
+const DecoyPolicyConfigKey = "anticheat.decoy_policy"
+
+// DecoyFlag is a flag planted for a challenge, e.g. in a fake leaked writeup. Submitting it is
+// answered like any wrong flag but records a decoy_flag incident against the team.
+type DecoyFlag struct {
+	ID                uuid.UUID `json:"id"`
+	ChallengeID       uuid.UUID `json:"challenge_id"`
+	FlagHash          string    `json:"flag_hash"`
+	IsCaseInsensitive bool      `json:"is_case_insensitive"`
+	Note              string    `json:"note,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+const MaxDecoyNoteLength = 500
+
+type DecoyAction string
+
+const (
+	DecoyActionNone DecoyAction = "none"
+	DecoyActionHide DecoyAction = "hide"
+	DecoyActionBan  DecoyAction = "ban"
+)
+
+func (a DecoyAction) IsValid() bool {
+	switch a {
+	case DecoyActionNone, DecoyActionHide, DecoyActionBan:
+		return true
+	}
+	return false
+}
+
+const MaxDecoyThreshold = 100
+
+// DecoyPolicy decides what happens to a team once it has submitted Threshold distinct decoy
+// flags: nothing, hidden from the scoreboard or banned.
+type DecoyPolicy struct {
+	Action    DecoyAction `json:"action"`
+	Threshold int         `json:"threshold"`
+}
+
+// DefaultDecoyPolicy only records incidents; admins decide what to do about them.
+func DefaultDecoyPolicy() DecoyPolicy {
+	return DecoyPolicy{Action: DecoyActionNone, Threshold: 1}
+}
+
+func (p DecoyPolicy) IsValid() bool {
+	return p.Action.IsValid() && p.Threshold >= 1 && p.Threshold <= MaxDecoyThreshold
+}
@@ -0,0 +1,24 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrAttemptLimitNotConfigured = &HTTPError{
+		Err:        errors.New("challenge has no attempt limit"),
+		StatusCode: http.StatusNotFound,
+		Code:       "ATTEMPT_LIMIT_NOT_CONFIGURED",
+	}
+	ErrInvalidAttemptLimit = &HTTPError{
+		Err:        errors.New("attempt limit needs max_attempts, wrong_penalty or both"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_ATTEMPT_LIMIT",
+	}
+	ErrAttemptsExhausted = &HTTPError{
+		Err:        errors.New("no attempts left on this challenge"),
+		StatusCode: http.StatusForbidden,
+		Code:       "ATTEMPTS_EXHAUSTED",
+	}
+)
@@ -1 +1,124 @@
 package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrInvalidRefreshToken = &HTTPError{
+		Err:        errors.New("invalid refresh token"),
+		StatusCode: http.StatusUnauthorized,
+		Code:       "INVALID_REFRESH_TOKEN",
+	}
+	ErrRefreshTokenReused = &HTTPError{
+		Err:        errors.New("refresh token reuse detected"),
+		StatusCode: http.StatusUnauthorized,
+		Code:       "REFRESH_TOKEN_REUSED",
+	}
+	ErrSessionNotFound = &HTTPError{
+		Err:        errors.New("session not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "SESSION_NOT_FOUND",
+	}
+	ErrSessionRevoked = &HTTPError{
+		Err:        errors.New("session revoked or expired"),
+		StatusCode: http.StatusUnauthorized,
+		Code:       "SESSION_REVOKED",
+	}
+	ErrTwoFactorNotEnrolled = &HTTPError{
+		Err:        errors.New("two-factor authentication is not set up"),
+		StatusCode: http.StatusNotFound,
+		Code:       "TWO_FACTOR_NOT_ENROLLED",
+	}
+	ErrTwoFactorAlreadyEnabled = &HTTPError{
+		Err:        errors.New("two-factor authentication already enabled"),
+		StatusCode: http.StatusConflict,
+		Code:       "TWO_FACTOR_ALREADY_ENABLED",
+	}
+	ErrInvalidTwoFactorCode = &HTTPError{
+		Err:        errors.New("invalid two-factor code"),
+		StatusCode: http.StatusUnauthorized,
+		Code:       "INVALID_TWO_FACTOR_CODE",
+	}
+	ErrInvalidChallengeToken = &HTTPError{
+		Err:        errors.New("invalid or expired login challenge"),
+		StatusCode: http.StatusUnauthorized,
+		Code:       "INVALID_CHALLENGE_TOKEN",
+	}
+	ErrTwoFactorRequired = &HTTPError{
+		Err:        errors.New("two-factor authentication must be enabled for this account"),
+		StatusCode: http.StatusForbidden,
+		Code:       "TWO_FACTOR_REQUIRED",
+	}
+	ErrOAuthProviderNotFound = &HTTPError{
+		Err:        errors.New("login provider not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "OAUTH_PROVIDER_NOT_FOUND",
+	}
+	ErrInvalidOAuthProvider = &HTTPError{
+		Err:        errors.New("invalid login provider configuration"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_OAUTH_PROVIDER",
+	}
+	ErrInvalidOAuthState = &HTTPError{
+		Err:        errors.New("invalid or expired login state"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_OAUTH_STATE",
+	}
+	ErrOAuthExchangeFailed = &HTTPError{
+		Err:        errors.New("login provider rejected the authorization"),
+		StatusCode: http.StatusUnauthorized,
+		Code:       "OAUTH_EXCHANGE_FAILED",
+	}
+	ErrOAuthAccountNotLinked = &HTTPError{
+		Err:        errors.New("no account is linked to this provider identity"),
+		StatusCode: http.StatusForbidden,
+		Code:       "OAUTH_ACCOUNT_NOT_LINKED",
+	}
+	ErrOAuthEmailInUse = &HTTPError{
+		Err:        errors.New("an account with this email already exists; log in and link the provider instead"),
+		StatusCode: http.StatusConflict,
+		Code:       "OAUTH_EMAIL_IN_USE",
+	}
+	ErrIdentityAlreadyLinked = &HTTPError{
+		Err:        errors.New("provider identity already linked"),
+		StatusCode: http.StatusConflict,
+		Code:       "IDENTITY_ALREADY_LINKED",
+	}
+	ErrIdentityNotFound = &HTTPError{
+		Err:        errors.New("linked identity not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "IDENTITY_NOT_FOUND",
+	}
+	ErrLastLoginMethod = &HTTPError{
+		Err:        errors.New("cannot unlink the only way to log in; set a password first"),
+		StatusCode: http.StatusConflict,
+		Code:       "LAST_LOGIN_METHOD",
+	}
+	ErrAccountLocked = &HTTPError{
+		Err:        errors.New("account temporarily locked after too many failed login attempts"),
+		StatusCode: http.StatusTooManyRequests,
+		Code:       "ACCOUNT_LOCKED",
+	}
+	ErrTooManyLoginAttempts = &HTTPError{
+		Err:        errors.New("too many failed login attempts from this address"),
+		StatusCode: http.StatusTooManyRequests,
+		Code:       "TOO_MANY_LOGIN_ATTEMPTS",
+	}
+	ErrAPITokenNotFound = &HTTPError{
+		Err:        errors.New("api token not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "API_TOKEN_NOT_FOUND",
+	}
+	ErrInvalidTokenScope = &HTTPError{
+		Err:        errors.New("invalid or not permitted token scope"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_TOKEN_SCOPE",
+	}
+	ErrInsufficientScope = &HTTPError{
+		Err:        errors.New("api token lacks the required scope"),
+		StatusCode: http.StatusForbidden,
+		Code:       "INSUFFICIENT_SCOPE",
+	}
+)
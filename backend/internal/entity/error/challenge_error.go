@@ -21,4 +21,44 @@ var (
 		StatusCode: http.StatusBadRequest,
 		Code:       "INVALID_FLAG_FORMAT",
 	}
+	ErrChallengeFlagNotFound = &HTTPError{
+		Err:        errors.New("challenge flag not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "CHALLENGE_FLAG_NOT_FOUND",
+	}
+	ErrInvalidChallengeFlag = &HTTPError{
+		Err:        errors.New("invalid challenge flag"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_CHALLENGE_FLAG",
+	}
+	ErrChallengeLocked = &HTTPError{
+		Err:        errors.New("challenge is locked"),
+		StatusCode: http.StatusForbidden,
+		Code:       "CHALLENGE_LOCKED",
+	}
+	ErrInvalidChallengeRequirements = &HTTPError{
+		Err:        errors.New("invalid challenge requirements"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_CHALLENGE_REQUIREMENTS",
+	}
+	ErrPrerequisiteCycle = &HTTPError{
+		Err:        errors.New("prerequisites would form a cycle"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "PREREQUISITE_CYCLE",
+	}
+	ErrInvalidChallengeSchedule = &HTTPError{
+		Err:        errors.New("hide_at must be after release_at"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_CHALLENGE_SCHEDULE",
+	}
+	ErrInvalidTeamFlagTemplate = &HTTPError{
+		Err:        errors.New("team flag template must contain one <hmacN> token with N between 4 and 64"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_TEAM_FLAG_TEMPLATE",
+	}
+	ErrTeamFlagNotFound = &HTTPError{
+		Err:        errors.New("challenge has no per-team flag"),
+		StatusCode: http.StatusNotFound,
+		Code:       "TEAM_FLAG_NOT_FOUND",
+	}
 )
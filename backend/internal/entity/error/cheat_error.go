@@ -0,0 +1,54 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrCheatIncidentNotFound = &HTTPError{
+		Err:        errors.New("cheat incident not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "CHEAT_INCIDENT_NOT_FOUND",
+	}
+	ErrCheatIncidentExists = &HTTPError{
+		Err:        errors.New("cheat incident is already recorded"),
+		StatusCode: http.StatusConflict,
+		Code:       "CHEAT_INCIDENT_EXISTS",
+	}
+	ErrCheatIncidentResolved = &HTTPError{
+		Err:        errors.New("cheat incident is already resolved"),
+		StatusCode: http.StatusConflict,
+		Code:       "CHEAT_INCIDENT_RESOLVED",
+	}
+	ErrInvalidCheatIncidentFilter = &HTTPError{
+		Err:        errors.New("invalid cheat incident status or kind"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_CHEAT_INCIDENT_FILTER",
+	}
+	ErrInvalidCheatAnalysis = &HTTPError{
+		Err:        errors.New("solve window must be between 1 and 3600 seconds and min teams between 2 and 100"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_CHEAT_ANALYSIS",
+	}
+	ErrDecoyFlagNotFound = &HTTPError{
+		Err:        errors.New("decoy flag not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "DECOY_FLAG_NOT_FOUND",
+	}
+	ErrDecoyFlagExists = &HTTPError{
+		Err:        errors.New("decoy flag already exists for this challenge"),
+		StatusCode: http.StatusConflict,
+		Code:       "DECOY_FLAG_EXISTS",
+	}
+	ErrInvalidDecoyFlag = &HTTPError{
+		Err:        errors.New("decoy flag must be non-empty and must not be accepted by the challenge"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_DECOY_FLAG",
+	}
+	ErrInvalidDecoyPolicy = &HTTPError{
+		Err:        errors.New("decoy action must be none, hide or ban and threshold between 1 and 100"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_DECOY_POLICY",
+	}
+)
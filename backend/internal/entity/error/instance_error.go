@@ -0,0 +1,34 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrInstancesDisabled = &HTTPError{
+		Err:        errors.New("challenge instances are disabled"),
+		StatusCode: http.StatusServiceUnavailable,
+		Code:       "INSTANCES_DISABLED",
+	}
+	ErrInstanceNotConfigured = &HTTPError{
+		Err:        errors.New("challenge has no instance configuration"),
+		StatusCode: http.StatusNotFound,
+		Code:       "INSTANCE_NOT_CONFIGURED",
+	}
+	ErrInstanceNotFound = &HTTPError{
+		Err:        errors.New("instance not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "INSTANCE_NOT_FOUND",
+	}
+	ErrInstanceLimitReached = &HTTPError{
+		Err:        errors.New("team has reached its instance limit"),
+		StatusCode: http.StatusConflict,
+		Code:       "INSTANCE_LIMIT_REACHED",
+	}
+	ErrInvalidInstanceConfig = &HTTPError{
+		Err:        errors.New("instance image is required and ttl must be positive"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_INSTANCE_CONFIG",
+	}
+)
@@ -0,0 +1,54 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrRegistrationClosed = &HTTPError{
+		Err:        errors.New("registration is closed"),
+		StatusCode: http.StatusForbidden,
+		Code:       "REGISTRATION_CLOSED",
+	}
+	ErrInvalidInviteCode = &HTTPError{
+		Err:        errors.New("invite code is invalid, expired or used up"),
+		StatusCode: http.StatusForbidden,
+		Code:       "INVALID_INVITE_CODE",
+	}
+	ErrEmailDomainNotAllowed = &HTTPError{
+		Err:        errors.New("email domain is not allowed to register"),
+		StatusCode: http.StatusForbidden,
+		Code:       "EMAIL_DOMAIN_NOT_ALLOWED",
+	}
+	ErrInviteNotFound = &HTTPError{
+		Err:        errors.New("invite not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "INVITE_NOT_FOUND",
+	}
+	ErrInviteCodeConflict = &HTTPError{
+		Err:        errors.New("invite code already exists"),
+		StatusCode: http.StatusConflict,
+		Code:       "INVITE_CODE_CONFLICT",
+	}
+	ErrInvalidInvite = &HTTPError{
+		Err:        errors.New("invalid invite"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_INVITE",
+	}
+	ErrDomainRuleNotFound = &HTTPError{
+		Err:        errors.New("domain rule not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "DOMAIN_RULE_NOT_FOUND",
+	}
+	ErrDomainRuleConflict = &HTTPError{
+		Err:        errors.New("a rule for this domain already exists"),
+		StatusCode: http.StatusConflict,
+		Code:       "DOMAIN_RULE_CONFLICT",
+	}
+	ErrInvalidDomainRule = &HTTPError{
+		Err:        errors.New("invalid domain rule"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_DOMAIN_RULE",
+	}
+)
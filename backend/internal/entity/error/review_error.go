@@ -0,0 +1,44 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrManualReviewNotConfigured = &HTTPError{
+		Err:        errors.New("challenge is not manually graded"),
+		StatusCode: http.StatusNotFound,
+		Code:       "MANUAL_REVIEW_NOT_CONFIGURED",
+	}
+	ErrSubmissionPendingReview = &HTTPError{
+		Err:        errors.New("submission queued for review"),
+		StatusCode: http.StatusAccepted,
+		Code:       "SUBMISSION_PENDING_REVIEW",
+	}
+	ErrReviewAlreadyPending = &HTTPError{
+		Err:        errors.New("team already has a submission awaiting review"),
+		StatusCode: http.StatusConflict,
+		Code:       "REVIEW_ALREADY_PENDING",
+	}
+	ErrReviewNotFound = &HTTPError{
+		Err:        errors.New("review not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "REVIEW_NOT_FOUND",
+	}
+	ErrReviewAlreadyResolved = &HTTPError{
+		Err:        errors.New("review is already resolved"),
+		StatusCode: http.StatusConflict,
+		Code:       "REVIEW_ALREADY_RESOLVED",
+	}
+	ErrInvalidReviewPoints = &HTTPError{
+		Err:        errors.New("review points must be between 0 and the challenge value"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_REVIEW_POINTS",
+	}
+	ErrInvalidReviewStatus = &HTTPError{
+		Err:        errors.New("invalid review status"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_REVIEW_STATUS",
+	}
+)
@@ -0,0 +1,19 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrRevisionNotFound = &HTTPError{
+		Err:        errors.New("revision not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "REVISION_NOT_FOUND",
+	}
+	ErrRevisionUnchanged = &HTTPError{
+		Err:        errors.New("challenge already matches this revision"),
+		StatusCode: http.StatusConflict,
+		Code:       "REVISION_UNCHANGED",
+	}
+)
@@ -0,0 +1,49 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrRoleNotFound = &HTTPError{
+		Err:        errors.New("role not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "ROLE_NOT_FOUND",
+	}
+	ErrRoleAlreadyExists = &HTTPError{
+		Err:        errors.New("role already exists"),
+		StatusCode: http.StatusConflict,
+		Code:       "ROLE_ALREADY_EXISTS",
+	}
+	ErrBuiltInRole = &HTTPError{
+		Err:        errors.New("built-in roles cannot be modified"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "BUILT_IN_ROLE",
+	}
+	ErrInvalidRoleName = &HTTPError{
+		Err:        errors.New("invalid role name"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_ROLE_NAME",
+	}
+	ErrInvalidPermission = &HTTPError{
+		Err:        errors.New("invalid permission"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_PERMISSION",
+	}
+	ErrCannotChangeOwnRole = &HTTPError{
+		Err:        errors.New("cannot change your own role"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "CANNOT_CHANGE_OWN_ROLE",
+	}
+	ErrPermissionDenied = &HTTPError{
+		Err:        errors.New("permission denied"),
+		StatusCode: http.StatusForbidden,
+		Code:       "PERMISSION_DENIED",
+	}
+	ErrNotChallengeAuthor = &HTTPError{
+		Err:        errors.New("only the challenge authors can change this challenge"),
+		StatusCode: http.StatusForbidden,
+		Code:       "NOT_CHALLENGE_AUTHOR",
+	}
+)
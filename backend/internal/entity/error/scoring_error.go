@@ -0,0 +1,28 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrUnknownScoringFunction = &HTTPError{
+		Err:        errors.New("unknown scoring function"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "UNKNOWN_SCORING_FUNCTION",
+	}
+	ErrInvalidScoringPreview = &HTTPError{
+		Err:        errors.New("preview needs between 1 and 500 solves and elapsed between 0 and 1"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_SCORING_PREVIEW",
+	}
+)
+
+// InvalidScoringParams reports why a scoring function rejected its parameters.
+func InvalidScoringParams(err error) *HTTPError {
+	return &HTTPError{
+		Err:        err,
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_SCORING_PARAMS",
+	}
+}
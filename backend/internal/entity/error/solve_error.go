@@ -16,4 +16,9 @@ var (
 		StatusCode: http.StatusConflict,
 		Code:       "ALREADY_SOLVED",
 	}
+	ErrSolverNotInTeam = &HTTPError{
+		Err:        errors.New("solver is not a member of the team"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "SOLVER_NOT_IN_TEAM",
+	}
 )
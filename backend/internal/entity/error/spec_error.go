@@ -0,0 +1,19 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrInvalidSpecBundle = &HTTPError{
+		Err:        errors.New("upload must be a challenge.yml or a zip archive containing challenge.yml files"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_SPEC_BUNDLE",
+	}
+	ErrSpecSlugTaken = &HTTPError{
+		Err:        errors.New("spec slug is already linked to another challenge"),
+		StatusCode: http.StatusConflict,
+		Code:       "SPEC_SLUG_TAKEN",
+	}
+)
@@ -0,0 +1,29 @@
+package entityError
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	ErrStageNotFound = &HTTPError{
+		Err:        errors.New("stage not found"),
+		StatusCode: http.StatusNotFound,
+		Code:       "STAGE_NOT_FOUND",
+	}
+	ErrStageCompleted = &HTTPError{
+		Err:        errors.New("stage completed"),
+		StatusCode: http.StatusOK,
+		Code:       "STAGE_COMPLETED",
+	}
+	ErrInvalidChallengeStage = &HTTPError{
+		Err:        errors.New("stage needs a title, non-negative points and at least one flag"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_CHALLENGE_STAGE",
+	}
+	ErrInvalidStageOrder = &HTTPError{
+		Err:        errors.New("stage order index is out of range"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_STAGE_ORDER",
+	}
+)
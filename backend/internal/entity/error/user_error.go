@@ -46,4 +46,29 @@ var (
 		StatusCode: http.StatusUnauthorized,
 		Code:       "NOT_AUTHENTICATED",
 	}
+	ErrUserBanned = &HTTPError{
+		Err:        errors.New("user is banned"),
+		StatusCode: http.StatusForbidden,
+		Code:       "USER_BANNED",
+	}
+	ErrCannotModifySelf = &HTTPError{
+		Err:        errors.New("cannot ban or delete your own account"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "CANNOT_MODIFY_SELF",
+	}
+	ErrWeakPassword = &HTTPError{
+		Err:        errors.New("password must be at least 8 characters"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "WEAK_PASSWORD",
+	}
+	ErrInvalidCurrentPassword = &HTTPError{
+		Err:        errors.New("current password is incorrect"),
+		StatusCode: http.StatusForbidden,
+		Code:       "INVALID_CURRENT_PASSWORD",
+	}
+	ErrInvalidUsername = &HTTPError{
+		Err:        errors.New("username must be between 1 and 50 characters"),
+		StatusCode: http.StatusBadRequest,
+		Code:       "INVALID_USERNAME",
+	}
 )
@@ -23,3 +23,11 @@ type File struct {
 	SHA256      string    `json:"sha256"`
 	CreatedAt   time.Time `json:"created_at"`
 }
+
+// FileDownload records a user fetching a challenge file, for the anti-cheat analysis.
+type FileDownload struct {
+	FileID uuid.UUID  `json:"file_id"`
+	UserID uuid.UUID  `json:"user_id"`
+	TeamID *uuid.UUID `json:"team_id,omitempty"`
+	IP     string     `json:"ip,omitempty"`
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InstanceConfig marks a challenge as needing a per-team environment started from Image.
+// Each instance lives for TTLSeconds unless the team extends it.
+type InstanceConfig struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	Image       string    `json:"image"`
+	TTLSeconds  int       `json:"ttl_seconds"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (c *InstanceConfig) TTL() time.Duration {
+	return time.Duration(c.TTLSeconds) * time.Second
+}
+
+type ChallengeInstance struct {
+	ID             uuid.UUID `json:"id"`
+	ChallengeID    uuid.UUID `json:"challenge_id"`
+	TeamID         uuid.UUID `json:"team_id"`
+	ConnectionInfo string    `json:"connection_info"`
+	Status         string    `json:"status"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
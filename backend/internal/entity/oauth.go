@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OAuthProviderConfigPrefix namespaces provider configs stored in the configs table.
+const OAuthProviderConfigPrefix = "oauth_provider."
+
+// OAuthProvider describes an external OIDC/OAuth2 login provider. When Issuer is set the endpoints
+// are discovered and the id_token is verified; otherwise AuthURL, TokenURL and UserInfoURL are used
+// as a plain OAuth2 provider (e.g. CTFtime).
+type OAuthProvider struct {
+	Name                  string            `json:"name"`
+	DisplayName           string            `json:"display_name"`
+	Enabled               bool              `json:"enabled"`
+	Issuer                string            `json:"issuer,omitempty"`
+	AuthURL               string            `json:"auth_url,omitempty"`
+	TokenURL              string            `json:"token_url,omitempty"`
+	UserInfoURL           string            `json:"userinfo_url,omitempty"`
+	ClientID              string            `json:"client_id"`
+	ClientSecretEncrypted string            `json:"client_secret_encrypted,omitempty"`
+	RedirectURL           string            `json:"redirect_url"`
+	Scopes                []string          `json:"scopes,omitempty"`
+	SubjectClaim          string            `json:"subject_claim,omitempty"`
+	EmailClaim            string            `json:"email_claim,omitempty"`
+	UsernameClaim         string            `json:"username_claim,omitempty"`
+	FieldClaims           map[string]string `json:"field_claims,omitempty"`
+	AutoProvision         bool              `json:"auto_provision"`
+}
+
+func (p *OAuthProvider) IsOIDC() bool {
+	return p.Issuer != ""
+}
+
+func (p *OAuthProvider) ConfigKey() string {
+	return OAuthProviderConfigPrefix + p.Name
+}
+
+// UserIdentity links a local user to the stable subject of an external provider.
+type UserIdentity struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Provider    string     `json:"provider"`
+	Subject     string     `json:"subject"`
+	Email       string     `json:"email,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DomainRuleAction string
+
+const (
+	DomainRuleAllow DomainRuleAction = "allow"
+	DomainRuleDeny  DomainRuleAction = "deny"
+)
+
+func (a DomainRuleAction) IsValid() bool {
+	return a == DomainRuleAllow || a == DomainRuleDeny
+}
+
+// RegistrationInvite admits sign-ups while registration is closed. MaxUses and ExpiresAt are optional.
+type RegistrationInvite struct {
+	ID        uuid.UUID  `json:"id"`
+	Code      string     `json:"code"`
+	MaxUses   *int       `json:"max_uses,omitempty"`
+	Uses      int        `json:"uses"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func (i *RegistrationInvite) IsUsable(now time.Time) bool {
+	if i.MaxUses != nil && i.Uses >= *i.MaxUses {
+		return false
+	}
+	return i.ExpiresAt == nil || now.Before(*i.ExpiresAt)
+}
+
+// RegistrationDomainRule allows or denies sign-ups from an email domain and its subdomains.
+// Teams created by users matching an allow rule with a BracketID are placed in that bracket.
+type RegistrationDomainRule struct {
+	ID        uuid.UUID        `json:"id"`
+	Domain    string           `json:"domain"`
+	Action    DomainRuleAction `json:"action"`
+	BracketID *uuid.UUID       `json:"bracket_id,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// Matches reports whether domain equals the rule domain or is one of its subdomains.
+func (r *RegistrationDomainRule) Matches(domain string) bool {
+	domain = strings.ToLower(domain)
+	return domain == r.Domain || strings.HasSuffix(domain, "."+r.Domain)
+}
+
+// EmailDomain returns the lower-cased part of email after the last "@", or "" if there is none.
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+// MatchDomainRule returns the most specific rule matching the domain of email, or nil.
+func MatchDomainRule(rules []*RegistrationDomainRule, email string) *RegistrationDomainRule {
+	domain := EmailDomain(email)
+	if domain == "" {
+		return nil
+	}
+	var best *RegistrationDomainRule
+	for _, r := range rules {
+		if r.Matches(domain) && (best == nil || len(r.Domain) > len(best.Domain)) {
+			best = r
+		}
+	}
+	return best
+}
+
+// DomainAllowed applies the rules to email: a matching deny rule rejects it, and once any allow
+// rule exists only addresses matching an allow rule are accepted.
+func DomainAllowed(rules []*RegistrationDomainRule, email string) bool {
+	if rule := MatchDomainRule(rules, email); rule != nil {
+		return rule.Action == DomainRuleAllow
+	}
+	for _, r := range rules {
+		if r.Action == DomainRuleAllow {
+			return false
+		}
+	}
+	return true
+}
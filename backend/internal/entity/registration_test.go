@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppSettings_IsRegistrationOpen(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	assert.True(t, (&AppSettings{RegistrationOpen: true}).IsRegistrationOpen(now))
+	assert.False(t, (&AppSettings{RegistrationOpen: false}).IsRegistrationOpen(now))
+	assert.True(t, (&AppSettings{RegistrationOpen: true, RegistrationOpensAt: &past, RegistrationClosesAt: &future}).IsRegistrationOpen(now))
+	assert.False(t, (&AppSettings{RegistrationOpen: true, RegistrationOpensAt: &future}).IsRegistrationOpen(now))
+	assert.False(t, (&AppSettings{RegistrationOpen: true, RegistrationClosesAt: &past}).IsRegistrationOpen(now))
+}
+
+func TestRegistrationInvite_IsUsable(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	limit := 2
+
+	assert.True(t, (&RegistrationInvite{}).IsUsable(now))
+	assert.True(t, (&RegistrationInvite{MaxUses: &limit, Uses: 1}).IsUsable(now))
+	assert.False(t, (&RegistrationInvite{MaxUses: &limit, Uses: 2}).IsUsable(now))
+	assert.False(t, (&RegistrationInvite{ExpiresAt: &past}).IsUsable(now))
+}
+
+func TestEmailDomain(t *testing.T) {
+	assert.Equal(t, "uni.edu", EmailDomain("Alice@Uni.EDU"))
+	assert.Equal(t, "", EmailDomain("alice"))
+	assert.Equal(t, "", EmailDomain("alice@"))
+}
+
+func TestMatchDomainRule_MostSpecificWins(t *testing.T) {
+	bracket := uuid.New()
+	uni := &RegistrationDomainRule{Domain: "uni.edu", Action: DomainRuleAllow, BracketID: &bracket}
+	staff := &RegistrationDomainRule{Domain: "staff.uni.edu", Action: DomainRuleDeny}
+	rules := []*RegistrationDomainRule{uni, staff}
+
+	assert.Same(t, uni, MatchDomainRule(rules, "a@uni.edu"))
+	assert.Same(t, uni, MatchDomainRule(rules, "a@cs.uni.edu"))
+	assert.Same(t, staff, MatchDomainRule(rules, "a@staff.uni.edu"))
+	assert.Nil(t, MatchDomainRule(rules, "a@notuni.edu"))
+}
+
+func TestDomainAllowed(t *testing.T) {
+	deny := []*RegistrationDomainRule{{Domain: "mailinator.com", Action: DomainRuleDeny}}
+	assert.True(t, DomainAllowed(nil, "a@example.com"))
+	assert.True(t, DomainAllowed(deny, "a@example.com"))
+	assert.False(t, DomainAllowed(deny, "a@mailinator.com"))
+
+	allow := []*RegistrationDomainRule{{Domain: "uni.edu", Action: DomainRuleAllow}}
+	assert.True(t, DomainAllowed(allow, "a@uni.edu"))
+	assert.False(t, DomainAllowed(allow, "a@example.com"))
+}
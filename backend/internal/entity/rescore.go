@@ -0,0 +1,27 @@
+package entity
+
+import "github.com/google/uuid"
+
+// RescoreOptions selects which solves count towards a challenge. Solves of deleted teams never
+// count.
+type RescoreOptions struct {
+	ExcludeBanned bool `json:"exclude_banned"`
+	ExcludeHidden bool `json:"exclude_hidden"`
+}
+
+// ScoreDrift is a challenge whose stored solve count or value disagrees with its solves.
+type ScoreDrift struct {
+	ChallengeID      uuid.UUID `json:"challenge_id"`
+	Title            string    `json:"title"`
+	StoredSolveCount int       `json:"stored_solve_count"`
+	SolveCount       int       `json:"solve_count"`
+	StoredPoints     int       `json:"stored_points"`
+	Points           int       `json:"points"`
+}
+
+type RescoreReport struct {
+	Options RescoreOptions `json:"options"`
+	Checked int            `json:"checked"`
+	Drifts  []*ScoreDrift  `json:"drifts"`
+	Fixed   bool           `json:"fixed"`
+}
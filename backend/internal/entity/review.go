@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewStatus string
+
+const (
+	ReviewStatusPending  ReviewStatus = "pending"
+	ReviewStatusApproved ReviewStatus = "approved"
+	ReviewStatusRejected ReviewStatus = "rejected"
+)
+
+func (s ReviewStatus) IsValid() bool {
+	switch s {
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected:
+		return true
+	}
+	return false
+}
+
+// ManualReviewConfig marks a challenge as manually graded: submissions are queued for an
+// admin instead of being checked against flags.
+type ManualReviewConfig struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// SubmissionReview is one answer to a manually graded challenge. Points is the score granted
+// on approval and stays nil otherwise. SubmissionID is the logged submission of the answer.
+type SubmissionReview struct {
+	ID           uuid.UUID    `json:"id"`
+	ChallengeID  uuid.UUID    `json:"challenge_id"`
+	TeamID       uuid.UUID    `json:"team_id"`
+	UserID       uuid.UUID    `json:"user_id"`
+	Answer       string       `json:"answer"`
+	Status       ReviewStatus `json:"status"`
+	Points       *int         `json:"points,omitempty"`
+	Comment      string       `json:"comment"`
+	ReviewedBy   *uuid.UUID   `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time   `json:"reviewed_at,omitempty"`
+	SubmissionID *uuid.UUID   `json:"submission_id,omitempty"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+type SubmissionReviewWithDetails struct {
+	SubmissionReview
+	ChallengeTitle string `json:"challenge_title"`
+	TeamName       string `json:"team_name"`
+	Username       string `json:"username"`
+}
@@ -0,0 +1,179 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RevisionFieldTitle             = "title"
+	RevisionFieldDescription       = "description"
+	RevisionFieldCategory          = "category"
+	RevisionFieldPoints            = "points"
+	RevisionFieldInitialValue      = "initial_value"
+	RevisionFieldMinValue          = "min_value"
+	RevisionFieldDecay             = "decay"
+	RevisionFieldFlag              = "flag"
+	RevisionFieldIsHidden          = "is_hidden"
+	RevisionFieldIsRegex           = "is_regex"
+	RevisionFieldIsCaseInsensitive = "is_case_insensitive"
+	RevisionFieldFlagFormatRegex   = "flag_format_regex"
+	RevisionFieldTags              = "tags"
+	RevisionFieldHints             = "hints"
+)
+
+// ChallengeSnapshot is the editable state of a challenge, its tags and its hints. Flags are
+// kept as stored (hash or encrypted regex), never in plain text.
+type ChallengeSnapshot struct {
+	Title             string         `json:"title"`
+	Description       string         `json:"description"`
+	Category          string         `json:"category"`
+	Points            int            `json:"points"`
+	InitialValue      int            `json:"initial_value"`
+	MinValue          int            `json:"min_value"`
+	Decay             int            `json:"decay"`
+	FlagHash          string         `json:"flag_hash"`
+	FlagRegex         string         `json:"flag_regex"`
+	IsHidden          bool           `json:"is_hidden"`
+	IsRegex           bool           `json:"is_regex"`
+	IsCaseInsensitive bool           `json:"is_case_insensitive"`
+	FlagFormatRegex   *string        `json:"flag_format_regex"`
+	TagIDs            []uuid.UUID    `json:"tag_ids"`
+	Hints             []RevisionHint `json:"hints"`
+}
+
+type RevisionHint struct {
+	ID         uuid.UUID `json:"id"`
+	Content    string    `json:"content"`
+	Cost       int       `json:"cost"`
+	OrderIndex int       `json:"order_index"`
+}
+
+// ChallengeRevision is an immutable version of a challenge. RestoredFrom is set when the
+// revision was produced by rolling back to an earlier version.
+type ChallengeRevision struct {
+	ID            uuid.UUID         `json:"id"`
+	ChallengeID   uuid.UUID         `json:"challenge_id"`
+	Version       int               `json:"version"`
+	AuthorID      *uuid.UUID        `json:"author_id,omitempty"`
+	AuthorName    string            `json:"author_name,omitempty"`
+	Snapshot      ChallengeSnapshot `json:"snapshot"`
+	ChangedFields []string          `json:"changed_fields"`
+	RestoredFrom  *int              `json:"restored_from,omitempty"`
+	CreatedAt     time.Time         `json:"created_at"`
+}
+
+// RevisionChange is one differing field between two snapshots. From and To stay nil for the
+// flag so that diffs never expose flag material.
+type RevisionChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from,omitempty"`
+	To    any    `json:"to,omitempty"`
+}
+
+type RevisionDiff struct {
+	ChallengeID uuid.UUID        `json:"challenge_id"`
+	From        int              `json:"from"`
+	To          int              `json:"to"`
+	Changes     []RevisionChange `json:"changes"`
+}
+
+// NewChallengeSnapshot captures the current state with tags and hints in a stable order, so
+// equal states always produce equal snapshots.
+func NewChallengeSnapshot(c *Challenge, tags []*Tag, hints []*Hint) *ChallengeSnapshot {
+	s := &ChallengeSnapshot{
+		Title:             c.Title,
+		Description:       c.Description,
+		Category:          c.Category,
+		Points:            c.Points,
+		InitialValue:      c.InitialValue,
+		MinValue:          c.MinValue,
+		Decay:             c.Decay,
+		FlagHash:          c.FlagHash,
+		FlagRegex:         c.FlagRegex,
+		IsHidden:          c.IsHidden,
+		IsRegex:           c.IsRegex,
+		IsCaseInsensitive: c.IsCaseInsensitive,
+		FlagFormatRegex:   c.FlagFormatRegex,
+		TagIDs:            make([]uuid.UUID, 0, len(tags)),
+		Hints:             make([]RevisionHint, 0, len(hints)),
+	}
+	for _, tag := range tags {
+		s.TagIDs = append(s.TagIDs, tag.ID)
+	}
+	slices.SortFunc(s.TagIDs, func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	for _, h := range hints {
+		s.Hints = append(s.Hints, RevisionHint{ID: h.ID, Content: h.Content, Cost: h.Cost, OrderIndex: h.OrderIndex})
+	}
+	slices.SortFunc(s.Hints, func(a, b RevisionHint) int {
+		if a.OrderIndex != b.OrderIndex {
+			return a.OrderIndex - b.OrderIndex
+		}
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+	return s
+}
+
+// ApplyTo copies the challenge fields of the snapshot onto c; tags and hints are left to the
+// caller.
+func (s *ChallengeSnapshot) ApplyTo(c *Challenge) {
+	c.Title = s.Title
+	c.Description = s.Description
+	c.Category = s.Category
+	c.Points = s.Points
+	c.InitialValue = s.InitialValue
+	c.MinValue = s.MinValue
+	c.Decay = s.Decay
+	c.FlagHash = s.FlagHash
+	c.FlagRegex = s.FlagRegex
+	c.IsHidden = s.IsHidden
+	c.IsRegex = s.IsRegex
+	c.IsCaseInsensitive = s.IsCaseInsensitive
+	c.FlagFormatRegex = s.FlagFormatRegex
+}
+
+// Diff lists the fields that differ from s to other, in a fixed field order.
+func (s *ChallengeSnapshot) Diff(other *ChallengeSnapshot) []RevisionChange {
+	changes := make([]RevisionChange, 0)
+	add := func(field string, changed bool, from, to any) {
+		if changed {
+			changes = append(changes, RevisionChange{Field: field, From: from, To: to})
+		}
+	}
+	add(RevisionFieldTitle, s.Title != other.Title, s.Title, other.Title)
+	add(RevisionFieldDescription, s.Description != other.Description, s.Description, other.Description)
+	add(RevisionFieldCategory, s.Category != other.Category, s.Category, other.Category)
+	add(RevisionFieldPoints, s.Points != other.Points, s.Points, other.Points)
+	add(RevisionFieldInitialValue, s.InitialValue != other.InitialValue, s.InitialValue, other.InitialValue)
+	add(RevisionFieldMinValue, s.MinValue != other.MinValue, s.MinValue, other.MinValue)
+	add(RevisionFieldDecay, s.Decay != other.Decay, s.Decay, other.Decay)
+	add(RevisionFieldFlag, s.FlagHash != other.FlagHash || s.FlagRegex != other.FlagRegex, nil, nil)
+	add(RevisionFieldIsHidden, s.IsHidden != other.IsHidden, s.IsHidden, other.IsHidden)
+	add(RevisionFieldIsRegex, s.IsRegex != other.IsRegex, s.IsRegex, other.IsRegex)
+	add(RevisionFieldIsCaseInsensitive, s.IsCaseInsensitive != other.IsCaseInsensitive, s.IsCaseInsensitive, other.IsCaseInsensitive)
+	fromFormat, toFormat := optionalString(s.FlagFormatRegex), optionalString(other.FlagFormatRegex)
+	add(RevisionFieldFlagFormatRegex, fromFormat != toFormat, fromFormat, toFormat)
+	add(RevisionFieldTags, !slices.Equal(s.TagIDs, other.TagIDs), s.TagIDs, other.TagIDs)
+	add(RevisionFieldHints, !slices.Equal(s.Hints, other.Hints), s.Hints, other.Hints)
+	return changes
+}
+
+func RevisionChangedFields(changes []RevisionChange) []string {
+	fields := make([]string, len(changes))
+	for i, c := range changes {
+		fields[i] = c.Field
+	}
+	return fields
+}
+
+func optionalString(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
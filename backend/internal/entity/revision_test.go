@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChallengeSnapshot_StableOrder(t *testing.T) {
+	c := &Challenge{Title: "Crypto 1"}
+	tagA, tagB := &Tag{ID: uuid.New()}, &Tag{ID: uuid.New()}
+	hintA := &Hint{ID: uuid.New(), Content: "first", OrderIndex: 0}
+	hintB := &Hint{ID: uuid.New(), Content: "second", OrderIndex: 1}
+
+	s1 := NewChallengeSnapshot(c, []*Tag{tagA, tagB}, []*Hint{hintA, hintB})
+	s2 := NewChallengeSnapshot(c, []*Tag{tagB, tagA}, []*Hint{hintB, hintA})
+
+	assert.Equal(t, s1, s2)
+	assert.Empty(t, s1.Diff(s2))
+	assert.Equal(t, "first", s1.Hints[0].Content)
+}
+
+func TestChallengeSnapshot_Diff(t *testing.T) {
+	format := "CTF{.*}"
+	from := &ChallengeSnapshot{Title: "A", Points: 100, FlagRegex: "enc-1", IsRegex: true}
+	to := &ChallengeSnapshot{Title: "A", Points: 200, FlagRegex: "enc-2", IsRegex: true, FlagFormatRegex: &format}
+
+	changes := from.Diff(to)
+
+	assert.Equal(t, []RevisionChange{
+		{Field: RevisionFieldPoints, From: 100, To: 200},
+		{Field: RevisionFieldFlag},
+		{Field: RevisionFieldFlagFormatRegex, From: nil, To: format},
+	}, changes)
+	assert.Equal(t, []string{"points", "flag", "flag_format_regex"}, RevisionChangedFields(changes))
+}
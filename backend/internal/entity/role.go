@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"regexp"
+	"slices"
+	"time"
+)
+
+const (
+	RoleAuthor    = "author"
+	RoleModerator = "moderator"
+	RoleSupport   = "support"
+)
+
+// Permissions guard admin routes. Every route in the admin API maps to exactly one of them.
+const (
+	PermCompetitionManage   = "competition.manage"
+	PermChallengesManage    = "challenges.manage"
+	PermChallengesAuthor    = "challenges.author"
+	PermTagsManage          = "tags.manage"
+	PermAwardsManage        = "awards.manage"
+	PermTeamsModerate       = "teams.moderate"
+	PermUsersManage         = "users.manage"
+	PermRolesManage         = "roles.manage"
+	PermBracketsManage      = "brackets.manage"
+	PermRatingsManage       = "ratings.manage"
+	PermFieldsManage        = "fields.manage"
+	PermPagesManage         = "pages.manage"
+	PermNotificationsManage = "notifications.manage"
+	PermCommentsModerate    = "comments.moderate"
+	PermSubmissionsRead     = "submissions.read"
+	PermFlagsRead           = "flags.read"
+	PermBackupManage        = "backup.manage"
+	PermTokensManage        = "tokens.manage"
+)
+
+var Permissions = []string{
+	PermCompetitionManage,
+	PermChallengesManage,
+	PermChallengesAuthor,
+	PermTagsManage,
+	PermAwardsManage,
+	PermTeamsModerate,
+	PermUsersManage,
+	PermRolesManage,
+	PermBracketsManage,
+	PermRatingsManage,
+	PermFieldsManage,
+	PermPagesManage,
+	PermNotificationsManage,
+	PermCommentsModerate,
+	PermSubmissionsRead,
+	PermFlagsRead,
+	PermBackupManage,
+	PermTokensManage,
+}
+
+// BuiltInRoles are defined in code and cannot be changed; custom roles are stored in the database.
+var BuiltInRoles = []*Role{
+	{Name: RoleAdmin, Description: "Full access", Permissions: Permissions, BuiltIn: true},
+	{Name: RoleAuthor, Description: "Creates challenges and edits the ones they own", Permissions: []string{PermChallengesAuthor}, BuiltIn: true},
+	{Name: RoleModerator, Description: "Moderates comments, team bans and notifications", Permissions: []string{PermCommentsModerate, PermTeamsModerate, PermNotificationsManage}, BuiltIn: true},
+	{Name: RoleSupport, Description: "Reads submissions without submitted flags", Permissions: []string{PermSubmissionsRead}, BuiltIn: true},
+	{Name: RoleUser, Description: "Regular participant", Permissions: []string{}, BuiltIn: true},
+}
+
+var roleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,19}$`)
+
+type Role struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	BuiltIn     bool      `json:"built_in"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (r *Role) HasPermission(permission string) bool {
+	return slices.Contains(r.Permissions, permission)
+}
+
+func BuiltInRole(name string) (*Role, bool) {
+	for _, r := range BuiltInRoles {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+func IsValidPermission(permission string) bool {
+	return slices.Contains(Permissions, permission)
+}
+
+func IsValidRoleName(name string) bool {
+	return roleNameRegex.MatchString(name)
+}
+
+// IsStaffRole reports whether the role is anything other than a regular participant.
+func IsStaffRole(role string) bool {
+	return role != "" && role != RoleUser
+}
@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChallengeScoring names the scoring function a challenge is valued by and the parameters it
+// runs with. Challenges without one keep the decay set by InitialValue, MinValue and Decay.
+type ChallengeScoring struct {
+	ChallengeID uuid.UUID          `json:"challenge_id"`
+	Function    string             `json:"function"`
+	Params      map[string]float64 `json:"params"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a single login. Its ID doubles as the refresh token family: every refresh rotates
+// RefreshJTI and AccessJTI, and revoking the session invalidates all tokens issued from it.
+type Session struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	RefreshJTI string
+	AccessJTI  string
+	IP         string
+	UserAgent  string
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+	LastSeenAt time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+func (s *Session) IsExpired() bool {
+	return time.Now().After(s.ExpiresAt)
+}
+
+func (s *Session) IsActive() bool {
+	return !s.IsRevoked() && !s.IsExpired()
+}
@@ -12,4 +12,6 @@ type Solve struct {
 	TeamID      uuid.UUID `json:"team_id"`
 	ChallengeID uuid.UUID `json:"challenge_id"`
 	SolvedAt    time.Time `json:"solved_at"`
+	// Points pins the value awarded to this solve; nil follows the challenge's current points.
+	Points *int `json:"points,omitempty"`
 }
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChallengeStage is one step of a multi-stage challenge. Teams clear stages in OrderIndex
+// order, each against its own flags, and earn Points for every stage they complete; the
+// challenge value itself is awarded once the last stage is done. Flags carry the ID of the
+// stage's challenge.
+type ChallengeStage struct {
+	ID          uuid.UUID        `json:"id"`
+	ChallengeID uuid.UUID        `json:"challenge_id"`
+	OrderIndex  int              `json:"order_index"`
+	Title       string           `json:"title"`
+	Points      int              `json:"points"`
+	Flags       []*ChallengeFlag `json:"flags"`
+	SolveCount  int              `json:"solve_count"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+type StageSolve struct {
+	ID       uuid.UUID `json:"id"`
+	StageID  uuid.UUID `json:"stage_id"`
+	TeamID   uuid.UUID `json:"team_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	SolvedAt time.Time `json:"solved_at"`
+}
+
+// StageProgress counts the stages of a challenge and how many of them a team completed.
+type StageProgress struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	Completed   int       `json:"completed"`
+	Total       int       `json:"total"`
+}
+
+// StageFirstBlood is the first team to complete a stage.
+type StageFirstBlood struct {
+	StageID  uuid.UUID `json:"stage_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	TeamID   uuid.UUID `json:"team_id"`
+	TeamName string    `json:"team_name"`
+	SolvedAt time.Time `json:"solved_at"`
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TwoFactor is a user's TOTP enrollment. The secret is stored encrypted and only becomes
+// effective once EnabledAt is set; LastUsedStep rejects replays of an already accepted code.
+type TwoFactor struct {
+	UserID          uuid.UUID
+	SecretEncrypted string
+	EnabledAt       *time.Time
+	LastUsedStep    int64
+	CreatedAt       time.Time
+}
+
+func (t *TwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
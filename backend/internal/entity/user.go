@@ -20,5 +20,20 @@ type User struct {
 	Role         string     `json:"-"`
 	IsVerified   bool       `json:"is_verified"`
 	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	IsBanned     bool       `json:"is_banned"`
+	BannedAt     *time.Time `json:"banned_at,omitempty"`
+	BannedReason *string    `json:"banned_reason,omitempty"`
 	CreatedAt    time.Time  `json:"created_at"`
 }
+
+// UserFilter narrows the admin user list. Zero values disable a filter; Search matches
+// username, email and any custom field value, FieldValue only the field given by FieldID.
+type UserFilter struct {
+	Search     string
+	FieldID    *uuid.UUID
+	FieldValue string
+	Verified   *bool
+	Banned     *bool
+	Role       string
+	TeamID     *uuid.UUID
+}
@@ -11,6 +11,7 @@ type TokenType string
 const (
 	TokenTypeEmailVerification TokenType = "email_verification" // #nosec G101
 	TokenTypePasswordReset     TokenType = "password_reset"
+	TokenTypeEmailChange       TokenType = "email_change"
 )
 
 type VerificationToken struct {
@@ -21,6 +22,8 @@ type VerificationToken struct {
 	ExpiresAt time.Time
 	UsedAt    *time.Time
 	CreatedAt time.Time
+	// NewEmail is the address awaiting confirmation for TokenTypeEmailChange tokens.
+	NewEmail *string
 }
 
 func (t *VerificationToken) IsExpired() bool {
@@ -0,0 +1,47 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrNotFound = errors.New("instance not found")
+
+// Key identifies the instance a team runs for a challenge.
+type Key struct {
+	ChallengeID uuid.UUID
+	TeamID      uuid.UUID
+}
+
+type Spec struct {
+	Key
+	Image     string
+	ExpiresAt time.Time
+}
+
+type Status string
+
+const (
+	StatusStarting Status = "starting"
+	StatusRunning  Status = "running"
+	StatusFailed   Status = "failed"
+)
+
+type Instance struct {
+	Key
+	ConnectionInfo string
+	Status         Status
+	ExpiresAt      time.Time
+}
+
+// Provider runs per-team challenge environments on some runtime. Start is idempotent for a
+// key that is already running; Stop, Extend and Status return ErrNotFound for unknown keys.
+type Provider interface {
+	Start(ctx context.Context, spec Spec) (*Instance, error)
+	Stop(ctx context.Context, key Key) error
+	Extend(ctx context.Context, key Key, expiresAt time.Time) error
+	Status(ctx context.Context, key Key) (*Instance, error)
+}
@@ -0,0 +1,96 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const fakeFirstPort = 30000
+
+// FakeProvider keeps instances in memory and hands out host:port pairs without running
+// anything. It backs local development and tests.
+type FakeProvider struct {
+	mu        sync.Mutex
+	host      string
+	nextPort  int
+	instances map[Key]*Instance
+}
+
+func NewFakeProvider(host string) *FakeProvider {
+	return &FakeProvider{
+		host:      host,
+		nextPort:  fakeFirstPort,
+		instances: make(map[Key]*Instance),
+	}
+}
+
+func (p *FakeProvider) Start(ctx context.Context, spec Spec) (*Instance, error) {
+	if spec.Image == "" {
+		return nil, fmt.Errorf("image is required")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if inst, ok := p.instances[spec.Key]; ok {
+		cp := *inst
+		return &cp, nil
+	}
+
+	inst := &Instance{
+		Key:            spec.Key,
+		ConnectionInfo: fmt.Sprintf("%s:%d", p.host, p.nextPort),
+		Status:         StatusRunning,
+		ExpiresAt:      spec.ExpiresAt,
+	}
+	p.nextPort++
+	p.instances[spec.Key] = inst
+
+	cp := *inst
+	return &cp, nil
+}
+
+func (p *FakeProvider) Stop(ctx context.Context, key Key) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.instances[key]; !ok {
+		return ErrNotFound
+	}
+	delete(p.instances, key)
+	return nil
+}
+
+func (p *FakeProvider) Extend(ctx context.Context, key Key, expiresAt time.Time) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	inst, ok := p.instances[key]
+	if !ok {
+		return ErrNotFound
+	}
+	inst.ExpiresAt = expiresAt
+	return nil
+}
+
+func (p *FakeProvider) Status(ctx context.Context, key Key) (*Instance, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	inst, ok := p.instances[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cp := *inst
+	return &cp, nil
+}
+
+// Count returns the number of instances currently running.
+func (p *FakeProvider) Count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.instances)
+}
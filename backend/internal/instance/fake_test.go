@@ -0,0 +1,72 @@
+package instance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/instance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeProvider_Workflow(t *testing.T) {
+	provider := instance.NewFakeProvider("localhost")
+	ctx := context.Background()
+	key := instance.Key{ChallengeID: uuid.New(), TeamID: uuid.New()}
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("Start", func(t *testing.T) {
+		inst, err := provider.Start(ctx, instance.Spec{Key: key, Image: "pwn:latest", ExpiresAt: expiresAt})
+		require.NoError(t, err)
+		assert.Equal(t, instance.StatusRunning, inst.Status)
+		assert.Equal(t, "localhost:30000", inst.ConnectionInfo)
+	})
+
+	t.Run("Start_Idempotent", func(t *testing.T) {
+		inst, err := provider.Start(ctx, instance.Spec{Key: key, Image: "pwn:latest", ExpiresAt: expiresAt})
+		require.NoError(t, err)
+		assert.Equal(t, "localhost:30000", inst.ConnectionInfo)
+		assert.Equal(t, 1, provider.Count())
+	})
+
+	t.Run("Extend", func(t *testing.T) {
+		later := expiresAt.Add(time.Hour)
+		require.NoError(t, provider.Extend(ctx, key, later))
+
+		inst, err := provider.Status(ctx, key)
+		require.NoError(t, err)
+		assert.True(t, inst.ExpiresAt.Equal(later))
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		require.NoError(t, provider.Stop(ctx, key))
+
+		_, err := provider.Status(ctx, key)
+		assert.ErrorIs(t, err, instance.ErrNotFound)
+		assert.ErrorIs(t, provider.Stop(ctx, key), instance.ErrNotFound)
+		assert.ErrorIs(t, provider.Extend(ctx, key, expiresAt), instance.ErrNotFound)
+	})
+}
+
+func TestFakeProvider_Start_NoImage(t *testing.T) {
+	provider := instance.NewFakeProvider("localhost")
+
+	_, err := provider.Start(context.Background(), instance.Spec{Key: instance.Key{ChallengeID: uuid.New(), TeamID: uuid.New()}})
+	assert.Error(t, err)
+}
+
+func TestFakeProvider_Start_DistinctPorts(t *testing.T) {
+	provider := instance.NewFakeProvider("ctf.local")
+	ctx := context.Background()
+	challengeID := uuid.New()
+
+	first, err := provider.Start(ctx, instance.Spec{Key: instance.Key{ChallengeID: challengeID, TeamID: uuid.New()}, Image: "web"})
+	require.NoError(t, err)
+	second, err := provider.Start(ctx, instance.Spec{Key: instance.Key{ChallengeID: challengeID, TeamID: uuid.New()}, Image: "web"})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.ConnectionInfo, second.ConnectionInfo)
+	assert.Equal(t, 2, provider.Count())
+}
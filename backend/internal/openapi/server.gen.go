@@ -33,6 +33,12 @@ type ServerInterface interface {
 	// Update bracket
 	// (PUT /admin/brackets/{ID})
 	PutAdminBracketsID(w http.ResponseWriter, r *http.Request, id string)
+	// Export challenge specs
+	// (GET /admin/challenge-specs/export)
+	GetAdminChallengeSpecsExport(w http.ResponseWriter, r *http.Request, params GetAdminChallengeSpecsExportParams)
+	// Import challenge specs
+	// (POST /admin/challenge-specs/import)
+	PostAdminChallengeSpecsImport(w http.ResponseWriter, r *http.Request)
 	// Create challenge
 	// (POST /admin/challenges)
 	PostAdminChallenges(w http.ResponseWriter, r *http.Request)
@@ -42,12 +48,123 @@ type ServerInterface interface {
 	// Update challenge
 	// (PUT /admin/challenges/{ID})
 	PutAdminChallengesID(w http.ResponseWriter, r *http.Request, id string)
+	// Delete attempt limit
+	// (DELETE /admin/challenges/{challengeID}/attempt-limit)
+	DeleteAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Get attempt limit
+	// (GET /admin/challenges/{challengeID}/attempt-limit)
+	GetAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Set attempt limit
+	// (PUT /admin/challenges/{challengeID}/attempt-limit)
+	PutAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Reset team attempts
+	// (DELETE /admin/challenges/{challengeID}/attempts/{teamID})
+	DeleteAdminChallengesChallengeIDAttemptsTeamID(w http.ResponseWriter, r *http.Request, challengeID string, teamID string)
+	// List decoy flags
+	// (GET /admin/challenges/{challengeID}/decoys)
+	GetAdminChallengesChallengeIDDecoys(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Create decoy flag
+	// (POST /admin/challenges/{challengeID}/decoys)
+	PostAdminChallengesChallengeIDDecoys(w http.ResponseWriter, r *http.Request, challengeID string)
 	// Upload file to challenge
 	// (POST /admin/challenges/{challengeID}/files)
 	PostAdminChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Request, challengeID string)
+	// List challenge flags
+	// (GET /admin/challenges/{challengeID}/flags)
+	GetAdminChallengesChallengeIDFlags(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Create challenge flag
+	// (POST /admin/challenges/{challengeID}/flags)
+	PostAdminChallengesChallengeIDFlags(w http.ResponseWriter, r *http.Request, challengeID string)
 	// Create hint
 	// (POST /admin/challenges/{challengeID}/hints)
 	PostAdminChallengesChallengeIDHints(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Delete instance configuration
+	// (DELETE /admin/challenges/{challengeID}/instance-config)
+	DeleteAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Get instance configuration
+	// (GET /admin/challenges/{challengeID}/instance-config)
+	GetAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Set instance configuration
+	// (PUT /admin/challenges/{challengeID}/instance-config)
+	PutAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Disable manual grading
+	// (DELETE /admin/challenges/{challengeID}/manual-review)
+	DeleteAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Get manual grading
+	// (GET /admin/challenges/{challengeID}/manual-review)
+	GetAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Enable manual grading
+	// (PUT /admin/challenges/{challengeID}/manual-review)
+	PutAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Get challenge unlock requirements
+	// (GET /admin/challenges/{challengeID}/requirements)
+	GetAdminChallengesChallengeIDRequirements(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Set challenge unlock requirements
+	// (PUT /admin/challenges/{challengeID}/requirements)
+	PutAdminChallengesChallengeIDRequirements(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Get challenge revisions
+	// (GET /admin/challenges/{challengeID}/revisions)
+	GetAdminChallengesChallengeIDRevisions(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Diff challenge revisions
+	// (GET /admin/challenges/{challengeID}/revisions/diff)
+	GetAdminChallengesChallengeIDRevisionsDiff(w http.ResponseWriter, r *http.Request, challengeID string, params GetAdminChallengesChallengeIDRevisionsDiffParams)
+	// Roll back challenge
+	// (POST /admin/challenges/{challengeID}/revisions/{version}/rollback)
+	PostAdminChallengesChallengeIDRevisionsVersionRollback(w http.ResponseWriter, r *http.Request, challengeID string, version int)
+	// Get challenge release schedule
+	// (GET /admin/challenges/{challengeID}/schedule)
+	GetAdminChallengesChallengeIDSchedule(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Set challenge release schedule
+	// (PUT /admin/challenges/{challengeID}/schedule)
+	PutAdminChallengesChallengeIDSchedule(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Reset challenge scoring
+	// (DELETE /admin/challenges/{challengeID}/scoring)
+	DeleteAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Get challenge scoring
+	// (GET /admin/challenges/{challengeID}/scoring)
+	GetAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Set challenge scoring
+	// (PUT /admin/challenges/{challengeID}/scoring)
+	PutAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Grant solve
+	// (POST /admin/challenges/{challengeID}/solves)
+	PostAdminChallengesChallengeIDSolves(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Revoke solve
+	// (DELETE /admin/challenges/{challengeID}/solves/{teamID})
+	DeleteAdminChallengesChallengeIDSolvesTeamID(w http.ResponseWriter, r *http.Request, challengeID string, teamID string)
+	// List challenge stages
+	// (GET /admin/challenges/{challengeID}/stages)
+	GetAdminChallengesChallengeIDStages(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Create challenge stage
+	// (POST /admin/challenges/{challengeID}/stages)
+	PostAdminChallengesChallengeIDStages(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Delete per-team flag
+	// (DELETE /admin/challenges/{challengeID}/team-flag)
+	DeleteAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Get per-team flag
+	// (GET /admin/challenges/{challengeID}/team-flag)
+	GetAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Set per-team flag
+	// (PUT /admin/challenges/{challengeID}/team-flag)
+	PutAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Render per-team flag
+	// (POST /admin/challenges/{challengeID}/team-flag/render)
+	PostAdminChallengesChallengeIDTeamFlagRender(w http.ResponseWriter, r *http.Request, challengeID string)
+	// Get cheat incidents
+	// (GET /admin/cheat-incidents)
+	GetAdminCheatIncidents(w http.ResponseWriter, r *http.Request, params GetAdminCheatIncidentsParams)
+	// Analyze submissions for cheating
+	// (POST /admin/cheat-incidents/analyze)
+	PostAdminCheatIncidentsAnalyze(w http.ResponseWriter, r *http.Request)
+	// Get cheat incident
+	// (GET /admin/cheat-incidents/{ID})
+	GetAdminCheatIncidentsID(w http.ResponseWriter, r *http.Request, id string)
+	// Dismiss cheat incident
+	// (POST /admin/cheat-incidents/{ID}/dismiss)
+	PostAdminCheatIncidentsIDDismiss(w http.ResponseWriter, r *http.Request, id string)
+	// Escalate cheat incident
+	// (POST /admin/cheat-incidents/{ID}/escalate)
+	PostAdminCheatIncidentsIDEscalate(w http.ResponseWriter, r *http.Request, id string)
 	// Get admin competition
 	// (GET /admin/competition)
 	GetAdminCompetition(w http.ResponseWriter, r *http.Request)
@@ -75,6 +192,15 @@ type ServerInterface interface {
 	// Finalize CTF event
 	// (POST /admin/ctf-events/{ID}/finalize)
 	PostAdminCtfEventsIDFinalize(w http.ResponseWriter, r *http.Request, id string)
+	// Get decoy policy
+	// (GET /admin/decoy-policy)
+	GetAdminDecoyPolicy(w http.ResponseWriter, r *http.Request)
+	// Update decoy policy
+	// (PUT /admin/decoy-policy)
+	PutAdminDecoyPolicy(w http.ResponseWriter, r *http.Request)
+	// Delete decoy flag
+	// (DELETE /admin/decoys/{ID})
+	DeleteAdminDecoysID(w http.ResponseWriter, r *http.Request, id string)
 	// Export competition backup
 	// (GET /admin/export)
 	GetAdminExport(w http.ResponseWriter, r *http.Request, params GetAdminExportParams)
@@ -93,6 +219,12 @@ type ServerInterface interface {
 	// Delete file
 	// (DELETE /admin/files/{ID})
 	DeleteAdminFilesID(w http.ResponseWriter, r *http.Request, id string)
+	// Delete challenge flag
+	// (DELETE /admin/flags/{ID})
+	DeleteAdminFlagsID(w http.ResponseWriter, r *http.Request, id string)
+	// Update challenge flag
+	// (PUT /admin/flags/{ID})
+	PutAdminFlagsID(w http.ResponseWriter, r *http.Request, id string)
 	// Delete hint
 	// (DELETE /admin/hints/{ID})
 	DeleteAdminHintsID(w http.ResponseWriter, r *http.Request, id string)
@@ -114,6 +246,15 @@ type ServerInterface interface {
 	// Update notification
 	// (PUT /admin/notifications/{ID})
 	PutAdminNotificationsID(w http.ResponseWriter, r *http.Request, id string)
+	// List login providers (admin)
+	// (GET /admin/oauth/providers)
+	GetAdminOauthProviders(w http.ResponseWriter, r *http.Request)
+	// Delete login provider
+	// (DELETE /admin/oauth/providers/{provider})
+	DeleteAdminOauthProvidersProvider(w http.ResponseWriter, r *http.Request, provider string)
+	// Save login provider
+	// (PUT /admin/oauth/providers/{provider})
+	PutAdminOauthProvidersProvider(w http.ResponseWriter, r *http.Request, provider string)
 	// Get all pages
 	// (GET /admin/pages)
 	GetAdminPages(w http.ResponseWriter, r *http.Request)
@@ -129,12 +270,75 @@ type ServerInterface interface {
 	// Update page
 	// (PUT /admin/pages/{ID})
 	PutAdminPagesID(w http.ResponseWriter, r *http.Request, id string)
+	// List permissions
+	// (GET /admin/permissions)
+	GetAdminPermissions(w http.ResponseWriter, r *http.Request)
+	// List registration domain rules
+	// (GET /admin/registration/domains)
+	GetAdminRegistrationDomains(w http.ResponseWriter, r *http.Request)
+	// Create registration domain rule
+	// (POST /admin/registration/domains)
+	PostAdminRegistrationDomains(w http.ResponseWriter, r *http.Request)
+	// Delete registration domain rule
+	// (DELETE /admin/registration/domains/{ID})
+	DeleteAdminRegistrationDomainsID(w http.ResponseWriter, r *http.Request, id string)
+	// List registration invites
+	// (GET /admin/registration/invites)
+	GetAdminRegistrationInvites(w http.ResponseWriter, r *http.Request)
+	// Create registration invite
+	// (POST /admin/registration/invites)
+	PostAdminRegistrationInvites(w http.ResponseWriter, r *http.Request)
+	// Delete registration invite
+	// (DELETE /admin/registration/invites/{ID})
+	DeleteAdminRegistrationInvitesID(w http.ResponseWriter, r *http.Request, id string)
+	// Get challenge release timeline
+	// (GET /admin/release-timeline)
+	GetAdminReleaseTimeline(w http.ResponseWriter, r *http.Request)
+	// Get review queue
+	// (GET /admin/reviews)
+	GetAdminReviews(w http.ResponseWriter, r *http.Request, params GetAdminReviewsParams)
+	// Approve submission
+	// (POST /admin/reviews/{ID}/approve)
+	PostAdminReviewsIDApprove(w http.ResponseWriter, r *http.Request, id string)
+	// Reject submission
+	// (POST /admin/reviews/{ID}/reject)
+	PostAdminReviewsIDReject(w http.ResponseWriter, r *http.Request, id string)
+	// List roles
+	// (GET /admin/roles)
+	GetAdminRoles(w http.ResponseWriter, r *http.Request)
+	// Create role
+	// (POST /admin/roles)
+	PostAdminRoles(w http.ResponseWriter, r *http.Request)
+	// Delete role
+	// (DELETE /admin/roles/{name})
+	DeleteAdminRolesName(w http.ResponseWriter, r *http.Request, name string)
+	// Update role
+	// (PUT /admin/roles/{name})
+	PutAdminRolesName(w http.ResponseWriter, r *http.Request, name string)
+	// Check scoring consistency
+	// (GET /admin/scoring/consistency)
+	GetAdminScoringConsistency(w http.ResponseWriter, r *http.Request, params GetAdminScoringConsistencyParams)
+	// List scoring functions
+	// (GET /admin/scoring/functions)
+	GetAdminScoringFunctions(w http.ResponseWriter, r *http.Request)
+	// Preview scoring function
+	// (POST /admin/scoring/preview)
+	PostAdminScoringPreview(w http.ResponseWriter, r *http.Request)
+	// Rescore all challenges
+	// (POST /admin/scoring/rescore)
+	PostAdminScoringRescore(w http.ResponseWriter, r *http.Request)
 	// Get admin settings
 	// (GET /admin/settings)
 	GetAdminSettings(w http.ResponseWriter, r *http.Request)
 	// Update admin settings
 	// (PUT /admin/settings)
 	PutAdminSettings(w http.ResponseWriter, r *http.Request)
+	// Delete challenge stage
+	// (DELETE /admin/stages/{ID})
+	DeleteAdminStagesID(w http.ResponseWriter, r *http.Request, id string)
+	// Update challenge stage
+	// (PUT /admin/stages/{ID})
+	PutAdminStagesID(w http.ResponseWriter, r *http.Request, id string)
 	// Get all submissions
 	// (GET /admin/submissions)
 	GetAdminSubmissions(w http.ResponseWriter, r *http.Request, params GetAdminSubmissionsParams)
@@ -171,18 +375,99 @@ type ServerInterface interface {
 	// Set team hidden status
 	// (PATCH /admin/teams/{ID}/hidden)
 	PatchAdminTeamsIDHidden(w http.ResponseWriter, r *http.Request, id string)
+	// Revoke team sessions
+	// (DELETE /admin/teams/{ID}/sessions)
+	DeleteAdminTeamsIDSessions(w http.ResponseWriter, r *http.Request, id string)
+	// List service tokens
+	// (GET /admin/tokens)
+	GetAdminTokens(w http.ResponseWriter, r *http.Request)
+	// Create service token
+	// (POST /admin/tokens)
+	PostAdminTokens(w http.ResponseWriter, r *http.Request)
+	// Revoke service token
+	// (DELETE /admin/tokens/{ID})
+	DeleteAdminTokensID(w http.ResponseWriter, r *http.Request, id string)
+	// List token requests
+	// (GET /admin/tokens/{ID}/requests)
+	GetAdminTokensIDRequests(w http.ResponseWriter, r *http.Request, id string)
+	// List users
+	// (GET /admin/users)
+	GetAdminUsers(w http.ResponseWriter, r *http.Request, params GetAdminUsersParams)
+	// Delete user
+	// (DELETE /admin/users/{ID})
+	DeleteAdminUsersID(w http.ResponseWriter, r *http.Request, id string)
+	// Get user
+	// (GET /admin/users/{ID})
+	GetAdminUsersID(w http.ResponseWriter, r *http.Request, id string)
+	// Update user
+	// (PATCH /admin/users/{ID})
+	PatchAdminUsersID(w http.ResponseWriter, r *http.Request, id string)
+	// Reset user 2FA
+	// (DELETE /admin/users/{ID}/2fa)
+	DeleteAdminUsersID2fa(w http.ResponseWriter, r *http.Request, id string)
+	// Unban user
+	// (DELETE /admin/users/{ID}/ban)
+	DeleteAdminUsersIDBan(w http.ResponseWriter, r *http.Request, id string)
+	// Ban user
+	// (POST /admin/users/{ID}/ban)
+	PostAdminUsersIDBan(w http.ResponseWriter, r *http.Request, id string)
+	// Unlock user
+	// (DELETE /admin/users/{ID}/lockout)
+	DeleteAdminUsersIDLockout(w http.ResponseWriter, r *http.Request, id string)
+	// Get user lockout
+	// (GET /admin/users/{ID}/lockout)
+	GetAdminUsersIDLockout(w http.ResponseWriter, r *http.Request, id string)
+	// Reset user password
+	// (PUT /admin/users/{ID}/password)
+	PutAdminUsersIDPassword(w http.ResponseWriter, r *http.Request, id string)
+	// Set user role
+	// (PUT /admin/users/{ID}/role)
+	PutAdminUsersIDRole(w http.ResponseWriter, r *http.Request, id string)
+	// Revoke user sessions
+	// (DELETE /admin/users/{ID}/sessions)
+	DeleteAdminUsersIDSessions(w http.ResponseWriter, r *http.Request, id string)
+	// Move user to team
+	// (PUT /admin/users/{ID}/team)
+	PutAdminUsersIDTeam(w http.ResponseWriter, r *http.Request, id string)
+	// Admin WebSocket connection
+	// (GET /admin/ws)
+	GetAdminWs(w http.ResponseWriter, r *http.Request)
+	// Confirm email change
+	// (GET /auth/confirm-email)
+	GetAuthConfirmEmail(w http.ResponseWriter, r *http.Request, params GetAuthConfirmEmailParams)
 	// Request password reset
 	// (POST /auth/forgot-password)
 	PostAuthForgotPassword(w http.ResponseWriter, r *http.Request)
 	// User login
 	// (POST /auth/login)
 	PostAuthLogin(w http.ResponseWriter, r *http.Request)
+	// Complete two-factor login
+	// (POST /auth/login/2fa)
+	PostAuthLogin2fa(w http.ResponseWriter, r *http.Request)
+	// User logout
+	// (POST /auth/logout)
+	PostAuthLogout(w http.ResponseWriter, r *http.Request)
 	// Get current user info
 	// (GET /auth/me)
 	GetAuthMe(w http.ResponseWriter, r *http.Request)
+	// List login providers
+	// (GET /auth/oauth/providers)
+	GetAuthOauthProviders(w http.ResponseWriter, r *http.Request)
+	// Start external login
+	// (GET /auth/oauth/{provider}/authorize)
+	GetAuthOauthProviderAuthorize(w http.ResponseWriter, r *http.Request, provider string)
+	// Complete external login
+	// (POST /auth/oauth/{provider}/callback)
+	PostAuthOauthProviderCallback(w http.ResponseWriter, r *http.Request, provider string)
+	// Refresh tokens
+	// (POST /auth/refresh)
+	PostAuthRefresh(w http.ResponseWriter, r *http.Request)
 	// Register new user
 	// (POST /auth/register)
 	PostAuthRegister(w http.ResponseWriter, r *http.Request)
+	// Registration status
+	// (GET /auth/registration)
+	GetAuthRegistration(w http.ResponseWriter, r *http.Request)
 	// Resend verification email
 	// (POST /auth/resend-verification)
 	PostAuthResendVerification(w http.ResponseWriter, r *http.Request)
@@ -201,6 +486,21 @@ type ServerInterface interface {
 	// Get first blood
 	// (GET /challenges/{ID}/first-blood)
 	GetChallengesIDFirstBlood(w http.ResponseWriter, r *http.Request, id string)
+	// Stop challenge instance
+	// (DELETE /challenges/{ID}/instance)
+	DeleteChallengesIDInstance(w http.ResponseWriter, r *http.Request, id string)
+	// Get challenge instance
+	// (GET /challenges/{ID}/instance)
+	GetChallengesIDInstance(w http.ResponseWriter, r *http.Request, id string)
+	// Start challenge instance
+	// (POST /challenges/{ID}/instance)
+	PostChallengesIDInstance(w http.ResponseWriter, r *http.Request, id string)
+	// Extend challenge instance
+	// (POST /challenges/{ID}/instance/extend)
+	PostChallengesIDInstanceExtend(w http.ResponseWriter, r *http.Request, id string)
+	// Get challenge stages
+	// (GET /challenges/{ID}/stages)
+	GetChallengesIDStages(w http.ResponseWriter, r *http.Request, id string)
 	// Submit flag
 	// (POST /challenges/{ID}/submit)
 	PostChallengesIDSubmit(w http.ResponseWriter, r *http.Request, id string)
@@ -294,12 +594,54 @@ type ServerInterface interface {
 	// Get team by ID
 	// (GET /teams/{ID})
 	GetTeamsID(w http.ResponseWriter, r *http.Request, id string)
+	// Get my 2FA status
+	// (GET /user/2fa)
+	GetUser2fa(w http.ResponseWriter, r *http.Request)
+	// Disable 2FA
+	// (POST /user/2fa/disable)
+	PostUser2faDisable(w http.ResponseWriter, r *http.Request)
+	// Enable 2FA
+	// (POST /user/2fa/enable)
+	PostUser2faEnable(w http.ResponseWriter, r *http.Request)
+	// Regenerate recovery codes
+	// (POST /user/2fa/recovery-codes)
+	PostUser2faRecoveryCodes(w http.ResponseWriter, r *http.Request)
+	// Start 2FA setup
+	// (POST /user/2fa/setup)
+	PostUser2faSetup(w http.ResponseWriter, r *http.Request)
+	// Change email
+	// (PUT /user/email)
+	PutUserEmail(w http.ResponseWriter, r *http.Request)
+	// List my identities
+	// (GET /user/identities)
+	GetUserIdentities(w http.ResponseWriter, r *http.Request)
+	// Unlink identity
+	// (DELETE /user/identities/{provider})
+	DeleteUserIdentitiesProvider(w http.ResponseWriter, r *http.Request, provider string)
+	// Start identity linking
+	// (POST /user/identities/{provider}/authorize)
+	PostUserIdentitiesProviderAuthorize(w http.ResponseWriter, r *http.Request, provider string)
+	// Link identity
+	// (POST /user/identities/{provider}/link)
+	PostUserIdentitiesProviderLink(w http.ResponseWriter, r *http.Request, provider string)
+	// Delete account
+	// (DELETE /user/me)
+	DeleteUserMe(w http.ResponseWriter, r *http.Request)
 	// Get user notifications
 	// (GET /user/notifications)
 	GetUserNotifications(w http.ResponseWriter, r *http.Request, params GetUserNotificationsParams)
 	// Mark notification as read
 	// (PATCH /user/notifications/{ID}/read)
 	PatchUserNotificationsIDRead(w http.ResponseWriter, r *http.Request, id string)
+	// Change password
+	// (PUT /user/password)
+	PutUserPassword(w http.ResponseWriter, r *http.Request)
+	// List my sessions
+	// (GET /user/sessions)
+	GetUserSessions(w http.ResponseWriter, r *http.Request)
+	// Revoke session
+	// (DELETE /user/sessions/{ID})
+	DeleteUserSessionsID(w http.ResponseWriter, r *http.Request, id string)
 	// List my API tokens
 	// (GET /user/tokens)
 	GetUserTokens(w http.ResponseWriter, r *http.Request)
@@ -309,6 +651,12 @@ type ServerInterface interface {
 	// Revoke API token
 	// (DELETE /user/tokens/{ID})
 	DeleteUserTokensID(w http.ResponseWriter, r *http.Request, id string)
+	// List API token requests
+	// (GET /user/tokens/{ID}/requests)
+	GetUserTokensIDRequests(w http.ResponseWriter, r *http.Request, id string)
+	// Change username
+	// (PUT /user/username)
+	PutUserUsername(w http.ResponseWriter, r *http.Request)
 	// Get user profile
 	// (GET /users/{ID})
 	GetUsersID(w http.ResponseWriter, r *http.Request, id string)
@@ -357,6 +705,18 @@ func (_ Unimplemented) PutAdminBracketsID(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export challenge specs
+// (GET /admin/challenge-specs/export)
+func (_ Unimplemented) GetAdminChallengeSpecsExport(w http.ResponseWriter, r *http.Request, params GetAdminChallengeSpecsExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import challenge specs
+// (POST /admin/challenge-specs/import)
+func (_ Unimplemented) PostAdminChallengeSpecsImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create challenge
 // (POST /admin/challenges)
 func (_ Unimplemented) PostAdminChallenges(w http.ResponseWriter, r *http.Request) {
@@ -375,18 +735,240 @@ func (_ Unimplemented) PutAdminChallengesID(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete attempt limit
+// (DELETE /admin/challenges/{challengeID}/attempt-limit)
+func (_ Unimplemented) DeleteAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get attempt limit
+// (GET /admin/challenges/{challengeID}/attempt-limit)
+func (_ Unimplemented) GetAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set attempt limit
+// (PUT /admin/challenges/{challengeID}/attempt-limit)
+func (_ Unimplemented) PutAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reset team attempts
+// (DELETE /admin/challenges/{challengeID}/attempts/{teamID})
+func (_ Unimplemented) DeleteAdminChallengesChallengeIDAttemptsTeamID(w http.ResponseWriter, r *http.Request, challengeID string, teamID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List decoy flags
+// (GET /admin/challenges/{challengeID}/decoys)
+func (_ Unimplemented) GetAdminChallengesChallengeIDDecoys(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create decoy flag
+// (POST /admin/challenges/{challengeID}/decoys)
+func (_ Unimplemented) PostAdminChallengesChallengeIDDecoys(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Upload file to challenge
 // (POST /admin/challenges/{challengeID}/files)
 func (_ Unimplemented) PostAdminChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Request, challengeID string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List challenge flags
+// (GET /admin/challenges/{challengeID}/flags)
+func (_ Unimplemented) GetAdminChallengesChallengeIDFlags(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create challenge flag
+// (POST /admin/challenges/{challengeID}/flags)
+func (_ Unimplemented) PostAdminChallengesChallengeIDFlags(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create hint
 // (POST /admin/challenges/{challengeID}/hints)
 func (_ Unimplemented) PostAdminChallengesChallengeIDHints(w http.ResponseWriter, r *http.Request, challengeID string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete instance configuration
+// (DELETE /admin/challenges/{challengeID}/instance-config)
+func (_ Unimplemented) DeleteAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get instance configuration
+// (GET /admin/challenges/{challengeID}/instance-config)
+func (_ Unimplemented) GetAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set instance configuration
+// (PUT /admin/challenges/{challengeID}/instance-config)
+func (_ Unimplemented) PutAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Disable manual grading
+// (DELETE /admin/challenges/{challengeID}/manual-review)
+func (_ Unimplemented) DeleteAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get manual grading
+// (GET /admin/challenges/{challengeID}/manual-review)
+func (_ Unimplemented) GetAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Enable manual grading
+// (PUT /admin/challenges/{challengeID}/manual-review)
+func (_ Unimplemented) PutAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenge unlock requirements
+// (GET /admin/challenges/{challengeID}/requirements)
+func (_ Unimplemented) GetAdminChallengesChallengeIDRequirements(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set challenge unlock requirements
+// (PUT /admin/challenges/{challengeID}/requirements)
+func (_ Unimplemented) PutAdminChallengesChallengeIDRequirements(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenge revisions
+// (GET /admin/challenges/{challengeID}/revisions)
+func (_ Unimplemented) GetAdminChallengesChallengeIDRevisions(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Diff challenge revisions
+// (GET /admin/challenges/{challengeID}/revisions/diff)
+func (_ Unimplemented) GetAdminChallengesChallengeIDRevisionsDiff(w http.ResponseWriter, r *http.Request, challengeID string, params GetAdminChallengesChallengeIDRevisionsDiffParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Roll back challenge
+// (POST /admin/challenges/{challengeID}/revisions/{version}/rollback)
+func (_ Unimplemented) PostAdminChallengesChallengeIDRevisionsVersionRollback(w http.ResponseWriter, r *http.Request, challengeID string, version int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenge release schedule
+// (GET /admin/challenges/{challengeID}/schedule)
+func (_ Unimplemented) GetAdminChallengesChallengeIDSchedule(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set challenge release schedule
+// (PUT /admin/challenges/{challengeID}/schedule)
+func (_ Unimplemented) PutAdminChallengesChallengeIDSchedule(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reset challenge scoring
+// (DELETE /admin/challenges/{challengeID}/scoring)
+func (_ Unimplemented) DeleteAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenge scoring
+// (GET /admin/challenges/{challengeID}/scoring)
+func (_ Unimplemented) GetAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set challenge scoring
+// (PUT /admin/challenges/{challengeID}/scoring)
+func (_ Unimplemented) PutAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Grant solve
+// (POST /admin/challenges/{challengeID}/solves)
+func (_ Unimplemented) PostAdminChallengesChallengeIDSolves(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke solve
+// (DELETE /admin/challenges/{challengeID}/solves/{teamID})
+func (_ Unimplemented) DeleteAdminChallengesChallengeIDSolvesTeamID(w http.ResponseWriter, r *http.Request, challengeID string, teamID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List challenge stages
+// (GET /admin/challenges/{challengeID}/stages)
+func (_ Unimplemented) GetAdminChallengesChallengeIDStages(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create challenge stage
+// (POST /admin/challenges/{challengeID}/stages)
+func (_ Unimplemented) PostAdminChallengesChallengeIDStages(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete per-team flag
+// (DELETE /admin/challenges/{challengeID}/team-flag)
+func (_ Unimplemented) DeleteAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get per-team flag
+// (GET /admin/challenges/{challengeID}/team-flag)
+func (_ Unimplemented) GetAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set per-team flag
+// (PUT /admin/challenges/{challengeID}/team-flag)
+func (_ Unimplemented) PutAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Render per-team flag
+// (POST /admin/challenges/{challengeID}/team-flag/render)
+func (_ Unimplemented) PostAdminChallengesChallengeIDTeamFlagRender(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get cheat incidents
+// (GET /admin/cheat-incidents)
+func (_ Unimplemented) GetAdminCheatIncidents(w http.ResponseWriter, r *http.Request, params GetAdminCheatIncidentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Analyze submissions for cheating
+// (POST /admin/cheat-incidents/analyze)
+func (_ Unimplemented) PostAdminCheatIncidentsAnalyze(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get cheat incident
+// (GET /admin/cheat-incidents/{ID})
+func (_ Unimplemented) GetAdminCheatIncidentsID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dismiss cheat incident
+// (POST /admin/cheat-incidents/{ID}/dismiss)
+func (_ Unimplemented) PostAdminCheatIncidentsIDDismiss(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Escalate cheat incident
+// (POST /admin/cheat-incidents/{ID}/escalate)
+func (_ Unimplemented) PostAdminCheatIncidentsIDEscalate(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get admin competition
 // (GET /admin/competition)
 func (_ Unimplemented) GetAdminCompetition(w http.ResponseWriter, r *http.Request) {
@@ -441,6 +1023,24 @@ func (_ Unimplemented) PostAdminCtfEventsIDFinalize(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get decoy policy
+// (GET /admin/decoy-policy)
+func (_ Unimplemented) GetAdminDecoyPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update decoy policy
+// (PUT /admin/decoy-policy)
+func (_ Unimplemented) PutAdminDecoyPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete decoy flag
+// (DELETE /admin/decoys/{ID})
+func (_ Unimplemented) DeleteAdminDecoysID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Export competition backup
 // (GET /admin/export)
 func (_ Unimplemented) GetAdminExport(w http.ResponseWriter, r *http.Request, params GetAdminExportParams) {
@@ -477,6 +1077,18 @@ func (_ Unimplemented) DeleteAdminFilesID(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete challenge flag
+// (DELETE /admin/flags/{ID})
+func (_ Unimplemented) DeleteAdminFlagsID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update challenge flag
+// (PUT /admin/flags/{ID})
+func (_ Unimplemented) PutAdminFlagsID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete hint
 // (DELETE /admin/hints/{ID})
 func (_ Unimplemented) DeleteAdminHintsID(w http.ResponseWriter, r *http.Request, id string) {
@@ -519,6 +1131,24 @@ func (_ Unimplemented) PutAdminNotificationsID(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List login providers (admin)
+// (GET /admin/oauth/providers)
+func (_ Unimplemented) GetAdminOauthProviders(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete login provider
+// (DELETE /admin/oauth/providers/{provider})
+func (_ Unimplemented) DeleteAdminOauthProvidersProvider(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Save login provider
+// (PUT /admin/oauth/providers/{provider})
+func (_ Unimplemented) PutAdminOauthProvidersProvider(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get all pages
 // (GET /admin/pages)
 func (_ Unimplemented) GetAdminPages(w http.ResponseWriter, r *http.Request) {
@@ -549,6 +1179,120 @@ func (_ Unimplemented) PutAdminPagesID(w http.ResponseWriter, r *http.Request, i
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List permissions
+// (GET /admin/permissions)
+func (_ Unimplemented) GetAdminPermissions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List registration domain rules
+// (GET /admin/registration/domains)
+func (_ Unimplemented) GetAdminRegistrationDomains(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create registration domain rule
+// (POST /admin/registration/domains)
+func (_ Unimplemented) PostAdminRegistrationDomains(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete registration domain rule
+// (DELETE /admin/registration/domains/{ID})
+func (_ Unimplemented) DeleteAdminRegistrationDomainsID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List registration invites
+// (GET /admin/registration/invites)
+func (_ Unimplemented) GetAdminRegistrationInvites(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create registration invite
+// (POST /admin/registration/invites)
+func (_ Unimplemented) PostAdminRegistrationInvites(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete registration invite
+// (DELETE /admin/registration/invites/{ID})
+func (_ Unimplemented) DeleteAdminRegistrationInvitesID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenge release timeline
+// (GET /admin/release-timeline)
+func (_ Unimplemented) GetAdminReleaseTimeline(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get review queue
+// (GET /admin/reviews)
+func (_ Unimplemented) GetAdminReviews(w http.ResponseWriter, r *http.Request, params GetAdminReviewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve submission
+// (POST /admin/reviews/{ID}/approve)
+func (_ Unimplemented) PostAdminReviewsIDApprove(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reject submission
+// (POST /admin/reviews/{ID}/reject)
+func (_ Unimplemented) PostAdminReviewsIDReject(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List roles
+// (GET /admin/roles)
+func (_ Unimplemented) GetAdminRoles(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create role
+// (POST /admin/roles)
+func (_ Unimplemented) PostAdminRoles(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete role
+// (DELETE /admin/roles/{name})
+func (_ Unimplemented) DeleteAdminRolesName(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update role
+// (PUT /admin/roles/{name})
+func (_ Unimplemented) PutAdminRolesName(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check scoring consistency
+// (GET /admin/scoring/consistency)
+func (_ Unimplemented) GetAdminScoringConsistency(w http.ResponseWriter, r *http.Request, params GetAdminScoringConsistencyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List scoring functions
+// (GET /admin/scoring/functions)
+func (_ Unimplemented) GetAdminScoringFunctions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview scoring function
+// (POST /admin/scoring/preview)
+func (_ Unimplemented) PostAdminScoringPreview(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rescore all challenges
+// (POST /admin/scoring/rescore)
+func (_ Unimplemented) PostAdminScoringRescore(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get admin settings
 // (GET /admin/settings)
 func (_ Unimplemented) GetAdminSettings(w http.ResponseWriter, r *http.Request) {
@@ -561,6 +1305,18 @@ func (_ Unimplemented) PutAdminSettings(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete challenge stage
+// (DELETE /admin/stages/{ID})
+func (_ Unimplemented) DeleteAdminStagesID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update challenge stage
+// (PUT /admin/stages/{ID})
+func (_ Unimplemented) PutAdminStagesID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get all submissions
 // (GET /admin/submissions)
 func (_ Unimplemented) GetAdminSubmissions(w http.ResponseWriter, r *http.Request, params GetAdminSubmissionsParams) {
@@ -633,109 +1389,301 @@ func (_ Unimplemented) PatchAdminTeamsIDHidden(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Request password reset
-// (POST /auth/forgot-password)
-func (_ Unimplemented) PostAuthForgotPassword(w http.ResponseWriter, r *http.Request) {
+// Revoke team sessions
+// (DELETE /admin/teams/{ID}/sessions)
+func (_ Unimplemented) DeleteAdminTeamsIDSessions(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// User login
-// (POST /auth/login)
-func (_ Unimplemented) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+// List service tokens
+// (GET /admin/tokens)
+func (_ Unimplemented) GetAdminTokens(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get current user info
-// (GET /auth/me)
-func (_ Unimplemented) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+// Create service token
+// (POST /admin/tokens)
+func (_ Unimplemented) PostAdminTokens(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Register new user
-// (POST /auth/register)
-func (_ Unimplemented) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+// Revoke service token
+// (DELETE /admin/tokens/{ID})
+func (_ Unimplemented) DeleteAdminTokensID(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Resend verification email
-// (POST /auth/resend-verification)
-func (_ Unimplemented) PostAuthResendVerification(w http.ResponseWriter, r *http.Request) {
+// List token requests
+// (GET /admin/tokens/{ID}/requests)
+func (_ Unimplemented) GetAdminTokensIDRequests(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Reset password
-// (POST /auth/reset-password)
-func (_ Unimplemented) PostAuthResetPassword(w http.ResponseWriter, r *http.Request) {
+// List users
+// (GET /admin/users)
+func (_ Unimplemented) GetAdminUsers(w http.ResponseWriter, r *http.Request, params GetAdminUsersParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Verify email
-// (GET /auth/verify-email)
-func (_ Unimplemented) GetAuthVerifyEmail(w http.ResponseWriter, r *http.Request, params GetAuthVerifyEmailParams) {
+// Delete user
+// (DELETE /admin/users/{ID})
+func (_ Unimplemented) DeleteAdminUsersID(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get brackets list
-// (GET /brackets)
-func (_ Unimplemented) GetBrackets(w http.ResponseWriter, r *http.Request) {
+// Get user
+// (GET /admin/users/{ID})
+func (_ Unimplemented) GetAdminUsersID(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get challenges list
-// (GET /challenges)
-func (_ Unimplemented) GetChallenges(w http.ResponseWriter, r *http.Request, params GetChallengesParams) {
+// Update user
+// (PATCH /admin/users/{ID})
+func (_ Unimplemented) PatchAdminUsersID(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get first blood
-// (GET /challenges/{ID}/first-blood)
-func (_ Unimplemented) GetChallengesIDFirstBlood(w http.ResponseWriter, r *http.Request, id string) {
+// Reset user 2FA
+// (DELETE /admin/users/{ID}/2fa)
+func (_ Unimplemented) DeleteAdminUsersID2fa(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Submit flag
-// (POST /challenges/{ID}/submit)
-func (_ Unimplemented) PostChallengesIDSubmit(w http.ResponseWriter, r *http.Request, id string) {
+// Unban user
+// (DELETE /admin/users/{ID}/ban)
+func (_ Unimplemented) DeleteAdminUsersIDBan(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get comments for challenge
-// (GET /challenges/{challengeID}/comments)
-func (_ Unimplemented) GetChallengesChallengeIDComments(w http.ResponseWriter, r *http.Request, challengeID string) {
+// Ban user
+// (POST /admin/users/{ID}/ban)
+func (_ Unimplemented) PostAdminUsersIDBan(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create comment for challenge
-// (POST /challenges/{challengeID}/comments)
-func (_ Unimplemented) PostChallengesChallengeIDComments(w http.ResponseWriter, r *http.Request, challengeID string) {
+// Unlock user
+// (DELETE /admin/users/{ID}/lockout)
+func (_ Unimplemented) DeleteAdminUsersIDLockout(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get challenge files
-// (GET /challenges/{challengeID}/files)
-func (_ Unimplemented) GetChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Request, challengeID string, params GetChallengesChallengeIDFilesParams) {
+// Get user lockout
+// (GET /admin/users/{ID}/lockout)
+func (_ Unimplemented) GetAdminUsersIDLockout(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get hints for challenge
-// (GET /challenges/{challengeID}/hints)
-func (_ Unimplemented) GetChallengesChallengeIDHints(w http.ResponseWriter, r *http.Request, challengeID string) {
+// Reset user password
+// (PUT /admin/users/{ID}/password)
+func (_ Unimplemented) PutAdminUsersIDPassword(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Unlock hint
-// (POST /challenges/{challengeID}/hints/{hintID}/unlock)
-func (_ Unimplemented) PostChallengesChallengeIDHintsHintIDUnlock(w http.ResponseWriter, r *http.Request, challengeID string, hintID string) {
+// Set user role
+// (PUT /admin/users/{ID}/role)
+func (_ Unimplemented) PutAdminUsersIDRole(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete comment
-// (DELETE /comments/{ID})
-func (_ Unimplemented) DeleteCommentsID(w http.ResponseWriter, r *http.Request, id string) {
+// Revoke user sessions
+// (DELETE /admin/users/{ID}/sessions)
+func (_ Unimplemented) DeleteAdminUsersIDSessions(w http.ResponseWriter, r *http.Request, id string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get competition status
+// Move user to team
+// (PUT /admin/users/{ID}/team)
+func (_ Unimplemented) PutAdminUsersIDTeam(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Admin WebSocket connection
+// (GET /admin/ws)
+func (_ Unimplemented) GetAdminWs(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm email change
+// (GET /auth/confirm-email)
+func (_ Unimplemented) GetAuthConfirmEmail(w http.ResponseWriter, r *http.Request, params GetAuthConfirmEmailParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Request password reset
+// (POST /auth/forgot-password)
+func (_ Unimplemented) PostAuthForgotPassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// User login
+// (POST /auth/login)
+func (_ Unimplemented) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete two-factor login
+// (POST /auth/login/2fa)
+func (_ Unimplemented) PostAuthLogin2fa(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// User logout
+// (POST /auth/logout)
+func (_ Unimplemented) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get current user info
+// (GET /auth/me)
+func (_ Unimplemented) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List login providers
+// (GET /auth/oauth/providers)
+func (_ Unimplemented) GetAuthOauthProviders(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start external login
+// (GET /auth/oauth/{provider}/authorize)
+func (_ Unimplemented) GetAuthOauthProviderAuthorize(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete external login
+// (POST /auth/oauth/{provider}/callback)
+func (_ Unimplemented) PostAuthOauthProviderCallback(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Refresh tokens
+// (POST /auth/refresh)
+func (_ Unimplemented) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register new user
+// (POST /auth/register)
+func (_ Unimplemented) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Registration status
+// (GET /auth/registration)
+func (_ Unimplemented) GetAuthRegistration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resend verification email
+// (POST /auth/resend-verification)
+func (_ Unimplemented) PostAuthResendVerification(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reset password
+// (POST /auth/reset-password)
+func (_ Unimplemented) PostAuthResetPassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Verify email
+// (GET /auth/verify-email)
+func (_ Unimplemented) GetAuthVerifyEmail(w http.ResponseWriter, r *http.Request, params GetAuthVerifyEmailParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get brackets list
+// (GET /brackets)
+func (_ Unimplemented) GetBrackets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenges list
+// (GET /challenges)
+func (_ Unimplemented) GetChallenges(w http.ResponseWriter, r *http.Request, params GetChallengesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get first blood
+// (GET /challenges/{ID}/first-blood)
+func (_ Unimplemented) GetChallengesIDFirstBlood(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop challenge instance
+// (DELETE /challenges/{ID}/instance)
+func (_ Unimplemented) DeleteChallengesIDInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenge instance
+// (GET /challenges/{ID}/instance)
+func (_ Unimplemented) GetChallengesIDInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start challenge instance
+// (POST /challenges/{ID}/instance)
+func (_ Unimplemented) PostChallengesIDInstance(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Extend challenge instance
+// (POST /challenges/{ID}/instance/extend)
+func (_ Unimplemented) PostChallengesIDInstanceExtend(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenge stages
+// (GET /challenges/{ID}/stages)
+func (_ Unimplemented) GetChallengesIDStages(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Submit flag
+// (POST /challenges/{ID}/submit)
+func (_ Unimplemented) PostChallengesIDSubmit(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comments for challenge
+// (GET /challenges/{challengeID}/comments)
+func (_ Unimplemented) GetChallengesChallengeIDComments(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create comment for challenge
+// (POST /challenges/{challengeID}/comments)
+func (_ Unimplemented) PostChallengesChallengeIDComments(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get challenge files
+// (GET /challenges/{challengeID}/files)
+func (_ Unimplemented) GetChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Request, challengeID string, params GetChallengesChallengeIDFilesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get hints for challenge
+// (GET /challenges/{challengeID}/hints)
+func (_ Unimplemented) GetChallengesChallengeIDHints(w http.ResponseWriter, r *http.Request, challengeID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unlock hint
+// (POST /challenges/{challengeID}/hints/{hintID}/unlock)
+func (_ Unimplemented) PostChallengesChallengeIDHintsHintIDUnlock(w http.ResponseWriter, r *http.Request, challengeID string, hintID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete comment
+// (DELETE /comments/{ID})
+func (_ Unimplemented) DeleteCommentsID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get competition status
 // (GET /competition/status)
 func (_ Unimplemented) GetCompetitionStatus(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -879,6 +1827,72 @@ func (_ Unimplemented) GetTeamsID(w http.ResponseWriter, r *http.Request, id str
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get my 2FA status
+// (GET /user/2fa)
+func (_ Unimplemented) GetUser2fa(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Disable 2FA
+// (POST /user/2fa/disable)
+func (_ Unimplemented) PostUser2faDisable(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Enable 2FA
+// (POST /user/2fa/enable)
+func (_ Unimplemented) PostUser2faEnable(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Regenerate recovery codes
+// (POST /user/2fa/recovery-codes)
+func (_ Unimplemented) PostUser2faRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start 2FA setup
+// (POST /user/2fa/setup)
+func (_ Unimplemented) PostUser2faSetup(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change email
+// (PUT /user/email)
+func (_ Unimplemented) PutUserEmail(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List my identities
+// (GET /user/identities)
+func (_ Unimplemented) GetUserIdentities(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unlink identity
+// (DELETE /user/identities/{provider})
+func (_ Unimplemented) DeleteUserIdentitiesProvider(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start identity linking
+// (POST /user/identities/{provider}/authorize)
+func (_ Unimplemented) PostUserIdentitiesProviderAuthorize(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Link identity
+// (POST /user/identities/{provider}/link)
+func (_ Unimplemented) PostUserIdentitiesProviderLink(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete account
+// (DELETE /user/me)
+func (_ Unimplemented) DeleteUserMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get user notifications
 // (GET /user/notifications)
 func (_ Unimplemented) GetUserNotifications(w http.ResponseWriter, r *http.Request, params GetUserNotificationsParams) {
@@ -891,6 +1905,24 @@ func (_ Unimplemented) PatchUserNotificationsIDRead(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Change password
+// (PUT /user/password)
+func (_ Unimplemented) PutUserPassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List my sessions
+// (GET /user/sessions)
+func (_ Unimplemented) GetUserSessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke session
+// (DELETE /user/sessions/{ID})
+func (_ Unimplemented) DeleteUserSessionsID(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List my API tokens
 // (GET /user/tokens)
 func (_ Unimplemented) GetUserTokens(w http.ResponseWriter, r *http.Request) {
@@ -909,6 +1941,18 @@ func (_ Unimplemented) DeleteUserTokensID(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List API token requests
+// (GET /user/tokens/{ID}/requests)
+func (_ Unimplemented) GetUserTokensIDRequests(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change username
+// (PUT /user/username)
+func (_ Unimplemented) PutUserUsername(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get user profile
 // (GET /users/{ID})
 func (_ Unimplemented) GetUsersID(w http.ResponseWriter, r *http.Request, id string) {
@@ -1094,6 +2138,67 @@ func (siw *ServerInterfaceWrapper) PutAdminBracketsID(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
+// GetAdminChallengeSpecsExport operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengeSpecsExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminChallengeSpecsExportParams
+
+	// ------------- Optional query parameter "challenge" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "challenge", r.URL.Query(), &params.Challenge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challenge", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminChallengeSpecsExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminChallengeSpecsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengeSpecsImport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminChallengeSpecsImport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // PostAdminChallenges operation middleware
 func (siw *ServerInterfaceWrapper) PostAdminChallenges(w http.ResponseWriter, r *http.Request) {
 
@@ -1176,8 +2281,8 @@ func (siw *ServerInterfaceWrapper) PutAdminChallengesID(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminChallengesChallengeIDFiles operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminChallengesChallengeIDAttemptLimit operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1197,7 +2302,7 @@ func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDFiles(w http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminChallengesChallengeIDFiles(w, r, challengeID)
+		siw.Handler.DeleteAdminChallengesChallengeIDAttemptLimit(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1207,8 +2312,8 @@ func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDFiles(w http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminChallengesChallengeIDHints operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDHints(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDAttemptLimit operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1228,7 +2333,7 @@ func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDHints(w http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminChallengesChallengeIDHints(w, r, challengeID)
+		siw.Handler.GetAdminChallengesChallengeIDAttemptLimit(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1238,29 +2343,20 @@ func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDHints(w http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminCompetition operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminCompetition(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
+// PutAdminChallengesChallengeIDAttemptLimit operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminChallengesChallengeIDAttemptLimit(w http.ResponseWriter, r *http.Request) {
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	var err error
 
-	r = r.WithContext(ctx)
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminCompetition(w, r)
-	}))
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// PutAdminCompetition operation middleware
-func (siw *ServerInterfaceWrapper) PutAdminCompetition(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1268,7 +2364,7 @@ func (siw *ServerInterfaceWrapper) PutAdminCompetition(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAdminCompetition(w, r)
+		siw.Handler.PutAdminChallengesChallengeIDAttemptLimit(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1278,8 +2374,28 @@ func (siw *ServerInterfaceWrapper) PutAdminCompetition(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminConfigs operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminConfigs(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminChallengesChallengeIDAttemptsTeamID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminChallengesChallengeIDAttemptsTeamID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", chi.URLParam(r, "teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "teamID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1288,7 +2404,7 @@ func (siw *ServerInterfaceWrapper) GetAdminConfigs(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminConfigs(w, r)
+		siw.Handler.DeleteAdminChallengesChallengeIDAttemptsTeamID(w, r, challengeID, teamID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1298,17 +2414,17 @@ func (siw *ServerInterfaceWrapper) GetAdminConfigs(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAdminConfigsKey operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminConfigsKey(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDDecoys operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDDecoys(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "key" -------------
-	var key string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1319,7 +2435,7 @@ func (siw *ServerInterfaceWrapper) DeleteAdminConfigsKey(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAdminConfigsKey(w, r, key)
+		siw.Handler.GetAdminChallengesChallengeIDDecoys(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1329,17 +2445,17 @@ func (siw *ServerInterfaceWrapper) DeleteAdminConfigsKey(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminConfigsKey operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminConfigsKey(w http.ResponseWriter, r *http.Request) {
+// PostAdminChallengesChallengeIDDecoys operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDDecoys(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "key" -------------
-	var key string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1350,7 +2466,7 @@ func (siw *ServerInterfaceWrapper) GetAdminConfigsKey(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminConfigsKey(w, r, key)
+		siw.Handler.PostAdminChallengesChallengeIDDecoys(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1360,17 +2476,17 @@ func (siw *ServerInterfaceWrapper) GetAdminConfigsKey(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// PutAdminConfigsKey operation middleware
-func (siw *ServerInterfaceWrapper) PutAdminConfigsKey(w http.ResponseWriter, r *http.Request) {
+// PostAdminChallengesChallengeIDFiles operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "key" -------------
-	var key string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1381,7 +2497,7 @@ func (siw *ServerInterfaceWrapper) PutAdminConfigsKey(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAdminConfigsKey(w, r, key)
+		siw.Handler.PostAdminChallengesChallengeIDFiles(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1391,8 +2507,19 @@ func (siw *ServerInterfaceWrapper) PutAdminConfigsKey(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminCtfEvents operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminCtfEvents(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDFlags operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDFlags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1401,7 +2528,7 @@ func (siw *ServerInterfaceWrapper) GetAdminCtfEvents(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminCtfEvents(w, r)
+		siw.Handler.GetAdminChallengesChallengeIDFlags(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1411,8 +2538,19 @@ func (siw *ServerInterfaceWrapper) GetAdminCtfEvents(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminCtfEvents operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminCtfEvents(w http.ResponseWriter, r *http.Request) {
+// PostAdminChallengesChallengeIDFlags operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDFlags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1421,7 +2559,7 @@ func (siw *ServerInterfaceWrapper) PostAdminCtfEvents(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminCtfEvents(w, r)
+		siw.Handler.PostAdminChallengesChallengeIDFlags(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1431,17 +2569,17 @@ func (siw *ServerInterfaceWrapper) PostAdminCtfEvents(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminCtfEventsIDFinalize operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminCtfEventsIDFinalize(w http.ResponseWriter, r *http.Request) {
+// PostAdminChallengesChallengeIDHints operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDHints(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1452,7 +2590,7 @@ func (siw *ServerInterfaceWrapper) PostAdminCtfEventsIDFinalize(w http.ResponseW
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminCtfEventsIDFinalize(w, r, id)
+		siw.Handler.PostAdminChallengesChallengeIDHints(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1462,54 +2600,28 @@ func (siw *ServerInterfaceWrapper) PostAdminCtfEventsIDFinalize(w http.ResponseW
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminExport operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminChallengesChallengeIDInstanceConfig operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminExportParams
-
-	// ------------- Optional query parameter "include_users" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "include_users", r.URL.Query(), &params.IncludeUsers)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_users", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "include_teams" -------------
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindQueryParameter("form", true, false, "include_teams", r.URL.Query(), &params.IncludeTeams)
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_teams", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "include_solves" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "include_solves", r.URL.Query(), &params.IncludeSolves)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_solves", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "include_awards" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "include_awards", r.URL.Query(), &params.IncludeAwards)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_awards", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminExport(w, r, params)
+		siw.Handler.DeleteAdminChallengesChallengeIDInstanceConfig(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1519,30 +2631,28 @@ func (siw *ServerInterfaceWrapper) GetAdminExport(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminExportZip operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminExportZip(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDInstanceConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminExportZipParams
-
-	// ------------- Optional query parameter "include_files" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "include_files", r.URL.Query(), &params.IncludeFiles)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_files", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminExportZip(w, r, params)
+		siw.Handler.GetAdminChallengesChallengeIDInstanceConfig(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1552,8 +2662,19 @@ func (siw *ServerInterfaceWrapper) GetAdminExportZip(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminFields operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminFields(w http.ResponseWriter, r *http.Request) {
+// PutAdminChallengesChallengeIDInstanceConfig operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminChallengesChallengeIDInstanceConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1562,7 +2683,7 @@ func (siw *ServerInterfaceWrapper) PostAdminFields(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminFields(w, r)
+		siw.Handler.PutAdminChallengesChallengeIDInstanceConfig(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1572,17 +2693,17 @@ func (siw *ServerInterfaceWrapper) PostAdminFields(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAdminFieldsID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminFieldsID(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminChallengesChallengeIDManualReview operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1593,7 +2714,7 @@ func (siw *ServerInterfaceWrapper) DeleteAdminFieldsID(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAdminFieldsID(w, r, id)
+		siw.Handler.DeleteAdminChallengesChallengeIDManualReview(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1603,17 +2724,17 @@ func (siw *ServerInterfaceWrapper) DeleteAdminFieldsID(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PutAdminFieldsID operation middleware
-func (siw *ServerInterfaceWrapper) PutAdminFieldsID(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDManualReview operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1624,7 +2745,7 @@ func (siw *ServerInterfaceWrapper) PutAdminFieldsID(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAdminFieldsID(w, r, id)
+		siw.Handler.GetAdminChallengesChallengeIDManualReview(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1634,17 +2755,17 @@ func (siw *ServerInterfaceWrapper) PutAdminFieldsID(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAdminFilesID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminFilesID(w http.ResponseWriter, r *http.Request) {
+// PutAdminChallengesChallengeIDManualReview operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminChallengesChallengeIDManualReview(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1655,7 +2776,7 @@ func (siw *ServerInterfaceWrapper) DeleteAdminFilesID(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAdminFilesID(w, r, id)
+		siw.Handler.PutAdminChallengesChallengeIDManualReview(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1665,17 +2786,17 @@ func (siw *ServerInterfaceWrapper) DeleteAdminFilesID(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAdminHintsID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminHintsID(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDRequirements operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDRequirements(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1686,7 +2807,7 @@ func (siw *ServerInterfaceWrapper) DeleteAdminHintsID(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAdminHintsID(w, r, id)
+		siw.Handler.GetAdminChallengesChallengeIDRequirements(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1696,17 +2817,17 @@ func (siw *ServerInterfaceWrapper) DeleteAdminHintsID(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// PutAdminHintsID operation middleware
-func (siw *ServerInterfaceWrapper) PutAdminHintsID(w http.ResponseWriter, r *http.Request) {
+// PutAdminChallengesChallengeIDRequirements operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminChallengesChallengeIDRequirements(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1717,7 +2838,7 @@ func (siw *ServerInterfaceWrapper) PutAdminHintsID(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAdminHintsID(w, r, id)
+		siw.Handler.PutAdminChallengesChallengeIDRequirements(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1727,8 +2848,19 @@ func (siw *ServerInterfaceWrapper) PutAdminHintsID(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminImport operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDRevisions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1737,7 +2869,7 @@ func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminImport(w, r)
+		siw.Handler.GetAdminChallengesChallengeIDRevisions(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1747,8 +2879,19 @@ func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminNotifications operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminNotifications(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDRevisionsDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDRevisionsDiff(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1756,8 +2899,41 @@ func (siw *ServerInterfaceWrapper) PostAdminNotifications(w http.ResponseWriter,
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminChallengesChallengeIDRevisionsDiffParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminNotifications(w, r)
+		siw.Handler.GetAdminChallengesChallengeIDRevisionsDiff(w, r, challengeID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1767,17 +2943,26 @@ func (siw *ServerInterfaceWrapper) PostAdminNotifications(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminNotificationsUserUserID operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminNotificationsUserUserID(w http.ResponseWriter, r *http.Request) {
+// PostAdminChallengesChallengeIDRevisionsVersionRollback operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDRevisionsVersionRollback(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userID" -------------
-	var userID string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
@@ -1788,7 +2973,7 @@ func (siw *ServerInterfaceWrapper) PostAdminNotificationsUserUserID(w http.Respo
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminNotificationsUserUserID(w, r, userID)
+		siw.Handler.PostAdminChallengesChallengeIDRevisionsVersionRollback(w, r, challengeID, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1798,17 +2983,17 @@ func (siw *ServerInterfaceWrapper) PostAdminNotificationsUserUserID(w http.Respo
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAdminNotificationsID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminNotificationsID(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDSchedule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1819,7 +3004,7 @@ func (siw *ServerInterfaceWrapper) DeleteAdminNotificationsID(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAdminNotificationsID(w, r, id)
+		siw.Handler.GetAdminChallengesChallengeIDSchedule(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1829,17 +3014,17 @@ func (siw *ServerInterfaceWrapper) DeleteAdminNotificationsID(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// PutAdminNotificationsID operation middleware
-func (siw *ServerInterfaceWrapper) PutAdminNotificationsID(w http.ResponseWriter, r *http.Request) {
+// PutAdminChallengesChallengeIDSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminChallengesChallengeIDSchedule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1850,7 +3035,7 @@ func (siw *ServerInterfaceWrapper) PutAdminNotificationsID(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAdminNotificationsID(w, r, id)
+		siw.Handler.PutAdminChallengesChallengeIDSchedule(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1860,8 +3045,19 @@ func (siw *ServerInterfaceWrapper) PutAdminNotificationsID(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminPages operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminPages(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminChallengesChallengeIDScoring operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1870,7 +3066,7 @@ func (siw *ServerInterfaceWrapper) GetAdminPages(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminPages(w, r)
+		siw.Handler.DeleteAdminChallengesChallengeIDScoring(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1880,8 +3076,19 @@ func (siw *ServerInterfaceWrapper) GetAdminPages(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminPages operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminPages(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDScoring operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1890,7 +3097,7 @@ func (siw *ServerInterfaceWrapper) PostAdminPages(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminPages(w, r)
+		siw.Handler.GetAdminChallengesChallengeIDScoring(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1900,17 +3107,17 @@ func (siw *ServerInterfaceWrapper) PostAdminPages(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAdminPagesID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminPagesID(w http.ResponseWriter, r *http.Request) {
+// PutAdminChallengesChallengeIDScoring operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminChallengesChallengeIDScoring(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1921,7 +3128,7 @@ func (siw *ServerInterfaceWrapper) DeleteAdminPagesID(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAdminPagesID(w, r, id)
+		siw.Handler.PutAdminChallengesChallengeIDScoring(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1931,17 +3138,17 @@ func (siw *ServerInterfaceWrapper) DeleteAdminPagesID(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminPagesID operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminPagesID(w http.ResponseWriter, r *http.Request) {
+// PostAdminChallengesChallengeIDSolves operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDSolves(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -1952,7 +3159,7 @@ func (siw *ServerInterfaceWrapper) GetAdminPagesID(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminPagesID(w, r, id)
+		siw.Handler.PostAdminChallengesChallengeIDSolves(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1962,17 +3169,26 @@ func (siw *ServerInterfaceWrapper) GetAdminPagesID(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// PutAdminPagesID operation middleware
-func (siw *ServerInterfaceWrapper) PutAdminPagesID(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminChallengesChallengeIDSolvesTeamID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminChallengesChallengeIDSolvesTeamID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", chi.URLParam(r, "teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "teamID", Err: err})
 		return
 	}
 
@@ -1983,7 +3199,7 @@ func (siw *ServerInterfaceWrapper) PutAdminPagesID(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAdminPagesID(w, r, id)
+		siw.Handler.DeleteAdminChallengesChallengeIDSolvesTeamID(w, r, challengeID, teamID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1993,8 +3209,19 @@ func (siw *ServerInterfaceWrapper) PutAdminPagesID(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminSettings(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDStages operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDStages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2003,7 +3230,7 @@ func (siw *ServerInterfaceWrapper) GetAdminSettings(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminSettings(w, r)
+		siw.Handler.GetAdminChallengesChallengeIDStages(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2013,8 +3240,19 @@ func (siw *ServerInterfaceWrapper) GetAdminSettings(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// PutAdminSettings operation middleware
-func (siw *ServerInterfaceWrapper) PutAdminSettings(w http.ResponseWriter, r *http.Request) {
+// PostAdminChallengesChallengeIDStages operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDStages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2023,7 +3261,7 @@ func (siw *ServerInterfaceWrapper) PutAdminSettings(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAdminSettings(w, r)
+		siw.Handler.PostAdminChallengesChallengeIDStages(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2033,38 +3271,28 @@ func (siw *ServerInterfaceWrapper) PutAdminSettings(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminSubmissions operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminSubmissions(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminChallengesChallengeIDTeamFlag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminSubmissionsParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminSubmissions(w, r, params)
+		siw.Handler.DeleteAdminChallengesChallengeIDTeamFlag(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2074,8 +3302,8 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissions(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminSubmissionsChallengeChallengeID operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminSubmissionsChallengeChallengeID(w http.ResponseWriter, r *http.Request) {
+// GetAdminChallengesChallengeIDTeamFlag operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2094,27 +3322,8 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsChallengeChallengeID(w htt
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminSubmissionsChallengeChallengeIDParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "per_page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminSubmissionsChallengeChallengeID(w, r, challengeID, params)
+		siw.Handler.GetAdminChallengesChallengeIDTeamFlag(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2124,8 +3333,8 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsChallengeChallengeID(w htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminSubmissionsChallengeChallengeIDStats operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminSubmissionsChallengeChallengeIDStats(w http.ResponseWriter, r *http.Request) {
+// PutAdminChallengesChallengeIDTeamFlag operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminChallengesChallengeIDTeamFlag(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2145,7 +3354,7 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsChallengeChallengeIDStats(
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminSubmissionsChallengeChallengeIDStats(w, r, challengeID)
+		siw.Handler.PutAdminChallengesChallengeIDTeamFlag(w, r, challengeID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2155,17 +3364,17 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsChallengeChallengeIDStats(
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminSubmissionsTeamTeamID operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminSubmissionsTeamTeamID(w http.ResponseWriter, r *http.Request) {
+// PostAdminChallengesChallengeIDTeamFlagRender operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChallengesChallengeIDTeamFlagRender(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "teamID" -------------
-	var teamID string
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", chi.URLParam(r, "teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "teamID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
 		return
 	}
 
@@ -2175,28 +3384,9 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsTeamTeamID(w http.Response
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminSubmissionsTeamTeamIDParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "per_page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
-		return
-	}
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminSubmissionsTeamTeamID(w, r, teamID, params)
-	}))
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminChallengesChallengeIDTeamFlagRender(w, r, challengeID)
+	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
@@ -2205,20 +3395,11 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsTeamTeamID(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminSubmissionsUserUserID operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminSubmissionsUserUserID(w http.ResponseWriter, r *http.Request) {
+// GetAdminCheatIncidents operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminCheatIncidents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userID" -------------
-	var userID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2226,7 +3407,23 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsUserUserID(w http.Response
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminSubmissionsUserUserIDParams
+	var params GetAdminCheatIncidentsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -2245,7 +3442,7 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsUserUserID(w http.Response
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminSubmissionsUserUserID(w, r, userID, params)
+		siw.Handler.GetAdminCheatIncidents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2255,8 +3452,8 @@ func (siw *ServerInterfaceWrapper) GetAdminSubmissionsUserUserID(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminTags operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminTags(w http.ResponseWriter, r *http.Request) {
+// PostAdminCheatIncidentsAnalyze operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminCheatIncidentsAnalyze(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2265,7 +3462,7 @@ func (siw *ServerInterfaceWrapper) PostAdminTags(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminTags(w, r)
+		siw.Handler.PostAdminCheatIncidentsAnalyze(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2275,8 +3472,8 @@ func (siw *ServerInterfaceWrapper) PostAdminTags(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAdminTagsID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminTagsID(w http.ResponseWriter, r *http.Request) {
+// GetAdminCheatIncidentsID operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminCheatIncidentsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2296,7 +3493,7 @@ func (siw *ServerInterfaceWrapper) DeleteAdminTagsID(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAdminTagsID(w, r, id)
+		siw.Handler.GetAdminCheatIncidentsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2306,8 +3503,8 @@ func (siw *ServerInterfaceWrapper) DeleteAdminTagsID(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PutAdminTagsID operation middleware
-func (siw *ServerInterfaceWrapper) PutAdminTagsID(w http.ResponseWriter, r *http.Request) {
+// PostAdminCheatIncidentsIDDismiss operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminCheatIncidentsIDDismiss(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2327,7 +3524,7 @@ func (siw *ServerInterfaceWrapper) PutAdminTagsID(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAdminTagsID(w, r, id)
+		siw.Handler.PostAdminCheatIncidentsIDDismiss(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2337,8 +3534,8 @@ func (siw *ServerInterfaceWrapper) PutAdminTagsID(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAdminTeamsIDBan operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminTeamsIDBan(w http.ResponseWriter, r *http.Request) {
+// PostAdminCheatIncidentsIDEscalate operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminCheatIncidentsIDEscalate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2358,7 +3555,7 @@ func (siw *ServerInterfaceWrapper) DeleteAdminTeamsIDBan(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAdminTeamsIDBan(w, r, id)
+		siw.Handler.PostAdminCheatIncidentsIDEscalate(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2368,19 +3565,8 @@ func (siw *ServerInterfaceWrapper) DeleteAdminTeamsIDBan(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostAdminTeamsIDBan operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminTeamsIDBan(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "ID" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
-		return
-	}
+// GetAdminCompetition operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminCompetition(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2389,7 +3575,7 @@ func (siw *ServerInterfaceWrapper) PostAdminTeamsIDBan(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAdminTeamsIDBan(w, r, id)
+		siw.Handler.GetAdminCompetition(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2399,19 +3585,8 @@ func (siw *ServerInterfaceWrapper) PostAdminTeamsIDBan(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PatchAdminTeamsIDBracket operation middleware
-func (siw *ServerInterfaceWrapper) PatchAdminTeamsIDBracket(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "ID" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
-		return
-	}
+// PutAdminCompetition operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminCompetition(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2420,7 +3595,7 @@ func (siw *ServerInterfaceWrapper) PatchAdminTeamsIDBracket(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchAdminTeamsIDBracket(w, r, id)
+		siw.Handler.PutAdminCompetition(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2430,19 +3605,8 @@ func (siw *ServerInterfaceWrapper) PatchAdminTeamsIDBracket(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// PatchAdminTeamsIDHidden operation middleware
-func (siw *ServerInterfaceWrapper) PatchAdminTeamsIDHidden(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "ID" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
-		return
-	}
+// GetAdminConfigs operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminConfigs(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2451,7 +3615,7 @@ func (siw *ServerInterfaceWrapper) PatchAdminTeamsIDHidden(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchAdminTeamsIDHidden(w, r, id)
+		siw.Handler.GetAdminConfigs(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2461,25 +3625,28 @@ func (siw *ServerInterfaceWrapper) PatchAdminTeamsIDHidden(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostAuthForgotPassword operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthForgotPassword(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminConfigsKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminConfigsKey(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthForgotPassword(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	ctx := r.Context()
 
-// PostAuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthLogin(w, r)
+		siw.Handler.DeleteAdminConfigsKey(w, r, key)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2489,8 +3656,19 @@ func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetAuthMe operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+// GetAdminConfigsKey operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminConfigsKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2499,7 +3677,7 @@ func (siw *ServerInterfaceWrapper) GetAuthMe(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuthMe(w, r)
+		siw.Handler.GetAdminConfigsKey(w, r, key)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2509,11 +3687,28 @@ func (siw *ServerInterfaceWrapper) GetAuthMe(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostAuthRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+// PutAdminConfigsKey operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminConfigsKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", chi.URLParam(r, "key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthRegister(w, r)
+		siw.Handler.PutAdminConfigsKey(w, r, key)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2523,8 +3718,8 @@ func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// PostAuthResendVerification operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthResendVerification(w http.ResponseWriter, r *http.Request) {
+// GetAdminCtfEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminCtfEvents(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2533,7 +3728,7 @@ func (siw *ServerInterfaceWrapper) PostAuthResendVerification(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthResendVerification(w, r)
+		siw.Handler.GetAdminCtfEvents(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2543,11 +3738,17 @@ func (siw *ServerInterfaceWrapper) PostAuthResendVerification(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// PostAuthResetPassword operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthResetPassword(w http.ResponseWriter, r *http.Request) {
+// PostAdminCtfEvents operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminCtfEvents(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthResetPassword(w, r)
+		siw.Handler.PostAdminCtfEvents(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2557,31 +3758,28 @@ func (siw *ServerInterfaceWrapper) PostAuthResetPassword(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetAuthVerifyEmail operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+// PostAdminCtfEventsIDFinalize operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminCtfEventsIDFinalize(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAuthVerifyEmailParams
-
-	// ------------- Required query parameter "token" -------------
-
-	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
-		return
-	}
+	// ------------- Path parameter "ID" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuthVerifyEmail(w, r, params)
+		siw.Handler.PostAdminCtfEventsIDFinalize(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2591,11 +3789,17 @@ func (siw *ServerInterfaceWrapper) GetAuthVerifyEmail(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetBrackets operation middleware
-func (siw *ServerInterfaceWrapper) GetBrackets(w http.ResponseWriter, r *http.Request) {
+// GetAdminDecoyPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminDecoyPolicy(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBrackets(w, r)
+		siw.Handler.GetAdminDecoyPolicy(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2605,10 +3809,8 @@ func (siw *ServerInterfaceWrapper) GetBrackets(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetChallenges operation middleware
-func (siw *ServerInterfaceWrapper) GetChallenges(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PutAdminDecoyPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminDecoyPolicy(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2616,19 +3818,8 @@ func (siw *ServerInterfaceWrapper) GetChallenges(w http.ResponseWriter, r *http.
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetChallengesParams
-
-	// ------------- Optional query parameter "tag" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChallenges(w, r, params)
+		siw.Handler.PutAdminDecoyPolicy(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2638,8 +3829,8 @@ func (siw *ServerInterfaceWrapper) GetChallenges(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetChallengesIDFirstBlood operation middleware
-func (siw *ServerInterfaceWrapper) GetChallengesIDFirstBlood(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminDecoysID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminDecoysID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2652,8 +3843,14 @@ func (siw *ServerInterfaceWrapper) GetChallengesIDFirstBlood(w http.ResponseWrit
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChallengesIDFirstBlood(w, r, id)
+		siw.Handler.DeleteAdminDecoysID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2663,28 +3860,54 @@ func (siw *ServerInterfaceWrapper) GetChallengesIDFirstBlood(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// PostChallengesIDSubmit operation middleware
-func (siw *ServerInterfaceWrapper) PostChallengesIDSubmit(w http.ResponseWriter, r *http.Request) {
+// GetAdminExport operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminExportParams
+
+	// ------------- Optional query parameter "include_users" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_users", r.URL.Query(), &params.IncludeUsers)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_users", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "include_teams" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "include_teams", r.URL.Query(), &params.IncludeTeams)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_teams", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "include_solves" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_solves", r.URL.Query(), &params.IncludeSolves)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_solves", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_awards" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_awards", r.URL.Query(), &params.IncludeAwards)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_awards", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostChallengesIDSubmit(w, r, id)
+		siw.Handler.GetAdminExport(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2694,28 +3917,30 @@ func (siw *ServerInterfaceWrapper) PostChallengesIDSubmit(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetChallengesChallengeIDComments operation middleware
-func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDComments(w http.ResponseWriter, r *http.Request) {
+// GetAdminExportZip operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExportZip(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "challengeID" -------------
-	var challengeID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminExportZipParams
+
+	// ------------- Optional query parameter "include_files" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_files", r.URL.Query(), &params.IncludeFiles)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_files", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChallengesChallengeIDComments(w, r, challengeID)
+		siw.Handler.GetAdminExportZip(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2725,19 +3950,8 @@ func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDComments(w http.Respo
 	handler.ServeHTTP(w, r)
 }
 
-// PostChallengesChallengeIDComments operation middleware
-func (siw *ServerInterfaceWrapper) PostChallengesChallengeIDComments(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "challengeID" -------------
-	var challengeID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
-		return
-	}
+// PostAdminFields operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminFields(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2746,7 +3960,7 @@ func (siw *ServerInterfaceWrapper) PostChallengesChallengeIDComments(w http.Resp
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostChallengesChallengeIDComments(w, r, challengeID)
+		siw.Handler.PostAdminFields(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2756,17 +3970,17 @@ func (siw *ServerInterfaceWrapper) PostChallengesChallengeIDComments(w http.Resp
 	handler.ServeHTTP(w, r)
 }
 
-// GetChallengesChallengeIDFiles operation middleware
-func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminFieldsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminFieldsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "challengeID" -------------
-	var challengeID string
+	// ------------- Path parameter "ID" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
 		return
 	}
 
@@ -2776,19 +3990,8 @@ func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDFiles(w http.Response
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetChallengesChallengeIDFilesParams
-
-	// ------------- Optional query parameter "type" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChallengesChallengeIDFiles(w, r, challengeID, params)
+		siw.Handler.DeleteAdminFieldsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2798,17 +4001,17 @@ func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDFiles(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// GetChallengesChallengeIDHints operation middleware
-func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDHints(w http.ResponseWriter, r *http.Request) {
+// PutAdminFieldsID operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminFieldsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "challengeID" -------------
-	var challengeID string
+	// ------------- Path parameter "ID" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
 		return
 	}
 
@@ -2819,7 +4022,7 @@ func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDHints(w http.Response
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChallengesChallengeIDHints(w, r, challengeID)
+		siw.Handler.PutAdminFieldsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2829,26 +4032,17 @@ func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDHints(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// PostChallengesChallengeIDHintsHintIDUnlock operation middleware
-func (siw *ServerInterfaceWrapper) PostChallengesChallengeIDHintsHintIDUnlock(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminFilesID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminFilesID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "challengeID" -------------
-	var challengeID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "hintID" -------------
-	var hintID string
+	// ------------- Path parameter "ID" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "hintID", chi.URLParam(r, "hintID"), &hintID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hintID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
 		return
 	}
 
@@ -2859,7 +4053,7 @@ func (siw *ServerInterfaceWrapper) PostChallengesChallengeIDHintsHintIDUnlock(w
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostChallengesChallengeIDHintsHintIDUnlock(w, r, challengeID, hintID)
+		siw.Handler.DeleteAdminFilesID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2869,8 +4063,8 @@ func (siw *ServerInterfaceWrapper) PostChallengesChallengeIDHintsHintIDUnlock(w
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCommentsID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCommentsID(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminFlagsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminFlagsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2890,21 +4084,7 @@ func (siw *ServerInterfaceWrapper) DeleteCommentsID(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCommentsID(w, r, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// GetCompetitionStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetCompetitionStatus(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCompetitionStatus(w, r)
+		siw.Handler.DeleteAdminFlagsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2914,31 +4094,28 @@ func (siw *ServerInterfaceWrapper) GetCompetitionStatus(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetFields operation middleware
-func (siw *ServerInterfaceWrapper) GetFields(w http.ResponseWriter, r *http.Request) {
+// PutAdminFlagsID operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminFlagsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFieldsParams
-
-	// ------------- Required query parameter "entity_type" -------------
-
-	if paramValue := r.URL.Query().Get("entity_type"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "entity_type"})
-		return
-	}
+	// ------------- Path parameter "ID" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, true, "entity_type", r.URL.Query(), &params.EntityType)
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFields(w, r, params)
+		siw.Handler.PutAdminFlagsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2948,8 +4125,8 @@ func (siw *ServerInterfaceWrapper) GetFields(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetFilesIDDownload operation middleware
-func (siw *ServerInterfaceWrapper) GetFilesIDDownload(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminHintsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminHintsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2969,7 +4146,7 @@ func (siw *ServerInterfaceWrapper) GetFilesIDDownload(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFilesIDDownload(w, r, id)
+		siw.Handler.DeleteAdminHintsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2979,32 +4156,28 @@ func (siw *ServerInterfaceWrapper) GetFilesIDDownload(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetNotifications operation middleware
-func (siw *ServerInterfaceWrapper) GetNotifications(w http.ResponseWriter, r *http.Request) {
+// PutAdminHintsID operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminHintsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNotificationsParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "ID" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNotifications(w, r, params)
+		siw.Handler.PutAdminHintsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3014,11 +4187,17 @@ func (siw *ServerInterfaceWrapper) GetNotifications(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetPages operation middleware
-func (siw *ServerInterfaceWrapper) GetPages(w http.ResponseWriter, r *http.Request) {
+// PostAdminImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPages(w, r)
+		siw.Handler.PostAdminImport(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3028,22 +4207,17 @@ func (siw *ServerInterfaceWrapper) GetPages(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// GetPagesSlug operation middleware
-func (siw *ServerInterfaceWrapper) GetPagesSlug(w http.ResponseWriter, r *http.Request) {
+// PostAdminNotifications operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminNotifications(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "slug" -------------
-	var slug string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPagesSlug(w, r, slug)
+		siw.Handler.PostAdminNotifications(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3053,32 +4227,3153 @@ func (siw *ServerInterfaceWrapper) GetPagesSlug(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetRatings operation middleware
-func (siw *ServerInterfaceWrapper) GetRatings(w http.ResponseWriter, r *http.Request) {
+// PostAdminNotificationsUserUserID operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminNotificationsUserUserID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
+	// ------------- Path parameter "userID" -------------
+	var userID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminNotificationsUserUserID(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminNotificationsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminNotificationsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminNotificationsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminNotificationsID operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminNotificationsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminNotificationsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminOauthProviders operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminOauthProviders(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminOauthProviders(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminOauthProvidersProvider operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminOauthProvidersProvider(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminOauthProvidersProvider(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminOauthProvidersProvider operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminOauthProvidersProvider(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminOauthProvidersProvider(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminPages operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminPages(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminPages(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminPages operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminPages(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminPages(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminPagesID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminPagesID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminPagesID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminPagesID operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminPagesID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminPagesID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminPagesID operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminPagesID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminPagesID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminPermissions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminPermissions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminPermissions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminRegistrationDomains operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminRegistrationDomains(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminRegistrationDomains(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminRegistrationDomains operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminRegistrationDomains(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminRegistrationDomains(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminRegistrationDomainsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminRegistrationDomainsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminRegistrationDomainsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminRegistrationInvites operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminRegistrationInvites(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminRegistrationInvites(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminRegistrationInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminRegistrationInvites(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminRegistrationInvites(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminRegistrationInvitesID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminRegistrationInvitesID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminRegistrationInvitesID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminReleaseTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminReleaseTimeline(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminReleaseTimeline(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminReviewsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminReviewsIDApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminReviewsIDApprove(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminReviewsIDApprove(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminReviewsIDReject operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminReviewsIDReject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminReviewsIDReject(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminRoles operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminRoles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminRoles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminRoles operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminRoles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminRoles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminRolesName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminRolesName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminRolesName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminRolesName operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminRolesName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminRolesName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminScoringConsistency operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminScoringConsistency(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminScoringConsistencyParams
+
+	// ------------- Optional query parameter "exclude_banned" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_banned", r.URL.Query(), &params.ExcludeBanned)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_banned", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_hidden" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_hidden", r.URL.Query(), &params.ExcludeHidden)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_hidden", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminScoringConsistency(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminScoringFunctions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminScoringFunctions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminScoringFunctions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminScoringPreview operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminScoringPreview(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminScoringPreview(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminScoringRescore operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminScoringRescore(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminScoringRescore(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSettings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminSettings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminStagesID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminStagesID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminStagesID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminStagesID operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminStagesID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminStagesID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSubmissions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSubmissions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminSubmissionsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSubmissions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSubmissionsChallengeChallengeID operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSubmissionsChallengeChallengeID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminSubmissionsChallengeChallengeIDParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSubmissionsChallengeChallengeID(w, r, challengeID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSubmissionsChallengeChallengeIDStats operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSubmissionsChallengeChallengeIDStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSubmissionsChallengeChallengeIDStats(w, r, challengeID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSubmissionsTeamTeamID operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSubmissionsTeamTeamID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", chi.URLParam(r, "teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "teamID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminSubmissionsTeamTeamIDParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSubmissionsTeamTeamID(w, r, teamID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSubmissionsUserUserID operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSubmissionsUserUserID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userID" -------------
+	var userID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", chi.URLParam(r, "userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminSubmissionsUserUserIDParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSubmissionsUserUserID(w, r, userID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminTags operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTags(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminTags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminTagsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminTagsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminTagsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminTagsID operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminTagsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminTagsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminTeamsIDBan operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminTeamsIDBan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminTeamsIDBan(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminTeamsIDBan operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTeamsIDBan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminTeamsIDBan(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchAdminTeamsIDBracket operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminTeamsIDBracket(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchAdminTeamsIDBracket(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchAdminTeamsIDHidden operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminTeamsIDHidden(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchAdminTeamsIDHidden(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminTeamsIDSessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminTeamsIDSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminTeamsIDSessions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminTokens(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminTokens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminTokens operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTokens(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminTokens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminTokensID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminTokensID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminTokensID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminTokensIDRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminTokensIDRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminTokensIDRequests(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminUsersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", r.URL.Query(), &params.Field)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "field_value" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field_value", r.URL.Query(), &params.FieldValue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field_value", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "verified" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "verified", r.URL.Query(), &params.Verified)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "verified", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "banned" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "banned", r.URL.Query(), &params.Banned)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "banned", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminUsersID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminUsersID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminUsersID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminUsersID operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsersID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminUsersID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchAdminUsersID operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminUsersID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchAdminUsersID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminUsersID2fa operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminUsersID2fa(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminUsersID2fa(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminUsersIDBan operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminUsersIDBan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminUsersIDBan(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminUsersIDBan operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersIDBan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminUsersIDBan(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminUsersIDLockout operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminUsersIDLockout(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminUsersIDLockout(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminUsersIDLockout operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsersIDLockout(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminUsersIDLockout(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminUsersIDPassword operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminUsersIDPassword(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminUsersIDPassword(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminUsersIDRole operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminUsersIDRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminUsersIDRole(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminUsersIDSessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminUsersIDSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminUsersIDSessions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminUsersIDTeam operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminUsersIDTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminUsersIDTeam(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminWs operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminWs(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminWs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthConfirmEmail operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthConfirmEmail(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthConfirmEmailParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthConfirmEmail(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthForgotPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthForgotPassword(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthForgotPassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLogin2fa operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin2fa(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogin2fa(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthMe operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthOauthProviders operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthOauthProviders(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthOauthProviders(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthOauthProviderAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthOauthProviderAuthorize(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthOauthProviderAuthorize(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthOauthProviderCallback operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthOauthProviderCallback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthOauthProviderCallback(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthRegistration operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthRegistration(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthRegistration(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthResendVerification operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthResendVerification(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthResendVerification(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthResetPassword(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthResetPassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthVerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthVerifyEmailParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthVerifyEmail(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBrackets operation middleware
+func (siw *ServerInterfaceWrapper) GetBrackets(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBrackets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChallenges operation middleware
+func (siw *ServerInterfaceWrapper) GetChallenges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChallengesParams
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChallenges(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChallengesIDFirstBlood operation middleware
+func (siw *ServerInterfaceWrapper) GetChallengesIDFirstBlood(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChallengesIDFirstBlood(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteChallengesIDInstance operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChallengesIDInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteChallengesIDInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChallengesIDInstance operation middleware
+func (siw *ServerInterfaceWrapper) GetChallengesIDInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChallengesIDInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostChallengesIDInstance operation middleware
+func (siw *ServerInterfaceWrapper) PostChallengesIDInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostChallengesIDInstance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostChallengesIDInstanceExtend operation middleware
+func (siw *ServerInterfaceWrapper) PostChallengesIDInstanceExtend(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostChallengesIDInstanceExtend(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChallengesIDStages operation middleware
+func (siw *ServerInterfaceWrapper) GetChallengesIDStages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChallengesIDStages(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostChallengesIDSubmit operation middleware
+func (siw *ServerInterfaceWrapper) PostChallengesIDSubmit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostChallengesIDSubmit(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChallengesChallengeIDComments operation middleware
+func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChallengesChallengeIDComments(w, r, challengeID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostChallengesChallengeIDComments operation middleware
+func (siw *ServerInterfaceWrapper) PostChallengesChallengeIDComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostChallengesChallengeIDComments(w, r, challengeID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChallengesChallengeIDFiles operation middleware
+func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDFiles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChallengesChallengeIDFilesParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChallengesChallengeIDFiles(w, r, challengeID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChallengesChallengeIDHints operation middleware
+func (siw *ServerInterfaceWrapper) GetChallengesChallengeIDHints(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChallengesChallengeIDHints(w, r, challengeID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostChallengesChallengeIDHintsHintIDUnlock operation middleware
+func (siw *ServerInterfaceWrapper) PostChallengesChallengeIDHintsHintIDUnlock(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "challengeID" -------------
+	var challengeID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "challengeID", chi.URLParam(r, "challengeID"), &challengeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challengeID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "hintID" -------------
+	var hintID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hintID", chi.URLParam(r, "hintID"), &hintID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hintID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostChallengesChallengeIDHintsHintIDUnlock(w, r, challengeID, hintID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCommentsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCommentsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCommentsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCompetitionStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetCompetitionStatus(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCompetitionStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFields operation middleware
+func (siw *ServerInterfaceWrapper) GetFields(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFieldsParams
+
+	// ------------- Required query parameter "entity_type" -------------
+
+	if paramValue := r.URL.Query().Get("entity_type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "entity_type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "entity_type", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFields(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFilesIDDownload operation middleware
+func (siw *ServerInterfaceWrapper) GetFilesIDDownload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFilesIDDownload(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPages operation middleware
+func (siw *ServerInterfaceWrapper) GetPages(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPages(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPagesSlug operation middleware
+func (siw *ServerInterfaceWrapper) GetPagesSlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPagesSlug(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRatings operation middleware
+func (siw *ServerInterfaceWrapper) GetRatings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
 	var params GetRatingsParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRatings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRatingsTeamID operation middleware
+func (siw *ServerInterfaceWrapper) GetRatingsTeamID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRatingsTeamID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScoreboard operation middleware
+func (siw *ServerInterfaceWrapper) GetScoreboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScoreboardParams
+
+	// ------------- Optional query parameter "bracket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bracket", r.URL.Query(), &params.Bracket)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bracket", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScoreboard(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScoreboardGraph operation middleware
+func (siw *ServerInterfaceWrapper) GetScoreboardGraph(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScoreboardGraphParams
+
+	// ------------- Optional query parameter "top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "top", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScoreboardGraph(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatisticsChallenges operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticsChallenges(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatisticsChallenges(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatisticsChallengesId operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticsChallengesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatisticsChallengesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatisticsGeneral operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticsGeneral(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatisticsGeneral(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatisticsScoreboard operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticsScoreboard(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatisticsScoreboard(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeams operation middleware
+func (siw *ServerInterfaceWrapper) PostTeams(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeams(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamsJoin operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamsJoin(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamsJoin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamsLeave operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamsLeave(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamsLeave(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTeamsMe operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeamsMe(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "per_page" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRatings(w, r, params)
+		siw.Handler.DeleteTeamsMe(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3088,8 +7383,8 @@ func (siw *ServerInterfaceWrapper) GetRatings(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetRatingsTeamID operation middleware
-func (siw *ServerInterfaceWrapper) GetRatingsTeamID(w http.ResponseWriter, r *http.Request) {
+// DeleteTeamsMembersID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeamsMembersID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3102,8 +7397,14 @@ func (siw *ServerInterfaceWrapper) GetRatingsTeamID(w http.ResponseWriter, r *ht
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRatingsTeamID(w, r, id)
+		siw.Handler.DeleteTeamsMembersID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3113,24 +7414,17 @@ func (siw *ServerInterfaceWrapper) GetRatingsTeamID(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetScoreboard operation middleware
-func (siw *ServerInterfaceWrapper) GetScoreboard(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// GetTeamsMy operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsMy(w http.ResponseWriter, r *http.Request) {
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetScoreboardParams
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "bracket" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "bracket", r.URL.Query(), &params.Bracket)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bracket", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetScoreboard(w, r, params)
+		siw.Handler.GetTeamsMy(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3140,24 +7434,17 @@ func (siw *ServerInterfaceWrapper) GetScoreboard(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetScoreboardGraph operation middleware
-func (siw *ServerInterfaceWrapper) GetScoreboardGraph(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PostTeamsSolo operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamsSolo(w http.ResponseWriter, r *http.Request) {
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetScoreboardGraphParams
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "top" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "top", r.URL.Query(), &params.Top)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "top", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetScoreboardGraph(w, r, params)
+		siw.Handler.PostTeamsSolo(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3167,8 +7454,8 @@ func (siw *ServerInterfaceWrapper) GetScoreboardGraph(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatisticsChallenges operation middleware
-func (siw *ServerInterfaceWrapper) GetStatisticsChallenges(w http.ResponseWriter, r *http.Request) {
+// PostTeamsTransferCaptain operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamsTransferCaptain(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3177,7 +7464,7 @@ func (siw *ServerInterfaceWrapper) GetStatisticsChallenges(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatisticsChallenges(w, r)
+		siw.Handler.PostTeamsTransferCaptain(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3187,17 +7474,17 @@ func (siw *ServerInterfaceWrapper) GetStatisticsChallenges(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatisticsChallengesId operation middleware
-func (siw *ServerInterfaceWrapper) GetStatisticsChallengesId(w http.ResponseWriter, r *http.Request) {
+// GetTeamsID operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// ------------- Path parameter "ID" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
 		return
 	}
 
@@ -3208,7 +7495,7 @@ func (siw *ServerInterfaceWrapper) GetStatisticsChallengesId(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatisticsChallengesId(w, r, id)
+		siw.Handler.GetTeamsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3218,8 +7505,8 @@ func (siw *ServerInterfaceWrapper) GetStatisticsChallengesId(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatisticsGeneral operation middleware
-func (siw *ServerInterfaceWrapper) GetStatisticsGeneral(w http.ResponseWriter, r *http.Request) {
+// GetUser2fa operation middleware
+func (siw *ServerInterfaceWrapper) GetUser2fa(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3228,7 +7515,7 @@ func (siw *ServerInterfaceWrapper) GetStatisticsGeneral(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatisticsGeneral(w, r)
+		siw.Handler.GetUser2fa(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3238,8 +7525,8 @@ func (siw *ServerInterfaceWrapper) GetStatisticsGeneral(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatisticsScoreboard operation middleware
-func (siw *ServerInterfaceWrapper) GetStatisticsScoreboard(w http.ResponseWriter, r *http.Request) {
+// PostUser2faDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostUser2faDisable(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3248,7 +7535,7 @@ func (siw *ServerInterfaceWrapper) GetStatisticsScoreboard(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatisticsScoreboard(w, r)
+		siw.Handler.PostUser2faDisable(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3258,11 +7545,17 @@ func (siw *ServerInterfaceWrapper) GetStatisticsScoreboard(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetTags operation middleware
-func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Request) {
+// PostUser2faEnable operation middleware
+func (siw *ServerInterfaceWrapper) PostUser2faEnable(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTags(w, r)
+		siw.Handler.PostUser2faEnable(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3272,8 +7565,8 @@ func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeams operation middleware
-func (siw *ServerInterfaceWrapper) PostTeams(w http.ResponseWriter, r *http.Request) {
+// PostUser2faRecoveryCodes operation middleware
+func (siw *ServerInterfaceWrapper) PostUser2faRecoveryCodes(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3282,7 +7575,7 @@ func (siw *ServerInterfaceWrapper) PostTeams(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeams(w, r)
+		siw.Handler.PostUser2faRecoveryCodes(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3292,8 +7585,8 @@ func (siw *ServerInterfaceWrapper) PostTeams(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamsJoin operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamsJoin(w http.ResponseWriter, r *http.Request) {
+// PostUser2faSetup operation middleware
+func (siw *ServerInterfaceWrapper) PostUser2faSetup(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3302,7 +7595,7 @@ func (siw *ServerInterfaceWrapper) PostTeamsJoin(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamsJoin(w, r)
+		siw.Handler.PostUser2faSetup(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3312,8 +7605,8 @@ func (siw *ServerInterfaceWrapper) PostTeamsJoin(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamsLeave operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamsLeave(w http.ResponseWriter, r *http.Request) {
+// PutUserEmail operation middleware
+func (siw *ServerInterfaceWrapper) PutUserEmail(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3322,7 +7615,7 @@ func (siw *ServerInterfaceWrapper) PostTeamsLeave(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamsLeave(w, r)
+		siw.Handler.PutUserEmail(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3332,8 +7625,8 @@ func (siw *ServerInterfaceWrapper) PostTeamsLeave(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTeamsMe operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTeamsMe(w http.ResponseWriter, r *http.Request) {
+// GetUserIdentities operation middleware
+func (siw *ServerInterfaceWrapper) GetUserIdentities(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3342,7 +7635,7 @@ func (siw *ServerInterfaceWrapper) DeleteTeamsMe(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTeamsMe(w, r)
+		siw.Handler.GetUserIdentities(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3352,17 +7645,17 @@ func (siw *ServerInterfaceWrapper) DeleteTeamsMe(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTeamsMembersID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTeamsMembersID(w http.ResponseWriter, r *http.Request) {
+// DeleteUserIdentitiesProvider operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserIdentitiesProvider(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id string
+	// ------------- Path parameter "provider" -------------
+	var provider string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
 		return
 	}
 
@@ -3373,7 +7666,7 @@ func (siw *ServerInterfaceWrapper) DeleteTeamsMembersID(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTeamsMembersID(w, r, id)
+		siw.Handler.DeleteUserIdentitiesProvider(w, r, provider)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3383,8 +7676,19 @@ func (siw *ServerInterfaceWrapper) DeleteTeamsMembersID(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamsMy operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamsMy(w http.ResponseWriter, r *http.Request) {
+// PostUserIdentitiesProviderAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) PostUserIdentitiesProviderAuthorize(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3393,7 +7697,7 @@ func (siw *ServerInterfaceWrapper) GetTeamsMy(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamsMy(w, r)
+		siw.Handler.PostUserIdentitiesProviderAuthorize(w, r, provider)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3403,8 +7707,19 @@ func (siw *ServerInterfaceWrapper) GetTeamsMy(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamsSolo operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamsSolo(w http.ResponseWriter, r *http.Request) {
+// PostUserIdentitiesProviderLink operation middleware
+func (siw *ServerInterfaceWrapper) PostUserIdentitiesProviderLink(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3413,7 +7728,7 @@ func (siw *ServerInterfaceWrapper) PostTeamsSolo(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamsSolo(w, r)
+		siw.Handler.PostUserIdentitiesProviderLink(w, r, provider)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3423,8 +7738,8 @@ func (siw *ServerInterfaceWrapper) PostTeamsSolo(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamsTransferCaptain operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamsTransferCaptain(w http.ResponseWriter, r *http.Request) {
+// DeleteUserMe operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserMe(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3433,7 +7748,7 @@ func (siw *ServerInterfaceWrapper) PostTeamsTransferCaptain(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamsTransferCaptain(w, r)
+		siw.Handler.DeleteUserMe(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3443,8 +7758,49 @@ func (siw *ServerInterfaceWrapper) PostTeamsTransferCaptain(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamsID operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamsID(w http.ResponseWriter, r *http.Request) {
+// GetUserNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetUserNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserNotificationsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchUserNotificationsIDRead operation middleware
+func (siw *ServerInterfaceWrapper) PatchUserNotificationsIDRead(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3464,7 +7820,7 @@ func (siw *ServerInterfaceWrapper) GetTeamsID(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamsID(w, r, id)
+		siw.Handler.PatchUserNotificationsIDRead(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3474,10 +7830,8 @@ func (siw *ServerInterfaceWrapper) GetTeamsID(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserNotifications operation middleware
-func (siw *ServerInterfaceWrapper) GetUserNotifications(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PutUserPassword operation middleware
+func (siw *ServerInterfaceWrapper) PutUserPassword(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3485,27 +7839,28 @@ func (siw *ServerInterfaceWrapper) GetUserNotifications(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserNotificationsParams
-
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUserPassword(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
-		return
-	}
+// GetUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserNotifications(w, r, params)
+		siw.Handler.GetUserSessions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3515,8 +7870,8 @@ func (siw *ServerInterfaceWrapper) GetUserNotifications(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PatchUserNotificationsIDRead operation middleware
-func (siw *ServerInterfaceWrapper) PatchUserNotificationsIDRead(w http.ResponseWriter, r *http.Request) {
+// DeleteUserSessionsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserSessionsID(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3536,7 +7891,7 @@ func (siw *ServerInterfaceWrapper) PatchUserNotificationsIDRead(w http.ResponseW
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchUserNotificationsIDRead(w, r, id)
+		siw.Handler.DeleteUserSessionsID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3576,7 +7931,38 @@ func (siw *ServerInterfaceWrapper) PostUserTokens(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUserTokens(w, r)
+		siw.Handler.PostUserTokens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserTokensID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserTokensID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", chi.URLParam(r, "ID"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserTokensID(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3586,8 +7972,8 @@ func (siw *ServerInterfaceWrapper) PostUserTokens(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteUserTokensID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUserTokensID(w http.ResponseWriter, r *http.Request) {
+// GetUserTokensIDRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetUserTokensIDRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3607,7 +7993,27 @@ func (siw *ServerInterfaceWrapper) DeleteUserTokensID(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteUserTokensID(w, r, id)
+		siw.Handler.GetUserTokensIDRequests(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutUserUsername operation middleware
+func (siw *ServerInterfaceWrapper) PutUserUsername(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUserUsername(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3787,6 +8193,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/admin/brackets/{ID}", wrapper.PutAdminBracketsID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenge-specs/export", wrapper.GetAdminChallengeSpecsExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/challenge-specs/import", wrapper.PostAdminChallengeSpecsImport)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/challenges", wrapper.PostAdminChallenges)
 	})
@@ -3796,12 +8208,123 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/admin/challenges/{ID}", wrapper.PutAdminChallengesID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/challenges/{challengeID}/attempt-limit", wrapper.DeleteAdminChallengesChallengeIDAttemptLimit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/attempt-limit", wrapper.GetAdminChallengesChallengeIDAttemptLimit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/challenges/{challengeID}/attempt-limit", wrapper.PutAdminChallengesChallengeIDAttemptLimit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/challenges/{challengeID}/attempts/{teamID}", wrapper.DeleteAdminChallengesChallengeIDAttemptsTeamID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/decoys", wrapper.GetAdminChallengesChallengeIDDecoys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/challenges/{challengeID}/decoys", wrapper.PostAdminChallengesChallengeIDDecoys)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/challenges/{challengeID}/files", wrapper.PostAdminChallengesChallengeIDFiles)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/flags", wrapper.GetAdminChallengesChallengeIDFlags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/challenges/{challengeID}/flags", wrapper.PostAdminChallengesChallengeIDFlags)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/challenges/{challengeID}/hints", wrapper.PostAdminChallengesChallengeIDHints)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/challenges/{challengeID}/instance-config", wrapper.DeleteAdminChallengesChallengeIDInstanceConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/instance-config", wrapper.GetAdminChallengesChallengeIDInstanceConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/challenges/{challengeID}/instance-config", wrapper.PutAdminChallengesChallengeIDInstanceConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/challenges/{challengeID}/manual-review", wrapper.DeleteAdminChallengesChallengeIDManualReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/manual-review", wrapper.GetAdminChallengesChallengeIDManualReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/challenges/{challengeID}/manual-review", wrapper.PutAdminChallengesChallengeIDManualReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/requirements", wrapper.GetAdminChallengesChallengeIDRequirements)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/challenges/{challengeID}/requirements", wrapper.PutAdminChallengesChallengeIDRequirements)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/revisions", wrapper.GetAdminChallengesChallengeIDRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/revisions/diff", wrapper.GetAdminChallengesChallengeIDRevisionsDiff)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/challenges/{challengeID}/revisions/{version}/rollback", wrapper.PostAdminChallengesChallengeIDRevisionsVersionRollback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/schedule", wrapper.GetAdminChallengesChallengeIDSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/challenges/{challengeID}/schedule", wrapper.PutAdminChallengesChallengeIDSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/challenges/{challengeID}/scoring", wrapper.DeleteAdminChallengesChallengeIDScoring)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/scoring", wrapper.GetAdminChallengesChallengeIDScoring)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/challenges/{challengeID}/scoring", wrapper.PutAdminChallengesChallengeIDScoring)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/challenges/{challengeID}/solves", wrapper.PostAdminChallengesChallengeIDSolves)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/challenges/{challengeID}/solves/{teamID}", wrapper.DeleteAdminChallengesChallengeIDSolvesTeamID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/stages", wrapper.GetAdminChallengesChallengeIDStages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/challenges/{challengeID}/stages", wrapper.PostAdminChallengesChallengeIDStages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/challenges/{challengeID}/team-flag", wrapper.DeleteAdminChallengesChallengeIDTeamFlag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/challenges/{challengeID}/team-flag", wrapper.GetAdminChallengesChallengeIDTeamFlag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/challenges/{challengeID}/team-flag", wrapper.PutAdminChallengesChallengeIDTeamFlag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/challenges/{challengeID}/team-flag/render", wrapper.PostAdminChallengesChallengeIDTeamFlagRender)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/cheat-incidents", wrapper.GetAdminCheatIncidents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/cheat-incidents/analyze", wrapper.PostAdminCheatIncidentsAnalyze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/cheat-incidents/{ID}", wrapper.GetAdminCheatIncidentsID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/cheat-incidents/{ID}/dismiss", wrapper.PostAdminCheatIncidentsIDDismiss)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/cheat-incidents/{ID}/escalate", wrapper.PostAdminCheatIncidentsIDEscalate)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/competition", wrapper.GetAdminCompetition)
 	})
@@ -3829,6 +8352,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/ctf-events/{ID}/finalize", wrapper.PostAdminCtfEventsIDFinalize)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/decoy-policy", wrapper.GetAdminDecoyPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/decoy-policy", wrapper.PutAdminDecoyPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/decoys/{ID}", wrapper.DeleteAdminDecoysID)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/export", wrapper.GetAdminExport)
 	})
@@ -3847,6 +8379,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/admin/files/{ID}", wrapper.DeleteAdminFilesID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/flags/{ID}", wrapper.DeleteAdminFlagsID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/flags/{ID}", wrapper.PutAdminFlagsID)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/admin/hints/{ID}", wrapper.DeleteAdminHintsID)
 	})
@@ -3868,6 +8406,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/admin/notifications/{ID}", wrapper.PutAdminNotificationsID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/oauth/providers", wrapper.GetAdminOauthProviders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/oauth/providers/{provider}", wrapper.DeleteAdminOauthProvidersProvider)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/oauth/providers/{provider}", wrapper.PutAdminOauthProvidersProvider)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/pages", wrapper.GetAdminPages)
 	})
@@ -3883,12 +8430,75 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/admin/pages/{ID}", wrapper.PutAdminPagesID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/permissions", wrapper.GetAdminPermissions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/registration/domains", wrapper.GetAdminRegistrationDomains)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/registration/domains", wrapper.PostAdminRegistrationDomains)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/registration/domains/{ID}", wrapper.DeleteAdminRegistrationDomainsID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/registration/invites", wrapper.GetAdminRegistrationInvites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/registration/invites", wrapper.PostAdminRegistrationInvites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/registration/invites/{ID}", wrapper.DeleteAdminRegistrationInvitesID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/release-timeline", wrapper.GetAdminReleaseTimeline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/reviews", wrapper.GetAdminReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/reviews/{ID}/approve", wrapper.PostAdminReviewsIDApprove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/reviews/{ID}/reject", wrapper.PostAdminReviewsIDReject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/roles", wrapper.GetAdminRoles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/roles", wrapper.PostAdminRoles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/roles/{name}", wrapper.DeleteAdminRolesName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/roles/{name}", wrapper.PutAdminRolesName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/scoring/consistency", wrapper.GetAdminScoringConsistency)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/scoring/functions", wrapper.GetAdminScoringFunctions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/scoring/preview", wrapper.PostAdminScoringPreview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/scoring/rescore", wrapper.PostAdminScoringRescore)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/settings", wrapper.GetAdminSettings)
 	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/admin/settings", wrapper.PutAdminSettings)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/stages/{ID}", wrapper.DeleteAdminStagesID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/stages/{ID}", wrapper.PutAdminStagesID)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/submissions", wrapper.GetAdminSubmissions)
 	})
@@ -3925,18 +8535,99 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/admin/teams/{ID}/hidden", wrapper.PatchAdminTeamsIDHidden)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/teams/{ID}/sessions", wrapper.DeleteAdminTeamsIDSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/tokens", wrapper.GetAdminTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/tokens", wrapper.PostAdminTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/tokens/{ID}", wrapper.DeleteAdminTokensID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/tokens/{ID}/requests", wrapper.GetAdminTokensIDRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users", wrapper.GetAdminUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/users/{ID}", wrapper.DeleteAdminUsersID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{ID}", wrapper.GetAdminUsersID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/admin/users/{ID}", wrapper.PatchAdminUsersID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/users/{ID}/2fa", wrapper.DeleteAdminUsersID2fa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/users/{ID}/ban", wrapper.DeleteAdminUsersIDBan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{ID}/ban", wrapper.PostAdminUsersIDBan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/users/{ID}/lockout", wrapper.DeleteAdminUsersIDLockout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{ID}/lockout", wrapper.GetAdminUsersIDLockout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/users/{ID}/password", wrapper.PutAdminUsersIDPassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/users/{ID}/role", wrapper.PutAdminUsersIDRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/users/{ID}/sessions", wrapper.DeleteAdminUsersIDSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/users/{ID}/team", wrapper.PutAdminUsersIDTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/ws", wrapper.GetAdminWs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/confirm-email", wrapper.GetAuthConfirmEmail)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/forgot-password", wrapper.PostAuthForgotPassword)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/login", wrapper.PostAuthLogin)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login/2fa", wrapper.PostAuthLogin2fa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/logout", wrapper.PostAuthLogout)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/auth/me", wrapper.GetAuthMe)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oauth/providers", wrapper.GetAuthOauthProviders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/oauth/{provider}/authorize", wrapper.GetAuthOauthProviderAuthorize)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/oauth/{provider}/callback", wrapper.PostAuthOauthProviderCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.PostAuthRefresh)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/register", wrapper.PostAuthRegister)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/registration", wrapper.GetAuthRegistration)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/resend-verification", wrapper.PostAuthResendVerification)
 	})
@@ -3955,6 +8646,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/challenges/{ID}/first-blood", wrapper.GetChallengesIDFirstBlood)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/challenges/{ID}/instance", wrapper.DeleteChallengesIDInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/challenges/{ID}/instance", wrapper.GetChallengesIDInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/challenges/{ID}/instance", wrapper.PostChallengesIDInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/challenges/{ID}/instance/extend", wrapper.PostChallengesIDInstanceExtend)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/challenges/{ID}/stages", wrapper.GetChallengesIDStages)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/challenges/{ID}/submit", wrapper.PostChallengesIDSubmit)
 	})
@@ -4048,12 +8754,54 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/teams/{ID}", wrapper.GetTeamsID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/2fa", wrapper.GetUser2fa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/2fa/disable", wrapper.PostUser2faDisable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/2fa/enable", wrapper.PostUser2faEnable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/2fa/recovery-codes", wrapper.PostUser2faRecoveryCodes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/2fa/setup", wrapper.PostUser2faSetup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/user/email", wrapper.PutUserEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/identities", wrapper.GetUserIdentities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/user/identities/{provider}", wrapper.DeleteUserIdentitiesProvider)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/identities/{provider}/authorize", wrapper.PostUserIdentitiesProviderAuthorize)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/identities/{provider}/link", wrapper.PostUserIdentitiesProviderLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/user/me", wrapper.DeleteUserMe)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/user/notifications", wrapper.GetUserNotifications)
 	})
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/user/notifications/{ID}/read", wrapper.PatchUserNotificationsIDRead)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/user/password", wrapper.PutUserPassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/sessions", wrapper.GetUserSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/user/sessions/{ID}", wrapper.DeleteUserSessionsID)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/user/tokens", wrapper.GetUserTokens)
 	})
@@ -4063,6 +8811,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/user/tokens/{ID}", wrapper.DeleteUserTokensID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/tokens/{ID}/requests", wrapper.GetUserTokensIDRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/user/username", wrapper.PutUserUsername)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{ID}", wrapper.GetUsersID)
 	})
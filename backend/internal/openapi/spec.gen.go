@@ -18,154 +18,424 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3Pbtprov4LL3Znr7sqWnTbdc7xzZza248Q9TeuxndOZPq4GIj9JqEmABwDtqJn8",
-	"7zsA+JRAEqT1sqNfWkck8fje+PA9Pns+i2JGgUrhnX72hD+DCOs/gUoi50dvHjEP1L9jzmLgkoB+6nPA",
-	"EoIRlupfch6Dd+oJyQmdel8GXgDC5ySWhFHrcxJYf5aAo1HNswccJlB6QqiEKXDvi/rO/MTGf4Iv1cvp",
-	"4s+wf5/EF1ji5R1gtTH9F5EQ6T/+ncPEO/X+bVgAZZhCZFgBRzEl5hzP1b/9GQ5DoFPoPOR59uXbTzHj",
-	"0jo4i2KQJAOny6ClLxQ89ND1+JqQsPvCL0kIttUKFj50H+1WfWUbThFF59HuAEf18EwE8M5DfhTA64d8",
-	"AC7s1N5AnznqL0BiEt5KbNhwgdewhCnj8xrMcSFH45CxoCu9aYi/pZLPG1gyBu4DlXgKI43X8ls0icaK",
-	"AwdezEgqQRa5MyWHkc8SKhte6M821W0sUQ+RIdiFDZM4HOXU1UGsLHJsN4y1ycZJiKejGRYz69NZBugu",
-	"sHpPqJVoa3BOxGhGggDK6xszFgKmbciuA7cLNEuIXIKoob1UfE0Yj9RfXoAlHEoSgTfopkz0M4qj3kvt",
-	"wal1DPYU1ukD7qouqW4AaDDS8LQSJgf4C+qfVzZYWiQRoxgnoiI6SuQUscA+Xg1+Bp6QmMu6dTRsXSus",
-	"ZaRlSK0jlhZbR+nO2qXWDBkyH9cKADHDr15/b39E/oIaStC/dIHGO6DAca3SyaHSJrkb6VPxWcNzpYjr",
-	"nzcsXku0HqhkVAKVNc9EzSprBmM8AD4iNIBPHVd/FSm9cQMiCS27AM7ZgnmyLMAWba57EscQNCIr8X0Q",
-	"wsaEDUu99RmHa2YFt1DP6gRTBELiKO5Gk3q2McM8eMdxPFuekmM6BVcbkERwo99/ihWpRgkJtZimTvt4",
-	"T4RkfF6j1hpVaU/91R/42gLvzlQ1P1dUdiftrKWC9VnD6ksWv0UvxxIT2nEDRIzGmNJavQXK+h2RoCOr",
-	"djc7KmS4tLmn0Uk2ZqejWiETujBFwY82u6Ne03cDVumYtjxNhEnYhQQ4qzs8tJBvByT/+SiP7tg90GtM",
-	"uMVPoaX2CD7FhIOoslNJWqSvSTWQfSsw4SBmrQNl79WNZNsCh38lIOTRGaaKVm/MPy3SG7AwRg98wlGs",
-	"YOv9k7BQ20KITRBPQhDewIvwpx+BTuXMO319fDywrEFNSbjizd+yYf9oWNm5tuHeXF9pSNcusO1sVgWe",
-	"yznkS/uiHjEPeq+or8usDL/yHMWI2fftYD3j2L8H2XsPRIwCmODUGMr/nOBQwMAieTPmKlHJSTuV6K/a",
-	"N3N+d/n2AWj9bspnJLeTqGW9ryzrXTzYuA3+CGQ6qwLuZLDoobGBojLdoNiWA4gyi6AWRuWDcMHqv8DY",
-	"toMAfFx989XxwIsIJVESeacFoEpSaoGkijnytaEFqra5WRY+vbv8bJwvwOFL3Tcjg5YRh6mx+ytL8X7W",
-	"f+AQ6edowjhSXyHzFXrAIQmMuFOP5IwIlBtY/43YA3BOAhCo5PJFGWIHpcX+//O7y99///wbPvzrzeGv",
-	"x4d/H/3xn7///uXfbcsmlEiCw1EuD/JhtHBthjQRIx8LGBEqgAoiyUN1iFourXiRnF7PQdr+dkSoZTsn",
-	"7dspLO4uX0k8zQy9Krrv8BRdXYgUmVDg0ht0MAlzN46Njk+8NsmWc9tgQZRrGs/3nM3jwOAsippEYP0x",
-	"enFl6YvtU14SCIMGmatsu1Hm5ACqMPWbtrRSjVWaouyagTBY+krCJ8VLqWwceAJCtaRBTl9/uMnwE6sM",
-	"Zxr4ok4yGFIxUyL9cRdCWXA55BLfSrQFItqVql1BlOA3qOCgHZ/viRv9FBR/p2QhEQijmTpZDOo9NCXx",
-	"1ca4CwDLv2z5sDcZ/8QkmRDj3OvBPsZZSrJDZ7sp1HC9kVJ9PoZH6IRpPBo2SP/5iDlVnxQOooHxQFnY",
-	"YAEsZvJBB/Bc4yajoRksAceTqp0jeWIFSicuEWEydWLsHNQtZlwNkPQ87RC6w9MGAIWMV3H6b9+P/+vV",
-	"346brM6VWMWNxzqf0Qnh0YiDAGl3lmSLKbE74Ai98VZktatT/5N579kw0yXjUyavsRCPrOHsmLs7CqjH",
-	"IZ4DP/mf9Jcjn0Xdzqw/MEKfSgyEPhAJhYehWB4+Gb/yvw2+O4TXk+8P/+tvfz8+xGM/OITJyatvv3v9",
-	"vfqllWQqwzeB8Uc2JXTl0Bt4cYqY6se34CccMqSdvPr2/7TuJB+oaRc3MCVCAq/HSCIki0ZamRuXUhAQ",
-	"c0q5rnoSG6+qvXM9DtLjIG18C3RgTAQSoMPfk+Pjb8E8+MazOei2ANCqN25p4m60f6NIupXrKDyO7Av+",
-	"CR7d1tzge6uIjFYKvwV5rvhx2ts3s+hDWngyWpaM6RuFbMx/MGadEgTewPuz6rKr2WK7C+oW5Ht9zKzd",
-	"Yn0sw5fmcZWca/Ntjc3z1AWXu2ySRHvQaBKGeKyQX7FVXIjtNhlHRF6GDfZAdxfGAnT1AE3AveOYignw",
-	"c3OB0kjz1UuWFcv0hQma1vwxDrCEN3F8C1ISOhW1q8ZxPHI+1/mMixHjZEqoqAmP0Jo7GCU8XBjx9cmr",
-	"gc0jrwQ31/bKiMV14TZKkdJgBFQRUtD4zoSzaJSL2bKd+vq1dQHFV85w0Hp9JGU4mrHEXFNH+JM5SZ18",
-	"/7fSuerEam7nN6OjByLIOKwczeNkHBLfG2Q8O/BwEBEqRoyGc+u5XGg2GYVE/TdIUmhGRAOzZSnlT2Pg",
-	"I+1OaP3sATiZzA2YhR0d6Ss9gbRA+jmVLtDgAsXZiMCC4nbOWa03f2Pee7P4XXZNmxUG/RzTSgCqJ3u/",
-	"9Nfjl369g37pjIiz42pPz3QHl3TK2AXd1evzMGSPOqJ3JB6J9Gd2AdT9Ak/zV04FbYGRbmO2REWqx0YZ",
-	"riVo0vHquocEbnbkb9kj39vT3uxd7+xNbwdjd/95xpkzQiXK3nDxojtIpzo3+snK3ehmF6t1o/dxm2/H",
-	"sWd2vxIveatbfIdd4QYMTq7w1bu9RcyogKMsVsj4l4Ob9PeVp8J1DymqT5/L3EZVW+A6xArJnyTSL6AD",
-	"MWOPFDHql/10PXxMC5B6DiAKsZDqnBc8OYQr23zZz1C3/7Kj4emOhY07EpwcBy6OAld3QLdTfoeTvSUD",
-	"wWjNOtLsedh3yQUoSMgEAPZknuzxeL6Tmbj5LnO3gts+3bi85+5cXRRdBEERN7jKDXY/q9RseYXngnLM",
-	"4XKcYQOECt9MLYiekji5+mxGt9TVOhsTT90TTnIgaaMnBU+HdNZmwGfhXLVgf1LeVA+yroucrwhjt6G6",
-	"JYuUYVL4E+rgso10yDUf/G2PZSKeAL5bPUBPIK581anyF0IZCNohVHtEb9qduTOt21KbJLqH+cro2/UC",
-	"ttmMVyvKxqp82WjZp76c1Sq0bUdydlWQW3EfNeGEC3kWMtaAmP6pd80JY/WytnPWU76fdyEb4/AGqyNU",
-	"/Y7GIOSIY3qvb5vtt+ol6IKyxEST5jYHQcOP668pkFWbWDI2nAynMojEj0Q0aPKcQrtZHVYk2MwPtYvO",
-	"pv97QuWbICJ0XRbIBjK3K5tp2McGlznwEhoy/76HEPkAvc+bvXMoq04pJS6QeoQO9BX/AKlfvunKdH2l",
-	"TtXB3AubzWCqP4w8zS/dZZPXeApKXFxJiBpERk/iy7zFzmG0rWtdBSKedP5Ykf+8Q2Bxd4uwEYpFLOim",
-	"uLsvAxYVEnRphPoFa0W9dM52q5+zyhJAxcrVWp6gyXqUS2heT37eWYtpUAzfZBjEeFpTCSQGPqp/2s+g",
-	"sKypqYBNk1Op3fDIX6jn4p66IK4TQj7jXO3ZKoaMc1tNl0UK7biFX2BL1xpqkvKL2y7bSLTxcT9CKnvb",
-	"OtwxdjtCNq8AcGTs7mYt3UfL+XIy0iehjqIoO2dZTq16pfXHmEF9TSBHKDT4kfROzAr6OFbtgLbIsqk+",
-	"DD3x7NS+3QYneGO1mp7CZiHnZmXE+wuRsw+6FE4DZ5s6OnVLTp8W1UGW97wVkDgVAOpBih9FyUJ7WoWg",
-	"yphbPNRwwBs50qhtXnM2IWH/E2yTAdbHQKrYgzUFbFetUysk5Hqk20Q1o4eTo7ecs6YTSN0NhomncplG",
-	"YQv8hBM5v1XoMAOfAebA3yRytuxu+OGXO2RKJJkgmCN0qVXoKfo9/Q591g++/O7pTCXv1JsBDrRb2Wzf",
-	"UyMzTv7C1chtHJN/wNz78kWLlAnLeAwbUyU9UXninp9E4uTb77///n+m6rc02S0b/PoK3SZxVl64uvqb",
-	"t7d3SL0xYRxFmOIpoVN0fndZDsf2Bl5IfEhhng774erOG3g6cMSbSRmL0+GQxUAFS7gPR4xPh+lHYqje",
-	"1cTAI/Hz5Bb4A/Gh9J0vJyHgaQJHPBnqt/L4XR2jfqZOc2qZXqkcsndydHx0bPzoQHFMvFPvW/2TOjTI",
-	"mcbcULuAhkVJ7jh1lS0kIGq+FggjCo9Iv40OxowmAjGO1PChnH+jgYSRoukjpN2OiNFwfuTpJZjQkavA",
-	"O/WumTBuyTdm3jz+7IwF8wVJieM4TCXr8M9USxlJ0C4naos9aZKpblE/RwGW2CvfEUieQIn9NYxeHZ+s",
-	"cJHWWBTLAtNwOIXQ746PV7aAJbFhmfoMBygHnZr+ZKPTf6Q4FQDZ9r/d6PyXjI9NHENZ/nmnv1Ul329/",
-	"fPlDHRKjCKsjb4owwy1eFpXwm6cJ3/tDDVXhvqHim+Fn9d+riy9q3VOwsOINyIRTgUIiJGITM7xwZr13",
-	"UOY8Xd9QT6iFAscRSG1Y/baU1gA4Qvo1LaGVAClEqMyGqPLNoISDRc3yxxJPdSPpbpbCAnMtlU5cQvnP",
-	"/9jz2XPhs3cgMy4YzzULNHJbmjfsrO3S9x012lk2+iZ02kLC4Jdq7MHGVNdigKGT8nKhfCfyrKUh9cbf",
-	"LdhldBKSBWPWVZinxOBEYMPPqRwPIARpuYm70L8rOnOiMfN6hcpsctsin58sm79bXvxPDJ2nVLRKhFln",
-	"kuiSJTTohjEDrgaMDZoVbPqhkilXF25KddNoOd4KL2e6cecwrhRBBWtWpMeJBekm78WZFa+TjSF8fTrE",
-	"mnTupEO2S3cbVB/NtLlSBWOw4aRgqh2vmm0YZcHk75eJut6EOS+G34QRs1Q4wGY+FLVlt3ZAXw6i3x/S",
-	"X8whvZyl78J4zradG++VTLuC+9oP5QVb1J3MN2b5lfD8H8P/2DRprXxKmx5Y53xPs3GbqLfF4PErkrVZ",
-	"QSRyRyh03TbRWlTS8ZZU0t6VtV1tZJMf6528pzBJLdDuqjD/++riyzBvaGq3Sz/GIcOBQOo1hKXE/iwC",
-	"KpFkCPc3VM+LFVwS08jkiWKptKfVyacoCSWJMZfDCePRYZC2xy1GWyzZYovfVhtU4Eo0JL1BEZI0JhTr",
-	"ij8OVXTLWLaNP4/htKQcGEePnEhI4vbShsRaVmj1vkl7MdVKYkp58r2h/qwNdSM4jNyQ7KlSKm/m6nID",
-	"oOv6mAs2q4Q66iii3qcVuHZRRK3qVF+uomQhAvV4i2f55byovYh4MWf5rIlFvVSoNuJtvAWYJGFYKb2o",
-	"q6xP3e4DzisxQhs4D1hS69frwO98T6uhVo2d6npiLT52c9IvYmHtp8flgolr8Ko7l5JvNYU27FfvcyRp",
-	"pJcyYyveFK1MjcMQBXOKI+Kn/CxcGdpMsNHglYWaCB2iV7bB4FpcZlBqRdXw8z3MHRypTmK37EU1w/9D",
-	"F19ov5gzRRq+0htyA9ruF+TmOzSeo3uYd+KfzaFlLUq2yo7P7IK8gjV37XsLEjGOkkwgt3NjoX7Xj/P1",
-	"qfSlPh57Vd5POdzmtNesF+Tk0NQQcY6APb+7ROYTRyEkJ2/NDJtV44tl6nZbkRdQ1YC2SwoH50k+jqvH",
-	"pIKdtQcfLPQc3lYI5TJx7EYMZY/jd45wRz7XV+nDCaE4JH9BvU/uMn1DFDMgTAPEwcehn4Sa5kwGKUpz",
-	"VbuS3NVFNsk+rLIOyxmEHPEMn3RiVZ0sf6sfLzS3wBIjLNAPtz//hMbYv09iN8FuBmtzrF5RP0wC0FVv",
-	"zFyEIsg+1Wj+VwL6HiXFMzFf6Fq2SiwVKG5v7lo3uwQcdZtdfVEzu70dZ/3kOuGy2+xpjuZqNo/zXCvn",
-	"+XGWKea+/XWeBkwxvaMzTZ0XWGK7F1c9NW7ur/0O/PWGPehXVAKnOES3wB+AI/1BN0lnxElFNBlp5CDw",
-	"hn+ZGiOdhd6vV9cIc39GHgA9EjnTF16ii/z7lcSuIrC439WzODPjJL1jXxcvpsArhm+94l4mgBIgvUGa",
-	"UaynTtXr4QURMRP5LUAxWamBXh6f8N8aQgoM/+93z1DB4avjV98fvzo+uTv59vj4+PjXo79IrHOYl9a2",
-	"Z/6Xw/wpkzbKgKLha9sJyS91dnW0Vi/N4Js4HVU6Cm3raFQthft8z0Uaxw5k0yFtzJ16Sq5xQz/7zLFW",
-	"v3gdwlpTiDowdSI3g5N133l2lxTHW5AUO5dB1OMu1EWMhB0SFHRA1YSzCAnJOHZPVNCBlu0R4DqccJ+e",
-	"8DWnJ+iI0CaK1dF4zhSr3nbWdjrWrp1KdTzankq/ZiqtCRtr0fazLJDRTdFvixzXrf9XGO55vKVwz32e",
-	"zD5Pposh1hpmSqLs6sPuBbiKatyA2hr79era6fIj9wuY4bwV5p/4aSL7KGt9tMDU7BFJhmaYBqEJCVEv",
-	"6/6saZeYCLgO0GcPwHX6iDfwxD2Jrd1hgGMBI/hEhFS/LHtN1XOUPTeQGsOEcUAk2/pyEUx7Dk0B3Mw4",
-	"cUiiSTu1Z+7PpUH/mT43JrU/A/9eJKZUX3OX4idkzKz8RsNQ0Q2IJLRKcvMc8fSFvcB8HqHxKdo63mXQ",
-	"UllbJ3dmev1e/s5Rev1UmWoTzk1bp+tt+Tit9YOfr6vTQgbudDZMBPDhZ/Xf9EDYRnUxcMHowoRpwpYa",
-	"pg8JfhTAP+olOPnkkuzVHUvDWi5OvV1Cry2W/XyJ3Up9Hcjd3d3vLlZLDpAKVe+9/q1ugBYstjr/O+i+",
-	"RG4UQ+v2AfSWM8fbU6gv4UbAWe7EOC0Z5lZYOAyR/sIt+OQaZwXDNhZRXelctvtpUXEKoX5x1DF2Tjov",
-	"ULFu88JgYLsmRZUKXm7pWd3Kq5W9O5gT7RRVMiM0Te3Nh1bzoQZLLal06qsuhWY3io3jzfPsTmfQFcjq",
-	"Yx86yPFkM0hetz3YWTlskdBedE3ZVs0hQOa93ZoT5+MYZS+7SarbbOhNYPtNHGfz7XT9C1EApav8cEZA",
-	"JkUqCFg3y1cQsM+XXUHpi1aCKXFx3u2znZFjPCVUmcWV494kxFNUGsaRxUvz2nXVQhpBKo8s2QMnA0v3",
-	"yppBsu621oFeHVtG2ohpU9MUuE0M7S+w3A7RokJsTsxQVIGrFoHrwSSlUbsVhLPwSl7g7bxSx63d1utb",
-	"922w58Z9+M+LEQZlVhzPHetBOkiFoZDYofhEMRJSHxAhib8emaBbd69VMGyYE6u9yPes+IJYUfNCT37s",
-	"1v6wWTeP552aIZY4sKkj4mo6H+7V8J73X6wabu2+WOb4pUCfp3J8e7CPhePXH+mz5/g9x79Yjlf80Mjx",
-	"5olbpXWJp4533Hd4upkr7js83fYNt17Cs4+Tk3jaSicdbq9bSaV0ea2IZX933Xp3bcdQ641mO9MmchNo",
-	"WPflRldJcLxxSfASotlaxQTgKK0ZOMa0SVZ8pGNMhdNBsCwr1PhXF2eYPqkl/oZdMKu/EtvnIe58HqKi",
-	"77oTV11Q4ZkrSxSW1vYYYn0S/QzrfTWkLJ9hijhgkTeN2Pn76/3ZaS8r6mTFWb2ksKvWtA21Ordh6c+s",
-	"BeGFqWKatVw/8LGEKePzb1okixqwIlryntfPzTC8Ban2sCM91I1Ee6Hm4S3ICrm5UvLMrMaFkM2r+g4j",
-	"ER1p+L2Z5uVoyFuQZk+NZT1KANu0miwl+e/15F5PrljKzBZI2yprEjkbThifMnkYYyEeGQ/qPZ23QAOB",
-	"svcQBwESQYRJiCRDIgafTAgECAcBByHsBnkiZ5d6wutsvvVKgepkDaLgrd5Isfa9xdwkCV79faPT3zGG",
-	"PmA6z9YgDFPkVJ/+vECcZapP5AyozJIMS+Qfsimh9URf+hCEKblvejaYO70ffrlDkt0DrSf3H/UE66Vy",
-	"PUdTr3cOgdoFDsVGC1j9+SiP7hR4rjHhe71m1WsVQv6oCCxMKaadeCNovXUm1FQGIowiPGaJRLhE0kF2",
-	"/bV8y5zI2QfYSNbDB9j1KOPOPcoSzoFKIy8UBpywyWFKhATuetmYjo7kDJCYCwlRrRC6yYZerxzKpmkQ",
-	"ReYVs8QtNlAuVvo8+idvVt9Ws7VKatYALac+R7IWQIPDB+BFln8thd/olwUqv10YmQ6iq6B4NdA/y5Pu",
-	"ryh6ijQDSwtOnPHvcr5Qs8jSASMRhE6NddWI5U0dJSpzNUg4bfBoK9GUPagsbn+kqBNxJ5ud/h2jsCTe",
-	"BMgywtppW7PE/NAwQ50pZoRQdnwwwqxE3KZcpg6LQ7nzrc4k02PN36bM1+irK8u+nI1sIXjZs+d82/u1",
-	"066hCzepnDqgu1XSyT5CB9q9lF6VEBDf2Ej1LJtio/V08muMbiV1viya7/leFxqH5rsycMxTBLpBsvjM",
-	"NMjSnemC1FOnM3CUnPi/IrvvWgLueTFve+8CZa6VZhzPkcTTkgt/URbo6JX1cX7HlrPZwntXSXouB8YC",
-	"Qws0V0L2ItVljUe5kIfjkLGglQzVSVG/b4iOa5KspLo0ENvVxaX69EzP1EJ4+Ve7El/k2H8l25+LQ2Kr",
-	"9wEV6jEoHaeIcaYcHQjdUN87t1t0Wr0STKXEwBssAYUkIvIUvUZYSohiZb0DRxGhiQSrzV6mplsz/VYo",
-	"aY3XjnpXl+FCrOWCXFYAlcycqOb7o8H+3nEX7x236XfamTsm54tXzfdaVLrK4ErWts+iSHeNb9Ph2YuW",
-	"XO0HTEI8DkHHfCA80bZfqWL6DAsENIDgqFnTl5K3z7NlPVlMbyu5u6PFafb73OzNLQopdFAmMcqkIbFv",
-	"ehjBZcq25V/nxOhSczQdbbVsUrVhdo9P1p1MlrPHdhPKlrh0ty9v9pLhCZIhzb4rs3OLbGjUs3nvGyd/",
-	"jWkrbtpoQ6CvoFyPyyXhcJm2G9+SZBhYW1qqt05LHdQZR7rFkW6pYnUNqWGtWdpeBSHr0t/2U02lX3t2",
-	"kHnhTiKU9a/vbmbqTpXO5K/fXtagaTIoIiILM1SvUEYPExoy/x4C86W7manbCn4tNqbpNfiyHZoF5diE",
-	"tUG3C6kOP6v/qX8a0qr3Vn3Uz5Xlp1tajudIxEADfc0GOEIxS2nM0aLTa3yvJzdD75AAb2yvaQC2e97V",
-	"KtnvfU01xtqrjc5/RUUymRCfKHmessjX5nV6E3LAwRxlyqtrFqv6arGbZkXCpaapc3EI9khze9cU1a9J",
-	"+M6M3vaGvOmbO9Ui+qs5BqmjT4ZP9kiBf/OMMkrS2hrp+htOXMVZb5hmnTi4MvPjYXr9fRAn45D4A0RN",
-	"tJ81uuC8+O62SN1at/5amrVNmX2xOLgW9lsFZ/YwheiEQBg4QDERkkXIvK1NLl6OsT2YmCCA8RyZFqkj",
-	"xcxWuF6aCZ1qYJfGapQcWSvdLFwUcGTpnrthC1xv9MlhIinEc8CiFBgZRlNwZsgMs3vXgD3SkOH26/qY",
-	"gyBTCgH6ePOjxqzuzJt/b0VhCOLq4qJ4pS1ABPYlQNalcJ6FfFeUnFGUorMmt8JSP10nL4Klm6qw0e5i",
-	"C90XXIm/m7iyNwPsKbVqsJHhvIoEg/Zuffe0BhczCEzDOHQgiQxhgESYTK1qZ0ud934kQl5JiJ4M0cUN",
-	"L0Rwme2VIDn8rEDhVDBU92lSb6eGkZ6F0XBeD8jbMJk6VaIQ5sVn2lmrvTCDtedVumkbbhQsXXoIpQyk",
-	"nUrpN+ggL+5qRcwNzrqffO3dRd5p4KXwcKmsWie/OF5sKJMBuYLNtCq3S31e80Xq7dbYPUjn+k8UAz+E",
-	"B6CyCb0dym7von9OV1/RO1kD/5W4pRZlwmccxgzzwOHMYxIsi0/0gQqQYFxCoDjdOLOQ+t64gY/Qz7Gx",
-	"L7MA7xEJkDkd5bWfs8B2e+nl22KFbpHXpfWN55ZCQ/WB2EWVmAKNJpPXO/WShATetg9RBTDeUsnnT1aj",
-	"ogzcjEJKEF8kkuGU43jW3uiiQIH+QCedmsh7jXBJIggJBXN0fiAiwSH5K8sYbCCBd3r6Fjr4KYnGJs5a",
-	"slhPKJBkiFA/TAKoTciJaxTApuW2OdgeLW66Vi683rD3/opK4Iqjb4E/AEf6g0baMkTQSGF5O5QuGR6L",
-	"TVSqiR4G30q96MB75LPEdhml6Csfp5LmsX6+TlGdz2ratjhfT+4Q5vvdqRcILBNH8WMDcQw/k6DdvghA",
-	"YhKmmT6VfjuC0GkIpbUcaCoRg3Jk/0AZIT5Qiad2752Ncq4CJ3OEBI3myLr1TheyvNBQTIlzZy8RN+v5",
-	"KW6eKZNokt2jPXuWNBzTnTOnQIHjsP0kZ95DcYilovEyZx4YEa00dyKAi4FR3oOSVB8YYS5auPFdupr1",
-	"M0k6UwtzPFOyyJDVmRo6HCtKZsKMCMn4XEtoYzdWTMMjdGGMMpMDhU6O0UGEP6HXxy3UUDlCbEyrF7O+",
-	"N/vSJvuL1+7L+GyimazRh3MmrzQ9PJawnff22NhZrFJXv+f5q7SjDER6IylwlPRrr0ykm6Do2ty6lNUY",
-	"fBaBQD6OJSb2Oh66AOlmWqE0V8zWxU23V5KorfrtPqK9uFnbZjmkrm1jqlWrDbWXeGr4J2sqQPgDIzSr",
-	"Uz1HhD4QNWZtURw9vPpmzQylpmhhp6vqWne9nvTXy0xfZYJpF05WxN7OxyHgB6hn5B/V49xxba3tkTOw",
-	"ftfbZ4HvlU5XUjVU1kqrETTGoRIxxjQw9ULKFIsOzo0hV3MHbWIF9XQfYF9v71m2SEyR70JD0Rh4e1Tz",
-	"P4hJyzDvm2pnirA6E5Serj3U2bxozh/70LZ9RYwtxdUpsk9pvpmN5j1qOJcLDKeVwo7Q1QRRpv8e5AXK",
-	"vzv+znqTbVhq7m3KDP+FyFnKwbte7HnzFKZFFRHae09oGn3S3dsVzduFtmAhc6kvrd4zEnrCuBbXunjl",
-	"wS2E4Et0qx5/YIHlJio3YtU7u+DWUYYU4VHaE2B/8Ezh1N2TkdNEI4VJjqmYAD/MfH611HaXvpkF3hhD",
-	"gLMQ6okq++Y8dyiuk74WZmsgsp/gMd+BxbjYV/Tal8R4ZvZLzp0pWYsZiRsZ3ynIUrN62Z7R+Y21Fkq7",
-	"tb9LTWxXqhv2ySuOZk/mG9fItpGnslx6pq5UvjLlDEqWt41slS23T2Spof1F4PSugrHKUhXasO2SDrNM",
-	"Tyavj4PJ6avp0/gB83tRmQhhgfRH1u6MS5R0dXEDONhgfHlPBKy2b6YCWx3UWrGU9uZyZfc311dpOy9n",
-	"Xr/Lun9tkI3eXF/pabfOPj8quEXzEtxKSFHQcarUR+GxGOEI5elPIVZy6pNM2yQw6oO9It8CHtZ93izA",
-	"v90qeNk60sCAbrEDT22f2+P8mON4mUgWGLbVp3wDD+xeEQ+tjGpzHhfEsancHNdSGJuToQZcLghwNONN",
-	"lQaLYzLvkChnQHjW28Avh7JbxaiDpb9LDn1na+easwkJ4XnVk9dIjM3KF0gl066P9Ur1rZDYpKoK9AuM",
-	"b5nOefIZpeBrSjElKnB4KEkE5Ri9JA6USrDRyC9LKvbExkS3j0T6M0Kn6JozyXwWLrYKta2otMe3D1lJ",
-	"E81f/CGjxYSH3qk3kzIWp8MhjsmRLych4GkCRzxRPwwfTjxlmRdvNr34x5f/DQAA///X8F3FQmUBAA==",
+	"H4sIAAAAAAAC/+z9eXMbN/ogAH8VvNytGue31GHn2Bm7tmody040k8RaSZ68NZO8LLAbJDHqBvoHoEUz",
+	"Ln/3t54HQB9knxQPyen5IyOzu3E+9/lpFMg4kYIJo0cvP410sGAxxT+ZMNysTl8vqQrh34mSCVOGM3wa",
+	"KEYNCyfUwL/MKmGjlyNtFBfz0efxKGQ6UDwxXIrK5zys/NkwGk9qnt3TKGWFJ1wYNmdq9Pnz2P8kp/9h",
+	"gYGX3eK/p8FdmlxQQzd3QGFj+Bc3LMY//qdis9HL0f84yw/lzJ3IWek48impUnQF/w4WNIqYmLPeQ77x",
+	"X779mEhlKgeXccIM98fZZdDCF3AeOHT9fc141H/h73jEqlarZXTff7Qb+KpqOACK3qPdMhrXn2eqmeo9",
+	"5AfNVP2Q90zpamhvgM/s6i+YoTy6MdToTUgNqGFzqVY1N6e0mUwjKcO+8IYn/lYYtWpAyYSpgAlD52yC",
+	"91p8S6TxlCl8S3JHQdax04HDJJCpMA0vbI825W1sQA83EasmNtLQaJJBVw+yso6x/W6sjTbOIjqfLKhe",
+	"VD5d+IPuc1Y/clEJtDV3zvVkwcOQFdc3lTJiVLRddt1xdznNwkVunKiFPUe+ZlLF8NcopIadGB6z0bgf",
+	"M8FngsZbL3ULTK1DsIegzjbHXeYl5Q0wEU7wPCsBUzH2B6t/zsPqRXI9SWiqWVgNTrEMq8eruZ/xSBuq",
+	"TN06GraODGvz0vyl1gFLi6wDvLN2qTVDRjKgtQRAL+iLb7+rfsT/YDWQsEqKTzqcxg9MMEVrmU52Km2U",
+	"u+kFxLOG58CI6583LB4p2hZXKYVhwtQ80zWrrBlMqpCpCRch+9hz9Zcx8I1rptOoYhdMKbkmnmzMvSFz",
+	"3fEkYWHjZaVBwLSuQsKGpd4EUrErWXncGp7VEaaYaUPjpB9M4mxTSVX4g6LJYnNKRcWcdZUBecyu8f2H",
+	"SJEwSsRFhWjaaR8/cm2kWtWwtUZWuiX/2v7wUQLvj1Q1P5dYdi/ujFSh8lnT6hMWXCk5jVi8uYeYaU3n",
+	"1aeVULOonkqx/065YuHo5b/tW+NsoN+bF3KzEsGlqVoJDTzdZyKNYWTLXkbjUZqE7g8RLABuw9F4NKM8",
+	"YmFhvgLBapI22kmhnWIy4ywKe1IbpFD9eLY/5ZL0O7qiZkHkjJgFI9mKT1dxRLjQPGT4IE0iScMqEU9H",
+	"6bzz1eHLboWFwxv7K9k4kg53XEfCnchQjdihWk1UWiNcu/uu/DC7oF56dREcK+4ysTiz1bge36pU7AyG",
+	"qxk/wnpYwzeLt+dPK5PDRvnXlajiz6mwtYabLBgKKsT5xFAuetI9ridTKkStuMtAaZ7wvjjXX1spca+N",
+	"zT2Mvfgxe0FMLkr04aU5G69SV+oVhH6HVbDubE4TUx71AQElI1Z/sA1cr8cl/2dpTm/lHRNXlKsqPgPC",
+	"3oR9TLhiusyFC3joXjMwUPVW2EwxvWgdyL9XN1LVFgDNmTanr8OYi2ummbmiWi+lCq/tk81tJe4F+Dvm",
+	"4icm5sBX/jpu5QHuu9/b1vEBaQuAQ+0iMnjIDBH2l/Eoph/9kr49H1fShnum+IzXUYciEKwNVtju83Gv",
+	"400SJe/ZNbvnbFm7qUDGsVONyjz6jX1ANPzHSGTJAMdkyc0C/3XPVMgDU8WhcwF3jfHj7zDcXFFhgN0b",
+	"RkMvC8zSKMoFAmJt/2DGpnESsew8eAwi1Pl4Ax4bj8MYFifmJx5zU3saMf04ofbFitX/qqSYE51OY67B",
+	"6qsJo8HCnkpMVySmd+wVSUUEc7CQLBdMEBlzY5lWvo3CLp6PK7BqCRNNEiZoZFa1p4iTL9fWRECf1dlt",
+	"Fad9fo6Q6uY9x/9tf57fUwGcpvYoFaPaSbx+AaN/chmhAQQuXKUR0+vIc96G0W7Y35tX1ojHVSu7SWhM",
+	"aGD16D2sKTMhvovovHZlYAPevG74xOLCmEiFV5sAkCpBZlIRxebsI4FPdfG2cbBPNIL3qOH37POohZgg",
+	"nQqoZhMuNBOaw1fV9Mr+kisz2lDDgxHsd84+VqgtayeGT63Nu9uxXduPgSLpeuzlYpIZJ8pnCKBK8BkR",
+	"jIUsHJNzPD0hBRs1Y8F4lCiGq9fcsAq6kK0yxzsSp9qQBb1nxPlPCnJ8xj/SlFcqOBtCUomnlRbT6fRu",
+	"ggUL04jVntyCh8zx+JqtEa6J9Q4QOqdcEGqIWXBNDI/ZKyLYPVPrBK+bwV5Iw2eriRQTxSJGNasieNoQ",
+	"SuaRnNKI4AfcWlLtlCU9kswl0yTi94XZCqDrJmnZbTbG2j7xJx7HLOTUsGi1zZY/d7syCW/Xk4pU5CaF",
+	"DOcjOaeKm0XMg3ZsT6hyxjEahugZoNFVeZJsNzKdRoWt5C7AdcVe0ZgZpiy90uSOrVhIpiuSZE+cJp4t",
+	"Gi4hoKvRyxfn4xEX3HAaObKbc8nz881TW8OL7ESynXXDDUMPTpU1OlaleNo0GU+u8dS6Wxfar+UzntSl",
+	"Hex5hUGjWeSkliwzqoTGC4JFRMxwMce7wyspS0vnbWwhc8NlH41+YECo9IJFa3qBH65Rli9dBw6e7Wvs",
+	"DrTlZsA7DUpJuxKTr1mw5f91/zoNZFykYl0VnKJ+1rwtO2L7LlpVwiBVigkzaZh6DFubbKs7lr5tX/AH",
+	"p8LVLrio4+WHn0R0xdSLzTPuAyvZ0M3LZNS8FjRaad4sQ2VOkzIm/SiXJKZihZikrYSDioexGERj5tQR",
+	"AFVEMo6q45ThL3MWviIhm9E0sjqg23VG4gv49qJSLeIilMuJZoEUYc0Cg0hqRsxSWrlLg6ZBC8IBrnrK",
+	"mpb1/Ly4rq+/K3OiflrSGzRevr66RJtN7am3BYeUzTDd5CodyKRi9NEN/m4VcBZmqj2s75RcFM4BRLoV",
+	"CMgnFCwkBAd8RQr/0MRBIQ5hHygZsdOitOv5UaIk+MxfKoZWff/PpeIG1w9Alf0ruzDt33eGivwVC3qj",
+	"8Qjn9f/vX7f/mGIkYKUbpd0QuXaHEAK49QVuG+JYMokX5shH9N//3rqD7xUN7pjZeg9cTxya2LfdnzMa",
+	"aVYlaVcYtJ63K9PtdAw38+b23dt7Jup3U4xp6aiIbK73xfn5uMbO3HPwJePzRfngnm+I01VHUZpunG+r",
+	"wxEVledqHlpwJeYs6Vc2rdqBk9ILb75olZDWQCqfI9e01qC6Kixu7dPbd59ssBxT7HPdNxN7LRMr/G4Q",
+	"wfeJ1Xe8nC5VxrRiakCu56FVMGco3HOd85BXRN4zpXjINCmE6HoeUhL3/39vbt/99tunf9OTP16f/Ov8",
+	"5G+T3//Xb799/p9Vy3a6zySjBwW7Z+tJ16gJ2RC1WFqK+uv0enak7W+DNLG5nQ6idS7N9/nK0Ln3sK2Z",
+	"f+icXF5od5kFY0GRUbX64irk/RyOn4/aKFvB91wGeoTxgqRv5+mA4JYlNtj268Ke1lfmXmyf8h34yBto",
+	"ruFmNVnXREE8dRyrkhWj433jK8M+mtHY08bxSLOIobfBw9fv3Wj480oaLvHwdR1lsKBipyROM+4OKGsh",
+	"YhnFrwTa/CLamWo1gyic37h0B+33CQF1XeAnh/hboIUcFGqISa6iYz6irrPbZuPAsi/b/RPbgfEvBRPi",
+	"Fuhjg1u5EN1urTEc3UF9NsaIi5nEe7Ro4P65pErAJ3lAn4/H+b2rJaH78Vw1GXZajiVUdFaWc4xKKw+l",
+	"F5b4mJ9WxM6OukWMqzkknKf9hK7ZnGujEIAuZEy5uG6yr2+Gf9EokkvkBGJVScmmVlp3SkOZRjlJ3rIz",
+	"VMZdkAxYWmNqggWYtTDd5RXBmay3jUgRrUbjdgdEiFsqI34q+CkL07Kl4sW337adrBsrC7jqd7iX4p6b",
+	"JmAMK/wF9iMCD1+ROQY8Z/5YFidmVd7Ed9+Md6Nyg/s41VVK9y/IxdDtWdic9RfBMlF5NrrBe/wwC8S1",
+	"bIDONsXPM9Xyln6SS6ZA5iQRM4YpPSYhn3Ojx+S30clvI0JFSH4bTX4bjQmqMACTGDxA3RdroFQ2er0Y",
+	"V2Yledd7nyiqaq5ZHKwdJm+YuucBezxmnNdFM8yaMUfbxTqjzhMwxjRY9tcuzx1H+4XdNnh0AhlJVeI5",
+	"o//x3fR/v/jreZNdYCd2i8aYiUCKGVfxRDHNTLWXZ9OCDCOS16Md2VXAjP1g6ejJiDsXLJCrLZx/+J31",
+	"/r0i2kjFQgK5e+U4H/z4kzg3E7P4eqK+/iaanIuvd+nzE9JUUOZfF8yZZENc55JqkkRIIXrHtrS6A/Eo",
+	"rmTEg1UP8ceFXUDcAWh0NdqcWSimFzKqEIEuuDZcBMZuMfPvFZ0SUzaT3jSNCyA0SSLug46q3A7PW3WM",
+	"LGo8X1vz6UTMsNc2sKhTeGOPYIG3OqARGvoYNZci4GGTNSAPe1oTJqkg9lnZDSIFA1+9d5ByNz6546Ib",
+	"GNWu+51Uc9ke9FnhqrTesudr7soeU18KbagI2BugtPVYz2OXNrImV8LP7jTsOARiLHnIlBVxrNkgiwos",
+	"EQMn+K1OIQswOkuW4mTBaPISblCbdqJgTFTv+vL7IhGfMcNjWCHxbxe92n/tCe/2JMqzNwH83yUXD+Vx",
+	"HMX3PKY4P0P6fPoi+Dr85oR9O/vu5H//9W/nJ3QahCds9vzF1998+x380soJS8M37eUnOedi5+BZ9pYX",
+	"QhBZkKrM7/38xdf/n9FOwptxF7dL+Y4GRtZHReaZQ/VR4dXa1ncnKPeT2/e3V1aZkYpQolgg0YOIXxUx",
+	"wd5Vu710bUVu/qa9/izvUYJphMCCL27dSqzmzCDmviICYpEVi+W9C+sDdZrMlIzhX1x5BF/XpeE7Oo3Y",
+	"mt2jC3F6/zo1izc0ikBSblV5q9xShnUx9obOq2SaDxOXc+XoWz1vT81ikqqoQkNJzUIq/of1ozARooEb",
+	"KWQSQQghTvAiI6G6CldoauQE3/B1NtZC9lBqJVT46F0CPhuutCERAH4xxNyeAjA0SiIu7lhIkKVxs8qn",
+	"LtChIOJMmNpMOvtUs0CxiljCGysVMhGoVWJYeEp+YhAOivYH4K93jCVW/7eRLMSNVGWO4RpIy2RT+P8g",
+	"OJYgMStyc/O+6lskU5Mgojyu3AYTAK01eQnWsowfNwYKNle7GP1ME10yfBAcGF0yRhI7PglkwlkI95fd",
+	"t1UBNiCUa50yVWG7v7x4Q+xD8uH6p1PyK9hQNDPjDPw0oYqRkGskTixEKwVy9NCSGbBtZ8kaRaq1MCbR",
+	"L8/OtJannsBbe5jZzJ4JuWKB8XixOUhgZqcFLnEmAY3OAof7zXaAHunhKR5ZfvtruAM/E5BhC1Fw08iR",
+	"ussL8szJgyBRf1W1KDwxv8vK1CbQ5xpfAJiuBc910pUh5NoZN5Gxa5ui1Gy52chjyq+Mrf6+mP4Q8Pf8",
+	"75cf/rh8/gu/1Jfi+tvgzeV3l3fJ//efb/7+t9PT01F7lkBxiuYVA6Y00Nwg1UbGhRzeLfHyDY7jkNFF",
+	"7D6zOM9DcvJben7+tUvD+aoKD7cXgZwMVi1QuGB/MIHyiJUJB9c2xCvcrWA1bgzPe95Pybhm8MsREq+a",
+	"FyVCKxg12jsKwlF7mgL7aKpSLT4aslxIzcgnDNT4/Bn4fcCA0DBlCbBi+FOYbwom/osmzhfeYuJxi2xG",
+	"Ikz3qN0o+xhEacgKSbtrBgYJiQbEyhR5LKF9ndgQySqhwY+bB1V0Gte+Xjtu8812SaFcD4XNYfwXtuyG",
+	"Ig1JnqXbaSVwLrnhSjWjCItooqvu5mYBMOSSBtk9IsqCQr6NJrBHSO9BSf0c4zlH44pMhtwCVOljzjMc",
+	"hkyLUrG2Or+WA2UQ52ScpMbaSXBxIPJvpHH64/+2p0FiM80jW10jyDHTYvNpc96sh2muPZlsmrbdG+NC",
+	"Sob7wUZOAHaPxqP/lJMHa7CqPcrzhpkfkYo0pXnVlHf73DwusI228NGyw3qHavENM5jP2eTC9Kn3faLm",
+	"8ZvGAwWw+kHRBqNqqdrNhhXeZqbhO2RBk4SJ9VhzgUEAD69sV6idU5H4aItPkECxEF3LVOcLU+UFZdKG",
+	"q4KxE158gwb5Tn6W7nGefR0VraKPkoYaVqvQ/+DiCAglgi2dtj4mXPhgUTF3IfOwFLKgIgRtNgUuT2ZU",
+	"VUoLhsVJRKucOLBU4h9bMYl9pIGJVmiZR9k8WMQ0+MWJ6ch2x+QXMmVmyZgg36Bu+903o/HaqSaKzfjH",
+	"ST7EX/HPDoecLbfxoBUVesbUGwtBjRJJudbKjg29axM0rtnbR9/IsG+0yd7sn23WTluz4nWS3DAD4Fef",
+	"1UOTZNI5NDKQSk+k4nMudE1FSHSthl6vL7qCnr+otIfkGtwE1TddSTKLgT9WzdO7yzMuLUImdWVON17r",
+	"sFR8bW2l8NtDM4UzeJi8mFGIaJ9gJIWuW7mGS2k06Ll3QDieZNp7SzxX+avOYAQfmQn4jRYyVb68hhPz",
+	"vvtrWw0MndXSm4AtcBqVgoOTdBqh5O1EGhdjoicY4VblS7ZO4QlGV03C1F1wbKPcWpZS/DRhCmK8VPtn",
+	"aEVc2WOuuTL3ypaHtO6a9ki+hsJrCFsFBBVX3E54dptPdLD8Ibv4x5wcY1cYbpcaA0wPngyZMX+ezJhv",
+	"H2FmjAdi+2jr3JgeSTFriN2xPME6W0e7ZF4SICvhcKAyBhtB8SVrC1uSP5iSJ1OqWUgSqbmvplSsYdAV",
+	"gDpWN9hhFYMO15eTjXppFiLaMUt9opfcBItq/tE/AxTJY4bEbZXQu43ZUgYdHltZZi9V0jvWo9mCgTZn",
+	"gh05pWvrVK3m9Kze6Vjtx9g/AcsTVki/Iv6NLmlYHZhLXR7W853nYdld7DYPa5u8q+PEHdvd7yTNqjWv",
+	"6hHnUtljeFBuym5SQrrmgtgFd0ot2H0agU6k0OzUFxOxkU/htft95826tklWqasJv0XsSuZsXPN7QeQY",
+	"8BFr7yTP9EIuBZEiYF+1my8bPJNrp+sueOvT5UnlzzEzCxn2KUhvI/XSzs2KKnay5Rb84+nq2PATUW3A",
+	"6BJumTnVI2LKZk5V6EnK+jHLuVV6DDEqwQJjGsCtb7jNwqLEJ713crT5K8NKxJqpn3gT6PUrCL85ejZy",
+	"xQEk5X4JRb2BqUn9U2yr1R9GN5a06VbEgIteF+8+yRMeWnr7dBu1dznwlorwrUWhu5QTb3GrNpYXd+ZH",
+	"3usc1mPpw1FhjnFWMA7XXt5j8UBKN9BMj4vejToYKbo3Hu7O6OG+OIzn4RG5Cjq5Brq4Aroa/PvZ8XvY",
+	"7mubU9Sxwy3N+f3oYalIeS3fbmvysosy5s5TZd1WWVb6aNx6cB3rYe2yxHmbPlo8r/Wpm6mPLfp2HAFq",
+	"391ys11mjpxu++x2wVvurqtTqFP8kNtfXitulxvsb16s2fIOTXnFOnObteUaTqhcnn5rwrPNOdYDQr+6",
+	"xx0ElTVC4Os6VczUXUDJjs6nYD7k+KQQDKMbJ2iPqij6ECqmMVBLWaJdyEUdu9wiGzXPDcTK+8IblQbC",
+	"bYB+h0aBTK0tVKz2i1WpcHa4hk5o9ZSwy+W7jzdPPVtaabtbQES5acH23LzY1qBDc4ItbWBr51MetriK",
+	"rpuvldadZDJRLKYcr7mDjJIFJkKQd8RmZk1E8aOiKt4srzyoT/N2zZMjGdxVRbLfFncFBoSY2WrKqYBP",
+	"iCqAEFkx8wofFsYAJIfgwAWLwsoIw53Lgj0O+uH9wWt0E/R06omr4V6ZIoBv2OrPcRoZfoLfFCpB55Gu",
+	"fpR8o1ggPu/q4aoWaS7mEVtLUSmu2K4qM4X0XNFDpzf9Cu47hL0tMPxejdR3J8GvAdhyAyYB0maKsdG4",
+	"n2BbIEY2n7SBKGGych0Jdk/rbQz9226uRfvi7zaLJeSzWSHVnCSK3XOZaqLcLnqFZuxQJFPMlrpBbX9z",
+	"D/9kCi8XY4L8WrH4jJJRBMkpNLgjRlbjjqCJXkjTGW7zEAv3ZRGK7+1SOhTVrhIN/Ncb11ZY5hbiQN6F",
+	"pxYIhZCpCHreVivwFbr7PKAlT1srnW5DV0h97ljg5F3XHgah8+0e17Vr21x1Nl3XC3I9d7YW1Yq5Yq31",
+	"09eSRcyCrYVgYYkA7Tg+fkhsnGEVnz9ujtnG6da1Y3njih3giBs9gHdkXmqGk6oMssLdtAQrtZKfxkDS",
+	"+sDR1vjQbsGcFcjv7qGfWOA5po1PqZcPNkIzOwdgtgRcNgdYtgRU9hNFC8GRW4Q8douFKEc1FsIdswDH",
+	"8kkWN+ShpHhCheOos2D4bXkY6AjPIJT61qyHtAP1bRjlFl6IsGyA0upltkbKPEiB6QghVRJIcWHjzVZQ",
+	"xan9wW0jkNgI2dpbrteuiuyqrEOVAlGrCsoobSbTSMqw8w2/g2++h0+KV/zor7TXHeZn3XJ1pR5OtTcH",
+	"PsoJzlC9DyEnoVwKbLBffRILCoI+TxofWxXQ5yE0BAWsF6uzpQQ15sip0MoUqDZQt7d2p06+wqrljEtn",
+	"UN6wX1f7Sb+FulSNJt3q8qC/5l2wPIZoYhf5CmtwExu1hIo9ou+pq5RbE0e0dn5XG8NWferK9U1o4tqi",
+	"r5meeMyyXFGzKDbNqiyYsCEs5hp6dWrBzZr1LqsgCSohFzOmFNg6lIzHREYh08YW7uqh3q7DRHlF7Rfs",
+	"IfGCGWyaV3fNfuk9tNNSSc6cbOVL3IbXZR/XM7uNYodu5eWpux/NXkKRao/nuOFI1cvaQvzJXqg3n23l",
+	"fXH0qN85b1Cxeu6JtV2LurklrI6kPoTkYpneyVqSfsm0VKyk0DXixX1U42DX7J4pblbFHdmuCzELeRqj",
+	"ZDxfVC5Iy1QFbNLkbC++0uTDXbN3YAAQ1vQDbETSzVwh375urvGoefJCVYg+gWFV0oyr+psdadFJ5qGy",
+	"6FTLF9ZDMPX9jB7iQK0Nmt+hIXSbgJf6u/jcciZ5PlTduRRDEdqylpr3WFbbj5i41B4F3ff4bnCALQ9x",
+	"56suiU+Y0FabYtS0O1vRqG5LbRakO7baGXx3LY/UTGtgRX6s0peNhKNQxv9Jha748v39DQTVFh8crzu9",
+	"LZXur/VJ7KJ2/66K6ruFY4tr23a5AZ99zOqmx92VCv6Lzmrv4suE2vCaUWXrGRFWRipgYsDS13qyDiOy",
+	"pNz4NuNY7txmzN9zim9B8eFSfUcoKLQxeV2aoV/KuLGZdmY8wTTN3Qa+HbvLX99AuqNkhjbdyYZBq7mw",
+	"2KMQCev380MkpzS6xhJYDYkcTJuJouKuIW+hcLpY1lE3GeNsVpBlVd2hd/ujQ51ysmE/7BRgWTwivReV",
+	"uvISKvPqttGMwQ30YKdAk3AudfFBUQzdys7bupmGfRxwmeORjfPagoisdzI5rOATly0vtV1K+kXmVzUb",
+	"6S7O/CSDO5maNjnfBpQ2xMS9wxfyODcuSmX6l1yEaESooEi1V+mfTVJheNT1oD83b3fehI4U09cn5YDd",
+	"zSW71xp6fmRX1DZUlw4ivgh621gb9dg3AW0pJzMs2Tcpd9DdrMEJEpqQLmPTlZ82qcJinN7xYuUyOFKU",
+	"0q7e39ySMyzKjz+evZjRvtmcP1OR0siXAT8gfjYjWVd0+pltnfjSO1Vyyyz+PEeyfO3YvwAekWeYBTcm",
+	"NoBvTMAsoaiBP3WaJFKZse12gCUbbV19/PKrbSqwbiM8lUtgbMWUmi+Dh/upnNFnk9jpxfeDYW1RmK5l",
+	"TE0+ZvtEvmfNFdqn6mdbb6vSo2RDxxU077Q24XSz6U3f5jStWztOW5iNY1tQPdlopFNlbPQNX7orfetN",
+	"WPbTUeUYLVHqgQ8qyoB2AfXFGjSMLWVVXzemc7/P1rXuguA91FS3i0o6PTqgbhXNWH+K167KMNQtbhB6",
+	"fTFi7LjSN0uoaXbfqeZQksK2bLauYXu72bOlM/tOoDPvt97tHKosxOt91rtLelX91uvxMtyhP3t32YTF",
+	"1usVWnbNk0pLuy3XjZ9sd4ZtGugWJSTqq0b0LRSxtmUcuGVnGEwP4UIRF+ytMGq18xSGphDp3ec3tAQa",
+	"d0x/aIokPHhqROfYxPU0WF+1rioiuRBtvG1yRdaGCXStJi2YVSdI5g1mCglxwM1SUZd1GCo+2ybkHdI/",
+	"2AV83GQ/3ewb1aUHVJUM/bEuIdRugCyZYgQa7BsmSLktYJ1ryB9jdggb691YnF9Jyy1aVaTN54aKQV2R",
+	"naqUMcwCcQY2bA+WJY6NS9mQ8ByDh1DCbhlIsGWHgdb7h+DSO53CBZ/NHhYdBlC8fU7G2i1UAKk/7arA",
+	"uP5mWRwNP80X3+mgHo+hvZrR+9qpOFt5jLb9yTt2w6zFqknqhvcaqZp2gxD/bs8UV1s8s9bnlvLITHid",
+	"CeFhBSJrNe/ta3LW77OCOPfHvLqkNEs9FHOdy8I899b2bvJR+q0ZA2sB0LYlmuNVnjr5Rmm0FFZdHNMm",
+	"2TYmKthXdpWvUCMTVMyynrdQXmu31LmbrNBXiyyJDt6NMgBV11mx8y3dvK1AyMX8nUsg3D7wqh51sizO",
+	"/rILdFSEz5vT+WMQBXRbUo9Nz3TE3vcLs50W55JpaNKsqHubCpvTIIWD7VNy62Ja7DA0SSJuewPaBlkW",
+	"q9aTP8fQu9EQKRj43FBICU/bZR4XZLrWLCPfZ3aov3e42vIB9r5XD4AdzjZOtSFTRij0So0YySJgKkt4",
+	"dMzfdWXwOrzZoxrx+tnGaGWAReUb7nS2qsUV1pg93Uy76cwwZYtNIHCNs8pHeYfZuuSSItVoSqUo5g13",
+	"IXNMN5ec2MZi4pzQDfVpkcXYqfFvV7oak25iGtpWcjWda3dmjampfmwJOmOif+Q0nVeLis30utDAcfuc",
+	"/nLKYk39HiGX2EldWzAj+D6Roi6/vXDutekFvRMiOnaIfEixLD9McX3lM/q99UYecBldzqoXhGxkD2+s",
+	"aceF6BqKzjWfXBYuvpfwsS6pZYdOx6pYUwPEPMyG2D9l64G0EABAKgV7roQmW7jW+Oyrxx8FWrwt4PN7",
+	"B9OSMPGogLVZzKFCL5lqgcKHgKlNo9q367ROIHOFv+bAdhmyQJpALAONKrVdKxT2zvxzH9Vl/m2Y0vOg",
+	"ebsaVAwUg2t8zGl3HfiwA6dCQp4HgO7eqxx4wXfV5LraIFmFq+Si8fF2eHXbmNVT222lX4pA8wqyxtGi",
+	"MaRnSzr90XQQGrJ799X/2MeWK81XfdDI4GJD635GJ/9hd6iFLdqA9+Z4l60ULTOboL2jpyTqExwqCB2u",
+	"tD5/wFXA748g2SnUn4DdiV3BNjUiqw+6gufOMQvhgUkL7dutv+pSP/FdSXAYE9EQi7wtVfmVm8XP2Jtf",
+	"d2yAsk2vk6McSUvzk9juuj8otvWv2eoqfPP3G2bSpP4mpEmKYZtlscc9fHl2Rj5cX9oK3cAvwHhKyf+7",
+	"9m3gq8q0uLjH8oDfU82+fmG7ytt30L4TY1Q5YcKo1Wi85T5bU6Eb+4IUQ8kmEZuZ9vy4ppzJF+9ekwSz",
+	"UIuWYniyTQ8lAJDL0OYo7pYl1MeqoV0L8wR6DZi4KOG+8ApbPGLYOJYqOkDQOGzzSskZj7bPRGiyF22j",
+	"fpbMV1X+lT1kCDT3x6qZrEvzqIeu8P756VulpNoiWtD21OwyjaWQqeJmBQV0Yzvw94wqpiDUfpO6/P3X",
+	"W2LTmnyPwN/c++QT/vD5t9FXkPDx+uoyfwN75RVeQOfG6OVowWiIVMgezOh1MUUhx2qa8H+w1ejzZ2SO",
+	"M+mxj1ptyNGOkb5Tz2P9/Ovvvvvu/87ht9NAxoXBry7Jjc1L2SzHev325hbX7NgAnYN7483tu2KvdYwU",
+	"C5i7DTfsz5e3o/EI2dZoYUyiX56dYdwgVrA5lWp+5j7SZ/AugomK9fvZje+Hl30XmFnE6Dxlpyo9w7cy",
+	"jzE2oP8eHLuwzEIh5Zej56fnp+c+XJEmfPRy9DX+ZPse4p2eYa7OGYWeO/hD4oJA1irIIsYDU8WseXib",
+	"PJtKkWq4U1eP/Cs8JIq2+VOCmavopAR/IgCojXUN0VyhbWbraztv1uzzexmu1mgosidLc8/+4+QtSyM6",
+	"NwbHxbu2QviTBZm1Nh+4qZAaOiqyUaNSViAMeEYvzp/vcJGVbY8qFmi3EcKFfnN+vrMFbBCUiqm/pyEp",
+	"NFP/5vz5Qaf/IHyOkt/+1wed/51UUxu4V6SMo5f/LtPEf//++XewIccxVavswiy2jHyrgH+PEPBHv8NQ",
+	"Jew7A7w5+wT/vbz4DOueV4mo15hGqUnEwW8/s8Przqj3AytiHihEtzihd9kzgyrCvzfER3C4XV54Cg0E",
+	"JCehxg9Rxptx4Q7Wec7vGzjVD6R7tuEsI9eG03njyt//Y8Czp4JnPzDjsQBKmrr2dLXY5lJJOnM7935H",
+	"jva9H/0QPC1rIlfgakdgXeu97Doxry6Q3wk8a2EI3vhbxe1KMYt4YPoBmSPmDhg6AdjZJ0fHQxYxU5Ey",
+	"fYG/A5x1gjH7egnKquh2BX1+MG3+piKcVpI3Dop2eWGVMxnyTqYi7Hdj9rgabmzczGDdh0BTLi+6MdVD",
+	"X8v5UXD5/T8e6Y0DIyjdWuWlJ2nFpX/ADNHOqHiVHuzC98dD7J634SHHhbsDso9m2Nwpg7G30YnBZF68",
+	"E52wQJ+xj2i8qNMY3uJjXUzmQvv4vy6vMMfLzIIIGqOtomIfHJvILxVnemyDPCHUDqwfNH/rdBVHhIqQ",
+	"YOk9HkEcMhi6eWBLrGPhF7s8FpIUbfM+G2iyoHphG9z4jv52KYTPhVQw7ZQFNNXMhjqbBeMKevYtbJNN",
+	"qVh4SsCdI1OoYh4B5rlg53wbXGfz19PtvDsDnKc9rzaFyL7ll8Z1KeAQcf+/U6ZWOfIXn+fIkVmt05SH",
+	"Vb6FpnkLNypnbhV5TmHlIvLHu2I0f9hgq4otTbmglQ6TDdQGUKQqWPB7RiLKQwJXSjX5LT0//zrwi8Z/",
+	"sTP7I5QBcD+UwHE0duZDXLoTUU4uuE6k5j7SOV8s+0jjBO1o1BgaLGImzCsEZTix//Nbfm365MX5i+/O",
+	"X5w/v33+9fn5+fm/Tv/gyW+jqv39uZW3jHIebPJfZAHlY2oCICw2/hzJAqzp2wMr85fCMCVoRMCeyxTB",
+	"D/rxBIfr+daQ3vfiDTz2vKFZ15WKpE4GKlAVzMnKOUUVc4Av3WuuWWaZO/hKbpa0R3QlU3NKkNAid7C3",
+	"FYL7sTDxdEUAwcdES2K3AJwHBtI0dudA6JxykSXMCGkWXMwtSyChWk1Uauf2xCxvA6LJEro+LmUahZAQ",
+	"4jxa+SmEp+QXOx42e3UJwbYXq1jh/PCAi3sa8fCV6xkppxGLy9XObCqEDRMj37x40WA6KHOhy9hxoXoR",
+	"EDuaJlSZMyC5J2i7LoHwWiKNPZJNGHgPx6IwW9yeC+zGnro7InvGNd2FqqqBFQm6VGWQGI07sIj1ROGo",
+	"KlT7sLKq9W+fwu3crATUuEqjSleCvToAuzQyY+ISRJKICmemDdWKqNRRysHEeDQu9eLFEYDDkgxLwl55",
+	"qoWpQo7M9OMRDti24hEdbKBgAc2xt6AUd6BjhzGCllq/1zj33uSc62gOvs0u7YOT74tx8pWyzTogXmfb",
+	"cDfcK5iGc+xrd+rlaFHn2TuY5bhwz/919l+HBq2dT9miDe18vofZyJugt8VgGpQoazODSM0jgdB921T3",
+	"wpLOj8SSBlf4n8+ashUxcRbs/qww+/vy4vOZKwF/EvGYmyYO+RMW7ALNyn1C8BM0R2OLPJJ3e/Ihahgw",
+	"U+SpV/g7Z5rQSDEaok1VzVmISvwdS3JHUEGLdaWtT7vx4Tf59l7blf6Ee3sw6Suc2/659ID+j18Ydey8",
+	"hA/9Hd8LuSQxWLnWsUhbPxDWXojpisT0jmGuaREzhG1HbVOu7Ae26ExMAqmN7oVPGw6ap4BMe2DUxa0O",
+	"vHrg1TsJlWgnE5WS/xuaWLa7SR7kzPlfkUSsUwZDoGBw1uIFKIVlttoONfFM2tEOsJWW6Ae1IXlzasCs",
+	"jCF/p+QnRu/BgFYaG9yHd4wlJflAk1TgXsG634MIbeotj5MI7U+rKZOf+hD6znLYcdSdgYoOVHSnVPSm",
+	"AxXtrvLoUvB/ndrzA7/HODV41YbdpFHkKtmhepPRwDL5hWLGp+TXDZqtDV1lZTKz30kk54jByVEUJN0t",
+	"KWF/BHV8+PyHQQcbCNJDCdI108xY0uDpwHYkKYQ2xbo1CQmIBr7qwv2SKC9AVDSzYNdjW4TThkcIkBOz",
+	"IIlThDOuSsEnp5huyXoqhRd24U9WHeyX17TZhHvIbRqoya6oCVSTK6J3tY5YGT5wBYQAxJT88w2icGOr",
+	"7WGMlSFcE1tVi4Uk4neM5BYo+HqMpcIhx4uSBZ8viAYKws3KzoAF+wgXAQ+ZMGNCI6ZgflimVTWzMhcZ",
+	"ybL1L07JPy1ZKhUNxWT2xPhYLluyrB+ZqoiHeGx0an8aY4Ew1aqLBaYwJgHVjPiynHCtcGeut/4RgjUq",
+	"COsQrDFQ09oMkINN/qDsxZwYbycVYoZHfczYhySSNLSJICQPpydGlgl/vyCyAtF8h/M/TprZMxi3OmwW",
+	"NgjHleJJdgmU9T/ASDOaRmYt06Rq/FXCXhZYnVQYecjSZJ9xuP0IVHV3U3tBFZMPdPlJ+y0t4bB0o5gH",
+	"sCWVQlm1i+qaQ5lPV6uQAKdM85BptLUlisOS8e1TAvJBk1K7K0fnOyd8/ylU2mwfg1o7CGL7U2tzlOyr",
+	"2r4OUQmFz2p0xnqKsZEba9NYMauVhaDjztlH95iJQK2S3s7KZgHqEVCSPeYDlElHreL5Dq9ulbCxb5Yk",
+	"wg0F9MhJAoPuOZC83ZG89SyFB+iAC19Ku0vtJHjZ5bxV6n996dePvuXdl0m/8OxsA89a4gWPj5jFBNPj",
+	"/QzE6cvLYgJ03Y4qcKENFQE7CaSY8XlTAMONkQmEis2YQtO/+1Jv0onrVIjyKyAyRWyGJiXbOm2n0QeX",
+	"bqI3dhNPK0B7APTuEdIeoogF11RllYX7hUpjpDNTJzYYp3LQjZSDXVkFHi+w7iF0r7zZztXJBqH0kccg",
+	"90DEymDkn+kd0yShyvCAJ+hzxiak6HkOYcsszCdZ736b95ye83smCI/Bk0ve0mCRfxTxe8ecjIkmmgVS",
+	"hBhKzLRV8RH52UfD4HcX+GorVCUJC7EmKRYhoQlTtmkqFivdXQzyo6IE+xOP12lArYh8CddoryG/xBlz",
+	"LSGOEHjcn3oNQvNAPFtDjzsTzw4CvG1xc2Kb/DWK70tuy2utBxeDTI5G0WDBgjusxPT/UpaysBRuHFBB",
+	"tOFRBGWXFLNNZHcqxP+MO7ENIQcR/gsU4bmGTkm+KdNcUddksqfsvnTt4Wm5WqQdNlrhwLv04j1WuNwD",
+	"vytudZDVvxRZvQPCVcrolRzDyLXxXDyoYxNUMfLflntwX8QPoMn+iJyP0RAE+injwvEcFtqSgNqsi/ng",
+	"9tqdvD1g8oDJTxaT34pu3LODzOhgNWbOI9RuKlMMv9G86Iyy8dkxFzxOY6tNY1fWEr0QjEF/DzaDB9yA",
+	"/i2Dux1WE7gububLRupSeSK/50ErHYjLTsSEHA8tjhJVxqyOUsM1SyIauLyMipE2S/kUiAt8ldX3hRha",
+	"eHUVRGwtn+O1ICxOzMp208I0EfIHU9IRIMViec8IjaLS1LuTJB4R0TlAWE6Z3NSa764quQS5vKhjFEcv",
+	"njZQ0YGK7ti215eKdhLX7jlqV62ymi3i4t9fo7RjiOxhGlpuKA3Z//mLXLssPah7vmA2Hrqkio2tx4TO",
+	"sW48/I3xRK7A+OFCqq+zs/izhVX7nW8TWj2QjqclgKkClD+EYJyFfDarpRpvZJxQxTQxS5lPuUY1yIyz",
+	"CJ2h+AeQDYvzoXUacNvR1DVMkKlB4mApwR7Q/gI29Hiqi/zTNm3G5Bd7muiarmni4x61zseFYXOmOk5o",
+	"ZM10Rvab7CA6rL9JuMhB6Boo58NLYfPZbB+k85NryP75TMkoAkdpffD0NcN8EF0rM/lWa1ZoMrIQu+Jn",
+	"xHd8rQTX/SaNjC+et8xfxM40VBCahtxgwScmjFqdkluYyvp/Q6K5CBgMJGzCyh2HqJZXBD23TkiThhiZ",
+	"BguQ0N5RHmk79jfnf7Mtc9bSZFwhqWKfJr+oHea6ZLTe0bprf/yPh+z7JRIHI9XT5Q8fGxFuECoHSjxQ",
+	"4idYKAGIhI1meWASMiw1TCNWKzUXwhDEegyCYhGjGtyZIiQLvD/r2dydJHzj1/cncXX4/Q4UaqBQO9ey",
+	"EVuJzlFqGx+HH2bJRSiXG+6N25IMlavL6LRwicelWszQ1G/KzJIx4ceeUONJCvz9ikhfgGsqzcK5O+xq",
+	"/Gasfc9tEOP2XDzGLDWpwoVMlaRhQLWTMbNFTjIyxu6ZMJbScUPmkmmMqh7jYhJp9wHfziM5pRER0vCZ",
+	"u3X7Gf6ymkjhB4WJNTO7c8U8EoJ4ADdMTgprXTDXJVg8sn9lIN0D6d6Tb6UD6e4k60lE4YaoaS/trYXA",
+	"2WKEWLeJhCygK6e9Ow8IN85O6r7wplH7qi1wz40mQaoUUFj8bKfB1Ddua38aKRG3O1CagdLsqBZzjoI6",
+	"Q6Utkkzdx2SWisBwuaExIuaDe8UaC3NkzRryrNGIHNszqiIF812gN+lSyBS/Z6HNmOOCG06jiavdGXMx",
+	"yQup2PdhUML1xI/SV1ZrUV4HqjRQpYEqPVh1baJJlRqrq5Vcyv2y+a2AUeEmnUJ6IUCfFeuyDVF8vjDQ",
+	"x2d1St5iyAk6FRRZyCjUm1RrDEoiEpYN+jQmWpKEalP0S7i5QmKkPCW3PGYnU9RH/ersazAY+wgaNJdi",
+	"7Bwe2HgIxyo0ErJEjhvs1h3TkBFqTonNb4BdG4lENOFCE/aRa1St/YJM1Ya0JBE1GOICv7iKAaC6W++4",
+	"zhvyC/bR+AOas11GIT4OcnoQzdcR0lrF92aDzzqPW+Fkjq0ND8xgYAb7UIbrmUEXHRipXGNdsJCbrHGR",
+	"9T07Altl6rRPApkKo10/AGveNMaFDCHxLHuWM6KafYv4i4GKZBpJGTq+ENAoSCPq7aduFDEv2zMLK1Ge",
+	"3WRed9xGQBNTdMtsUdLsxh7cl0p7cXs/KNpY0wybKxlJAgSS4xQ2Ky50qGw20Nen7slGULbE6yEUvVNP",
+	"uluswlNNzlG+t2qApf0b9J2ESiZ6XEXLMZDp3psxinQ8oVqzjBgXZGMssjtnjpYAPS81qauk0K1WUDyK",
+	"oSfd0JNuIIJb2EHv5R17ECUChO6WYWxftSQIO3KcaEsNcmMpaMwhU5khgauSsHm4HJQbu60/WwIKbnut",
+	"hOxQ3X8gN/up7q89knUu758kWMiP2k83mhe9B+tg4Reo3e9mGRNaCMEB0cOXJUGLXrE2iS3x7+oRWrnG",
+	"TedVSxhWSIPpGRiJ/craJLN/uw8wA9b1GrB90wsabUmW4tr2THc5NvhOBCZTKdgO464fBVk7hDER9tlk",
+	"SrT3yU3ExsWr8f0mjtldoIoGD8ruQHh32mMA6dN2Eh9QwBNAlK0rEQI91AuqnLFQ7zQqBhSvd7aDwlBe",
+	"8MusEJ5V9a7plNGnMLhtFcTiBCzOe6sH/hiBcg+OML/NoRrZlxKO0I5qvcoKlobTrpo3/hCn2lgB3Tg5",
+	"26GkDVYymvyWnp9/HSxiGvyCf8KAdxg/j4H6WTFvK7H/QhbsI0yuaICxVnJGfvz59ZuTmx9fv/j2u2ea",
+	"BYqZsZ388uKrV64Imu0IhoH7Gw0IMZ2SRFLMmXJx/Sx0JlMcDsT4ORNAFmyjfbuWVNvoKzB2wq82qiBN",
+	"QuzG46qVK2moYZN8IBuVZReo83bcVEgsjQq//0VnCfq+blIhxdQH7ltWO/H1f6nJenHvLkzhkRC4/akW",
+	"OWmrr41UyVOOE5LQhxIP2sPACFpDEdoYQR/F4UwxETLVlGufS2qITCiX4fTegGIT6w34lpBD2LJInz7B",
+	"658/l5gCN2NsETFNeRQCCc32UqyAErlal4WV6B1aXnKExK1/qWTSbq8DsbzNLhOu0Eii/MEclVrCGgaa",
+	"OdDMHXjWAJR6kU1GzYmXzNo9agmdc4FyZlmm0+UacGOi02BhBcEWUbKsE2PDtxkXSDGdMZwKw0/sdFTQ",
+	"aKW5PiWuxGIpw/VUMRq+sr9kQWHap6eye1hpYAt+ovldL+TSFT2xmgF836RjM2ous6PaIKZVlZK0oSaF",
+	"d3PQYCKN4TJkwsRoPAq5hvWzcDQeMR1QjEErdNQvOvurZrjjIqwcvyCAj8b+XzzJ/14qKeb+eRBJzSbe",
+	"JSvkJJRLEUkKY4cskCv7Yvd1JdbUl6/LhcuNXj4fV5ajqhyEqUn9QC/Ox0crc1IABfAvDdT7y7D12WSI",
+	"EmXrQz/PkED9weqFzJuACo31g4up+SAoptoZDKC0HsOUAKCL1mhtCSe5vAKPIiKupZc5rZuuiAZxlEb2",
+	"szEJpFIsyPIfgM5xQXy7Mjlbp8RZrBaGTdC4VHAKhCa7QngNl1OVhuqIBjznRlsB1xlcPFUvlgQFBiEI",
+	"UMLsvF/5F3VWFip/PS83VeAAuN3TWIZoAWkUkYsE/LW7qn07Bxk1rx3bapBM/SvELBTTmO5yrLSC0oIH",
+	"svYlkDUH6xs0B8lXe4R/mcZ9crGfjYIiXSOjeYBBcQ1AIzK5zHvEjWv8UhDhWkS2emmwqzTXHsXpX63V",
+	"jR9nwmhhkxfMUB4NKD3omTvKGS3id28KcuYUn4YEIVBJNgUEq1XOaKSBZGhu+D17uDRweXHh1vPF04GB",
+	"AgwU4EknsjhMfTAF8uaWehL0PXX+ALRQyVkFMRIhCSyhgveKRMoPH+6COr31az0GedqfeuS3tUagatWk",
+	"7ym43qmW4oja0UBIB0L6RRBSj329KKmME2a4nbdFCZylUUQKH7i21M2pd5mCVpjoIKidz9c5kqwT+O/M",
+	"MIkXUDzP7qFRH1zYT+HjlhT1tPoW9scK7BJLt1BgA7ul9XmU1ZWCfRvOdAX3y9wLcgpxTq10vgsxPhzI",
+	"2ANtgZciYgNutrsAwXYdrgSNeeDwWXdFaDvBYZPMcNKtWxsdGMGRXPpTar2qs093bNWYhu1Cl7uQ3WKE",
+	"ux3+H2xV4+QsS5R3+N4hItUfdhuZSLCb7iDFo+0fDm6/A6/NHVv1wp/DXctemGwZHfeKfru9cLR5FW+t",
+	"R2AyMxDZkHqC3I6NOfvd/53vsdQLM/7CB1b+EOZwk8FeM18wsxOsrN7OxbG9rZyRN7fvbDH2rkzczN7e",
+	"O7f4Idn47Tuc9okw8vxU8aB7JBvbnDnfmSkbp2s9qdLt7NGrjavML6UHdu8jiXUDOLpkrj4uDLfryy+8",
+	"I55bQ+aMCxrxprCTd+4Nnc/gczZ84TPtey0odAnrviB3eeEn6cSqDpbe+JhkCH9CHe8ZA+9OEhnxYNWh",
+	"bRA1ZEGThAlbBQmDh7DructwwuF8ElRnk7Sn/Bfw9ZVdyyFExcJ8Q2bfDsPbLBQk/iK7i7DaFdnNP89q",
+	"X2T1KfJ4tCyOiYRcGy4CNzPW+eXaN7GCDAkqBBSzoLa+qpDCBXvQiCmjrdmrD8R64XkdYvfHD0uwWh/j",
+	"lReQzU7nOG6Mnrg1ODEeP2o7a18Ldq/xljyYq9mCBPJozj1OSeaYBGQuhWfa0noZquZpWacxFXTOGg1P",
+	"CJYdYrEusqXsLwpjKHQ3+PZ2ZCPMMacRI9nHRCpTK+e9xcclFw4JqaEQavD3m/e/YKGTNOmmxtvBOgQT",
+	"RGnIMPDbzsUFYf7TqoQNbr+YwBe6OmsDI7cy7X0qZcSoqCpm6WdH4aLX7PBFzeyWEHSf3AbF95pd+/rN",
+	"u9g81ufqNz9+0m/7+7T9MmG4WZ1+j9B5QQ2tJm7wFPf5pydu3x6YsVwKwxQUnLhhCupa4gc9AwkQLkuk",
+	"yVKjDgTv7A+ebEX0/nV5RagKFvze1etw2Sbd6d+/eNKVBK4nbXdFRnx7j7joDi8ffiZVTA2MyAXFFa3L",
+	"OhsAUDjI0Xi0YDTEs/g0ctLOyQXXNtJ2HfTYRxonEYxOjaHBInYJPBGDY/g/v40sFJy8OH/x3fmL8+e3",
+	"z78+Pz8//9fpHzz5bVS1tgH5vxzkd0jaSANmnEVhc5cIZw8PUm1kTPCDjrbJd3bwQ9jCcapjG8LdIp68",
+	"FRzvuAPY9NBeu0NPQR+18FOljw4G7ZKGU3dhLdFovZA6NYe5k31HuPWnFOdHoBQHdobvFiqdLawLGYlY",
+	"dyoCb9u+FdpIRQulGkW0aqYjEetg1oLXjmrQWmdJ/3X2X4cWtXY+ZYvdaOfzPZSQtrScRu9dd74nigUF",
+	"CzoMWnG3q38LJYu6APNgnR2ss0/BOlvGiu5CzLUt9rZDJMtEnCNh2AFK5LfUZ8MtwdrGJG8fHWA/fiYw",
+	"1Rcu9rjtNocClwP92aWU2kp/cua/4MJ0Z/7wdmdV90feqQoGvDaIqH9qERXAqr+qD1+h5b6bln8scNy3",
+	"8g8LbuB/P/pTOg6Pg+lbe8AM/G3gb334Ww29yLkaj33cQ7UL4DKu8QGiKQacV10iHzKngB2u0SmAfQIT",
+	"qswZeNNOYLLyiSalVJPAJV9PYhlW8OMf5RLicRdUhBEj/mU9GmelOmOmsMYlNDZdKm7gb6hzV1Fuczxi",
+	"imo2YR8xqnJe4TKF58Q/tyc1ZTOpGOF+6+tOx/EIDQ8bY+WH6y0Tre7F8eieRhxu3vk+Nwb9p3uOQ9qG",
+	"aDqNdT5UISyiQAX/bdf4e2Umz+GIpQtnsFB0zXQauSVUAS1R7oWBYD6NKEp3bT0DGYQ0fOb20smX6TIt",
+	"it91pF6/lKY6hGezOOOxHZzltTx5P2cFGHSHs7NUM3X2Cf7rFMI2qEuY0mijKo6DdSAphvhtA4IfNFMf",
+	"cAmdHHKpf/XxmKbweGALjwnQN9fz5IG9Evp6gHt3X393slowgJSgenD5t5oBWm6x1fPfg/el5qA3tG8b",
+	"wNZ05vx4DPVLCAfoTHckLPYsURIqE6tuFXFsdn6qWEjYRxdSF8k5FyQb55S8iTgTxrVta2wl3xi8+h7W",
+	"d5Ut76CJ+O9fF+beOht/UEM6tCxfAx/yDKHzqz6ge/bJ/9nIOq9ZLO+tJ7MGeE/JT1xA33JM++KGM11I",
+	"9+rIYstw6/9os/H69wiS9EpLb5IPNTTMHbz6IJ6Uwbe7gOK1Jal8N7dmtHgtCIsTsyIB0nbfSfOOscTm",
+	"S2sjFbblZN2knEeIJPsTiNa4yXFloRrWNnhAnjInvaH3HYhBzkCh21T36kkg+eEX3QS3Kxz8oPIaTPmE",
+	"qh8m7oS2K5eUrMXkNhix8qvYt2nJ3sBxzUllKHgcJqSsSPIuKhl7G5PtFdeC3j1MSe0QVZBvEaYG01Gr",
+	"bFZzS+PW1pMMKi9eXvQgtoe6jfPD4+yjLpSZX9Y2tsEOdDw9zCXv2xbYmzkcEdAeje1vp5zDGQfbOQdT",
+	"vrNYq3ho24LnX9gqbAEVZMrIXFGBBbIkoUTJqNi8CP5ZXykno2yFpexKmKwrlzrY83Zmz0tK11YPaYrN",
+	"uTb23s9CGVMuutmgWUx5dGK/IMVRiEqxMkIGZ8U2AG3Qdl0Y6MKt5qAqzOYCrtOIDbbnfcJqCXo8RKXR",
+	"drqZAOiUS7CphUyscCAbdiAszPoZINuDG6wiaH/Rp+R2wUgstSE6YQH4b0hMTbCA0DYcZ8mFfkUk1iEU",
+	"KzcTPsEQOD2G5BzFtGY6/1LI4otgyFYMgsnAA3OLdTMDqxGBDGPb3mbfUlH8FguPUDJVNLgDHVYxglbD",
+	"0LYdp8Y/6ol9maJah377VlvrsK42dtm+NPZlHOEuZeKSotwZjI6i/LbRjy7q8GDlGzo4PcYOTs7uUUeu",
+	"e8sZfTKLm8WNnvSuYEapoHjtmSCA0kO68UAmHr1j8uGoysU9N6ybSlCazX5IAhkyPQaDOdOGzLjSZgeq",
+	"waVb1dFUA7uAQS04mFrAsxvfQiMowKIVkwHQQfTnc3GSJlDOHrJDyhNq22o1LLrdYQCuvbMeBXZKFBWh",
+	"jK3T/eFidxG0Dyl2e4iuFbkv80Mck1SDzTXiMbetHdjHhKvV8UXudbwcxO1Hy0efqsRriUlvDtrDD1jH",
+	"R3cj5ToC0y7lOoQf5NxBzn1Scm4nBI0Y1ezE8JhFXLBa8RYEEe9ige2EacTCvIrGOOssIrBML5bhDcdE",
+	"qpApKx+4qQhM1a9XQS744gi3fq0HFnpLk78VRq0GsXePHXPyCi1FyHEX3wTR95wt2/W0hM65QGMzds+x",
+	"HiJwD8ZUpDSKVuAyDIswrsdERmGl9tYHhu3yql3na+WstaEmLdex9snrCRMh8JMxXKCS98z2tLHm9IoM",
+	"9s/j6imcB7aiUPbzbAwuDJsz1TAIU5P6gV6cV4x0kGCOm+xm7bEDERtCTL8cEmFxnfx3ytJORMH27XMI",
+	"U5+7+9q+YJN3Ec0KNAK1PNtsAiPNC4WkpEI/mmE0HlsXle/YrQOprBcrxI/wezJVkoYBBVKSSC6MtlEK",
+	"QJuU4VDVTrGQG5ImQJdwslQpJoq0EQu2vcKHIZ/NmGIicKq57w9k4zTn1EDJfmxTYf17sE5402ZoQbT8",
+	"DMe5ZyrkQU/6VlDh8agvL9wptpqQ7R0+jXJCbk92zQ0mgvfeBedv0t0v3H8g49i2IDxC1NE6RRyo4aB7",
+	"PGlXnMPIAoHuzgisuFTPB67xeTUb6ERCEd9juvKtQOmccvFQumpX9UWRVbul7lR1IKEDCR1I6K5IqMW+",
+	"zhRURh28oEAEpymPzAkXNr6WzCTEb1lbkGtAgQ96B+Jey+jgHk85hD7u2ccptwxzLMKSVboA9ub8noli",
+	"7G9nKMsZbgZme/c+yujouWplCB+8hYO3cAfeQtkWYCOxEQzIpP0aStkEjg8YIpzhPPxIZhB/A8UDvckE",
+	"u6j2SvgougvhxV9scn+zuA1z11cBcE8GJ+EgZT4GJ2E1XrZ13EAbY/4IldsCh8XM/DKCfl+WAAMqhDSQ",
+	"jRUsqJhDRFFXppyaR4CP+05L7C0HnB9eDhg02oHW9MnwbJUBnGvkLJBCc22YCFYN2mUgU2F07kNBqmOj",
+	"ElpjEYBeRVy7z7PXIehR6qxkEA5LcB5InbINcUKu6VyxgoKBL5ySm2wRVnYJ0SSoXYE599Iv0mAOE9c5",
+	"4euSS39jj+ZN4WRayN6FJEBh7erzA5pSIfzSajons4+2c7J99YFt1GuW4a6lyzLsq/2WcRCX8jUDgGXX",
+	"zBUi71YoYDABtCgMUI4+85IGJYBvJx2zVASmMUX8pwzt/RzZN4TmpMAniyPSo6HKyDkzC6ZyxM/xjzAa",
+	"LJzFPyaG3jHdq/HYGpq/y3ZxUNPW2uyDlWufVq4N6OsE34n1WjU5qnKrq2VYVhbPQXBmmCrCqw1h8rTZ",
+	"/V8qQqYK5jO/SgR+mRpkkTaMYYUcbUy0dOCN0j0xqWDrWOK6YCTpNOIavjolLKKJhmRdh5MLqphfGLtn",
+	"wtjkhAWFUAitgXlDcIXhMTuZUvgyO8B+rf68bc8B/ZU72P0K9+XJGvxbN2vQMS4eI0gvIo2nTMFJ2Rs7",
+	"kgNsbT+DsvAl0Cd3nRskqhOFUlYqaqJQTnJfk9VJxO+YE6gzpm+70yDEY18eSyWUHaIsolu8QJqnrdkP",
+	"pWpGjKJC26R466r3xZ5JqPjMlYFe0wOQ6CyZgrGVcoUJOtW1cyjhhMPRvr3lTgStJSS/LniwyILUpD2q",
+	"4xCL3vLyQCseP61wl2pLsGf400wpmDFczDvkzyYJ8S93VJP90IeA59dJ4uc7UJm43gU98ch0fih9C8F1",
+	"vgBvlS1dwL6NpKUL2JutNG8tfVVqOldXsKvYFe2oddy2MNG1AkwBi03PSp74wZoWzY3Gprs6D4fGt9YN",
+	"eobReNt2+TemrkThmsiNMw+ZfYMh/ek0zEds6U/XAdEMN5Ft7+4C0tFOgAOe2ly9CRch+0hsZ4oMN8cO",
+	"YV2+fwGFlwsmrLlgq6b7x8PTffOprHM9Lr1J67ckEm5m7K4F/l/zzMmKJ33k/vu4yqFL8UDU9tSFv46o",
+	"FWSPPE2zR3JnsWcA4FEx27OjelGYt1PW5p8kpbJrMuWgUffoxKBLwNYJGc4yJDr7lP3pBPSeSFIY1TUo",
+	"zQbsjSsZ53iTr6lTwfCg9H53rj4esHHgzF8MMSii4rRgMH8oVQAV3rQz0Hwk4MyGa8OD/dCEG1zPPgnD",
+	"gTERNzSg4peIiogLW+KjYTQ++wT/fThvxmp7JfNYVwyEMtu3uIZOKGf8qwMbHtjwwIYR5zpjfKqZOvtk",
+	"e+/vBONhqN4YD0kyH3z//3aMT/2rA8YPGD9gPOJcI8bbJ586dUo0dN4xoOSWzg+Te3pL58dOPcUlPK4u",
+	"iVskOxo6b4WTHo7TVlApODsBWIYGiK0etOobam2L1460qTnENezbYdWXEpwfnBI8upZ4W3g8WskEo7Er",
+	"TzSloolWfBBTiukL7YpgkVbA+JcX31PR5nKFN/cXGXHscJzBRfjoXYQA33UaV11ZkO+7okQuaR0PIfZH",
+	"0b+nuK+GqIPvqSCKUY0B3k8idm7QnQZaUUcrvq+nFNWs1XXGe/kJ0DhYbBKSG+YquWZNBp8F1LC5VKuv",
+	"WigLDFgiLVkbvqcmGN4wA3twGzi6dIgU7QsVD2+YKYFbV0h2idJdANm+SmxR8Z4w/KPPx/5SOOQNM3ZP",
+	"DTzyx+KBHZpNZnntA58c+OTn8Y6pzGINtDvRGs3y8Ls6rfSa3cs75vP8aIBFx92HNtp4G3X1htUF4D1e",
+	"nbVjehwcl9/e4EcYcPzBOG5ByqK5Zh1iCY28Yx1iajVT9zxgxL4+hlaBwQLTaIU0xED1ayN7eSlv7cQH",
+	"LXfx+uoSpx3qXOy1zkUJVrYq61oaAiPPptKle6Ov18KTPiU3pblIQJVaIeD5xLZAJrbKsHO0RxQG+Gjc",
+	"0FIErKutKAfYffvl3K4crB7XQedxxnnihjKxX1LlJeu9LGFbB27R6sn0cuAaIncX/HCa9sQwfG9I4BwE",
+	"skcvkG2FYp4rdKu4H0vsFh0wYYj/kMQ0dHULqViR11eXXTCxLKJB0xG3jOOi42EkQ9zqNgLiQAoGUtAu",
+	"HFuxU+UYVU8JQJdqR32aB4+OyYxHhik6jVgWSIqjjCECrap5JT5tbbqBtdYfd/7jRhnSn8GK7XYIw44J",
+	"iymPIEMdSKErVT3jLHIlpMDHo9kJF5oJza3pKp1aevRVTcVSzagKFqOWANk1Kbk48+XFK/vXxK6BA8mG",
+	"hYe2XxRAzIJr9zaQ65qV4AulhcykiqkZvRylKYcnrQu78bvNGln5aoIGuw8Wlm1LBE5XxE9buyS7r34n",
+	"9A6hGIa3V3bPFJ85dK6Zy77CwqqJGmrV5jNNacEgWjVDRW3cPuO7UshVI7tHWx2Q83tWDesedQeIg9g8",
+	"M3oyhE5/YWaf1DGJFobWIwwW3ic0cIW0X5OAJoZy8Rfn0sTqoFjijgqJFYZiBiUyXzk/A4nYzGTlS+0z",
+	"bbujQoWTsDMbLGimyAnbFVN4bdBLB2H00RcWqslwGLfJnIiaVq+ET07Q5IoMX28nXR4bp/bJ6gY2NyDs",
+	"gxEW0pNqsbUm/OcNNpzw+PqXSmWoKFxn4UHvY24gG9FiNPr57ljSXXHMQ4iOhdx7bAOO28KAfovctSFE",
+	"7+zpGek6fxynitdAhgYy9GX0qnVZNK1ZmbmecfZiRpsdVbbWYUYgzVKezGhgpCJMKBlFMRO2k7digbTl",
+	"y2XI9Jiw0/mpa61ASSS1ISEDE39nJ5ejjLDCQZsYqMIT93JpJ56QF+9ed0XOlhy3n2ypfjvslIoH6Osd",
+	"EnwGJBuQ7CnkxNXrAE05cTYu73vbCi7F1rmuIWck59A2A1gc1CA0C8ZVFjqIkr9CF3Z3c1kWMXVE5Ntr",
+	"dl2L2N8ru24gDANh2EUCXB+hOJLBnfR1D5p574zyiIUnkZxzQdx3SCuCiFGlsybbf9HuVUKNYXFidF85",
+	"+Ce3qIFND9j4xNk04MmWlnXszleFc9qA6os5NN1j7B8Tau3BsuX2dYNGy8G6NeDuzozsHu26ctSEar2U",
+	"KoSlV1YUwkRcWwfMv+sK6uJ01sDkgqY3pPDukndawvsrv6ovzPiO1ga/uQZB/JfCaQ+i+EBADmsIK0Be",
+	"JxqCYWB19OO11nyOmvw05ZE5ca12bEwefFlMv/tQ1O+t68lRFLkU+HaBosA/O1OUaxuq9qVQkxtmUJeX",
+	"EeuVZjVQioFS7CIbH+mEC//sRCN2k4TfrkBsquddk/CfnBIxJOEPqL2fnC/E7k5J+AUMx6jtOing54Kz",
+	"uhj3Ch+NQSAASwGguLCR4bZBH/w14dhwX6RRdEou50Iq3xQQXtP8D0gYibnZVtW4tcHmX4pgAAcNy22p",
+	"o3dL1dwVVRliewa69dTpFkB9RltaK+ot63PT3mpDpxHXC6RWv7LpjcQqeoEUgmFbfmv8QAmERkwZTXQK",
+	"BUU0tP+nZsJFwEMmDEgxwhQtILAufRrLEKhRQ1Txrxu1RZ5XFX67WXITLCDp6UpJIwMZ6SHxozPEWBmy",
+	"6oLrQCc1i7NAihlX8QnGoNYC0Rv7FmY4MhHCFeEHXqNNNfyEPMxWCVEyxn+64W1Ea8TFXSWMpGbhZniL",
+	"y2hhXm+LU/ss7sq0K/fsKddHPjKn+FMGQo5H3zw/7Ln/IAWz6J4XBLEYUUK0IianZsGEcUsqovRMqrk0",
+	"JyU7eGU8yg0Toc5t4ArtZXY6I4lOWIB5nISGoWJaVweXpGbxDie8Klt39yUOlidrEAgtlcjXPtRWbkL0",
+	"F4fFtVspyc+gGl377Psy8Luf14CzE/ijr7Ye6AsfMl30+Fin799/vbUsRZ+SdzJLedQ2waoQkkxLCyBM",
+	"QKZ/SIR0n2O4Ftc6ZeErwoU2jIbIEj3YYYEsbivzLKQyJxG/Z2GhE3NecOvq/c0tKewOQqlBDEuwxhM6",
+	"qVOQx9DZDXO4VePO4N9BxEGAu7wihsWJVFTxaEWeffPib1/VYvVPeI77RWacowGH3ygGkien0ZHarrsF",
+	"Dgpds4T8yKjHB+s2tvDbkWL49ISacngyTrKOQEt5og1L7AyOMCzYJuaCELyOuja4k9y+v70CI1Epk6EZ",
+	"FW1ywt6x8XYp3yGFO1KV8f8szSmW/7miXA0Y90QwzuNHkUP2QkAXA1mNfd6NYtnnTDG98DhGY+BkWV0U",
+	"pYDP+ZlrscmGk+wTl67tMjfLRq7vrLCbIS7igXhRyQTWQodqgTBmrfWduLB1W0Dgo1MMv82Hc8H8dQaO",
+	"n9noEALLz6yLtHLsS+oTA+aRGkV1uIFOtynxv4mS9zzsUrrLy+/so2FK0Mgx92wAlMOBxrjfbUWsypt+",
+	"D1NfZTMftHbe+9eFua/SacSDvvXzPm9Uk1k7ih7n/8l/9PksA4Haq7gxVGFhYeLftZgGohGZRXJpRa2r",
+	"f7x5W1LZ4Fb8POTD9U/4w1TJJfr8FjKNQjJlRAMQGdnp1l5ni20xRfoPCFocK71pfmmPz92OwJJttQvd",
+	"OKrrJA/XAEBZw9TtgDKgUTSlwV0nwV+sE4dc8gcIBZC0keEWMG0ZdiuyhFyxwABwnpIP4k5A7Bf6VLhB",
+	"C4ByEKy5hO+sq7gI1jSK5FITcAq/7miRAG8o3VBKqPtuXS+plZZKePHGn9cx0WJ/QhsihN/jsbssDaaH",
+	"wZf8aN0jj1X93IIpOIWyngW8/RhktXvWtE+pXCKB/XdCuTolt0i4mWYCdILyF1wTJYFJhK+IYtZtSgWR",
+	"WFCUZXkHQPuXCxtKnKu5tTTaaZFPU6UdTEdHU5FLV6U7Ysuca8NU1077TmtDiNYrbVjcAMVu6H2DsZ2m",
+	"EYThFbtEElJDR0dp95Gv9Gn0+Thm3aICTNtDy6CvB1jbG2+1FSwXDEM9ix8BZXeWCrBHJsxa+rnRRCbW",
+	"UUyWXIRyeUp+XfCIEW7wm0hqqBZeGkv5ACsqCBf33LBq/4BTXYvgOjpMnHY+YbeMz4orUsUydx0vSTMR",
+	"npRKUDfYjDWGNxTfzoMbOtjtcrIEA/2zXPd6aKK+lT3PnmXFnXS+/y5xLTCLKQS2uOg0J4/U3/KhQli6",
+	"5kzaHh5ARkS/7Mk/dyjL8UO3bOZhVdJhLWwjSqxawjAtEfJhK5aYFYDbepwxBpJk5pk6poFjrTrFWxZp",
+	"3xBv+YXDroWLblTZtchu9674BiiQT+8/Is8w38U1c+dMf1UFqt/7KQ7qRskarT/AdQK+q2yvcACF08x2",
+	"Zc8xM9L2O8n8M2vT1TKCCDIrUaFxwlfStPkDG4f7Jp+3hQS4LheFGaHhBZ0XcojWaQGdjx5PT6lsp0+t",
+	"3Whvb2l+Q2swV7jsdaiz6W7Yj+hkGkkZdmpyhu9boFM2mTUbsRnYLi/ewaff40wtgJd99aQSWfP9PR2v",
+	"GkCPvdKpu5jOkMOFNlQErCkV+sbIJE9z/Ism/qMseKcWeGwGdBF+Lv2Ex4eeISJncNBUO2i+PfDOff/l",
+	"D4LeUx5BPEvPMghGJgWHMc+RrJISdCif5lBdpUKAltId5df4xSPC9z1wi2zVfpuD13cgKl8KUSkJpR1o",
+	"Sk2qmosNIyELndG2lphkoXpZKYZipJgUjNBIMRquPF06Je8oj5wS9c3537ATfTYCvKUhbiYGB7SfFX/B",
+	"qBwWblAvMCoO5GsgXwP5ekxBK09QHqOqD/FsUs3OIDJGtDtNMMSZz5jhcUZZ1xU2F9Y4S6OI3N7+ZM3O",
+	"Qi4708G3di0DNRyo4SDMPSWKZBH3gSRJG9rF0o0RQ/iqNS7GaWT4Cf5SWADKbD4gIxPZAhcPGBJGg4Wj",
+	"YzG6UpcLWXjMnc2rTQG9sWt+ahRrSyM57nYbS/lAxw4p2+RYaFP/WXhkira9Xqg9fnWnIuk05g05o5mv",
+	"fBbROaqE2Qin5LXQS6ZYaMnHN+dfr6l8qYaooAR/8E001lwc9lMq/HNfs88OjZ0mYypSGkUrMlc0LJZ2",
+	"sBkf/52ylNnS44rdc7a0ueGlpb04fwHdtnEPZkFNRrqwbATSwgbi6CoSLqiNv4yoxpiz8hS/jdxnnij+",
+	"Njol19S4KoQvybf5ESRMkZiL1LBWWe/G3s9RKOYeqxTjrt5FdN7UaxRvS9owp9Wjj9d5cf7iSPO/DgKW",
+	"PJbo1UEIHvJYjpTH0tkggNQHuUFXVpn9DTwzkHEMa26Vvf2LLsOlyDm94oDVql3TVTgNZpAokAXVhImQ",
+	"hafNMvWbfGFv/LIezCwKu33Mcrbd71MLRTkikSLPiiAmpLEg9tUWImcRsov+xwyb3AsNzog8w8WNtls0",
+	"KUtSjw9P9idd2YPN0KNH/u0esm82sPRxJ98MlOEBlMFeZAmdW2hDI5+d8ahHKCe+DToWDRa2aEDnSLoC",
+	"cXiHcx6NMowrIkYZgbdeFhVSRZaKG5YmdVGjMGxxnpDNaBqZ4spG4/3x72rdxm5+TZ0ZmPaXb5aaOaTq",
+	"L2ovuOgRF49vb0oRLooQDnJhqR28IqQ4SYU9Wftld1H7R/4nkrNhs4OQ/eXja449VUzbgnwXdD37BP8H",
+	"/7ToVW9ctn1lQQOALyAXQvu6+Gg/TqTDs46SPa7xR5zcDv2IGDksq3YWe2CPzwtfRv3BYVVDaV4cdP5L",
+	"odPZjAdYi9qhyEDwjmIEfe0iIL0csVVbbUD+OkLrNCX01TWlhdjcDo3dMN1HQE4vL+oa4Xkd7PKilUa6",
+	"4Y6Z+vGn1cqx3am7ALkUTH31hIQKC2l+/Q0GgNz0cObqWHSwrPtPfKLmswTrdI6JsHUpKvNg3+Tf3fiK",
+	"GQcIZluftVedD2dvXdtv+Tj9Q3eiM86isMMp2ma79m3nQS/UFHk2s+mq0xXBGourCSBz5bm+sxNukJIq",
+	"40RhrEbKwUQaw/Z89RlG49Hv4yMrQ7jRByc0uxPPDpa4w/A36o7TX2bkozVCuRSRpO2JpYlims8FC7GS",
+	"K9wsjEKy7yuvMIKAg4v8lbZU5kcTn/VlVYIZRLn+uqsHbAD3JkOTkCarwdHdrjSP5JRGpPxxBQr9svZC",
+	"B2LoKmBXWGqfZ+DKhWFzpqxWWTkIU5P6gV6cV4x0WKpZPJgHE8+a2/B3Xr4Ee+0J7VMUAgUJDU4E/I48",
+	"M9xEbEx0lM4rud+Vi7o74InClFBV/NKw+MEnur7htZIHdnuFkzz7BEfxuZ0LQUAcWHWidE6e5bOAM7f+",
+	"IG+idF6DPGUuo+2Lj8xmclUK+m0pV9C9pkDxLGvuBs5SzNvh3CGQzcqz35BnCZ1zQQ0LKy/m2g39RdO0",
+	"Ttf7Ax6eOw/AwN6yvDt+lR2pv0t/yKXbxPbZmf7feK/2C+f/wNt95ub6XxByeoKNZ5uuF3pDVxkEnkjO",
+	"ECzf7mQP+FfAltor04FUbCqpCjuoXrYdR/6Jq3qvpYKMiunKmfYIfG+N4qfkva+B6SoiQQ90q6TZSkKF",
+	"SlCrSm/WTb7CbqWKCuubrvy05Jmf5Kv6ykXu3RL+2r4vo5ejNOXh6Ni6XH4Yb4VRqwezUV08XA8h+SQb",
+	"QHI2VzRZtIJK4QrwAyyl69oWwIUbHrOIC2Y1+HuuUxq5ph/NIPADTt8CB7+k8dQWJjIywQkxKJ+LIEpD",
+	"VlvBLqlhAIem21a/Pl3fdC1d+PbAvoxL4SqtQ1IZUwQ/aIQtCwSNEGao4drwQPcpiZZ/ZTlIuTKavW9g",
+	"L1ipitjmGZXwlY1Tqou2f7x2V11Mv7KekW4O60d081sn/7iDLwJH/mMDcJx94mG7fBEyQ3nkSuMVQYVo",
+	"LuZRMWXmGUKJHhdLYY1BCAmYMHRebUSsgpzLsJM4wsNGcWTffKcPWF7gKTrgHBqWrxmghIS4wSyx94mj",
+	"pMWY/pg5Z4IpGrVrcvY9kkTUAIwXMfOZ628kZ1i/Uo8t8x7ny9NjS8x1Czb+4FazfyRxM7UgxxMFC39Z",
+	"vaGhh1qRv0oWXBupVkihrdxYEg1PyYUVymx+Inl+Tp7F9CP59rwFGkoqxMG4ej7rj3ZfKLJ/8dx98z6b",
+	"YMY+6FH6Fj+ouO1b+/sBdbFbOn+w/lXYkT8i3Ig7HEbtepqzUbANEaPxKcHOp1MWyJhpEtDE0Jr+brc4",
+	"8iFyOtDC0VDpHtTB4zVasasb8jy6OPiO2eSlZzqHK3udoRRCewGnzv4jeUMHkb9LLmztbLAguT4s9V0k",
+	"cHj4Zs8IBVO0oNNlea1HaF7YhlFD/OWfN+26DyYDsLfjccToPatH5J/gcWa4riyGnyEwvjsaulIMTKcv",
+	"qFooa4XVuLFK+gXXUypCW/2qCLHk2RsryNX4oG3IIk73MxsaVD2a4hq9wk7t5XeBIXBttAdX/4PbJBX7",
+	"vq3TmNU46gNQOF17xLV90eofQ4TdUCfmSHF1APYO5pvRaNVqc+DCWuC5FIROZWoyolxorXNKLmcQvG3r",
+	"Tfti09+cf1PpybYotRodSgz/lZuFw+AuEvmfSyRGUsU1Wu+5cNEn/a1d8aqdaGsZyS5dc+E9S6F9HXPs",
+	"9vbshkUsMOQGHv8sQ/ZVvRAL7zwGsw4IUlzFBBs3jgbF051Tf0tGBhONEGYUFXrG1Im3+dVC26170wfe",
+	"4OtEyYjVA5X/5k1mUNwnfK3N1gBkv7BltoMK4WLojjkUinli8kuGnQ6s9YInjYjfKcgSUb0oz2CaZa2E",
+	"0i7tw2tPqjR7V97wp7UK9hV7vG388qIGPEFyOXsxo52KfZulPJnRwEhVbM/tEwfzPi8FAbwKekGkgykP",
+	"AlFL+Q5X3C078qk1k4xX5MW715t5m3DEazd8FnKN9eRrZY4L+4Kuv+dTcp01vie372+vbO+eQN4ztaru",
+	"gA/SibtwN/6+5RJ/429kyHqVqBsaBX4RdM+BGSBGC0Yw0YwQTjuyxM+Xj9EsUMy44uIWCQDwsTS4HbAR",
+	"gW4XzBkgWFhGHVtzXC/k0lr8sOJ5Ez69FY8anfZAza/decFa9OC9HLyXD3UJvRUdSYXH1BPE1KYmTUlE",
+	"sflcFK2jtyMZEAakmXkYLy1hwkACBhLwpFn2NbMRrIat4UwLVmpm0qQeGX9wg2qHdYhlln+fktsmZWYF",
+	"wc0zkgrDI+T+9iswRAdWKGAhueeUXL2/uSUbEkUD4t7gkg+r+sCUT0Gtfgocw7b8Q6XL3WQdgLKYcoyz",
+	"T9JKRoFEQhMqCL4JwfNYU/TXBfM/hSziiAxcO9kyJNRDoAXWiIs7eKwxDsH2/QNYp2GomNYolrrmq64K",
+	"hxdkLXDzMlC/si1pllzbZjt+GPu5JjyOWcipYdHqlAC2Z33K0Bny+urSBrVVlFZMEQXe4qns2fWBi8WZ",
+	"WszS9pjhjLzRIqFaL6UKjxOVh2u2yx942+M2VA89Ubp6yCzlYQ7x68glDzEfg3fIJ2UfXQZEJOdckPxL",
+	"pIa2QntXQ+RlPu1BsxIKc6+eWgnkXkF3XKOZkhfPuR0Gzj4lSt7zkKnG+KkPAm7cMlEPFG6QVdY0HPip",
+	"ZXO2vXi0Iku6IhGbIceEamaEi5r4qjKMXLlFtXle/HsEnS2V/pckH+oLL3E5WCHaq7WiFOcAty+CnGUH",
+	"Xq8K+fb8gviXrfiIpstZJJe2eaHFJjR3egj2q+pEVL2is4kxr7M1PhrU2YP89h62mW11cGXuyD5gla4M",
+	"EgFKy8VyuuEJfNdk7feNO9cnchVRFsyiC6gL1uGZGfIdbigWcsUC42oFdsWNn2Bdx0SL/aliiBBvaBRN",
+	"aXB37IZR1TLXkE04MO+HZJV0ZN0taSXMkp4ih6UB1lmwPkP3D9sdWIpVDFdEFM0bCCsWy3sWjomWrvgC",
+	"uWMssfV0fPU2n1xQ8D4Up/TWDys0m8K8C6qJFKyn0SeXoX/et6PSTvXaLrcp5LW/oWcIDPgysncQQjxE",
+	"N6DqdqV8S18hYnSxPwyFfRuY9UOK+2Zosct4K7jJXuWBN+HJlltXzJZaT6gJFpur/JmqO12aiFBN8KMN",
+	"sRJG2ICky4trRsMD1tvc8gK6lcvsekVwbHWn1npLGUOoc9m8cT4QVI/dy1YZ4HOhiUxtnRDb018zrWGG",
+	"U+JYkiaBFSuJWSiZzhclo5W1ZMZ0RTQzhBYYMTcLHDmjJv25sHO9XJU53n69L36yDpwYjhBcVgNH/kJ8",
+	"I0/SP1GAvjq5wON0q0hAA8PvmUNq/1Wf8OgbP9Nhq9baWf8M7gidH3DbbbcmcV+ze3nHUD2quuO/6AIz",
+	"uEUKTbjWKQuRbnNDtJEJWUqFtqaih71Bn/IQcqii2gPF/UIirQBWPUA2QL8TJboqP7n00VnzufXCygEp",
+	"3OurS5z26MqEp0MlqW3tLsatmcggNWUjnBJ/KUlEuTDso7EPMJD8tNYeXbiHfWcj58d/XEOwX4cz9Paz",
+	"BXchQ7sCEzt7fsetCNuZWVFRGrWOzVjgeERM5sAapaOXPS/Ag73ulFYXS22IYgEQTP8hiWnIrN/JiRXr",
+	"xKKBpoLy7+ZvyxCF9x9Liui2pBy3OrQR/xITrZFNZmCfYUcDFsJ/LPh2sOL4l0/JTzzmxjpyv86CXROm",
+	"SEi3DHT94BdyCGuLn6wl3DUtr+nAwa0/DzGtjzcG/qmabQogXUMSOlZfsD1+K+pJwRg+jJ4r61oNC6Xu",
+	"63hxhwINj6kOW2enzJWS0PG1cyOsY/GYTcdNYle+BireCbCsl9beakNth0FNfmXTG4mtqgIpBAsQUmyD",
+	"YxqdGB6zYmn1NAmpqYaRXzd03+dV0u3NkptgAZahKyWNDGSk1/ZXtaLCHt/e+4bY8BXWjLewmKpo9HK0",
+	"MCbRL8/OaMJPAzOLGJ2n7FSl8MPZ/fPR53HxzaYXf//8/x8AF/f04jLCAwA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -13,6 +13,39 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for EntitySpecSyncItemAction.
+const (
+	EntitySpecSyncItemActionCreate    EntitySpecSyncItemAction = "create"
+	EntitySpecSyncItemActionFailed    EntitySpecSyncItemAction = "failed"
+	EntitySpecSyncItemActionUnchanged EntitySpecSyncItemAction = "unchanged"
+	EntitySpecSyncItemActionUpdate    EntitySpecSyncItemAction = "update"
+)
+
+// Defines values for RequestChallengeFlagRequestType.
+const (
+	RequestChallengeFlagRequestTypeRegex  RequestChallengeFlagRequestType = "regex"
+	RequestChallengeFlagRequestTypeStatic RequestChallengeFlagRequestType = "static"
+)
+
+// Defines values for RequestChallengeStageFlagRequestType.
+const (
+	RequestChallengeStageFlagRequestTypeRegex  RequestChallengeStageFlagRequestType = "regex"
+	RequestChallengeStageFlagRequestTypeStatic RequestChallengeStageFlagRequestType = "static"
+)
+
+// Defines values for RequestCreateAPITokenRequestScopes.
+const (
+	RequestCreateAPITokenRequestScopesAdmin          RequestCreateAPITokenRequestScopes = "admin"
+	RequestCreateAPITokenRequestScopesAdminBackup    RequestCreateAPITokenRequestScopes = "admin:backup"
+	RequestCreateAPITokenRequestScopesAdminRead      RequestCreateAPITokenRequestScopes = "admin:read"
+	RequestCreateAPITokenRequestScopesChallengesRead RequestCreateAPITokenRequestScopes = "challenges:read"
+	RequestCreateAPITokenRequestScopesCommentsWrite  RequestCreateAPITokenRequestScopes = "comments:write"
+	RequestCreateAPITokenRequestScopesProfileRead    RequestCreateAPITokenRequestScopes = "profile:read"
+	RequestCreateAPITokenRequestScopesProfileWrite   RequestCreateAPITokenRequestScopes = "profile:write"
+	RequestCreateAPITokenRequestScopesSubmit         RequestCreateAPITokenRequestScopes = "submit"
+	RequestCreateAPITokenRequestScopesTeamsWrite     RequestCreateAPITokenRequestScopes = "teams:write"
+)
+
 // Defines values for RequestCreateFieldRequestEntityType.
 const (
 	RequestCreateFieldRequestEntityTypeTeam RequestCreateFieldRequestEntityType = "team"
@@ -35,6 +68,25 @@ const (
 	RequestCreateNotificationRequestTypeWarning RequestCreateNotificationRequestType = "warning"
 )
 
+// Defines values for RequestCreateRegistrationDomainRuleRequestAction.
+const (
+	Allow RequestCreateRegistrationDomainRuleRequestAction = "allow"
+	Deny  RequestCreateRegistrationDomainRuleRequestAction = "deny"
+)
+
+// Defines values for RequestCreateServiceTokenRequestScopes.
+const (
+	RequestCreateServiceTokenRequestScopesAdmin          RequestCreateServiceTokenRequestScopes = "admin"
+	RequestCreateServiceTokenRequestScopesAdminBackup    RequestCreateServiceTokenRequestScopes = "admin:backup"
+	RequestCreateServiceTokenRequestScopesAdminRead      RequestCreateServiceTokenRequestScopes = "admin:read"
+	RequestCreateServiceTokenRequestScopesChallengesRead RequestCreateServiceTokenRequestScopes = "challenges:read"
+	RequestCreateServiceTokenRequestScopesCommentsWrite  RequestCreateServiceTokenRequestScopes = "comments:write"
+	RequestCreateServiceTokenRequestScopesProfileRead    RequestCreateServiceTokenRequestScopes = "profile:read"
+	RequestCreateServiceTokenRequestScopesProfileWrite   RequestCreateServiceTokenRequestScopes = "profile:write"
+	RequestCreateServiceTokenRequestScopesSubmit         RequestCreateServiceTokenRequestScopes = "submit"
+	RequestCreateServiceTokenRequestScopesTeamsWrite     RequestCreateServiceTokenRequestScopes = "teams:write"
+)
+
 // Defines values for RequestCreateUserNotificationRequestType.
 const (
 	RequestCreateUserNotificationRequestTypeError   RequestCreateUserNotificationRequestType = "error"
@@ -43,6 +95,13 @@ const (
 	RequestCreateUserNotificationRequestTypeWarning RequestCreateUserNotificationRequestType = "warning"
 )
 
+// Defines values for RequestDecoyPolicyRequestAction.
+const (
+	RequestDecoyPolicyRequestActionBan  RequestDecoyPolicyRequestAction = "ban"
+	RequestDecoyPolicyRequestActionHide RequestDecoyPolicyRequestAction = "hide"
+	RequestDecoyPolicyRequestActionNone RequestDecoyPolicyRequestAction = "none"
+)
+
 // Defines values for RequestSetConfigRequestValueType.
 const (
 	Bool   RequestSetConfigRequestValueType = "bool"
@@ -74,6 +133,51 @@ const (
 	Warning RequestUpdateNotificationRequestType = "warning"
 )
 
+// Defines values for ResponseChallengeInstanceResponseStatus.
+const (
+	ResponseChallengeInstanceResponseStatusFailed   ResponseChallengeInstanceResponseStatus = "failed"
+	ResponseChallengeInstanceResponseStatusRunning  ResponseChallengeInstanceResponseStatus = "running"
+	ResponseChallengeInstanceResponseStatusStarting ResponseChallengeInstanceResponseStatus = "starting"
+)
+
+// Defines values for ResponseChallengeScheduleResponseStatus.
+const (
+	ResponseChallengeScheduleResponseStatusEnded     ResponseChallengeScheduleResponseStatus = "ended"
+	ResponseChallengeScheduleResponseStatusLive      ResponseChallengeScheduleResponseStatus = "live"
+	ResponseChallengeScheduleResponseStatusScheduled ResponseChallengeScheduleResponseStatus = "scheduled"
+)
+
+// Defines values for ResponseCheatIncidentResponseKind.
+const (
+	ResponseCheatIncidentResponseKindCloseSolve      ResponseCheatIncidentResponseKind = "close_solve"
+	ResponseCheatIncidentResponseKindDecoyFlag       ResponseCheatIncidentResponseKind = "decoy_flag"
+	ResponseCheatIncidentResponseKindNoDownload      ResponseCheatIncidentResponseKind = "no_download"
+	ResponseCheatIncidentResponseKindSharedFlag      ResponseCheatIncidentResponseKind = "shared_flag"
+	ResponseCheatIncidentResponseKindSharedIP        ResponseCheatIncidentResponseKind = "shared_ip"
+	ResponseCheatIncidentResponseKindSharedWrongFlag ResponseCheatIncidentResponseKind = "shared_wrong_flag"
+)
+
+// Defines values for ResponseCheatIncidentResponseSeverity.
+const (
+	High   ResponseCheatIncidentResponseSeverity = "high"
+	Low    ResponseCheatIncidentResponseSeverity = "low"
+	Medium ResponseCheatIncidentResponseSeverity = "medium"
+)
+
+// Defines values for ResponseCheatIncidentResponseStatus.
+const (
+	ResponseCheatIncidentResponseStatusDismissed ResponseCheatIncidentResponseStatus = "dismissed"
+	ResponseCheatIncidentResponseStatusEscalated ResponseCheatIncidentResponseStatus = "escalated"
+	ResponseCheatIncidentResponseStatusOpen      ResponseCheatIncidentResponseStatus = "open"
+)
+
+// Defines values for ResponseDecoyPolicyResponseAction.
+const (
+	ResponseDecoyPolicyResponseActionBan  ResponseDecoyPolicyResponseAction = "ban"
+	ResponseDecoyPolicyResponseActionHide ResponseDecoyPolicyResponseAction = "hide"
+	ResponseDecoyPolicyResponseActionNone ResponseDecoyPolicyResponseAction = "none"
+)
+
 // Defines values for ResponseFieldResponseEntityType.
 const (
 	ResponseFieldResponseEntityTypeTeam ResponseFieldResponseEntityType = "team"
@@ -88,6 +192,37 @@ const (
 	Text    ResponseFieldResponseFieldType = "text"
 )
 
+// Defines values for ResponseReleaseTimelineEntryResponseStatus.
+const (
+	ResponseReleaseTimelineEntryResponseStatusEnded     ResponseReleaseTimelineEntryResponseStatus = "ended"
+	ResponseReleaseTimelineEntryResponseStatusLive      ResponseReleaseTimelineEntryResponseStatus = "live"
+	ResponseReleaseTimelineEntryResponseStatusScheduled ResponseReleaseTimelineEntryResponseStatus = "scheduled"
+)
+
+// Defines values for ResponseSubmissionReviewResponseStatus.
+const (
+	ResponseSubmissionReviewResponseStatusApproved ResponseSubmissionReviewResponseStatus = "approved"
+	ResponseSubmissionReviewResponseStatusPending  ResponseSubmissionReviewResponseStatus = "pending"
+	ResponseSubmissionReviewResponseStatusRejected ResponseSubmissionReviewResponseStatus = "rejected"
+)
+
+// Defines values for GetAdminCheatIncidentsParamsStatus.
+const (
+	GetAdminCheatIncidentsParamsStatusDismissed GetAdminCheatIncidentsParamsStatus = "dismissed"
+	GetAdminCheatIncidentsParamsStatusEscalated GetAdminCheatIncidentsParamsStatus = "escalated"
+	GetAdminCheatIncidentsParamsStatusOpen      GetAdminCheatIncidentsParamsStatus = "open"
+)
+
+// Defines values for GetAdminCheatIncidentsParamsKind.
+const (
+	GetAdminCheatIncidentsParamsKindCloseSolve      GetAdminCheatIncidentsParamsKind = "close_solve"
+	GetAdminCheatIncidentsParamsKindDecoyFlag       GetAdminCheatIncidentsParamsKind = "decoy_flag"
+	GetAdminCheatIncidentsParamsKindNoDownload      GetAdminCheatIncidentsParamsKind = "no_download"
+	GetAdminCheatIncidentsParamsKindSharedFlag      GetAdminCheatIncidentsParamsKind = "shared_flag"
+	GetAdminCheatIncidentsParamsKindSharedIP        GetAdminCheatIncidentsParamsKind = "shared_ip"
+	GetAdminCheatIncidentsParamsKindSharedWrongFlag GetAdminCheatIncidentsParamsKind = "shared_wrong_flag"
+)
+
 // Defines values for PostAdminImportMultipartBodyConflictMode.
 const (
 	Merge     PostAdminImportMultipartBodyConflictMode = "merge"
@@ -95,6 +230,13 @@ const (
 	Skip      PostAdminImportMultipartBodyConflictMode = "skip"
 )
 
+// Defines values for GetAdminReviewsParamsStatus.
+const (
+	GetAdminReviewsParamsStatusApproved GetAdminReviewsParamsStatus = "approved"
+	GetAdminReviewsParamsStatusPending  GetAdminReviewsParamsStatus = "pending"
+	GetAdminReviewsParamsStatusRejected GetAdminReviewsParamsStatus = "rejected"
+)
+
 // Defines values for GetFieldsParamsEntityType.
 const (
 	Team GetFieldsParamsEntityType = "team"
@@ -240,6 +382,40 @@ type EntitySolve struct {
 	UserID      *string `json:"user_id,omitempty"`
 }
 
+// EntitySpecProblem defines model for entity.SpecProblem.
+type EntitySpecProblem struct {
+	Message string `json:"message"`
+	Path    string `json:"path"`
+}
+
+// EntitySpecSyncItem defines model for entity.SpecSyncItem.
+type EntitySpecSyncItem struct {
+	Action        EntitySpecSyncItemAction `json:"action"`
+	Category      string                   `json:"category"`
+	ChallengeID   *string                  `json:"challenge_id,omitempty"`
+	ChangedFields []string                 `json:"changed_fields"`
+	Error         *string                  `json:"error,omitempty"`
+	Name          string                   `json:"name"`
+
+	// Path Path of the challenge.yml inside the upload
+	Path string `json:"path"`
+	Slug string `json:"slug"`
+}
+
+// EntitySpecSyncItemAction defines model for EntitySpecSyncItem.Action.
+type EntitySpecSyncItemAction string
+
+// EntitySpecSyncResult defines model for entity.SpecSyncResult.
+type EntitySpecSyncResult struct {
+	Created   int                  `json:"created"`
+	DryRun    bool                 `json:"dry_run"`
+	Failed    int                  `json:"failed"`
+	Items     []EntitySpecSyncItem `json:"items"`
+	Problems  []EntitySpecProblem  `json:"problems"`
+	Unchanged int                  `json:"unchanged"`
+	Updated   int                  `json:"updated"`
+}
+
 // EntityTeamExport defines model for entity.TeamExport.
 type EntityTeamExport struct {
 	CaptainID *string   `json:"captain_id,omitempty"`
@@ -279,17 +455,144 @@ type JwtTokenPair struct {
 	RefreshToken     *string `json:"refresh_token,omitempty"`
 }
 
+// RequestAdminResetPasswordRequest defines model for request.AdminResetPasswordRequest.
+type RequestAdminResetPasswordRequest struct {
+	Password string `json:"password"`
+}
+
+// RequestAdminUpdateUserRequest defines model for request.AdminUpdateUserRequest.
+type RequestAdminUpdateUserRequest struct {
+	Email      *openapi_types.Email `json:"email,omitempty"`
+	IsVerified *bool                `json:"is_verified,omitempty"`
+	Username   *string              `json:"username,omitempty"`
+}
+
+// RequestApproveReviewRequest defines model for request.ApproveReviewRequest.
+type RequestApproveReviewRequest struct {
+	// Comment Comment sent to the team with the verdict
+	Comment *string `json:"comment,omitempty"`
+
+	// Points Points to grant instead of the full challenge value
+	Points *int `json:"points,omitempty"`
+}
+
+// RequestAttemptLimitRequest defines model for request.AttemptLimitRequest.
+type RequestAttemptLimitRequest struct {
+	// MaxAttempts Wrong submissions each team may make; unlimited when omitted
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+
+	// WrongPenalty Points each wrong submission costs the team
+	WrongPenalty *int `json:"wrong_penalty,omitempty"`
+}
+
 // RequestBanTeamRequest defines model for request.BanTeamRequest.
 type RequestBanTeamRequest struct {
 	Reason string `json:"reason"`
 }
 
+// RequestBanUserRequest defines model for request.BanUserRequest.
+type RequestBanUserRequest struct {
+	Reason string `json:"reason"`
+}
+
+// RequestChallengeFlagRequest defines model for request.ChallengeFlagRequest.
+type RequestChallengeFlagRequest struct {
+	// Flag Flag value, or the pattern for regex flags
+	Flag              string                          `json:"flag"`
+	IsCaseInsensitive *bool                           `json:"is_case_insensitive,omitempty"`
+	Type              RequestChallengeFlagRequestType `json:"type"`
+}
+
+// RequestChallengeFlagRequestType defines model for RequestChallengeFlagRequest.Type.
+type RequestChallengeFlagRequestType string
+
+// RequestChallengeRequirementsRequest defines model for request.ChallengeRequirementsRequest.
+type RequestChallengeRequirementsRequest struct {
+	// MinScore Team score needed, 0 for none
+	MinScore *int `json:"min_score,omitempty"`
+
+	// Prerequisites Challenges the team must have solved
+	Prerequisites []openapi_types.UUID `json:"prerequisites"`
+}
+
+// RequestChallengeScheduleRequest defines model for request.ChallengeScheduleRequest.
+type RequestChallengeScheduleRequest struct {
+	// HideAt Challenge is hidden again at this time; never when omitted
+	HideAt *time.Time `json:"hide_at,omitempty"`
+
+	// NotifyOnRelease Post a global notification when the challenge goes live
+	NotifyOnRelease *bool `json:"notify_on_release,omitempty"`
+
+	// ReleaseAt Challenge goes live at this time; live immediately when omitted
+	ReleaseAt *time.Time `json:"release_at,omitempty"`
+}
+
+// RequestChallengeScoringRequest defines model for request.ChallengeScoringRequest.
+type RequestChallengeScoringRequest struct {
+	Function string `json:"function"`
+
+	// Params Parameter values keyed by parameter name
+	Params map[string]float64 `json:"params"`
+}
+
+// RequestChallengeStageFlagRequest defines model for request.ChallengeStageFlagRequest.
+type RequestChallengeStageFlagRequest struct {
+	// Flag Flag value, or the pattern for regex flags
+	Flag              string                               `json:"flag"`
+	IsCaseInsensitive *bool                                `json:"is_case_insensitive,omitempty"`
+	Type              RequestChallengeStageFlagRequestType `json:"type"`
+}
+
+// RequestChallengeStageFlagRequestType defines model for RequestChallengeStageFlagRequest.Type.
+type RequestChallengeStageFlagRequestType string
+
+// RequestChallengeStageRequest defines model for request.ChallengeStageRequest.
+type RequestChallengeStageRequest struct {
+	Flags []RequestChallengeStageFlagRequest `json:"flags"`
+
+	// Points Points a team earns for completing the stage
+	Points int    `json:"points"`
+	Title  string `json:"title"`
+}
+
+// RequestChangeEmailRequest defines model for request.ChangeEmailRequest.
+type RequestChangeEmailRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password *string             `json:"password,omitempty"`
+}
+
+// RequestChangePasswordRequest defines model for request.ChangePasswordRequest.
+type RequestChangePasswordRequest struct {
+	CurrentPassword *string `json:"current_password,omitempty"`
+	NewPassword     string  `json:"new_password"`
+}
+
+// RequestChangeUsernameRequest defines model for request.ChangeUsernameRequest.
+type RequestChangeUsernameRequest struct {
+	Username string `json:"username"`
+}
+
+// RequestCheatAnalysisRequest defines model for request.CheatAnalysisRequest.
+type RequestCheatAnalysisRequest struct {
+	// MinTeams How many teams must submit the same wrong flag for it to be flagged; defaults to 2
+	MinTeams *int `json:"min_teams,omitempty"`
+
+	// WindowSeconds How close two solves of a challenge must be to be flagged; defaults to 10
+	WindowSeconds *int `json:"window_seconds,omitempty"`
+}
+
 // RequestCreateAPITokenRequest defines model for request.CreateAPITokenRequest.
 type RequestCreateAPITokenRequest struct {
 	Description *string    `json:"description,omitempty"`
 	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+
+	// Scopes Scopes granted to the token. Defaults to every non-admin scope; admin scopes require the admin role.
+	Scopes *[]RequestCreateAPITokenRequestScopes `json:"scopes,omitempty"`
 }
 
+// RequestCreateAPITokenRequestScopes defines model for RequestCreateAPITokenRequest.Scopes.
+type RequestCreateAPITokenRequestScopes string
+
 // RequestCreateAwardRequest defines model for request.CreateAwardRequest.
 type RequestCreateAwardRequest struct {
 	Description string `json:"description"`
@@ -383,6 +686,49 @@ type RequestCreatePageRequest struct {
 	Title      string  `json:"title"`
 }
 
+// RequestCreateRegistrationDomainRuleRequest defines model for request.CreateRegistrationDomainRuleRequest.
+type RequestCreateRegistrationDomainRuleRequest struct {
+	Action RequestCreateRegistrationDomainRuleRequestAction `json:"action"`
+
+	// BracketID Bracket for teams created by matching users; allow rules only
+	BracketID *openapi_types.UUID `json:"bracket_id,omitempty"`
+	Domain    string              `json:"domain"`
+}
+
+// RequestCreateRegistrationDomainRuleRequestAction defines model for RequestCreateRegistrationDomainRuleRequest.Action.
+type RequestCreateRegistrationDomainRuleRequestAction string
+
+// RequestCreateRegistrationInviteRequest defines model for request.CreateRegistrationInviteRequest.
+type RequestCreateRegistrationInviteRequest struct {
+	// Code Invite code; generated when empty
+	Code      *string    `json:"code,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// MaxUses Number of registrations the code admits; unlimited when omitted
+	MaxUses *int `json:"max_uses,omitempty"`
+}
+
+// RequestCreateRoleRequest defines model for request.CreateRoleRequest.
+type RequestCreateRoleRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// Name Lowercase letters, digits, "-" and "_", starting with a letter
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// RequestCreateServiceTokenRequest defines model for request.CreateServiceTokenRequest.
+type RequestCreateServiceTokenRequest struct {
+	Description *string    `json:"description,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+
+	// Scopes Admin scopes granted to the service token.
+	Scopes []RequestCreateServiceTokenRequestScopes `json:"scopes"`
+}
+
+// RequestCreateServiceTokenRequestScopes defines model for RequestCreateServiceTokenRequest.Scopes.
+type RequestCreateServiceTokenRequestScopes string
+
 // RequestCreateTagRequest defines model for request.CreateTagRequest.
 type RequestCreateTagRequest struct {
 	Color *string `json:"color,omitempty"`
@@ -405,11 +751,52 @@ type RequestCreateUserNotificationRequest struct {
 // RequestCreateUserNotificationRequestType defines model for RequestCreateUserNotificationRequest.Type.
 type RequestCreateUserNotificationRequestType string
 
+// RequestDecoyFlagRequest defines model for request.DecoyFlagRequest.
+type RequestDecoyFlagRequest struct {
+	// Flag Decoy value; stored hashed
+	Flag              string `json:"flag"`
+	IsCaseInsensitive *bool  `json:"is_case_insensitive,omitempty"`
+
+	// Note Where the decoy was planted
+	Note *string `json:"note,omitempty"`
+}
+
+// RequestDecoyPolicyRequest defines model for request.DecoyPolicyRequest.
+type RequestDecoyPolicyRequest struct {
+	Action RequestDecoyPolicyRequestAction `json:"action"`
+
+	// Threshold Distinct decoys a team must submit before the action applies
+	Threshold int `json:"threshold"`
+}
+
+// RequestDecoyPolicyRequestAction defines model for RequestDecoyPolicyRequest.Action.
+type RequestDecoyPolicyRequestAction string
+
+// RequestDeleteAccountRequest defines model for request.DeleteAccountRequest.
+type RequestDeleteAccountRequest struct {
+	Password *string `json:"password,omitempty"`
+}
+
+// RequestEscalateCheatIncidentRequest defines model for request.EscalateCheatIncidentRequest.
+type RequestEscalateCheatIncidentRequest struct {
+	// Reason Ban reason; defaults to one naming the incident kind
+	Reason *string `json:"reason,omitempty"`
+}
+
 // RequestForgotPasswordRequest defines model for request.ForgotPasswordRequest.
 type RequestForgotPasswordRequest struct {
 	Email *string `json:"email,omitempty"`
 }
 
+// RequestInstanceConfigRequest defines model for request.InstanceConfigRequest.
+type RequestInstanceConfigRequest struct {
+	// Image Image the instance provider starts for each team
+	Image string `json:"image"`
+
+	// TTLSeconds Instance lifetime in seconds
+	TTLSeconds int `json:"ttl_seconds"`
+}
+
 // RequestJoinTeamRequest defines model for request.JoinTeamRequest.
 type RequestJoinTeamRequest struct {
 	ConfirmReset *bool  `json:"confirm_reset,omitempty"`
@@ -422,13 +809,94 @@ type RequestLoginRequest struct {
 	Password string  `json:"password"`
 }
 
+// RequestLoginTwoFactorRequest defines model for request.LoginTwoFactorRequest.
+type RequestLoginTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+
+	// Code 6-digit TOTP code or a recovery code
+	Code string `json:"code"`
+}
+
+// RequestMoveUserTeamRequest defines model for request.MoveUserTeamRequest.
+type RequestMoveUserTeamRequest struct {
+	// TeamID Target team; null removes the user from their team
+	TeamID *openapi_types.UUID `json:"team_id"`
+}
+
+// RequestOAuthCallbackRequest defines model for request.OAuthCallbackRequest.
+type RequestOAuthCallbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+// RequestOAuthProviderRequest defines model for request.OAuthProviderRequest.
+type RequestOAuthProviderRequest struct {
+	// AuthURL Authorization endpoint for plain OAuth2 providers
+	AuthURL *string `json:"auth_url,omitempty"`
+
+	// AutoProvision Create an account on first login instead of requiring a linked identity
+	AutoProvision *bool  `json:"auto_provision,omitempty"`
+	ClientID      string `json:"client_id"`
+
+	// ClientSecret Stored encrypted. Leave empty to keep the current secret
+	ClientSecret *string `json:"client_secret,omitempty"`
+	DisplayName  *string `json:"display_name,omitempty"`
+	EmailClaim   *string `json:"email_claim,omitempty"`
+	Enabled      *bool   `json:"enabled,omitempty"`
+
+	// FieldClaims Maps registration field IDs to claims copied on provisioning
+	FieldClaims *map[string]string `json:"field_claims,omitempty"`
+
+	// Issuer OIDC issuer URL. When set, endpoints are discovered and the id_token is verified
+	Issuer      *string   `json:"issuer,omitempty"`
+	RedirectURL string    `json:"redirect_url"`
+	Scopes      *[]string `json:"scopes,omitempty"`
+
+	// SubjectClaim Claim holding the stable user ID (default sub)
+	SubjectClaim  *string `json:"subject_claim,omitempty"`
+	TokenURL      *string `json:"token_url,omitempty"`
+	UserinfoURL   *string `json:"userinfo_url,omitempty"`
+	UsernameClaim *string `json:"username_claim,omitempty"`
+}
+
+// RequestRefreshTokenRequest defines model for request.RefreshTokenRequest.
+type RequestRefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 // RequestRegisterRequest defines model for request.RegisterRequest.
 type RequestRegisterRequest struct {
 	// CustomFields Custom field values (field_id -> value)
 	CustomFields *map[string]string `json:"custom_fields,omitempty"`
 	Email        *string            `json:"email,omitempty"`
-	Password     *string            `json:"password,omitempty"`
-	Username     *string            `json:"username,omitempty"`
+
+	// InviteCode Required while registration is closed
+	InviteCode *string `json:"invite_code,omitempty"`
+	Password   *string `json:"password,omitempty"`
+	Username   *string `json:"username,omitempty"`
+}
+
+// RequestRejectReviewRequest defines model for request.RejectReviewRequest.
+type RequestRejectReviewRequest struct {
+	// Comment Comment sent to the team with the verdict
+	Comment *string `json:"comment,omitempty"`
+}
+
+// RequestRenderTeamFlagRequest defines model for request.RenderTeamFlagRequest.
+type RequestRenderTeamFlagRequest struct {
+	TeamID openapi_types.UUID `json:"team_id"`
+
+	// Text Text whose {{flag}} placeholders are replaced with the team's flag
+	Text *string `json:"text,omitempty"`
+}
+
+// RequestRescoreRequest defines model for request.RescoreRequest.
+type RequestRescoreRequest struct {
+	// ExcludeBanned Do not count solves of banned teams
+	ExcludeBanned *bool `json:"exclude_banned,omitempty"`
+
+	// ExcludeHidden Do not count solves of hidden teams
+	ExcludeHidden *bool `json:"exclude_hidden,omitempty"`
 }
 
 // RequestResetPasswordRequest defines model for request.ResetPasswordRequest.
@@ -437,6 +905,19 @@ type RequestResetPasswordRequest struct {
 	Token       string  `json:"token"`
 }
 
+// RequestScoringPreviewRequest defines model for request.ScoringPreviewRequest.
+type RequestScoringPreviewRequest struct {
+	// Elapsed Share of the event that has passed, from 0 to 1
+	Elapsed  *float64 `json:"elapsed,omitempty"`
+	Function string   `json:"function"`
+
+	// Params Parameter values keyed by parameter name
+	Params map[string]float64 `json:"params"`
+
+	// Solves Number of solves to compute the value for
+	Solves int `json:"solves"`
+}
+
 // RequestSetConfigRequest defines model for request.SetConfigRequest.
 type RequestSetConfigRequest struct {
 	Description *string                           `json:"description,omitempty"`
@@ -457,22 +938,59 @@ type RequestSetTeamBracketRequest struct {
 	BracketID *openapi_types.UUID `json:"bracket_id"`
 }
 
+// RequestSetUserRoleRequest defines model for request.SetUserRoleRequest.
+type RequestSetUserRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// RequestSolveGrantRequest defines model for request.SolveGrantRequest.
+type RequestSolveGrantRequest struct {
+	// SolvedAt When the solve happened; defaults to now
+	SolvedAt *time.Time `json:"solved_at,omitempty"`
+	TeamID   string     `json:"team_id"`
+
+	// UserID Team member credited as the solver; defaults to the team captain
+	UserID *string `json:"user_id,omitempty"`
+}
+
 // RequestSubmitFlagRequest defines model for request.SubmitFlagRequest.
 type RequestSubmitFlagRequest struct {
 	Flag string `json:"flag"`
 }
 
+// RequestTeamFlagRequest defines model for request.TeamFlagRequest.
+type RequestTeamFlagRequest struct {
+	// RotateSecret Generate a new secret, invalidating every flag handed out so far
+	RotateSecret *bool `json:"rotate_secret,omitempty"`
+
+	// Template Flag template with exactly one <hmacN> token, N between 4 and 64
+	Template string `json:"template"`
+}
+
 // RequestTransferCaptainRequest defines model for request.TransferCaptainRequest.
 type RequestTransferCaptainRequest struct {
 	NewCaptainID string `json:"new_captain_id"`
 }
 
+// RequestTwoFactorCodeRequest defines model for request.TwoFactorCodeRequest.
+type RequestTwoFactorCodeRequest struct {
+	// Code 6-digit TOTP code or a recovery code
+	Code string `json:"code"`
+}
+
 // RequestUpdateAppSettingsRequest defines model for request.UpdateAppSettingsRequest.
 type RequestUpdateAppSettingsRequest struct {
-	AppName                string                                            `json:"app_name"`
-	CorsOrigins            string                                            `json:"cors_origins"`
-	FrontendURL            string                                            `json:"frontend_url"`
-	RegistrationOpen       *bool                                             `json:"registration_open,omitempty"`
+	AppName     string `json:"app_name"`
+	CorsOrigins string `json:"cors_origins"`
+	FrontendURL string `json:"frontend_url"`
+
+	// RegistrationClosesAt Registration closes at this time; never when omitted
+	RegistrationClosesAt *time.Time `json:"registration_closes_at,omitempty"`
+	RegistrationOpen     *bool      `json:"registration_open,omitempty"`
+
+	// RegistrationOpensAt Registration opens at this time; open immediately when omitted
+	RegistrationOpensAt    *time.Time                                        `json:"registration_opens_at,omitempty"`
+	Require2FaForAdmins    *bool                                             `json:"require_2fa_for_admins,omitempty"`
 	ResendEnabled          *bool                                             `json:"resend_enabled,omitempty"`
 	ResendFromEmail        string                                            `json:"resend_from_email"`
 	ResendFromName         string                                            `json:"resend_from_name"`
@@ -515,6 +1033,17 @@ type RequestUpdateChallengeRequest struct {
 	Title  string    `json:"title"`
 }
 
+// RequestUpdateChallengeStageRequest defines model for request.UpdateChallengeStageRequest.
+type RequestUpdateChallengeStageRequest struct {
+	// Flags Replace the stage flags
+	Flags *[]RequestChallengeStageFlagRequest `json:"flags,omitempty"`
+
+	// OrderIndex New zero-based position of the stage
+	OrderIndex *int   `json:"order_index,omitempty"`
+	Points     int    `json:"points"`
+	Title      string `json:"title"`
+}
+
 // RequestUpdateCompetitionRequest defines model for request.UpdateCompetitionRequest.
 type RequestUpdateCompetitionRequest struct {
 	AllowTeamSwitch *bool      `json:"allow_team_switch,omitempty"`
@@ -567,6 +1096,12 @@ type RequestUpdatePageRequest struct {
 	Title      string  `json:"title"`
 }
 
+// RequestUpdateRoleRequest defines model for request.UpdateRoleRequest.
+type RequestUpdateRoleRequest struct {
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
 // RequestUpdateTagRequest defines model for request.UpdateTagRequest.
 type RequestUpdateTagRequest struct {
 	Color *string `json:"color,omitempty"`
@@ -579,36 +1114,89 @@ type ResponseAPITokenCreatedResponse struct {
 	Description *string    `json:"description,omitempty"`
 	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
 	ID          *string    `json:"id,omitempty"`
+	Scopes      *[]string  `json:"scopes,omitempty"`
 
 	// Token Plaintext token (shown once)
 	Token string `json:"token"`
 }
 
+// ResponseAPITokenRequestResponse defines model for response.APITokenRequestResponse.
+type ResponseAPITokenRequestResponse struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	IP        *string `json:"ip,omitempty"`
+	Method    *string `json:"method,omitempty"`
+	Path      *string `json:"path,omitempty"`
+	Status    *int    `json:"status,omitempty"`
+}
+
 // ResponseAPITokenResponse defines model for response.APITokenResponse.
 type ResponseAPITokenResponse struct {
 	CreatedAt   *string    `json:"created_at,omitempty"`
+	CreatedBy   *string    `json:"created_by,omitempty"`
 	Description *string    `json:"description,omitempty"`
 	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
 	ID          *string    `json:"id,omitempty"`
 	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	Scopes      *[]string  `json:"scopes,omitempty"`
+
+	// Service True for service tokens, which are not tied to a user
+	Service *bool `json:"service,omitempty"`
+}
+
+// ResponseAdminUserListResponse defines model for response.AdminUserListResponse.
+type ResponseAdminUserListResponse struct {
+	Items   *[]ResponseAdminUserResponse `json:"items,omitempty"`
+	Page    *int                         `json:"page,omitempty"`
+	PerPage *int                         `json:"per_page,omitempty"`
+	Total   *int                         `json:"total,omitempty"`
+}
+
+// ResponseAdminUserResponse defines model for response.AdminUserResponse.
+type ResponseAdminUserResponse struct {
+	BannedAt     *time.Time `json:"banned_at,omitempty"`
+	BannedReason *string    `json:"banned_reason,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	Email        string     `json:"email"`
+	ID           string     `json:"id"`
+	IsBanned     bool       `json:"is_banned"`
+	IsVerified   bool       `json:"is_verified"`
+	Role         string     `json:"role"`
+	TeamID       *string    `json:"team_id"`
+	Username     string     `json:"username"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
 }
 
 // ResponseAppSettingsResponse defines model for response.AppSettingsResponse.
 type ResponseAppSettingsResponse struct {
-	AppName                *string `json:"app_name,omitempty"`
-	CorsOrigins            *string `json:"cors_origins,omitempty"`
-	FrontendURL            *string `json:"frontend_url,omitempty"`
-	RegistrationOpen       *bool   `json:"registration_open,omitempty"`
-	ResendEnabled          *bool   `json:"resend_enabled,omitempty"`
-	ResendFromEmail        *string `json:"resend_from_email,omitempty"`
-	ResendFromName         *string `json:"resend_from_name,omitempty"`
-	ResetTTLHours          *int    `json:"reset_ttl_hours,omitempty"`
-	ScoreboardVisible      *string `json:"scoreboard_visible,omitempty"`
-	SubmitLimitDurationMin *int    `json:"submit_limit_duration_min,omitempty"`
-	SubmitLimitPerUser     *int    `json:"submit_limit_per_user,omitempty"`
-	UpdatedAt              *string `json:"updated_at,omitempty"`
-	VerifyEmails           *bool   `json:"verify_emails,omitempty"`
-	VerifyTTLHours         *int    `json:"verify_ttl_hours,omitempty"`
+	AppName                *string    `json:"app_name,omitempty"`
+	CorsOrigins            *string    `json:"cors_origins,omitempty"`
+	FrontendURL            *string    `json:"frontend_url,omitempty"`
+	RegistrationClosesAt   *time.Time `json:"registration_closes_at,omitempty"`
+	RegistrationOpen       *bool      `json:"registration_open,omitempty"`
+	RegistrationOpensAt    *time.Time `json:"registration_opens_at,omitempty"`
+	Require2FaForAdmins    *bool      `json:"require_2fa_for_admins,omitempty"`
+	ResendEnabled          *bool      `json:"resend_enabled,omitempty"`
+	ResendFromEmail        *string    `json:"resend_from_email,omitempty"`
+	ResendFromName         *string    `json:"resend_from_name,omitempty"`
+	ResetTTLHours          *int       `json:"reset_ttl_hours,omitempty"`
+	ScoreboardVisible      *string    `json:"scoreboard_visible,omitempty"`
+	SubmitLimitDurationMin *int       `json:"submit_limit_duration_min,omitempty"`
+	SubmitLimitPerUser     *int       `json:"submit_limit_per_user,omitempty"`
+	UpdatedAt              *string    `json:"updated_at,omitempty"`
+	VerifyEmails           *bool      `json:"verify_emails,omitempty"`
+	VerifyTTLHours         *int       `json:"verify_ttl_hours,omitempty"`
+}
+
+// ResponseAttemptLimitResponse defines model for response.AttemptLimitResponse.
+type ResponseAttemptLimitResponse struct {
+	ChallengeID string `json:"challenge_id"`
+
+	// MaxAttempts Wrong submissions each team may make; omitted when unlimited
+	MaxAttempts *int       `json:"max_attempts,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// WrongPenalty Points each wrong submission costs the team
+	WrongPenalty int `json:"wrong_penalty"`
 }
 
 // ResponseAwardResponse defines model for response.AwardResponse.
@@ -640,19 +1228,224 @@ type ResponseCTFEventResponse struct {
 	Weight    *float32   `json:"weight,omitempty"`
 }
 
+// ResponseChallengeFlagResponse defines model for response.ChallengeFlagResponse.
+type ResponseChallengeFlagResponse struct {
+	ChallengeID       string    `json:"challenge_id"`
+	CreatedAt         time.Time `json:"created_at"`
+	ID                string    `json:"id"`
+	IsCaseInsensitive bool      `json:"is_case_insensitive"`
+	Type              string    `json:"type"`
+}
+
+// ResponseChallengeInstanceResponse defines model for response.ChallengeInstanceResponse.
+type ResponseChallengeInstanceResponse struct {
+	ChallengeID string `json:"challenge_id"`
+
+	// ConnectionInfo Address to reach the instance, empty while it is starting
+	ConnectionInfo string                                  `json:"connection_info"`
+	CreatedAt      time.Time                               `json:"created_at"`
+	ExpiresAt      time.Time                               `json:"expires_at"`
+	ID             string                                  `json:"id"`
+	Status         ResponseChallengeInstanceResponseStatus `json:"status"`
+	TeamID         string                                  `json:"team_id"`
+}
+
+// ResponseChallengeInstanceResponseStatus defines model for ResponseChallengeInstanceResponse.Status.
+type ResponseChallengeInstanceResponseStatus string
+
+// ResponseChallengeRequirementsResponse defines model for response.ChallengeRequirementsResponse.
+type ResponseChallengeRequirementsResponse struct {
+	ChallengeID   string   `json:"challenge_id"`
+	MinScore      int      `json:"min_score"`
+	Prerequisites []string `json:"prerequisites"`
+}
+
 // ResponseChallengeResponse defines model for response.ChallengeResponse.
 type ResponseChallengeResponse struct {
-	Category    *string                `json:"category,omitempty"`
-	Description *string                `json:"description,omitempty"`
-	ID          *string                `json:"id,omitempty"`
-	IsHidden    *bool                  `json:"is_hidden,omitempty"`
-	Points      *int                   `json:"points,omitempty"`
-	SolveCount  *int                   `json:"solve_count,omitempty"`
-	Solved      *bool                  `json:"solved,omitempty"`
+	// AttemptsRemaining Wrong submissions the team has left; omitted when attempts are unlimited
+	AttemptsRemaining *int    `json:"attempts_remaining,omitempty"`
+	Category          *string `json:"category,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	ID                *string `json:"id,omitempty"`
+	IsHidden          *bool   `json:"is_hidden,omitempty"`
+
+	// Locked The team has not met the unlock requirements yet; the description is withheld
+	Locked *bool `json:"locked,omitempty"`
+
+	// MaxAttempts Wrong submissions each team may make; omitted when attempts are unlimited
+	MaxAttempts *int  `json:"max_attempts,omitempty"`
+	Points      *int  `json:"points,omitempty"`
+	SolveCount  *int  `json:"solve_count,omitempty"`
+	Solved      *bool `json:"solved,omitempty"`
+
+	// StagesCompleted Stages of a multi-stage challenge the team completed; omitted for challenges with a single flag
+	StagesCompleted *int `json:"stages_completed,omitempty"`
+
+	// StagesTotal Stages of a multi-stage challenge; omitted for challenges with a single flag
+	StagesTotal *int                   `json:"stages_total,omitempty"`
 	Tags        *[]ResponseTagResponse `json:"tags,omitempty"`
 	Title       *string                `json:"title,omitempty"`
+
+	// WrongPenalty Points each wrong submission costs the team; omitted when wrong submissions are free
+	WrongPenalty *int `json:"wrong_penalty,omitempty"`
+}
+
+// ResponseChallengeRevisionResponse defines model for response.ChallengeRevisionResponse.
+type ResponseChallengeRevisionResponse struct {
+	AuthorID    *string `json:"author_id,omitempty"`
+	AuthorName  *string `json:"author_name,omitempty"`
+	ChallengeID string  `json:"challenge_id"`
+
+	// ChangedFields Fields that differ from the previous revision
+	ChangedFields []string  `json:"changed_fields"`
+	CreatedAt     time.Time `json:"created_at"`
+	ID            string    `json:"id"`
+
+	// RestoredFrom Version this revision was rolled back to
+	RestoredFrom *int                              `json:"restored_from,omitempty"`
+	Snapshot     ResponseChallengeSnapshotResponse `json:"snapshot"`
+	Version      int                               `json:"version"`
+}
+
+// ResponseChallengeScheduleResponse defines model for response.ChallengeScheduleResponse.
+type ResponseChallengeScheduleResponse struct {
+	AnnouncedAt     *time.Time                              `json:"announced_at,omitempty"`
+	ChallengeID     string                                  `json:"challenge_id"`
+	HideAt          *time.Time                              `json:"hide_at,omitempty"`
+	NotifyOnRelease bool                                    `json:"notify_on_release"`
+	ReleaseAt       *time.Time                              `json:"release_at,omitempty"`
+	Status          ResponseChallengeScheduleResponseStatus `json:"status"`
 }
 
+// ResponseChallengeScheduleResponseStatus defines model for ResponseChallengeScheduleResponse.Status.
+type ResponseChallengeScheduleResponseStatus string
+
+// ResponseChallengeScoringResponse defines model for response.ChallengeScoringResponse.
+type ResponseChallengeScoringResponse struct {
+	ChallengeID string `json:"challenge_id"`
+	Function    string `json:"function"`
+
+	// IsDefault Whether the challenge keeps the default decay
+	IsDefault bool `json:"is_default"`
+
+	// Params Parameter values keyed by parameter name
+	Params map[string]float64 `json:"params"`
+
+	// Points Current value of the challenge
+	Points    int        `json:"points"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ResponseChallengeSnapshotResponse defines model for response.ChallengeSnapshotResponse.
+type ResponseChallengeSnapshotResponse struct {
+	Category          string                         `json:"category"`
+	Decay             int                            `json:"decay"`
+	Description       string                         `json:"description"`
+	FlagFormatRegex   *string                        `json:"flag_format_regex,omitempty"`
+	Hints             []ResponseRevisionHintResponse `json:"hints"`
+	InitialValue      int                            `json:"initial_value"`
+	IsCaseInsensitive bool                           `json:"is_case_insensitive"`
+	IsHidden          bool                           `json:"is_hidden"`
+	IsRegex           bool                           `json:"is_regex"`
+	MinValue          int                            `json:"min_value"`
+	Points            int                            `json:"points"`
+	TagIds            []string                       `json:"tag_ids"`
+	Title             string                         `json:"title"`
+}
+
+// ResponseChallengeStageAdminResponse defines model for response.ChallengeStageAdminResponse.
+type ResponseChallengeStageAdminResponse struct {
+	ChallengeID string                      `json:"challenge_id"`
+	CreatedAt   time.Time                   `json:"created_at"`
+	Flags       []ResponseStageFlagResponse `json:"flags"`
+	ID          string                      `json:"id"`
+	OrderIndex  int                         `json:"order_index"`
+	Points      int                         `json:"points"`
+	SolveCount  int                         `json:"solve_count"`
+	Title       string                      `json:"title"`
+}
+
+// ResponseChallengeStageResponse defines model for response.ChallengeStageResponse.
+type ResponseChallengeStageResponse struct {
+	// Completed Whether the team completed the stage
+	Completed  bool                        `json:"completed"`
+	FirstBlood *ResponseFirstBloodResponse `json:"first_blood,omitempty"`
+	ID         string                      `json:"id"`
+	OrderIndex int                         `json:"order_index"`
+	Points     int                         `json:"points"`
+	SolveCount int                         `json:"solve_count"`
+	Title      string                      `json:"title"`
+}
+
+// ResponseCheatAnalysisResponse defines model for response.CheatAnalysisResponse.
+type ResponseCheatAnalysisResponse struct {
+	CloseSolve      int `json:"close_solve"`
+	NoDownload      int `json:"no_download"`
+	SharedIP        int `json:"shared_ip"`
+	SharedWrongFlag int `json:"shared_wrong_flag"`
+
+	// Total Incidents recorded by this analysis
+	Total int `json:"total"`
+}
+
+// ResponseCheatEvidenceResponse defines model for response.CheatEvidenceResponse.
+type ResponseCheatEvidenceResponse struct {
+	// Flag Wrong flag the teams shared; only shown with flags.read
+	Flag *string `json:"flag,omitempty"`
+
+	// IP IP the teams shared
+	IP *string `json:"ip,omitempty"`
+
+	// SecondsApart Time between the two solves
+	SecondsApart *float64 `json:"seconds_apart,omitempty"`
+
+	// SubmissionIds Submissions the incident was inferred from, oldest first
+	SubmissionIds []string `json:"submission_ids"`
+}
+
+// ResponseCheatIncidentDetailResponse defines model for response.CheatIncidentDetailResponse.
+type ResponseCheatIncidentDetailResponse struct {
+	Incident    ResponseCheatIncidentResponse `json:"incident"`
+	Submissions []ResponseSubmissionResponse  `json:"submissions"`
+}
+
+// ResponseCheatIncidentListResponse defines model for response.CheatIncidentListResponse.
+type ResponseCheatIncidentListResponse struct {
+	Items   *[]ResponseCheatIncidentResponse `json:"items,omitempty"`
+	Page    *int                             `json:"page,omitempty"`
+	PerPage *int                             `json:"per_page,omitempty"`
+	Total   *int                             `json:"total,omitempty"`
+}
+
+// ResponseCheatIncidentResponse defines model for response.CheatIncidentResponse.
+type ResponseCheatIncidentResponse struct {
+	ChallengeID    *string                               `json:"challenge_id,omitempty"`
+	ChallengeTitle *string                               `json:"challenge_title,omitempty"`
+	CreatedAt      time.Time                             `json:"created_at"`
+	Evidence       ResponseCheatEvidenceResponse         `json:"evidence"`
+	ID             string                                `json:"id"`
+	Kind           ResponseCheatIncidentResponseKind     `json:"kind"`
+	ResolvedAt     *time.Time                            `json:"resolved_at,omitempty"`
+	ResolvedBy     *string                               `json:"resolved_by,omitempty"`
+	Severity       ResponseCheatIncidentResponseSeverity `json:"severity"`
+	SourceTeamID   *string                               `json:"source_team_id,omitempty"`
+	SourceTeamName *string                               `json:"source_team_name,omitempty"`
+	Status         ResponseCheatIncidentResponseStatus   `json:"status"`
+	TeamID         string                                `json:"team_id"`
+	TeamName       string                                `json:"team_name"`
+	UserID         *string                               `json:"user_id,omitempty"`
+	Username       *string                               `json:"username,omitempty"`
+}
+
+// ResponseCheatIncidentResponseKind defines model for ResponseCheatIncidentResponse.Kind.
+type ResponseCheatIncidentResponseKind string
+
+// ResponseCheatIncidentResponseSeverity defines model for ResponseCheatIncidentResponse.Severity.
+type ResponseCheatIncidentResponseSeverity string
+
+// ResponseCheatIncidentResponseStatus defines model for ResponseCheatIncidentResponse.Status.
+type ResponseCheatIncidentResponseStatus string
+
 // ResponseCommentResponse defines model for response.CommentResponse.
 type ResponseCommentResponse struct {
 	ChallengeID *string    `json:"challenge_id,omitempty"`
@@ -694,6 +1487,33 @@ type ResponseConfigResponse struct {
 	ValueType   string     `json:"value_type"`
 }
 
+// ResponseDecoyFlagResponse defines model for response.DecoyFlagResponse.
+type ResponseDecoyFlagResponse struct {
+	ChallengeID       string    `json:"challenge_id"`
+	CreatedAt         time.Time `json:"created_at"`
+	ID                string    `json:"id"`
+	IsCaseInsensitive bool      `json:"is_case_insensitive"`
+	Note              string    `json:"note"`
+}
+
+// ResponseDecoyPolicyResponse defines model for response.DecoyPolicyResponse.
+type ResponseDecoyPolicyResponse struct {
+	Action    ResponseDecoyPolicyResponseAction `json:"action"`
+	Threshold int                               `json:"threshold"`
+}
+
+// ResponseDecoyPolicyResponseAction defines model for ResponseDecoyPolicyResponse.Action.
+type ResponseDecoyPolicyResponseAction string
+
+// ResponseEmailChangeResponse defines model for response.EmailChangeResponse.
+type ResponseEmailChangeResponse struct {
+	// Email The account's current email address
+	Email string `json:"email"`
+
+	// Pending True when the change waits for confirmation via the link sent to the new address
+	Pending bool `json:"pending"`
+}
+
 // ResponseFieldResponse defines model for response.FieldResponse.
 type ResponseFieldResponse struct {
 	CreatedAt  *time.Time                       `json:"created_at,omitempty"`
@@ -755,13 +1575,49 @@ type ResponseHintResponse struct {
 	Unlocked   *bool   `json:"unlocked,omitempty"`
 }
 
+// ResponseInstanceConfigResponse defines model for response.InstanceConfigResponse.
+type ResponseInstanceConfigResponse struct {
+	ChallengeID string    `json:"challenge_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Image       string    `json:"image"`
+	TTLSeconds  int       `json:"ttl_seconds"`
+}
+
+// ResponseLockoutStatusResponse defines model for response.LockoutStatusResponse.
+type ResponseLockoutStatusResponse struct {
+	// FailedAttempts Failed attempts in the current window
+	FailedAttempts *int       `json:"failed_attempts,omitempty"`
+	Locked         *bool      `json:"locked,omitempty"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+}
+
+// ResponseLoginResponse defines model for response.LoginResponse.
+type ResponseLoginResponse struct {
+	AccessExpiresAt    *int    `json:"access_expires_at,omitempty"`
+	AccessToken        *string `json:"access_token,omitempty"`
+	ChallengeExpiresAt *int    `json:"challenge_expires_at,omitempty"`
+	ChallengeToken     *string `json:"challenge_token,omitempty"`
+	RefreshExpiresAt   *int    `json:"refresh_expires_at,omitempty"`
+	RefreshToken       *string `json:"refresh_token,omitempty"`
+
+	// TwoFactorRequired When true no tokens are returned; complete the login via POST /auth/login/2fa
+	TwoFactorRequired *bool `json:"two_factor_required,omitempty"`
+}
+
+// ResponseManualReviewResponse defines model for response.ManualReviewResponse.
+type ResponseManualReviewResponse struct {
+	ChallengeID string    `json:"challenge_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
 // ResponseMeResponse defines model for response.MeResponse.
 type ResponseMeResponse struct {
-	CreatedAt *string `json:"created_at,omitempty"`
-	Email     *string `json:"email,omitempty"`
-	ID        *string `json:"id,omitempty"`
+	CreatedAt   *string   `json:"created_at,omitempty"`
+	Email       *string   `json:"email,omitempty"`
+	ID          *string   `json:"id,omitempty"`
+	Permissions *[]string `json:"permissions,omitempty"`
 
-	// Role user role (admin, user)
+	// Role user role (admin, author, moderator, support, user or a custom role)
 	Role     *string `json:"role,omitempty"`
 	TeamID   *string `json:"team_id,omitempty"`
 	Username *string `json:"username,omitempty"`
@@ -777,6 +1633,37 @@ type ResponseNotificationResponse struct {
 	Type      *string `json:"type,omitempty"`
 }
 
+// ResponseOAuthAuthorizeResponse defines model for response.OAuthAuthorizeResponse.
+type ResponseOAuthAuthorizeResponse struct {
+	AuthorizationURL *string `json:"authorization_url,omitempty"`
+}
+
+// ResponseOAuthProviderPublicResponse defines model for response.OAuthProviderPublicResponse.
+type ResponseOAuthProviderPublicResponse struct {
+	DisplayName *string `json:"display_name,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// ResponseOAuthProviderResponse defines model for response.OAuthProviderResponse.
+type ResponseOAuthProviderResponse struct {
+	AuthURL         *string            `json:"auth_url,omitempty"`
+	AutoProvision   *bool              `json:"auto_provision,omitempty"`
+	ClientID        *string            `json:"client_id,omitempty"`
+	DisplayName     *string            `json:"display_name,omitempty"`
+	EmailClaim      *string            `json:"email_claim,omitempty"`
+	Enabled         *bool              `json:"enabled,omitempty"`
+	FieldClaims     *map[string]string `json:"field_claims,omitempty"`
+	HasClientSecret *bool              `json:"has_client_secret,omitempty"`
+	Issuer          *string            `json:"issuer,omitempty"`
+	Name            *string            `json:"name,omitempty"`
+	RedirectURL     *string            `json:"redirect_url,omitempty"`
+	Scopes          *[]string          `json:"scopes,omitempty"`
+	SubjectClaim    *string            `json:"subject_claim,omitempty"`
+	TokenURL        *string            `json:"token_url,omitempty"`
+	UserinfoURL     *string            `json:"userinfo_url,omitempty"`
+	UsernameClaim   *string            `json:"username_claim,omitempty"`
+}
+
 // ResponsePageListItemResponse defines model for response.PageListItemResponse.
 type ResponsePageListItemResponse struct {
 	ID         *string `json:"id,omitempty"`
@@ -797,6 +1684,11 @@ type ResponsePageResponse struct {
 	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
 }
 
+// ResponseRecoveryCodesResponse defines model for response.RecoveryCodesResponse.
+type ResponseRecoveryCodesResponse struct {
+	RecoveryCodes *[]string `json:"recovery_codes,omitempty"`
+}
+
 // ResponseRegisterResponse defines model for response.RegisterResponse.
 type ResponseRegisterResponse struct {
 	CreatedAt *string `json:"created_at,omitempty"`
@@ -805,6 +1697,117 @@ type ResponseRegisterResponse struct {
 	Username  *string `json:"username,omitempty"`
 }
 
+// ResponseRegistrationDomainRuleResponse defines model for response.RegistrationDomainRuleResponse.
+type ResponseRegistrationDomainRuleResponse struct {
+	Action    string    `json:"action"`
+	BracketID *string   `json:"bracket_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Domain    string    `json:"domain"`
+	ID        string    `json:"id"`
+}
+
+// ResponseRegistrationInviteResponse defines model for response.RegistrationInviteResponse.
+type ResponseRegistrationInviteResponse struct {
+	Code      string     `json:"code"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	ID        string     `json:"id"`
+	MaxUses   *int       `json:"max_uses,omitempty"`
+	Uses      int        `json:"uses"`
+}
+
+// ResponseRegistrationStatusResponse defines model for response.RegistrationStatusResponse.
+type ResponseRegistrationStatusResponse struct {
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+	Open     bool       `json:"open"`
+	OpensAt  *time.Time `json:"opens_at,omitempty"`
+}
+
+// ResponseReleaseTimelineEntryResponse defines model for response.ReleaseTimelineEntryResponse.
+type ResponseReleaseTimelineEntryResponse struct {
+	AnnouncedAt     *time.Time                                 `json:"announced_at,omitempty"`
+	Category        string                                     `json:"category"`
+	ChallengeID     string                                     `json:"challenge_id"`
+	HideAt          *time.Time                                 `json:"hide_at,omitempty"`
+	IsHidden        bool                                       `json:"is_hidden"`
+	NotifyOnRelease bool                                       `json:"notify_on_release"`
+	Points          int                                        `json:"points"`
+	ReleaseAt       *time.Time                                 `json:"release_at,omitempty"`
+	Status          ResponseReleaseTimelineEntryResponseStatus `json:"status"`
+	Title           string                                     `json:"title"`
+}
+
+// ResponseReleaseTimelineEntryResponseStatus defines model for ResponseReleaseTimelineEntryResponse.Status.
+type ResponseReleaseTimelineEntryResponseStatus string
+
+// ResponseRescoreReportResponse defines model for response.RescoreReportResponse.
+type ResponseRescoreReportResponse struct {
+	// Checked Number of challenges recounted
+	Checked       int                          `json:"checked"`
+	Drifts        []ResponseScoreDriftResponse `json:"drifts"`
+	ExcludeBanned bool                         `json:"exclude_banned"`
+	ExcludeHidden bool                         `json:"exclude_hidden"`
+
+	// Fixed The drifts were written back
+	Fixed bool `json:"fixed"`
+}
+
+// ResponseRevisionChangeResponse defines model for response.RevisionChangeResponse.
+type ResponseRevisionChangeResponse struct {
+	Field string `json:"field"`
+
+	// From Value in the older revision, omitted for the flag
+	From interface{} `json:"from,omitempty"`
+
+	// To Value in the newer revision, omitted for the flag
+	To interface{} `json:"to,omitempty"`
+}
+
+// ResponseRevisionDiffResponse defines model for response.RevisionDiffResponse.
+type ResponseRevisionDiffResponse struct {
+	ChallengeID string                           `json:"challenge_id"`
+	Changes     []ResponseRevisionChangeResponse `json:"changes"`
+	From        int                              `json:"from"`
+	To          int                              `json:"to"`
+}
+
+// ResponseRevisionHintResponse defines model for response.RevisionHintResponse.
+type ResponseRevisionHintResponse struct {
+	Content    string `json:"content"`
+	Cost       int    `json:"cost"`
+	ID         string `json:"id"`
+	OrderIndex int    `json:"order_index"`
+}
+
+// ResponseRevokeSessionsResponse defines model for response.RevokeSessionsResponse.
+type ResponseRevokeSessionsResponse struct {
+	// Revoked Number of sessions revoked
+	Revoked *int `json:"revoked,omitempty"`
+}
+
+// ResponseRoleResponse defines model for response.RoleResponse.
+type ResponseRoleResponse struct {
+	BuiltIn     *bool     `json:"built_in,omitempty"`
+	CreatedAt   *string   `json:"created_at,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Permissions *[]string `json:"permissions,omitempty"`
+}
+
+// ResponseScoreDriftResponse defines model for response.ScoreDriftResponse.
+type ResponseScoreDriftResponse struct {
+	ChallengeID string `json:"challenge_id"`
+
+	// Points Value recomputed from the solve count
+	Points int `json:"points"`
+
+	// SolveCount Solves counted in the solves table
+	SolveCount       int    `json:"solve_count"`
+	StoredPoints     int    `json:"stored_points"`
+	StoredSolveCount int    `json:"stored_solve_count"`
+	Title            string `json:"title"`
+}
+
 // ResponseScoreboardEntryResponse defines model for response.ScoreboardEntryResponse.
 type ResponseScoreboardEntryResponse struct {
 	LastSolved *string `json:"last_solved,omitempty"`
@@ -813,6 +1816,60 @@ type ResponseScoreboardEntryResponse struct {
 	TeamName   *string `json:"team_name,omitempty"`
 }
 
+// ResponseScoringFunctionResponse defines model for response.ScoringFunctionResponse.
+type ResponseScoringFunctionResponse struct {
+	Description string                         `json:"description"`
+	Name        string                         `json:"name"`
+	Params      []ResponseScoringParamResponse `json:"params"`
+
+	// TimeBased Whether the value changes as the event goes on rather than only on solves. The new value applies to every solve of the challenge, past ones included.
+	TimeBased bool `json:"time_based"`
+}
+
+// ResponseScoringParamResponse defines model for response.ScoringParamResponse.
+type ResponseScoringParamResponse struct {
+	Description string `json:"description"`
+
+	// Integer Whether the value must be a whole number
+	Integer bool    `json:"integer"`
+	Max     float64 `json:"max"`
+	Min     float64 `json:"min"`
+	Name    string  `json:"name"`
+}
+
+// ResponseScoringPreviewResponse defines model for response.ScoringPreviewResponse.
+type ResponseScoringPreviewResponse struct {
+	Function string `json:"function"`
+
+	// Points Value after each solve, starting with the first
+	Points []int `json:"points"`
+}
+
+// ResponseSessionResponse defines model for response.SessionResponse.
+type ResponseSessionResponse struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Current True for the session the request was made with
+	Current    *bool      `json:"current,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	ID         *string    `json:"id,omitempty"`
+	IP         *string    `json:"ip,omitempty"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	UserAgent  *string    `json:"user_agent,omitempty"`
+}
+
+// ResponseSolveGrantResponse defines model for response.SolveGrantResponse.
+type ResponseSolveGrantResponse struct {
+	ChallengeID string `json:"challenge_id"`
+
+	// FirstBlood The team now holds first blood on the challenge
+	FirstBlood bool      `json:"first_blood"`
+	ID         string    `json:"id"`
+	SolvedAt   time.Time `json:"solved_at"`
+	TeamID     string    `json:"team_id"`
+	UserID     string    `json:"user_id"`
+}
+
 // ResponseSolveResponse defines model for response.SolveResponse.
 type ResponseSolveResponse struct {
 	ChallengeID *string `json:"challenge_id,omitempty"`
@@ -820,6 +1877,12 @@ type ResponseSolveResponse struct {
 	SolvedAt    *string `json:"solved_at,omitempty"`
 }
 
+// ResponseStageFlagResponse defines model for response.StageFlagResponse.
+type ResponseStageFlagResponse struct {
+	IsCaseInsensitive bool   `json:"is_case_insensitive"`
+	Type              string `json:"type"`
+}
+
 // ResponseSubmissionListResponse defines model for response.SubmissionListResponse.
 type ResponseSubmissionListResponse struct {
 	Items   *[]ResponseSubmissionResponse `json:"items,omitempty"`
@@ -844,6 +1907,37 @@ type ResponseSubmissionResponse struct {
 	Username          *string `json:"username,omitempty"`
 }
 
+// ResponseSubmissionReviewListResponse defines model for response.SubmissionReviewListResponse.
+type ResponseSubmissionReviewListResponse struct {
+	Items   *[]ResponseSubmissionReviewResponse `json:"items,omitempty"`
+	Page    *int                                `json:"page,omitempty"`
+	PerPage *int                                `json:"per_page,omitempty"`
+	Total   *int                                `json:"total,omitempty"`
+}
+
+// ResponseSubmissionReviewResponse defines model for response.SubmissionReviewResponse.
+type ResponseSubmissionReviewResponse struct {
+	Answer         string    `json:"answer"`
+	ChallengeID    string    `json:"challenge_id"`
+	ChallengeTitle *string   `json:"challenge_title,omitempty"`
+	Comment        string    `json:"comment"`
+	CreatedAt      time.Time `json:"created_at"`
+	ID             string    `json:"id"`
+
+	// Points Points granted on approval
+	Points     *int                                   `json:"points,omitempty"`
+	ReviewedAt *time.Time                             `json:"reviewed_at,omitempty"`
+	ReviewedBy *string                                `json:"reviewed_by,omitempty"`
+	Status     ResponseSubmissionReviewResponseStatus `json:"status"`
+	TeamID     string                                 `json:"team_id"`
+	TeamName   *string                                `json:"team_name,omitempty"`
+	UserID     string                                 `json:"user_id"`
+	Username   *string                                `json:"username,omitempty"`
+}
+
+// ResponseSubmissionReviewResponseStatus defines model for ResponseSubmissionReviewResponse.Status.
+type ResponseSubmissionReviewResponseStatus string
+
 // ResponseSubmissionStatsResponse defines model for response.SubmissionStatsResponse.
 type ResponseSubmissionStatsResponse struct {
 	Correct   *int `json:"correct,omitempty"`
@@ -858,6 +1952,20 @@ type ResponseTagResponse struct {
 	Name  *string `json:"name,omitempty"`
 }
 
+// ResponseTeamFlagRenderResponse defines model for response.TeamFlagRenderResponse.
+type ResponseTeamFlagRenderResponse struct {
+	Flag   string `json:"flag"`
+	TeamID string `json:"team_id"`
+	Text   string `json:"text"`
+}
+
+// ResponseTeamFlagResponse defines model for response.TeamFlagResponse.
+type ResponseTeamFlagResponse struct {
+	ChallengeID string    `json:"challenge_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Template    string    `json:"template"`
+}
+
 // ResponseTeamRatingItemResponse defines model for response.TeamRatingItemResponse.
 type ResponseTeamRatingItemResponse struct {
 	CreatedAt    *time.Time `json:"created_at,omitempty"`
@@ -896,6 +2004,32 @@ type ResponseTeamWithMembersResponse struct {
 	Name         *string                 `json:"name,omitempty"`
 }
 
+// ResponseTwoFactorSetupResponse defines model for response.TwoFactorSetupResponse.
+type ResponseTwoFactorSetupResponse struct {
+	// OtpauthURL otpauth:// URI to render as a QR code
+	OtpauthURL *string `json:"otpauth_url,omitempty"`
+
+	// Secret Base32 TOTP secret for manual entry
+	Secret *string `json:"secret,omitempty"`
+}
+
+// ResponseTwoFactorStatusResponse defines model for response.TwoFactorStatusResponse.
+type ResponseTwoFactorStatusResponse struct {
+	Enabled           *bool `json:"enabled,omitempty"`
+	RecoveryCodesLeft *int  `json:"recovery_codes_left,omitempty"`
+
+	// Required True when the 2FA policy applies to the user
+	Required *bool `json:"required,omitempty"`
+}
+
+// ResponseUserIdentityResponse defines model for response.UserIdentityResponse.
+type ResponseUserIdentityResponse struct {
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Email       *string    `json:"email,omitempty"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	Provider    *string    `json:"provider,omitempty"`
+}
+
 // ResponseUserNotificationResponse defines model for response.UserNotificationResponse.
 type ResponseUserNotificationResponse struct {
 	Content   *string `json:"content,omitempty"`
@@ -929,6 +2063,24 @@ type V1ErrorResponse struct {
 	Error *string `json:"error,omitempty"`
 }
 
+// GetAdminChallengeSpecsExportParams defines parameters for GetAdminChallengeSpecsExport.
+type GetAdminChallengeSpecsExportParams struct {
+	// Challenge Export only this challenge
+	Challenge *openapi_types.UUID `form:"challenge,omitempty" json:"challenge,omitempty"`
+
+	// Category Export only challenges of this category
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+}
+
+// PostAdminChallengeSpecsImportMultipartBody defines parameters for PostAdminChallengeSpecsImport.
+type PostAdminChallengeSpecsImportMultipartBody struct {
+	// DryRun Only report what the import would change
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// File ZIP archive or challenge.yml
+	File openapi_types.File `json:"file"`
+}
+
 // PostAdminChallengesChallengeIDFilesMultipartBody defines parameters for PostAdminChallengesChallengeIDFiles.
 type PostAdminChallengesChallengeIDFilesMultipartBody struct {
 	// File File to upload
@@ -938,6 +2090,29 @@ type PostAdminChallengesChallengeIDFilesMultipartBody struct {
 	Type *string `json:"type,omitempty"`
 }
 
+// GetAdminChallengesChallengeIDRevisionsDiffParams defines parameters for GetAdminChallengesChallengeIDRevisionsDiff.
+type GetAdminChallengesChallengeIDRevisionsDiffParams struct {
+	// From Version to compare from
+	From int `form:"from" json:"from"`
+
+	// To Version to compare to
+	To int `form:"to" json:"to"`
+}
+
+// GetAdminCheatIncidentsParams defines parameters for GetAdminCheatIncidents.
+type GetAdminCheatIncidentsParams struct {
+	Status  *GetAdminCheatIncidentsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Kind    *GetAdminCheatIncidentsParamsKind   `form:"kind,omitempty" json:"kind,omitempty"`
+	Page    *int                                `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int                                `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// GetAdminCheatIncidentsParamsStatus defines parameters for GetAdminCheatIncidents.
+type GetAdminCheatIncidentsParamsStatus string
+
+// GetAdminCheatIncidentsParamsKind defines parameters for GetAdminCheatIncidents.
+type GetAdminCheatIncidentsParamsKind string
+
 // GetAdminExportParams defines parameters for GetAdminExport.
 type GetAdminExportParams struct {
 	// IncludeUsers Include user data in export
@@ -977,6 +2152,25 @@ type PostAdminImportMultipartBody struct {
 // PostAdminImportMultipartBodyConflictMode defines parameters for PostAdminImport.
 type PostAdminImportMultipartBodyConflictMode string
 
+// GetAdminReviewsParams defines parameters for GetAdminReviews.
+type GetAdminReviewsParams struct {
+	Status  *GetAdminReviewsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page    *int                         `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int                         `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// GetAdminReviewsParamsStatus defines parameters for GetAdminReviews.
+type GetAdminReviewsParamsStatus string
+
+// GetAdminScoringConsistencyParams defines parameters for GetAdminScoringConsistency.
+type GetAdminScoringConsistencyParams struct {
+	// ExcludeBanned Do not count solves of banned teams
+	ExcludeBanned *bool `form:"exclude_banned,omitempty" json:"exclude_banned,omitempty"`
+
+	// ExcludeHidden Do not count solves of hidden teams
+	ExcludeHidden *bool `form:"exclude_hidden,omitempty" json:"exclude_hidden,omitempty"`
+}
+
 // GetAdminSubmissionsParams defines parameters for GetAdminSubmissions.
 type GetAdminSubmissionsParams struct {
 	Page    *int `form:"page,omitempty" json:"page,omitempty"`
@@ -1001,6 +2195,39 @@ type GetAdminSubmissionsUserUserIDParams struct {
 	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
 }
 
+// GetAdminUsersParams defines parameters for GetAdminUsers.
+type GetAdminUsersParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Search Matches username, email or any custom field value (case-insensitive substring)
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Field Custom field ID; field_value is matched against this field only
+	Field *openapi_types.UUID `form:"field,omitempty" json:"field,omitempty"`
+
+	// FieldValue Substring of the value of the custom field given by field
+	FieldValue *string `form:"field_value,omitempty" json:"field_value,omitempty"`
+
+	// Verified Filter by email verification
+	Verified *bool `form:"verified,omitempty" json:"verified,omitempty"`
+
+	// Banned Filter by ban status
+	Banned *bool `form:"banned,omitempty" json:"banned,omitempty"`
+
+	// Role Filter by role
+	Role *string `form:"role,omitempty" json:"role,omitempty"`
+
+	// Team Filter by team
+	Team *openapi_types.UUID `form:"team,omitempty" json:"team,omitempty"`
+}
+
+// GetAuthConfirmEmailParams defines parameters for GetAuthConfirmEmail.
+type GetAuthConfirmEmailParams struct {
+	// Token Email change token
+	Token string `form:"token" json:"token"`
+}
+
 // GetAuthVerifyEmailParams defines parameters for GetAuthVerifyEmail.
 type GetAuthVerifyEmailParams struct {
 	// Token Verification token
@@ -1066,18 +2293,60 @@ type PostAdminBracketsJSONRequestBody = RequestCreateBracketRequest
 // PutAdminBracketsIDJSONRequestBody defines body for PutAdminBracketsID for application/json ContentType.
 type PutAdminBracketsIDJSONRequestBody = RequestUpdateBracketRequest
 
+// PostAdminChallengeSpecsImportMultipartRequestBody defines body for PostAdminChallengeSpecsImport for multipart/form-data ContentType.
+type PostAdminChallengeSpecsImportMultipartRequestBody PostAdminChallengeSpecsImportMultipartBody
+
 // PostAdminChallengesJSONRequestBody defines body for PostAdminChallenges for application/json ContentType.
 type PostAdminChallengesJSONRequestBody = RequestCreateChallengeRequest
 
 // PutAdminChallengesIDJSONRequestBody defines body for PutAdminChallengesID for application/json ContentType.
 type PutAdminChallengesIDJSONRequestBody = RequestUpdateChallengeRequest
 
+// PutAdminChallengesChallengeIDAttemptLimitJSONRequestBody defines body for PutAdminChallengesChallengeIDAttemptLimit for application/json ContentType.
+type PutAdminChallengesChallengeIDAttemptLimitJSONRequestBody = RequestAttemptLimitRequest
+
+// PostAdminChallengesChallengeIDDecoysJSONRequestBody defines body for PostAdminChallengesChallengeIDDecoys for application/json ContentType.
+type PostAdminChallengesChallengeIDDecoysJSONRequestBody = RequestDecoyFlagRequest
+
 // PostAdminChallengesChallengeIDFilesMultipartRequestBody defines body for PostAdminChallengesChallengeIDFiles for multipart/form-data ContentType.
 type PostAdminChallengesChallengeIDFilesMultipartRequestBody PostAdminChallengesChallengeIDFilesMultipartBody
 
+// PostAdminChallengesChallengeIDFlagsJSONRequestBody defines body for PostAdminChallengesChallengeIDFlags for application/json ContentType.
+type PostAdminChallengesChallengeIDFlagsJSONRequestBody = RequestChallengeFlagRequest
+
 // PostAdminChallengesChallengeIDHintsJSONRequestBody defines body for PostAdminChallengesChallengeIDHints for application/json ContentType.
 type PostAdminChallengesChallengeIDHintsJSONRequestBody = RequestCreateHintRequest
 
+// PutAdminChallengesChallengeIDInstanceConfigJSONRequestBody defines body for PutAdminChallengesChallengeIDInstanceConfig for application/json ContentType.
+type PutAdminChallengesChallengeIDInstanceConfigJSONRequestBody = RequestInstanceConfigRequest
+
+// PutAdminChallengesChallengeIDRequirementsJSONRequestBody defines body for PutAdminChallengesChallengeIDRequirements for application/json ContentType.
+type PutAdminChallengesChallengeIDRequirementsJSONRequestBody = RequestChallengeRequirementsRequest
+
+// PutAdminChallengesChallengeIDScheduleJSONRequestBody defines body for PutAdminChallengesChallengeIDSchedule for application/json ContentType.
+type PutAdminChallengesChallengeIDScheduleJSONRequestBody = RequestChallengeScheduleRequest
+
+// PutAdminChallengesChallengeIDScoringJSONRequestBody defines body for PutAdminChallengesChallengeIDScoring for application/json ContentType.
+type PutAdminChallengesChallengeIDScoringJSONRequestBody = RequestChallengeScoringRequest
+
+// PostAdminChallengesChallengeIDSolvesJSONRequestBody defines body for PostAdminChallengesChallengeIDSolves for application/json ContentType.
+type PostAdminChallengesChallengeIDSolvesJSONRequestBody = RequestSolveGrantRequest
+
+// PostAdminChallengesChallengeIDStagesJSONRequestBody defines body for PostAdminChallengesChallengeIDStages for application/json ContentType.
+type PostAdminChallengesChallengeIDStagesJSONRequestBody = RequestChallengeStageRequest
+
+// PutAdminChallengesChallengeIDTeamFlagJSONRequestBody defines body for PutAdminChallengesChallengeIDTeamFlag for application/json ContentType.
+type PutAdminChallengesChallengeIDTeamFlagJSONRequestBody = RequestTeamFlagRequest
+
+// PostAdminChallengesChallengeIDTeamFlagRenderJSONRequestBody defines body for PostAdminChallengesChallengeIDTeamFlagRender for application/json ContentType.
+type PostAdminChallengesChallengeIDTeamFlagRenderJSONRequestBody = RequestRenderTeamFlagRequest
+
+// PostAdminCheatIncidentsAnalyzeJSONRequestBody defines body for PostAdminCheatIncidentsAnalyze for application/json ContentType.
+type PostAdminCheatIncidentsAnalyzeJSONRequestBody = RequestCheatAnalysisRequest
+
+// PostAdminCheatIncidentsIDEscalateJSONRequestBody defines body for PostAdminCheatIncidentsIDEscalate for application/json ContentType.
+type PostAdminCheatIncidentsIDEscalateJSONRequestBody = RequestEscalateCheatIncidentRequest
+
 // PutAdminCompetitionJSONRequestBody defines body for PutAdminCompetition for application/json ContentType.
 type PutAdminCompetitionJSONRequestBody = RequestUpdateCompetitionRequest
 
@@ -1087,12 +2356,18 @@ type PutAdminConfigsKeyJSONRequestBody = RequestSetConfigRequest
 // PostAdminCtfEventsJSONRequestBody defines body for PostAdminCtfEvents for application/json ContentType.
 type PostAdminCtfEventsJSONRequestBody = RequestCreateCTFEventRequest
 
+// PutAdminDecoyPolicyJSONRequestBody defines body for PutAdminDecoyPolicy for application/json ContentType.
+type PutAdminDecoyPolicyJSONRequestBody = RequestDecoyPolicyRequest
+
 // PostAdminFieldsJSONRequestBody defines body for PostAdminFields for application/json ContentType.
 type PostAdminFieldsJSONRequestBody = RequestCreateFieldRequest
 
 // PutAdminFieldsIDJSONRequestBody defines body for PutAdminFieldsID for application/json ContentType.
 type PutAdminFieldsIDJSONRequestBody = RequestUpdateFieldRequest
 
+// PutAdminFlagsIDJSONRequestBody defines body for PutAdminFlagsID for application/json ContentType.
+type PutAdminFlagsIDJSONRequestBody = RequestChallengeFlagRequest
+
 // PutAdminHintsIDJSONRequestBody defines body for PutAdminHintsID for application/json ContentType.
 type PutAdminHintsIDJSONRequestBody = RequestUpdateHintRequest
 
@@ -1108,15 +2383,45 @@ type PostAdminNotificationsUserUserIDJSONRequestBody = RequestCreateUserNotifica
 // PutAdminNotificationsIDJSONRequestBody defines body for PutAdminNotificationsID for application/json ContentType.
 type PutAdminNotificationsIDJSONRequestBody = RequestUpdateNotificationRequest
 
+// PutAdminOauthProvidersProviderJSONRequestBody defines body for PutAdminOauthProvidersProvider for application/json ContentType.
+type PutAdminOauthProvidersProviderJSONRequestBody = RequestOAuthProviderRequest
+
 // PostAdminPagesJSONRequestBody defines body for PostAdminPages for application/json ContentType.
 type PostAdminPagesJSONRequestBody = RequestCreatePageRequest
 
 // PutAdminPagesIDJSONRequestBody defines body for PutAdminPagesID for application/json ContentType.
 type PutAdminPagesIDJSONRequestBody = RequestUpdatePageRequest
 
+// PostAdminRegistrationDomainsJSONRequestBody defines body for PostAdminRegistrationDomains for application/json ContentType.
+type PostAdminRegistrationDomainsJSONRequestBody = RequestCreateRegistrationDomainRuleRequest
+
+// PostAdminRegistrationInvitesJSONRequestBody defines body for PostAdminRegistrationInvites for application/json ContentType.
+type PostAdminRegistrationInvitesJSONRequestBody = RequestCreateRegistrationInviteRequest
+
+// PostAdminReviewsIDApproveJSONRequestBody defines body for PostAdminReviewsIDApprove for application/json ContentType.
+type PostAdminReviewsIDApproveJSONRequestBody = RequestApproveReviewRequest
+
+// PostAdminReviewsIDRejectJSONRequestBody defines body for PostAdminReviewsIDReject for application/json ContentType.
+type PostAdminReviewsIDRejectJSONRequestBody = RequestRejectReviewRequest
+
+// PostAdminRolesJSONRequestBody defines body for PostAdminRoles for application/json ContentType.
+type PostAdminRolesJSONRequestBody = RequestCreateRoleRequest
+
+// PutAdminRolesNameJSONRequestBody defines body for PutAdminRolesName for application/json ContentType.
+type PutAdminRolesNameJSONRequestBody = RequestUpdateRoleRequest
+
+// PostAdminScoringPreviewJSONRequestBody defines body for PostAdminScoringPreview for application/json ContentType.
+type PostAdminScoringPreviewJSONRequestBody = RequestScoringPreviewRequest
+
+// PostAdminScoringRescoreJSONRequestBody defines body for PostAdminScoringRescore for application/json ContentType.
+type PostAdminScoringRescoreJSONRequestBody = RequestRescoreRequest
+
 // PutAdminSettingsJSONRequestBody defines body for PutAdminSettings for application/json ContentType.
 type PutAdminSettingsJSONRequestBody = RequestUpdateAppSettingsRequest
 
+// PutAdminStagesIDJSONRequestBody defines body for PutAdminStagesID for application/json ContentType.
+type PutAdminStagesIDJSONRequestBody = RequestUpdateChallengeStageRequest
+
 // PostAdminTagsJSONRequestBody defines body for PostAdminTags for application/json ContentType.
 type PostAdminTagsJSONRequestBody = RequestCreateTagRequest
 
@@ -1132,12 +2437,42 @@ type PatchAdminTeamsIDBracketJSONRequestBody = RequestSetTeamBracketRequest
 // PatchAdminTeamsIDHiddenJSONRequestBody defines body for PatchAdminTeamsIDHidden for application/json ContentType.
 type PatchAdminTeamsIDHiddenJSONRequestBody = RequestSetHiddenRequest
 
+// PostAdminTokensJSONRequestBody defines body for PostAdminTokens for application/json ContentType.
+type PostAdminTokensJSONRequestBody = RequestCreateServiceTokenRequest
+
+// PatchAdminUsersIDJSONRequestBody defines body for PatchAdminUsersID for application/json ContentType.
+type PatchAdminUsersIDJSONRequestBody = RequestAdminUpdateUserRequest
+
+// PostAdminUsersIDBanJSONRequestBody defines body for PostAdminUsersIDBan for application/json ContentType.
+type PostAdminUsersIDBanJSONRequestBody = RequestBanUserRequest
+
+// PutAdminUsersIDPasswordJSONRequestBody defines body for PutAdminUsersIDPassword for application/json ContentType.
+type PutAdminUsersIDPasswordJSONRequestBody = RequestAdminResetPasswordRequest
+
+// PutAdminUsersIDRoleJSONRequestBody defines body for PutAdminUsersIDRole for application/json ContentType.
+type PutAdminUsersIDRoleJSONRequestBody = RequestSetUserRoleRequest
+
+// PutAdminUsersIDTeamJSONRequestBody defines body for PutAdminUsersIDTeam for application/json ContentType.
+type PutAdminUsersIDTeamJSONRequestBody = RequestMoveUserTeamRequest
+
 // PostAuthForgotPasswordJSONRequestBody defines body for PostAuthForgotPassword for application/json ContentType.
 type PostAuthForgotPasswordJSONRequestBody = RequestForgotPasswordRequest
 
 // PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
 type PostAuthLoginJSONRequestBody = RequestLoginRequest
 
+// PostAuthLogin2FaJSONRequestBody defines body for PostAuthLogin2Fa for application/json ContentType.
+type PostAuthLogin2FaJSONRequestBody = RequestLoginTwoFactorRequest
+
+// PostAuthLogoutJSONRequestBody defines body for PostAuthLogout for application/json ContentType.
+type PostAuthLogoutJSONRequestBody = RequestRefreshTokenRequest
+
+// PostAuthOauthProviderCallbackJSONRequestBody defines body for PostAuthOauthProviderCallback for application/json ContentType.
+type PostAuthOauthProviderCallbackJSONRequestBody = RequestOAuthCallbackRequest
+
+// PostAuthRefreshJSONRequestBody defines body for PostAuthRefresh for application/json ContentType.
+type PostAuthRefreshJSONRequestBody = RequestRefreshTokenRequest
+
 // PostAuthRegisterJSONRequestBody defines body for PostAuthRegister for application/json ContentType.
 type PostAuthRegisterJSONRequestBody = RequestRegisterRequest
 
@@ -1162,5 +2497,29 @@ type PostTeamsSoloJSONRequestBody = RequestCreateTeamRequest
 // PostTeamsTransferCaptainJSONRequestBody defines body for PostTeamsTransferCaptain for application/json ContentType.
 type PostTeamsTransferCaptainJSONRequestBody = RequestTransferCaptainRequest
 
+// PostUser2FaDisableJSONRequestBody defines body for PostUser2FaDisable for application/json ContentType.
+type PostUser2FaDisableJSONRequestBody = RequestTwoFactorCodeRequest
+
+// PostUser2FaEnableJSONRequestBody defines body for PostUser2FaEnable for application/json ContentType.
+type PostUser2FaEnableJSONRequestBody = RequestTwoFactorCodeRequest
+
+// PostUser2FaRecoveryCodesJSONRequestBody defines body for PostUser2FaRecoveryCodes for application/json ContentType.
+type PostUser2FaRecoveryCodesJSONRequestBody = RequestTwoFactorCodeRequest
+
+// PutUserEmailJSONRequestBody defines body for PutUserEmail for application/json ContentType.
+type PutUserEmailJSONRequestBody = RequestChangeEmailRequest
+
+// PostUserIdentitiesProviderLinkJSONRequestBody defines body for PostUserIdentitiesProviderLink for application/json ContentType.
+type PostUserIdentitiesProviderLinkJSONRequestBody = RequestOAuthCallbackRequest
+
+// DeleteUserMeJSONRequestBody defines body for DeleteUserMe for application/json ContentType.
+type DeleteUserMeJSONRequestBody = RequestDeleteAccountRequest
+
+// PutUserPasswordJSONRequestBody defines body for PutUserPassword for application/json ContentType.
+type PutUserPasswordJSONRequestBody = RequestChangePasswordRequest
+
 // PostUserTokensJSONRequestBody defines body for PostUserTokens for application/json ContentType.
 type PostUserTokensJSONRequestBody = RequestCreateAPITokenRequest
+
+// PutUserUsernameJSONRequestBody defines body for PutUserUsername for application/json ContentType.
+type PutUserUsernameJSONRequestBody = RequestChangeUsernameRequest
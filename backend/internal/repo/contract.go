@@ -21,9 +21,35 @@ type (
 		GetByUsername(ctx context.Context, username string) (*entity.User, error)
 		GetByTeamID(ctx context.Context, teamID uuid.UUID) ([]*entity.User, error)
 		GetAll(ctx context.Context) ([]*entity.User, error)
+		List(ctx context.Context, filter *entity.UserFilter, limit, offset int) ([]*entity.User, error)
+		Count(ctx context.Context, filter *entity.UserFilter) (int64, error)
 		UpdateTeamID(ctx context.Context, userID uuid.UUID, teamID *uuid.UUID) error
 		SetVerified(ctx context.Context, userID uuid.UUID) error
 		UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash string) error
+		UpdateEmail(ctx context.Context, userID uuid.UUID, email string) error
+		UpdateRole(ctx context.Context, userID uuid.UUID, role string) error
+	}
+
+	RoleRepository interface {
+		Create(ctx context.Context, role *entity.Role) error
+		GetByName(ctx context.Context, name string) (*entity.Role, error)
+		GetAll(ctx context.Context) ([]*entity.Role, error)
+		Update(ctx context.Context, role *entity.Role) error
+		Delete(ctx context.Context, name string) error
+	}
+
+	RegistrationRepository interface {
+		CreateInvite(ctx context.Context, invite *entity.RegistrationInvite) error
+		GetInvites(ctx context.Context) ([]*entity.RegistrationInvite, error)
+		DeleteInvite(ctx context.Context, id uuid.UUID) error
+		CreateDomainRule(ctx context.Context, rule *entity.RegistrationDomainRule) error
+		GetDomainRules(ctx context.Context) ([]*entity.RegistrationDomainRule, error)
+		DeleteDomainRule(ctx context.Context, id uuid.UUID) error
+	}
+
+	ChallengeAuthorRepository interface {
+		Add(ctx context.Context, challengeID, userID uuid.UUID) error
+		IsAuthor(ctx context.Context, challengeID, userID uuid.UUID) (bool, error)
 	}
 
 	ChallengeRepository interface {
@@ -81,6 +107,8 @@ type (
 		GetScoreboardByBracketFrozen(ctx context.Context, freezeTime time.Time, bracketID *uuid.UUID) ([]*ScoreboardEntry, error)
 		GetFirstBlood(ctx context.Context, challengeID uuid.UUID) (*FirstBloodEntry, error)
 		GetTeamScore(ctx context.Context, teamID uuid.UUID) (int, error)
+		GetSolvedChallengeIDs(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error)
+		PinPoints(ctx context.Context, challengeID uuid.UUID, points int) error
 	}
 
 	CompetitionRepository interface {
@@ -108,6 +136,169 @@ type (
 		Delete(ctx context.Context, ID uuid.UUID) error
 	}
 
+	ChallengeFlagRepository interface {
+		Create(ctx context.Context, flag *entity.ChallengeFlag) error
+		GetByID(ctx context.Context, ID uuid.UUID) (*entity.ChallengeFlag, error)
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeFlag, error)
+		Update(ctx context.Context, flag *entity.ChallengeFlag) error
+		Delete(ctx context.Context, ID uuid.UUID) error
+	}
+
+	DecoyFlagRepository interface {
+		// Create returns ErrDecoyFlagExists when the challenge already has a decoy with the same hash.
+		Create(ctx context.Context, decoy *entity.DecoyFlag) error
+		GetByID(ctx context.Context, ID uuid.UUID) (*entity.DecoyFlag, error)
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.DecoyFlag, error)
+		Delete(ctx context.Context, ID uuid.UUID) error
+	}
+
+	ChallengeRequirementRepository interface {
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeRequirements, error)
+		GetAll(ctx context.Context) ([]*entity.ChallengeRequirements, error)
+		Set(ctx context.Context, req *entity.ChallengeRequirements) error
+	}
+
+	ChallengeScheduleRepository interface {
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeSchedule, error)
+		GetAll(ctx context.Context) ([]*ScheduledChallenge, error)
+		Set(ctx context.Context, schedule *entity.ChallengeSchedule) error
+		Delete(ctx context.Context, challengeID uuid.UUID) error
+		ClaimDueReleases(ctx context.Context) ([]*ScheduledChallenge, error)
+	}
+
+	ScheduledChallenge struct {
+		Schedule *entity.ChallengeSchedule
+		Title    string
+		Category string
+		Points   int
+		IsHidden bool
+	}
+
+	TeamFlagRepository interface {
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.TeamFlagConfig, error)
+		Upsert(ctx context.Context, cfg *entity.TeamFlagConfig) error
+		Delete(ctx context.Context, challengeID uuid.UUID) error
+	}
+
+	InstanceRepository interface {
+		GetConfig(ctx context.Context, challengeID uuid.UUID) (*entity.InstanceConfig, error)
+		UpsertConfig(ctx context.Context, cfg *entity.InstanceConfig) error
+		DeleteConfig(ctx context.Context, challengeID uuid.UUID) error
+		GetByChallengeAndTeam(ctx context.Context, challengeID, teamID uuid.UUID) (*entity.ChallengeInstance, error)
+		// Create returns ErrInstanceLimitReached when the team already runs maxPerTeam instances
+		// or an instance of the same challenge.
+		Create(ctx context.Context, inst *entity.ChallengeInstance, maxPerTeam int) error
+		UpdateConnectionInfo(ctx context.Context, id uuid.UUID, connectionInfo string) error
+		UpdateExpiry(ctx context.Context, id uuid.UUID, expiresAt time.Time) error
+		Delete(ctx context.Context, id uuid.UUID) error
+		GetExpired(ctx context.Context, now time.Time) ([]*entity.ChallengeInstance, error)
+	}
+
+	RevisionRepository interface {
+		// GetLatestTx returns ErrRevisionNotFound when the challenge has no revisions yet.
+		GetLatestTx(ctx context.Context, tx Transaction, challengeID uuid.UUID) (*entity.ChallengeRevision, error)
+		GetByVersion(ctx context.Context, challengeID uuid.UUID, version int) (*entity.ChallengeRevision, error)
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeRevision, error)
+		// CreateTx stores the revision under the next version of its challenge. Callers lock the
+		// challenge row first so versions are assigned one at a time.
+		CreateTx(ctx context.Context, tx Transaction, revision *entity.ChallengeRevision) error
+		// RestoreTx writes the snapshot back to the challenge row, its tag links and its hints.
+		// Hints missing from the snapshot are deleted, the others are recreated under their IDs.
+		RestoreTx(ctx context.Context, tx Transaction, challengeID uuid.UUID, snapshot *entity.ChallengeSnapshot) error
+	}
+
+	ChallengeSpecRepository interface {
+		// GetAll lists every challenge, hidden and unreleased ones included, with the slug it is
+		// synced under.
+		GetAll(ctx context.Context) ([]*entity.LinkedChallenge, error)
+		// Link binds slug to the challenge, replacing its previous slug. It returns
+		// ErrSpecSlugTaken when another challenge holds slug.
+		Link(ctx context.Context, challengeID uuid.UUID, slug string) error
+	}
+
+	ChallengeStageRepository interface {
+		// Create stores a stage and its flags after the last stage of its challenge.
+		Create(ctx context.Context, stage *entity.ChallengeStage) error
+		GetByID(ctx context.Context, ID uuid.UUID) (*entity.ChallengeStage, error)
+		// GetByChallengeID returns the stages of a challenge in order, flags included.
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeStage, error)
+		// Update stores the title and points of a stage and, when stage.Flags is not nil,
+		// replaces its flags.
+		Update(ctx context.Context, stage *entity.ChallengeStage) error
+		// Reorder numbers the given stages by their position in stageIDs.
+		Reorder(ctx context.Context, stageIDs []uuid.UUID) error
+		Delete(ctx context.Context, ID uuid.UUID) error
+		// GetProgress counts the stages of every multi-stage challenge and those teamID
+		// completed; a nil team completed none.
+		GetProgress(ctx context.Context, teamID *uuid.UUID) ([]*entity.StageProgress, error)
+		GetCompletedStageIDs(ctx context.Context, teamID, challengeID uuid.UUID) ([]uuid.UUID, error)
+		// CreateSolve returns the number of teams that completed the stage, this one included,
+		// counted while the stage row is locked, or ErrAlreadySolved when the team already
+		// completed it.
+		CreateSolve(ctx context.Context, solve *entity.StageSolve) (int, error)
+		CreateSolveTx(ctx context.Context, tx Transaction, solve *entity.StageSolve) error
+		GetAllSolves(ctx context.Context) ([]*entity.StageSolve, error)
+		GetFirstBloods(ctx context.Context, challengeID uuid.UUID) ([]*entity.StageFirstBlood, error)
+	}
+
+	ChallengeScoringRepository interface {
+		// GetByChallengeID returns nil for a challenge that keeps the default decay.
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeScoring, error)
+		// GetByFunctions returns the challenges scored by any of the named functions.
+		GetByFunctions(ctx context.Context, functions []string) ([]*ScoredChallenge, error)
+		Set(ctx context.Context, scoring *entity.ChallengeScoring) error
+		Delete(ctx context.Context, challengeID uuid.UUID) error
+	}
+
+	AttemptLimitRepository interface {
+		// GetByChallengeID returns ErrAttemptLimitNotConfigured for challenges without a limit.
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.AttemptLimit, error)
+		GetAll(ctx context.Context) ([]*entity.AttemptLimit, error)
+		Set(ctx context.Context, limit *entity.AttemptLimit) error
+		Delete(ctx context.Context, challengeID uuid.UUID) error
+		// ResetTeam stops the wrong submissions a team made on a challenge before at from
+		// counting towards the limit.
+		ResetTeam(ctx context.Context, challengeID, teamID uuid.UUID, at time.Time) error
+		GetAllResets(ctx context.Context) ([]*entity.AttemptReset, error)
+	}
+
+	ScoredChallenge struct {
+		Scoring    *entity.ChallengeScoring
+		Points     int
+		SolveCount int
+	}
+
+	RescoreRepository interface {
+		// ListSolveTallies returns every challenge, hidden ones included, with the number of solves
+		// the options let count.
+		ListSolveTallies(ctx context.Context, opts entity.RescoreOptions) ([]*ChallengeSolveTally, error)
+		CountSolvesTx(ctx context.Context, tx Transaction, challengeID uuid.UUID, opts entity.RescoreOptions) (int, error)
+		UpdateChallengeScoreTx(ctx context.Context, tx Transaction, challengeID uuid.UUID, solveCount, points int) error
+	}
+
+	ChallengeSolveTally struct {
+		Challenge *entity.Challenge
+		Solves    int
+	}
+
+	ReviewRepository interface {
+		GetConfig(ctx context.Context, challengeID uuid.UUID) (*entity.ManualReviewConfig, error)
+		UpsertConfig(ctx context.Context, challengeID uuid.UUID) error
+		DeleteConfig(ctx context.Context, challengeID uuid.UUID) error
+		// Create returns ErrReviewAlreadyPending when the team already waits on a review of the
+		// same challenge.
+		Create(ctx context.Context, review *entity.SubmissionReview) error
+		// LinkSubmission records which logged submission carried the team's pending answer, so it
+		// is not counted as a failed attempt. Returns ErrReviewNotFound when nothing was pending.
+		LinkSubmission(ctx context.Context, challengeID, teamID, submissionID uuid.UUID) error
+		GetByID(ctx context.Context, id uuid.UUID) (*entity.SubmissionReview, error)
+		GetAll(ctx context.Context, status *entity.ReviewStatus, limit, offset int) ([]*entity.SubmissionReviewWithDetails, error)
+		CountAll(ctx context.Context, status *entity.ReviewStatus) (int64, error)
+		// ResolveTx stores the verdict of a pending review and returns ErrReviewAlreadyResolved
+		// when another reviewer got there first.
+		ResolveTx(ctx context.Context, tx Transaction, review *entity.SubmissionReview) error
+	}
+
 	HintUnlockRepository interface {
 		GetByTeamAndHint(ctx context.Context, teamID, hintID uuid.UUID) (*entity.HintUnlock, error)
 		GetUnlockedHintIDs(ctx context.Context, teamID, challengeID uuid.UUID) ([]uuid.UUID, error)
@@ -126,6 +317,8 @@ type (
 		GetByChallengeID(ctx context.Context, challengeID uuid.UUID, fileType entity.FileType) ([]*entity.File, error)
 		GetAll(ctx context.Context) ([]*entity.File, error)
 		Delete(ctx context.Context, ID uuid.UUID) error
+		// RecordDownload does nothing when the file does not exist.
+		RecordDownload(ctx context.Context, download *entity.FileDownload) error
 	}
 
 	TxRepository interface {
@@ -136,16 +329,31 @@ type (
 		GetChallengeByIDTx(ctx context.Context, tx Transaction, ID uuid.UUID) (*entity.Challenge, error)
 		DeleteChallengeTx(ctx context.Context, tx Transaction, challengeID uuid.UUID) error
 		IncrementChallengeSolveCountTx(ctx context.Context, tx Transaction, ID uuid.UUID) (int, error)
+		DecrementChallengeSolveCountTx(ctx context.Context, tx Transaction, ID uuid.UUID) (int, error)
 		UpdateChallengePointsTx(ctx context.Context, tx Transaction, ID uuid.UUID, points int) error
 
 		CreateUserTx(ctx context.Context, tx Transaction, user *entity.User) error
+		CreateUserIdentityTx(ctx context.Context, tx Transaction, identity *entity.UserIdentity) error
+		ConsumeRegistrationInviteTx(ctx context.Context, tx Transaction, code string) (*entity.RegistrationInvite, error)
 		UpdateUserTeamIDTx(ctx context.Context, tx Transaction, userID uuid.UUID, teamID *uuid.UUID) error
+		UpdateUserTx(ctx context.Context, tx Transaction, user *entity.User) error
+		UpdateUserPasswordTx(ctx context.Context, tx Transaction, userID uuid.UUID, passwordHash string) error
+		UpdateUserRoleTx(ctx context.Context, tx Transaction, userID uuid.UUID, role string) error
+		BanUserTx(ctx context.Context, tx Transaction, userID uuid.UUID, reason string) error
+		UnbanUserTx(ctx context.Context, tx Transaction, userID uuid.UUID) error
+		DeleteUserTx(ctx context.Context, tx Transaction, userID uuid.UUID) error
+		AnonymizeUserTx(ctx context.Context, tx Transaction, userID uuid.UUID, username, email string) error
+
+		CreateRoleTx(ctx context.Context, tx Transaction, role *entity.Role) error
+		UpdateRoleTx(ctx context.Context, tx Transaction, role *entity.Role) error
+		DeleteRoleTx(ctx context.Context, tx Transaction, name string) error
 
 		CreateTeamTx(ctx context.Context, tx Transaction, team *entity.Team) error
 		GetTeamByIDTx(ctx context.Context, tx Transaction, ID uuid.UUID) (*entity.Team, error)
 		GetSoloTeamByUserIDTx(ctx context.Context, tx Transaction, userID uuid.UUID) (*entity.Team, error)
 
 		CreateSolveTx(ctx context.Context, tx Transaction, solve *entity.Solve) error
+		DeleteSolveTx(ctx context.Context, tx Transaction, solveID uuid.UUID) error
 		GetSolveByTeamAndChallengeTx(ctx context.Context, tx Transaction, teamID, challengeID uuid.UUID) (*entity.Solve, error)
 		GetTeamScoreTx(ctx context.Context, tx Transaction, teamID uuid.UUID) (int, error)
 
@@ -177,6 +385,36 @@ type (
 		DeleteByUserAndType(ctx context.Context, userID uuid.UUID, tokenType entity.TokenType) error
 	}
 
+	SessionRepository interface {
+		Create(ctx context.Context, session *entity.Session) error
+		GetByID(ctx context.Context, ID uuid.UUID) (*entity.Session, error)
+		GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+		Rotate(ctx context.Context, ID uuid.UUID, oldRefreshJTI, newRefreshJTI, newAccessJTI string, expiresAt time.Time) (bool, error)
+		Touch(ctx context.Context, ID uuid.UUID, lastSeenAt time.Time) error
+		Revoke(ctx context.Context, ID uuid.UUID) error
+		RevokeAllByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+		RevokeAllByTeamID(ctx context.Context, teamID uuid.UUID) (int64, error)
+	}
+
+	TwoFactorRepository interface {
+		Upsert(ctx context.Context, tf *entity.TwoFactor) error
+		GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.TwoFactor, error)
+		Enable(ctx context.Context, userID uuid.UUID, step int64, codeHashes []string) error
+		ConsumeStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+		ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+		UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+		CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error)
+		Delete(ctx context.Context, userID uuid.UUID) error
+	}
+
+	UserIdentityRepository interface {
+		Create(ctx context.Context, identity *entity.UserIdentity) error
+		GetByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error)
+		GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserIdentity, error)
+		TouchLogin(ctx context.Context, id uuid.UUID, at time.Time) error
+		Delete(ctx context.Context, userID uuid.UUID, provider string) error
+	}
+
 	AuditLogRepository interface {
 		Create(ctx context.Context, log *entity.AuditLog) error
 	}
@@ -208,14 +446,57 @@ type (
 		GetByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.SubmissionWithDetails, error)
 		GetByTeam(ctx context.Context, teamID uuid.UUID, limit, offset int) ([]*entity.SubmissionWithDetails, error)
 		GetAll(ctx context.Context, limit, offset int) ([]*entity.SubmissionWithDetails, error)
+		GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.SubmissionWithDetails, error)
 		CountByChallenge(ctx context.Context, challengeID uuid.UUID) (int64, error)
 		CountByUser(ctx context.Context, userID uuid.UUID) (int64, error)
 		CountByTeam(ctx context.Context, teamID uuid.UUID) (int64, error)
 		CountAll(ctx context.Context) (int64, error)
 		CountFailedByIP(ctx context.Context, ip string, since time.Time) (int64, error)
+		// CountFailedByTeamAndChallenge counts the wrong submissions of a team made since its
+		// attempts on the challenge were last reset. Answers queued for manual review only count
+		// once the review rejects them.
+		CountFailedByTeamAndChallenge(ctx context.Context, teamID, challengeID uuid.UUID) (int64, error)
+		// CountFailedByTeam is CountFailedByTeamAndChallenge for every challenge at once, keyed by
+		// challenge; challenges without wrong submissions are absent.
+		CountFailedByTeam(ctx context.Context, teamID uuid.UUID) (map[uuid.UUID]int, error)
 		GetStats(ctx context.Context, challengeID uuid.UUID) (*entity.SubmissionStats, error)
 	}
 
+	CheatIncidentRepository interface {
+		// Create returns ErrCheatIncidentExists when an incident with the same fingerprint is
+		// already recorded.
+		Create(ctx context.Context, incident *entity.CheatIncident) error
+		GetByID(ctx context.Context, id uuid.UUID) (*entity.CheatIncidentWithDetails, error)
+		GetAll(ctx context.Context, filter entity.CheatIncidentFilter, limit, offset int) ([]*entity.CheatIncidentWithDetails, error)
+		CountAll(ctx context.Context, filter entity.CheatIncidentFilter) (int64, error)
+		CountByTeamAndKind(ctx context.Context, teamID uuid.UUID, kind entity.CheatIncidentKind) (int64, error)
+		// Resolve returns ErrCheatIncidentResolved when the incident is no longer open.
+		Resolve(ctx context.Context, id uuid.UUID, status entity.CheatIncidentStatus, resolvedBy *uuid.UUID) error
+	}
+
+	// CheatAnalyticsRepository finds the submission patterns the anti-cheat analysis reports.
+	// Only submissions made on behalf of a team are considered.
+	CheatAnalyticsRepository interface {
+		// GetSharedIPSubmissions returns the earliest submission of each team from every IP
+		// more than one team submitted from, ordered by IP.
+		GetSharedIPSubmissions(ctx context.Context) ([]*entity.Submission, error)
+		// GetSharedWrongFlagSubmissions returns the earliest submission of each team for every
+		// wrong flag at least minTeams teams submitted to a challenge, grouped by challenge
+		// and flag.
+		GetSharedWrongFlagSubmissions(ctx context.Context, minTeams int) ([]*entity.Submission, error)
+		// GetCloseSolves pairs each correct submission with the correct submissions other teams
+		// made for the same challenge at most window before it.
+		GetCloseSolves(ctx context.Context, window time.Duration) ([]*CloseSolve, error)
+		// GetSolvesWithoutDownload returns the correct submissions of users who had not
+		// downloaded any of the challenge files, for challenges that had files at the time.
+		GetSolvesWithoutDownload(ctx context.Context) ([]*entity.Submission, error)
+	}
+
+	CloseSolve struct {
+		Solve    *entity.Submission
+		Previous *entity.Submission
+	}
+
 	NotificationRepository interface {
 		Create(ctx context.Context, notif *entity.Notification) error
 		GetByID(ctx context.Context, id uuid.UUID) (*entity.Notification, error)
@@ -266,9 +547,14 @@ type (
 	APITokenRepository interface {
 		Create(ctx context.Context, token *entity.APIToken) error
 		GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.APIToken, error)
+		GetServiceTokens(ctx context.Context) ([]*entity.APIToken, error)
+		GetByID(ctx context.Context, id uuid.UUID) (*entity.APIToken, error)
 		GetByTokenHash(ctx context.Context, tokenHash string) (*entity.APIToken, error)
 		Delete(ctx context.Context, id, userID uuid.UUID) error
+		DeleteServiceToken(ctx context.Context, id uuid.UUID) error
 		UpdateLastUsedAt(ctx context.Context, id uuid.UUID, at time.Time) error
+		CreateRequest(ctx context.Context, req *entity.APITokenRequest) error
+		GetRequests(ctx context.Context, tokenID uuid.UUID, limit int) ([]*entity.APITokenRequest, error)
 	}
 
 	CommentRepository interface {
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
 )
 
@@ -22,6 +23,32 @@ func NewAPITokenRepo(pool *pgxpool.Pool) *APITokenRepo {
 	}
 }
 
+func toEntityAPIToken(row sqlc.ApiToken) *entity.APIToken {
+	scopes := row.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &entity.APIToken{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		TokenHash:   row.TokenHash,
+		Description: ptrStrToStr(row.Description),
+		Scopes:      scopes,
+		CreatedBy:   row.CreatedBy,
+		ExpiresAt:   row.ExpiresAt,
+		LastUsedAt:  row.LastUsedAt,
+		CreatedAt:   ptrTimeToTime(row.CreatedAt),
+	}
+}
+
+func toEntityAPITokens(rows []sqlc.ApiToken) []*entity.APIToken {
+	out := make([]*entity.APIToken, len(rows))
+	for i, row := range rows {
+		out[i] = toEntityAPIToken(row)
+	}
+	return out
+}
+
 func (r *APITokenRepo) Create(ctx context.Context, token *entity.APIToken) error {
 	if token.ID == uuid.Nil {
 		token.ID = uuid.New()
@@ -29,6 +56,9 @@ func (r *APITokenRepo) Create(ctx context.Context, token *entity.APIToken) error
 	if token.CreatedAt.IsZero() {
 		token.CreatedAt = time.Now()
 	}
+	if token.Scopes == nil {
+		token.Scopes = []string{}
+	}
 	desc := strPtrOrNil(token.Description)
 	var expiresAt *time.Time
 	if token.ExpiresAt != nil && !token.ExpiresAt.IsZero() {
@@ -40,29 +70,38 @@ func (r *APITokenRepo) Create(ctx context.Context, token *entity.APIToken) error
 		UserID:      token.UserID,
 		TokenHash:   token.TokenHash,
 		Description: desc,
+		Scopes:      token.Scopes,
+		CreatedBy:   token.CreatedBy,
 		ExpiresAt:   expiresAt,
 		CreatedAt:   createdAt,
 	})
 }
 
 func (r *APITokenRepo) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.APIToken, error) {
-	rows, err := r.q.GetAPITokensByUserID(ctx, userID)
+	rows, err := r.q.GetAPITokensByUserID(ctx, &userID)
 	if err != nil {
 		return nil, err
 	}
-	out := make([]*entity.APIToken, len(rows))
-	for i, row := range rows {
-		out[i] = &entity.APIToken{
-			ID:          row.ID,
-			UserID:      row.UserID,
-			TokenHash:   row.TokenHash,
-			Description: ptrStrToStr(row.Description),
-			ExpiresAt:   row.ExpiresAt,
-			LastUsedAt:  row.LastUsedAt,
-			CreatedAt:   ptrTimeToTime(row.CreatedAt),
+	return toEntityAPITokens(rows), nil
+}
+
+func (r *APITokenRepo) GetServiceTokens(ctx context.Context) ([]*entity.APIToken, error) {
+	rows, err := r.q.GetServiceAPITokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toEntityAPITokens(rows), nil
+}
+
+func (r *APITokenRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.APIToken, error) {
+	row, err := r.q.GetAPITokenByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrAPITokenNotFound
 		}
+		return nil, err
 	}
-	return out, nil
+	return toEntityAPIToken(row), nil
 }
 
 func (r *APITokenRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.APIToken, error) {
@@ -73,19 +112,22 @@ func (r *APITokenRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*e
 		}
 		return nil, err
 	}
-	return &entity.APIToken{
-		ID:          row.ID,
-		UserID:      row.UserID,
-		TokenHash:   row.TokenHash,
-		Description: ptrStrToStr(row.Description),
-		ExpiresAt:   row.ExpiresAt,
-		LastUsedAt:  row.LastUsedAt,
-		CreatedAt:   ptrTimeToTime(row.CreatedAt),
-	}, nil
+	return toEntityAPIToken(row), nil
 }
 
 func (r *APITokenRepo) Delete(ctx context.Context, id, userID uuid.UUID) error {
-	return r.q.DeleteAPIToken(ctx, sqlc.DeleteAPITokenParams{ID: id, UserID: userID})
+	return r.q.DeleteAPIToken(ctx, sqlc.DeleteAPITokenParams{ID: id, UserID: &userID})
+}
+
+func (r *APITokenRepo) DeleteServiceToken(ctx context.Context, id uuid.UUID) error {
+	n, err := r.q.DeleteServiceAPIToken(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return entityError.ErrAPITokenNotFound
+	}
+	return nil
 }
 
 func (r *APITokenRepo) UpdateLastUsedAt(ctx context.Context, id uuid.UUID, at time.Time) error {
@@ -94,3 +136,52 @@ func (r *APITokenRepo) UpdateLastUsedAt(ctx context.Context, id uuid.UUID, at ti
 		LastUsedAt: &at,
 	})
 }
+
+func (r *APITokenRepo) CreateRequest(ctx context.Context, req *entity.APITokenRequest) error {
+	if req.ID == uuid.Nil {
+		req.ID = uuid.New()
+	}
+	if req.CreatedAt.IsZero() {
+		req.CreatedAt = time.Now()
+	}
+	status, err := intToInt32Safe(req.Status)
+	if err != nil {
+		return err
+	}
+	return r.q.CreateAPITokenRequest(ctx, sqlc.CreateAPITokenRequestParams{
+		ID:        req.ID,
+		TokenID:   req.TokenID,
+		Method:    req.Method,
+		Path:      req.Path,
+		Status:    status,
+		Ip:        strPtrOrNil(req.IP),
+		CreatedAt: &req.CreatedAt,
+	})
+}
+
+func (r *APITokenRepo) GetRequests(ctx context.Context, tokenID uuid.UUID, limit int) ([]*entity.APITokenRequest, error) {
+	lim, err := intToInt32Safe(limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.GetAPITokenRequests(ctx, sqlc.GetAPITokenRequestsParams{
+		TokenID: tokenID,
+		Limit:   lim,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.APITokenRequest, len(rows))
+	for i, row := range rows {
+		out[i] = &entity.APITokenRequest{
+			ID:        row.ID,
+			TokenID:   row.TokenID,
+			Method:    row.Method,
+			Path:      row.Path,
+			Status:    int(row.Status),
+			IP:        ptrStrToStr(row.Ip),
+			CreatedAt: ptrTimeToTime(row.CreatedAt),
+		}
+	}
+	return out, nil
+}
@@ -36,6 +36,9 @@ func toEntityAppSettings(s sqlc.AppSetting) *entity.AppSettings {
 		SubmitLimitDurationMin: int(s.SubmitLimitDurationMin),
 		ScoreboardVisible:      s.ScoreboardVisible,
 		RegistrationOpen:       s.RegistrationOpen,
+		Require2FAForAdmins:    s.Require2faForAdmins,
+		RegistrationOpensAt:    s.RegistrationOpensAt,
+		RegistrationClosesAt:   s.RegistrationClosesAt,
 		UpdatedAt:              s.UpdatedAt,
 	}
 }
@@ -82,7 +85,10 @@ func (r *AppSettingsRepo) Update(ctx context.Context, s *entity.AppSettings) err
 		SubmitLimitDurationMin: submitDuration,
 		ScoreboardVisible:      s.ScoreboardVisible,
 		RegistrationOpen:       s.RegistrationOpen,
+		Require2faForAdmins:    s.Require2FAForAdmins,
 		UpdatedAt:              time.Now(),
+		RegistrationOpensAt:    s.RegistrationOpensAt,
+		RegistrationClosesAt:   s.RegistrationClosesAt,
 	})
 	if err != nil {
 		return fmt.Errorf("AppSettingsRepo - Update: %w", err)
@@ -2,16 +2,18 @@ package persistent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/skr1ms/CTFBoard/internal/entity"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 )
 
 var backupEraseTables = []string{
-	"solves", "awards", "hint_unlocks", "files", "hints", "challenges", "users", "teams",
+	"challenge_stage_solves", "solves", "awards", "hint_unlocks", "file_downloads", "files", "hints", "challenge_flags", "challenge_decoy_flags", "challenge_prerequisites", "challenge_unlock_scores", "challenge_schedules", "challenge_team_flags", "cheat_incidents", "challenge_instances", "challenge_instance_configs", "submission_reviews", "challenge_manual_reviews", "challenge_revisions", "challenge_specs", "challenge_stage_flags", "challenge_stages", "challenge_scoring", "challenge_attempt_resets", "challenge_attempt_limits", "challenges", "users", "teams",
 }
 
 var (
@@ -19,12 +21,18 @@ var (
 		"id", "title", "description", "category", "flag_hash", "points",
 		"initial_value", "min_value", "decay", "solve_count", "is_hidden", "is_regex", "is_case_insensitive", "flag_regex",
 	}
-	backupHintImportCols  = []string{"id", "challenge_id", "content", "cost", "order_index"}
-	backupTeamImportCols  = []string{"id", "name", "captain_id", "invite_token", "is_solo", "is_banned", "banned_reason", "is_hidden", "created_at"}
-	backupUserImportCols  = []string{"id", "username", "email", "password_hash", "role", "team_id"}
-	backupAwardImportCols = []string{"id", "team_id", "value", "description", "created_by", "created_at"}
-	backupSolveImportCols = []string{"id", "user_id", "team_id", "challenge_id", "solved_at"}
-	backupFileImportCols  = []string{"id", "type", "challenge_id", "location", "filename", "size", "sha256", "created_at"}
+	backupHintImportCols       = []string{"id", "challenge_id", "content", "cost", "order_index"}
+	backupFlagImportCols       = []string{"id", "challenge_id", "flag_type", "flag_hash", "flag_regex", "is_case_insensitive"}
+	backupDecoyImportCols      = []string{"id", "challenge_id", "flag_hash", "is_case_insensitive", "note", "created_at"}
+	backupTeamImportCols       = []string{"id", "name", "captain_id", "invite_token", "is_solo", "is_banned", "banned_reason", "is_hidden", "created_at"}
+	backupUserImportCols       = []string{"id", "username", "email", "password_hash", "role", "team_id"}
+	backupAwardImportCols      = []string{"id", "team_id", "value", "description", "created_by", "created_at"}
+	backupSolveImportCols      = []string{"id", "user_id", "team_id", "challenge_id", "solved_at", "points"}
+	backupReviewImportCols     = []string{"id", "challenge_id", "team_id", "user_id", "answer", "status", "points", "comment", "reviewed_by", "reviewed_at", "created_at"}
+	backupStageImportCols      = []string{"id", "challenge_id", "order_index", "title", "points", "created_at"}
+	backupStageFlagImportCols  = []string{"id", "stage_id", "flag_type", "flag_hash", "flag_regex", "is_case_insensitive", "created_at"}
+	backupStageSolveImportCols = []string{"id", "stage_id", "team_id", "user_id", "solved_at"}
+	backupFileImportCols       = []string{"id", "type", "challenge_id", "location", "filename", "size", "sha256", "created_at"}
 )
 
 const (
@@ -35,6 +43,16 @@ const (
 		is_hidden = EXCLUDED.is_hidden, is_regex = EXCLUDED.is_regex, is_case_insensitive = EXCLUDED.is_case_insensitive,
 		flag_regex = EXCLUDED.flag_regex`
 	backupHintUpsertSuffix         = `ON CONFLICT (id) DO UPDATE SET content = EXCLUDED.content, cost = EXCLUDED.cost, order_index = EXCLUDED.order_index`
+	backupFlagUpsertSuffix         = `ON CONFLICT (id) DO UPDATE SET flag_type = EXCLUDED.flag_type, flag_hash = EXCLUDED.flag_hash, flag_regex = EXCLUDED.flag_regex, is_case_insensitive = EXCLUDED.is_case_insensitive`
+	backupDecoyUpsertSuffix        = `ON CONFLICT (id) DO UPDATE SET flag_hash = EXCLUDED.flag_hash, is_case_insensitive = EXCLUDED.is_case_insensitive, note = EXCLUDED.note`
+	backupUnlockScoreUpsertSuffix  = `ON CONFLICT (challenge_id) DO UPDATE SET min_score = EXCLUDED.min_score`
+	backupTeamFlagUpsertSuffix     = `ON CONFLICT (challenge_id) DO UPDATE SET template = EXCLUDED.template, secret = EXCLUDED.secret`
+	backupInstanceUpsertSuffix     = `ON CONFLICT (challenge_id) DO UPDATE SET image = EXCLUDED.image, ttl_seconds = EXCLUDED.ttl_seconds`
+	backupStageUpsertSuffix        = `ON CONFLICT (id) DO UPDATE SET order_index = EXCLUDED.order_index, title = EXCLUDED.title, points = EXCLUDED.points`
+	backupScoringUpsertSuffix      = `ON CONFLICT (challenge_id) DO UPDATE SET function_name = EXCLUDED.function_name, params = EXCLUDED.params, updated_at = EXCLUDED.updated_at`
+	backupAttemptLimitUpsertSuffix = `ON CONFLICT (challenge_id) DO UPDATE SET max_attempts = EXCLUDED.max_attempts, wrong_penalty = EXCLUDED.wrong_penalty, updated_at = EXCLUDED.updated_at`
+	backupStageFlagUpsertSuffix    = `ON CONFLICT (id) DO UPDATE SET flag_type = EXCLUDED.flag_type, flag_hash = EXCLUDED.flag_hash, flag_regex = EXCLUDED.flag_regex, is_case_insensitive = EXCLUDED.is_case_insensitive`
+	backupScheduleUpsertSuffix     = `ON CONFLICT (challenge_id) DO UPDATE SET release_at = EXCLUDED.release_at, hide_at = EXCLUDED.hide_at, notify_on_release = EXCLUDED.notify_on_release, announced_at = EXCLUDED.announced_at`
 	backupTeamUpsertSuffix         = `ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, captain_id = EXCLUDED.captain_id, invite_token = EXCLUDED.invite_token, is_solo = EXCLUDED.is_solo, is_banned = EXCLUDED.is_banned, banned_reason = EXCLUDED.banned_reason, is_hidden = EXCLUDED.is_hidden`
 	backupUserUpsertSuffix         = `ON CONFLICT (id) DO UPDATE SET username = EXCLUDED.username, email = EXCLUDED.email, role = EXCLUDED.role, team_id = EXCLUDED.team_id`
 	backupUserRestoredPasswordHash = "__RESTORED__"
@@ -116,6 +134,190 @@ func (r *BackupRepo) ImportChallengesTx(ctx context.Context, tx repo.Transaction
 				return fmt.Errorf("BackupRepo - ImportChallengesTx - hint %s: %w", hint.ID, err)
 			}
 		}
+
+		for _, flag := range ch.Flags {
+			flagQuery := squirrel.Insert("challenge_flags").
+				Columns(backupFlagImportCols...).
+				Values(flag.ID, ch.ID, string(flag.Type), strPtrOrNil(flag.FlagHash), strPtrOrNil(flag.FlagRegex), flag.IsCaseInsensitive).
+				Suffix(backupFlagUpsertSuffix).
+				PlaceholderFormat(squirrel.Dollar)
+
+			if err := execTx(ctx, tx, flagQuery); err != nil {
+				return fmt.Errorf("BackupRepo - ImportChallengesTx - flag %s: %w", flag.ID, err)
+			}
+		}
+
+		if err := importChallengeDecoysTx(ctx, tx, &ch); err != nil {
+			return err
+		}
+
+		if err := importChallengeConfigsTx(ctx, tx, &ch); err != nil {
+			return err
+		}
+
+		if ch.Schedule != nil && !ch.Schedule.IsEmpty() {
+			scheduleQuery := squirrel.Insert("challenge_schedules").
+				Columns("challenge_id", "release_at", "hide_at", "notify_on_release", "announced_at").
+				Values(ch.ID, ch.Schedule.ReleaseAt, ch.Schedule.HideAt, ch.Schedule.NotifyOnRelease, ch.Schedule.AnnouncedAt).
+				Suffix(backupScheduleUpsertSuffix).
+				PlaceholderFormat(squirrel.Dollar)
+
+			if err := execTx(ctx, tx, scheduleQuery); err != nil {
+				return fmt.Errorf("BackupRepo - ImportChallengesTx - schedule %s: %w", ch.ID, err)
+			}
+		}
+	}
+	return importChallengeRequirementsTx(ctx, tx, data)
+}
+
+// importChallengeConfigsTx stores the optional per-challenge settings: team flags, instances,
+// manual review, stages, scoring and attempt limits.
+func importChallengeConfigsTx(ctx context.Context, tx repo.Transaction, ch *entity.ChallengeExport) error {
+	if ch.TeamFlag != nil {
+		teamFlagQuery := squirrel.Insert("challenge_team_flags").
+			Columns("challenge_id", "template", "secret", "created_at").
+			Values(ch.ID, ch.TeamFlag.Template, ch.TeamFlag.Secret, ch.TeamFlag.CreatedAt).
+			Suffix(backupTeamFlagUpsertSuffix).
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, teamFlagQuery); err != nil {
+			return fmt.Errorf("BackupRepo - ImportChallengesTx - team flag %s: %w", ch.ID, err)
+		}
+	}
+
+	if ch.Instance != nil {
+		instanceQuery := squirrel.Insert("challenge_instance_configs").
+			Columns("challenge_id", "image", "ttl_seconds", "created_at").
+			Values(ch.ID, ch.Instance.Image, ch.Instance.TTLSeconds, ch.Instance.CreatedAt).
+			Suffix(backupInstanceUpsertSuffix).
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, instanceQuery); err != nil {
+			return fmt.Errorf("BackupRepo - ImportChallengesTx - instance config %s: %w", ch.ID, err)
+		}
+	}
+
+	if ch.ManualReview {
+		manualReviewQuery := squirrel.Insert("challenge_manual_reviews").
+			Columns("challenge_id").
+			Values(ch.ID).
+			Suffix("ON CONFLICT (challenge_id) DO NOTHING").
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, manualReviewQuery); err != nil {
+			return fmt.Errorf("BackupRepo - ImportChallengesTx - manual review %s: %w", ch.ID, err)
+		}
+	}
+
+	if err := importChallengeStagesTx(ctx, tx, ch); err != nil {
+		return err
+	}
+
+	if ch.Scoring != nil {
+		params, err := json.Marshal(ch.Scoring.Params)
+		if err != nil {
+			return fmt.Errorf("BackupRepo - ImportChallengesTx - scoring %s: %w", ch.ID, err)
+		}
+		scoringQuery := squirrel.Insert("challenge_scoring").
+			Columns("challenge_id", "function_name", "params", "updated_at").
+			Values(ch.ID, ch.Scoring.Function, params, ch.Scoring.UpdatedAt).
+			Suffix(backupScoringUpsertSuffix).
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, scoringQuery); err != nil {
+			return fmt.Errorf("BackupRepo - ImportChallengesTx - scoring %s: %w", ch.ID, err)
+		}
+	}
+
+	if ch.AttemptLimit != nil {
+		attemptQuery := squirrel.Insert("challenge_attempt_limits").
+			Columns("challenge_id", "max_attempts", "wrong_penalty", "updated_at").
+			Values(ch.ID, ch.AttemptLimit.MaxAttempts, ch.AttemptLimit.WrongPenalty, ch.AttemptLimit.UpdatedAt).
+			Suffix(backupAttemptLimitUpsertSuffix).
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, attemptQuery); err != nil {
+			return fmt.Errorf("BackupRepo - ImportChallengesTx - attempt limit %s: %w", ch.ID, err)
+		}
+	}
+	return nil
+}
+
+func importChallengeDecoysTx(ctx context.Context, tx repo.Transaction, ch *entity.ChallengeExport) error {
+	for _, decoy := range ch.Decoys {
+		query := squirrel.Insert("challenge_decoy_flags").
+			Columns(backupDecoyImportCols...).
+			Values(decoy.ID, ch.ID, decoy.FlagHash, decoy.IsCaseInsensitive, decoy.Note, decoy.CreatedAt).
+			Suffix(backupDecoyUpsertSuffix).
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, query); err != nil {
+			return fmt.Errorf("BackupRepo - ImportChallengesTx - decoy %s: %w", decoy.ID, err)
+		}
+	}
+	return nil
+}
+
+func importChallengeStagesTx(ctx context.Context, tx repo.Transaction, ch *entity.ChallengeExport) error {
+	for _, stage := range ch.Stages {
+		query := squirrel.Insert("challenge_stages").
+			Columns(backupStageImportCols...).
+			Values(stage.ID, ch.ID, stage.OrderIndex, stage.Title, stage.Points, stage.CreatedAt).
+			Suffix(backupStageUpsertSuffix).
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, query); err != nil {
+			return fmt.Errorf("BackupRepo - ImportChallengesTx - stage %s: %w", stage.ID, err)
+		}
+
+		for _, flag := range stage.Flags {
+			flagQuery := squirrel.Insert("challenge_stage_flags").
+				Columns(backupStageFlagImportCols...).
+				Values(flag.ID, stage.ID, string(flag.Type), strPtrOrNil(flag.FlagHash), strPtrOrNil(flag.FlagRegex), flag.IsCaseInsensitive, flag.CreatedAt).
+				Suffix(backupStageFlagUpsertSuffix).
+				PlaceholderFormat(squirrel.Dollar)
+
+			if err := execTx(ctx, tx, flagQuery); err != nil {
+				return fmt.Errorf("BackupRepo - ImportChallengesTx - stage flag %s: %w", flag.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// importChallengeRequirementsTx runs once every challenge exists; prerequisites outside the backup are dropped.
+func importChallengeRequirementsTx(ctx context.Context, tx repo.Transaction, data *entity.BackupData) error {
+	imported := make(map[uuid.UUID]bool, len(data.Challenges))
+	for _, ch := range data.Challenges {
+		imported[ch.ID] = true
+	}
+	for _, ch := range data.Challenges {
+		if ch.Requirements == nil {
+			continue
+		}
+		for _, prerequisiteID := range ch.Requirements.Prerequisites {
+			if !imported[prerequisiteID] || prerequisiteID == ch.ID {
+				continue
+			}
+			query := squirrel.Insert("challenge_prerequisites").
+				Columns("challenge_id", "prerequisite_id").
+				Values(ch.ID, prerequisiteID).
+				Suffix("ON CONFLICT DO NOTHING").
+				PlaceholderFormat(squirrel.Dollar)
+			if err := execTx(ctx, tx, query); err != nil {
+				return fmt.Errorf("BackupRepo - ImportChallengesTx - prerequisite %s: %w", ch.ID, err)
+			}
+		}
+		if ch.Requirements.MinScore > 0 {
+			query := squirrel.Insert("challenge_unlock_scores").
+				Columns("challenge_id", "min_score").
+				Values(ch.ID, ch.Requirements.MinScore).
+				Suffix(backupUnlockScoreUpsertSuffix).
+				PlaceholderFormat(squirrel.Dollar)
+			if err := execTx(ctx, tx, query); err != nil {
+				return fmt.Errorf("BackupRepo - ImportChallengesTx - unlock score %s: %w", ch.ID, err)
+			}
+		}
 	}
 	return nil
 }
@@ -181,7 +383,7 @@ func (r *BackupRepo) ImportSolvesTx(ctx context.Context, tx repo.Transaction, da
 	for _, s := range data.Solves {
 		query := squirrel.Insert("solves").
 			Columns(backupSolveImportCols...).
-			Values(s.ID, s.UserID, s.TeamID, s.ChallengeID, s.SolvedAt).
+			Values(s.ID, s.UserID, s.TeamID, s.ChallengeID, s.SolvedAt, s.Points).
 			Suffix(backupSolveConflictSuffix).
 			PlaceholderFormat(squirrel.Dollar)
 
@@ -189,6 +391,59 @@ func (r *BackupRepo) ImportSolvesTx(ctx context.Context, tx repo.Transaction, da
 			return fmt.Errorf("BackupRepo - ImportSolvesTx - solve %s: %w", s.ID, err)
 		}
 	}
+	if err := importStageSolvesTx(ctx, tx, data); err != nil {
+		return err
+	}
+	if err := importAttemptResetsTx(ctx, tx, data); err != nil {
+		return err
+	}
+	return importReviewsTx(ctx, tx, data)
+}
+
+func importStageSolvesTx(ctx context.Context, tx repo.Transaction, data *entity.BackupData) error {
+	for _, s := range data.StageSolves {
+		query := squirrel.Insert("challenge_stage_solves").
+			Columns(backupStageSolveImportCols...).
+			Values(s.ID, s.StageID, s.TeamID, s.UserID, s.SolvedAt).
+			Suffix("ON CONFLICT DO NOTHING").
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, query); err != nil {
+			return fmt.Errorf("BackupRepo - ImportSolvesTx - stage solve %s: %w", s.ID, err)
+		}
+	}
+	return nil
+}
+
+func importAttemptResetsTx(ctx context.Context, tx repo.Transaction, data *entity.BackupData) error {
+	for _, r := range data.AttemptResets {
+		query := squirrel.Insert("challenge_attempt_resets").
+			Columns("challenge_id", "team_id", "reset_at").
+			Values(r.ChallengeID, r.TeamID, r.ResetAt).
+			Suffix("ON CONFLICT (challenge_id, team_id) DO UPDATE SET reset_at = EXCLUDED.reset_at").
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, query); err != nil {
+			return fmt.Errorf("BackupRepo - ImportSolvesTx - attempt reset %s/%s: %w", r.ChallengeID, r.TeamID, err)
+		}
+	}
+	return nil
+}
+
+// importReviewsTx skips reviews clashing with a stored one, including a second pending review of
+// the same team and challenge.
+func importReviewsTx(ctx context.Context, tx repo.Transaction, data *entity.BackupData) error {
+	for _, r := range data.Reviews {
+		query := squirrel.Insert("submission_reviews").
+			Columns(backupReviewImportCols...).
+			Values(r.ID, r.ChallengeID, r.TeamID, r.UserID, r.Answer, string(r.Status), r.Points, r.Comment, r.ReviewedBy, r.ReviewedAt, r.CreatedAt).
+			Suffix("ON CONFLICT DO NOTHING").
+			PlaceholderFormat(squirrel.Dollar)
+
+		if err := execTx(ctx, tx, query); err != nil {
+			return fmt.Errorf("BackupRepo - ImportSolvesTx - review %s: %w", r.ID, err)
+		}
+	}
 	return nil
 }
 
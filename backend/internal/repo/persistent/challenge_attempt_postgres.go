@@ -0,0 +1,109 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type AttemptLimitRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewAttemptLimitRepo(db *pgxpool.Pool) *AttemptLimitRepo {
+	return &AttemptLimitRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityAttemptLimit(row sqlc.ChallengeAttemptLimit) *entity.AttemptLimit {
+	return &entity.AttemptLimit{
+		ChallengeID:  row.ChallengeID,
+		MaxAttempts:  int32PtrToIntPtr(row.MaxAttempts),
+		WrongPenalty: int(row.WrongPenalty),
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
+
+func (r *AttemptLimitRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.AttemptLimit, error) {
+	row, err := r.q.GetChallengeAttemptLimit(ctx, challengeID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrAttemptLimitNotConfigured
+		}
+		return nil, fmt.Errorf("AttemptLimitRepo - GetByChallengeID: %w", err)
+	}
+	return toEntityAttemptLimit(row), nil
+}
+
+func (r *AttemptLimitRepo) GetAll(ctx context.Context) ([]*entity.AttemptLimit, error) {
+	rows, err := r.q.GetAllChallengeAttemptLimits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("AttemptLimitRepo - GetAll: %w", err)
+	}
+	out := make([]*entity.AttemptLimit, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toEntityAttemptLimit(row))
+	}
+	return out, nil
+}
+
+func (r *AttemptLimitRepo) Set(ctx context.Context, limit *entity.AttemptLimit) error {
+	var maxAttempts *int32
+	if limit.MaxAttempts != nil {
+		v, err := intToInt32Safe(*limit.MaxAttempts)
+		if err != nil {
+			return fmt.Errorf("AttemptLimitRepo - Set MaxAttempts: %w", err)
+		}
+		maxAttempts = &v
+	}
+	penalty, err := intToInt32Safe(limit.WrongPenalty)
+	if err != nil {
+		return fmt.Errorf("AttemptLimitRepo - Set WrongPenalty: %w", err)
+	}
+	updatedAt, err := r.q.UpsertChallengeAttemptLimit(ctx, sqlc.UpsertChallengeAttemptLimitParams{
+		ChallengeID:  limit.ChallengeID,
+		MaxAttempts:  maxAttempts,
+		WrongPenalty: penalty,
+	})
+	if err != nil {
+		return fmt.Errorf("AttemptLimitRepo - Set: %w", err)
+	}
+	limit.UpdatedAt = updatedAt
+	return nil
+}
+
+func (r *AttemptLimitRepo) Delete(ctx context.Context, challengeID uuid.UUID) error {
+	if err := r.q.DeleteChallengeAttemptLimit(ctx, challengeID); err != nil {
+		return fmt.Errorf("AttemptLimitRepo - Delete: %w", err)
+	}
+	return nil
+}
+
+func (r *AttemptLimitRepo) ResetTeam(ctx context.Context, challengeID, teamID uuid.UUID, at time.Time) error {
+	if err := r.q.UpsertChallengeAttemptReset(ctx, sqlc.UpsertChallengeAttemptResetParams{
+		ChallengeID: challengeID,
+		TeamID:      teamID,
+		ResetAt:     at,
+	}); err != nil {
+		return fmt.Errorf("AttemptLimitRepo - ResetTeam: %w", err)
+	}
+	return nil
+}
+
+func (r *AttemptLimitRepo) GetAllResets(ctx context.Context) ([]*entity.AttemptReset, error) {
+	rows, err := r.q.GetAllChallengeAttemptResets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("AttemptLimitRepo - GetAllResets: %w", err)
+	}
+	out := make([]*entity.AttemptReset, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.AttemptReset{ChallengeID: row.ChallengeID, TeamID: row.TeamID, ResetAt: row.ResetAt})
+	}
+	return out, nil
+}
@@ -0,0 +1,43 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type ChallengeAuthorRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewChallengeAuthorRepo(db *pgxpool.Pool) *ChallengeAuthorRepo {
+	return &ChallengeAuthorRepo{db: db, q: sqlc.New(db)}
+}
+
+func (r *ChallengeAuthorRepo) Add(ctx context.Context, challengeID, userID uuid.UUID) error {
+	now := time.Now()
+	if err := r.q.AddChallengeAuthor(ctx, sqlc.AddChallengeAuthorParams{
+		ChallengeID: challengeID,
+		UserID:      userID,
+		CreatedAt:   &now,
+	}); err != nil {
+		return fmt.Errorf("ChallengeAuthorRepo - Add: %w", err)
+	}
+	return nil
+}
+
+func (r *ChallengeAuthorRepo) IsAuthor(ctx context.Context, challengeID, userID uuid.UUID) (bool, error) {
+	ok, err := r.q.IsChallengeAuthor(ctx, sqlc.IsChallengeAuthorParams{
+		ChallengeID: challengeID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("ChallengeAuthorRepo - IsAuthor: %w", err)
+	}
+	return ok, nil
+}
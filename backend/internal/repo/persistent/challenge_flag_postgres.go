@@ -0,0 +1,101 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type ChallengeFlagRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewChallengeFlagRepo(db *pgxpool.Pool) *ChallengeFlagRepo {
+	return &ChallengeFlagRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityChallengeFlag(f sqlc.ChallengeFlag) *entity.ChallengeFlag {
+	return &entity.ChallengeFlag{
+		ID:                f.ID,
+		ChallengeID:       f.ChallengeID,
+		Type:              entity.FlagType(f.FlagType),
+		FlagHash:          ptrStrToStr(f.FlagHash),
+		FlagRegex:         ptrStrToStr(f.FlagRegex),
+		IsCaseInsensitive: f.IsCaseInsensitive,
+		CreatedAt:         ptrTimeToTime(f.CreatedAt),
+	}
+}
+
+func (r *ChallengeFlagRepo) Create(ctx context.Context, f *entity.ChallengeFlag) error {
+	f.ID = uuid.New()
+	row, err := r.q.CreateChallengeFlag(ctx, sqlc.CreateChallengeFlagParams{
+		ID:                f.ID,
+		ChallengeID:       f.ChallengeID,
+		FlagType:          string(f.Type),
+		FlagHash:          strPtrOrNil(f.FlagHash),
+		FlagRegex:         strPtrOrNil(f.FlagRegex),
+		IsCaseInsensitive: f.IsCaseInsensitive,
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeFlagRepo - Create: %w", err)
+	}
+	f.CreatedAt = ptrTimeToTime(row.CreatedAt)
+	return nil
+}
+
+func (r *ChallengeFlagRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.ChallengeFlag, error) {
+	f, err := r.q.GetChallengeFlagByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrChallengeFlagNotFound
+		}
+		return nil, fmt.Errorf("ChallengeFlagRepo - GetByID: %w", err)
+	}
+	return toEntityChallengeFlag(f), nil
+}
+
+func (r *ChallengeFlagRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeFlag, error) {
+	rows, err := r.q.GetChallengeFlagsByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeFlagRepo - GetByChallengeID: %w", err)
+	}
+	out := make([]*entity.ChallengeFlag, 0, len(rows))
+	for _, f := range rows {
+		out = append(out, toEntityChallengeFlag(f))
+	}
+	return out, nil
+}
+
+func (r *ChallengeFlagRepo) Update(ctx context.Context, f *entity.ChallengeFlag) error {
+	n, err := r.q.UpdateChallengeFlag(ctx, sqlc.UpdateChallengeFlagParams{
+		ID:                f.ID,
+		FlagType:          string(f.Type),
+		FlagHash:          strPtrOrNil(f.FlagHash),
+		FlagRegex:         strPtrOrNil(f.FlagRegex),
+		IsCaseInsensitive: f.IsCaseInsensitive,
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeFlagRepo - Update: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrChallengeFlagNotFound
+	}
+	return nil
+}
+
+func (r *ChallengeFlagRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	n, err := r.q.DeleteChallengeFlag(ctx, id)
+	if err != nil {
+		return fmt.Errorf("ChallengeFlagRepo - Delete: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrChallengeFlagNotFound
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type ChallengeRequirementRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewChallengeRequirementRepo(db *pgxpool.Pool) *ChallengeRequirementRepo {
+	return &ChallengeRequirementRepo{db: db, q: sqlc.New(db)}
+}
+
+// GetByChallengeID returns empty requirements for a challenge that has none.
+func (r *ChallengeRequirementRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeRequirements, error) {
+	ids, err := r.q.GetChallengePrerequisiteIDs(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeRequirementRepo - GetByChallengeID - Prerequisites: %w", err)
+	}
+	req := &entity.ChallengeRequirements{ChallengeID: challengeID, Prerequisites: ids}
+	if req.Prerequisites == nil {
+		req.Prerequisites = []uuid.UUID{}
+	}
+	minScore, err := r.q.GetChallengeUnlockScore(ctx, challengeID)
+	if err != nil && !isNoRows(err) {
+		return nil, fmt.Errorf("ChallengeRequirementRepo - GetByChallengeID - UnlockScore: %w", err)
+	}
+	req.MinScore = int(minScore)
+	return req, nil
+}
+
+// GetAll returns the requirements of every challenge that has any.
+func (r *ChallengeRequirementRepo) GetAll(ctx context.Context) ([]*entity.ChallengeRequirements, error) {
+	edges, err := r.q.ListChallengePrerequisites(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeRequirementRepo - GetAll - Prerequisites: %w", err)
+	}
+	scores, err := r.q.ListChallengeUnlockScores(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeRequirementRepo - GetAll - UnlockScores: %w", err)
+	}
+	byID := make(map[uuid.UUID]*entity.ChallengeRequirements)
+	var out []*entity.ChallengeRequirements
+	get := func(challengeID uuid.UUID) *entity.ChallengeRequirements {
+		req, ok := byID[challengeID]
+		if !ok {
+			req = &entity.ChallengeRequirements{ChallengeID: challengeID, Prerequisites: []uuid.UUID{}}
+			byID[challengeID] = req
+			out = append(out, req)
+		}
+		return req
+	}
+	for _, e := range edges {
+		req := get(e.ChallengeID)
+		req.Prerequisites = append(req.Prerequisites, e.PrerequisiteID)
+	}
+	for _, s := range scores {
+		get(s.ChallengeID).MinScore = int(s.MinScore)
+	}
+	return out, nil
+}
+
+// Set replaces the prerequisites and minimum score of a challenge in one transaction.
+func (r *ChallengeRequirementRepo) Set(ctx context.Context, req *entity.ChallengeRequirements) error {
+	minScore, err := intToInt32Safe(req.MinScore)
+	if err != nil {
+		return fmt.Errorf("ChallengeRequirementRepo - Set MinScore: %w", err)
+	}
+	err = pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+		if err := q.DeleteChallengePrerequisites(ctx, req.ChallengeID); err != nil {
+			return err
+		}
+		for _, prerequisiteID := range req.Prerequisites {
+			if err := q.AddChallengePrerequisite(ctx, sqlc.AddChallengePrerequisiteParams{
+				ChallengeID:    req.ChallengeID,
+				PrerequisiteID: prerequisiteID,
+			}); err != nil {
+				return err
+			}
+		}
+		if minScore <= 0 {
+			return q.DeleteChallengeUnlockScore(ctx, req.ChallengeID)
+		}
+		return q.UpsertChallengeUnlockScore(ctx, sqlc.UpsertChallengeUnlockScoreParams{
+			ChallengeID: req.ChallengeID,
+			MinScore:    minScore,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeRequirementRepo - Set: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type ChallengeScheduleRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewChallengeScheduleRepo(db *pgxpool.Pool) *ChallengeScheduleRepo {
+	return &ChallengeScheduleRepo{db: db, q: sqlc.New(db)}
+}
+
+func toScheduledChallenge(row sqlc.ListChallengeSchedulesRow) *repo.ScheduledChallenge {
+	return &repo.ScheduledChallenge{
+		Schedule: &entity.ChallengeSchedule{
+			ChallengeID:     row.ChallengeID,
+			ReleaseAt:       row.ReleaseAt,
+			HideAt:          row.HideAt,
+			NotifyOnRelease: row.NotifyOnRelease,
+			AnnouncedAt:     row.AnnouncedAt,
+		},
+		Title:    row.Title,
+		Category: ptrStrToStr(row.Category),
+		Points:   int32PtrToInt(row.Points),
+		IsHidden: boolPtrToBool(row.IsHidden),
+	}
+}
+
+// GetByChallengeID returns an empty schedule for a challenge that has none.
+func (r *ChallengeScheduleRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeSchedule, error) {
+	row, err := r.q.GetChallengeSchedule(ctx, challengeID)
+	if err != nil {
+		if isNoRows(err) {
+			return &entity.ChallengeSchedule{ChallengeID: challengeID}, nil
+		}
+		return nil, fmt.Errorf("ChallengeScheduleRepo - GetByChallengeID: %w", err)
+	}
+	return &entity.ChallengeSchedule{
+		ChallengeID:     row.ChallengeID,
+		ReleaseAt:       row.ReleaseAt,
+		HideAt:          row.HideAt,
+		NotifyOnRelease: row.NotifyOnRelease,
+		AnnouncedAt:     row.AnnouncedAt,
+	}, nil
+}
+
+// GetAll returns every scheduled challenge ordered by release time.
+func (r *ChallengeScheduleRepo) GetAll(ctx context.Context) ([]*repo.ScheduledChallenge, error) {
+	rows, err := r.q.ListChallengeSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeScheduleRepo - GetAll: %w", err)
+	}
+	out := make([]*repo.ScheduledChallenge, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toScheduledChallenge(row))
+	}
+	return out, nil
+}
+
+func (r *ChallengeScheduleRepo) Set(ctx context.Context, schedule *entity.ChallengeSchedule) error {
+	err := r.q.UpsertChallengeSchedule(ctx, sqlc.UpsertChallengeScheduleParams{
+		ChallengeID:     schedule.ChallengeID,
+		ReleaseAt:       schedule.ReleaseAt,
+		HideAt:          schedule.HideAt,
+		NotifyOnRelease: schedule.NotifyOnRelease,
+		AnnouncedAt:     schedule.AnnouncedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeScheduleRepo - Set: %w", err)
+	}
+	return nil
+}
+
+func (r *ChallengeScheduleRepo) Delete(ctx context.Context, challengeID uuid.UUID) error {
+	if err := r.q.DeleteChallengeSchedule(ctx, challengeID); err != nil {
+		return fmt.Errorf("ChallengeScheduleRepo - Delete: %w", err)
+	}
+	return nil
+}
+
+// ClaimDueReleases marks every visible challenge whose release time has passed as announced and
+// returns them; concurrent callers never claim the same release twice.
+func (r *ChallengeScheduleRepo) ClaimDueReleases(ctx context.Context) ([]*repo.ScheduledChallenge, error) {
+	rows, err := r.q.ClaimDueChallengeReleases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeScheduleRepo - ClaimDueReleases: %w", err)
+	}
+	out := make([]*repo.ScheduledChallenge, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toScheduledChallenge(sqlc.ListChallengeSchedulesRow(row)))
+	}
+	return out, nil
+}
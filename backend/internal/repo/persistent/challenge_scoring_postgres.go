@@ -0,0 +1,91 @@
+package persistent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type ChallengeScoringRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewChallengeScoringRepo(db *pgxpool.Pool) *ChallengeScoringRepo {
+	return &ChallengeScoringRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityChallengeScoring(challengeID uuid.UUID, function string, params []byte) (*entity.ChallengeScoring, error) {
+	s := &entity.ChallengeScoring{ChallengeID: challengeID, Function: function}
+	if err := json.Unmarshal(params, &s.Params); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *ChallengeScoringRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeScoring, error) {
+	row, err := r.q.GetChallengeScoring(ctx, challengeID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ChallengeScoringRepo - GetByChallengeID: %w", err)
+	}
+	s, err := toEntityChallengeScoring(row.ChallengeID, row.FunctionName, row.Params)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeScoringRepo - GetByChallengeID - Unmarshal: %w", err)
+	}
+	s.UpdatedAt = row.UpdatedAt
+	return s, nil
+}
+
+func (r *ChallengeScoringRepo) GetByFunctions(ctx context.Context, functions []string) ([]*repo.ScoredChallenge, error) {
+	rows, err := r.q.ListChallengeScoringByFunctions(ctx, functions)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeScoringRepo - GetByFunctions: %w", err)
+	}
+	out := make([]*repo.ScoredChallenge, 0, len(rows))
+	for _, row := range rows {
+		s, err := toEntityChallengeScoring(row.ChallengeID, row.FunctionName, row.Params)
+		if err != nil {
+			return nil, fmt.Errorf("ChallengeScoringRepo - GetByFunctions - Unmarshal: %w", err)
+		}
+		s.UpdatedAt = row.UpdatedAt
+		out = append(out, &repo.ScoredChallenge{
+			Scoring:    s,
+			Points:     int32PtrToInt(row.Points),
+			SolveCount: int(row.SolveCount),
+		})
+	}
+	return out, nil
+}
+
+func (r *ChallengeScoringRepo) Set(ctx context.Context, scoring *entity.ChallengeScoring) error {
+	params, err := json.Marshal(scoring.Params)
+	if err != nil {
+		return fmt.Errorf("ChallengeScoringRepo - Set - Marshal: %w", err)
+	}
+	updatedAt, err := r.q.UpsertChallengeScoring(ctx, sqlc.UpsertChallengeScoringParams{
+		ChallengeID:  scoring.ChallengeID,
+		FunctionName: scoring.Function,
+		Params:       params,
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeScoringRepo - Set: %w", err)
+	}
+	scoring.UpdatedAt = updatedAt
+	return nil
+}
+
+func (r *ChallengeScoringRepo) Delete(ctx context.Context, challengeID uuid.UUID) error {
+	if err := r.q.DeleteChallengeScoring(ctx, challengeID); err != nil {
+		return fmt.Errorf("ChallengeScoringRepo - Delete: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type ChallengeSpecRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewChallengeSpecRepo(db *pgxpool.Pool) *ChallengeSpecRepo {
+	return &ChallengeSpecRepo{db: db, q: sqlc.New(db)}
+}
+
+func (r *ChallengeSpecRepo) GetAll(ctx context.Context) ([]*entity.LinkedChallenge, error) {
+	rows, err := r.q.ListSpecChallenges(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeSpecRepo - GetAll: %w", err)
+	}
+	result := make([]*entity.LinkedChallenge, len(rows))
+	for i, row := range rows {
+		result[i] = &entity.LinkedChallenge{
+			Challenge: toEntityChallengeFromRow(row.ID, row.Title, row.Description, row.Category, row.Points, row.InitialValue, row.MinValue, row.Decay, row.SolveCount, row.FlagHash, row.IsHidden, row.IsRegex, row.IsCaseInsensitive, row.FlagRegex, row.FlagFormatRegex),
+			Slug:      ptrStrToStr(row.Slug),
+		}
+	}
+	return result, nil
+}
+
+func (r *ChallengeSpecRepo) Link(ctx context.Context, challengeID uuid.UUID, slug string) error {
+	err := r.q.UpsertChallengeSpec(ctx, sqlc.UpsertChallengeSpecParams{ChallengeID: challengeID, Slug: slug})
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrSpecSlugTaken
+		}
+		return fmt.Errorf("ChallengeSpecRepo - Link: %w", err)
+	}
+	return nil
+}
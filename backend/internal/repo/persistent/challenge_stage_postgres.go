@@ -0,0 +1,306 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type ChallengeStageRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewChallengeStageRepo(db *pgxpool.Pool) *ChallengeStageRepo {
+	return &ChallengeStageRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityStageFlag(f sqlc.ChallengeStageFlag, challengeID uuid.UUID) *entity.ChallengeFlag {
+	return &entity.ChallengeFlag{
+		ID:                f.ID,
+		ChallengeID:       challengeID,
+		Type:              entity.FlagType(f.FlagType),
+		FlagHash:          ptrStrToStr(f.FlagHash),
+		FlagRegex:         ptrStrToStr(f.FlagRegex),
+		IsCaseInsensitive: f.IsCaseInsensitive,
+		CreatedAt:         ptrTimeToTime(f.CreatedAt),
+	}
+}
+
+func (r *ChallengeStageRepo) Create(ctx context.Context, stage *entity.ChallengeStage) error {
+	points, err := intToInt32Safe(stage.Points)
+	if err != nil {
+		return fmt.Errorf("ChallengeStageRepo - Create Points: %w", err)
+	}
+	stage.ID = uuid.New()
+	err = pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+		row, err := q.CreateChallengeStage(ctx, sqlc.CreateChallengeStageParams{
+			ID:          stage.ID,
+			ChallengeID: stage.ChallengeID,
+			Title:       stage.Title,
+			Points:      points,
+		})
+		if err != nil {
+			return err
+		}
+		stage.OrderIndex = int(row.OrderIndex)
+		stage.CreatedAt = ptrTimeToTime(row.CreatedAt)
+		return createStageFlags(ctx, q, stage)
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeStageRepo - Create: %w", err)
+	}
+	return nil
+}
+
+func createStageFlags(ctx context.Context, q *sqlc.Queries, stage *entity.ChallengeStage) error {
+	for _, f := range stage.Flags {
+		if err := q.CreateChallengeStageFlag(ctx, sqlc.CreateChallengeStageFlagParams{
+			StageID:           stage.ID,
+			FlagType:          string(f.Type),
+			FlagHash:          strPtrOrNil(f.FlagHash),
+			FlagRegex:         strPtrOrNil(f.FlagRegex),
+			IsCaseInsensitive: f.IsCaseInsensitive,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ChallengeStageRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.ChallengeStage, error) {
+	row, err := r.q.GetChallengeStageByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrStageNotFound
+		}
+		return nil, fmt.Errorf("ChallengeStageRepo - GetByID: %w", err)
+	}
+	flags, err := r.q.GetChallengeStageFlagsByStageID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeStageRepo - GetByID - Flags: %w", err)
+	}
+	stage := &entity.ChallengeStage{
+		ID:          row.ID,
+		ChallengeID: row.ChallengeID,
+		OrderIndex:  int(row.OrderIndex),
+		Title:       row.Title,
+		Points:      int(row.Points),
+		Flags:       make([]*entity.ChallengeFlag, 0, len(flags)),
+		SolveCount:  int(row.SolveCount),
+		CreatedAt:   ptrTimeToTime(row.CreatedAt),
+	}
+	for _, f := range flags {
+		stage.Flags = append(stage.Flags, toEntityStageFlag(f, row.ChallengeID))
+	}
+	return stage, nil
+}
+
+func (r *ChallengeStageRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeStage, error) {
+	rows, err := r.q.GetChallengeStagesByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeStageRepo - GetByChallengeID: %w", err)
+	}
+	flags, err := r.q.GetChallengeStageFlagsByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeStageRepo - GetByChallengeID - Flags: %w", err)
+	}
+	out := make([]*entity.ChallengeStage, 0, len(rows))
+	byID := make(map[uuid.UUID]*entity.ChallengeStage, len(rows))
+	for _, row := range rows {
+		stage := &entity.ChallengeStage{
+			ID:          row.ID,
+			ChallengeID: row.ChallengeID,
+			OrderIndex:  int(row.OrderIndex),
+			Title:       row.Title,
+			Points:      int(row.Points),
+			Flags:       []*entity.ChallengeFlag{},
+			SolveCount:  int(row.SolveCount),
+			CreatedAt:   ptrTimeToTime(row.CreatedAt),
+		}
+		byID[stage.ID] = stage
+		out = append(out, stage)
+	}
+	for _, f := range flags {
+		if stage, ok := byID[f.StageID]; ok {
+			stage.Flags = append(stage.Flags, toEntityStageFlag(f, challengeID))
+		}
+	}
+	return out, nil
+}
+
+func (r *ChallengeStageRepo) Update(ctx context.Context, stage *entity.ChallengeStage) error {
+	points, err := intToInt32Safe(stage.Points)
+	if err != nil {
+		return fmt.Errorf("ChallengeStageRepo - Update Points: %w", err)
+	}
+	err = pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+		n, err := q.UpdateChallengeStage(ctx, sqlc.UpdateChallengeStageParams{
+			ID:     stage.ID,
+			Title:  stage.Title,
+			Points: points,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entityError.ErrStageNotFound
+		}
+		if stage.Flags == nil {
+			return nil
+		}
+		if err := q.DeleteChallengeStageFlags(ctx, stage.ID); err != nil {
+			return err
+		}
+		return createStageFlags(ctx, q, stage)
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeStageRepo - Update: %w", err)
+	}
+	return nil
+}
+
+func (r *ChallengeStageRepo) Reorder(ctx context.Context, stageIDs []uuid.UUID) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+		for i, id := range stageIDs {
+			orderIndex, err := intToInt32Safe(i)
+			if err != nil {
+				return err
+			}
+			if err := q.SetChallengeStageOrder(ctx, sqlc.SetChallengeStageOrderParams{ID: id, OrderIndex: orderIndex}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeStageRepo - Reorder: %w", err)
+	}
+	return nil
+}
+
+func (r *ChallengeStageRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	n, err := r.q.DeleteChallengeStage(ctx, id)
+	if err != nil {
+		return fmt.Errorf("ChallengeStageRepo - Delete: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrStageNotFound
+	}
+	return nil
+}
+
+func (r *ChallengeStageRepo) GetProgress(ctx context.Context, teamID *uuid.UUID) ([]*entity.StageProgress, error) {
+	rows, err := r.q.GetStageProgress(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeStageRepo - GetProgress: %w", err)
+	}
+	out := make([]*entity.StageProgress, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.StageProgress{
+			ChallengeID: row.ChallengeID,
+			Completed:   int(row.Completed),
+			Total:       int(row.Total),
+		})
+	}
+	return out, nil
+}
+
+func (r *ChallengeStageRepo) GetCompletedStageIDs(ctx context.Context, teamID, challengeID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := r.q.GetCompletedStageIDs(ctx, sqlc.GetCompletedStageIDsParams{TeamID: teamID, ChallengeID: challengeID})
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeStageRepo - GetCompletedStageIDs: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *ChallengeStageRepo) CreateSolve(ctx context.Context, solve *entity.StageSolve) (int, error) {
+	var count int32
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+		if _, err := q.LockChallengeStage(ctx, solve.StageID); err != nil {
+			if isNoRows(err) {
+				return entityError.ErrStageNotFound
+			}
+			return fmt.Errorf("ChallengeStageRepo - CreateSolve - LockChallengeStage: %w", err)
+		}
+		if err := createStageSolve(ctx, q, solve, "CreateSolve"); err != nil {
+			return err
+		}
+		var err error
+		if count, err = q.CountChallengeStageSolves(ctx, solve.StageID); err != nil {
+			return fmt.Errorf("ChallengeStageRepo - CreateSolve - CountChallengeStageSolves: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *ChallengeStageRepo) CreateSolveTx(ctx context.Context, tx repo.Transaction, solve *entity.StageSolve) error {
+	return createStageSolve(ctx, r.q.WithTx(mustPgxTx(tx)), solve, "CreateSolveTx")
+}
+
+func createStageSolve(ctx context.Context, q *sqlc.Queries, solve *entity.StageSolve, method string) error {
+	n, err := q.CreateChallengeStageSolve(ctx, sqlc.CreateChallengeStageSolveParams{
+		StageID: solve.StageID,
+		TeamID:  solve.TeamID,
+		UserID:  solve.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("ChallengeStageRepo - %s: %w", method, err)
+	}
+	if n == 0 {
+		return entityError.ErrAlreadySolved
+	}
+	return nil
+}
+
+func (r *ChallengeStageRepo) GetAllSolves(ctx context.Context) ([]*entity.StageSolve, error) {
+	rows, err := r.q.GetAllChallengeStageSolves(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeStageRepo - GetAllSolves: %w", err)
+	}
+	out := make([]*entity.StageSolve, len(rows))
+	for i, row := range rows {
+		out[i] = &entity.StageSolve{
+			ID:       row.ID,
+			StageID:  row.StageID,
+			TeamID:   row.TeamID,
+			UserID:   row.UserID,
+			SolvedAt: row.SolvedAt,
+		}
+	}
+	return out, nil
+}
+
+func (r *ChallengeStageRepo) GetFirstBloods(ctx context.Context, challengeID uuid.UUID) ([]*entity.StageFirstBlood, error) {
+	rows, err := r.q.GetStageFirstBloods(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("ChallengeStageRepo - GetFirstBloods: %w", err)
+	}
+	out := make([]*entity.StageFirstBlood, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.StageFirstBlood{
+			StageID:  row.StageID,
+			UserID:   row.UserID,
+			Username: row.Username,
+			TeamID:   row.TeamID,
+			TeamName: row.TeamName,
+			SolvedAt: row.SolvedAt,
+		})
+	}
+	return out, nil
+}
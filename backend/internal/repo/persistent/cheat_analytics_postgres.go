@@ -0,0 +1,115 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type CheatAnalyticsRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewCheatAnalyticsRepo(db *pgxpool.Pool) *CheatAnalyticsRepo {
+	return &CheatAnalyticsRepo{db: db, q: sqlc.New(db)}
+}
+
+func (r *CheatAnalyticsRepo) GetSharedIPSubmissions(ctx context.Context) ([]*entity.Submission, error) {
+	rows, err := r.q.GetSharedIPSubmissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("CheatAnalyticsRepo - GetSharedIPSubmissions: %w", err)
+	}
+	out := make([]*entity.Submission, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.Submission{
+			ID:          row.ID,
+			UserID:      row.UserID,
+			TeamID:      row.TeamID,
+			ChallengeID: row.ChallengeID,
+			IP:          ptrStrToStr(row.Ip),
+			CreatedAt:   ptrTimeToTime(row.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (r *CheatAnalyticsRepo) GetSharedWrongFlagSubmissions(ctx context.Context, minTeams int) ([]*entity.Submission, error) {
+	minTeams32, err := intToInt32Safe(minTeams)
+	if err != nil {
+		return nil, fmt.Errorf("CheatAnalyticsRepo - GetSharedWrongFlagSubmissions minTeams: %w", err)
+	}
+	rows, err := r.q.GetSharedWrongFlagSubmissions(ctx, minTeams32)
+	if err != nil {
+		return nil, fmt.Errorf("CheatAnalyticsRepo - GetSharedWrongFlagSubmissions: %w", err)
+	}
+	out := make([]*entity.Submission, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.Submission{
+			ID:            row.ID,
+			UserID:        row.UserID,
+			TeamID:        row.TeamID,
+			ChallengeID:   row.ChallengeID,
+			SubmittedFlag: row.SubmittedFlag,
+			CreatedAt:     ptrTimeToTime(row.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (r *CheatAnalyticsRepo) GetCloseSolves(ctx context.Context, window time.Duration) ([]*repo.CloseSolve, error) {
+	seconds, err := intToInt32Safe(int(window / time.Second))
+	if err != nil {
+		return nil, fmt.Errorf("CheatAnalyticsRepo - GetCloseSolves window: %w", err)
+	}
+	rows, err := r.q.GetCloseSolves(ctx, seconds)
+	if err != nil {
+		return nil, fmt.Errorf("CheatAnalyticsRepo - GetCloseSolves: %w", err)
+	}
+	out := make([]*repo.CloseSolve, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &repo.CloseSolve{
+			Solve: &entity.Submission{
+				ID:          row.ID,
+				UserID:      row.UserID,
+				TeamID:      row.TeamID,
+				ChallengeID: row.ChallengeID,
+				IsCorrect:   true,
+				CreatedAt:   ptrTimeToTime(row.CreatedAt),
+			},
+			Previous: &entity.Submission{
+				ID:          row.SourceSubmissionID,
+				UserID:      row.SourceUserID,
+				TeamID:      row.SourceTeamID,
+				ChallengeID: row.ChallengeID,
+				IsCorrect:   true,
+				CreatedAt:   ptrTimeToTime(row.SourceCreatedAt),
+			},
+		})
+	}
+	return out, nil
+}
+
+func (r *CheatAnalyticsRepo) GetSolvesWithoutDownload(ctx context.Context) ([]*entity.Submission, error) {
+	rows, err := r.q.GetSolvesWithoutDownload(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("CheatAnalyticsRepo - GetSolvesWithoutDownload: %w", err)
+	}
+	out := make([]*entity.Submission, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.Submission{
+			ID:          row.ID,
+			UserID:      row.UserID,
+			TeamID:      row.TeamID,
+			ChallengeID: row.ChallengeID,
+			IsCorrect:   true,
+			CreatedAt:   ptrTimeToTime(row.CreatedAt),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,174 @@
+package persistent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type CheatIncidentRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewCheatIncidentRepo(db *pgxpool.Pool) *CheatIncidentRepo {
+	return &CheatIncidentRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityCheatIncident(row sqlc.GetCheatIncidentsRow) (*entity.CheatIncidentWithDetails, error) {
+	incident := &entity.CheatIncidentWithDetails{
+		CheatIncident: entity.CheatIncident{
+			ID:           row.ID,
+			Kind:         entity.CheatIncidentKind(row.Kind),
+			ChallengeID:  row.ChallengeID,
+			TeamID:       row.TeamID,
+			UserID:       row.UserID,
+			SourceTeamID: row.SourceTeamID,
+			Status:       entity.CheatIncidentStatus(row.Status),
+			ResolvedBy:   row.ResolvedBy,
+			ResolvedAt:   row.ResolvedAt,
+			CreatedAt:    ptrTimeToTime(row.CreatedAt),
+		},
+		TeamName:       row.TeamName,
+		SourceTeamName: row.SourceTeamName,
+		Username:       row.Username,
+		ChallengeTitle: row.ChallengeTitle,
+	}
+	if err := json.Unmarshal(row.Evidence, &incident.Evidence); err != nil {
+		return nil, err
+	}
+	return incident, nil
+}
+
+func (r *CheatIncidentRepo) Create(ctx context.Context, incident *entity.CheatIncident) error {
+	evidence, err := json.Marshal(incident.Evidence)
+	if err != nil {
+		return fmt.Errorf("CheatIncidentRepo - Create - Marshal: %w", err)
+	}
+	row, err := r.q.CreateCheatIncident(ctx, sqlc.CreateCheatIncidentParams{
+		Kind:         string(incident.Kind),
+		ChallengeID:  incident.ChallengeID,
+		TeamID:       incident.TeamID,
+		UserID:       incident.UserID,
+		SourceTeamID: incident.SourceTeamID,
+		Evidence:     evidence,
+		Fingerprint:  strPtrOrNil(incident.Fingerprint),
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return entityError.ErrCheatIncidentExists
+		}
+		return fmt.Errorf("CheatIncidentRepo - Create: %w", err)
+	}
+	incident.ID = row.ID
+	incident.Status = entity.CheatIncidentStatus(row.Status)
+	incident.CreatedAt = ptrTimeToTime(row.CreatedAt)
+	return nil
+}
+
+func (r *CheatIncidentRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.CheatIncidentWithDetails, error) {
+	row, err := r.q.GetCheatIncidentByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrCheatIncidentNotFound
+		}
+		return nil, fmt.Errorf("CheatIncidentRepo - GetByID: %w", err)
+	}
+	incident, err := toEntityCheatIncident(sqlc.GetCheatIncidentsRow(row))
+	if err != nil {
+		return nil, fmt.Errorf("CheatIncidentRepo - GetByID - Unmarshal: %w", err)
+	}
+	return incident, nil
+}
+
+func (r *CheatIncidentRepo) GetAll(ctx context.Context, filter entity.CheatIncidentFilter, limit, offset int) ([]*entity.CheatIncidentWithDetails, error) {
+	limit32, err := intToInt32Safe(limit)
+	if err != nil {
+		return nil, fmt.Errorf("CheatIncidentRepo - GetAll limit: %w", err)
+	}
+	offset32, err := intToInt32Safe(offset)
+	if err != nil {
+		return nil, fmt.Errorf("CheatIncidentRepo - GetAll offset: %w", err)
+	}
+	rows, err := r.q.GetCheatIncidents(ctx, sqlc.GetCheatIncidentsParams{
+		Status: cheatIncidentStatusPtr(filter.Status),
+		Kind:   cheatIncidentKindPtr(filter.Kind),
+		Limit:  limit32,
+		Offset: offset32,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CheatIncidentRepo - GetAll: %w", err)
+	}
+
+	result := make([]*entity.CheatIncidentWithDetails, len(rows))
+	for i, row := range rows {
+		incident, err := toEntityCheatIncident(row)
+		if err != nil {
+			return nil, fmt.Errorf("CheatIncidentRepo - GetAll - Unmarshal: %w", err)
+		}
+		result[i] = incident
+	}
+	return result, nil
+}
+
+func (r *CheatIncidentRepo) CountAll(ctx context.Context, filter entity.CheatIncidentFilter) (int64, error) {
+	n, err := r.q.CountCheatIncidents(ctx, sqlc.CountCheatIncidentsParams{
+		Status: cheatIncidentStatusPtr(filter.Status),
+		Kind:   cheatIncidentKindPtr(filter.Kind),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("CheatIncidentRepo - CountAll: %w", err)
+	}
+	return n, nil
+}
+
+func (r *CheatIncidentRepo) CountByTeamAndKind(ctx context.Context, teamID uuid.UUID, kind entity.CheatIncidentKind) (int64, error) {
+	n, err := r.q.CountCheatIncidentsByTeamAndKind(ctx, sqlc.CountCheatIncidentsByTeamAndKindParams{
+		TeamID: teamID,
+		Kind:   string(kind),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("CheatIncidentRepo - CountByTeamAndKind: %w", err)
+	}
+	return n, nil
+}
+
+func (r *CheatIncidentRepo) Resolve(ctx context.Context, id uuid.UUID, status entity.CheatIncidentStatus, resolvedBy *uuid.UUID) error {
+	now := time.Now()
+	n, err := r.q.ResolveCheatIncident(ctx, sqlc.ResolveCheatIncidentParams{
+		ID:         id,
+		Status:     string(status),
+		ResolvedBy: resolvedBy,
+		ResolvedAt: &now,
+	})
+	if err != nil {
+		return fmt.Errorf("CheatIncidentRepo - Resolve: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrCheatIncidentResolved
+	}
+	return nil
+}
+
+func cheatIncidentStatusPtr(status *entity.CheatIncidentStatus) *string {
+	if status == nil {
+		return nil
+	}
+	s := string(*status)
+	return &s
+}
+
+func cheatIncidentKindPtr(kind *entity.CheatIncidentKind) *string {
+	if kind == nil {
+		return nil
+	}
+	s := string(*kind)
+	return &s
+}
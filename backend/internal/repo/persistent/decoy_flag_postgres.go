@@ -0,0 +1,84 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type DecoyFlagRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewDecoyFlagRepo(db *pgxpool.Pool) *DecoyFlagRepo {
+	return &DecoyFlagRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityDecoyFlag(d sqlc.ChallengeDecoyFlag) *entity.DecoyFlag {
+	return &entity.DecoyFlag{
+		ID:                d.ID,
+		ChallengeID:       d.ChallengeID,
+		FlagHash:          d.FlagHash,
+		IsCaseInsensitive: d.IsCaseInsensitive,
+		Note:              d.Note,
+		CreatedAt:         d.CreatedAt,
+	}
+}
+
+func (r *DecoyFlagRepo) Create(ctx context.Context, d *entity.DecoyFlag) error {
+	row, err := r.q.CreateDecoyFlag(ctx, sqlc.CreateDecoyFlagParams{
+		ChallengeID:       d.ChallengeID,
+		FlagHash:          d.FlagHash,
+		IsCaseInsensitive: d.IsCaseInsensitive,
+		Note:              d.Note,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return entityError.ErrDecoyFlagExists
+		}
+		return fmt.Errorf("DecoyFlagRepo - Create: %w", err)
+	}
+	d.ID = row.ID
+	d.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *DecoyFlagRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.DecoyFlag, error) {
+	d, err := r.q.GetDecoyFlagByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrDecoyFlagNotFound
+		}
+		return nil, fmt.Errorf("DecoyFlagRepo - GetByID: %w", err)
+	}
+	return toEntityDecoyFlag(d), nil
+}
+
+func (r *DecoyFlagRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.DecoyFlag, error) {
+	rows, err := r.q.GetDecoyFlagsByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("DecoyFlagRepo - GetByChallengeID: %w", err)
+	}
+	out := make([]*entity.DecoyFlag, 0, len(rows))
+	for _, d := range rows {
+		out = append(out, toEntityDecoyFlag(d))
+	}
+	return out, nil
+}
+
+func (r *DecoyFlagRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	n, err := r.q.DeleteDecoyFlag(ctx, id)
+	if err != nil {
+		return fmt.Errorf("DecoyFlagRepo - Delete: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrDecoyFlagNotFound
+	}
+	return nil
+}
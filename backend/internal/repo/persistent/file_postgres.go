@@ -105,3 +105,16 @@ func (r *FileRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+func (r *FileRepository) RecordDownload(ctx context.Context, download *entity.FileDownload) error {
+	err := r.q.CreateFileDownload(ctx, sqlc.CreateFileDownloadParams{
+		UserID: download.UserID,
+		TeamID: download.TeamID,
+		Ip:     strPtrOrNil(download.IP),
+		FileID: download.FileID,
+	})
+	if err != nil {
+		return fmt.Errorf("FileRepository - RecordDownload: %w", err)
+	}
+	return nil
+}
@@ -52,6 +52,14 @@ func int32PtrToInt(p *int32) int {
 	return int(*p)
 }
 
+func int32PtrToIntPtr(p *int32) *int {
+	if p == nil {
+		return nil
+	}
+	v := int(*p)
+	return &v
+}
+
 func boolPtrToBool(p *bool) bool {
 	if p == nil {
 		return false
@@ -0,0 +1,163 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type InstanceRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewInstanceRepo(db *pgxpool.Pool) *InstanceRepo {
+	return &InstanceRepo{db: db, q: sqlc.New(db)}
+}
+
+func toChallengeInstance(row sqlc.ChallengeInstance) *entity.ChallengeInstance {
+	return &entity.ChallengeInstance{
+		ID:             row.ID,
+		ChallengeID:    row.ChallengeID,
+		TeamID:         row.TeamID,
+		ConnectionInfo: row.ConnectionInfo,
+		ExpiresAt:      row.ExpiresAt,
+		CreatedAt:      ptrTimeToTime(row.CreatedAt),
+	}
+}
+
+func (r *InstanceRepo) GetConfig(ctx context.Context, challengeID uuid.UUID) (*entity.InstanceConfig, error) {
+	row, err := r.q.GetChallengeInstanceConfig(ctx, challengeID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrInstanceNotConfigured
+		}
+		return nil, fmt.Errorf("InstanceRepo - GetConfig: %w", err)
+	}
+	return &entity.InstanceConfig{
+		ChallengeID: row.ChallengeID,
+		Image:       row.Image,
+		TTLSeconds:  int(row.TtlSeconds),
+		CreatedAt:   ptrTimeToTime(row.CreatedAt),
+	}, nil
+}
+
+func (r *InstanceRepo) UpsertConfig(ctx context.Context, cfg *entity.InstanceConfig) error {
+	ttl, err := intToInt32Safe(cfg.TTLSeconds)
+	if err != nil {
+		return fmt.Errorf("InstanceRepo - UpsertConfig: %w", err)
+	}
+	err = r.q.UpsertChallengeInstanceConfig(ctx, sqlc.UpsertChallengeInstanceConfigParams{
+		ChallengeID: cfg.ChallengeID,
+		Image:       cfg.Image,
+		TtlSeconds:  ttl,
+	})
+	if err != nil {
+		return fmt.Errorf("InstanceRepo - UpsertConfig: %w", err)
+	}
+	return nil
+}
+
+func (r *InstanceRepo) DeleteConfig(ctx context.Context, challengeID uuid.UUID) error {
+	if err := r.q.DeleteChallengeInstanceConfig(ctx, challengeID); err != nil {
+		return fmt.Errorf("InstanceRepo - DeleteConfig: %w", err)
+	}
+	return nil
+}
+
+func (r *InstanceRepo) GetByChallengeAndTeam(ctx context.Context, challengeID, teamID uuid.UUID) (*entity.ChallengeInstance, error) {
+	row, err := r.q.GetChallengeInstance(ctx, sqlc.GetChallengeInstanceParams{
+		ChallengeID: challengeID,
+		TeamID:      teamID,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrInstanceNotFound
+		}
+		return nil, fmt.Errorf("InstanceRepo - GetByChallengeAndTeam: %w", err)
+	}
+	return toChallengeInstance(row), nil
+}
+
+// Create inserts inst only while the team stays under maxPerTeam and has no instance of the
+// same challenge, filling in ID and CreatedAt.
+// Create inserts inst unless the team already runs maxPerTeam instances. Creates for the same
+// team are serialized so concurrent starts of different challenges cannot both pass the count.
+func (r *InstanceRepo) Create(ctx context.Context, inst *entity.ChallengeInstance, maxPerTeam int) error {
+	limit, err := intToInt32Safe(maxPerTeam)
+	if err != nil {
+		return fmt.Errorf("InstanceRepo - Create: %w", err)
+	}
+	var row sqlc.CreateChallengeInstanceRow
+	err = pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+		if err := q.LockTeamChallengeInstances(ctx, inst.TeamID); err != nil {
+			return err
+		}
+		row, err = q.CreateChallengeInstance(ctx, sqlc.CreateChallengeInstanceParams{
+			ChallengeID:    inst.ChallengeID,
+			TeamID:         inst.TeamID,
+			ConnectionInfo: inst.ConnectionInfo,
+			ExpiresAt:      inst.ExpiresAt.UTC(),
+			MaxPerTeam:     limit,
+		})
+		return err
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return entityError.ErrInstanceLimitReached
+		}
+		return fmt.Errorf("InstanceRepo - Create: %w", err)
+	}
+	inst.ID = row.ID
+	inst.CreatedAt = ptrTimeToTime(row.CreatedAt)
+	return nil
+}
+
+func (r *InstanceRepo) UpdateConnectionInfo(ctx context.Context, id uuid.UUID, connectionInfo string) error {
+	err := r.q.UpdateChallengeInstanceConnectionInfo(ctx, sqlc.UpdateChallengeInstanceConnectionInfoParams{
+		ID:             id,
+		ConnectionInfo: connectionInfo,
+	})
+	if err != nil {
+		return fmt.Errorf("InstanceRepo - UpdateConnectionInfo: %w", err)
+	}
+	return nil
+}
+
+func (r *InstanceRepo) UpdateExpiry(ctx context.Context, id uuid.UUID, expiresAt time.Time) error {
+	err := r.q.UpdateChallengeInstanceExpiry(ctx, sqlc.UpdateChallengeInstanceExpiryParams{
+		ID:        id,
+		ExpiresAt: expiresAt.UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("InstanceRepo - UpdateExpiry: %w", err)
+	}
+	return nil
+}
+
+func (r *InstanceRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.q.DeleteChallengeInstance(ctx, id); err != nil {
+		return fmt.Errorf("InstanceRepo - Delete: %w", err)
+	}
+	return nil
+}
+
+func (r *InstanceRepo) GetExpired(ctx context.Context, now time.Time) ([]*entity.ChallengeInstance, error) {
+	rows, err := r.q.GetExpiredChallengeInstances(ctx, now.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("InstanceRepo - GetExpired: %w", err)
+	}
+	out := make([]*entity.ChallengeInstance, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toChallengeInstance(row))
+	}
+	return out, nil
+}
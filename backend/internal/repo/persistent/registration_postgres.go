@@ -0,0 +1,150 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type RegistrationRepo struct {
+	pool *pgxpool.Pool
+	q    *sqlc.Queries
+}
+
+func NewRegistrationRepo(pool *pgxpool.Pool) *RegistrationRepo {
+	return &RegistrationRepo{
+		pool: pool,
+		q:    sqlc.New(pool),
+	}
+}
+
+func (r *RegistrationRepo) CreateInvite(ctx context.Context, invite *entity.RegistrationInvite) error {
+	if invite.ID == uuid.Nil {
+		invite.ID = uuid.New()
+	}
+	if invite.CreatedAt.IsZero() {
+		invite.CreatedAt = time.Now()
+	}
+	var maxUses *int32
+	if invite.MaxUses != nil {
+		n, err := intToInt32Safe(*invite.MaxUses)
+		if err != nil {
+			return fmt.Errorf("RegistrationRepo - CreateInvite MaxUses: %w", err)
+		}
+		maxUses = &n
+	}
+	row, err := r.q.CreateRegistrationInvite(ctx, sqlc.CreateRegistrationInviteParams{
+		ID:        invite.ID,
+		Code:      invite.Code,
+		MaxUses:   maxUses,
+		ExpiresAt: invite.ExpiresAt,
+		CreatedBy: invite.CreatedBy,
+		CreatedAt: &invite.CreatedAt,
+	})
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrInviteCodeConflict
+		}
+		return fmt.Errorf("RegistrationRepo - CreateInvite: %w", err)
+	}
+	*invite = *toEntityRegistrationInvite(row)
+	return nil
+}
+
+func (r *RegistrationRepo) GetInvites(ctx context.Context) ([]*entity.RegistrationInvite, error) {
+	rows, err := r.q.GetRegistrationInvites(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("RegistrationRepo - GetInvites: %w", err)
+	}
+	out := make([]*entity.RegistrationInvite, len(rows))
+	for i := range rows {
+		out[i] = toEntityRegistrationInvite(rows[i])
+	}
+	return out, nil
+}
+
+func (r *RegistrationRepo) DeleteInvite(ctx context.Context, id uuid.UUID) error {
+	n, err := r.q.DeleteRegistrationInvite(ctx, id)
+	if err != nil {
+		return fmt.Errorf("RegistrationRepo - DeleteInvite: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrInviteNotFound
+	}
+	return nil
+}
+
+func (r *RegistrationRepo) CreateDomainRule(ctx context.Context, rule *entity.RegistrationDomainRule) error {
+	if rule.ID == uuid.Nil {
+		rule.ID = uuid.New()
+	}
+	if rule.CreatedAt.IsZero() {
+		rule.CreatedAt = time.Now()
+	}
+	_, err := r.q.CreateRegistrationDomainRule(ctx, sqlc.CreateRegistrationDomainRuleParams{
+		ID:        rule.ID,
+		Domain:    rule.Domain,
+		Action:    string(rule.Action),
+		BracketID: rule.BracketID,
+		CreatedAt: &rule.CreatedAt,
+	})
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrDomainRuleConflict
+		}
+		return fmt.Errorf("RegistrationRepo - CreateDomainRule: %w", err)
+	}
+	return nil
+}
+
+func (r *RegistrationRepo) GetDomainRules(ctx context.Context) ([]*entity.RegistrationDomainRule, error) {
+	rows, err := r.q.GetRegistrationDomainRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("RegistrationRepo - GetDomainRules: %w", err)
+	}
+	out := make([]*entity.RegistrationDomainRule, len(rows))
+	for i, row := range rows {
+		out[i] = &entity.RegistrationDomainRule{
+			ID:        row.ID,
+			Domain:    row.Domain,
+			Action:    entity.DomainRuleAction(row.Action),
+			BracketID: row.BracketID,
+			CreatedAt: ptrTimeToTime(row.CreatedAt),
+		}
+	}
+	return out, nil
+}
+
+func (r *RegistrationRepo) DeleteDomainRule(ctx context.Context, id uuid.UUID) error {
+	n, err := r.q.DeleteRegistrationDomainRule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("RegistrationRepo - DeleteDomainRule: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrDomainRuleNotFound
+	}
+	return nil
+}
+
+func toEntityRegistrationInvite(row sqlc.RegistrationInvite) *entity.RegistrationInvite {
+	var maxUses *int
+	if row.MaxUses != nil {
+		n := int(*row.MaxUses)
+		maxUses = &n
+	}
+	return &entity.RegistrationInvite{
+		ID:        row.ID,
+		Code:      row.Code,
+		MaxUses:   maxUses,
+		Uses:      int(row.Uses),
+		ExpiresAt: row.ExpiresAt,
+		CreatedBy: row.CreatedBy,
+		CreatedAt: ptrTimeToTime(row.CreatedAt),
+	}
+}
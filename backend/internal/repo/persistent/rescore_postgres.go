@@ -0,0 +1,78 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type RescoreRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewRescoreRepo(db *pgxpool.Pool) *RescoreRepo {
+	return &RescoreRepo{db: db, q: sqlc.New(db)}
+}
+
+func (r *RescoreRepo) ListSolveTallies(ctx context.Context, opts entity.RescoreOptions) ([]*repo.ChallengeSolveTally, error) {
+	rows, err := r.q.ListChallengeSolveTallies(ctx, sqlc.ListChallengeSolveTalliesParams{
+		ExcludeBanned: opts.ExcludeBanned,
+		ExcludeHidden: opts.ExcludeHidden,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("RescoreRepo - ListSolveTallies: %w", err)
+	}
+	out := make([]*repo.ChallengeSolveTally, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &repo.ChallengeSolveTally{
+			Challenge: &entity.Challenge{
+				ID:           row.ID,
+				Title:        row.Title,
+				Points:       int32PtrToInt(row.Points),
+				InitialValue: int(row.InitialValue),
+				MinValue:     int(row.MinValue),
+				Decay:        int(row.Decay),
+				SolveCount:   int(row.SolveCount),
+			},
+			Solves: int(row.Solves),
+		})
+	}
+	return out, nil
+}
+
+func (r *RescoreRepo) CountSolvesTx(ctx context.Context, tx repo.Transaction, challengeID uuid.UUID, opts entity.RescoreOptions) (int, error) {
+	n, err := r.q.WithTx(mustPgxTx(tx)).CountChallengeSolves(ctx, sqlc.CountChallengeSolvesParams{
+		ChallengeID:   challengeID,
+		ExcludeBanned: opts.ExcludeBanned,
+		ExcludeHidden: opts.ExcludeHidden,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("RescoreRepo - CountSolvesTx: %w", err)
+	}
+	return int(n), nil
+}
+
+func (r *RescoreRepo) UpdateChallengeScoreTx(ctx context.Context, tx repo.Transaction, challengeID uuid.UUID, solveCount, points int) error {
+	solveCount32, err := intToInt32Safe(solveCount)
+	if err != nil {
+		return fmt.Errorf("RescoreRepo - UpdateChallengeScoreTx solveCount: %w", err)
+	}
+	points32, err := intToInt32Safe(points)
+	if err != nil {
+		return fmt.Errorf("RescoreRepo - UpdateChallengeScoreTx points: %w", err)
+	}
+	if err := r.q.WithTx(mustPgxTx(tx)).UpdateChallengeScore(ctx, sqlc.UpdateChallengeScoreParams{
+		ID:         challengeID,
+		SolveCount: solveCount32,
+		Points:     &points32,
+	}); err != nil {
+		return fmt.Errorf("RescoreRepo - UpdateChallengeScoreTx: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type ReviewRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewReviewRepo(db *pgxpool.Pool) *ReviewRepo {
+	return &ReviewRepo{db: db, q: sqlc.New(db)}
+}
+
+func (r *ReviewRepo) GetConfig(ctx context.Context, challengeID uuid.UUID) (*entity.ManualReviewConfig, error) {
+	row, err := r.q.GetChallengeManualReview(ctx, challengeID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrManualReviewNotConfigured
+		}
+		return nil, fmt.Errorf("ReviewRepo - GetConfig: %w", err)
+	}
+	return &entity.ManualReviewConfig{
+		ChallengeID: row.ChallengeID,
+		CreatedAt:   ptrTimeToTime(row.CreatedAt),
+	}, nil
+}
+
+func (r *ReviewRepo) UpsertConfig(ctx context.Context, challengeID uuid.UUID) error {
+	if err := r.q.UpsertChallengeManualReview(ctx, challengeID); err != nil {
+		return fmt.Errorf("ReviewRepo - UpsertConfig: %w", err)
+	}
+	return nil
+}
+
+func (r *ReviewRepo) DeleteConfig(ctx context.Context, challengeID uuid.UUID) error {
+	if err := r.q.DeleteChallengeManualReview(ctx, challengeID); err != nil {
+		return fmt.Errorf("ReviewRepo - DeleteConfig: %w", err)
+	}
+	return nil
+}
+
+func (r *ReviewRepo) Create(ctx context.Context, review *entity.SubmissionReview) error {
+	row, err := r.q.CreateSubmissionReview(ctx, sqlc.CreateSubmissionReviewParams{
+		ChallengeID: review.ChallengeID,
+		TeamID:      review.TeamID,
+		UserID:      review.UserID,
+		Answer:      review.Answer,
+	})
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrReviewAlreadyPending
+		}
+		return fmt.Errorf("ReviewRepo - Create: %w", err)
+	}
+	review.ID = row.ID
+	review.Status = entity.ReviewStatus(row.Status)
+	review.CreatedAt = ptrTimeToTime(row.CreatedAt)
+	return nil
+}
+
+func (r *ReviewRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.SubmissionReview, error) {
+	row, err := r.q.GetSubmissionReviewByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrReviewNotFound
+		}
+		return nil, fmt.Errorf("ReviewRepo - GetByID: %w", err)
+	}
+	return &entity.SubmissionReview{
+		ID:           row.ID,
+		ChallengeID:  row.ChallengeID,
+		TeamID:       row.TeamID,
+		UserID:       row.UserID,
+		Answer:       row.Answer,
+		Status:       entity.ReviewStatus(row.Status),
+		Points:       int32PtrToIntPtr(row.Points),
+		Comment:      row.Comment,
+		ReviewedBy:   row.ReviewedBy,
+		ReviewedAt:   row.ReviewedAt,
+		SubmissionID: row.SubmissionID,
+		CreatedAt:    ptrTimeToTime(row.CreatedAt),
+	}, nil
+}
+
+// LinkSubmission attaches the logged submission to the team's pending review of the challenge.
+func (r *ReviewRepo) LinkSubmission(ctx context.Context, challengeID, teamID, submissionID uuid.UUID) error {
+	n, err := r.q.LinkPendingSubmissionReview(ctx, sqlc.LinkPendingSubmissionReviewParams{
+		ChallengeID:  challengeID,
+		TeamID:       teamID,
+		SubmissionID: &submissionID,
+	})
+	if err != nil {
+		return fmt.Errorf("ReviewRepo - LinkSubmission: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrReviewNotFound
+	}
+	return nil
+}
+
+func (r *ReviewRepo) GetAll(ctx context.Context, status *entity.ReviewStatus, limit, offset int) ([]*entity.SubmissionReviewWithDetails, error) {
+	limit32, err := intToInt32Safe(limit)
+	if err != nil {
+		return nil, fmt.Errorf("ReviewRepo - GetAll limit: %w", err)
+	}
+	offset32, err := intToInt32Safe(offset)
+	if err != nil {
+		return nil, fmt.Errorf("ReviewRepo - GetAll offset: %w", err)
+	}
+	rows, err := r.q.GetSubmissionReviews(ctx, sqlc.GetSubmissionReviewsParams{
+		Status: reviewStatusPtr(status),
+		Limit:  limit32,
+		Offset: offset32,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ReviewRepo - GetAll: %w", err)
+	}
+
+	result := make([]*entity.SubmissionReviewWithDetails, len(rows))
+	for i, row := range rows {
+		result[i] = &entity.SubmissionReviewWithDetails{
+			SubmissionReview: entity.SubmissionReview{
+				ID:          row.ID,
+				ChallengeID: row.ChallengeID,
+				TeamID:      row.TeamID,
+				UserID:      row.UserID,
+				Answer:      row.Answer,
+				Status:      entity.ReviewStatus(row.Status),
+				Points:      int32PtrToIntPtr(row.Points),
+				Comment:     row.Comment,
+				ReviewedBy:  row.ReviewedBy,
+				ReviewedAt:  row.ReviewedAt,
+				CreatedAt:   ptrTimeToTime(row.CreatedAt),
+			},
+			ChallengeTitle: row.ChallengeTitle,
+			TeamName:       row.TeamName,
+			Username:       row.Username,
+		}
+	}
+	return result, nil
+}
+
+func (r *ReviewRepo) CountAll(ctx context.Context, status *entity.ReviewStatus) (int64, error) {
+	n, err := r.q.CountSubmissionReviews(ctx, reviewStatusPtr(status))
+	if err != nil {
+		return 0, fmt.Errorf("ReviewRepo - CountAll: %w", err)
+	}
+	return n, nil
+}
+
+func (r *ReviewRepo) ResolveTx(ctx context.Context, tx repo.Transaction, review *entity.SubmissionReview) error {
+	var points *int32
+	if review.Points != nil {
+		p, err := intToInt32Safe(*review.Points)
+		if err != nil {
+			return fmt.Errorf("ReviewRepo - ResolveTx points: %w", err)
+		}
+		points = &p
+	}
+	reviewedAt := time.Now().UTC()
+	n, err := r.q.WithTx(mustPgxTx(tx)).ResolveSubmissionReview(ctx, sqlc.ResolveSubmissionReviewParams{
+		ID:         review.ID,
+		Status:     string(review.Status),
+		Points:     points,
+		Comment:    review.Comment,
+		ReviewedBy: review.ReviewedBy,
+		ReviewedAt: &reviewedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("ReviewRepo - ResolveTx: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrReviewAlreadyResolved
+	}
+	review.ReviewedAt = &reviewedAt
+	return nil
+}
+
+func reviewStatusPtr(status *entity.ReviewStatus) *string {
+	if status == nil {
+		return nil
+	}
+	s := string(*status)
+	return &s
+}
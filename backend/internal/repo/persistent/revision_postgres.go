@@ -0,0 +1,213 @@
+package persistent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type RevisionRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewRevisionRepo(db *pgxpool.Pool) *RevisionRepo {
+	return &RevisionRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityRevision(row sqlc.ChallengeRevision) (*entity.ChallengeRevision, error) {
+	rev := &entity.ChallengeRevision{
+		ID:            row.ID,
+		ChallengeID:   row.ChallengeID,
+		Version:       int(row.Version),
+		AuthorID:      row.AuthorID,
+		ChangedFields: row.ChangedFields,
+		RestoredFrom:  int32PtrToIntPtr(row.RestoredFrom),
+		CreatedAt:     ptrTimeToTime(row.CreatedAt),
+	}
+	if err := json.Unmarshal(row.Snapshot, &rev.Snapshot); err != nil {
+		return nil, err
+	}
+	return rev, nil
+}
+
+func (r *RevisionRepo) GetLatestTx(ctx context.Context, tx repo.Transaction, challengeID uuid.UUID) (*entity.ChallengeRevision, error) {
+	row, err := r.q.WithTx(mustPgxTx(tx)).GetLatestChallengeRevision(ctx, challengeID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("RevisionRepo - GetLatestTx: %w", err)
+	}
+	rev, err := toEntityRevision(row)
+	if err != nil {
+		return nil, fmt.Errorf("RevisionRepo - GetLatestTx - Unmarshal: %w", err)
+	}
+	return rev, nil
+}
+
+func (r *RevisionRepo) GetByVersion(ctx context.Context, challengeID uuid.UUID, version int) (*entity.ChallengeRevision, error) {
+	version32, err := intToInt32Safe(version)
+	if err != nil {
+		return nil, fmt.Errorf("RevisionRepo - GetByVersion version: %w", err)
+	}
+	row, err := r.q.GetChallengeRevisionByVersion(ctx, sqlc.GetChallengeRevisionByVersionParams{
+		ChallengeID: challengeID,
+		Version:     version32,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("RevisionRepo - GetByVersion: %w", err)
+	}
+	rev, err := toEntityRevision(row)
+	if err != nil {
+		return nil, fmt.Errorf("RevisionRepo - GetByVersion - Unmarshal: %w", err)
+	}
+	return rev, nil
+}
+
+func (r *RevisionRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeRevision, error) {
+	rows, err := r.q.GetChallengeRevisions(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("RevisionRepo - GetByChallengeID: %w", err)
+	}
+	result := make([]*entity.ChallengeRevision, len(rows))
+	for i, row := range rows {
+		rev, err := toEntityRevision(sqlc.ChallengeRevision{
+			ID:            row.ID,
+			ChallengeID:   row.ChallengeID,
+			Version:       row.Version,
+			AuthorID:      row.AuthorID,
+			Snapshot:      row.Snapshot,
+			ChangedFields: row.ChangedFields,
+			RestoredFrom:  row.RestoredFrom,
+			CreatedAt:     row.CreatedAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("RevisionRepo - GetByChallengeID - Unmarshal: %w", err)
+		}
+		rev.AuthorName = ptrStrToStr(row.AuthorName)
+		result[i] = rev
+	}
+	return result, nil
+}
+
+func (r *RevisionRepo) CreateTx(ctx context.Context, tx repo.Transaction, revision *entity.ChallengeRevision) error {
+	snapshot, err := json.Marshal(revision.Snapshot)
+	if err != nil {
+		return fmt.Errorf("RevisionRepo - CreateTx - Marshal: %w", err)
+	}
+	var restoredFrom *int32
+	if revision.RestoredFrom != nil {
+		v, err := intToInt32Safe(*revision.RestoredFrom)
+		if err != nil {
+			return fmt.Errorf("RevisionRepo - CreateTx restoredFrom: %w", err)
+		}
+		restoredFrom = &v
+	}
+	changedFields := revision.ChangedFields
+	if changedFields == nil {
+		changedFields = []string{}
+	}
+	row, err := r.q.WithTx(mustPgxTx(tx)).CreateChallengeRevision(ctx, sqlc.CreateChallengeRevisionParams{
+		ChallengeID:   revision.ChallengeID,
+		AuthorID:      revision.AuthorID,
+		Snapshot:      snapshot,
+		ChangedFields: changedFields,
+		RestoredFrom:  restoredFrom,
+	})
+	if err != nil {
+		return fmt.Errorf("RevisionRepo - CreateTx: %w", err)
+	}
+	revision.ID = row.ID
+	revision.Version = int(row.Version)
+	revision.ChangedFields = changedFields
+	revision.CreatedAt = ptrTimeToTime(row.CreatedAt)
+	return nil
+}
+
+func (r *RevisionRepo) RestoreTx(ctx context.Context, tx repo.Transaction, challengeID uuid.UUID, snapshot *entity.ChallengeSnapshot) error {
+	q := r.q.WithTx(mustPgxTx(tx))
+
+	pts, err := intToInt32Safe(snapshot.Points)
+	if err != nil {
+		return fmt.Errorf("RevisionRepo - RestoreTx Points: %w", err)
+	}
+	initialValue, err := intToInt32Safe(snapshot.InitialValue)
+	if err != nil {
+		return fmt.Errorf("RevisionRepo - RestoreTx InitialValue: %w", err)
+	}
+	minValue, err := intToInt32Safe(snapshot.MinValue)
+	if err != nil {
+		return fmt.Errorf("RevisionRepo - RestoreTx MinValue: %w", err)
+	}
+	decay, err := intToInt32Safe(snapshot.Decay)
+	if err != nil {
+		return fmt.Errorf("RevisionRepo - RestoreTx Decay: %w", err)
+	}
+	err = q.UpdateChallenge(ctx, sqlc.UpdateChallengeParams{
+		ID:                challengeID,
+		Title:             snapshot.Title,
+		Description:       snapshot.Description,
+		Category:          strPtrOrNil(snapshot.Category),
+		Points:            &pts,
+		InitialValue:      initialValue,
+		MinValue:          minValue,
+		Decay:             decay,
+		FlagHash:          snapshot.FlagHash,
+		IsHidden:          &snapshot.IsHidden,
+		IsRegex:           &snapshot.IsRegex,
+		IsCaseInsensitive: &snapshot.IsCaseInsensitive,
+		FlagRegex:         strPtrOrNil(snapshot.FlagRegex),
+		FlagFormatRegex:   snapshot.FlagFormatRegex,
+	})
+	if err != nil {
+		return fmt.Errorf("RevisionRepo - RestoreTx - UpdateChallenge: %w", err)
+	}
+
+	if err := q.DeleteChallengeTags(ctx, challengeID); err != nil {
+		return fmt.Errorf("RevisionRepo - RestoreTx - DeleteTags: %w", err)
+	}
+	for _, tagID := range snapshot.TagIDs {
+		if err := q.AddChallengeTag(ctx, sqlc.AddChallengeTagParams{ChallengeID: challengeID, TagID: tagID}); err != nil {
+			return fmt.Errorf("RevisionRepo - RestoreTx - AddTag: %w", err)
+		}
+	}
+
+	keepIDs := make([]uuid.UUID, len(snapshot.Hints))
+	for i, h := range snapshot.Hints {
+		keepIDs[i] = h.ID
+	}
+	if err := q.DeleteChallengeHintsExcept(ctx, sqlc.DeleteChallengeHintsExceptParams{ChallengeID: challengeID, KeepIds: keepIDs}); err != nil {
+		return fmt.Errorf("RevisionRepo - RestoreTx - DeleteHints: %w", err)
+	}
+	for _, h := range snapshot.Hints {
+		cost, err := intToInt32Safe(h.Cost)
+		if err != nil {
+			return fmt.Errorf("RevisionRepo - RestoreTx hint Cost: %w", err)
+		}
+		orderIndex, err := intToInt32Safe(h.OrderIndex)
+		if err != nil {
+			return fmt.Errorf("RevisionRepo - RestoreTx hint OrderIndex: %w", err)
+		}
+		if err := q.RestoreChallengeHint(ctx, sqlc.RestoreChallengeHintParams{
+			ID:          h.ID,
+			ChallengeID: challengeID,
+			Content:     h.Content,
+			Cost:        cost,
+			OrderIndex:  orderIndex,
+		}); err != nil {
+			return fmt.Errorf("RevisionRepo - RestoreTx - RestoreHint: %w", err)
+		}
+	}
+	return nil
+}
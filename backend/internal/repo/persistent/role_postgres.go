@@ -0,0 +1,100 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type RoleRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewRoleRepo(db *pgxpool.Pool) *RoleRepo {
+	return &RoleRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityRole(r sqlc.Role) *entity.Role {
+	permissions := r.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return &entity.Role{
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: permissions,
+		CreatedAt:   ptrTimeToTime(r.CreatedAt),
+	}
+}
+
+func (r *RoleRepo) Create(ctx context.Context, role *entity.Role) error {
+	role.CreatedAt = time.Now()
+	if err := r.q.CreateRole(ctx, sqlc.CreateRoleParams{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		CreatedAt:   &role.CreatedAt,
+	}); err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrRoleAlreadyExists
+		}
+		return fmt.Errorf("RoleRepo - Create: %w", err)
+	}
+	return nil
+}
+
+func (r *RoleRepo) GetByName(ctx context.Context, name string) (*entity.Role, error) {
+	role, err := r.q.GetRoleByName(ctx, name)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("RoleRepo - GetByName: %w", err)
+	}
+	return toEntityRole(role), nil
+}
+
+func (r *RoleRepo) GetAll(ctx context.Context) ([]*entity.Role, error) {
+	rows, err := r.q.GetAllRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("RoleRepo - GetAll: %w", err)
+	}
+	out := make([]*entity.Role, 0, len(rows))
+	for _, role := range rows {
+		out = append(out, toEntityRole(role))
+	}
+	return out, nil
+}
+
+func (r *RoleRepo) Update(ctx context.Context, role *entity.Role) error {
+	n, err := r.q.UpdateRole(ctx, sqlc.UpdateRoleParams{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	})
+	if err != nil {
+		return fmt.Errorf("RoleRepo - Update: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrRoleNotFound
+	}
+	return nil
+}
+
+// Delete removes a custom role and moves its users back to the regular user role.
+func (r *RoleRepo) Delete(ctx context.Context, name string) error {
+	n, err := r.q.DeleteRole(ctx, name)
+	if err != nil {
+		return fmt.Errorf("RoleRepo - Delete: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrRoleNotFound
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type SessionRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewSessionRepo(db *pgxpool.Pool) *SessionRepo {
+	return &SessionRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntitySession(s sqlc.UserSession) *entity.Session {
+	return &entity.Session{
+		ID:         s.ID,
+		UserID:     s.UserID,
+		RefreshJTI: s.RefreshJti,
+		AccessJTI:  s.AccessJti,
+		IP:         s.Ip,
+		UserAgent:  s.UserAgent,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt,
+		LastSeenAt: ptrTimeToTime(s.LastSeenAt),
+		CreatedAt:  ptrTimeToTime(s.CreatedAt),
+		UpdatedAt:  ptrTimeToTime(s.UpdatedAt),
+	}
+}
+
+func (r *SessionRepo) Create(ctx context.Context, session *entity.Session) error {
+	if session.ID == uuid.Nil {
+		session.ID = uuid.New()
+	}
+	session.CreatedAt = time.Now()
+	session.UpdatedAt = session.CreatedAt
+	session.LastSeenAt = session.CreatedAt
+	err := r.q.CreateUserSession(ctx, sqlc.CreateUserSessionParams{
+		ID:         session.ID,
+		UserID:     session.UserID,
+		RefreshJti: session.RefreshJTI,
+		AccessJti:  session.AccessJTI,
+		Ip:         session.IP,
+		UserAgent:  session.UserAgent,
+		ExpiresAt:  session.ExpiresAt,
+		CreatedAt:  &session.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("SessionRepo - Create: %w", err)
+	}
+	return nil
+}
+
+func (r *SessionRepo) GetByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	s, err := r.q.GetUserSessionByID(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("SessionRepo - GetByID: %w", err)
+	}
+	return toEntitySession(s), nil
+}
+
+func (r *SessionRepo) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	rows, err := r.q.GetActiveUserSessionsByUserID(ctx, sqlc.GetActiveUserSessionsByUserIDParams{
+		UserID:    userID,
+		ExpiresAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("SessionRepo - GetActiveByUserID: %w", err)
+	}
+	out := make([]*entity.Session, 0, len(rows))
+	for _, s := range rows {
+		out = append(out, toEntitySession(s))
+	}
+	return out, nil
+}
+
+// Rotate swaps the refresh jti only if it still equals oldRefreshJTI, so two concurrent refreshes with the same token cannot both succeed.
+func (r *SessionRepo) Rotate(ctx context.Context, id uuid.UUID, oldRefreshJTI, newRefreshJTI, newAccessJTI string, expiresAt time.Time) (bool, error) {
+	now := time.Now()
+	n, err := r.q.RotateUserSession(ctx, sqlc.RotateUserSessionParams{
+		NewRefreshJti: newRefreshJTI,
+		AccessJti:     newAccessJTI,
+		ExpiresAt:     expiresAt,
+		UpdatedAt:     &now,
+		ID:            id,
+		OldRefreshJti: oldRefreshJTI,
+	})
+	if err != nil {
+		return false, fmt.Errorf("SessionRepo - Rotate: %w", err)
+	}
+	return n == 1, nil
+}
+
+func (r *SessionRepo) Touch(ctx context.Context, id uuid.UUID, lastSeenAt time.Time) error {
+	if err := r.q.TouchUserSession(ctx, sqlc.TouchUserSessionParams{ID: id, LastSeenAt: &lastSeenAt}); err != nil {
+		return fmt.Errorf("SessionRepo - Touch: %w", err)
+	}
+	return nil
+}
+
+func (r *SessionRepo) Revoke(ctx context.Context, id uuid.UUID) error {
+	now := time.Now()
+	if err := r.q.RevokeUserSession(ctx, sqlc.RevokeUserSessionParams{ID: id, RevokedAt: &now}); err != nil {
+		return fmt.Errorf("SessionRepo - Revoke: %w", err)
+	}
+	return nil
+}
+
+func (r *SessionRepo) RevokeAllByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	now := time.Now()
+	n, err := r.q.RevokeUserSessionsByUserID(ctx, sqlc.RevokeUserSessionsByUserIDParams{UserID: userID, RevokedAt: &now})
+	if err != nil {
+		return 0, fmt.Errorf("SessionRepo - RevokeAllByUserID: %w", err)
+	}
+	return n, nil
+}
+
+func (r *SessionRepo) RevokeAllByTeamID(ctx context.Context, teamID uuid.UUID) (int64, error) {
+	now := time.Now()
+	n, err := r.q.RevokeUserSessionsByTeamID(ctx, sqlc.RevokeUserSessionsByTeamIDParams{TeamID: &teamID, RevokedAt: &now})
+	if err != nil {
+		return 0, fmt.Errorf("SessionRepo - RevokeAllByTeamID: %w", err)
+	}
+	return n, nil
+}
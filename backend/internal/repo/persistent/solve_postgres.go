@@ -29,9 +29,21 @@ func toEntitySolve(s sqlc.Solf) *entity.Solve {
 		TeamID:      s.TeamID,
 		ChallengeID: s.ChallengeID,
 		SolvedAt:    ptrTimeToTime(s.SolvedAt),
+		Points:      int32PtrToIntPtr(s.Points),
 	}
 }
 
+func toSolvePoints(s *entity.Solve) (*int32, error) {
+	if s.Points == nil {
+		return nil, nil
+	}
+	p, err := intToInt32Safe(*s.Points)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
 func toScoreboardEntry(row sqlc.GetScoreboardRow) *repo.ScoreboardEntry {
 	return &repo.ScoreboardEntry{
 		TeamID:   row.TeamID,
@@ -79,14 +91,19 @@ func toFirstBloodEntry(row sqlc.GetFirstBloodRow) *repo.FirstBloodEntry {
 }
 
 func (r *SolveRepo) Create(ctx context.Context, s *entity.Solve) error {
+	points, err := toSolvePoints(s)
+	if err != nil {
+		return fmt.Errorf("SolveRepo - Create points: %w", err)
+	}
 	s.ID = uuid.New()
 	s.SolvedAt = time.Now()
-	err := r.q.CreateSolve(ctx, sqlc.CreateSolveParams{
+	err = r.q.CreateSolve(ctx, sqlc.CreateSolveParams{
 		ID:          s.ID,
 		UserID:      s.UserID,
 		TeamID:      s.TeamID,
 		ChallengeID: s.ChallengeID,
 		SolvedAt:    &s.SolvedAt,
+		Points:      points,
 	})
 	if err != nil {
 		if isPgUniqueViolation(err) {
@@ -197,6 +214,14 @@ func (r *SolveRepo) GetTeamScore(ctx context.Context, teamID uuid.UUID) (int, er
 	return int(total), nil
 }
 
+func (r *SolveRepo) GetSolvedChallengeIDs(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := r.q.GetSolvedChallengeIDsByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("SolveRepo - GetSolvedChallengeIDs: %w", err)
+	}
+	return ids, nil
+}
+
 func (r *SolveRepo) GetFirstBlood(ctx context.Context, challengeID uuid.UUID) (*repo.FirstBloodEntry, error) {
 	row, err := r.q.GetFirstBlood(ctx, challengeID)
 	if err != nil {
@@ -219,3 +244,15 @@ func (r *SolveRepo) GetAll(ctx context.Context) ([]*entity.Solve, error) {
 	}
 	return out, nil
 }
+
+// PinPoints fixes every solve of challengeID that follows the challenge value at points.
+func (r *SolveRepo) PinPoints(ctx context.Context, challengeID uuid.UUID, points int) error {
+	p, err := intToInt32Safe(points)
+	if err != nil {
+		return fmt.Errorf("SolveRepo - PinPoints points: %w", err)
+	}
+	if err := r.q.PinSolvePoints(ctx, sqlc.PinSolvePointsParams{ChallengeID: challengeID, Points: &p}); err != nil {
+		return fmt.Errorf("SolveRepo - PinPoints: %w", err)
+	}
+	return nil
+}
@@ -13,15 +13,17 @@ import (
 )
 
 const createAPIToken = `-- name: CreateAPIToken :exec
-INSERT INTO api_tokens (id, user_id, token_hash, description, expires_at, created_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO api_tokens (id, user_id, token_hash, description, scopes, created_by, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateAPITokenParams struct {
 	ID          uuid.UUID  `json:"id"`
-	UserID      uuid.UUID  `json:"user_id"`
+	UserID      *uuid.UUID `json:"user_id"`
 	TokenHash   string     `json:"token_hash"`
 	Description *string    `json:"description"`
+	Scopes      []string   `json:"scopes"`
+	CreatedBy   *uuid.UUID `json:"created_by"`
 	ExpiresAt   *time.Time `json:"expires_at"`
 	CreatedAt   *time.Time `json:"created_at"`
 }
@@ -32,19 +34,49 @@ func (q *Queries) CreateAPIToken(ctx context.Context, arg CreateAPITokenParams)
 		arg.UserID,
 		arg.TokenHash,
 		arg.Description,
+		arg.Scopes,
+		arg.CreatedBy,
 		arg.ExpiresAt,
 		arg.CreatedAt,
 	)
 	return err
 }
 
+const createAPITokenRequest = `-- name: CreateAPITokenRequest :exec
+INSERT INTO api_token_requests (id, token_id, method, path, status, ip, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateAPITokenRequestParams struct {
+	ID        uuid.UUID  `json:"id"`
+	TokenID   uuid.UUID  `json:"token_id"`
+	Method    string     `json:"method"`
+	Path      string     `json:"path"`
+	Status    int32      `json:"status"`
+	Ip        *string    `json:"ip"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (q *Queries) CreateAPITokenRequest(ctx context.Context, arg CreateAPITokenRequestParams) error {
+	_, err := q.db.Exec(ctx, createAPITokenRequest,
+		arg.ID,
+		arg.TokenID,
+		arg.Method,
+		arg.Path,
+		arg.Status,
+		arg.Ip,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const deleteAPIToken = `-- name: DeleteAPIToken :exec
 DELETE FROM api_tokens WHERE id = $1 AND user_id = $2
 `
 
 type DeleteAPITokenParams struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"user_id"`
+	ID     uuid.UUID  `json:"id"`
+	UserID *uuid.UUID `json:"user_id"`
 }
 
 func (q *Queries) DeleteAPIToken(ctx context.Context, arg DeleteAPITokenParams) error {
@@ -52,8 +84,29 @@ func (q *Queries) DeleteAPIToken(ctx context.Context, arg DeleteAPITokenParams)
 	return err
 }
 
+const deleteAPITokensByUserID = `-- name: DeleteAPITokensByUserID :exec
+DELETE FROM api_tokens WHERE user_id = $1
+`
+
+func (q *Queries) DeleteAPITokensByUserID(ctx context.Context, userID *uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAPITokensByUserID, userID)
+	return err
+}
+
+const deleteServiceAPIToken = `-- name: DeleteServiceAPIToken :execrows
+DELETE FROM api_tokens WHERE id = $1 AND user_id IS NULL
+`
+
+func (q *Queries) DeleteServiceAPIToken(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteServiceAPIToken, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getAPITokenByHash = `-- name: GetAPITokenByHash :one
-SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at
+SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at, scopes, created_by
 FROM api_tokens
 WHERE token_hash = $1
 `
@@ -69,18 +122,84 @@ func (q *Queries) GetAPITokenByHash(ctx context.Context, tokenHash string) (ApiT
 		&i.ExpiresAt,
 		&i.LastUsedAt,
 		&i.CreatedAt,
+		&i.Scopes,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const getAPITokenByID = `-- name: GetAPITokenByID :one
+SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at, scopes, created_by
+FROM api_tokens
+WHERE id = $1
+`
+
+func (q *Queries) GetAPITokenByID(ctx context.Context, id uuid.UUID) (ApiToken, error) {
+	row := q.db.QueryRow(ctx, getAPITokenByID, id)
+	var i ApiToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.Description,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+		&i.Scopes,
+		&i.CreatedBy,
 	)
 	return i, err
 }
 
+const getAPITokenRequests = `-- name: GetAPITokenRequests :many
+SELECT id, token_id, method, path, status, ip, created_at
+FROM api_token_requests
+WHERE token_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type GetAPITokenRequestsParams struct {
+	TokenID uuid.UUID `json:"token_id"`
+	Limit   int32     `json:"limit"`
+}
+
+func (q *Queries) GetAPITokenRequests(ctx context.Context, arg GetAPITokenRequestsParams) ([]ApiTokenRequest, error) {
+	rows, err := q.db.Query(ctx, getAPITokenRequests, arg.TokenID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiTokenRequest
+	for rows.Next() {
+		var i ApiTokenRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.TokenID,
+			&i.Method,
+			&i.Path,
+			&i.Status,
+			&i.Ip,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAPITokensByUserID = `-- name: GetAPITokensByUserID :many
-SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at
+SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at, scopes, created_by
 FROM api_tokens
 WHERE user_id = $1
 ORDER BY created_at DESC
 `
 
-func (q *Queries) GetAPITokensByUserID(ctx context.Context, userID uuid.UUID) ([]ApiToken, error) {
+func (q *Queries) GetAPITokensByUserID(ctx context.Context, userID *uuid.UUID) ([]ApiToken, error) {
 	rows, err := q.db.Query(ctx, getAPITokensByUserID, userID)
 	if err != nil {
 		return nil, err
@@ -97,6 +216,45 @@ func (q *Queries) GetAPITokensByUserID(ctx context.Context, userID uuid.UUID) ([
 			&i.ExpiresAt,
 			&i.LastUsedAt,
 			&i.CreatedAt,
+			&i.Scopes,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getServiceAPITokens = `-- name: GetServiceAPITokens :many
+SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at, scopes, created_by
+FROM api_tokens
+WHERE user_id IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetServiceAPITokens(ctx context.Context) ([]ApiToken, error) {
+	rows, err := q.db.Query(ctx, getServiceAPITokens)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiToken
+	for rows.Next() {
+		var i ApiToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TokenHash,
+			&i.Description,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+			&i.Scopes,
+			&i.CreatedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -15,7 +15,8 @@ SELECT id, app_name, verify_emails, frontend_url, cors_origins,
        resend_enabled, resend_from_email, resend_from_name,
        verify_ttl_hours, reset_ttl_hours,
        submit_limit_per_user, submit_limit_duration_min,
-       scoreboard_visible, registration_open, updated_at
+       scoreboard_visible, registration_open, require_2fa_for_admins, updated_at,
+       registration_opens_at, registration_closes_at
 FROM app_settings
 WHERE id = 1
 `
@@ -38,7 +39,10 @@ func (q *Queries) GetAppSettings(ctx context.Context) (AppSetting, error) {
 		&i.SubmitLimitDurationMin,
 		&i.ScoreboardVisible,
 		&i.RegistrationOpen,
+		&i.Require2faForAdmins,
 		&i.UpdatedAt,
+		&i.RegistrationOpensAt,
+		&i.RegistrationClosesAt,
 	)
 	return i, err
 }
@@ -58,25 +62,31 @@ UPDATE app_settings SET
     submit_limit_duration_min = $11,
     scoreboard_visible = $12,
     registration_open = $13,
-    updated_at = $14
+    require_2fa_for_admins = $14,
+    updated_at = $15,
+    registration_opens_at = $16,
+    registration_closes_at = $17
 WHERE id = 1
 `
 
 type UpdateAppSettingsParams struct {
-	AppName                string    `json:"app_name"`
-	VerifyEmails           bool      `json:"verify_emails"`
-	FrontendUrl            string    `json:"frontend_url"`
-	CorsOrigins            string    `json:"cors_origins"`
-	ResendEnabled          bool      `json:"resend_enabled"`
-	ResendFromEmail        string    `json:"resend_from_email"`
-	ResendFromName         string    `json:"resend_from_name"`
-	VerifyTtlHours         int32     `json:"verify_ttl_hours"`
-	ResetTtlHours          int32     `json:"reset_ttl_hours"`
-	SubmitLimitPerUser     int32     `json:"submit_limit_per_user"`
-	SubmitLimitDurationMin int32     `json:"submit_limit_duration_min"`
-	ScoreboardVisible      string    `json:"scoreboard_visible"`
-	RegistrationOpen       bool      `json:"registration_open"`
-	UpdatedAt              time.Time `json:"updated_at"`
+	AppName                string     `json:"app_name"`
+	VerifyEmails           bool       `json:"verify_emails"`
+	FrontendUrl            string     `json:"frontend_url"`
+	CorsOrigins            string     `json:"cors_origins"`
+	ResendEnabled          bool       `json:"resend_enabled"`
+	ResendFromEmail        string     `json:"resend_from_email"`
+	ResendFromName         string     `json:"resend_from_name"`
+	VerifyTtlHours         int32      `json:"verify_ttl_hours"`
+	ResetTtlHours          int32      `json:"reset_ttl_hours"`
+	SubmitLimitPerUser     int32      `json:"submit_limit_per_user"`
+	SubmitLimitDurationMin int32      `json:"submit_limit_duration_min"`
+	ScoreboardVisible      string     `json:"scoreboard_visible"`
+	RegistrationOpen       bool       `json:"registration_open"`
+	Require2faForAdmins    bool       `json:"require_2fa_for_admins"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+	RegistrationOpensAt    *time.Time `json:"registration_opens_at"`
+	RegistrationClosesAt   *time.Time `json:"registration_closes_at"`
 }
 
 func (q *Queries) UpdateAppSettings(ctx context.Context, arg UpdateAppSettingsParams) error {
@@ -94,7 +104,10 @@ func (q *Queries) UpdateAppSettings(ctx context.Context, arg UpdateAppSettingsPa
 		arg.SubmitLimitDurationMin,
 		arg.ScoreboardVisible,
 		arg.RegistrationOpen,
+		arg.Require2faForAdmins,
 		arg.UpdatedAt,
+		arg.RegistrationOpensAt,
+		arg.RegistrationClosesAt,
 	)
 	return err
 }
@@ -55,6 +55,17 @@ func (q *Queries) CreateChallenge(ctx context.Context, arg CreateChallengeParams
 	return err
 }
 
+const decrementChallengeSolveCount = `-- name: DecrementChallengeSolveCount :one
+UPDATE challenges SET solve_count = GREATEST(solve_count - 1, 0) WHERE id = $1 RETURNING solve_count
+`
+
+func (q *Queries) DecrementChallengeSolveCount(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, decrementChallengeSolveCount, id)
+	var solve_count int32
+	err := row.Scan(&solve_count)
+	return solve_count, err
+}
+
 const deleteChallenge = `-- name: DeleteChallenge :one
 DELETE FROM challenges WHERE id = $1 RETURNING id
 `
@@ -175,6 +186,10 @@ const listChallenges = `-- name: ListChallenges :many
 SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.min_value, c.decay, c.solve_count, c.flag_hash, c.is_hidden, c.is_regex, c.is_case_insensitive, c.flag_regex, c.flag_format_regex, 0::int as solved
 FROM challenges c
 WHERE c.is_hidden = false
+  AND NOT EXISTS (
+      SELECT 1 FROM challenge_schedules cs
+      WHERE cs.challenge_id = c.id AND (cs.release_at > NOW() OR cs.hide_at <= NOW())
+  )
 `
 
 type ListChallengesRow struct {
@@ -238,6 +253,10 @@ SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.mi
 FROM challenges c
 JOIN challenge_tags ct ON ct.challenge_id = c.id AND ct.tag_id = $1
 WHERE c.is_hidden = false
+  AND NOT EXISTS (
+      SELECT 1 FROM challenge_schedules cs
+      WHERE cs.challenge_id = c.id AND (cs.release_at > NOW() OR cs.hide_at <= NOW())
+  )
 `
 
 type ListChallengesByTagRow struct {
@@ -302,6 +321,10 @@ SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.mi
 FROM challenges c
 LEFT JOIN solves s ON s.challenge_id = c.id AND s.team_id = $1
 WHERE c.is_hidden = false
+  AND NOT EXISTS (
+      SELECT 1 FROM challenge_schedules cs
+      WHERE cs.challenge_id = c.id AND (cs.release_at > NOW() OR cs.hide_at <= NOW())
+  )
 `
 
 type ListChallengesForTeamRow struct {
@@ -367,6 +390,10 @@ FROM challenges c
 JOIN challenge_tags ct ON ct.challenge_id = c.id AND ct.tag_id = $1
 LEFT JOIN solves s ON s.challenge_id = c.id AND s.team_id = $2
 WHERE c.is_hidden = false
+  AND NOT EXISTS (
+      SELECT 1 FROM challenge_schedules cs
+      WHERE cs.challenge_id = c.id AND (cs.release_at > NOW() OR cs.hide_at <= NOW())
+  )
 `
 
 type ListChallengesForTeamByTagParams struct {
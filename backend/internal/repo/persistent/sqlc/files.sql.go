@@ -42,6 +42,30 @@ func (q *Queries) CreateFile(ctx context.Context, arg CreateFileParams) error {
 	return err
 }
 
+const createFileDownload = `-- name: CreateFileDownload :exec
+INSERT INTO file_downloads (file_id, challenge_id, user_id, team_id, ip)
+SELECT f.id, f.challenge_id, $1, $2, $3
+FROM files f
+WHERE f.id = $4
+`
+
+type CreateFileDownloadParams struct {
+	UserID uuid.UUID  `json:"user_id"`
+	TeamID *uuid.UUID `json:"team_id"`
+	Ip     *string    `json:"ip"`
+	FileID uuid.UUID  `json:"file_id"`
+}
+
+func (q *Queries) CreateFileDownload(ctx context.Context, arg CreateFileDownloadParams) error {
+	_, err := q.db.Exec(ctx, createFileDownload,
+		arg.UserID,
+		arg.TeamID,
+		arg.Ip,
+		arg.FileID,
+	)
+	return err
+}
+
 const deleteFile = `-- name: DeleteFile :one
 DELETE FROM files WHERE id = $1 RETURNING id
 `
@@ -12,30 +12,45 @@ import (
 
 type ApiToken struct {
 	ID          uuid.UUID  `json:"id"`
-	UserID      uuid.UUID  `json:"user_id"`
+	UserID      *uuid.UUID `json:"user_id"`
 	TokenHash   string     `json:"token_hash"`
 	Description *string    `json:"description"`
 	ExpiresAt   *time.Time `json:"expires_at"`
 	LastUsedAt  *time.Time `json:"last_used_at"`
 	CreatedAt   *time.Time `json:"created_at"`
+	Scopes      []string   `json:"scopes"`
+	CreatedBy   *uuid.UUID `json:"created_by"`
+}
+
+type ApiTokenRequest struct {
+	ID        uuid.UUID  `json:"id"`
+	TokenID   uuid.UUID  `json:"token_id"`
+	Method    string     `json:"method"`
+	Path      string     `json:"path"`
+	Status    int32      `json:"status"`
+	Ip        *string    `json:"ip"`
+	CreatedAt *time.Time `json:"created_at"`
 }
 
 type AppSetting struct {
-	ID                     int32     `json:"id"`
-	AppName                string    `json:"app_name"`
-	VerifyEmails           bool      `json:"verify_emails"`
-	FrontendUrl            string    `json:"frontend_url"`
-	CorsOrigins            string    `json:"cors_origins"`
-	ResendEnabled          bool      `json:"resend_enabled"`
-	ResendFromEmail        string    `json:"resend_from_email"`
-	ResendFromName         string    `json:"resend_from_name"`
-	VerifyTtlHours         int32     `json:"verify_ttl_hours"`
-	ResetTtlHours          int32     `json:"reset_ttl_hours"`
-	SubmitLimitPerUser     int32     `json:"submit_limit_per_user"`
-	SubmitLimitDurationMin int32     `json:"submit_limit_duration_min"`
-	ScoreboardVisible      string    `json:"scoreboard_visible"`
-	RegistrationOpen       bool      `json:"registration_open"`
-	UpdatedAt              time.Time `json:"updated_at"`
+	ID                     int32      `json:"id"`
+	AppName                string     `json:"app_name"`
+	VerifyEmails           bool       `json:"verify_emails"`
+	FrontendUrl            string     `json:"frontend_url"`
+	CorsOrigins            string     `json:"cors_origins"`
+	ResendEnabled          bool       `json:"resend_enabled"`
+	ResendFromEmail        string     `json:"resend_from_email"`
+	ResendFromName         string     `json:"resend_from_name"`
+	VerifyTtlHours         int32      `json:"verify_ttl_hours"`
+	ResetTtlHours          int32      `json:"reset_ttl_hours"`
+	SubmitLimitPerUser     int32      `json:"submit_limit_per_user"`
+	SubmitLimitDurationMin int32      `json:"submit_limit_duration_min"`
+	ScoreboardVisible      string     `json:"scoreboard_visible"`
+	RegistrationOpen       bool       `json:"registration_open"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+	Require2faForAdmins    bool       `json:"require_2fa_for_admins"`
+	RegistrationOpensAt    *time.Time `json:"registration_opens_at"`
+	RegistrationClosesAt   *time.Time `json:"registration_closes_at"`
 }
 
 type AuditLog struct {
@@ -84,11 +99,161 @@ type Challenge struct {
 	FlagFormatRegex   *string   `json:"flag_format_regex"`
 }
 
+type ChallengeAttemptLimit struct {
+	ChallengeID  uuid.UUID `json:"challenge_id"`
+	MaxAttempts  *int32    `json:"max_attempts"`
+	WrongPenalty int32     `json:"wrong_penalty"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type ChallengeAttemptReset struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	TeamID      uuid.UUID `json:"team_id"`
+	ResetAt     time.Time `json:"reset_at"`
+}
+
+type ChallengeAuthor struct {
+	ChallengeID uuid.UUID  `json:"challenge_id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+type ChallengeDecoyFlag struct {
+	ID                uuid.UUID `json:"id"`
+	ChallengeID       uuid.UUID `json:"challenge_id"`
+	FlagHash          string    `json:"flag_hash"`
+	IsCaseInsensitive bool      `json:"is_case_insensitive"`
+	Note              string    `json:"note"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+type ChallengeFlag struct {
+	ID                uuid.UUID  `json:"id"`
+	ChallengeID       uuid.UUID  `json:"challenge_id"`
+	FlagType          string     `json:"flag_type"`
+	FlagHash          *string    `json:"flag_hash"`
+	FlagRegex         *string    `json:"flag_regex"`
+	IsCaseInsensitive bool       `json:"is_case_insensitive"`
+	CreatedAt         *time.Time `json:"created_at"`
+}
+
+type ChallengeInstance struct {
+	ID             uuid.UUID  `json:"id"`
+	ChallengeID    uuid.UUID  `json:"challenge_id"`
+	TeamID         uuid.UUID  `json:"team_id"`
+	ConnectionInfo string     `json:"connection_info"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	CreatedAt      *time.Time `json:"created_at"`
+}
+
+type ChallengeInstanceConfig struct {
+	ChallengeID uuid.UUID  `json:"challenge_id"`
+	Image       string     `json:"image"`
+	TtlSeconds  int32      `json:"ttl_seconds"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+type ChallengeManualReview struct {
+	ChallengeID uuid.UUID  `json:"challenge_id"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+type ChallengePrerequisite struct {
+	ChallengeID    uuid.UUID `json:"challenge_id"`
+	PrerequisiteID uuid.UUID `json:"prerequisite_id"`
+}
+
+type ChallengeRevision struct {
+	ID            uuid.UUID  `json:"id"`
+	ChallengeID   uuid.UUID  `json:"challenge_id"`
+	Version       int32      `json:"version"`
+	AuthorID      *uuid.UUID `json:"author_id"`
+	Snapshot      []byte     `json:"snapshot"`
+	ChangedFields []string   `json:"changed_fields"`
+	RestoredFrom  *int32     `json:"restored_from"`
+	CreatedAt     *time.Time `json:"created_at"`
+}
+
+type ChallengeSchedule struct {
+	ChallengeID     uuid.UUID  `json:"challenge_id"`
+	ReleaseAt       *time.Time `json:"release_at"`
+	HideAt          *time.Time `json:"hide_at"`
+	NotifyOnRelease bool       `json:"notify_on_release"`
+	AnnouncedAt     *time.Time `json:"announced_at"`
+}
+
+type ChallengeScoring struct {
+	ChallengeID  uuid.UUID `json:"challenge_id"`
+	FunctionName string    `json:"function_name"`
+	Params       []byte    `json:"params"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type ChallengeSpec struct {
+	ChallengeID uuid.UUID  `json:"challenge_id"`
+	Slug        string     `json:"slug"`
+	SyncedAt    *time.Time `json:"synced_at"`
+}
+
+type ChallengeStage struct {
+	ID          uuid.UUID  `json:"id"`
+	ChallengeID uuid.UUID  `json:"challenge_id"`
+	OrderIndex  int32      `json:"order_index"`
+	Title       string     `json:"title"`
+	Points      int32      `json:"points"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+type ChallengeStageFlag struct {
+	ID                uuid.UUID  `json:"id"`
+	StageID           uuid.UUID  `json:"stage_id"`
+	FlagType          string     `json:"flag_type"`
+	FlagHash          *string    `json:"flag_hash"`
+	FlagRegex         *string    `json:"flag_regex"`
+	IsCaseInsensitive bool       `json:"is_case_insensitive"`
+	CreatedAt         *time.Time `json:"created_at"`
+}
+
+type ChallengeStageSolve struct {
+	ID       uuid.UUID `json:"id"`
+	StageID  uuid.UUID `json:"stage_id"`
+	TeamID   uuid.UUID `json:"team_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	SolvedAt time.Time `json:"solved_at"`
+}
+
 type ChallengeTag struct {
 	ChallengeID uuid.UUID `json:"challenge_id"`
 	TagID       uuid.UUID `json:"tag_id"`
 }
 
+type ChallengeTeamFlag struct {
+	ChallengeID uuid.UUID  `json:"challenge_id"`
+	Template    string     `json:"template"`
+	Secret      string     `json:"secret"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+type ChallengeUnlockScore struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	MinScore    int32     `json:"min_score"`
+}
+
+type CheatIncident struct {
+	ID           uuid.UUID  `json:"id"`
+	Kind         string     `json:"kind"`
+	ChallengeID  *uuid.UUID `json:"challenge_id"`
+	TeamID       uuid.UUID  `json:"team_id"`
+	UserID       *uuid.UUID `json:"user_id"`
+	SourceTeamID *uuid.UUID `json:"source_team_id"`
+	Status       string     `json:"status"`
+	Evidence     []byte     `json:"evidence"`
+	Fingerprint  *string    `json:"fingerprint"`
+	ResolvedBy   *uuid.UUID `json:"resolved_by"`
+	ResolvedAt   *time.Time `json:"resolved_at"`
+	CreatedAt    *time.Time `json:"created_at"`
+}
+
 type Comment struct {
 	ID          uuid.UUID  `json:"id"`
 	UserID      uuid.UUID  `json:"user_id"`
@@ -162,6 +327,16 @@ type File struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
+type FileDownload struct {
+	ID           uuid.UUID  `json:"id"`
+	FileID       uuid.UUID  `json:"file_id"`
+	ChallengeID  uuid.UUID  `json:"challenge_id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	TeamID       *uuid.UUID `json:"team_id"`
+	Ip           *string    `json:"ip"`
+	DownloadedAt time.Time  `json:"downloaded_at"`
+}
+
 type GlobalRating struct {
 	TeamID      uuid.UUID  `json:"team_id"`
 	TotalPoints float64    `json:"total_points"`
@@ -206,12 +381,38 @@ type Page struct {
 	UpdatedAt  *time.Time `json:"updated_at"`
 }
 
+type RegistrationDomainRule struct {
+	ID        uuid.UUID  `json:"id"`
+	Domain    string     `json:"domain"`
+	Action    string     `json:"action"`
+	BracketID *uuid.UUID `json:"bracket_id"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+type RegistrationInvite struct {
+	ID        uuid.UUID  `json:"id"`
+	Code      string     `json:"code"`
+	MaxUses   *int32     `json:"max_uses"`
+	Uses      int32      `json:"uses"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	CreatedBy *uuid.UUID `json:"created_by"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+type Role struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Permissions []string   `json:"permissions"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
 type Solf struct {
 	ID          uuid.UUID  `json:"id"`
 	UserID      uuid.UUID  `json:"user_id"`
 	TeamID      uuid.UUID  `json:"team_id"`
 	ChallengeID uuid.UUID  `json:"challenge_id"`
 	SolvedAt    *time.Time `json:"solved_at"`
+	Points      *int32     `json:"points"`
 }
 
 type Submission struct {
@@ -225,6 +426,21 @@ type Submission struct {
 	CreatedAt     *time.Time `json:"created_at"`
 }
 
+type SubmissionReview struct {
+	ID           uuid.UUID  `json:"id"`
+	ChallengeID  uuid.UUID  `json:"challenge_id"`
+	TeamID       uuid.UUID  `json:"team_id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	Answer       string     `json:"answer"`
+	Status       string     `json:"status"`
+	Points       *int32     `json:"points"`
+	Comment      string     `json:"comment"`
+	ReviewedBy   *uuid.UUID `json:"reviewed_by"`
+	ReviewedAt   *time.Time `json:"reviewed_at"`
+	CreatedAt    *time.Time `json:"created_at"`
+	SubmissionID *uuid.UUID `json:"submission_id"`
+}
+
 type Tag struct {
 	ID    uuid.UUID `json:"id"`
 	Name  string    `json:"name"`
@@ -276,6 +492,19 @@ type User struct {
 	IsVerified   *bool      `json:"is_verified"`
 	VerifiedAt   *time.Time `json:"verified_at"`
 	CreatedAt    *time.Time `json:"created_at"`
+	IsBanned     *bool      `json:"is_banned"`
+	BannedAt     *time.Time `json:"banned_at"`
+	BannedReason *string    `json:"banned_reason"`
+}
+
+type UserIdentity struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Provider    string     `json:"provider"`
+	Subject     string     `json:"subject"`
+	Email       *string    `json:"email"`
+	CreatedAt   *time.Time `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
 }
 
 type UserNotification struct {
@@ -289,6 +518,37 @@ type UserNotification struct {
 	CreatedAt      *time.Time `json:"created_at"`
 }
 
+type UserRecoveryCode struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	CodeHash  string     `json:"code_hash"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt *time.Time `json:"created_at"`
+	NewEmail  *string    `json:"new_email"`
+}
+
+type UserSession struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	RefreshJti string     `json:"refresh_jti"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  *time.Time `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+	AccessJti  string     `json:"access_jti"`
+	Ip         string     `json:"ip"`
+	UserAgent  string     `json:"user_agent"`
+	LastSeenAt *time.Time `json:"last_seen_at"`
+}
+
+type UserTwoFactor struct {
+	UserID          uuid.UUID  `json:"user_id"`
+	SecretEncrypted string     `json:"secret_encrypted"`
+	EnabledAt       *time.Time `json:"enabled_at"`
+	LastUsedStep    int64      `json:"last_used_step"`
+	CreatedAt       *time.Time `json:"created_at"`
+}
+
 type VerificationToken struct {
 	ID        uuid.UUID  `json:"id"`
 	UserID    uuid.UUID  `json:"user_id"`
@@ -297,4 +557,5 @@ type VerificationToken struct {
 	ExpiresAt time.Time  `json:"expires_at"`
 	UsedAt    *time.Time `json:"used_at"`
 	CreatedAt *time.Time `json:"created_at"`
+	NewEmail  *string    `json:"new_email"`
 }
@@ -13,8 +13,8 @@ import (
 )
 
 const createSolve = `-- name: CreateSolve :exec
-INSERT INTO solves (id, user_id, team_id, challenge_id, solved_at)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO solves (id, user_id, team_id, challenge_id, solved_at, points)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type CreateSolveParams struct {
@@ -23,6 +23,7 @@ type CreateSolveParams struct {
 	TeamID      uuid.UUID  `json:"team_id"`
 	ChallengeID uuid.UUID  `json:"challenge_id"`
 	SolvedAt    *time.Time `json:"solved_at"`
+	Points      *int32     `json:"points"`
 }
 
 func (q *Queries) CreateSolve(ctx context.Context, arg CreateSolveParams) error {
@@ -32,10 +33,20 @@ func (q *Queries) CreateSolve(ctx context.Context, arg CreateSolveParams) error
 		arg.TeamID,
 		arg.ChallengeID,
 		arg.SolvedAt,
+		arg.Points,
 	)
 	return err
 }
 
+const deleteSolve = `-- name: DeleteSolve :exec
+DELETE FROM solves WHERE id = $1
+`
+
+func (q *Queries) DeleteSolve(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSolve, id)
+	return err
+}
+
 const deleteSolvesByTeamID = `-- name: DeleteSolvesByTeamID :exec
 DELETE FROM solves WHERE team_id = $1
 `
@@ -46,7 +57,7 @@ func (q *Queries) DeleteSolvesByTeamID(ctx context.Context, teamID uuid.UUID) er
 }
 
 const getAllSolves = `-- name: GetAllSolves :many
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 ORDER BY solved_at ASC
 `
@@ -66,6 +77,7 @@ func (q *Queries) GetAllSolves(ctx context.Context) ([]Solf, error) {
 			&i.TeamID,
 			&i.ChallengeID,
 			&i.SolvedAt,
+			&i.Points,
 		); err != nil {
 			return nil, err
 		}
@@ -112,22 +124,28 @@ const getScoreboard = `-- name: GetScoreboard :many
 SELECT
     t.id AS team_id,
     t.name AS team_name,
-    COALESCE(solve_points.points, 0) + COALESCE(award_points.total, 0) AS points,
-    solve_points.last_solved AS solved_at
+    COALESCE(solve_points.points, 0) + COALESCE(stage_points.points, 0) + COALESCE(award_points.total, 0) AS points,
+    GREATEST(solve_points.last_solved, stage_points.last_solved) AS solved_at
 FROM teams t
 LEFT JOIN (
-    SELECT s.team_id, SUM(c.points)::int AS points, MAX(s.solved_at) AS last_solved
+    SELECT s.team_id, SUM(COALESCE(s.points, c.points))::int AS points, MAX(s.solved_at) AS last_solved
     FROM solves s
     JOIN challenges c ON c.id = s.challenge_id
     GROUP BY s.team_id
 ) solve_points ON solve_points.team_id = t.id
+LEFT JOIN (
+    SELECT ss.team_id, SUM(st.points)::int AS points, MAX(ss.solved_at) AS last_solved
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON st.id = ss.stage_id
+    GROUP BY ss.team_id
+) stage_points ON stage_points.team_id = t.id
 LEFT JOIN (
     SELECT team_id, SUM(value)::int AS total
     FROM awards
     GROUP BY team_id
 ) award_points ON award_points.team_id = t.id
 WHERE t.is_banned = false AND t.is_hidden = false AND t.deleted_at IS NULL
-ORDER BY points DESC, COALESCE(solve_points.last_solved, '9999-12-31'::timestamp) ASC
+ORDER BY points DESC, COALESCE(GREATEST(solve_points.last_solved, stage_points.last_solved), '9999-12-31'::timestamp) ASC
 `
 
 type GetScoreboardRow struct {
@@ -166,15 +184,21 @@ const getScoreboardByBracket = `-- name: GetScoreboardByBracket :many
 SELECT
     t.id AS team_id,
     t.name AS team_name,
-    COALESCE(solve_points.points, 0) + COALESCE(award_points.total, 0) AS points,
-    solve_points.last_solved AS solved_at
+    COALESCE(solve_points.points, 0) + COALESCE(stage_points.points, 0) + COALESCE(award_points.total, 0) AS points,
+    GREATEST(solve_points.last_solved, stage_points.last_solved) AS solved_at
 FROM teams t
 LEFT JOIN (
-    SELECT s.team_id, SUM(c.points)::int AS points, MAX(s.solved_at) AS last_solved
+    SELECT s.team_id, SUM(COALESCE(s.points, c.points))::int AS points, MAX(s.solved_at) AS last_solved
     FROM solves s
     JOIN challenges c ON c.id = s.challenge_id
     GROUP BY s.team_id
 ) solve_points ON solve_points.team_id = t.id
+LEFT JOIN (
+    SELECT ss.team_id, SUM(st.points)::int AS points, MAX(ss.solved_at) AS last_solved
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON st.id = ss.stage_id
+    GROUP BY ss.team_id
+) stage_points ON stage_points.team_id = t.id
 LEFT JOIN (
     SELECT team_id, SUM(value)::int AS total
     FROM awards
@@ -182,7 +206,7 @@ LEFT JOIN (
 ) award_points ON award_points.team_id = t.id
 WHERE t.is_banned = false AND t.is_hidden = false AND t.deleted_at IS NULL
   AND ($1::uuid IS NULL OR t.bracket_id = $1)
-ORDER BY points DESC, COALESCE(solve_points.last_solved, '9999-12-31'::timestamp) ASC
+ORDER BY points DESC, COALESCE(GREATEST(solve_points.last_solved, stage_points.last_solved), '9999-12-31'::timestamp) ASC
 `
 
 type GetScoreboardByBracketRow struct {
@@ -221,16 +245,23 @@ const getScoreboardByBracketFrozen = `-- name: GetScoreboardByBracketFrozen :man
 SELECT
     t.id AS team_id,
     t.name AS team_name,
-    COALESCE(solve_points.points, 0) + COALESCE(award_points.total, 0) AS points,
-    solve_points.last_solved AS solved_at
+    COALESCE(solve_points.points, 0) + COALESCE(stage_points.points, 0) + COALESCE(award_points.total, 0) AS points,
+    GREATEST(solve_points.last_solved, stage_points.last_solved) AS solved_at
 FROM teams t
 LEFT JOIN (
-    SELECT s.team_id, SUM(c.points)::int AS points, MAX(s.solved_at) AS last_solved
+    SELECT s.team_id, SUM(COALESCE(s.points, c.points))::int AS points, MAX(s.solved_at) AS last_solved
     FROM solves s
     JOIN challenges c ON c.id = s.challenge_id
     WHERE s.solved_at <= $1
     GROUP BY s.team_id
 ) solve_points ON solve_points.team_id = t.id
+LEFT JOIN (
+    SELECT ss.team_id, SUM(st.points)::int AS points, MAX(ss.solved_at) AS last_solved
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON st.id = ss.stage_id
+    WHERE ss.solved_at <= $1
+    GROUP BY ss.team_id
+) stage_points ON stage_points.team_id = t.id
 LEFT JOIN (
     SELECT team_id, SUM(value)::int AS total
     FROM awards
@@ -239,7 +270,7 @@ LEFT JOIN (
 ) award_points ON award_points.team_id = t.id
 WHERE t.is_banned = false AND t.is_hidden = false AND t.deleted_at IS NULL
   AND ($3::uuid IS NULL OR t.bracket_id = $3)
-ORDER BY points DESC, COALESCE(solve_points.last_solved, '9999-12-31'::timestamp) ASC
+ORDER BY points DESC, COALESCE(GREATEST(solve_points.last_solved, stage_points.last_solved), '9999-12-31'::timestamp) ASC
 `
 
 type GetScoreboardByBracketFrozenParams struct {
@@ -284,16 +315,23 @@ const getScoreboardFrozen = `-- name: GetScoreboardFrozen :many
 SELECT
     t.id AS team_id,
     t.name AS team_name,
-    COALESCE(solve_points.points, 0) + COALESCE(award_points.total, 0) AS points,
-    solve_points.last_solved AS solved_at
+    COALESCE(solve_points.points, 0) + COALESCE(stage_points.points, 0) + COALESCE(award_points.total, 0) AS points,
+    GREATEST(solve_points.last_solved, stage_points.last_solved) AS solved_at
 FROM teams t
 LEFT JOIN (
-    SELECT s.team_id, SUM(c.points)::int AS points, MAX(s.solved_at) AS last_solved
+    SELECT s.team_id, SUM(COALESCE(s.points, c.points))::int AS points, MAX(s.solved_at) AS last_solved
     FROM solves s
     JOIN challenges c ON c.id = s.challenge_id
     WHERE s.solved_at <= $1
     GROUP BY s.team_id
 ) solve_points ON solve_points.team_id = t.id
+LEFT JOIN (
+    SELECT ss.team_id, SUM(st.points)::int AS points, MAX(ss.solved_at) AS last_solved
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON st.id = ss.stage_id
+    WHERE ss.solved_at <= $1
+    GROUP BY ss.team_id
+) stage_points ON stage_points.team_id = t.id
 LEFT JOIN (
     SELECT team_id, SUM(value)::int AS total
     FROM awards
@@ -301,7 +339,7 @@ LEFT JOIN (
     GROUP BY team_id
 ) award_points ON award_points.team_id = t.id
 WHERE t.is_banned = false AND t.is_hidden = false AND t.deleted_at IS NULL
-ORDER BY points DESC, COALESCE(solve_points.last_solved, '9999-12-31'::timestamp) ASC
+ORDER BY points DESC, COALESCE(GREATEST(solve_points.last_solved, stage_points.last_solved), '9999-12-31'::timestamp) ASC
 `
 
 type GetScoreboardFrozenParams struct {
@@ -342,7 +380,7 @@ func (q *Queries) GetScoreboardFrozen(ctx context.Context, arg GetScoreboardFroz
 }
 
 const getSolveByID = `-- name: GetSolveByID :one
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 WHERE id = $1
 `
@@ -356,12 +394,13 @@ func (q *Queries) GetSolveByID(ctx context.Context, id uuid.UUID) (Solf, error)
 		&i.TeamID,
 		&i.ChallengeID,
 		&i.SolvedAt,
+		&i.Points,
 	)
 	return i, err
 }
 
 const getSolveByTeamAndChallenge = `-- name: GetSolveByTeamAndChallenge :one
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 WHERE team_id = $1 AND challenge_id = $2
 `
@@ -380,12 +419,13 @@ func (q *Queries) GetSolveByTeamAndChallenge(ctx context.Context, arg GetSolveBy
 		&i.TeamID,
 		&i.ChallengeID,
 		&i.SolvedAt,
+		&i.Points,
 	)
 	return i, err
 }
 
 const getSolveByTeamAndChallengeForUpdate = `-- name: GetSolveByTeamAndChallengeForUpdate :one
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 WHERE team_id = $1 AND challenge_id = $2
 FOR UPDATE
@@ -405,12 +445,37 @@ func (q *Queries) GetSolveByTeamAndChallengeForUpdate(ctx context.Context, arg G
 		&i.TeamID,
 		&i.ChallengeID,
 		&i.SolvedAt,
+		&i.Points,
 	)
 	return i, err
 }
 
+const getSolvedChallengeIDsByTeamID = `-- name: GetSolvedChallengeIDsByTeamID :many
+SELECT challenge_id FROM solves WHERE team_id = $1
+`
+
+func (q *Queries) GetSolvedChallengeIDsByTeamID(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getSolvedChallengeIDsByTeamID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var challenge_id uuid.UUID
+		if err := rows.Scan(&challenge_id); err != nil {
+			return nil, err
+		}
+		items = append(items, challenge_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSolvesByUserID = `-- name: GetSolvesByUserID :many
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 WHERE user_id = $1
 ORDER BY solved_at DESC
@@ -431,6 +496,7 @@ func (q *Queries) GetSolvesByUserID(ctx context.Context, userID uuid.UUID) ([]So
 			&i.TeamID,
 			&i.ChallengeID,
 			&i.SolvedAt,
+			&i.Points,
 		); err != nil {
 			return nil, err
 		}
@@ -445,10 +511,15 @@ func (q *Queries) GetSolvesByUserID(ctx context.Context, userID uuid.UUID) ([]So
 const getTeamScore = `-- name: GetTeamScore :one
 SELECT
     COALESCE((
-        SELECT SUM(c.points) FROM solves s
+        SELECT SUM(COALESCE(s.points, c.points)) FROM solves s
         JOIN challenges c ON c.id = s.challenge_id
         WHERE s.team_id = $1
     ), 0)::int +
+    COALESCE((
+        SELECT SUM(st.points) FROM challenge_stage_solves ss
+        JOIN challenge_stages st ON st.id = ss.stage_id
+        WHERE ss.team_id = $1
+    ), 0)::int +
     COALESCE((
         SELECT SUM(value) FROM awards WHERE team_id = $1
     ), 0)::int AS total
@@ -460,3 +531,17 @@ func (q *Queries) GetTeamScore(ctx context.Context, teamID uuid.UUID) (int32, er
 	err := row.Scan(&total)
 	return total, err
 }
+
+const pinSolvePoints = `-- name: PinSolvePoints :exec
+UPDATE solves SET points = $2 WHERE challenge_id = $1 AND points IS NULL
+`
+
+type PinSolvePointsParams struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	Points      *int32    `json:"points"`
+}
+
+func (q *Queries) PinSolvePoints(ctx context.Context, arg PinSolvePointsParams) error {
+	_, err := q.db.Exec(ctx, pinSolvePoints, arg.ChallengeID, arg.Points)
+	return err
+}
@@ -169,15 +169,24 @@ WITH top_teams AS (
     LEFT JOIN awards a ON a.team_id = t.id
     WHERE t.deleted_at IS NULL
     GROUP BY t.id
-    ORDER BY COALESCE(SUM(c.points), 0) + COALESCE(SUM(a.value), 0) DESC
+    ORDER BY COALESCE(SUM(COALESCE(s.points, c.points)), 0) + COALESCE(SUM(a.value), 0) + COALESCE((
+        SELECT SUM(st.points) FROM challenge_stage_solves ss
+        JOIN challenge_stages st ON st.id = ss.stage_id
+        WHERE ss.team_id = t.id
+    ), 0) DESC
     LIMIT $1
 ),
 events AS (
-    SELECT s.team_id, s.solved_at AS event_time, c.points AS delta
+    SELECT s.team_id, s.solved_at AS event_time, COALESCE(s.points, c.points) AS delta
     FROM solves s
     JOIN challenges c ON s.challenge_id = c.id
     WHERE s.team_id IN (SELECT id FROM top_teams)
     UNION ALL
+    SELECT ss.team_id, ss.solved_at AS event_time, st.points AS delta
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON ss.stage_id = st.id
+    WHERE ss.team_id IN (SELECT id FROM top_teams)
+    UNION ALL
     SELECT a.team_id, a.created_at AS event_time, a.value AS delta
     FROM awards a
     WHERE a.team_id IN (SELECT id FROM top_teams)
@@ -39,6 +39,66 @@ func (q *Queries) CountFailedSubmissionsByIP(ctx context.Context, arg CountFaile
 	return count, err
 }
 
+const countFailedSubmissionsByTeam = `-- name: CountFailedSubmissionsByTeam :many
+SELECT s.challenge_id, COUNT(*) AS failed FROM submissions s
+LEFT JOIN challenge_attempt_resets r ON r.challenge_id = s.challenge_id AND r.team_id = s.team_id
+WHERE s.team_id = $1 AND s.is_correct = FALSE
+  AND (r.reset_at IS NULL OR s.created_at > r.reset_at)
+  AND NOT EXISTS (
+    SELECT 1 FROM submission_reviews sr
+    WHERE sr.submission_id = s.id AND sr.status IN ('pending', 'approved')
+  )
+GROUP BY s.challenge_id
+`
+
+type CountFailedSubmissionsByTeamRow struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	Failed      int64     `json:"failed"`
+}
+
+func (q *Queries) CountFailedSubmissionsByTeam(ctx context.Context, teamID *uuid.UUID) ([]CountFailedSubmissionsByTeamRow, error) {
+	rows, err := q.db.Query(ctx, countFailedSubmissionsByTeam, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountFailedSubmissionsByTeamRow
+	for rows.Next() {
+		var i CountFailedSubmissionsByTeamRow
+		if err := rows.Scan(&i.ChallengeID, &i.Failed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countFailedSubmissionsByTeamAndChallenge = `-- name: CountFailedSubmissionsByTeamAndChallenge :one
+SELECT COUNT(*) FROM submissions s
+LEFT JOIN challenge_attempt_resets r ON r.challenge_id = s.challenge_id AND r.team_id = s.team_id
+WHERE s.team_id = $1 AND s.challenge_id = $2 AND s.is_correct = FALSE
+  AND (r.reset_at IS NULL OR s.created_at > r.reset_at)
+  AND NOT EXISTS (
+    SELECT 1 FROM submission_reviews sr
+    WHERE sr.submission_id = s.id AND sr.status IN ('pending', 'approved')
+  )
+`
+
+type CountFailedSubmissionsByTeamAndChallengeParams struct {
+	TeamID      *uuid.UUID `json:"team_id"`
+	ChallengeID uuid.UUID  `json:"challenge_id"`
+}
+
+func (q *Queries) CountFailedSubmissionsByTeamAndChallenge(ctx context.Context, arg CountFailedSubmissionsByTeamAndChallengeParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFailedSubmissionsByTeamAndChallenge, arg.TeamID, arg.ChallengeID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countSubmissionsByChallenge = `-- name: CountSubmissionsByChallenge :one
 SELECT COUNT(*) FROM submissions WHERE challenge_id = $1
 `
@@ -249,6 +309,65 @@ func (q *Queries) GetSubmissionsByChallenge(ctx context.Context, arg GetSubmissi
 	return items, nil
 }
 
+const getSubmissionsByIDs = `-- name: GetSubmissionsByIDs :many
+SELECT s.id, s.user_id, s.team_id, s.challenge_id, s.submitted_flag, s.is_correct, s.ip, s.created_at,
+       u.username, COALESCE(t.name, '') AS team_name, c.title AS challenge_title, c.category AS challenge_category
+FROM submissions s
+JOIN users u ON u.id = s.user_id
+LEFT JOIN teams t ON t.id = s.team_id
+JOIN challenges c ON c.id = s.challenge_id
+WHERE s.id = ANY($1::uuid[])
+ORDER BY s.created_at, s.id
+`
+
+type GetSubmissionsByIDsRow struct {
+	ID                uuid.UUID  `json:"id"`
+	UserID            uuid.UUID  `json:"user_id"`
+	TeamID            *uuid.UUID `json:"team_id"`
+	ChallengeID       uuid.UUID  `json:"challenge_id"`
+	SubmittedFlag     string     `json:"submitted_flag"`
+	IsCorrect         bool       `json:"is_correct"`
+	Ip                *string    `json:"ip"`
+	CreatedAt         *time.Time `json:"created_at"`
+	Username          string     `json:"username"`
+	TeamName          string     `json:"team_name"`
+	ChallengeTitle    string     `json:"challenge_title"`
+	ChallengeCategory *string    `json:"challenge_category"`
+}
+
+func (q *Queries) GetSubmissionsByIDs(ctx context.Context, ids []uuid.UUID) ([]GetSubmissionsByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getSubmissionsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSubmissionsByIDsRow
+	for rows.Next() {
+		var i GetSubmissionsByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TeamID,
+			&i.ChallengeID,
+			&i.SubmittedFlag,
+			&i.IsCorrect,
+			&i.Ip,
+			&i.CreatedAt,
+			&i.Username,
+			&i.TeamName,
+			&i.ChallengeTitle,
+			&i.ChallengeCategory,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSubmissionsByTeam = `-- name: GetSubmissionsByTeam :many
 SELECT s.id, s.user_id, s.team_id, s.challenge_id, s.submitted_flag, s.is_correct, s.ip, s.created_at,
        u.username, c.title AS challenge_title, c.category AS challenge_category
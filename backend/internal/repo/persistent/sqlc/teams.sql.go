@@ -70,8 +70,8 @@ func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) error {
 }
 
 const createTeamReturningID = `-- name: CreateTeamReturningID :one
-INSERT INTO teams (name, invite_token, captain_id, is_solo, is_auto_created, created_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO teams (name, invite_token, captain_id, is_solo, is_auto_created, created_at, bracket_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id
 `
 
@@ -82,6 +82,7 @@ type CreateTeamReturningIDParams struct {
 	IsSolo        *bool      `json:"is_solo"`
 	IsAutoCreated *bool      `json:"is_auto_created"`
 	CreatedAt     *time.Time `json:"created_at"`
+	BracketID     *uuid.UUID `json:"bracket_id"`
 }
 
 func (q *Queries) CreateTeamReturningID(ctx context.Context, arg CreateTeamReturningIDParams) (uuid.UUID, error) {
@@ -92,6 +93,7 @@ func (q *Queries) CreateTeamReturningID(ctx context.Context, arg CreateTeamRetur
 		arg.IsSolo,
 		arg.IsAutoCreated,
 		arg.CreatedAt,
+		arg.BracketID,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -12,6 +12,83 @@ import (
 	"github.com/google/uuid"
 )
 
+const anonymizeUser = `-- name: AnonymizeUser :execrows
+UPDATE users SET username = $2, email = $3, password_hash = '', is_verified = false, verified_at = NULL WHERE id = $1
+`
+
+type AnonymizeUserParams struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+}
+
+func (q *Queries) AnonymizeUser(ctx context.Context, arg AnonymizeUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeUser, arg.ID, arg.Username, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const banUser = `-- name: BanUser :execrows
+UPDATE users SET is_banned = true, banned_at = $2, banned_reason = $3 WHERE id = $1
+`
+
+type BanUserParams struct {
+	ID           uuid.UUID  `json:"id"`
+	BannedAt     *time.Time `json:"banned_at"`
+	BannedReason *string    `json:"banned_reason"`
+}
+
+func (q *Queries) BanUser(ctx context.Context, arg BanUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, banUser, arg.ID, arg.BannedAt, arg.BannedReason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countSearchUsers = `-- name: CountSearchUsers :one
+SELECT COUNT(*)
+FROM users u
+WHERE ($1::text IS NULL
+       OR u.username ILIKE '%' || $1 || '%'
+       OR u.email ILIKE '%' || $1 || '%'
+       OR EXISTS (SELECT 1 FROM field_values fv WHERE fv.entity_id = u.id AND fv.value ILIKE '%' || $1 || '%'))
+  AND ($2::uuid IS NULL
+       OR EXISTS (SELECT 1 FROM field_values fv WHERE fv.entity_id = u.id AND fv.field_id = $2
+                  AND fv.value ILIKE '%' || COALESCE($3::text, '') || '%'))
+  AND ($4::boolean IS NULL OR COALESCE(u.is_verified, false) = $4)
+  AND ($5::boolean IS NULL OR COALESCE(u.is_banned, false) = $5)
+  AND ($6::text IS NULL OR u.role = $6)
+  AND ($7::uuid IS NULL OR u.team_id = $7)
+`
+
+type CountSearchUsersParams struct {
+	Search     *string    `json:"search"`
+	FieldID    *uuid.UUID `json:"field_id"`
+	FieldValue *string    `json:"field_value"`
+	IsVerified *bool      `json:"is_verified"`
+	IsBanned   *bool      `json:"is_banned"`
+	Role       *string    `json:"role"`
+	TeamID     *uuid.UUID `json:"team_id"`
+}
+
+func (q *Queries) CountSearchUsers(ctx context.Context, arg CountSearchUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchUsers,
+		arg.Search,
+		arg.FieldID,
+		arg.FieldValue,
+		arg.IsVerified,
+		arg.IsBanned,
+		arg.Role,
+		arg.TeamID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createUser = `-- name: CreateUser :exec
 INSERT INTO users (id, username, email, password_hash, role, is_verified, created_at)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -69,8 +146,20 @@ func (q *Queries) CreateUserReturningID(ctx context.Context, arg CreateUserRetur
 	return id, err
 }
 
+const deleteUser = `-- name: DeleteUser :execrows
+DELETE FROM users WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getAllUsers = `-- name: GetAllUsers :many
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 ORDER BY created_at ASC
 `
@@ -94,6 +183,9 @@ func (q *Queries) GetAllUsers(ctx context.Context) ([]User, error) {
 			&i.IsVerified,
 			&i.VerifiedAt,
 			&i.CreatedAt,
+			&i.IsBanned,
+			&i.BannedAt,
+			&i.BannedReason,
 		); err != nil {
 			return nil, err
 		}
@@ -106,7 +198,7 @@ func (q *Queries) GetAllUsers(ctx context.Context) ([]User, error) {
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 WHERE email = $1
 `
@@ -124,12 +216,15 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.IsVerified,
 		&i.VerifiedAt,
 		&i.CreatedAt,
+		&i.IsBanned,
+		&i.BannedAt,
+		&i.BannedReason,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 WHERE id = $1
 `
@@ -147,12 +242,15 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.IsVerified,
 		&i.VerifiedAt,
 		&i.CreatedAt,
+		&i.IsBanned,
+		&i.BannedAt,
+		&i.BannedReason,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 WHERE username = $1
 `
@@ -170,12 +268,15 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 		&i.IsVerified,
 		&i.VerifiedAt,
 		&i.CreatedAt,
+		&i.IsBanned,
+		&i.BannedAt,
+		&i.BannedReason,
 	)
 	return i, err
 }
 
 const listUsersByTeamID = `-- name: ListUsersByTeamID :many
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 WHERE team_id = $1
 `
@@ -199,6 +300,82 @@ func (q *Queries) ListUsersByTeamID(ctx context.Context, teamID *uuid.UUID) ([]U
 			&i.IsVerified,
 			&i.VerifiedAt,
 			&i.CreatedAt,
+			&i.IsBanned,
+			&i.BannedAt,
+			&i.BannedReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT u.id, u.team_id, u.username, u.email, u.password_hash, u.role, u.is_verified, u.verified_at, u.created_at, u.is_banned, u.banned_at, u.banned_reason
+FROM users u
+WHERE ($1::text IS NULL
+       OR u.username ILIKE '%' || $1 || '%'
+       OR u.email ILIKE '%' || $1 || '%'
+       OR EXISTS (SELECT 1 FROM field_values fv WHERE fv.entity_id = u.id AND fv.value ILIKE '%' || $1 || '%'))
+  AND ($2::uuid IS NULL
+       OR EXISTS (SELECT 1 FROM field_values fv WHERE fv.entity_id = u.id AND fv.field_id = $2
+                  AND fv.value ILIKE '%' || COALESCE($3::text, '') || '%'))
+  AND ($4::boolean IS NULL OR COALESCE(u.is_verified, false) = $4)
+  AND ($5::boolean IS NULL OR COALESCE(u.is_banned, false) = $5)
+  AND ($6::text IS NULL OR u.role = $6)
+  AND ($7::uuid IS NULL OR u.team_id = $7)
+ORDER BY u.created_at DESC, u.id
+LIMIT $8 OFFSET $9
+`
+
+type SearchUsersParams struct {
+	Search     *string    `json:"search"`
+	FieldID    *uuid.UUID `json:"field_id"`
+	FieldValue *string    `json:"field_value"`
+	IsVerified *bool      `json:"is_verified"`
+	IsBanned   *bool      `json:"is_banned"`
+	Role       *string    `json:"role"`
+	TeamID     *uuid.UUID `json:"team_id"`
+	Limit      int32      `json:"limit"`
+	Offset     int32      `json:"offset"`
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsers,
+		arg.Search,
+		arg.FieldID,
+		arg.FieldValue,
+		arg.IsVerified,
+		arg.IsBanned,
+		arg.Role,
+		arg.TeamID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Username,
+			&i.Email,
+			&i.PasswordHash,
+			&i.Role,
+			&i.IsVerified,
+			&i.VerifiedAt,
+			&i.CreatedAt,
+			&i.IsBanned,
+			&i.BannedAt,
+			&i.BannedReason,
 		); err != nil {
 			return nil, err
 		}
@@ -210,6 +387,18 @@ func (q *Queries) ListUsersByTeamID(ctx context.Context, teamID *uuid.UUID) ([]U
 	return items, nil
 }
 
+const unbanUser = `-- name: UnbanUser :execrows
+UPDATE users SET is_banned = false, banned_at = NULL, banned_reason = NULL WHERE id = $1
+`
+
+func (q *Queries) UnbanUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unbanUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updatePassword = `-- name: UpdatePassword :exec
 UPDATE users SET password_hash = $2 WHERE id = $1
 `
@@ -224,6 +413,64 @@ func (q *Queries) UpdatePassword(ctx context.Context, arg UpdatePasswordParams)
 	return err
 }
 
+const updateUserEmail = `-- name: UpdateUserEmail :execrows
+UPDATE users SET email = $2, is_verified = true, verified_at = $3 WHERE id = $1
+`
+
+type UpdateUserEmailParams struct {
+	ID         uuid.UUID  `json:"id"`
+	Email      string     `json:"email"`
+	VerifiedAt *time.Time `json:"verified_at"`
+}
+
+func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserEmail, arg.ID, arg.Email, arg.VerifiedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateUserProfile = `-- name: UpdateUserProfile :execrows
+UPDATE users SET username = $2, email = $3, is_verified = $4, verified_at = $5 WHERE id = $1
+`
+
+type UpdateUserProfileParams struct {
+	ID         uuid.UUID  `json:"id"`
+	Username   string     `json:"username"`
+	Email      string     `json:"email"`
+	IsVerified *bool      `json:"is_verified"`
+	VerifiedAt *time.Time `json:"verified_at"`
+}
+
+func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserProfile,
+		arg.ID,
+		arg.Username,
+		arg.Email,
+		arg.IsVerified,
+		arg.VerifiedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateUserRole = `-- name: UpdateUserRole :exec
+UPDATE users SET role = $2 WHERE id = $1
+`
+
+type UpdateUserRoleParams struct {
+	ID   uuid.UUID `json:"id"`
+	Role *string   `json:"role"`
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error {
+	_, err := q.db.Exec(ctx, updateUserRole, arg.ID, arg.Role)
+	return err
+}
+
 const updateUserTeamID = `-- name: UpdateUserTeamID :one
 UPDATE users SET team_id = $2 WHERE id = $1 RETURNING id
 `
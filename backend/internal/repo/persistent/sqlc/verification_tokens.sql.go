@@ -13,8 +13,8 @@ import (
 )
 
 const createVerificationToken = `-- name: CreateVerificationToken :exec
-INSERT INTO verification_tokens (id, user_id, token, type, expires_at)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO verification_tokens (id, user_id, token, type, expires_at, new_email)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type CreateVerificationTokenParams struct {
@@ -23,6 +23,7 @@ type CreateVerificationTokenParams struct {
 	Token     string    `json:"token"`
 	Type      string    `json:"type"`
 	ExpiresAt time.Time `json:"expires_at"`
+	NewEmail  *string   `json:"new_email"`
 }
 
 func (q *Queries) CreateVerificationToken(ctx context.Context, arg CreateVerificationTokenParams) error {
@@ -32,6 +33,7 @@ func (q *Queries) CreateVerificationToken(ctx context.Context, arg CreateVerific
 		arg.Token,
 		arg.Type,
 		arg.ExpiresAt,
+		arg.NewEmail,
 	)
 	return err
 }
@@ -60,7 +62,7 @@ func (q *Queries) DeleteVerificationTokensByUserAndType(ctx context.Context, arg
 }
 
 const getVerificationTokenByToken = `-- name: GetVerificationTokenByToken :one
-SELECT id, user_id, token, type, expires_at, used_at, created_at
+SELECT id, user_id, token, type, expires_at, used_at, created_at, new_email
 FROM verification_tokens
 WHERE token = $1
 `
@@ -76,6 +78,7 @@ func (q *Queries) GetVerificationTokenByToken(ctx context.Context, token string)
 		&i.ExpiresAt,
 		&i.UsedAt,
 		&i.CreatedAt,
+		&i.NewEmail,
 	)
 	return i, err
 }
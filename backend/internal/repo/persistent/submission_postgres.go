@@ -202,6 +202,34 @@ func (r *SubmissionRepo) GetAll(ctx context.Context, limit, offset int) ([]*enti
 	return result, nil
 }
 
+func (r *SubmissionRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.SubmissionWithDetails, error) {
+	rows, err := r.q.GetSubmissionsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("SubmissionRepo - GetByIDs: %w", err)
+	}
+
+	result := make([]*entity.SubmissionWithDetails, len(rows))
+	for i, row := range rows {
+		result[i] = &entity.SubmissionWithDetails{
+			Submission: entity.Submission{
+				ID:            row.ID,
+				UserID:        row.UserID,
+				TeamID:        row.TeamID,
+				ChallengeID:   row.ChallengeID,
+				SubmittedFlag: row.SubmittedFlag,
+				IsCorrect:     row.IsCorrect,
+				IP:            ptrStrToStr(row.Ip),
+				CreatedAt:     ptrTimeToTime(row.CreatedAt),
+			},
+			Username:          row.Username,
+			TeamName:          row.TeamName,
+			ChallengeTitle:    row.ChallengeTitle,
+			ChallengeCategory: ptrStrToStr(row.ChallengeCategory),
+		}
+	}
+	return result, nil
+}
+
 func (r *SubmissionRepo) CountByChallenge(ctx context.Context, challengeID uuid.UUID) (int64, error) {
 	return r.q.CountSubmissionsByChallenge(ctx, challengeID)
 }
@@ -225,6 +253,25 @@ func (r *SubmissionRepo) CountFailedByIP(ctx context.Context, ip string, since t
 	})
 }
 
+func (r *SubmissionRepo) CountFailedByTeamAndChallenge(ctx context.Context, teamID, challengeID uuid.UUID) (int64, error) {
+	return r.q.CountFailedSubmissionsByTeamAndChallenge(ctx, sqlc.CountFailedSubmissionsByTeamAndChallengeParams{
+		TeamID:      &teamID,
+		ChallengeID: challengeID,
+	})
+}
+
+func (r *SubmissionRepo) CountFailedByTeam(ctx context.Context, teamID uuid.UUID) (map[uuid.UUID]int, error) {
+	rows, err := r.q.CountFailedSubmissionsByTeam(ctx, &teamID)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		out[row.ChallengeID] = int(row.Failed)
+	}
+	return out, nil
+}
+
 func (r *SubmissionRepo) GetStats(ctx context.Context, challengeID uuid.UUID) (*entity.SubmissionStats, error) {
 	row, err := r.q.GetSubmissionStats(ctx, challengeID)
 	if err != nil {
@@ -0,0 +1,56 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type TeamFlagRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewTeamFlagRepo(db *pgxpool.Pool) *TeamFlagRepo {
+	return &TeamFlagRepo{db: db, q: sqlc.New(db)}
+}
+
+func (r *TeamFlagRepo) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) (*entity.TeamFlagConfig, error) {
+	row, err := r.q.GetChallengeTeamFlag(ctx, challengeID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrTeamFlagNotFound
+		}
+		return nil, fmt.Errorf("TeamFlagRepo - GetByChallengeID: %w", err)
+	}
+	return &entity.TeamFlagConfig{
+		ChallengeID: row.ChallengeID,
+		Template:    row.Template,
+		Secret:      row.Secret,
+		CreatedAt:   ptrTimeToTime(row.CreatedAt),
+	}, nil
+}
+
+func (r *TeamFlagRepo) Upsert(ctx context.Context, cfg *entity.TeamFlagConfig) error {
+	err := r.q.UpsertChallengeTeamFlag(ctx, sqlc.UpsertChallengeTeamFlagParams{
+		ChallengeID: cfg.ChallengeID,
+		Template:    cfg.Template,
+		Secret:      cfg.Secret,
+	})
+	if err != nil {
+		return fmt.Errorf("TeamFlagRepo - Upsert: %w", err)
+	}
+	return nil
+}
+
+func (r *TeamFlagRepo) Delete(ctx context.Context, challengeID uuid.UUID) error {
+	if err := r.q.DeleteChallengeTeamFlag(ctx, challengeID); err != nil {
+		return fmt.Errorf("TeamFlagRepo - Delete: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type TwoFactorRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewTwoFactorRepo(db *pgxpool.Pool) *TwoFactorRepo {
+	return &TwoFactorRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityTwoFactor(t sqlc.UserTwoFactor) *entity.TwoFactor {
+	return &entity.TwoFactor{
+		UserID:          t.UserID,
+		SecretEncrypted: t.SecretEncrypted,
+		EnabledAt:       t.EnabledAt,
+		LastUsedStep:    t.LastUsedStep,
+		CreatedAt:       ptrTimeToTime(t.CreatedAt),
+	}
+}
+
+// Upsert stores a fresh pending secret, discarding any previous enrollment for the user.
+func (r *TwoFactorRepo) Upsert(ctx context.Context, tf *entity.TwoFactor) error {
+	tf.CreatedAt = time.Now()
+	tf.EnabledAt = nil
+	tf.LastUsedStep = 0
+	err := r.q.UpsertUserTwoFactor(ctx, sqlc.UpsertUserTwoFactorParams{
+		UserID:          tf.UserID,
+		SecretEncrypted: tf.SecretEncrypted,
+		CreatedAt:       &tf.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("TwoFactorRepo - Upsert: %w", err)
+	}
+	return nil
+}
+
+func (r *TwoFactorRepo) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.TwoFactor, error) {
+	t, err := r.q.GetUserTwoFactorByUserID(ctx, userID)
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrTwoFactorNotEnrolled
+		}
+		return nil, fmt.Errorf("TwoFactorRepo - GetByUserID: %w", err)
+	}
+	return toEntityTwoFactor(t), nil
+}
+
+// Enable activates the enrollment and stores its recovery codes in one transaction.
+func (r *TwoFactorRepo) Enable(ctx context.Context, userID uuid.UUID, step int64, codeHashes []string) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+		now := time.Now()
+		if err := q.EnableUserTwoFactor(ctx, sqlc.EnableUserTwoFactorParams{
+			UserID:       userID,
+			EnabledAt:    &now,
+			LastUsedStep: step,
+		}); err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(ctx, q, userID, codeHashes)
+	})
+	if err != nil {
+		return fmt.Errorf("TwoFactorRepo - Enable: %w", err)
+	}
+	return nil
+}
+
+// ConsumeStep advances the last accepted TOTP step and reports false when the step was already used.
+func (r *TwoFactorRepo) ConsumeStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	n, err := r.q.ConsumeUserTwoFactorStep(ctx, sqlc.ConsumeUserTwoFactorStepParams{
+		UserID:       userID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return false, fmt.Errorf("TwoFactorRepo - ConsumeStep: %w", err)
+	}
+	return n == 1, nil
+}
+
+func (r *TwoFactorRepo) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		return replaceRecoveryCodes(ctx, r.q.WithTx(tx), userID, codeHashes)
+	})
+	if err != nil {
+		return fmt.Errorf("TwoFactorRepo - ReplaceRecoveryCodes: %w", err)
+	}
+	return nil
+}
+
+// UseRecoveryCode marks an unused code as spent and reports whether one matched.
+func (r *TwoFactorRepo) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	now := time.Now()
+	n, err := r.q.UseUserRecoveryCode(ctx, sqlc.UseUserRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+		UsedAt:   &now,
+	})
+	if err != nil {
+		return false, fmt.Errorf("TwoFactorRepo - UseRecoveryCode: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *TwoFactorRepo) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	n, err := r.q.CountUnusedUserRecoveryCodes(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("TwoFactorRepo - CountRecoveryCodes: %w", err)
+	}
+	return int(n), nil
+}
+
+func (r *TwoFactorRepo) Delete(ctx context.Context, userID uuid.UUID) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		q := r.q.WithTx(tx)
+		if err := q.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+			return err
+		}
+		return q.DeleteUserTwoFactor(ctx, userID)
+	})
+	if err != nil {
+		return fmt.Errorf("TwoFactorRepo - Delete: %w", err)
+	}
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, codeHashes []string) error {
+	if err := q.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, h := range codeHashes {
+		if err := q.CreateUserRecoveryCode(ctx, sqlc.CreateUserRecoveryCodeParams{
+			ID:        uuid.New(),
+			UserID:    userID,
+			CodeHash:  h,
+			CreatedAt: &now,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
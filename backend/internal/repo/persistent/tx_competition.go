@@ -37,6 +37,18 @@ func (r *TxChallengeRepo) IncrementChallengeSolveCountTx(ctx context.Context, tx
 	return int(n), nil
 }
 
+func (r *TxChallengeRepo) DecrementChallengeSolveCountTx(ctx context.Context, tx repo.Transaction, id uuid.UUID) (int, error) {
+	pgxTx := mustPgxTx(tx)
+	n, err := r.base.q.WithTx(pgxTx).DecrementChallengeSolveCount(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			return 0, entityError.ErrChallengeNotFound
+		}
+		return 0, fmt.Errorf("TxChallengeRepo - DecrementChallengeSolveCountTx: %w", err)
+	}
+	return int(n), nil
+}
+
 func (r *TxChallengeRepo) UpdateChallengePointsTx(ctx context.Context, tx repo.Transaction, id uuid.UUID, points int) error {
 	pgxTx := mustPgxTx(tx)
 	pts, err := intToInt32Safe(points)
@@ -71,14 +83,21 @@ type TxSolveRepo struct {
 
 func (r *TxSolveRepo) CreateSolveTx(ctx context.Context, tx repo.Transaction, s *entity.Solve) error {
 	pgxTx := mustPgxTx(tx)
+	points, err := toSolvePoints(s)
+	if err != nil {
+		return fmt.Errorf("TxSolveRepo - CreateSolveTx points: %w", err)
+	}
 	s.ID = uuid.New()
-	s.SolvedAt = time.Now()
-	err := r.base.q.WithTx(pgxTx).CreateSolve(ctx, sqlc.CreateSolveParams{
+	if s.SolvedAt.IsZero() {
+		s.SolvedAt = time.Now()
+	}
+	err = r.base.q.WithTx(pgxTx).CreateSolve(ctx, sqlc.CreateSolveParams{
 		ID:          s.ID,
 		UserID:      s.UserID,
 		TeamID:      s.TeamID,
 		ChallengeID: s.ChallengeID,
 		SolvedAt:    &s.SolvedAt,
+		Points:      points,
 	})
 	if err != nil {
 		if isPgUniqueViolation(err) {
@@ -89,6 +108,14 @@ func (r *TxSolveRepo) CreateSolveTx(ctx context.Context, tx repo.Transaction, s
 	return nil
 }
 
+func (r *TxSolveRepo) DeleteSolveTx(ctx context.Context, tx repo.Transaction, solveID uuid.UUID) error {
+	pgxTx := mustPgxTx(tx)
+	if err := r.base.q.WithTx(pgxTx).DeleteSolve(ctx, solveID); err != nil {
+		return fmt.Errorf("TxSolveRepo - DeleteSolveTx: %w", err)
+	}
+	return nil
+}
+
 func (r *TxSolveRepo) DeleteSolvesByTeamIDTx(ctx context.Context, tx repo.Transaction, teamID uuid.UUID) error {
 	pgxTx := mustPgxTx(tx)
 	if err := r.base.q.WithTx(pgxTx).DeleteSolvesByTeamID(ctx, teamID); err != nil {
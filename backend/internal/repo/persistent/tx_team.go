@@ -28,6 +28,7 @@ func (r *TxTeamRepo) CreateTeamTx(ctx context.Context, tx repo.Transaction, team
 		IsSolo:        &team.IsSolo,
 		IsAutoCreated: &team.IsAutoCreated,
 		CreatedAt:     &team.CreatedAt,
+		BracketID:     team.BracketID,
 	})
 	if err != nil {
 		return fmt.Errorf("TxTeamRepo - CreateTeamTx: %w", err)
@@ -36,6 +36,35 @@ func (r *TxUserRepo) CreateUserTx(ctx context.Context, tx repo.Transaction, user
 	return nil
 }
 
+func (r *TxUserRepo) CreateUserIdentityTx(ctx context.Context, tx repo.Transaction, identity *entity.UserIdentity) error {
+	pgxTx := mustPgxTx(tx)
+	if err := r.base.q.WithTx(pgxTx).CreateUserIdentity(ctx, createUserIdentityParams(identity)); err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrIdentityAlreadyLinked
+		}
+		return fmt.Errorf("TxUserRepo - CreateUserIdentityTx: %w", err)
+	}
+	return nil
+}
+
+// ConsumeRegistrationInviteTx counts one use of a usable invite; unknown, expired and used-up codes
+// return ErrInvalidInviteCode.
+func (r *TxUserRepo) ConsumeRegistrationInviteTx(ctx context.Context, tx repo.Transaction, code string) (*entity.RegistrationInvite, error) {
+	pgxTx := mustPgxTx(tx)
+	now := time.Now()
+	row, err := r.base.q.WithTx(pgxTx).ConsumeRegistrationInvite(ctx, sqlc.ConsumeRegistrationInviteParams{
+		Code:      code,
+		ExpiresAt: &now,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrInvalidInviteCode
+		}
+		return nil, fmt.Errorf("TxUserRepo - ConsumeRegistrationInviteTx: %w", err)
+	}
+	return toEntityRegistrationInvite(row), nil
+}
+
 func (r *TxUserRepo) UpdateUserTeamIDTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, teamID *uuid.UUID) error {
 	pgxTx := mustPgxTx(tx)
 	_, err := r.base.q.WithTx(pgxTx).UpdateUserTeamID(ctx, sqlc.UpdateUserTeamIDParams{
@@ -72,3 +101,166 @@ func (r *TxUserRepo) LockUserTx(ctx context.Context, tx repo.Transaction, userID
 	}
 	return nil
 }
+
+func (r *TxUserRepo) UpdateUserTx(ctx context.Context, tx repo.Transaction, user *entity.User) error {
+	pgxTx := mustPgxTx(tx)
+	n, err := r.base.q.WithTx(pgxTx).UpdateUserProfile(ctx, sqlc.UpdateUserProfileParams{
+		ID:         user.ID,
+		Username:   user.Username,
+		Email:      user.Email,
+		IsVerified: &user.IsVerified,
+		VerifiedAt: user.VerifiedAt,
+	})
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrUserAlreadyExists
+		}
+		return fmt.Errorf("TxUserRepo - UpdateUserTx: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *TxUserRepo) UpdateUserRoleTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, role string) error {
+	pgxTx := mustPgxTx(tx)
+	if err := r.base.q.WithTx(pgxTx).UpdateUserRole(ctx, sqlc.UpdateUserRoleParams{
+		ID:   userID,
+		Role: &role,
+	}); err != nil {
+		return fmt.Errorf("TxUserRepo - UpdateUserRoleTx: %w", err)
+	}
+	return nil
+}
+
+func (r *TxUserRepo) CreateRoleTx(ctx context.Context, tx repo.Transaction, role *entity.Role) error {
+	pgxTx := mustPgxTx(tx)
+	role.CreatedAt = time.Now()
+	if err := r.base.q.WithTx(pgxTx).CreateRole(ctx, sqlc.CreateRoleParams{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		CreatedAt:   &role.CreatedAt,
+	}); err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrRoleAlreadyExists
+		}
+		return fmt.Errorf("TxUserRepo - CreateRoleTx: %w", err)
+	}
+	return nil
+}
+
+func (r *TxUserRepo) UpdateRoleTx(ctx context.Context, tx repo.Transaction, role *entity.Role) error {
+	pgxTx := mustPgxTx(tx)
+	n, err := r.base.q.WithTx(pgxTx).UpdateRole(ctx, sqlc.UpdateRoleParams{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	})
+	if err != nil {
+		return fmt.Errorf("TxUserRepo - UpdateRoleTx: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrRoleNotFound
+	}
+	return nil
+}
+
+// DeleteRoleTx removes a custom role and moves its users back to the regular user role.
+func (r *TxUserRepo) DeleteRoleTx(ctx context.Context, tx repo.Transaction, name string) error {
+	pgxTx := mustPgxTx(tx)
+	n, err := r.base.q.WithTx(pgxTx).DeleteRole(ctx, name)
+	if err != nil {
+		return fmt.Errorf("TxUserRepo - DeleteRoleTx: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *TxUserRepo) UpdateUserPasswordTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, passwordHash string) error {
+	pgxTx := mustPgxTx(tx)
+	if err := r.base.q.WithTx(pgxTx).UpdatePassword(ctx, sqlc.UpdatePasswordParams{
+		ID:           userID,
+		PasswordHash: passwordHash,
+	}); err != nil {
+		return fmt.Errorf("TxUserRepo - UpdateUserPasswordTx: %w", err)
+	}
+	return nil
+}
+
+func (r *TxUserRepo) BanUserTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, reason string) error {
+	pgxTx := mustPgxTx(tx)
+	now := time.Now()
+	n, err := r.base.q.WithTx(pgxTx).BanUser(ctx, sqlc.BanUserParams{
+		ID:           userID,
+		BannedAt:     &now,
+		BannedReason: strPtrOrNil(reason),
+	})
+	if err != nil {
+		return fmt.Errorf("TxUserRepo - BanUserTx: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *TxUserRepo) UnbanUserTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID) error {
+	pgxTx := mustPgxTx(tx)
+	n, err := r.base.q.WithTx(pgxTx).UnbanUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("TxUserRepo - UnbanUserTx: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *TxUserRepo) DeleteUserTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID) error {
+	pgxTx := mustPgxTx(tx)
+	n, err := r.base.q.WithTx(pgxTx).DeleteUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("TxUserRepo - DeleteUserTx: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrUserNotFound
+	}
+	return nil
+}
+
+// AnonymizeUserTx replaces the identifying fields of the user and removes its login methods,
+// API tokens and custom field values. The row itself is kept so solves stay attributed.
+func (r *TxUserRepo) AnonymizeUserTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, username, email string) error {
+	q := r.base.q.WithTx(mustPgxTx(tx))
+	n, err := q.AnonymizeUser(ctx, sqlc.AnonymizeUserParams{
+		ID:       userID,
+		Username: username,
+		Email:    email,
+	})
+	if err != nil {
+		return fmt.Errorf("TxUserRepo - AnonymizeUserTx: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrUserNotFound
+	}
+	if err := q.DeleteUserIdentitiesByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("TxUserRepo - AnonymizeUserTx - DeleteUserIdentitiesByUserID: %w", err)
+	}
+	if err := q.DeleteAPITokensByUserID(ctx, &userID); err != nil {
+		return fmt.Errorf("TxUserRepo - AnonymizeUserTx - DeleteAPITokensByUserID: %w", err)
+	}
+	if err := q.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return fmt.Errorf("TxUserRepo - AnonymizeUserTx - DeleteUserRecoveryCodes: %w", err)
+	}
+	if err := q.DeleteUserTwoFactor(ctx, userID); err != nil {
+		return fmt.Errorf("TxUserRepo - AnonymizeUserTx - DeleteUserTwoFactor: %w", err)
+	}
+	if err := q.DeleteFieldValuesByEntityID(ctx, userID); err != nil {
+		return fmt.Errorf("TxUserRepo - AnonymizeUserTx - DeleteFieldValuesByEntityID: %w", err)
+	}
+	return nil
+}
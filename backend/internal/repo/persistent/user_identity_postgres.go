@@ -0,0 +1,110 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo/persistent/sqlc"
+)
+
+type UserIdentityRepo struct {
+	db *pgxpool.Pool
+	q  *sqlc.Queries
+}
+
+func NewUserIdentityRepo(db *pgxpool.Pool) *UserIdentityRepo {
+	return &UserIdentityRepo{db: db, q: sqlc.New(db)}
+}
+
+func toEntityUserIdentity(i sqlc.UserIdentity) *entity.UserIdentity {
+	return &entity.UserIdentity{
+		ID:          i.ID,
+		UserID:      i.UserID,
+		Provider:    i.Provider,
+		Subject:     i.Subject,
+		Email:       ptrStrToStr(i.Email),
+		CreatedAt:   ptrTimeToTime(i.CreatedAt),
+		LastLoginAt: i.LastLoginAt,
+	}
+}
+
+func createUserIdentityParams(identity *entity.UserIdentity) sqlc.CreateUserIdentityParams {
+	if identity.ID == uuid.Nil {
+		identity.ID = uuid.New()
+	}
+	identity.CreatedAt = time.Now()
+	return sqlc.CreateUserIdentityParams{
+		ID:          identity.ID,
+		UserID:      identity.UserID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       strPtrOrNil(identity.Email),
+		CreatedAt:   &identity.CreatedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
+
+func (r *UserIdentityRepo) Create(ctx context.Context, identity *entity.UserIdentity) error {
+	if err := r.q.CreateUserIdentity(ctx, createUserIdentityParams(identity)); err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrIdentityAlreadyLinked
+		}
+		return fmt.Errorf("UserIdentityRepo - Create: %w", err)
+	}
+	return nil
+}
+
+func (r *UserIdentityRepo) GetByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error) {
+	i, err := r.q.GetUserIdentityByProviderSubject(ctx, sqlc.GetUserIdentityByProviderSubjectParams{
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			return nil, entityError.ErrIdentityNotFound
+		}
+		return nil, fmt.Errorf("UserIdentityRepo - GetByProviderSubject: %w", err)
+	}
+	return toEntityUserIdentity(i), nil
+}
+
+func (r *UserIdentityRepo) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserIdentity, error) {
+	rows, err := r.q.GetUserIdentitiesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("UserIdentityRepo - GetByUserID: %w", err)
+	}
+	out := make([]*entity.UserIdentity, 0, len(rows))
+	for _, i := range rows {
+		out = append(out, toEntityUserIdentity(i))
+	}
+	return out, nil
+}
+
+func (r *UserIdentityRepo) TouchLogin(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if err := r.q.TouchUserIdentityLogin(ctx, sqlc.TouchUserIdentityLoginParams{
+		ID:          id,
+		LastLoginAt: &at,
+	}); err != nil {
+		return fmt.Errorf("UserIdentityRepo - TouchLogin: %w", err)
+	}
+	return nil
+}
+
+func (r *UserIdentityRepo) Delete(ctx context.Context, userID uuid.UUID, provider string) error {
+	n, err := r.q.DeleteUserIdentity(ctx, sqlc.DeleteUserIdentityParams{
+		UserID:   userID,
+		Provider: provider,
+	})
+	if err != nil {
+		return fmt.Errorf("UserIdentityRepo - Delete: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrIdentityNotFound
+	}
+	return nil
+}
@@ -31,6 +31,9 @@ func toEntityUser(u sqlc.User) *entity.User {
 		IsVerified:   boolPtrToBool(u.IsVerified),
 		TeamID:       u.TeamID,
 		VerifiedAt:   u.VerifiedAt,
+		IsBanned:     boolPtrToBool(u.IsBanned),
+		BannedAt:     u.BannedAt,
+		BannedReason: u.BannedReason,
 		CreatedAt:    ptrTimeToTime(u.CreatedAt),
 	}
 }
@@ -115,6 +118,63 @@ func (r *UserRepo) GetAll(ctx context.Context) ([]*entity.User, error) {
 	return out, nil
 }
 
+func (r *UserRepo) List(ctx context.Context, filter *entity.UserFilter, limit, offset int) ([]*entity.User, error) {
+	limit32, err := intToInt32Safe(limit)
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - List limit: %w", err)
+	}
+	offset32, err := intToInt32Safe(offset)
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - List offset: %w", err)
+	}
+	p := toCountSearchUsersParams(filter)
+	rows, err := r.q.SearchUsers(ctx, sqlc.SearchUsersParams{
+		Search:     p.Search,
+		FieldID:    p.FieldID,
+		FieldValue: p.FieldValue,
+		IsVerified: p.IsVerified,
+		IsBanned:   p.IsBanned,
+		Role:       p.Role,
+		TeamID:     p.TeamID,
+		Limit:      limit32,
+		Offset:     offset32,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - List: %w", err)
+	}
+	out := make([]*entity.User, 0, len(rows))
+	for _, u := range rows {
+		out = append(out, toEntityUser(u))
+	}
+	return out, nil
+}
+
+func (r *UserRepo) Count(ctx context.Context, filter *entity.UserFilter) (int64, error) {
+	n, err := r.q.CountSearchUsers(ctx, toCountSearchUsersParams(filter))
+	if err != nil {
+		return 0, fmt.Errorf("UserRepo - Count: %w", err)
+	}
+	return n, nil
+}
+
+func toCountSearchUsersParams(filter *entity.UserFilter) sqlc.CountSearchUsersParams {
+	if filter == nil {
+		return sqlc.CountSearchUsersParams{}
+	}
+	p := sqlc.CountSearchUsersParams{
+		Search:     strPtrOrNil(filter.Search),
+		FieldID:    filter.FieldID,
+		IsVerified: filter.Verified,
+		IsBanned:   filter.Banned,
+		Role:       strPtrOrNil(filter.Role),
+		TeamID:     filter.TeamID,
+	}
+	if filter.FieldID != nil {
+		p.FieldValue = strPtrOrNil(filter.FieldValue)
+	}
+	return p
+}
+
 func (r *UserRepo) UpdateTeamID(ctx context.Context, userID uuid.UUID, teamID *uuid.UUID) error {
 	_, err := r.q.UpdateUserTeamID(ctx, sqlc.UpdateUserTeamIDParams{
 		ID:     userID,
@@ -151,3 +211,33 @@ func (r *UserRepo) UpdatePassword(ctx context.Context, userID uuid.UUID, passwor
 	}
 	return nil
 }
+
+// UpdateEmail sets a confirmed email address, which also marks the account as verified.
+func (r *UserRepo) UpdateEmail(ctx context.Context, userID uuid.UUID, email string) error {
+	now := time.Now()
+	n, err := r.q.UpdateUserEmail(ctx, sqlc.UpdateUserEmailParams{
+		ID:         userID,
+		Email:      email,
+		VerifiedAt: &now,
+	})
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			return entityError.ErrUserAlreadyExists
+		}
+		return fmt.Errorf("UserRepo - UpdateEmail: %w", err)
+	}
+	if n == 0 {
+		return entityError.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepo) UpdateRole(ctx context.Context, userID uuid.UUID, role string) error {
+	if err := r.q.UpdateUserRole(ctx, sqlc.UpdateUserRoleParams{
+		ID:   userID,
+		Role: &role,
+	}); err != nil {
+		return fmt.Errorf("UserRepo - UpdateRole: %w", err)
+	}
+	return nil
+}
@@ -30,6 +30,7 @@ func toEntityVerificationToken(t sqlc.VerificationToken) *entity.VerificationTok
 		ExpiresAt: t.ExpiresAt,
 		UsedAt:    t.UsedAt,
 		CreatedAt: ptrTimeToTime(t.CreatedAt),
+		NewEmail:  t.NewEmail,
 	}
 }
 
@@ -43,6 +44,7 @@ func (r *VerificationTokenRepo) Create(ctx context.Context, token *entity.Verifi
 		Token:     token.Token,
 		Type:      string(token.Type),
 		ExpiresAt: token.ExpiresAt,
+		NewEmail:  token.NewEmail,
 	})
 	if err != nil {
 		return fmt.Errorf("VerificationTokenRepo - Create: %w", err)
@@ -0,0 +1,210 @@
+// Package scoring holds the functions that decide what a challenge is worth as teams solve it
+// or as the event goes on. Every function is registered under a name and declares the
+// parameters it takes, so a challenge can store the name and its parameters and be scored the
+// same way on every solve.
+package scoring
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	Static      = "static"
+	Quadratic   = "quadratic"
+	Logarithmic = "logarithmic"
+	Linear      = "linear"
+	Exponential = "exponential"
+	Time        = "time"
+)
+
+// MaxPoints bounds every point parameter.
+const MaxPoints = 1_000_000
+
+var ErrUnknownFunction = errors.New("unknown scoring function")
+
+// Params are the values a function is configured with, keyed by parameter name.
+type Params map[string]float64
+
+// Input is what a function can look at when it scores a challenge.
+type Input struct {
+	// Solves counts the teams that solved the challenge, the solve being scored included.
+	Solves int
+	// Elapsed is the share of the event that has passed, from 0 at the start to 1 at the end.
+	Elapsed float64
+}
+
+type Param struct {
+	Name        string
+	Description string
+	Min         float64
+	Max         float64
+	Integer     bool
+}
+
+type Function struct {
+	Name        string
+	Description string
+	Params      []Param
+	// TimeBased functions change value while nobody solves, so they must be re-evaluated
+	// periodically rather than only on solves. Unlike the others they value the solve, not
+	// the challenge: each solve keeps what the challenge was worth when it was made.
+	TimeBased bool
+	value     func(p Params, in Input) float64
+}
+
+var (
+	initialParam = Param{Name: "initial", Description: "Value before anyone solves the challenge", Min: 1, Max: MaxPoints, Integer: true}
+	minimumParam = Param{Name: "minimum", Description: "Value the challenge never drops below", Min: 0, Max: MaxPoints, Integer: true}
+	decayParam   = Param{Name: "decay", Description: "Solves after which the value is the minimum", Min: 1, Max: MaxPoints, Integer: true}
+)
+
+var registry = map[string]*Function{
+	Static: {
+		Name:        Static,
+		Description: "Fixed value",
+		Params:      []Param{initialParam},
+		value: func(p Params, _ Input) float64 {
+			return p["initial"]
+		},
+	},
+	// Quadratic is the CTFd dynamic decay: the value falls along a parabola from initial to
+	// minimum over decay solves. Challenges without a scoring function decay this way.
+	Quadratic: {
+		Name:        Quadratic,
+		Description: "CTFd dynamic decay: falls slowly at first and reaches the minimum after decay solves",
+		Params:      []Param{initialParam, minimumParam, decayParam},
+		value: func(p Params, in Input) float64 {
+			decay := math.Max(p["decay"], 1)
+			if float64(in.Solves) > decay {
+				return p["minimum"]
+			}
+			solved := float64(solvedBefore(in))
+			return p["initial"] + (p["minimum"]-p["initial"])/(decay*decay)*solved*solved
+		},
+	},
+	// Logarithmic loses most of its value to the first solves and flattens out, reaching the
+	// minimum after decay solves.
+	Logarithmic: {
+		Name:        Logarithmic,
+		Description: "Falls fast at first and reaches the minimum after decay solves",
+		Params:      []Param{initialParam, minimumParam, decayParam},
+		value: func(p Params, in Input) float64 {
+			decay := math.Max(p["decay"], 1)
+			if float64(in.Solves) > decay {
+				return p["minimum"]
+			}
+			share := math.Log1p(float64(solvedBefore(in))) / math.Log1p(decay)
+			return p["initial"] - (p["initial"]-p["minimum"])*share
+		},
+	},
+	Linear: {
+		Name:        Linear,
+		Description: "Loses a fixed number of points per solve",
+		Params:      []Param{initialParam, minimumParam, {Name: "decay", Description: "Points lost per solve", Min: 0, Max: MaxPoints}},
+		value: func(p Params, in Input) float64 {
+			return p["initial"] - p["decay"]*float64(solvedBefore(in))
+		},
+	},
+	Exponential: {
+		Name:        Exponential,
+		Description: "Halves the distance to the minimum every half_life solves",
+		Params:      []Param{initialParam, minimumParam, {Name: "half_life", Description: "Solves it takes to halve the value above the minimum", Min: 1, Max: MaxPoints}},
+		value: func(p Params, in Input) float64 {
+			return p["minimum"] + (p["initial"]-p["minimum"])*math.Pow(0.5, float64(solvedBefore(in))/p["half_life"])
+		},
+	},
+	Time: {
+		Name:        Time,
+		Description: "Falls linearly from initial at the start of the event to minimum at its end",
+		Params:      []Param{initialParam, minimumParam},
+		TimeBased:   true,
+		value: func(p Params, in Input) float64 {
+			elapsed := math.Min(math.Max(in.Elapsed, 0), 1)
+			return p["initial"] - (p["initial"]-p["minimum"])*elapsed
+		},
+	},
+}
+
+// solvedBefore counts the solves before the one being scored; the first solver gets the
+// initial value.
+func solvedBefore(in Input) int {
+	return max(in.Solves-1, 0)
+}
+
+// Functions returns every registered function ordered by name.
+func Functions() []*Function {
+	out := make([]*Function, 0, len(registry))
+	for _, f := range registry {
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func Lookup(name string) (*Function, error) {
+	f, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownFunction, name)
+	}
+	return f, nil
+}
+
+// Validate checks that p sets every parameter of the function and nothing else, within bounds.
+func (f *Function) Validate(p Params) error {
+	var problems []string
+	for _, param := range f.Params {
+		v, ok := p[param.Name]
+		switch {
+		case !ok:
+			problems = append(problems, param.Name+" is required")
+		case math.IsNaN(v) || v < param.Min || v > param.Max:
+			problems = append(problems, fmt.Sprintf("%s must be between %g and %g", param.Name, param.Min, param.Max))
+		case param.Integer && v != math.Trunc(v):
+			problems = append(problems, param.Name+" must be a whole number")
+		}
+	}
+	for name := range p {
+		if !f.hasParam(name) {
+			problems = append(problems, fmt.Sprintf("%s does not take %s", f.Name, name))
+		}
+	}
+	if _, ok := p["minimum"]; ok && p["minimum"] > p["initial"] {
+		problems = append(problems, "minimum must not exceed initial")
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return errors.New(strings.Join(problems, "; "))
+}
+
+func (f *Function) hasParam(name string) bool {
+	for _, param := range f.Params {
+		if param.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Value returns the points the challenge is worth, rounded up and kept between minimum and
+// initial. p must have passed Validate.
+func (f *Function) Value(p Params, in Input) int {
+	v := math.Ceil(f.value(p, in))
+	v = math.Max(math.Min(v, p["initial"]), p["minimum"])
+	return int(v)
+}
+
+// Curve returns the value after each of the first n solves, with elapsed as the share of the
+// event that has passed.
+func (f *Function) Curve(p Params, n int, elapsed float64) []int {
+	out := make([]int, n)
+	for i := range out {
+		out[i] = f.Value(p, Input{Solves: i + 1, Elapsed: elapsed})
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunction_Value(t *testing.T) {
+	tests := []struct {
+		name     string
+		function string
+		params   Params
+		in       Input
+		expected int
+	}{
+		{"static", Static, Params{"initial": 300}, Input{Solves: 40}, 300},
+		{"quadratic first blood", Quadratic, Params{"initial": 500, "minimum": 100, "decay": 20}, Input{Solves: 1}, 500},
+		{"quadratic 10 solves", Quadratic, Params{"initial": 500, "minimum": 100, "decay": 20}, Input{Solves: 10}, 419},
+		{"quadratic past decay", Quadratic, Params{"initial": 500, "minimum": 100, "decay": 20}, Input{Solves: 21}, 100},
+		{"logarithmic first blood", Logarithmic, Params{"initial": 500, "minimum": 100, "decay": 20}, Input{Solves: 1}, 500},
+		{"logarithmic 10 solves", Logarithmic, Params{"initial": 500, "minimum": 100, "decay": 20}, Input{Solves: 10}, 198},
+		{"logarithmic past decay", Logarithmic, Params{"initial": 500, "minimum": 100, "decay": 20}, Input{Solves: 21}, 100},
+		{"linear", Linear, Params{"initial": 500, "minimum": 100, "decay": 25}, Input{Solves: 5}, 400},
+		{"linear floors at minimum", Linear, Params{"initial": 500, "minimum": 100, "decay": 25}, Input{Solves: 100}, 100},
+		{"exponential one half life", Exponential, Params{"initial": 500, "minimum": 100, "half_life": 4}, Input{Solves: 5}, 300},
+		{"exponential rounds up", Exponential, Params{"initial": 500, "minimum": 100, "half_life": 3}, Input{Solves: 2}, 418},
+		{"time at start", Time, Params{"initial": 500, "minimum": 100}, Input{Elapsed: 0}, 500},
+		{"time halfway", Time, Params{"initial": 500, "minimum": 100}, Input{Elapsed: 0.5}, 300},
+		{"time past end", Time, Params{"initial": 500, "minimum": 100}, Input{Elapsed: 2}, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Lookup(tt.function)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, f.Value(tt.params, tt.in))
+		})
+	}
+}
+
+func TestFunction_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		function string
+		params   Params
+		expected string
+	}{
+		{"valid", Logarithmic, Params{"initial": 500, "minimum": 100, "decay": 20}, ""},
+		{"missing", Linear, Params{"initial": 500}, "decay is required; minimum is required"},
+		{"out of range", Exponential, Params{"initial": 500, "minimum": 100, "half_life": 0}, "half_life must be between 1 and 1e+06"},
+		{"fractional", Logarithmic, Params{"initial": 500, "minimum": 100, "decay": 2.5}, "decay must be a whole number"},
+		{"unknown param", Static, Params{"initial": 500, "decay": 1}, "static does not take decay"},
+		{"minimum above initial", Time, Params{"initial": 100, "minimum": 500}, "minimum must not exceed initial"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Lookup(tt.function)
+			require.NoError(t, err)
+			err = f.Validate(tt.params)
+			if tt.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestLookup_Unknown(t *testing.T) {
+	_, err := Lookup("sigmoid")
+
+	assert.ErrorIs(t, err, ErrUnknownFunction)
+}
+
+func TestFunctions_Sorted(t *testing.T) {
+	names := make([]string, 0)
+	for _, f := range Functions() {
+		names = append(names, f.Name)
+	}
+
+	assert.Equal(t, []string{Exponential, Linear, Logarithmic, Quadratic, Static, Time}, names)
+}
+
+func TestFunction_Curve(t *testing.T) {
+	f, err := Lookup(Linear)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{300, 250, 200, 200}, f.Curve(Params{"initial": 300, "minimum": 200, "decay": 50}, 4, 0))
+}
+
+func TestFunction_Curve_LogarithmicDiffersFromQuadratic(t *testing.T) {
+	params := Params{"initial": 500, "minimum": 100, "decay": 20}
+	logarithmic, err := Lookup(Logarithmic)
+	require.NoError(t, err)
+	quadratic, err := Lookup(Quadratic)
+	require.NoError(t, err)
+
+	logCurve, quadCurve := logarithmic.Curve(params, 21, 0), quadratic.Curve(params, 21, 0)
+
+	assert.NotEqual(t, quadCurve, logCurve)
+	assert.Equal(t, quadCurve[0], logCurve[0])
+	assert.Equal(t, quadCurve[20], logCurve[20])
+	for i := 1; i < 20; i++ {
+		assert.Less(t, logCurve[i], quadCurve[i], "solve %d", i+1)
+	}
+}
@@ -0,0 +1,129 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/usecase"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+func (uc *ChallengeUseCase) GetAttemptLimit(ctx context.Context, challengeID uuid.UUID) (*entity.AttemptLimit, error) {
+	limit, err := uc.attemptRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetAttemptLimit")
+	}
+	return limit, nil
+}
+
+// SetAttemptLimit caps the wrong submissions of every team on a challenge at maxAttempts, nil
+// meaning unlimited, and charges wrongPenalty points for each of them.
+func (uc *ChallengeUseCase) SetAttemptLimit(ctx context.Context, challengeID uuid.UUID, maxAttempts *int, wrongPenalty int) (*entity.AttemptLimit, error) {
+	if (maxAttempts == nil && wrongPenalty == 0) || (maxAttempts != nil && *maxAttempts < 1) || wrongPenalty < 0 {
+		return nil, entityError.ErrInvalidAttemptLimit
+	}
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetAttemptLimit - GetByID")
+	}
+	limit := &entity.AttemptLimit{ChallengeID: challengeID, MaxAttempts: maxAttempts, WrongPenalty: wrongPenalty}
+	if err := uc.attemptRepo.Set(ctx, limit); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetAttemptLimit")
+	}
+	return limit, nil
+}
+
+func (uc *ChallengeUseCase) DeleteAttemptLimit(ctx context.Context, challengeID uuid.UUID) error {
+	return usecaseutil.Wrap(uc.attemptRepo.Delete(ctx, challengeID), "ChallengeUseCase - DeleteAttemptLimit")
+}
+
+// ResetAttempts gives a team its full number of attempts on a challenge back. Penalties it
+// already paid are kept.
+func (uc *ChallengeUseCase) ResetAttempts(ctx context.Context, challengeID, teamID uuid.UUID) error {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - ResetAttempts - GetByID")
+	}
+	if _, err := uc.teamRepo.GetByID(ctx, teamID); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - ResetAttempts - GetTeam")
+	}
+	return usecaseutil.Wrap(uc.attemptRepo.ResetTeam(ctx, challengeID, teamID, time.Now()), "ChallengeUseCase - ResetAttempts")
+}
+
+// loadAttemptState returns the attempt limits keyed by challenge along with what teamID has
+// left of them; challenges without a limit are absent.
+func (uc *ChallengeUseCase) loadAttemptState(ctx context.Context, teamID *uuid.UUID) (map[uuid.UUID]*usecase.AttemptState, error) {
+	if uc.attemptRepo == nil {
+		return nil, nil
+	}
+	limits, err := uc.attemptRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetAttemptLimits")
+	}
+	if len(limits) == 0 {
+		return nil, nil
+	}
+	failed := map[uuid.UUID]int{}
+	if teamID != nil && uc.submissionRepo != nil {
+		if failed, err = uc.submissionRepo.CountFailedByTeam(ctx, *teamID); err != nil {
+			return nil, usecaseutil.Wrap(err, "CountFailedByTeam")
+		}
+	}
+	out := make(map[uuid.UUID]*usecase.AttemptState, len(limits))
+	for _, limit := range limits {
+		state := &usecase.AttemptState{AttemptLimit: limit}
+		if limit.MaxAttempts != nil {
+			remaining := max(*limit.MaxAttempts-failed[limit.ChallengeID], 0)
+			state.Remaining = &remaining
+		}
+		out[limit.ChallengeID] = state
+	}
+	return out, nil
+}
+
+// submitCheckAttempts returns the attempt limit of the challenge, or nil when it has none, and
+// ErrAttemptsExhausted once the team made as many wrong submissions as the limit allows.
+func (uc *ChallengeUseCase) submitCheckAttempts(sc *submitContext) (*entity.AttemptLimit, error) {
+	if uc.attemptRepo == nil {
+		return nil, nil
+	}
+	limit, err := uc.attemptRepo.GetByChallengeID(sc.ctx, sc.challengeID)
+	if errors.Is(err, entityError.ErrAttemptLimitNotConfigured) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - GetAttemptLimit")
+	}
+	if limit.MaxAttempts == nil || uc.submissionRepo == nil {
+		return limit, nil
+	}
+	failed, err := uc.submissionRepo.CountFailedByTeamAndChallenge(sc.ctx, sc.teamID, sc.challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - CountFailed")
+	}
+	if failed >= int64(*limit.MaxAttempts) {
+		return nil, entityError.ErrAttemptsExhausted
+	}
+	return limit, nil
+}
+
+// submitPenalize charges the team the wrong submission penalty of the challenge as a negative
+// award. It passes err through untouched and only penalizes wrong flags that caused no error.
+func (uc *ChallengeUseCase) submitPenalize(sc *submitContext, challenge *entity.Challenge, limit *entity.AttemptLimit, correct bool, err error) error {
+	if err != nil || correct || limit == nil || limit.WrongPenalty == 0 || uc.awardRepo == nil {
+		return err
+	}
+	award := &entity.Award{
+		TeamID:      sc.teamID,
+		Value:       -limit.WrongPenalty,
+		Description: fmt.Sprintf("Wrong submission on %s", challenge.Title),
+	}
+	if err := uc.awardRepo.Create(sc.ctx, award); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - CreatePenalty")
+	}
+	uc.submitInvalidateCache(sc.ctx)
+	return nil
+}
@@ -0,0 +1,42 @@
+package challenge
+
+import (
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithAttempts() *ChallengeUseCase {
+	h.t.Helper()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		WithSolveRepo(h.deps.solveRepo),
+		WithTxRepo(h.deps.txRepo),
+		WithCompetitionRepo(h.deps.compRepo),
+		WithTeamRepo(h.deps.teamRepo),
+		WithAttemptLimitRepo(h.deps.attemptRepo),
+		WithSubmissionRepo(h.deps.submissionRepo),
+		WithAwardRepo(h.deps.awardRepo),
+	)
+}
+
+func (h *ChallengeTestHelper) NewAttemptLimit(challengeID uuid.UUID, maxAttempts *int, wrongPenalty int) *entity.AttemptLimit {
+	h.t.Helper()
+	return &entity.AttemptLimit{ChallengeID: challengeID, MaxAttempts: maxAttempts, WrongPenalty: wrongPenalty}
+}
+
+// ExpectAttemptSubmit sets up a submission by teamID to a challenge with the given attempt
+// limit, the team having made failed wrong submissions so far.
+func (h *ChallengeTestHelper) ExpectAttemptSubmit(challenge *entity.Challenge, teamID uuid.UUID, limit *entity.AttemptLimit, failed int64) {
+	h.t.Helper()
+	h.deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	h.deps.challengeRepo.On("GetByID", mock.Anything, challenge.ID).Return(challenge, nil)
+	h.deps.attemptRepo.On("GetByChallengeID", mock.Anything, challenge.ID).Return(limit, nil)
+	if limit.MaxAttempts != nil {
+		h.deps.submissionRepo.On("CountFailedByTeamAndChallenge", mock.Anything, teamID, challenge.ID).Return(failed, nil)
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
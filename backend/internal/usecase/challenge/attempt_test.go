@@ -0,0 +1,170 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeUseCase_SubmitFlag_AttemptsExhausted(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithAttempts()
+	teamID := uuid.New()
+	c := h.NewChallenge(uuid.New(), "Limited", "Crypto", 100, h.Sha256Hash("flag{ok}"))
+	h.ExpectAttemptSubmit(c, teamID, h.NewAttemptLimit(c.ID, intPtr(3), 0), 3)
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{ok}", uuid.New(), &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrAttemptsExhausted)
+	assert.False(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_WrongWithPenalty(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithAttempts()
+	teamID := uuid.New()
+	c := h.NewChallenge(uuid.New(), "Limited", "Crypto", 100, h.Sha256Hash("flag{ok}"))
+	h.ExpectAttemptSubmit(c, teamID, h.NewAttemptLimit(c.ID, intPtr(3), 15), 2)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.awardRepo.On("Create", mock.Anything, mock.MatchedBy(func(a *entity.Award) bool {
+		return a.TeamID == teamID && a.Value == -15 && a.CreatedBy == nil
+	})).Return(nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{nope}", uuid.New(), &teamID)
+
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_WrongWithoutPenalty(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithAttempts()
+	teamID := uuid.New()
+	c := h.NewChallenge(uuid.New(), "Limited", "Crypto", 100, h.Sha256Hash("flag{ok}"))
+	h.ExpectAttemptSubmit(c, teamID, h.NewAttemptLimit(c.ID, intPtr(3), 0), 0)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{nope}", uuid.New(), &teamID)
+
+	require.NoError(t, err)
+	assert.False(t, valid)
+	deps.awardRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SubmitFlag_PenaltyOnlyNeverExhausts(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithAttempts()
+	teamID := uuid.New()
+	c := h.NewChallenge(uuid.New(), "Penalized", "Crypto", 100, h.Sha256Hash("flag{ok}"))
+	h.ExpectAttemptSubmit(c, teamID, h.NewAttemptLimit(c.ID, nil, 5), 0)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.awardRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := uc.SubmitFlag(context.Background(), c.ID, "flag{nope}", uuid.New(), &teamID)
+
+	require.NoError(t, err)
+	deps.submissionRepo.AssertNotCalled(t, "CountFailedByTeamAndChallenge", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SetAttemptLimit(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithAttempts()
+	c := h.NewChallenge(uuid.New(), "Limited", "Crypto", 100, "hash")
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.attemptRepo.On("Set", mock.Anything, mock.MatchedBy(func(l *entity.AttemptLimit) bool {
+		return l.ChallengeID == c.ID && *l.MaxAttempts == 5 && l.WrongPenalty == 10
+	})).Return(nil)
+
+	limit, err := uc.SetAttemptLimit(context.Background(), c.ID, intPtr(5), 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, *limit.MaxAttempts)
+}
+
+func TestChallengeUseCase_SetAttemptLimit_Invalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxAttempts  *int
+		wrongPenalty int
+	}{
+		{"nothing set", nil, 0},
+		{"zero attempts", intPtr(0), 10},
+		{"negative penalty", intPtr(3), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewChallengeTestHelper(t)
+			uc := h.CreateChallengeUseCaseWithAttempts()
+
+			_, err := uc.SetAttemptLimit(context.Background(), uuid.New(), tt.maxAttempts, tt.wrongPenalty)
+
+			assert.ErrorIs(t, err, entityError.ErrInvalidAttemptLimit)
+		})
+	}
+}
+
+func TestChallengeUseCase_ResetAttempts(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithAttempts()
+	c := h.NewChallenge(uuid.New(), "Limited", "Crypto", 100, "hash")
+	teamID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.attemptRepo.On("ResetTeam", mock.Anything, c.ID, teamID, mock.Anything).Return(nil)
+
+	err := uc.ResetAttempts(context.Background(), c.ID, teamID)
+
+	require.NoError(t, err)
+}
+
+func TestChallengeUseCase_ResetAttempts_TeamNotFound(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithAttempts()
+	c := h.NewChallenge(uuid.New(), "Limited", "Crypto", 100, "hash")
+	teamID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(nil, entityError.ErrTeamNotFound)
+
+	err := uc.ResetAttempts(context.Background(), c.ID, teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrTeamNotFound)
+	deps.attemptRepo.AssertNotCalled(t, "ResetTeam", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_GetAll_AttemptsRemaining(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithAttempts()
+	teamID := uuid.New()
+	limited := h.NewChallenge(uuid.New(), "Limited", "Crypto", 100, "hash")
+	open := h.NewChallenge(uuid.New(), "Open", "Web", 100, "hash")
+	deps.challengeRepo.On("GetAll", mock.Anything, &teamID, (*uuid.UUID)(nil)).Return([]*repo.ChallengeWithSolved{
+		h.NewChallengeWithSolved(limited, false),
+		h.NewChallengeWithSolved(open, false),
+	}, nil)
+	deps.attemptRepo.On("GetAll", mock.Anything).Return([]*entity.AttemptLimit{h.NewAttemptLimit(limited.ID, intPtr(5), 10)}, nil)
+	deps.submissionRepo.On("CountFailedByTeam", mock.Anything, teamID).Return(map[uuid.UUID]int{limited.ID: 2, open.ID: 7}, nil)
+
+	out, err := uc.GetAll(context.Background(), &teamID, nil)
+
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	require.NotNil(t, out[0].Attempts)
+	assert.Equal(t, 3, *out[0].Attempts.Remaining)
+	assert.Equal(t, 10, out[0].Attempts.WrongPenalty)
+	assert.Nil(t, out[1].Attempts)
+}
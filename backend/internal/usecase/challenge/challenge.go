@@ -2,13 +2,12 @@ package challenge
 
 import (
 	"context"
-	"crypto/sha256"
 	"crypto/subtle"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -19,6 +18,7 @@ import (
 	"github.com/skr1ms/CTFBoard/internal/usecase/competition"
 	"github.com/skr1ms/CTFBoard/pkg/cache"
 	"github.com/skr1ms/CTFBoard/pkg/crypto"
+	"github.com/skr1ms/CTFBoard/pkg/logger"
 	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
 	"github.com/skr1ms/CTFBoard/pkg/websocket"
 	"golang.org/x/sync/singleflight"
@@ -36,6 +36,22 @@ type ChallengeUseCase struct {
 	broadcaster     websocket.SolveBroadcaster
 	auditLogRepo    repo.AuditLogRepository
 	crypto          crypto.Service
+	flagRepo        repo.ChallengeFlagRepository
+	requirementRepo repo.ChallengeRequirementRepository
+	scheduleRepo    repo.ChallengeScheduleRepository
+	notifRepo       repo.NotificationRepository
+	teamFlagRepo    repo.TeamFlagRepository
+	cheatRepo       repo.CheatIncidentRepository
+	decoyRepo       repo.DecoyFlagRepository
+	configRepo      repo.ConfigRepository
+	alerts          websocket.AdminBroadcaster
+	reviewRepo      repo.ReviewRepository
+	stageRepo       repo.ChallengeStageRepository
+	scoringRepo     repo.ChallengeScoringRepository
+	attemptRepo     repo.AttemptLimitRepository
+	submissionRepo  repo.SubmissionRepository
+	awardRepo       repo.AwardRepository
+	logger          logger.Logger
 	regexCache      *cache.BoundedCache[string, *regexp.Regexp]
 	regexSf         singleflight.Group
 }
@@ -51,28 +67,36 @@ func NewChallengeUseCase(challengeRepo repo.ChallengeRepository, opts ...Challen
 	return uc
 }
 
+// GetAll lists the visible, released challenges, marking those teamID has not unlocked yet as
+// locked. Releases that came due since the previous read are announced first.
 func (uc *ChallengeUseCase) GetAll(ctx context.Context, teamID, tagID *uuid.UUID) ([]*usecase.ChallengeWithTags, error) {
+	uc.announceReleases(ctx)
 	challenges, err := uc.challengeRepo.GetAll(ctx, teamID, tagID)
 	if err != nil {
 		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetAll")
 	}
-	if uc.tagRepo == nil {
-		out := make([]*usecase.ChallengeWithTags, len(challenges))
-		for i, c := range challenges {
-			out[i] = &usecase.ChallengeWithTags{
-				ChallengeWithSolved: c,
-				Tags:                []*entity.Tag{},
-			}
-		}
-		return out, nil
+	state, err := uc.loadUnlockState(ctx, teamID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetAll - loadUnlockState")
 	}
-	ids := make([]uuid.UUID, len(challenges))
-	for i, c := range challenges {
-		ids[i] = c.Challenge.ID
+	progress, err := uc.loadStageProgress(ctx, teamID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetAll - loadStageProgress")
 	}
-	tagsMap, err := uc.tagRepo.GetByChallengeIDs(ctx, ids)
+	attempts, err := uc.loadAttemptState(ctx, teamID)
 	if err != nil {
-		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetAll - GetTags")
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetAll - loadAttemptState")
+	}
+	tagsMap := map[uuid.UUID][]*entity.Tag{}
+	if uc.tagRepo != nil {
+		ids := make([]uuid.UUID, len(challenges))
+		for i, c := range challenges {
+			ids[i] = c.Challenge.ID
+		}
+		tagsMap, err = uc.tagRepo.GetByChallengeIDs(ctx, ids)
+		if err != nil {
+			return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetAll - GetTags")
+		}
 	}
 	out := make([]*usecase.ChallengeWithTags, len(challenges))
 	for i, c := range challenges {
@@ -83,6 +107,9 @@ func (uc *ChallengeUseCase) GetAll(ctx context.Context, teamID, tagID *uuid.UUID
 		out[i] = &usecase.ChallengeWithTags{
 			ChallengeWithSolved: c,
 			Tags:                tags,
+			Locked:              !c.Solved && !state.isUnlocked(c.Challenge.ID),
+			Stages:              progress[c.Challenge.ID],
+			Attempts:            attempts[c.Challenge.ID],
 		}
 	}
 	return out, nil
@@ -103,12 +130,7 @@ func (uc *ChallengeUseCase) Create(ctx context.Context, title, description, cate
 		flagRegex = encrypted
 		flagHash = "REGEX_CHALLENGE"
 	} else {
-		userInput := flag
-		if isCaseInsensitive {
-			userInput = strings.ToLower(strings.TrimSpace(flag))
-		}
-		hash := sha256.Sum256([]byte(userInput))
-		flagHash = hex.EncodeToString(hash[:])
+		flagHash = hashFlag(flag, isCaseInsensitive)
 	}
 
 	challenge := &entity.Challenge{
@@ -193,12 +215,7 @@ func (uc *ChallengeUseCase) challengeUpdateApplyFlag(c *entity.Challenge, flag s
 		c.FlagHash = "REGEX_CHALLENGE"
 		return nil
 	}
-	userInput := flag
-	if isCaseInsensitive {
-		userInput = strings.ToLower(strings.TrimSpace(flag))
-	}
-	hash := sha256.Sum256([]byte(userInput))
-	c.FlagHash = hex.EncodeToString(hash[:])
+	c.FlagHash = hashFlag(flag, isCaseInsensitive)
 	c.FlagRegex = ""
 	return nil
 }
@@ -281,18 +298,45 @@ func (uc *ChallengeUseCase) SubmitFlag(ctx context.Context, challengeID uuid.UUI
 	if err != nil {
 		return false, err
 	}
+	state, err := uc.submitCheckAvailable(sc)
+	if err != nil {
+		return false, err
+	}
+	limit, err := uc.submitCheckAttempts(sc)
+	if err != nil {
+		return false, err
+	}
+	if queued, err := uc.submitQueueReviewIfManual(sc, challenge); queued {
+		return false, err
+	}
 	if err := uc.submitValidateFlagFormat(sc, challenge); err != nil {
+		// Decoys need not follow the flag format, so a malformed answer may still be one
+		if errors.Is(err, entityError.ErrInvalidFlagFormat) {
+			uc.submitCheckDecoy(sc, challenge)
+		}
 		return false, err
 	}
-	if !uc.submitCheckFlag(sc, challenge) {
-		return false, nil
+	if handled, correct, err := uc.submitStage(sc, challenge, state); handled {
+		if !correct && err == nil {
+			uc.submitCheckDecoy(sc, challenge)
+		}
+		return correct, uc.submitPenalize(sc, challenge, limit, correct, err)
+	}
+	correct, err := uc.submitCheckFlag(sc, challenge)
+	if err != nil {
+		return false, err
+	}
+	if !correct {
+		uc.submitCheckDecoy(sc, challenge)
+		return false, uc.submitPenalize(sc, challenge, limit, false, nil)
 	}
-	solvedChallenge, solveCount, err := uc.submitRecordSolve(sc, challenge)
+	solvedChallenge, solveCount, err := uc.submitRecordSolve(sc, nil)
 	if err != nil {
 		return errors.Is(err, entityError.ErrAlreadySolved), err
 	}
 	uc.submitInvalidateCache(sc.ctx)
 	uc.submitNotifySolve(sc.teamID, solvedChallenge, solveCount == 1)
+	uc.notifyUnlocks(ctx, sc.teamID, state)
 	return true, nil
 }
 
@@ -310,6 +354,22 @@ func (uc *ChallengeUseCase) submitValidateTeam(sc *submitContext) error {
 	return nil
 }
 
+// submitCheckAvailable checks that the challenge is released and unlocked for the team and
+// returns the unlock state of the team.
+func (uc *ChallengeUseCase) submitCheckAvailable(sc *submitContext) (*unlockState, error) {
+	if err := uc.checkReleased(sc.ctx, sc.challengeID); err != nil {
+		return nil, err
+	}
+	state, err := uc.loadUnlockState(sc.ctx, &sc.teamID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - loadUnlockState")
+	}
+	if !state.isUnlocked(sc.challengeID) {
+		return nil, entityError.ErrChallengeLocked
+	}
+	return state, nil
+}
+
 func (uc *ChallengeUseCase) submitGetChallenge(sc *submitContext) (*entity.Challenge, error) {
 	challenge, err := uc.challengeRepo.GetByID(sc.ctx, sc.challengeID)
 	if err != nil {
@@ -344,22 +404,57 @@ func (uc *ChallengeUseCase) submitValidateFlagFormat(sc *submitContext, challeng
 	return nil
 }
 
-func (uc *ChallengeUseCase) submitCheckFlag(sc *submitContext, challenge *entity.Challenge) bool {
+// submitCheckFlag accepts the primary flag of the challenge or any of its additional flags; a
+// challenge in per-team mode accepts only the submitting team's flag.
+func (uc *ChallengeUseCase) submitCheckFlag(sc *submitContext, challenge *entity.Challenge) (bool, error) {
+	if uc.teamFlagRepo != nil {
+		cfg, err := uc.teamFlagRepo.GetByChallengeID(sc.ctx, challenge.ID)
+		if err == nil {
+			return uc.submitCheckTeamFlag(sc, cfg)
+		}
+		if !errors.Is(err, entityError.ErrTeamFlagNotFound) {
+			return false, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - GetTeamFlag")
+		}
+	}
 	if challenge.IsRegex {
-		return uc.submitCheckRegexFlag(sc, challenge)
+		if uc.submitCheckRegexFlag(sc, challenge.FlagRegex, challenge.IsCaseInsensitive) {
+			return true, nil
+		}
+	} else if uc.submitCheckHashFlag(sc, challenge.FlagHash, challenge.IsCaseInsensitive) {
+		return true, nil
+	}
+	if uc.flagRepo == nil {
+		return false, nil
+	}
+	flags, err := uc.flagRepo.GetByChallengeID(sc.ctx, challenge.ID)
+	if err != nil {
+		return false, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - GetFlags")
 	}
-	return uc.submitCheckHashFlag(sc, challenge)
+	return uc.submitMatchFlags(sc, flags), nil
 }
 
-func (uc *ChallengeUseCase) submitCheckRegexFlag(sc *submitContext, challenge *entity.Challenge) bool {
+func (uc *ChallengeUseCase) submitMatchFlags(sc *submitContext, flags []*entity.ChallengeFlag) bool {
+	for _, f := range flags {
+		if f.Type == entity.FlagTypeRegex {
+			if uc.submitCheckRegexFlag(sc, f.FlagRegex, f.IsCaseInsensitive) {
+				return true
+			}
+		} else if uc.submitCheckHashFlag(sc, f.FlagHash, f.IsCaseInsensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+func (uc *ChallengeUseCase) submitCheckRegexFlag(sc *submitContext, encryptedPattern string, isCaseInsensitive bool) bool {
 	if uc.crypto == nil {
 		return false
 	}
-	pattern, err := uc.crypto.Decrypt(challenge.FlagRegex)
+	pattern, err := uc.crypto.Decrypt(encryptedPattern)
 	if err != nil {
 		return false
 	}
-	if challenge.IsCaseInsensitive {
+	if isCaseInsensitive {
 		pattern = "(?i)" + pattern
 	}
 	compiled, err := uc.getCompiledRegex(pattern)
@@ -369,17 +464,16 @@ func (uc *ChallengeUseCase) submitCheckRegexFlag(sc *submitContext, challenge *e
 	return compiled.MatchString(sc.flag)
 }
 
-func (uc *ChallengeUseCase) submitCheckHashFlag(sc *submitContext, challenge *entity.Challenge) bool {
-	userInput := sc.flag
-	if challenge.IsCaseInsensitive {
-		userInput = strings.ToLower(userInput)
-	}
-	hash := sha256.Sum256([]byte(userInput))
-	hashStr := hex.EncodeToString(hash[:])
-	return subtle.ConstantTimeCompare([]byte(hashStr), []byte(challenge.FlagHash)) == 1
+func (uc *ChallengeUseCase) submitCheckHashFlag(sc *submitContext, flagHash string, isCaseInsensitive bool) bool {
+	hashStr := hashFlag(sc.flag, isCaseInsensitive)
+	return subtle.ConstantTimeCompare([]byte(hashStr), []byte(flagHash)) == 1
 }
 
-func (uc *ChallengeUseCase) submitRecordSolve(sc *submitContext, _ *entity.Challenge) (*entity.Challenge, int, error) {
+// solveHook runs inside the solve transaction once the solve is recorded and the challenge
+// value has been updated.
+type solveHook func(ctx context.Context, tx repo.Transaction, solvedChallenge *entity.Challenge) error
+
+func (uc *ChallengeUseCase) submitRecordSolve(sc *submitContext, afterSolve solveHook) (*entity.Challenge, int, error) {
 	var solvedChallenge *entity.Challenge
 	var solveCount int
 	err := uc.txRepo.RunTransaction(sc.ctx, func(ctx context.Context, tx repo.Transaction) error {
@@ -392,6 +486,10 @@ func (uc *ChallengeUseCase) submitRecordSolve(sc *submitContext, _ *entity.Chall
 			return usecaseutil.Wrap(err2, "GetChallengeByIDTx")
 		}
 		solve := &entity.Solve{UserID: sc.userID, TeamID: sc.teamID, ChallengeID: sc.challengeID}
+		solve.Points, err2 = competition.SolveValue(ctx, uc.scoringRepo, uc.compRepo, solvedChallenge, solvedChallenge.SolveCount+1, time.Now())
+		if err2 != nil {
+			return err2
+		}
 		if err2 = uc.txRepo.CreateSolveTx(ctx, tx, solve); err2 != nil {
 			return usecaseutil.Wrap(err2, "CreateSolveTx")
 		}
@@ -399,7 +497,13 @@ func (uc *ChallengeUseCase) submitRecordSolve(sc *submitContext, _ *entity.Chall
 		if err2 != nil {
 			return usecaseutil.Wrap(err2, "IncrementChallengeSolveCountTx")
 		}
-		return uc.submitRecordSolveUpdatePointsIfDecay(ctx, tx, sc.challengeID, solvedChallenge, solveCount)
+		if err2 = uc.submitRecordSolveUpdatePoints(ctx, tx, sc.challengeID, solvedChallenge, solveCount); err2 != nil {
+			return err2
+		}
+		if afterSolve == nil {
+			return nil
+		}
+		return afterSolve(ctx, tx, solvedChallenge)
 	})
 	if err != nil {
 		return nil, 0, err
@@ -418,11 +522,11 @@ func (uc *ChallengeUseCase) submitRecordSolveCheckExisting(ctx context.Context,
 	return nil
 }
 
-func (uc *ChallengeUseCase) submitRecordSolveUpdatePointsIfDecay(ctx context.Context, tx repo.Transaction, challengeID uuid.UUID, solvedChallenge *entity.Challenge, solveCount int) error {
-	if solvedChallenge.InitialValue <= 0 || solvedChallenge.Decay <= 0 {
-		return nil
+func (uc *ChallengeUseCase) submitRecordSolveUpdatePoints(ctx context.Context, tx repo.Transaction, challengeID uuid.UUID, solvedChallenge *entity.Challenge, solveCount int) error {
+	newPoints, err := competition.ChallengeValue(ctx, uc.scoringRepo, uc.compRepo, solvedChallenge, solveCount)
+	if err != nil {
+		return err
 	}
-	newPoints := competition.CalculateDynamicScore(solvedChallenge.InitialValue, solvedChallenge.MinValue, solvedChallenge.Decay, solveCount)
 	if newPoints == solvedChallenge.Points {
 		return nil
 	}
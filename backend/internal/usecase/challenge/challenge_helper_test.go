@@ -10,6 +10,7 @@ import (
 	"github.com/skr1ms/CTFBoard/internal/entity"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/internal/usecase/challenge/mocks"
+	notificationMocks "github.com/skr1ms/CTFBoard/internal/usecase/notification/mocks"
 	"github.com/skr1ms/CTFBoard/pkg/crypto"
 )
 
@@ -19,20 +20,39 @@ type ChallengeTestHelper struct {
 }
 
 type challengeTestDeps struct {
-	challengeRepo  *mocks.MockChallengeRepository
-	solveRepo      *mocks.MockSolveRepository
-	txRepo         *mocks.MockTxRepository
-	teamRepo       *mocks.MockTeamRepository
-	compRepo       *mocks.MockCompetitionRepository
-	auditLogRepo   *mocks.MockAuditLogRepository
-	crypto         *mocks.MockCryptoService
-	hintRepo       *mocks.MockHintRepository
-	hintUnlockRepo *mocks.MockHintUnlockRepository
-	awardRepo      *mocks.MockAwardRepository
-	fileRepo       *mocks.MockFileRepository
-	s3Provider     *mocks.MockS3Provider
-	commentRepo    *mocks.MockCommentRepository
-	tagRepo        *mocks.MockTagRepository
+	challengeRepo   *mocks.MockChallengeRepository
+	solveRepo       *mocks.MockSolveRepository
+	txRepo          *mocks.MockTxRepository
+	teamRepo        *mocks.MockTeamRepository
+	compRepo        *mocks.MockCompetitionRepository
+	auditLogRepo    *mocks.MockAuditLogRepository
+	crypto          *mocks.MockCryptoService
+	hintRepo        *mocks.MockHintRepository
+	hintUnlockRepo  *mocks.MockHintUnlockRepository
+	awardRepo       *mocks.MockAwardRepository
+	fileRepo        *mocks.MockFileRepository
+	s3Provider      *mocks.MockS3Provider
+	commentRepo     *mocks.MockCommentRepository
+	tagRepo         *mocks.MockTagRepository
+	flagRepo        *mocks.MockChallengeFlagRepository
+	requirementRepo *mocks.MockChallengeRequirementRepository
+	scheduleRepo    *mocks.MockChallengeScheduleRepository
+	notifRepo       *notificationMocks.MockNotificationRepository
+	teamFlagRepo    *mocks.MockTeamFlagRepository
+	cheatRepo       *mocks.MockCheatIncidentRepository
+	decoyRepo       *mocks.MockDecoyFlagRepository
+	configRepo      *mocks.MockConfigRepository
+	alerts          *mocks.MockAdminBroadcaster
+	instanceRepo    *mocks.MockInstanceRepository
+	instances       *mocks.MockInstanceProvider
+	logger          *mocks.MockLogger
+	reviewRepo      *mocks.MockReviewRepository
+	revisionRepo    *mocks.MockRevisionRepository
+	specRepo        *mocks.MockChallengeSpecRepository
+	stageRepo       *mocks.MockChallengeStageRepository
+	scoringRepo     *mocks.MockChallengeScoringRepository
+	attemptRepo     *mocks.MockAttemptLimitRepository
+	submissionRepo  *mocks.MockSubmissionRepository
 }
 
 func NewChallengeTestHelper(t *testing.T) *ChallengeTestHelper {
@@ -41,20 +61,39 @@ func NewChallengeTestHelper(t *testing.T) *ChallengeTestHelper {
 	return &ChallengeTestHelper{
 		t: t,
 		deps: &challengeTestDeps{
-			challengeRepo:  mocks.NewMockChallengeRepository(t),
-			solveRepo:      mocks.NewMockSolveRepository(t),
-			txRepo:         mocks.NewMockTxRepository(t),
-			teamRepo:       mocks.NewMockTeamRepository(t),
-			compRepo:       mocks.NewMockCompetitionRepository(t),
-			auditLogRepo:   mocks.NewMockAuditLogRepository(t),
-			crypto:         mocks.NewMockCryptoService(t),
-			hintRepo:       mocks.NewMockHintRepository(t),
-			hintUnlockRepo: mocks.NewMockHintUnlockRepository(t),
-			awardRepo:      mocks.NewMockAwardRepository(t),
-			fileRepo:       mocks.NewMockFileRepository(t),
-			s3Provider:     mocks.NewMockS3Provider(t),
-			commentRepo:    mocks.NewMockCommentRepository(t),
-			tagRepo:        mocks.NewMockTagRepository(t),
+			challengeRepo:   mocks.NewMockChallengeRepository(t),
+			solveRepo:       mocks.NewMockSolveRepository(t),
+			txRepo:          mocks.NewMockTxRepository(t),
+			teamRepo:        mocks.NewMockTeamRepository(t),
+			compRepo:        mocks.NewMockCompetitionRepository(t),
+			auditLogRepo:    mocks.NewMockAuditLogRepository(t),
+			crypto:          mocks.NewMockCryptoService(t),
+			hintRepo:        mocks.NewMockHintRepository(t),
+			hintUnlockRepo:  mocks.NewMockHintUnlockRepository(t),
+			awardRepo:       mocks.NewMockAwardRepository(t),
+			fileRepo:        mocks.NewMockFileRepository(t),
+			s3Provider:      mocks.NewMockS3Provider(t),
+			commentRepo:     mocks.NewMockCommentRepository(t),
+			tagRepo:         mocks.NewMockTagRepository(t),
+			flagRepo:        mocks.NewMockChallengeFlagRepository(t),
+			requirementRepo: mocks.NewMockChallengeRequirementRepository(t),
+			scheduleRepo:    mocks.NewMockChallengeScheduleRepository(t),
+			notifRepo:       notificationMocks.NewMockNotificationRepository(t),
+			teamFlagRepo:    mocks.NewMockTeamFlagRepository(t),
+			cheatRepo:       mocks.NewMockCheatIncidentRepository(t),
+			decoyRepo:       mocks.NewMockDecoyFlagRepository(t),
+			configRepo:      mocks.NewMockConfigRepository(t),
+			alerts:          mocks.NewMockAdminBroadcaster(t),
+			instanceRepo:    mocks.NewMockInstanceRepository(t),
+			instances:       mocks.NewMockInstanceProvider(t),
+			logger:          mocks.NewMockLogger(t),
+			reviewRepo:      mocks.NewMockReviewRepository(t),
+			revisionRepo:    mocks.NewMockRevisionRepository(t),
+			specRepo:        mocks.NewMockChallengeSpecRepository(t),
+			stageRepo:       mocks.NewMockChallengeStageRepository(t),
+			scoringRepo:     mocks.NewMockChallengeScoringRepository(t),
+			attemptRepo:     mocks.NewMockAttemptLimitRepository(t),
+			submissionRepo:  mocks.NewMockSubmissionRepository(t),
 		},
 	}
 }
@@ -68,3 +68,14 @@ func (uc *CommentUseCase) Delete(ctx context.Context, id, userID uuid.UUID) erro
 	}
 	return nil
 }
+
+// DeleteAny removes a comment regardless of its author, for moderators.
+func (uc *CommentUseCase) DeleteAny(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.commentRepo.GetByID(ctx, id); err != nil {
+		return usecaseutil.Wrap(err, "CommentUseCase - DeleteAny - GetByID")
+	}
+	if err := uc.commentRepo.Delete(ctx, id); err != nil {
+		return usecaseutil.Wrap(err, "CommentUseCase - DeleteAny")
+	}
+	return nil
+}
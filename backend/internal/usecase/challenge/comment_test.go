@@ -114,3 +114,33 @@ func TestCommentUseCase_Delete_Error(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestCommentUseCase_DeleteAny_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+	c := h.NewComment(uuid.New(), uuid.New(), "spam")
+	c.ID = uuid.New()
+
+	deps.commentRepo.EXPECT().GetByID(mock.Anything, c.ID).Return(c, nil)
+	deps.commentRepo.EXPECT().Delete(mock.Anything, c.ID).Return(nil)
+
+	uc := h.CreateCommentUseCase()
+	err := uc.DeleteAny(ctx, c.ID)
+
+	assert.NoError(t, err)
+}
+
+func TestCommentUseCase_DeleteAny_Error(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+	id := uuid.New()
+
+	deps.commentRepo.EXPECT().GetByID(mock.Anything, id).Return(nil, assert.AnError)
+
+	uc := h.CreateCommentUseCase()
+	err := uc.DeleteAny(ctx, id)
+
+	assert.Error(t, err)
+}
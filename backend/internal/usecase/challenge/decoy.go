@@ -0,0 +1,213 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// decoyBanReason is stored on teams the decoy policy bans.
+const decoyBanReason = "decoy flag submitted"
+
+// ListDecoys returns the decoy flags of a challenge.
+func (uc *ChallengeUseCase) ListDecoys(ctx context.Context, challengeID uuid.UUID) ([]*entity.DecoyFlag, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ListDecoys - GetByID")
+	}
+	decoys, err := uc.decoyRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ListDecoys")
+	}
+	return decoys, nil
+}
+
+// CreateDecoy plants value as a decoy flag of a challenge. A value the challenge accepts is
+// rejected, so a decoy never shadows a real answer.
+func (uc *ChallengeUseCase) CreateDecoy(ctx context.Context, challengeID uuid.UUID, value string, isCaseInsensitive bool, note string) (*entity.DecoyFlag, error) {
+	value, note = strings.TrimSpace(value), strings.TrimSpace(note)
+	if value == "" || utf8.RuneCountInString(note) > entity.MaxDecoyNoteLength {
+		return nil, entityError.ErrInvalidDecoyFlag
+	}
+	challenge, err := uc.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - CreateDecoy - GetByID")
+	}
+	accepted, err := uc.acceptsFlag(ctx, challenge, value)
+	if err != nil {
+		return nil, err
+	}
+	if accepted {
+		return nil, entityError.ErrInvalidDecoyFlag
+	}
+	decoy := &entity.DecoyFlag{
+		ChallengeID:       challengeID,
+		FlagHash:          hashFlag(value, isCaseInsensitive),
+		IsCaseInsensitive: isCaseInsensitive,
+		Note:              note,
+	}
+	if err := uc.decoyRepo.Create(ctx, decoy); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - CreateDecoy")
+	}
+	return decoy, nil
+}
+
+func (uc *ChallengeUseCase) DeleteDecoy(ctx context.Context, ID uuid.UUID) error {
+	if err := uc.decoyRepo.Delete(ctx, ID); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - DeleteDecoy")
+	}
+	return nil
+}
+
+// GetDecoyPolicy returns the stored decoy policy, or the default one when none is stored.
+func (uc *ChallengeUseCase) GetDecoyPolicy(ctx context.Context) (entity.DecoyPolicy, error) {
+	if uc.configRepo == nil {
+		return entity.DefaultDecoyPolicy(), nil
+	}
+	cfg, err := uc.configRepo.GetByKey(ctx, entity.DecoyPolicyConfigKey)
+	if err != nil {
+		if errors.Is(err, entityError.ErrConfigNotFound) {
+			return entity.DefaultDecoyPolicy(), nil
+		}
+		return entity.DecoyPolicy{}, usecaseutil.Wrap(err, "ChallengeUseCase - GetDecoyPolicy - GetByKey")
+	}
+	var policy entity.DecoyPolicy
+	if err := json.Unmarshal([]byte(cfg.Value), &policy); err != nil {
+		return entity.DecoyPolicy{}, usecaseutil.Wrap(err, "ChallengeUseCase - GetDecoyPolicy - Unmarshal")
+	}
+	return policy, nil
+}
+
+func (uc *ChallengeUseCase) SetDecoyPolicy(ctx context.Context, policy entity.DecoyPolicy) (entity.DecoyPolicy, error) {
+	if !policy.IsValid() {
+		return entity.DecoyPolicy{}, entityError.ErrInvalidDecoyPolicy
+	}
+	value, err := json.Marshal(policy)
+	if err != nil {
+		return entity.DecoyPolicy{}, usecaseutil.Wrap(err, "ChallengeUseCase - SetDecoyPolicy - Marshal")
+	}
+	if err := uc.configRepo.Upsert(ctx, &entity.Config{
+		Key:         entity.DecoyPolicyConfigKey,
+		Value:       string(value),
+		ValueType:   entity.ConfigTypeJSON,
+		Description: "What happens to teams that submit decoy flags",
+	}); err != nil {
+		return entity.DecoyPolicy{}, usecaseutil.Wrap(err, "ChallengeUseCase - SetDecoyPolicy - Upsert")
+	}
+	return policy, nil
+}
+
+// acceptsFlag reports whether value is the primary flag or one of the additional flags of
+// challenge.
+func (uc *ChallengeUseCase) acceptsFlag(ctx context.Context, challenge *entity.Challenge, value string) (bool, error) {
+	sc := &submitContext{ctx: ctx, challengeID: challenge.ID, flag: value}
+	if challenge.IsRegex {
+		if uc.submitCheckRegexFlag(sc, challenge.FlagRegex, challenge.IsCaseInsensitive) {
+			return true, nil
+		}
+	} else if uc.submitCheckHashFlag(sc, challenge.FlagHash, challenge.IsCaseInsensitive) {
+		return true, nil
+	}
+	if uc.flagRepo == nil {
+		return false, nil
+	}
+	flags, err := uc.flagRepo.GetByChallengeID(ctx, challenge.ID)
+	if err != nil {
+		return false, usecaseutil.Wrap(err, "ChallengeUseCase - acceptsFlag - GetFlags")
+	}
+	return uc.submitMatchFlags(sc, flags), nil
+}
+
+// submitCheckDecoy records a decoy_flag incident when a rejected flag is one of the decoys of
+// the challenge, applies the decoy policy and alerts admins. Failures are only logged so the
+// answer stays indistinguishable from any other wrong flag. A team submitting the same decoy
+// again is not recorded twice.
+func (uc *ChallengeUseCase) submitCheckDecoy(sc *submitContext, challenge *entity.Challenge) {
+	if uc.decoyRepo == nil || uc.cheatRepo == nil {
+		return
+	}
+	decoys, err := uc.decoyRepo.GetByChallengeID(sc.ctx, challenge.ID)
+	if err != nil {
+		uc.logDecoyError(err, "GetDecoys")
+		return
+	}
+	var decoy *entity.DecoyFlag
+	for _, d := range decoys {
+		if uc.submitCheckHashFlag(sc, d.FlagHash, d.IsCaseInsensitive) {
+			decoy = d
+			break
+		}
+	}
+	if decoy == nil {
+		return
+	}
+	challengeID, userID := challenge.ID, sc.userID
+	incident := &entity.CheatIncident{
+		Kind:        entity.CheatIncidentDecoyFlag,
+		ChallengeID: &challengeID,
+		TeamID:      sc.teamID,
+		UserID:      &userID,
+		Evidence:    entity.CheatEvidence{Flag: sc.flag},
+		Fingerprint: fmt.Sprintf("%s:%s:%s", entity.CheatIncidentDecoyFlag, decoy.ID, sc.teamID),
+	}
+	if err := uc.cheatRepo.Create(sc.ctx, incident); err != nil {
+		if !errors.Is(err, entityError.ErrCheatIncidentExists) {
+			uc.logDecoyError(err, "CreateCheatIncident")
+		}
+		return
+	}
+	action, err := uc.applyDecoyPolicy(sc.ctx, sc.teamID)
+	if err != nil {
+		uc.logDecoyError(err, "applyDecoyPolicy")
+	}
+	if uc.alerts != nil {
+		applied := ""
+		if action != entity.DecoyActionNone {
+			applied = string(action)
+		}
+		uc.alerts.NotifyCheatIncident(incident.ID, string(incident.Kind), string(incident.Kind.Severity()), sc.teamID, incident.ChallengeID, applied)
+	}
+}
+
+// applyDecoyPolicy hides or bans teamID once it has hit as many distinct decoys as the policy
+// threshold, and returns what it did.
+func (uc *ChallengeUseCase) applyDecoyPolicy(ctx context.Context, teamID uuid.UUID) (entity.DecoyAction, error) {
+	policy, err := uc.GetDecoyPolicy(ctx)
+	if err != nil {
+		return entity.DecoyActionNone, err
+	}
+	if policy.Action == entity.DecoyActionNone || uc.teamRepo == nil {
+		return entity.DecoyActionNone, nil
+	}
+	hits, err := uc.cheatRepo.CountByTeamAndKind(ctx, teamID, entity.CheatIncidentDecoyFlag)
+	if err != nil {
+		return entity.DecoyActionNone, usecaseutil.Wrap(err, "ChallengeUseCase - applyDecoyPolicy - CountByTeamAndKind")
+	}
+	if hits < int64(policy.Threshold) {
+		return entity.DecoyActionNone, nil
+	}
+	switch policy.Action {
+	case entity.DecoyActionBan:
+		err = uc.teamRepo.Ban(ctx, teamID, decoyBanReason)
+	case entity.DecoyActionHide:
+		err = uc.teamRepo.SetHidden(ctx, teamID, true)
+	}
+	if err != nil {
+		return entity.DecoyActionNone, usecaseutil.Wrap(err, "ChallengeUseCase - applyDecoyPolicy")
+	}
+	uc.submitInvalidateCache(ctx)
+	return policy.Action, nil
+}
+
+func (uc *ChallengeUseCase) logDecoyError(err error, step string) {
+	if uc.logger != nil {
+		uc.logger.WithError(err).Error("ChallengeUseCase - SubmitFlag - " + step)
+	}
+}
@@ -0,0 +1,59 @@
+package challenge
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/mock"
+)
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithDecoys(opts ...ChallengeUCOption) *ChallengeUseCase {
+	h.t.Helper()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		append([]ChallengeUCOption{
+			WithSolveRepo(h.deps.solveRepo),
+			WithTxRepo(h.deps.txRepo),
+			WithCompetitionRepo(h.deps.compRepo),
+			WithTeamRepo(h.deps.teamRepo),
+			WithFlagRepo(h.deps.flagRepo),
+			WithCheatIncidentRepo(h.deps.cheatRepo),
+			WithDecoyRepo(h.deps.decoyRepo),
+			WithConfigRepo(h.deps.configRepo),
+			WithAdminBroadcaster(h.deps.alerts),
+		}, opts...)...,
+	)
+}
+
+func (h *ChallengeTestHelper) NewDecoyFlag(challengeID uuid.UUID, value string) *entity.DecoyFlag {
+	h.t.Helper()
+	return &entity.DecoyFlag{ID: uuid.New(), ChallengeID: challengeID, FlagHash: h.Sha256Hash(value)}
+}
+
+// ExpectDecoyPolicy makes the stored decoy policy p, or leaves it unset when p is nil.
+func (h *ChallengeTestHelper) ExpectDecoyPolicy(p *entity.DecoyPolicy) {
+	h.t.Helper()
+	if p == nil {
+		h.deps.configRepo.On("GetByKey", mock.Anything, entity.DecoyPolicyConfigKey).Return(nil, entityError.ErrConfigNotFound)
+		return
+	}
+	value, err := json.Marshal(p)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	h.deps.configRepo.On("GetByKey", mock.Anything, entity.DecoyPolicyConfigKey).Return(&entity.Config{
+		Key: entity.DecoyPolicyConfigKey, Value: string(value), ValueType: entity.ConfigTypeJSON,
+	}, nil)
+}
+
+// ExpectDecoySubmit sets up a wrong submission by teamID to challenge that has decoys planted.
+func (h *ChallengeTestHelper) ExpectDecoySubmit(challenge *entity.Challenge, teamID uuid.UUID, decoys ...*entity.DecoyFlag) {
+	h.t.Helper()
+	h.deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	h.deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	h.deps.challengeRepo.On("GetByID", mock.Anything, challenge.ID).Return(challenge, nil)
+	h.deps.flagRepo.On("GetByChallengeID", mock.Anything, challenge.ID).Return(nil, nil).Maybe()
+	h.deps.decoyRepo.On("GetByChallengeID", mock.Anything, challenge.ID).Return(decoys, nil)
+}
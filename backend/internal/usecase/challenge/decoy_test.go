@@ -0,0 +1,189 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeUseCase_CreateDecoy(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	c := h.NewChallenge(uuid.New(), "Trap", "Web", 100, h.Sha256Hash("flag{real}"))
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.flagRepo.On("GetByChallengeID", mock.Anything, c.ID).Return(nil, nil)
+	deps.decoyRepo.On("Create", mock.Anything, mock.MatchedBy(func(d *entity.DecoyFlag) bool {
+		return d.ChallengeID == c.ID && d.FlagHash == h.Sha256Hash("flag{leaked}") && d.Note == "pastebin"
+	})).Return(nil)
+
+	decoy, err := uc.CreateDecoy(context.Background(), c.ID, " flag{leaked} ", false, " pastebin ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "pastebin", decoy.Note)
+}
+
+func TestChallengeUseCase_CreateDecoy_Empty(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithDecoys()
+
+	_, err := uc.CreateDecoy(context.Background(), uuid.New(), "  ", false, "")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidDecoyFlag)
+}
+
+func TestChallengeUseCase_CreateDecoy_RejectsAcceptedFlag(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	c := h.NewChallenge(uuid.New(), "Trap", "Web", 100, h.Sha256Hash("flag{real}"))
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+
+	_, err := uc.CreateDecoy(context.Background(), c.ID, "flag{real}", false, "")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidDecoyFlag)
+	deps.decoyRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SetDecoyPolicy_Invalid(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithDecoys()
+
+	_, err := uc.SetDecoyPolicy(context.Background(), entity.DecoyPolicy{Action: entity.DecoyActionBan, Threshold: 0})
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidDecoyPolicy)
+}
+
+func TestChallengeUseCase_GetDecoyPolicy_Default(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	h.ExpectDecoyPolicy(nil)
+
+	policy, err := uc.GetDecoyPolicy(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.DefaultDecoyPolicy(), policy)
+}
+
+func TestChallengeUseCase_SubmitFlag_Decoy_RecordsIncident(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	teamID, userID := uuid.New(), uuid.New()
+	c := h.NewChallenge(uuid.New(), "Trap", "Web", 100, h.Sha256Hash("flag{real}"))
+	decoy := h.NewDecoyFlag(c.ID, "flag{leaked}")
+	h.ExpectDecoySubmit(c, teamID, decoy)
+	h.ExpectDecoyPolicy(nil)
+	deps.cheatRepo.On("Create", mock.Anything, mock.MatchedBy(func(i *entity.CheatIncident) bool {
+		return i.Kind == entity.CheatIncidentDecoyFlag && i.TeamID == teamID && *i.UserID == userID &&
+			*i.ChallengeID == c.ID && i.Evidence.Flag == "flag{leaked}" &&
+			i.Fingerprint == "decoy_flag:"+decoy.ID.String()+":"+teamID.String()
+	})).Return(nil)
+	deps.alerts.On("NotifyCheatIncident", mock.Anything, "decoy_flag", "high", teamID, mock.Anything, "").Return()
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{leaked}", userID, &teamID)
+
+	require.NoError(t, err)
+	assert.False(t, valid)
+	deps.teamRepo.AssertNotCalled(t, "Ban", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SubmitFlag_Decoy_OutsideFlagFormat(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	teamID, userID := uuid.New(), uuid.New()
+	c := h.NewChallenge(uuid.New(), "Trap", "Web", 100, h.Sha256Hash("flag{real}"))
+	format := `^flag\{.+\}$`
+	c.FlagFormatRegex = &format
+	decoy := h.NewDecoyFlag(c.ID, "admin:hunter2")
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.decoyRepo.On("GetByChallengeID", mock.Anything, c.ID).Return([]*entity.DecoyFlag{decoy}, nil)
+	h.ExpectDecoyPolicy(nil)
+	deps.cheatRepo.On("Create", mock.Anything, mock.MatchedBy(func(i *entity.CheatIncident) bool {
+		return i.Kind == entity.CheatIncidentDecoyFlag && i.Evidence.Flag == "admin:hunter2"
+	})).Return(nil)
+	deps.alerts.On("NotifyCheatIncident", mock.Anything, "decoy_flag", "high", teamID, mock.Anything, "").Return()
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "admin:hunter2", userID, &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidFlagFormat)
+	assert.False(t, valid)
+	deps.cheatRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestChallengeUseCase_SubmitFlag_Decoy_BansAtThreshold(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	teamID := uuid.New()
+	c := h.NewChallenge(uuid.New(), "Trap", "Web", 100, h.Sha256Hash("flag{real}"))
+	h.ExpectDecoySubmit(c, teamID, h.NewDecoyFlag(c.ID, "flag{other}"), h.NewDecoyFlag(c.ID, "flag{leaked}"))
+	h.ExpectDecoyPolicy(&entity.DecoyPolicy{Action: entity.DecoyActionBan, Threshold: 2})
+	deps.cheatRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	deps.cheatRepo.On("CountByTeamAndKind", mock.Anything, teamID, entity.CheatIncidentDecoyFlag).Return(int64(2), nil)
+	deps.teamRepo.On("Ban", mock.Anything, teamID, decoyBanReason).Return(nil)
+	deps.alerts.On("NotifyCheatIncident", mock.Anything, "decoy_flag", "high", teamID, mock.Anything, "ban").Return()
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{leaked}", uuid.New(), &teamID)
+
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_Decoy_BelowThreshold(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	teamID := uuid.New()
+	c := h.NewChallenge(uuid.New(), "Trap", "Web", 100, h.Sha256Hash("flag{real}"))
+	h.ExpectDecoySubmit(c, teamID, h.NewDecoyFlag(c.ID, "flag{leaked}"))
+	h.ExpectDecoyPolicy(&entity.DecoyPolicy{Action: entity.DecoyActionHide, Threshold: 3})
+	deps.cheatRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	deps.cheatRepo.On("CountByTeamAndKind", mock.Anything, teamID, entity.CheatIncidentDecoyFlag).Return(int64(1), nil)
+	deps.alerts.On("NotifyCheatIncident", mock.Anything, "decoy_flag", "high", teamID, mock.Anything, "").Return()
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{leaked}", uuid.New(), &teamID)
+
+	require.NoError(t, err)
+	assert.False(t, valid)
+	deps.teamRepo.AssertNotCalled(t, "SetHidden", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SubmitFlag_Decoy_RepeatIsSilent(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	teamID := uuid.New()
+	c := h.NewChallenge(uuid.New(), "Trap", "Web", 100, h.Sha256Hash("flag{real}"))
+	h.ExpectDecoySubmit(c, teamID, h.NewDecoyFlag(c.ID, "flag{leaked}"))
+	deps.cheatRepo.On("Create", mock.Anything, mock.Anything).Return(entityError.ErrCheatIncidentExists)
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{leaked}", uuid.New(), &teamID)
+
+	require.NoError(t, err)
+	assert.False(t, valid)
+	deps.alerts.AssertNotCalled(t, "NotifyCheatIncident", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SubmitFlag_WrongNotDecoy(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys()
+	teamID := uuid.New()
+	c := h.NewChallenge(uuid.New(), "Trap", "Web", 100, h.Sha256Hash("flag{real}"))
+	h.ExpectDecoySubmit(c, teamID, h.NewDecoyFlag(c.ID, "flag{leaked}"))
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{guess}", uuid.New(), &teamID)
+
+	require.NoError(t, err)
+	assert.False(t, valid)
+	deps.cheatRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
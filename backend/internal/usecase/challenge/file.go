@@ -20,17 +20,22 @@ type FileUseCase struct {
 	fileRepo repo.FileRepository
 	storage  storage.Provider
 	expiry   time.Duration
+	access   AccessChecker
 }
 
+// NewFileUseCase builds the use case; access hides files of challenges a team cannot see, and
+// a nil access skips that check.
 func NewFileUseCase(
 	fileRepo repo.FileRepository,
 	storageProvider storage.Provider,
 	presignedExpiry time.Duration,
+	access AccessChecker,
 ) *FileUseCase {
 	return &FileUseCase{
 		fileRepo: fileRepo,
 		storage:  storageProvider,
 		expiry:   presignedExpiry,
+		access:   access,
 	}
 }
 
@@ -86,11 +91,15 @@ func (uc *FileUseCase) Download(ctx context.Context, path string) (io.ReadCloser
 	return uc.storage.Download(ctx, path)
 }
 
-func (uc *FileUseCase) GetDownloadURL(ctx context.Context, fileID uuid.UUID) (string, error) {
+// GetDownloadURL presigns a download of a file of a challenge teamID may access.
+func (uc *FileUseCase) GetDownloadURL(ctx context.Context, fileID uuid.UUID, teamID *uuid.UUID) (string, error) {
 	file, err := uc.fileRepo.GetByID(ctx, fileID)
 	if err != nil {
 		return "", usecaseutil.Wrap(err, "FileUseCase - GetDownloadURL - GetByID")
 	}
+	if err := uc.checkAccess(ctx, file.ChallengeID, teamID); err != nil {
+		return "", err
+	}
 
 	url, err := uc.storage.GetPresignedURL(ctx, file.Location, uc.expiry)
 	if err != nil {
@@ -100,7 +109,28 @@ func (uc *FileUseCase) GetDownloadURL(ctx context.Context, fileID uuid.UUID) (st
 	return url, nil
 }
 
-func (uc *FileUseCase) GetByChallengeID(ctx context.Context, challengeID uuid.UUID, fileType entity.FileType) ([]*entity.File, error) {
+// RecordDownload notes that a user fetched a file, so the anti-cheat analysis can tell who
+// solved a challenge without its files.
+func (uc *FileUseCase) RecordDownload(ctx context.Context, download *entity.FileDownload) error {
+	if err := uc.fileRepo.RecordDownload(ctx, download); err != nil {
+		return usecaseutil.Wrap(err, "FileUseCase - RecordDownload")
+	}
+	return nil
+}
+
+func (uc *FileUseCase) GetByID(ctx context.Context, fileID uuid.UUID) (*entity.File, error) {
+	file, err := uc.fileRepo.GetByID(ctx, fileID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "FileUseCase - GetByID")
+	}
+	return file, nil
+}
+
+// GetByChallengeID lists the files of a challenge teamID may access.
+func (uc *FileUseCase) GetByChallengeID(ctx context.Context, challengeID uuid.UUID, teamID *uuid.UUID, fileType entity.FileType) ([]*entity.File, error) {
+	if err := uc.checkAccess(ctx, challengeID, teamID); err != nil {
+		return nil, err
+	}
 	files, err := uc.fileRepo.GetByChallengeID(ctx, challengeID, fileType)
 	if err != nil {
 		return nil, usecaseutil.Wrap(err, "FileUseCase - GetByChallengeID")
@@ -124,3 +154,10 @@ func (uc *FileUseCase) Delete(ctx context.Context, fileID uuid.UUID) error {
 
 	return nil
 }
+
+func (uc *FileUseCase) checkAccess(ctx context.Context, challengeID uuid.UUID, teamID *uuid.UUID) error {
+	if uc.access == nil {
+		return nil
+	}
+	return uc.access.CheckAccess(ctx, challengeID, teamID)
+}
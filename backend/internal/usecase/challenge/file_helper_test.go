@@ -6,5 +6,13 @@ import (
 
 func (h *ChallengeTestHelper) CreateFileUseCase() *FileUseCase {
 	h.t.Helper()
-	return NewFileUseCase(h.deps.fileRepo, h.deps.s3Provider, time.Hour)
+	return NewFileUseCase(h.deps.fileRepo, h.deps.s3Provider, time.Hour, nil)
+}
+
+// CreateFileUseCaseWithAccess returns a FileUseCase that checks access through a ChallengeUseCase
+// with unlock requirements.
+func (h *ChallengeTestHelper) CreateFileUseCaseWithAccess() *FileUseCase {
+	h.t.Helper()
+	access, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+	return NewFileUseCase(h.deps.fileRepo, h.deps.s3Provider, time.Hour, access)
 }
@@ -134,7 +134,7 @@ func TestFileUseCase_GetDownloadURL(t *testing.T) {
 		deps.fileRepo.On("GetByID", ctx, fileID).Return(fileEntity, nil)
 		deps.s3Provider.On("GetPresignedURL", ctx, fileEntity.Location, time.Hour).Return(expectedURL, nil)
 
-		url, err := uc.GetDownloadURL(ctx, fileID)
+		url, err := uc.GetDownloadURL(ctx, fileID, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedURL, url)
 
@@ -152,7 +152,7 @@ func TestFileUseCase_GetDownloadURL(t *testing.T) {
 
 		deps.fileRepo.On("GetByID", ctx, fileID).Return(nil, entityError.ErrFileNotFound)
 
-		url, err := uc.GetDownloadURL(ctx, fileID)
+		url, err := uc.GetDownloadURL(ctx, fileID, nil)
 		assert.Error(t, err)
 		assert.Empty(t, url)
 		assert.Contains(t, err.Error(), "GetByID")
@@ -178,7 +178,7 @@ func TestFileUseCase_GetByChallengeID(t *testing.T) {
 
 		deps.fileRepo.On("GetByChallengeID", ctx, challengeID, fileType).Return(expectedFiles, nil)
 
-		files, err := uc.GetByChallengeID(ctx, challengeID, fileType)
+		files, err := uc.GetByChallengeID(ctx, challengeID, nil, fileType)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedFiles, files)
 
@@ -197,7 +197,7 @@ func TestFileUseCase_GetByChallengeID(t *testing.T) {
 
 		deps.fileRepo.On("GetByChallengeID", ctx, challengeID, fileType).Return(nil, expectedErr)
 
-		files, err := uc.GetByChallengeID(ctx, challengeID, fileType)
+		files, err := uc.GetByChallengeID(ctx, challengeID, nil, fileType)
 		assert.Error(t, err)
 		assert.Nil(t, files)
 
@@ -205,6 +205,25 @@ func TestFileUseCase_GetByChallengeID(t *testing.T) {
 	})
 }
 
+func TestFileUseCase_LockedChallenge(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateFileUseCaseWithAccess()
+	challengeID, teamID, fileID := uuid.New(), uuid.New(), uuid.New()
+	h.ExpectLockedChallenge(challengeID, teamID)
+	deps.fileRepo.On("GetByID", mock.Anything, fileID).Return(&entity.File{ID: fileID, ChallengeID: challengeID, Location: "files/a.zip"}, nil)
+
+	files, err := uc.GetByChallengeID(context.Background(), challengeID, &teamID, entity.FileTypeChallenge)
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+	assert.Nil(t, files)
+
+	url, err := uc.GetDownloadURL(context.Background(), fileID, &teamID)
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+	assert.Empty(t, url)
+	deps.fileRepo.AssertNotCalled(t, "GetByChallengeID", mock.Anything, mock.Anything, mock.Anything)
+	deps.s3Provider.AssertNotCalled(t, "GetPresignedURL", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestFileUseCase_Delete(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		h := NewChallengeTestHelper(t)
@@ -0,0 +1,110 @@
+package challenge
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/pkg/crypto"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// ListFlags returns the additional flags of a challenge; its primary flag is not included.
+func (uc *ChallengeUseCase) ListFlags(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeFlag, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ListFlags - GetByID")
+	}
+	flags, err := uc.flagRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ListFlags")
+	}
+	return flags, nil
+}
+
+func (uc *ChallengeUseCase) GetFlag(ctx context.Context, ID uuid.UUID) (*entity.ChallengeFlag, error) {
+	flag, err := uc.flagRepo.GetByID(ctx, ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetFlag")
+	}
+	return flag, nil
+}
+
+// CreateFlag adds a flag the challenge accepts besides its primary one.
+func (uc *ChallengeUseCase) CreateFlag(ctx context.Context, challengeID uuid.UUID, flagType entity.FlagType, value string, isCaseInsensitive bool) (*entity.ChallengeFlag, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - CreateFlag - GetByID")
+	}
+	flag := &entity.ChallengeFlag{ChallengeID: challengeID}
+	if err := uc.applyFlagValue(flag, flagType, value, isCaseInsensitive); err != nil {
+		return nil, err
+	}
+	if err := uc.flagRepo.Create(ctx, flag); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - CreateFlag")
+	}
+	return flag, nil
+}
+
+// UpdateFlag replaces the type, value and case sensitivity of a flag.
+func (uc *ChallengeUseCase) UpdateFlag(ctx context.Context, ID uuid.UUID, flagType entity.FlagType, value string, isCaseInsensitive bool) (*entity.ChallengeFlag, error) {
+	flag, err := uc.flagRepo.GetByID(ctx, ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - UpdateFlag - GetByID")
+	}
+	if err := uc.applyFlagValue(flag, flagType, value, isCaseInsensitive); err != nil {
+		return nil, err
+	}
+	if err := uc.flagRepo.Update(ctx, flag); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - UpdateFlag")
+	}
+	return flag, nil
+}
+
+func (uc *ChallengeUseCase) DeleteFlag(ctx context.Context, ID uuid.UUID) error {
+	if err := uc.flagRepo.Delete(ctx, ID); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - DeleteFlag")
+	}
+	return nil
+}
+
+// applyFlagValue stores value on flag the way submissions are checked: static values hashed,
+// regex patterns validated and encrypted.
+func (uc *ChallengeUseCase) applyFlagValue(flag *entity.ChallengeFlag, flagType entity.FlagType, value string, isCaseInsensitive bool) error {
+	value = strings.TrimSpace(value)
+	if !flagType.IsValid() || value == "" {
+		return entityError.ErrInvalidChallengeFlag
+	}
+	flag.Type = flagType
+	flag.IsCaseInsensitive = isCaseInsensitive
+	if flagType == entity.FlagTypeStatic {
+		flag.FlagHash = hashFlag(value, isCaseInsensitive)
+		flag.FlagRegex = ""
+		return nil
+	}
+	if _, err := regexp.Compile(value); err != nil {
+		return entityError.ErrInvalidChallengeFlag
+	}
+	if uc.crypto == nil {
+		return usecaseutil.Wrap(crypto.ErrServiceNotConfigured, "ChallengeUseCase - applyFlagValue")
+	}
+	encrypted, err := uc.crypto.Encrypt(value)
+	if err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - applyFlagValue - Encrypt")
+	}
+	flag.FlagRegex = encrypted
+	flag.FlagHash = ""
+	return nil
+}
+
+// hashFlag returns the hex SHA-256 stored for a static flag.
+func hashFlag(value string, isCaseInsensitive bool) string {
+	if isCaseInsensitive {
+		value = strings.ToLower(strings.TrimSpace(value))
+	}
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
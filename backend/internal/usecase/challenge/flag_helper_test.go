@@ -0,0 +1,20 @@
+package challenge
+
+import (
+	"github.com/go-redis/redismock/v9"
+)
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithFlags() (*ChallengeUseCase, redismock.ClientMock) {
+	h.t.Helper()
+	client, redis := redismock.NewClientMock()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		WithSolveRepo(h.deps.solveRepo),
+		WithTxRepo(h.deps.txRepo),
+		WithCompetitionRepo(h.deps.compRepo),
+		WithTeamRepo(h.deps.teamRepo),
+		WithRedis(client),
+		WithCrypto(h.deps.crypto),
+		WithFlagRepo(h.deps.flagRepo),
+	), redis
+}
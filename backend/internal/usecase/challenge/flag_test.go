@@ -0,0 +1,161 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeUseCase_CreateFlag_Static(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithFlags()
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Test", "Web", 100, ""), nil)
+	deps.flagRepo.On("Create", mock.Anything, mock.MatchedBy(func(f *entity.ChallengeFlag) bool {
+		return f.ChallengeID == challengeID && f.Type == entity.FlagTypeStatic && f.FlagHash == h.Sha256Hash("flag{alt}")
+	})).Return(nil)
+
+	flag, err := uc.CreateFlag(context.Background(), challengeID, entity.FlagTypeStatic, " FLAG{Alt} ", true)
+
+	require.NoError(t, err)
+	assert.True(t, flag.IsCaseInsensitive)
+	assert.Empty(t, flag.FlagRegex)
+}
+
+func TestChallengeUseCase_CreateFlag_Regex(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithFlags()
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Test", "Web", 100, ""), nil)
+	deps.crypto.On("Encrypt", "^flag\\{[0-9]+\\}$").Return("encrypted", nil)
+	deps.flagRepo.On("Create", mock.Anything, mock.MatchedBy(func(f *entity.ChallengeFlag) bool {
+		return f.Type == entity.FlagTypeRegex && f.FlagRegex == "encrypted" && f.FlagHash == ""
+	})).Return(nil)
+
+	_, err := uc.CreateFlag(context.Background(), challengeID, entity.FlagTypeRegex, "^flag\\{[0-9]+\\}$", false)
+
+	assert.NoError(t, err)
+}
+
+func TestChallengeUseCase_CreateFlag_Invalid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		flagType entity.FlagType
+		value    string
+	}{
+		"bad regex":    {entity.FlagTypeRegex, "flag{("},
+		"empty value":  {entity.FlagTypeStatic, "  "},
+		"unknown type": {entity.FlagType("glob"), "flag*"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			h := NewChallengeTestHelper(t)
+			deps := h.Deps()
+			uc, _ := h.CreateChallengeUseCaseWithFlags()
+
+			challengeID := uuid.New()
+			deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Test", "Web", 100, ""), nil)
+
+			_, err := uc.CreateFlag(context.Background(), challengeID, tc.flagType, tc.value, false)
+
+			assert.ErrorIs(t, err, entityError.ErrInvalidChallengeFlag)
+		})
+	}
+}
+
+func TestChallengeUseCase_CreateFlag_ChallengeNotFound(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithFlags()
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(nil, entityError.ErrChallengeNotFound)
+
+	_, err := uc.CreateFlag(context.Background(), challengeID, entity.FlagTypeStatic, "flag{alt}", false)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+}
+
+func TestChallengeUseCase_UpdateFlag_NotFound(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithFlags()
+
+	flagID := uuid.New()
+	deps.flagRepo.On("GetByID", mock.Anything, flagID).Return(nil, entityError.ErrChallengeFlagNotFound)
+
+	_, err := uc.UpdateFlag(context.Background(), flagID, entity.FlagTypeStatic, "flag{alt}", false)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeFlagNotFound)
+}
+
+func TestChallengeUseCase_SubmitFlag_AlternativeFlag(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithFlags()
+
+	challengeID := uuid.New()
+	teamID := uuid.New()
+	userID := uuid.New()
+	challenge := h.NewChallenge(challengeID, "Test Challenge", "Web", 100, h.Sha256Hash("flag{primary}"))
+
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(challenge, nil)
+	deps.flagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeFlag{
+		{ChallengeID: challengeID, Type: entity.FlagTypeRegex, FlagRegex: "encrypted"},
+		{ChallengeID: challengeID, Type: entity.FlagTypeStatic, FlagHash: h.Sha256Hash("flag{alt}"), IsCaseInsensitive: true},
+	}, nil)
+	deps.crypto.On("Decrypt", "encrypted").Return("^flag\\{[0-9]+\\}$", nil)
+	deps.txRepo.On("RunTransaction", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		ctx, ok := args.Get(0).(context.Context)
+		if !ok {
+			return
+		}
+		fn, ok := args.Get(1).(func(context.Context, repo.Transaction) error)
+		if !ok {
+			return
+		}
+		_ = fn(ctx, nil) //nolint:errcheck
+	})
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challengeID).Return(nil, entityError.ErrSolveNotFound)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challengeID).Return(challenge, nil)
+	deps.txRepo.On("CreateSolveTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	deps.txRepo.On("IncrementChallengeSolveCountTx", mock.Anything, mock.Anything, challengeID).Return(1, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challengeID, "FLAG{Alt}", userID, &teamID)
+
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_NoFlagMatches(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithFlags()
+
+	challengeID := uuid.New()
+	teamID := uuid.New()
+	challenge := h.NewChallenge(challengeID, "Test Challenge", "Web", 100, h.Sha256Hash("flag{primary}"))
+
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(challenge, nil)
+	deps.flagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeFlag{
+		{ChallengeID: challengeID, Type: entity.FlagTypeStatic, FlagHash: h.Sha256Hash("flag{alt}")},
+	}, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challengeID, "FLAG{alt}", uuid.New(), &teamID)
+
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
@@ -20,6 +20,8 @@ type HintDeps struct {
 	TxRepo          repo.TxRepository
 	SolveRepo       repo.SolveRepository
 	ScoreboardCache cache.ScoreboardCacheInvalidator
+	// Access hides hints of challenges the team cannot see; nil skips the check.
+	Access AccessChecker
 }
 
 type HintUseCase struct {
@@ -54,6 +56,9 @@ func (uc *HintUseCase) GetByID(ctx context.Context, ID uuid.UUID) (*entity.Hint,
 }
 
 func (uc *HintUseCase) GetByChallengeID(ctx context.Context, challengeID uuid.UUID, teamID *uuid.UUID) ([]*HintWithUnlockStatus, error) {
+	if err := uc.checkAccess(ctx, challengeID, teamID); err != nil {
+		return nil, err
+	}
 	hints, err := uc.deps.HintRepo.GetByChallengeID(ctx, challengeID)
 	if err != nil {
 		return nil, usecaseutil.Wrap(err, "HintUseCase - GetByChallengeID")
@@ -127,6 +132,9 @@ func (uc *HintUseCase) UnlockHint(ctx context.Context, teamID, hintID uuid.UUID)
 		}
 		return nil, usecaseutil.Wrap(err, "HintUseCase - UnlockHint - GetByID")
 	}
+	if err := uc.checkAccess(ctx, hint.ChallengeID, &teamID); err != nil {
+		return nil, err
+	}
 	tx, err := uc.deps.TxRepo.BeginTx(ctx)
 	if err != nil {
 		return nil, usecaseutil.Wrap(err, "HintUseCase - UnlockHint - BeginTx")
@@ -192,3 +200,10 @@ func (uc *HintUseCase) unlockHintChargeIfNeeded(ctx context.Context, tx repo.Tra
 	}
 	return uc.deps.TxRepo.CreateAwardTx(ctx, tx, award)
 }
+
+func (uc *HintUseCase) checkAccess(ctx context.Context, challengeID uuid.UUID, teamID *uuid.UUID) error {
+	if uc.deps.Access == nil {
+		return nil
+	}
+	return uc.deps.Access.CheckAccess(ctx, challengeID, teamID)
+}
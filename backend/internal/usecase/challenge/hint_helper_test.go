@@ -15,6 +15,17 @@ func (h *ChallengeTestHelper) CreateHintUseCase() (*HintUseCase, redismock.Clien
 	}), redis
 }
 
+// CreateHintUseCaseWithAccess returns a HintUseCase that checks access through a ChallengeUseCase
+// with unlock requirements.
+func (h *ChallengeTestHelper) CreateHintUseCaseWithAccess() *HintUseCase {
+	h.t.Helper()
+	access, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+	return NewHintUseCase(HintDeps{
+		HintRepo: h.deps.hintRepo, HintUnlockRepo: h.deps.hintUnlockRepo, AwardRepo: h.deps.awardRepo,
+		TxRepo: h.deps.txRepo, SolveRepo: h.deps.solveRepo, Access: access,
+	})
+}
+
 func (h *ChallengeTestHelper) NewHint(id, challengeID uuid.UUID, content string, cost, orderIndex int) *entity.Hint {
 	h.t.Helper()
 	return &entity.Hint{
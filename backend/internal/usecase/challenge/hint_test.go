@@ -142,6 +142,20 @@ func TestHintUseCase_GetByChallengeID_UnlockRepoError(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestHintUseCase_GetByChallengeID_Locked(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateHintUseCaseWithAccess()
+	challengeID, teamID := uuid.New(), uuid.New()
+	h.ExpectLockedChallenge(challengeID, teamID)
+
+	result, err := uc.GetByChallengeID(context.Background(), challengeID, &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+	assert.Nil(t, result)
+	deps.hintRepo.AssertNotCalled(t, "GetByChallengeID", mock.Anything, mock.Anything)
+}
+
 func TestHintUseCase_Update_Success(t *testing.T) {
 	h := NewChallengeTestHelper(t)
 	deps := h.Deps()
@@ -299,6 +313,21 @@ func TestHintUseCase_UnlockHint_NotFound(t *testing.T) {
 	assert.Nil(t, unlocked)
 }
 
+func TestHintUseCase_UnlockHint_Locked(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateHintUseCaseWithAccess()
+	challengeID, teamID, hintID := uuid.New(), uuid.New(), uuid.New()
+	h.ExpectLockedChallenge(challengeID, teamID)
+	deps.hintRepo.On("GetByID", mock.Anything, hintID).Return(h.NewHint(hintID, challengeID, "Look closer", 10, 0), nil)
+
+	unlocked, err := uc.UnlockHint(context.Background(), teamID, hintID)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+	assert.Nil(t, unlocked)
+	deps.txRepo.AssertNotCalled(t, "BeginTx", mock.Anything)
+}
+
 func TestHintUseCase_UnlockHint_BeginTxError(t *testing.T) {
 	h := NewChallengeTestHelper(t)
 	deps := h.Deps()
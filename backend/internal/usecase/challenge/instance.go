@@ -0,0 +1,291 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/instance"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/logger"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// InstanceUseCase manages per-team challenge environments. The database row is the source of
+// truth for ownership, limits and expiry; the provider only runs what the rows describe.
+type InstanceUseCase struct {
+	challengeRepo repo.ChallengeRepository
+	instanceRepo  repo.InstanceRepository
+	provider      instance.Provider
+	access        AccessChecker
+	maxPerTeam    int
+	logger        logger.Logger
+}
+
+// NewInstanceUseCase builds the use case; a nil provider disables participant instances while
+// keeping the admin configuration endpoints usable. Participants only reach instances of
+// challenges access lets their team see.
+func NewInstanceUseCase(
+	challengeRepo repo.ChallengeRepository,
+	instanceRepo repo.InstanceRepository,
+	provider instance.Provider,
+	access AccessChecker,
+	maxPerTeam int,
+	l logger.Logger,
+) *InstanceUseCase {
+	return &InstanceUseCase{
+		challengeRepo: challengeRepo,
+		instanceRepo:  instanceRepo,
+		provider:      provider,
+		access:        access,
+		maxPerTeam:    maxPerTeam,
+		logger:        l,
+	}
+}
+
+func (uc *InstanceUseCase) GetConfig(ctx context.Context, challengeID uuid.UUID) (*entity.InstanceConfig, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - GetConfig - GetByID")
+	}
+	cfg, err := uc.instanceRepo.GetConfig(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - GetConfig")
+	}
+	return cfg, nil
+}
+
+func (uc *InstanceUseCase) SetConfig(ctx context.Context, challengeID uuid.UUID, image string, ttlSeconds int) (*entity.InstanceConfig, error) {
+	image = strings.TrimSpace(image)
+	if image == "" || ttlSeconds <= 0 {
+		return nil, entityError.ErrInvalidInstanceConfig
+	}
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - SetConfig - GetByID")
+	}
+	cfg := &entity.InstanceConfig{ChallengeID: challengeID, Image: image, TTLSeconds: ttlSeconds}
+	if err := uc.instanceRepo.UpsertConfig(ctx, cfg); err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - SetConfig")
+	}
+	saved, err := uc.instanceRepo.GetConfig(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - SetConfig - Reload")
+	}
+	return saved, nil
+}
+
+// DeleteConfig stops offering instances for a challenge. Running instances are left to expire.
+func (uc *InstanceUseCase) DeleteConfig(ctx context.Context, challengeID uuid.UUID) error {
+	if err := uc.instanceRepo.DeleteConfig(ctx, challengeID); err != nil {
+		return usecaseutil.Wrap(err, "InstanceUseCase - DeleteConfig")
+	}
+	return nil
+}
+
+// Get returns the team's running instance of a challenge with its live status.
+func (uc *InstanceUseCase) Get(ctx context.Context, challengeID, teamID uuid.UUID) (*entity.ChallengeInstance, error) {
+	if _, err := uc.availableConfig(ctx, challengeID, teamID); err != nil {
+		return nil, err
+	}
+	return uc.current(ctx, challengeID, teamID)
+}
+
+// Start launches an instance for the team, or returns the one already running. The row is
+// inserted before the provider is asked, and the repository serializes inserts per team, so
+// concurrent starts cannot exceed the per-team limit.
+func (uc *InstanceUseCase) Start(ctx context.Context, challengeID, teamID uuid.UUID) (*entity.ChallengeInstance, error) {
+	cfg, err := uc.availableConfig(ctx, challengeID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := uc.current(ctx, challengeID, teamID)
+	if err == nil && existing.ExpiresAt.After(time.Now()) {
+		return existing, nil
+	}
+	if err != nil && !errors.Is(err, entityError.ErrInstanceNotFound) {
+		return nil, err
+	}
+	if existing != nil {
+		if err := uc.remove(ctx, existing); err != nil {
+			return nil, usecaseutil.Wrap(err, "InstanceUseCase - Start - RemoveExpired")
+		}
+	}
+
+	inst := &entity.ChallengeInstance{
+		ChallengeID: challengeID,
+		TeamID:      teamID,
+		Status:      string(instance.StatusStarting),
+		ExpiresAt:   time.Now().UTC().Add(cfg.TTL()),
+	}
+	if err := uc.instanceRepo.Create(ctx, inst, uc.maxPerTeam); err != nil {
+		if errors.Is(err, entityError.ErrInstanceLimitReached) {
+			if concurrent, getErr := uc.instanceRepo.GetByChallengeAndTeam(ctx, challengeID, teamID); getErr == nil {
+				concurrent.Status = string(instance.StatusStarting)
+				return concurrent, nil
+			}
+		}
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - Start - Create")
+	}
+
+	started, err := uc.provider.Start(ctx, instance.Spec{
+		Key:       instanceKey(inst),
+		Image:     cfg.Image,
+		ExpiresAt: inst.ExpiresAt,
+	})
+	if err != nil {
+		if delErr := uc.instanceRepo.Delete(ctx, inst.ID); delErr != nil {
+			uc.logger.WithError(delErr).Error("InstanceUseCase - Start - Delete")
+		}
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - Start - Provider")
+	}
+	if err := uc.instanceRepo.UpdateConnectionInfo(ctx, inst.ID, started.ConnectionInfo); err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - Start - UpdateConnectionInfo")
+	}
+	inst.ConnectionInfo = started.ConnectionInfo
+	inst.Status = string(started.Status)
+	return inst, nil
+}
+
+// Extend resets the instance lifetime to a full TTL from now.
+func (uc *InstanceUseCase) Extend(ctx context.Context, challengeID, teamID uuid.UUID) (*entity.ChallengeInstance, error) {
+	cfg, err := uc.availableConfig(ctx, challengeID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	inst, err := uc.current(ctx, challengeID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().UTC().Add(cfg.TTL())
+	if err := uc.provider.Extend(ctx, instanceKey(inst), expiresAt); err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - Extend - Provider")
+	}
+	if err := uc.instanceRepo.UpdateExpiry(ctx, inst.ID, expiresAt); err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - Extend - UpdateExpiry")
+	}
+	inst.ExpiresAt = expiresAt
+	return inst, nil
+}
+
+func (uc *InstanceUseCase) Stop(ctx context.Context, challengeID, teamID uuid.UUID) error {
+	if uc.provider == nil {
+		return entityError.ErrInstancesDisabled
+	}
+	inst, err := uc.instanceRepo.GetByChallengeAndTeam(ctx, challengeID, teamID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "InstanceUseCase - Stop - Get")
+	}
+	if err := uc.remove(ctx, inst); err != nil {
+		return usecaseutil.Wrap(err, "InstanceUseCase - Stop")
+	}
+	return nil
+}
+
+// RunReaper stops expired instances every interval until ctx is cancelled.
+func (uc *InstanceUseCase) RunReaper(ctx context.Context, interval time.Duration) {
+	if uc.provider == nil || interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.ReapExpired(ctx)
+		}
+	}
+}
+
+// ReapExpired stops every instance past its expiry and returns how many were removed. Failures
+// are logged and retried on the next run.
+func (uc *InstanceUseCase) ReapExpired(ctx context.Context) int {
+	if uc.provider == nil {
+		return 0
+	}
+	expired, err := uc.instanceRepo.GetExpired(ctx, time.Now().UTC())
+	if err != nil {
+		uc.logger.WithError(err).Error("InstanceUseCase - ReapExpired - GetExpired")
+		return 0
+	}
+	reaped := 0
+	for _, inst := range expired {
+		if err := uc.remove(ctx, inst); err != nil {
+			uc.logger.Warn("InstanceUseCase - ReapExpired - remove", map[string]any{
+				"instance_id": inst.ID.String(),
+				"error":       err.Error(),
+			})
+			continue
+		}
+		reaped++
+	}
+	if reaped > 0 {
+		uc.logger.Info("InstanceUseCase - ReapExpired - completed", map[string]any{"reaped": reaped})
+	}
+	return reaped
+}
+
+// availableConfig returns the instance configuration of a challenge teamID can see.
+func (uc *InstanceUseCase) availableConfig(ctx context.Context, challengeID, teamID uuid.UUID) (*entity.InstanceConfig, error) {
+	if uc.provider == nil {
+		return nil, entityError.ErrInstancesDisabled
+	}
+	if err := uc.access.CheckAccess(ctx, challengeID, &teamID); err != nil {
+		return nil, err
+	}
+	cfg, err := uc.instanceRepo.GetConfig(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - availableConfig - GetConfig")
+	}
+	return cfg, nil
+}
+
+func (uc *InstanceUseCase) current(ctx context.Context, challengeID, teamID uuid.UUID) (*entity.ChallengeInstance, error) {
+	inst, err := uc.instanceRepo.GetByChallengeAndTeam(ctx, challengeID, teamID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "InstanceUseCase - current")
+	}
+	if err := uc.refreshStatus(ctx, inst); err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+// refreshStatus copies the provider's view into inst. An instance the provider no longer knows
+// about is forgotten and reported as not found.
+func (uc *InstanceUseCase) refreshStatus(ctx context.Context, inst *entity.ChallengeInstance) error {
+	status, err := uc.provider.Status(ctx, instanceKey(inst))
+	if err != nil {
+		if !errors.Is(err, instance.ErrNotFound) {
+			return usecaseutil.Wrap(err, "InstanceUseCase - refreshStatus")
+		}
+		if inst.ConnectionInfo == "" {
+			inst.Status = string(instance.StatusStarting)
+			return nil
+		}
+		if err := uc.instanceRepo.Delete(ctx, inst.ID); err != nil {
+			return usecaseutil.Wrap(err, "InstanceUseCase - refreshStatus - Delete")
+		}
+		return entityError.ErrInstanceNotFound
+	}
+	inst.Status = string(status.Status)
+	if status.ConnectionInfo != "" {
+		inst.ConnectionInfo = status.ConnectionInfo
+	}
+	return nil
+}
+
+func (uc *InstanceUseCase) remove(ctx context.Context, inst *entity.ChallengeInstance) error {
+	if err := uc.provider.Stop(ctx, instanceKey(inst)); err != nil && !errors.Is(err, instance.ErrNotFound) {
+		return err
+	}
+	return uc.instanceRepo.Delete(ctx, inst.ID)
+}
+
+func instanceKey(inst *entity.ChallengeInstance) instance.Key {
+	return instance.Key{ChallengeID: inst.ChallengeID, TeamID: inst.TeamID}
+}
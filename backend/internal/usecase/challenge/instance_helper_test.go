@@ -0,0 +1,46 @@
+package challenge
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+)
+
+const testInstanceMaxPerTeam = 2
+
+func (h *ChallengeTestHelper) CreateInstanceUseCase() *InstanceUseCase {
+	h.t.Helper()
+	access := NewChallengeUseCase(h.deps.challengeRepo)
+	return NewInstanceUseCase(h.deps.challengeRepo, h.deps.instanceRepo, h.deps.instances, access, testInstanceMaxPerTeam, h.deps.logger)
+}
+
+// CreateInstanceUseCaseWithAccess returns an InstanceUseCase that checks access through a
+// ChallengeUseCase with unlock requirements.
+func (h *ChallengeTestHelper) CreateInstanceUseCaseWithAccess() *InstanceUseCase {
+	h.t.Helper()
+	access, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+	return NewInstanceUseCase(h.deps.challengeRepo, h.deps.instanceRepo, h.deps.instances, access, testInstanceMaxPerTeam, h.deps.logger)
+}
+
+func (h *ChallengeTestHelper) CreateDisabledInstanceUseCase() *InstanceUseCase {
+	h.t.Helper()
+	access := NewChallengeUseCase(h.deps.challengeRepo)
+	return NewInstanceUseCase(h.deps.challengeRepo, h.deps.instanceRepo, nil, access, testInstanceMaxPerTeam, h.deps.logger)
+}
+
+func (h *ChallengeTestHelper) NewInstanceConfig(challengeID uuid.UUID) *entity.InstanceConfig {
+	h.t.Helper()
+	return &entity.InstanceConfig{ChallengeID: challengeID, Image: "pwn-heap:latest", TTLSeconds: 1800}
+}
+
+func (h *ChallengeTestHelper) NewChallengeInstance(challengeID, teamID uuid.UUID, expiresAt time.Time) *entity.ChallengeInstance {
+	h.t.Helper()
+	return &entity.ChallengeInstance{
+		ID:             uuid.New(),
+		ChallengeID:    challengeID,
+		TeamID:         teamID,
+		ConnectionInfo: "localhost:30000",
+		ExpiresAt:      expiresAt,
+	}
+}
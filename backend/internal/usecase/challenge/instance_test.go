@@ -0,0 +1,295 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/instance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceUseCase_SetConfig_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Heap", "Pwn", 500, ""), nil)
+	deps.instanceRepo.On("UpsertConfig", mock.Anything, mock.MatchedBy(func(cfg *entity.InstanceConfig) bool {
+		return cfg.Image == "pwn-heap:latest" && cfg.TTLSeconds == 1800
+	})).Return(nil)
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(h.NewInstanceConfig(challengeID), nil)
+
+	cfg, err := uc.SetConfig(context.Background(), challengeID, " pwn-heap:latest ", 1800)
+
+	require.NoError(t, err)
+	assert.Equal(t, "pwn-heap:latest", cfg.Image)
+}
+
+func TestInstanceUseCase_SetConfig_Invalid(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateInstanceUseCase()
+
+	_, err := uc.SetConfig(context.Background(), uuid.New(), "", 1800)
+	assert.ErrorIs(t, err, entityError.ErrInvalidInstanceConfig)
+
+	_, err = uc.SetConfig(context.Background(), uuid.New(), "web:latest", 0)
+	assert.ErrorIs(t, err, entityError.ErrInvalidInstanceConfig)
+}
+
+func TestInstanceUseCase_Start_Disabled(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateDisabledInstanceUseCase()
+
+	_, err := uc.Start(context.Background(), uuid.New(), uuid.New())
+
+	assert.ErrorIs(t, err, entityError.ErrInstancesDisabled)
+}
+
+func TestInstanceUseCase_Start_HiddenChallenge(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID := uuid.New()
+	challenge := h.NewChallenge(challengeID, "Heap", "Pwn", 500, "")
+	challenge.IsHidden = true
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(challenge, nil)
+
+	_, err := uc.Start(context.Background(), challengeID, uuid.New())
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+}
+
+func TestInstanceUseCase_LockedChallenge(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCaseWithAccess()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	h.ExpectLockedChallenge(challengeID, teamID)
+
+	_, err := uc.Start(context.Background(), challengeID, teamID)
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+	_, err = uc.Get(context.Background(), challengeID, teamID)
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+	_, err = uc.Extend(context.Background(), challengeID, teamID)
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+	deps.instanceRepo.AssertNotCalled(t, "GetConfig", mock.Anything, mock.Anything)
+	deps.instances.AssertNotCalled(t, "Start", mock.Anything, mock.Anything)
+}
+
+func TestInstanceUseCase_Start_NotConfigured(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Heap", "Pwn", 500, ""), nil)
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(nil, entityError.ErrInstanceNotConfigured)
+
+	_, err := uc.Start(context.Background(), challengeID, uuid.New())
+
+	assert.ErrorIs(t, err, entityError.ErrInstanceNotConfigured)
+}
+
+func TestInstanceUseCase_Start_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID, teamID, instanceID := uuid.New(), uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Heap", "Pwn", 500, ""), nil)
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(h.NewInstanceConfig(challengeID), nil)
+	deps.instanceRepo.On("GetByChallengeAndTeam", mock.Anything, challengeID, teamID).Return(nil, entityError.ErrInstanceNotFound)
+	deps.instanceRepo.On("Create", mock.Anything, mock.MatchedBy(func(inst *entity.ChallengeInstance) bool {
+		return inst.TeamID == teamID && time.Until(inst.ExpiresAt) > 29*time.Minute
+	}), testInstanceMaxPerTeam).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.ChallengeInstance).ID = instanceID
+	}).Return(nil)
+	deps.instances.On("Start", mock.Anything, mock.MatchedBy(func(spec instance.Spec) bool {
+		return spec.Image == "pwn-heap:latest" && spec.TeamID == teamID && spec.ChallengeID == challengeID
+	})).Return(&instance.Instance{ConnectionInfo: "localhost:30000", Status: instance.StatusRunning}, nil)
+	deps.instanceRepo.On("UpdateConnectionInfo", mock.Anything, instanceID, "localhost:30000").Return(nil)
+
+	inst, err := uc.Start(context.Background(), challengeID, teamID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:30000", inst.ConnectionInfo)
+	assert.Equal(t, string(instance.StatusRunning), inst.Status)
+}
+
+func TestInstanceUseCase_Start_ReturnsRunning(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	running := h.NewChallengeInstance(challengeID, teamID, time.Now().Add(10*time.Minute))
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Heap", "Pwn", 500, ""), nil)
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(h.NewInstanceConfig(challengeID), nil)
+	deps.instanceRepo.On("GetByChallengeAndTeam", mock.Anything, challengeID, teamID).Return(running, nil)
+	deps.instances.On("Status", mock.Anything, instance.Key{ChallengeID: challengeID, TeamID: teamID}).
+		Return(&instance.Instance{ConnectionInfo: "localhost:30000", Status: instance.StatusRunning}, nil)
+
+	inst, err := uc.Start(context.Background(), challengeID, teamID)
+
+	require.NoError(t, err)
+	assert.Equal(t, running.ID, inst.ID)
+	deps.instanceRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestInstanceUseCase_Start_LimitReached(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Heap", "Pwn", 500, ""), nil)
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(h.NewInstanceConfig(challengeID), nil)
+	deps.instanceRepo.On("GetByChallengeAndTeam", mock.Anything, challengeID, teamID).Return(nil, entityError.ErrInstanceNotFound)
+	deps.instanceRepo.On("Create", mock.Anything, mock.Anything, testInstanceMaxPerTeam).Return(entityError.ErrInstanceLimitReached)
+
+	_, err := uc.Start(context.Background(), challengeID, teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrInstanceLimitReached)
+	deps.instances.AssertNotCalled(t, "Start", mock.Anything, mock.Anything)
+}
+
+func TestInstanceUseCase_Start_ProviderError(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID, teamID, instanceID := uuid.New(), uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Heap", "Pwn", 500, ""), nil)
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(h.NewInstanceConfig(challengeID), nil)
+	deps.instanceRepo.On("GetByChallengeAndTeam", mock.Anything, challengeID, teamID).Return(nil, entityError.ErrInstanceNotFound)
+	deps.instanceRepo.On("Create", mock.Anything, mock.Anything, testInstanceMaxPerTeam).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.ChallengeInstance).ID = instanceID
+	}).Return(nil)
+	deps.instances.On("Start", mock.Anything, mock.Anything).Return(nil, errors.New("runtime unavailable"))
+	deps.instanceRepo.On("Delete", mock.Anything, instanceID).Return(nil)
+
+	_, err := uc.Start(context.Background(), challengeID, teamID)
+
+	assert.Error(t, err)
+	deps.instanceRepo.AssertCalled(t, "Delete", mock.Anything, instanceID)
+}
+
+func TestInstanceUseCase_Get_LostByProvider(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	lost := h.NewChallengeInstance(challengeID, teamID, time.Now().Add(10*time.Minute))
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Heap", "Pwn", 500, ""), nil)
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(h.NewInstanceConfig(challengeID), nil)
+	deps.instanceRepo.On("GetByChallengeAndTeam", mock.Anything, challengeID, teamID).Return(lost, nil)
+	deps.instances.On("Status", mock.Anything, mock.Anything).Return(nil, instance.ErrNotFound)
+	deps.instanceRepo.On("Delete", mock.Anything, lost.ID).Return(nil)
+
+	_, err := uc.Get(context.Background(), challengeID, teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrInstanceNotFound)
+}
+
+func TestInstanceUseCase_Extend_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	running := h.NewChallengeInstance(challengeID, teamID, time.Now().Add(time.Minute))
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Heap", "Pwn", 500, ""), nil)
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(h.NewInstanceConfig(challengeID), nil)
+	deps.instanceRepo.On("GetByChallengeAndTeam", mock.Anything, challengeID, teamID).Return(running, nil)
+	deps.instances.On("Status", mock.Anything, mock.Anything).Return(&instance.Instance{Status: instance.StatusRunning}, nil)
+	deps.instances.On("Extend", mock.Anything, instance.Key{ChallengeID: challengeID, TeamID: teamID}, mock.Anything).Return(nil)
+	deps.instanceRepo.On("UpdateExpiry", mock.Anything, running.ID, mock.Anything).Return(nil)
+
+	inst, err := uc.Extend(context.Background(), challengeID, teamID)
+
+	require.NoError(t, err)
+	assert.Greater(t, time.Until(inst.ExpiresAt), 29*time.Minute)
+}
+
+func TestInstanceUseCase_Stop_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	running := h.NewChallengeInstance(challengeID, teamID, time.Now().Add(time.Minute))
+	deps.instanceRepo.On("GetByChallengeAndTeam", mock.Anything, challengeID, teamID).Return(running, nil)
+	deps.instances.On("Stop", mock.Anything, instance.Key{ChallengeID: challengeID, TeamID: teamID}).Return(nil)
+	deps.instanceRepo.On("Delete", mock.Anything, running.ID).Return(nil)
+
+	err := uc.Stop(context.Background(), challengeID, teamID)
+
+	require.NoError(t, err)
+}
+
+func TestInstanceUseCase_Stop_NotFound(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	deps.instanceRepo.On("GetByChallengeAndTeam", mock.Anything, challengeID, teamID).Return(nil, entityError.ErrInstanceNotFound)
+
+	err := uc.Stop(context.Background(), challengeID, teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrInstanceNotFound)
+}
+
+func TestInstanceUseCase_ReapExpired(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	gone := h.NewChallengeInstance(uuid.New(), uuid.New(), time.Now().Add(-time.Minute))
+	stuck := h.NewChallengeInstance(uuid.New(), uuid.New(), time.Now().Add(-time.Minute))
+	deps.instanceRepo.On("GetExpired", mock.Anything, mock.Anything).Return([]*entity.ChallengeInstance{gone, stuck}, nil)
+	deps.instances.On("Stop", mock.Anything, instanceKey(gone)).Return(instance.ErrNotFound)
+	deps.instances.On("Stop", mock.Anything, instanceKey(stuck)).Return(errors.New("runtime unavailable"))
+	deps.instanceRepo.On("Delete", mock.Anything, gone.ID).Return(nil)
+	deps.logger.On("Warn", "InstanceUseCase - ReapExpired - remove", mock.Anything).Return()
+	deps.logger.On("Info", "InstanceUseCase - ReapExpired - completed", mock.Anything).Return()
+
+	reaped := uc.ReapExpired(context.Background())
+
+	assert.Equal(t, 1, reaped)
+	deps.instanceRepo.AssertNotCalled(t, "Delete", mock.Anything, stuck.ID)
+}
+
+func TestInstanceUseCase_RunReaper_StopsOnCancel(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateInstanceUseCase()
+
+	deps.instanceRepo.On("GetExpired", mock.Anything, mock.Anything).Return([]*entity.ChallengeInstance{}, nil).Maybe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		uc.RunReaper(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("reaper did not stop after cancel")
+	}
+}
@@ -356,3 +356,60 @@ func (_c *MockFileRepository_GetByID_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// RecordDownload provides a mock function for the type MockFileRepository
+func (_mock *MockFileRepository) RecordDownload(ctx context.Context, download *entity.FileDownload) error {
+	ret := _mock.Called(ctx, download)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDownload")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.FileDownload) error); ok {
+		r0 = returnFunc(ctx, download)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFileRepository_RecordDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDownload'
+type MockFileRepository_RecordDownload_Call struct {
+	*mock.Call
+}
+
+// RecordDownload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - download *entity.FileDownload
+func (_e *MockFileRepository_Expecter) RecordDownload(ctx interface{}, download interface{}) *MockFileRepository_RecordDownload_Call {
+	return &MockFileRepository_RecordDownload_Call{Call: _e.mock.On("RecordDownload", ctx, download)}
+}
+
+func (_c *MockFileRepository_RecordDownload_Call) Run(run func(ctx context.Context, download *entity.FileDownload)) *MockFileRepository_RecordDownload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entity.FileDownload
+		if args[1] != nil {
+			arg1 = args[1].(*entity.FileDownload)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileRepository_RecordDownload_Call) Return(err error) *MockFileRepository_RecordDownload_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFileRepository_RecordDownload_Call) RunAndReturn(run func(ctx context.Context, download *entity.FileDownload) error) *MockFileRepository_RecordDownload_Call {
+	_c.Call.Return(run)
+	return _c
+}
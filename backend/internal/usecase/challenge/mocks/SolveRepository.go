@@ -710,6 +710,74 @@ func (_c *MockSolveRepository_GetScoreboardFrozen_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetSolvedChallengeIDs provides a mock function for the type MockSolveRepository
+func (_mock *MockSolveRepository) GetSolvedChallengeIDs(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSolvedChallengeIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, teamID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSolveRepository_GetSolvedChallengeIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSolvedChallengeIDs'
+type MockSolveRepository_GetSolvedChallengeIDs_Call struct {
+	*mock.Call
+}
+
+// GetSolvedChallengeIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+func (_e *MockSolveRepository_Expecter) GetSolvedChallengeIDs(ctx interface{}, teamID interface{}) *MockSolveRepository_GetSolvedChallengeIDs_Call {
+	return &MockSolveRepository_GetSolvedChallengeIDs_Call{Call: _e.mock.On("GetSolvedChallengeIDs", ctx, teamID)}
+}
+
+func (_c *MockSolveRepository_GetSolvedChallengeIDs_Call) Run(run func(ctx context.Context, teamID uuid.UUID)) *MockSolveRepository_GetSolvedChallengeIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSolveRepository_GetSolvedChallengeIDs_Call) Return(uUIDs []uuid.UUID, err error) *MockSolveRepository_GetSolvedChallengeIDs_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockSolveRepository_GetSolvedChallengeIDs_Call) RunAndReturn(run func(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error)) *MockSolveRepository_GetSolvedChallengeIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTeamScore provides a mock function for the type MockSolveRepository
 func (_mock *MockSolveRepository) GetTeamScore(ctx context.Context, teamID uuid.UUID) (int, error) {
 	ret := _mock.Called(ctx, teamID)
@@ -775,3 +843,66 @@ func (_c *MockSolveRepository_GetTeamScore_Call) RunAndReturn(run func(ctx conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// PinPoints provides a mock function for the type MockSolveRepository
+func (_mock *MockSolveRepository) PinPoints(ctx context.Context, challengeID uuid.UUID, points int) error {
+	ret := _mock.Called(ctx, challengeID, points)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PinPoints")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = returnFunc(ctx, challengeID, points)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSolveRepository_PinPoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PinPoints'
+type MockSolveRepository_PinPoints_Call struct {
+	*mock.Call
+}
+
+// PinPoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challengeID uuid.UUID
+//   - points int
+func (_e *MockSolveRepository_Expecter) PinPoints(ctx interface{}, challengeID interface{}, points interface{}) *MockSolveRepository_PinPoints_Call {
+	return &MockSolveRepository_PinPoints_Call{Call: _e.mock.On("PinPoints", ctx, challengeID, points)}
+}
+
+func (_c *MockSolveRepository_PinPoints_Call) Run(run func(ctx context.Context, challengeID uuid.UUID, points int)) *MockSolveRepository_PinPoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSolveRepository_PinPoints_Call) Return(err error) *MockSolveRepository_PinPoints_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSolveRepository_PinPoints_Call) RunAndReturn(run func(ctx context.Context, challengeID uuid.UUID, points int) error) *MockSolveRepository_PinPoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
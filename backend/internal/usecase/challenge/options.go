@@ -5,6 +5,7 @@ import (
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/pkg/cache"
 	"github.com/skr1ms/CTFBoard/pkg/crypto"
+	"github.com/skr1ms/CTFBoard/pkg/logger"
 	pkgWS "github.com/skr1ms/CTFBoard/pkg/websocket"
 )
 
@@ -49,3 +50,67 @@ func WithCrypto(c crypto.Service) ChallengeUCOption {
 func WithScoreboardCache(inv cache.ScoreboardCacheInvalidator) ChallengeUCOption {
 	return func(uc *ChallengeUseCase) { uc.scoreboardCache = inv }
 }
+
+func WithFlagRepo(r repo.ChallengeFlagRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.flagRepo = r }
+}
+
+func WithRequirementRepo(r repo.ChallengeRequirementRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.requirementRepo = r }
+}
+
+func WithScheduleRepo(r repo.ChallengeScheduleRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.scheduleRepo = r }
+}
+
+func WithNotificationRepo(r repo.NotificationRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.notifRepo = r }
+}
+
+func WithTeamFlagRepo(r repo.TeamFlagRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.teamFlagRepo = r }
+}
+
+func WithCheatIncidentRepo(r repo.CheatIncidentRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.cheatRepo = r }
+}
+
+func WithDecoyRepo(r repo.DecoyFlagRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.decoyRepo = r }
+}
+
+func WithConfigRepo(r repo.ConfigRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.configRepo = r }
+}
+
+func WithAdminBroadcaster(b pkgWS.AdminBroadcaster) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.alerts = b }
+}
+
+func WithReviewRepo(r repo.ReviewRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.reviewRepo = r }
+}
+
+func WithStageRepo(r repo.ChallengeStageRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.stageRepo = r }
+}
+
+func WithScoringRepo(r repo.ChallengeScoringRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.scoringRepo = r }
+}
+
+func WithAttemptLimitRepo(r repo.AttemptLimitRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.attemptRepo = r }
+}
+
+func WithSubmissionRepo(r repo.SubmissionRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.submissionRepo = r }
+}
+
+func WithAwardRepo(r repo.AwardRepository) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.awardRepo = r }
+}
+
+func WithLogger(l logger.Logger) ChallengeUCOption {
+	return func(uc *ChallengeUseCase) { uc.logger = l }
+}
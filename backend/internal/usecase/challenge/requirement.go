@@ -0,0 +1,214 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+func (uc *ChallengeUseCase) GetRequirements(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeRequirements, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetRequirements - GetByID")
+	}
+	req, err := uc.requirementRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetRequirements")
+	}
+	return req, nil
+}
+
+// SetRequirements replaces what a challenge needs before it unlocks. Prerequisites must be other
+// existing challenges and must not lead back to this one.
+func (uc *ChallengeUseCase) SetRequirements(ctx context.Context, challengeID uuid.UUID, prerequisites []uuid.UUID, minScore int) (*entity.ChallengeRequirements, error) {
+	if minScore < 0 {
+		return nil, entityError.ErrInvalidChallengeRequirements
+	}
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetRequirements - GetByID")
+	}
+	req := &entity.ChallengeRequirements{ChallengeID: challengeID, Prerequisites: []uuid.UUID{}, MinScore: minScore}
+	seen := make(map[uuid.UUID]bool, len(prerequisites))
+	for _, id := range prerequisites {
+		if id == challengeID {
+			return nil, entityError.ErrInvalidChallengeRequirements
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if _, err := uc.challengeRepo.GetByID(ctx, id); err != nil {
+			if errors.Is(err, entityError.ErrChallengeNotFound) {
+				return nil, entityError.ErrInvalidChallengeRequirements
+			}
+			return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetRequirements - GetPrerequisite")
+		}
+		req.Prerequisites = append(req.Prerequisites, id)
+	}
+
+	all, err := uc.requirementRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetRequirements - GetAll")
+	}
+	graph := make(map[uuid.UUID][]uuid.UUID, len(all)+1)
+	for _, r := range all {
+		graph[r.ChallengeID] = r.Prerequisites
+	}
+	graph[challengeID] = req.Prerequisites
+	if hasPrerequisitePath(graph, req.Prerequisites, challengeID) {
+		return nil, entityError.ErrPrerequisiteCycle
+	}
+
+	if err := uc.requirementRepo.Set(ctx, req); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetRequirements")
+	}
+	return req, nil
+}
+
+// hasPrerequisitePath reports whether target is reachable from any of from by following prerequisites.
+func hasPrerequisitePath(graph map[uuid.UUID][]uuid.UUID, from []uuid.UUID, target uuid.UUID) bool {
+	visited := make(map[uuid.UUID]bool)
+	stack := append([]uuid.UUID(nil), from...)
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if id == target {
+			return true
+		}
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		stack = append(stack, graph[id]...)
+	}
+	return false
+}
+
+// AccessChecker decides whether a team may see the content of a challenge. ChallengeUseCase
+// implements it for the use cases serving files, hints and instances.
+type AccessChecker interface {
+	CheckAccess(ctx context.Context, challengeID uuid.UUID, teamID *uuid.UUID) error
+}
+
+// CheckAccess returns ErrChallengeNotFound for hidden or not yet released challenges and
+// ErrChallengeLocked while teamID has not met the challenge's requirements, so everything
+// hanging off a challenge is gated the way its description is.
+func (uc *ChallengeUseCase) CheckAccess(ctx context.Context, challengeID uuid.UUID, teamID *uuid.UUID) error {
+	challenge, err := uc.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - CheckAccess - GetByID")
+	}
+	if challenge.IsHidden {
+		return entityError.ErrChallengeNotFound
+	}
+	if err := uc.checkReleased(ctx, challengeID); err != nil {
+		return err
+	}
+	state, err := uc.loadUnlockState(ctx, teamID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - CheckAccess - loadUnlockState")
+	}
+	if !state.isUnlocked(challengeID) {
+		return entityError.ErrChallengeLocked
+	}
+	return nil
+}
+
+// unlockState holds what decides whether challenges are unlocked for one team. A nil state
+// unlocks everything.
+type unlockState struct {
+	rules  map[uuid.UUID]*entity.ChallengeRequirements
+	solved map[uuid.UUID]bool
+	score  int
+}
+
+func (s *unlockState) isUnlocked(challengeID uuid.UUID) bool {
+	if s == nil {
+		return true
+	}
+	rule, ok := s.rules[challengeID]
+	if !ok {
+		return true
+	}
+	if rule.MinScore > 0 && s.score < rule.MinScore {
+		return false
+	}
+	for _, id := range rule.Prerequisites {
+		if !s.solved[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// loadUnlockState reads the unlock rules and, when any exist, the solves and score of teamID.
+// Without a team nothing is solved and the score is zero.
+func (uc *ChallengeUseCase) loadUnlockState(ctx context.Context, teamID *uuid.UUID) (*unlockState, error) {
+	if uc.requirementRepo == nil {
+		return nil, nil
+	}
+	all, err := uc.requirementRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetRequirements")
+	}
+	s := &unlockState{rules: make(map[uuid.UUID]*entity.ChallengeRequirements, len(all))}
+	for _, r := range all {
+		if !r.IsEmpty() {
+			s.rules[r.ChallengeID] = r
+		}
+	}
+	if len(s.rules) == 0 || teamID == nil {
+		return s, nil
+	}
+	if err := uc.loadTeamProgress(ctx, s, *teamID); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (uc *ChallengeUseCase) loadTeamProgress(ctx context.Context, s *unlockState, teamID uuid.UUID) error {
+	solved, err := uc.solveRepo.GetSolvedChallengeIDs(ctx, teamID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetSolvedChallengeIDs")
+	}
+	s.solved = make(map[uuid.UUID]bool, len(solved))
+	for _, id := range solved {
+		s.solved[id] = true
+	}
+	for _, r := range s.rules {
+		if r.MinScore > 0 {
+			if s.score, err = uc.solveRepo.GetTeamScore(ctx, teamID); err != nil {
+				return usecaseutil.Wrap(err, "GetTeamScore")
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// notifyUnlocks tells the team which visible challenges its latest solve unlocked. before is
+// the state loaded ahead of the solve.
+func (uc *ChallengeUseCase) notifyUnlocks(ctx context.Context, teamID uuid.UUID, before *unlockState) {
+	if uc.broadcaster == nil || before == nil || len(before.rules) == 0 {
+		return
+	}
+	after := &unlockState{rules: before.rules}
+	if err := uc.loadTeamProgress(ctx, after, teamID); err != nil {
+		return
+	}
+	var unlocked []uuid.UUID
+	for id := range before.rules {
+		if before.isUnlocked(id) || !after.isUnlocked(id) {
+			continue
+		}
+		c, err := uc.challengeRepo.GetByID(ctx, id)
+		if err != nil || c.IsHidden {
+			continue
+		}
+		unlocked = append(unlocked, id)
+	}
+	uc.broadcaster.NotifyUnlock(teamID, unlocked)
+}
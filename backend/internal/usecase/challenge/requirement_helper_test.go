@@ -0,0 +1,60 @@
+package challenge
+
+import (
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type unlockRecorder struct {
+	teamID   uuid.UUID
+	unlocked []uuid.UUID
+}
+
+func (r *unlockRecorder) NotifySolve(uuid.UUID, string, int, bool) {}
+
+func (r *unlockRecorder) NotifySolveRevoked(uuid.UUID, string, int, *uuid.UUID) {}
+
+func (r *unlockRecorder) NotifyNotification(string, string) {}
+
+func (r *unlockRecorder) NotifyUnlock(teamID uuid.UUID, challengeIDs []uuid.UUID) {
+	r.teamID = teamID
+	r.unlocked = append(r.unlocked, challengeIDs...)
+}
+
+func (r *unlockRecorder) NotifyRelease(uuid.UUID, string, string, int) {}
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithRequirements(recorder *unlockRecorder) (*ChallengeUseCase, redismock.ClientMock) {
+	h.t.Helper()
+	client, redis := redismock.NewClientMock()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		WithSolveRepo(h.deps.solveRepo),
+		WithTxRepo(h.deps.txRepo),
+		WithCompetitionRepo(h.deps.compRepo),
+		WithTeamRepo(h.deps.teamRepo),
+		WithRedis(client),
+		WithRequirementRepo(h.deps.requirementRepo),
+		WithBroadcaster(recorder),
+	), redis
+}
+
+func (h *ChallengeTestHelper) NewRequirements(challengeID uuid.UUID, minScore int, prerequisites ...uuid.UUID) *entity.ChallengeRequirements {
+	h.t.Helper()
+	if prerequisites == nil {
+		prerequisites = []uuid.UUID{}
+	}
+	return &entity.ChallengeRequirements{ChallengeID: challengeID, Prerequisites: prerequisites, MinScore: minScore}
+}
+
+// ExpectLockedChallenge makes challengeID a visible challenge that stays locked for teamID
+// because teamID has not solved its prerequisite.
+func (h *ChallengeTestHelper) ExpectLockedChallenge(challengeID, teamID uuid.UUID) {
+	h.t.Helper()
+	h.deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Locked", "Web", 100, ""), nil)
+	h.deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{
+		h.NewRequirements(challengeID, 0, uuid.New()),
+	}, nil)
+	h.deps.solveRepo.On("GetSolvedChallengeIDs", mock.Anything, teamID).Return([]uuid.UUID{}, nil)
+}
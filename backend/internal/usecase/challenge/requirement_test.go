@@ -0,0 +1,231 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeUseCase_SetRequirements_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+
+	challengeID, prereqID := uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Stage 2", "Web", 100, ""), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, prereqID).Return(h.NewChallenge(prereqID, "Stage 1", "Web", 100, ""), nil)
+	deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{}, nil)
+	deps.requirementRepo.On("Set", mock.Anything, mock.MatchedBy(func(r *entity.ChallengeRequirements) bool {
+		return r.ChallengeID == challengeID && len(r.Prerequisites) == 1 && r.Prerequisites[0] == prereqID && r.MinScore == 50
+	})).Return(nil)
+
+	req, err := uc.SetRequirements(context.Background(), challengeID, []uuid.UUID{prereqID, prereqID}, 50)
+
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{prereqID}, req.Prerequisites)
+}
+
+func TestChallengeUseCase_SetRequirements_Cycle(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, mock.Anything).Return(h.NewChallenge(a, "Any", "Web", 100, ""), nil)
+	// b needs c and c needs a, so a needing b closes the loop
+	deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{
+		h.NewRequirements(b, 0, c),
+		h.NewRequirements(c, 0, a),
+	}, nil)
+
+	_, err := uc.SetRequirements(context.Background(), a, []uuid.UUID{b}, 0)
+
+	assert.ErrorIs(t, err, entityError.ErrPrerequisiteCycle)
+}
+
+func TestChallengeUseCase_SetRequirements_Invalid(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+
+	challengeID, missingID := uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Stage 2", "Web", 100, ""), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, missingID).Return(nil, entityError.ErrChallengeNotFound)
+
+	_, err := uc.SetRequirements(context.Background(), challengeID, []uuid.UUID{challengeID}, 0)
+	assert.ErrorIs(t, err, entityError.ErrInvalidChallengeRequirements)
+
+	_, err = uc.SetRequirements(context.Background(), challengeID, []uuid.UUID{missingID}, 0)
+	assert.ErrorIs(t, err, entityError.ErrInvalidChallengeRequirements)
+
+	_, err = uc.SetRequirements(context.Background(), challengeID, nil, -1)
+	assert.ErrorIs(t, err, entityError.ErrInvalidChallengeRequirements)
+}
+
+func TestChallengeUseCase_GetAll_MarksLocked(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+
+	teamID := uuid.New()
+	first, second, rich := uuid.New(), uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetAll", mock.Anything, &teamID, (*uuid.UUID)(nil)).Return([]*repo.ChallengeWithSolved{
+		h.NewChallengeWithSolved(h.NewChallenge(first, "First", "Web", 100, ""), true),
+		h.NewChallengeWithSolved(h.NewChallenge(second, "Second", "Web", 100, ""), false),
+		h.NewChallengeWithSolved(h.NewChallenge(rich, "Rich", "Web", 100, ""), false),
+	}, nil)
+	deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{
+		h.NewRequirements(second, 0, first),
+		h.NewRequirements(rich, 500),
+	}, nil)
+	deps.solveRepo.On("GetSolvedChallengeIDs", mock.Anything, teamID).Return([]uuid.UUID{first}, nil)
+	deps.solveRepo.On("GetTeamScore", mock.Anything, teamID).Return(100, nil)
+
+	result, err := uc.GetAll(context.Background(), &teamID, nil)
+
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	assert.False(t, result[0].Locked)
+	assert.False(t, result[1].Locked)
+	assert.True(t, result[2].Locked)
+}
+
+func TestChallengeUseCase_GetAll_NoTeamLocksRequirements(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+
+	first, second := uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetAll", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return([]*repo.ChallengeWithSolved{
+		h.NewChallengeWithSolved(h.NewChallenge(first, "First", "Web", 100, ""), false),
+		h.NewChallengeWithSolved(h.NewChallenge(second, "Second", "Web", 100, ""), false),
+	}, nil)
+	deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{
+		h.NewRequirements(second, 0, first),
+	}, nil)
+
+	result, err := uc.GetAll(context.Background(), nil, nil)
+
+	require.NoError(t, err)
+	assert.False(t, result[0].Locked)
+	assert.True(t, result[1].Locked)
+}
+
+func TestChallengeUseCase_SubmitFlag_Locked(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+
+	teamID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, second).Return(h.NewChallenge(second, "Second", "Web", 100, h.Sha256Hash("flag{second}")), nil)
+	deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{
+		h.NewRequirements(second, 0, first),
+	}, nil)
+	deps.solveRepo.On("GetSolvedChallengeIDs", mock.Anything, teamID).Return([]uuid.UUID{}, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), second, "flag{second}", uuid.New(), &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+	assert.False(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_NotifiesUnlocks(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	recorder := &unlockRecorder{}
+	uc, _ := h.CreateChallengeUseCaseWithRequirements(recorder)
+
+	teamID := uuid.New()
+	first, second, hidden := uuid.New(), uuid.New(), uuid.New()
+	challenge := h.NewChallenge(first, "First", "Web", 100, h.Sha256Hash("flag{first}"))
+	hiddenChallenge := h.NewChallenge(hidden, "Hidden", "Web", 100, "")
+	hiddenChallenge.IsHidden = true
+
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, first).Return(challenge, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, second).Return(h.NewChallenge(second, "Second", "Web", 100, ""), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, hidden).Return(hiddenChallenge, nil)
+	deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{
+		h.NewRequirements(second, 0, first),
+		h.NewRequirements(hidden, 0, first),
+	}, nil)
+	deps.solveRepo.On("GetSolvedChallengeIDs", mock.Anything, teamID).Return([]uuid.UUID{}, nil).Once()
+	deps.solveRepo.On("GetSolvedChallengeIDs", mock.Anything, teamID).Return([]uuid.UUID{first}, nil).Once()
+	deps.txRepo.On("RunTransaction", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		ctx, ok := args.Get(0).(context.Context)
+		if !ok {
+			return
+		}
+		fn, ok := args.Get(1).(func(context.Context, repo.Transaction) error)
+		if !ok {
+			return
+		}
+		_ = fn(ctx, nil) //nolint:errcheck
+	})
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, first).Return(nil, entityError.ErrSolveNotFound)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, first).Return(challenge, nil)
+	deps.txRepo.On("CreateSolveTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	deps.txRepo.On("IncrementChallengeSolveCountTx", mock.Anything, mock.Anything, first).Return(1, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), first, "flag{first}", uuid.New(), &teamID)
+
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, teamID, recorder.teamID)
+	assert.Equal(t, []uuid.UUID{second}, recorder.unlocked)
+}
+
+func TestChallengeUseCase_CheckAccess(t *testing.T) {
+	teamID, first, second := uuid.New(), uuid.New(), uuid.New()
+	tests := []struct {
+		name    string
+		hidden  bool
+		solved  []uuid.UUID
+		wantErr error
+	}{
+		{"Unlocked", false, []uuid.UUID{first}, nil},
+		{"Locked", false, []uuid.UUID{}, entityError.ErrChallengeLocked},
+		{"Hidden", true, []uuid.UUID{first}, entityError.ErrChallengeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewChallengeTestHelper(t)
+			deps := h.Deps()
+			uc, _ := h.CreateChallengeUseCaseWithRequirements(nil)
+			c := h.NewChallenge(second, "Second", "Web", 100, "")
+			c.IsHidden = tt.hidden
+			deps.challengeRepo.On("GetByID", mock.Anything, second).Return(c, nil)
+			deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{
+				h.NewRequirements(second, 0, first),
+			}, nil).Maybe()
+			deps.solveRepo.On("GetSolvedChallengeIDs", mock.Anything, teamID).Return(tt.solved, nil).Maybe()
+
+			err := uc.CheckAccess(context.Background(), second, &teamID)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestHasPrerequisitePath(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	graph := map[uuid.UUID][]uuid.UUID{a: {b}, b: {c}, d: {a}}
+
+	assert.True(t, hasPrerequisitePath(graph, []uuid.UUID{a}, c))
+	assert.False(t, hasPrerequisitePath(graph, []uuid.UUID{c}, a))
+	assert.True(t, hasPrerequisitePath(graph, []uuid.UUID{d}, c))
+}
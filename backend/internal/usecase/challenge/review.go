@@ -0,0 +1,248 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+func (uc *ChallengeUseCase) GetManualReview(ctx context.Context, challengeID uuid.UUID) (*entity.ManualReviewConfig, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetManualReview - GetByID")
+	}
+	cfg, err := uc.reviewRepo.GetConfig(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetManualReview")
+	}
+	return cfg, nil
+}
+
+// EnableManualReview switches a challenge to manual grading. Flags stay stored but are no
+// longer checked while it is enabled.
+func (uc *ChallengeUseCase) EnableManualReview(ctx context.Context, challengeID uuid.UUID) (*entity.ManualReviewConfig, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - EnableManualReview - GetByID")
+	}
+	if err := uc.reviewRepo.UpsertConfig(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - EnableManualReview")
+	}
+	cfg, err := uc.reviewRepo.GetConfig(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - EnableManualReview - Reload")
+	}
+	return cfg, nil
+}
+
+// DisableManualReview switches a challenge back to flag checking. Reviews already queued can
+// still be resolved.
+func (uc *ChallengeUseCase) DisableManualReview(ctx context.Context, challengeID uuid.UUID) error {
+	if err := uc.reviewRepo.DeleteConfig(ctx, challengeID); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - DisableManualReview")
+	}
+	return nil
+}
+
+// GetReviews lists queued answers oldest first, optionally filtered by status.
+func (uc *ChallengeUseCase) GetReviews(ctx context.Context, status *entity.ReviewStatus, page, perPage int) ([]*entity.SubmissionReviewWithDetails, int64, error) {
+	if status != nil && !status.IsValid() {
+		return nil, 0, entityError.ErrInvalidReviewStatus
+	}
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 || perPage > 100 {
+		perPage = 20
+	}
+	offset := (page - 1) * perPage
+
+	reviews, err := uc.reviewRepo.GetAll(ctx, status, perPage, offset)
+	if err != nil {
+		return nil, 0, usecaseutil.Wrap(err, "ChallengeUseCase - GetReviews")
+	}
+
+	total, err := uc.reviewRepo.CountAll(ctx, status)
+	if err != nil {
+		return nil, 0, usecaseutil.Wrap(err, "ChallengeUseCase - GetReviews count")
+	}
+
+	return reviews, total, nil
+}
+
+// ApproveReview solves the challenge for the submitting team through the regular solve path.
+// points grants partial credit: the difference to the challenge value at approval time is
+// recorded as a negative award, so it does not follow later dynamic scoring changes.
+func (uc *ChallengeUseCase) ApproveReview(ctx context.Context, reviewID uuid.UUID, reviewerID *uuid.UUID, points *int, comment string) (*entity.SubmissionReview, error) {
+	review, challenge, err := uc.pendingReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if points != nil && (*points < 0 || *points > challenge.Points) {
+		return nil, entityError.ErrInvalidReviewPoints
+	}
+	state, err := uc.loadUnlockState(ctx, &review.TeamID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ApproveReview - loadUnlockState")
+	}
+
+	review.Status = entity.ReviewStatusApproved
+	review.Comment = comment
+	review.ReviewedBy = reviewerID
+	var members []*entity.User
+	sc := &submitContext{ctx: ctx, challengeID: review.ChallengeID, userID: review.UserID, teamID: review.TeamID}
+	solvedChallenge, solveCount, err := uc.submitRecordSolve(sc, func(ctx context.Context, tx repo.Transaction, solved *entity.Challenge) error {
+		awarded := solved.Points
+		if points != nil && *points < awarded {
+			award := &entity.Award{
+				TeamID:      review.TeamID,
+				Value:       *points - awarded,
+				Description: fmt.Sprintf("Partial credit: %s", solved.Title),
+				CreatedBy:   reviewerID,
+			}
+			if err := uc.txRepo.CreateAwardTx(ctx, tx, award); err != nil {
+				return usecaseutil.Wrap(err, "CreateAwardTx")
+			}
+			awarded = *points
+		}
+		review.Points = &awarded
+		if err := uc.reviewRepo.ResolveTx(ctx, tx, review); err != nil {
+			return err
+		}
+		var err error
+		members, err = uc.txRepo.GetUsersByTeamIDTx(ctx, tx, review.TeamID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetUsersByTeamIDTx")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ApproveReview")
+	}
+	uc.submitInvalidateCache(ctx)
+	uc.submitNotifySolve(review.TeamID, solvedChallenge, solveCount == 1)
+	uc.notifyUnlocks(ctx, review.TeamID, state)
+	uc.notifyReviewVerdict(ctx, members, review, solvedChallenge.Title)
+	return review, nil
+}
+
+// RejectReview closes a pending review without a solve; the team may submit again.
+func (uc *ChallengeUseCase) RejectReview(ctx context.Context, reviewID uuid.UUID, reviewerID *uuid.UUID, comment string) (*entity.SubmissionReview, error) {
+	review, challenge, err := uc.pendingReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Status = entity.ReviewStatusRejected
+	review.Comment = comment
+	review.ReviewedBy = reviewerID
+	var members []*entity.User
+	err = uc.txRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.reviewRepo.ResolveTx(ctx, tx, review); err != nil {
+			return err
+		}
+		var err error
+		members, err = uc.txRepo.GetUsersByTeamIDTx(ctx, tx, review.TeamID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetUsersByTeamIDTx")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - RejectReview")
+	}
+	uc.notifyReviewVerdict(ctx, members, review, challenge.Title)
+	return review, nil
+}
+
+func (uc *ChallengeUseCase) pendingReview(ctx context.Context, reviewID uuid.UUID) (*entity.SubmissionReview, *entity.Challenge, error) {
+	review, err := uc.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, nil, usecaseutil.Wrap(err, "ChallengeUseCase - pendingReview - GetByID")
+	}
+	if review.Status != entity.ReviewStatusPending {
+		return nil, nil, entityError.ErrReviewAlreadyResolved
+	}
+	challenge, err := uc.challengeRepo.GetByID(ctx, review.ChallengeID)
+	if err != nil {
+		return nil, nil, usecaseutil.Wrap(err, "ChallengeUseCase - pendingReview - GetChallenge")
+	}
+	return review, challenge, nil
+}
+
+// LinkReviewSubmission attaches the logged submission of an answer SubmitFlag queued for review,
+// so the answer does not count against the team's attempt limit while pending or once approved.
+func (uc *ChallengeUseCase) LinkReviewSubmission(ctx context.Context, challengeID, teamID, submissionID uuid.UUID) error {
+	if err := uc.reviewRepo.LinkSubmission(ctx, challengeID, teamID, submissionID); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - LinkReviewSubmission")
+	}
+	return nil
+}
+
+// submitQueueReviewIfManual queues the answer when the challenge is manually graded. handled
+// reports whether SubmitFlag must stop and return err; a queued answer yields
+// ErrSubmissionPendingReview. A decoy is still recorded before it is queued, so manual grading
+// does not hide decoy hits from the anti-cheat policy.
+func (uc *ChallengeUseCase) submitQueueReviewIfManual(sc *submitContext, challenge *entity.Challenge) (handled bool, err error) {
+	if uc.reviewRepo == nil {
+		return false, nil
+	}
+	if _, err := uc.reviewRepo.GetConfig(sc.ctx, sc.challengeID); err != nil {
+		if errors.Is(err, entityError.ErrManualReviewNotConfigured) {
+			return false, nil
+		}
+		return true, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - GetManualReview")
+	}
+	if sc.flag == "" {
+		return true, entityError.ErrInvalidFlagFormat
+	}
+	if uc.solveRepo != nil {
+		_, err := uc.solveRepo.GetByTeamAndChallenge(sc.ctx, sc.teamID, sc.challengeID)
+		if err == nil {
+			return true, entityError.ErrAlreadySolved
+		}
+		if !errors.Is(err, entityError.ErrSolveNotFound) {
+			return true, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - GetSolve")
+		}
+	}
+	uc.submitCheckDecoy(sc, challenge)
+	review := &entity.SubmissionReview{
+		ChallengeID: sc.challengeID,
+		TeamID:      sc.teamID,
+		UserID:      sc.userID,
+		Answer:      sc.flag,
+	}
+	if err := uc.reviewRepo.Create(sc.ctx, review); err != nil {
+		return true, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - CreateReview")
+	}
+	return true, entityError.ErrSubmissionPendingReview
+}
+
+func (uc *ChallengeUseCase) notifyReviewVerdict(ctx context.Context, members []*entity.User, review *entity.SubmissionReview, challengeTitle string) {
+	if uc.notifRepo == nil {
+		return
+	}
+	title := "Submission rejected"
+	content := fmt.Sprintf("Your submission for %q was rejected.", challengeTitle)
+	notifType := entity.NotificationWarning
+	if review.Status == entity.ReviewStatusApproved {
+		title = "Submission approved"
+		content = fmt.Sprintf("Your submission for %q was approved for %d points.", challengeTitle, *review.Points)
+		notifType = entity.NotificationSuccess
+	}
+	if review.Comment != "" {
+		content += "\n\nReviewer comment: " + review.Comment
+	}
+	for _, member := range members {
+		_ = uc.notifRepo.CreateUserNotification(ctx, &entity.UserNotification{
+			UserID:  member.ID,
+			Title:   title,
+			Content: content,
+			Type:    notifType,
+		})
+	}
+}
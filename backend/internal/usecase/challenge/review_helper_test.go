@@ -0,0 +1,46 @@
+package challenge
+
+import (
+	"context"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/mock"
+)
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithReviews() (*ChallengeUseCase, redismock.ClientMock) {
+	h.t.Helper()
+	client, redis := redismock.NewClientMock()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		WithSolveRepo(h.deps.solveRepo),
+		WithTxRepo(h.deps.txRepo),
+		WithCompetitionRepo(h.deps.compRepo),
+		WithTeamRepo(h.deps.teamRepo),
+		WithRedis(client),
+		WithNotificationRepo(h.deps.notifRepo),
+		WithReviewRepo(h.deps.reviewRepo),
+	), redis
+}
+
+func (h *ChallengeTestHelper) NewPendingReview(challengeID, teamID uuid.UUID) *entity.SubmissionReview {
+	h.t.Helper()
+	return &entity.SubmissionReview{
+		ID:          uuid.New(),
+		ChallengeID: challengeID,
+		TeamID:      teamID,
+		UserID:      uuid.New(),
+		Answer:      "The author lives in Lisbon",
+		Status:      entity.ReviewStatusPending,
+	}
+}
+
+// RunTransactions makes RunTransaction call its function and return the function's error.
+func (h *ChallengeTestHelper) RunTransactions() {
+	h.t.Helper()
+	h.deps.txRepo.On("RunTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context, repo.Transaction) error) error {
+		return fn(ctx, nil)
+	})
+}
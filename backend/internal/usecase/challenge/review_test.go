@@ -0,0 +1,259 @@
+package challenge
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeUseCase_SubmitFlag_ManualReview_Queued(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID, teamID, userID := uuid.New(), uuid.New(), uuid.New()
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "OSINT", "Misc", 100, ""), nil)
+	deps.reviewRepo.On("GetConfig", mock.Anything, challengeID).Return(&entity.ManualReviewConfig{ChallengeID: challengeID}, nil)
+	deps.solveRepo.On("GetByTeamAndChallenge", mock.Anything, teamID, challengeID).Return(nil, entityError.ErrSolveNotFound)
+	deps.reviewRepo.On("Create", mock.Anything, mock.MatchedBy(func(r *entity.SubmissionReview) bool {
+		return r.ChallengeID == challengeID && r.TeamID == teamID && r.UserID == userID && r.Answer == "The author lives in Lisbon"
+	})).Return(nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challengeID, " The author lives in Lisbon ", userID, &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrSubmissionPendingReview)
+	assert.False(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_ManualReview_AlreadyPending(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "OSINT", "Misc", 100, ""), nil)
+	deps.reviewRepo.On("GetConfig", mock.Anything, challengeID).Return(&entity.ManualReviewConfig{ChallengeID: challengeID}, nil)
+	deps.solveRepo.On("GetByTeamAndChallenge", mock.Anything, teamID, challengeID).Return(nil, entityError.ErrSolveNotFound)
+	deps.reviewRepo.On("Create", mock.Anything, mock.Anything).Return(entityError.ErrReviewAlreadyPending)
+
+	_, err := uc.SubmitFlag(context.Background(), challengeID, "answer", uuid.New(), &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrReviewAlreadyPending)
+}
+
+func TestChallengeUseCase_SubmitFlag_ManualReview_AlreadySolved(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "OSINT", "Misc", 100, ""), nil)
+	deps.reviewRepo.On("GetConfig", mock.Anything, challengeID).Return(&entity.ManualReviewConfig{ChallengeID: challengeID}, nil)
+	deps.solveRepo.On("GetByTeamAndChallenge", mock.Anything, teamID, challengeID).Return(&entity.Solve{}, nil)
+
+	_, err := uc.SubmitFlag(context.Background(), challengeID, "answer", uuid.New(), &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrAlreadySolved)
+}
+
+func TestChallengeUseCase_LinkReviewSubmission(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID, teamID, submissionID := uuid.New(), uuid.New(), uuid.New()
+	deps.reviewRepo.On("LinkSubmission", mock.Anything, challengeID, teamID, submissionID).Return(nil).Once()
+	require.NoError(t, uc.LinkReviewSubmission(context.Background(), challengeID, teamID, submissionID))
+
+	deps.reviewRepo.On("LinkSubmission", mock.Anything, challengeID, teamID, submissionID).Return(entityError.ErrReviewNotFound).Once()
+	assert.ErrorIs(t, uc.LinkReviewSubmission(context.Background(), challengeID, teamID, submissionID), entityError.ErrReviewNotFound)
+}
+
+func TestChallengeUseCase_ApproveReview_PartialPoints(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID, teamID, reviewerID := uuid.New(), uuid.New(), uuid.New()
+	challenge := h.NewChallenge(challengeID, "OSINT", "Misc", 100, "")
+	review := h.NewPendingReview(challengeID, teamID)
+	members := []*entity.User{{ID: uuid.New()}, {ID: uuid.New()}}
+	points := 40
+
+	deps.reviewRepo.On("GetByID", mock.Anything, review.ID).Return(review, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(challenge, nil)
+	h.RunTransactions()
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challengeID).Return(nil, entityError.ErrSolveNotFound)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challengeID).Return(challenge, nil)
+	deps.txRepo.On("CreateSolveTx", mock.Anything, mock.Anything, mock.MatchedBy(func(s *entity.Solve) bool {
+		return s.TeamID == teamID && s.UserID == review.UserID && s.ChallengeID == challengeID
+	})).Return(nil)
+	deps.txRepo.On("IncrementChallengeSolveCountTx", mock.Anything, mock.Anything, challengeID).Return(1, nil)
+	deps.txRepo.On("CreateAwardTx", mock.Anything, mock.Anything, mock.MatchedBy(func(a *entity.Award) bool {
+		return a.TeamID == teamID && a.Value == -60 && a.CreatedBy != nil && *a.CreatedBy == reviewerID
+	})).Return(nil)
+	deps.reviewRepo.On("ResolveTx", mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.SubmissionReview) bool {
+		return r.Status == entity.ReviewStatusApproved && r.Points != nil && *r.Points == 40 && r.Comment == "Good enough"
+	})).Return(nil)
+	deps.txRepo.On("GetUsersByTeamIDTx", mock.Anything, mock.Anything, teamID).Return(members, nil)
+	deps.notifRepo.On("CreateUserNotification", mock.Anything, mock.MatchedBy(func(n *entity.UserNotification) bool {
+		return n.Type == entity.NotificationSuccess && strings.Contains(n.Content, "40 points") && strings.Contains(n.Content, "Good enough")
+	})).Return(nil).Times(2)
+
+	got, err := uc.ApproveReview(context.Background(), review.ID, &reviewerID, &points, "Good enough")
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.ReviewStatusApproved, got.Status)
+	assert.Equal(t, 40, *got.Points)
+}
+
+func TestChallengeUseCase_ApproveReview_FullPoints(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	challenge := h.NewChallenge(challengeID, "OSINT", "Misc", 100, "")
+	review := h.NewPendingReview(challengeID, teamID)
+
+	deps.reviewRepo.On("GetByID", mock.Anything, review.ID).Return(review, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(challenge, nil)
+	h.RunTransactions()
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challengeID).Return(nil, entityError.ErrSolveNotFound)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challengeID).Return(challenge, nil)
+	deps.txRepo.On("CreateSolveTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	deps.txRepo.On("IncrementChallengeSolveCountTx", mock.Anything, mock.Anything, challengeID).Return(1, nil)
+	deps.reviewRepo.On("ResolveTx", mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.SubmissionReview) bool {
+		return r.Points != nil && *r.Points == 100 && r.ReviewedBy == nil
+	})).Return(nil)
+	deps.txRepo.On("GetUsersByTeamIDTx", mock.Anything, mock.Anything, teamID).Return([]*entity.User{}, nil)
+
+	got, err := uc.ApproveReview(context.Background(), review.ID, nil, nil, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, 100, *got.Points)
+	deps.txRepo.AssertNotCalled(t, "CreateAwardTx", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_ApproveReview_PointsAboveValue(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID := uuid.New()
+	review := h.NewPendingReview(challengeID, uuid.New())
+	points := 150
+
+	deps.reviewRepo.On("GetByID", mock.Anything, review.ID).Return(review, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "OSINT", "Misc", 100, ""), nil)
+
+	_, err := uc.ApproveReview(context.Background(), review.ID, nil, &points, "")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidReviewPoints)
+}
+
+func TestChallengeUseCase_ApproveReview_AlreadyResolved(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	review := h.NewPendingReview(uuid.New(), uuid.New())
+	review.Status = entity.ReviewStatusRejected
+	deps.reviewRepo.On("GetByID", mock.Anything, review.ID).Return(review, nil)
+
+	_, err := uc.ApproveReview(context.Background(), review.ID, nil, nil, "")
+
+	assert.ErrorIs(t, err, entityError.ErrReviewAlreadyResolved)
+}
+
+func TestChallengeUseCase_RejectReview(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	review := h.NewPendingReview(challengeID, teamID)
+	member := &entity.User{ID: uuid.New()}
+
+	deps.reviewRepo.On("GetByID", mock.Anything, review.ID).Return(review, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "OSINT", "Misc", 100, ""), nil)
+	h.RunTransactions()
+	deps.reviewRepo.On("ResolveTx", mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.SubmissionReview) bool {
+		return r.Status == entity.ReviewStatusRejected && r.Points == nil
+	})).Return(nil)
+	deps.txRepo.On("GetUsersByTeamIDTx", mock.Anything, mock.Anything, teamID).Return([]*entity.User{member}, nil)
+	deps.notifRepo.On("CreateUserNotification", mock.Anything, mock.MatchedBy(func(n *entity.UserNotification) bool {
+		return n.UserID == member.ID && n.Type == entity.NotificationWarning && strings.Contains(n.Content, "Wrong city")
+	})).Return(nil)
+
+	got, err := uc.RejectReview(context.Background(), review.ID, nil, "Wrong city")
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.ReviewStatusRejected, got.Status)
+}
+
+func TestChallengeUseCase_RejectReview_ConcurrentlyResolved(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	challengeID := uuid.New()
+	review := h.NewPendingReview(challengeID, uuid.New())
+
+	deps.reviewRepo.On("GetByID", mock.Anything, review.ID).Return(review, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "OSINT", "Misc", 100, ""), nil)
+	h.RunTransactions()
+	deps.reviewRepo.On("ResolveTx", mock.Anything, mock.Anything, mock.Anything).Return(entityError.ErrReviewAlreadyResolved)
+
+	_, err := uc.RejectReview(context.Background(), review.ID, nil, "")
+
+	assert.ErrorIs(t, err, entityError.ErrReviewAlreadyResolved)
+}
+
+func TestChallengeUseCase_GetReviews_InvalidStatus(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc, _ := h.CreateChallengeUseCaseWithReviews()
+
+	status := entity.ReviewStatus("done")
+	_, _, err := uc.GetReviews(context.Background(), &status, 1, 20)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidReviewStatus)
+}
+
+func TestChallengeUseCase_SubmitFlag_ManualReview_DecoyRecorded(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithDecoys(WithReviewRepo(deps.reviewRepo))
+	teamID, userID := uuid.New(), uuid.New()
+	c := h.NewChallenge(uuid.New(), "OSINT", "Misc", 100, "")
+	decoy := h.NewDecoyFlag(c.ID, "flag{leaked}")
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.decoyRepo.On("GetByChallengeID", mock.Anything, c.ID).Return([]*entity.DecoyFlag{decoy}, nil)
+	h.ExpectDecoyPolicy(nil)
+	deps.reviewRepo.On("GetConfig", mock.Anything, c.ID).Return(&entity.ManualReviewConfig{ChallengeID: c.ID}, nil)
+	deps.solveRepo.On("GetByTeamAndChallenge", mock.Anything, teamID, c.ID).Return(nil, entityError.ErrSolveNotFound)
+	deps.cheatRepo.On("Create", mock.Anything, mock.MatchedBy(func(i *entity.CheatIncident) bool {
+		return i.Kind == entity.CheatIncidentDecoyFlag && i.TeamID == teamID && *i.ChallengeID == c.ID
+	})).Return(nil)
+	deps.alerts.On("NotifyCheatIncident", mock.Anything, "decoy_flag", "high", teamID, mock.Anything, "").Return()
+	deps.reviewRepo.On("Create", mock.Anything, mock.MatchedBy(func(r *entity.SubmissionReview) bool {
+		return r.Answer == "flag{leaked}"
+	})).Return(nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), c.ID, "flag{leaked}", userID, &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrSubmissionPendingReview)
+	assert.False(t, valid)
+	deps.cheatRepo.AssertExpectations(t)
+}
@@ -0,0 +1,205 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/cache"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+type RevisionDeps struct {
+	ChallengeRepo   repo.ChallengeRepository
+	TagRepo         repo.TagRepository
+	HintRepo        repo.HintRepository
+	RevisionRepo    repo.RevisionRepository
+	TxRepo          repo.TxRepository
+	ScoreboardCache cache.ScoreboardCacheInvalidator
+}
+
+// RevisionUseCase keeps an immutable history of challenge edits covering the challenge row,
+// its tags and its hints.
+type RevisionUseCase struct {
+	deps RevisionDeps
+}
+
+func NewRevisionUseCase(deps RevisionDeps) *RevisionUseCase {
+	return &RevisionUseCase{deps: deps}
+}
+
+// Record stores the current state of a challenge as a new revision when it differs from the
+// latest one. It returns the latest revision either way. authorID is nil for service tokens.
+func (uc *RevisionUseCase) Record(ctx context.Context, challengeID uuid.UUID, authorID *uuid.UUID) (*entity.ChallengeRevision, error) {
+	var revision *entity.ChallengeRevision
+	err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		current, err := uc.lockedSnapshot(ctx, tx, challengeID)
+		if err != nil {
+			return err
+		}
+		previous := &entity.ChallengeSnapshot{}
+		latest, err := uc.deps.RevisionRepo.GetLatestTx(ctx, tx, challengeID)
+		switch {
+		case err == nil:
+			previous = &latest.Snapshot
+		case !errors.Is(err, entityError.ErrRevisionNotFound):
+			return usecaseutil.Wrap(err, "GetLatestTx")
+		}
+
+		changes := previous.Diff(current)
+		if latest != nil && len(changes) == 0 {
+			revision = latest
+			return nil
+		}
+		revision = &entity.ChallengeRevision{
+			ChallengeID:   challengeID,
+			AuthorID:      authorID,
+			Snapshot:      *current,
+			ChangedFields: entity.RevisionChangedFields(changes),
+		}
+		if err := uc.deps.RevisionRepo.CreateTx(ctx, tx, revision); err != nil {
+			return usecaseutil.Wrap(err, "CreateTx")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RevisionUseCase - Record")
+	}
+	return revision, nil
+}
+
+// GetByChallengeID lists the revisions of a challenge, newest first.
+func (uc *RevisionUseCase) GetByChallengeID(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeRevision, error) {
+	if _, err := uc.deps.ChallengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "RevisionUseCase - GetByChallengeID - GetChallenge")
+	}
+	revisions, err := uc.deps.RevisionRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RevisionUseCase - GetByChallengeID")
+	}
+	return revisions, nil
+}
+
+// Diff compares two revisions of a challenge field by field. Flag changes are reported without
+// their values.
+func (uc *RevisionUseCase) Diff(ctx context.Context, challengeID uuid.UUID, fromVersion, toVersion int) (*entity.RevisionDiff, error) {
+	from, err := uc.deps.RevisionRepo.GetByVersion(ctx, challengeID, fromVersion)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RevisionUseCase - Diff - GetFrom")
+	}
+	to, err := uc.deps.RevisionRepo.GetByVersion(ctx, challengeID, toVersion)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RevisionUseCase - Diff - GetTo")
+	}
+	return &entity.RevisionDiff{
+		ChallengeID: challengeID,
+		From:        from.Version,
+		To:          to.Version,
+		Changes:     from.Snapshot.Diff(&to.Snapshot),
+	}, nil
+}
+
+// Rollback restores the challenge, its tags and its hints to an earlier revision and records
+// the result as a new revision. Tags deleted since then are skipped. Solves, solve counts and
+// hint unlocks of hints that no longer exist are not touched.
+func (uc *RevisionUseCase) Rollback(ctx context.Context, challengeID uuid.UUID, version int, actorID uuid.UUID, clientIP string) (*entity.ChallengeRevision, error) {
+	target, err := uc.deps.RevisionRepo.GetByVersion(ctx, challengeID, version)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RevisionUseCase - Rollback - GetByVersion")
+	}
+	restored, err := uc.withExistingTags(ctx, &target.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var revision *entity.ChallengeRevision
+	err = uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		current, err := uc.lockedSnapshot(ctx, tx, challengeID)
+		if err != nil {
+			return err
+		}
+		changes := current.Diff(restored)
+		if len(changes) == 0 {
+			return entityError.ErrRevisionUnchanged
+		}
+		if err := uc.deps.RevisionRepo.RestoreTx(ctx, tx, challengeID, restored); err != nil {
+			return usecaseutil.Wrap(err, "RestoreTx")
+		}
+
+		revision = &entity.ChallengeRevision{
+			ChallengeID:   challengeID,
+			AuthorID:      &actorID,
+			Snapshot:      *restored,
+			ChangedFields: entity.RevisionChangedFields(changes),
+			RestoredFrom:  &target.Version,
+		}
+		if err := uc.deps.RevisionRepo.CreateTx(ctx, tx, revision); err != nil {
+			return usecaseutil.Wrap(err, "CreateTx")
+		}
+
+		auditLog := &entity.AuditLog{
+			UserID:     &actorID,
+			Action:     entity.AuditActionRollback,
+			EntityType: entity.AuditEntityChallenge,
+			EntityID:   challengeID.String(),
+			IP:         clientIP,
+			Details: map[string]any{
+				"restored_version": target.Version,
+				"new_version":      revision.Version,
+				"changed_fields":   revision.ChangedFields,
+			},
+		}
+		if err := uc.deps.TxRepo.CreateAuditLogTx(ctx, tx, auditLog); err != nil {
+			return usecaseutil.Wrap(err, "CreateAuditLogTx")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RevisionUseCase - Rollback - Transaction")
+	}
+
+	if uc.deps.ScoreboardCache != nil {
+		uc.deps.ScoreboardCache.InvalidateAll(ctx)
+	}
+	return revision, nil
+}
+
+// lockedSnapshot locks the challenge row for the rest of tx, so revisions of one challenge are
+// numbered and compared one at a time, and captures its current state.
+func (uc *RevisionUseCase) lockedSnapshot(ctx context.Context, tx repo.Transaction, challengeID uuid.UUID) (*entity.ChallengeSnapshot, error) {
+	challenge, err := uc.deps.TxRepo.GetChallengeByIDTx(ctx, tx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetChallengeByIDTx")
+	}
+	tags, err := uc.deps.TagRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetTags")
+	}
+	hints, err := uc.deps.HintRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetHints")
+	}
+	return entity.NewChallengeSnapshot(challenge, tags, hints), nil
+}
+
+func (uc *RevisionUseCase) withExistingTags(ctx context.Context, snapshot *entity.ChallengeSnapshot) (*entity.ChallengeSnapshot, error) {
+	tags, err := uc.deps.TagRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RevisionUseCase - Rollback - GetTags")
+	}
+	existing := make(map[uuid.UUID]struct{}, len(tags))
+	for _, tag := range tags {
+		existing[tag.ID] = struct{}{}
+	}
+	restored := *snapshot
+	restored.TagIDs = make([]uuid.UUID, 0, len(snapshot.TagIDs))
+	for _, id := range snapshot.TagIDs {
+		if _, ok := existing[id]; ok {
+			restored.TagIDs = append(restored.TagIDs, id)
+		}
+	}
+	return &restored, nil
+}
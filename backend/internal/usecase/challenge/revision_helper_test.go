@@ -0,0 +1,33 @@
+package challenge
+
+import (
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+func (h *ChallengeTestHelper) CreateRevisionUseCase() *RevisionUseCase {
+	h.t.Helper()
+	return NewRevisionUseCase(RevisionDeps{
+		ChallengeRepo: h.deps.challengeRepo, TagRepo: h.deps.tagRepo, HintRepo: h.deps.hintRepo,
+		RevisionRepo: h.deps.revisionRepo, TxRepo: h.deps.txRepo, ScoreboardCache: nil,
+	})
+}
+
+func (h *ChallengeTestHelper) NewRevision(challengeID uuid.UUID, version int, c *entity.Challenge) *entity.ChallengeRevision {
+	h.t.Helper()
+	return &entity.ChallengeRevision{
+		ID:          uuid.New(),
+		ChallengeID: challengeID,
+		Version:     version,
+		Snapshot:    *entity.NewChallengeSnapshot(c, nil, nil),
+	}
+}
+
+// ExpectChallengeState makes the locked snapshot read c with the given tags and hints.
+func (h *ChallengeTestHelper) ExpectChallengeState(c *entity.Challenge, tags []*entity.Tag, hints []*entity.Hint) {
+	h.t.Helper()
+	h.deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, c.ID).Return(c, nil)
+	h.deps.tagRepo.On("GetByChallengeID", mock.Anything, c.ID).Return(tags, nil)
+	h.deps.hintRepo.On("GetByChallengeID", mock.Anything, c.ID).Return(hints, nil)
+}
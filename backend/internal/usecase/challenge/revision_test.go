@@ -0,0 +1,202 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevisionUseCase_Record_First(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	c := h.NewChallenge(uuid.New(), "Web 1", "web", 100, "hash")
+	tag := &entity.Tag{ID: uuid.New(), Name: "web"}
+	hint := h.NewHint(uuid.New(), c.ID, "look at cookies", 10, 0)
+	authorID := uuid.New()
+	h.RunTransactions()
+	h.ExpectChallengeState(c, []*entity.Tag{tag}, []*entity.Hint{hint})
+	deps.revisionRepo.On("GetLatestTx", mock.Anything, mock.Anything, c.ID).Return(nil, entityError.ErrRevisionNotFound)
+	deps.revisionRepo.On("CreateTx", mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.ChallengeRevision) bool {
+		return r.ChallengeID == c.ID && *r.AuthorID == authorID &&
+			r.Snapshot.Title == "Web 1" && len(r.Snapshot.TagIDs) == 1 && len(r.Snapshot.Hints) == 1 &&
+			assert.ObjectsAreEqual([]string{"title", "description", "category", "points", "flag", "tags", "hints"}, r.ChangedFields)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(*entity.ChallengeRevision).Version = 1
+	})
+
+	rev, err := uc.Record(context.Background(), c.ID, &authorID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rev.Version)
+}
+
+func TestRevisionUseCase_Record_ChangedFields(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	c := h.NewChallenge(uuid.New(), "Web 1", "web", 100, "hash")
+	latest := h.NewRevision(c.ID, 3, c)
+	c.Points = 250
+	h.RunTransactions()
+	h.ExpectChallengeState(c, nil, nil)
+	deps.revisionRepo.On("GetLatestTx", mock.Anything, mock.Anything, c.ID).Return(latest, nil)
+	deps.revisionRepo.On("CreateTx", mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.ChallengeRevision) bool {
+		return r.AuthorID == nil && r.Snapshot.Points == 250 && assert.ObjectsAreEqual([]string{"points"}, r.ChangedFields)
+	})).Return(nil)
+
+	_, err := uc.Record(context.Background(), c.ID, nil)
+
+	assert.NoError(t, err)
+}
+
+func TestRevisionUseCase_Record_Unchanged(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	c := h.NewChallenge(uuid.New(), "Web 1", "web", 100, "hash")
+	latest := h.NewRevision(c.ID, 2, c)
+	h.RunTransactions()
+	h.ExpectChallengeState(c, nil, nil)
+	deps.revisionRepo.On("GetLatestTx", mock.Anything, mock.Anything, c.ID).Return(latest, nil)
+
+	rev, err := uc.Record(context.Background(), c.ID, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, latest, rev)
+	deps.revisionRepo.AssertNotCalled(t, "CreateTx", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRevisionUseCase_GetByChallengeID_ChallengeNotFound(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(nil, entityError.ErrChallengeNotFound)
+
+	revisions, err := uc.GetByChallengeID(context.Background(), challengeID)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+	assert.Nil(t, revisions)
+}
+
+func TestRevisionUseCase_Diff_HidesFlag(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	c := h.NewChallenge(uuid.New(), "Web 1", "web", 100, "old-hash")
+	from := h.NewRevision(c.ID, 1, c)
+	c.Title = "Web 2"
+	c.FlagHash = "new-hash"
+	to := h.NewRevision(c.ID, 2, c)
+	deps.revisionRepo.On("GetByVersion", mock.Anything, c.ID, 1).Return(from, nil)
+	deps.revisionRepo.On("GetByVersion", mock.Anything, c.ID, 2).Return(to, nil)
+
+	diff, err := uc.Diff(context.Background(), c.ID, 1, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.RevisionChange{
+		{Field: entity.RevisionFieldTitle, From: "Web 1", To: "Web 2"},
+		{Field: entity.RevisionFieldFlag},
+	}, diff.Changes)
+}
+
+func TestRevisionUseCase_Diff_NotFound(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	challengeID := uuid.New()
+	deps.revisionRepo.On("GetByVersion", mock.Anything, challengeID, 7).Return(nil, entityError.ErrRevisionNotFound)
+
+	diff, err := uc.Diff(context.Background(), challengeID, 7, 8)
+
+	assert.ErrorIs(t, err, entityError.ErrRevisionNotFound)
+	assert.Nil(t, diff)
+}
+
+func TestRevisionUseCase_Rollback_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	c := h.NewChallenge(uuid.New(), "Web 1", "web", 100, "hash")
+	kept, deleted := &entity.Tag{ID: uuid.New()}, &entity.Tag{ID: uuid.New()}
+	target := h.NewRevision(c.ID, 1, c)
+	target.Snapshot.TagIDs = []uuid.UUID{kept.ID, deleted.ID}
+	c.Points = 500
+	actorID := uuid.New()
+
+	deps.revisionRepo.On("GetByVersion", mock.Anything, c.ID, 1).Return(target, nil)
+	deps.tagRepo.On("GetAll", mock.Anything).Return([]*entity.Tag{kept}, nil)
+	h.RunTransactions()
+	h.ExpectChallengeState(c, nil, nil)
+	deps.revisionRepo.On("RestoreTx", mock.Anything, mock.Anything, c.ID, mock.MatchedBy(func(s *entity.ChallengeSnapshot) bool {
+		return s.Points == 100 && assert.ObjectsAreEqual([]uuid.UUID{kept.ID}, s.TagIDs)
+	})).Return(nil)
+	deps.revisionRepo.On("CreateTx", mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.ChallengeRevision) bool {
+		return *r.RestoredFrom == 1 && *r.AuthorID == actorID && assert.ObjectsAreEqual([]string{"points", "tags"}, r.ChangedFields)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(*entity.ChallengeRevision).Version = 4
+	})
+	deps.txRepo.On("CreateAuditLogTx", mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionRollback && l.EntityType == entity.AuditEntityChallenge &&
+			l.EntityID == c.ID.String() && l.Details["restored_version"] == 1 && l.Details["new_version"] == 4
+	})).Return(nil)
+
+	rev, err := uc.Rollback(context.Background(), c.ID, 1, actorID, "127.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, rev.Version)
+}
+
+func TestRevisionUseCase_Rollback_Unchanged(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	c := h.NewChallenge(uuid.New(), "Web 1", "web", 100, "hash")
+	target := h.NewRevision(c.ID, 2, c)
+	deps.revisionRepo.On("GetByVersion", mock.Anything, c.ID, 2).Return(target, nil)
+	deps.tagRepo.On("GetAll", mock.Anything).Return([]*entity.Tag{}, nil)
+	h.RunTransactions()
+	h.ExpectChallengeState(c, nil, nil)
+
+	rev, err := uc.Rollback(context.Background(), c.ID, 2, uuid.New(), "127.0.0.1")
+
+	assert.ErrorIs(t, err, entityError.ErrRevisionUnchanged)
+	assert.Nil(t, rev)
+	deps.revisionRepo.AssertNotCalled(t, "RestoreTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRevisionUseCase_Rollback_RestoreError(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateRevisionUseCase()
+
+	c := h.NewChallenge(uuid.New(), "Web 1", "web", 100, "hash")
+	target := h.NewRevision(c.ID, 1, c)
+	c.Title = "Renamed"
+	deps.revisionRepo.On("GetByVersion", mock.Anything, c.ID, 1).Return(target, nil)
+	deps.tagRepo.On("GetAll", mock.Anything).Return([]*entity.Tag{}, nil)
+	h.RunTransactions()
+	h.ExpectChallengeState(c, nil, nil)
+	deps.revisionRepo.On("RestoreTx", mock.Anything, mock.Anything, c.ID, mock.Anything).Return(errors.New("db error"))
+
+	rev, err := uc.Rollback(context.Background(), c.ID, 1, uuid.New(), "127.0.0.1")
+
+	assert.Error(t, err)
+	assert.Nil(t, rev)
+	deps.txRepo.AssertNotCalled(t, "CreateAuditLogTx", mock.Anything, mock.Anything, mock.Anything)
+}
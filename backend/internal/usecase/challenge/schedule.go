@@ -0,0 +1,120 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+func (uc *ChallengeUseCase) GetSchedule(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeSchedule, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetSchedule - GetByID")
+	}
+	schedule, err := uc.scheduleRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetSchedule")
+	}
+	return schedule, nil
+}
+
+// SetSchedule replaces the release window of a challenge. Only a release still in the future is
+// announced; clearing both bounds removes the schedule.
+func (uc *ChallengeUseCase) SetSchedule(ctx context.Context, challengeID uuid.UUID, releaseAt, hideAt *time.Time, notifyOnRelease bool) (*entity.ChallengeSchedule, error) {
+	releaseAt, hideAt = utcOrNil(releaseAt), utcOrNil(hideAt)
+	if releaseAt != nil && hideAt != nil && !hideAt.After(*releaseAt) {
+		return nil, entityError.ErrInvalidChallengeSchedule
+	}
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetSchedule - GetByID")
+	}
+	schedule := &entity.ChallengeSchedule{
+		ChallengeID:     challengeID,
+		ReleaseAt:       releaseAt,
+		HideAt:          hideAt,
+		NotifyOnRelease: notifyOnRelease,
+	}
+	if schedule.IsEmpty() {
+		if err := uc.scheduleRepo.Delete(ctx, challengeID); err != nil {
+			return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetSchedule - Delete")
+		}
+		return schedule, nil
+	}
+	now := time.Now().UTC()
+	if releaseAt == nil || !releaseAt.After(now) {
+		schedule.AnnouncedAt = &now
+	}
+	if err := uc.scheduleRepo.Set(ctx, schedule); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetSchedule")
+	}
+	uc.submitInvalidateCache(ctx)
+	return schedule, nil
+}
+
+// GetReleaseTimeline lists every scheduled challenge, hidden ones included, ordered by release time.
+func (uc *ChallengeUseCase) GetReleaseTimeline(ctx context.Context) ([]*repo.ScheduledChallenge, error) {
+	uc.announceReleases(ctx)
+	timeline, err := uc.scheduleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetReleaseTimeline")
+	}
+	return timeline, nil
+}
+
+// announceReleases broadcasts the challenges whose release time has passed since the last read
+// and, when asked for, posts a global notification for each. Claiming is atomic, so every
+// release is announced once no matter how many readers race here.
+func (uc *ChallengeUseCase) announceReleases(ctx context.Context) {
+	if uc.scheduleRepo == nil {
+		return
+	}
+	released, err := uc.scheduleRepo.ClaimDueReleases(ctx)
+	if err != nil || len(released) == 0 {
+		return
+	}
+	uc.submitInvalidateCache(ctx)
+	for _, c := range released {
+		if uc.broadcaster != nil {
+			uc.broadcaster.NotifyRelease(c.Schedule.ChallengeID, c.Title, c.Category, c.Points)
+		}
+		if !c.Schedule.NotifyOnRelease || uc.notifRepo == nil {
+			continue
+		}
+		_ = uc.notifRepo.Create(ctx, &entity.Notification{
+			ID:        uuid.New(),
+			Title:     "New challenge released",
+			Content:   fmt.Sprintf("%s (%s, %d points) is now available", c.Title, c.Category, c.Points),
+			Type:      entity.NotificationInfo,
+			IsGlobal:  true,
+			CreatedAt: time.Now(),
+		})
+	}
+}
+
+// checkReleased hides challenges outside their release window from submissions.
+func (uc *ChallengeUseCase) checkReleased(ctx context.Context, challengeID uuid.UUID) error {
+	if uc.scheduleRepo == nil {
+		return nil
+	}
+	schedule, err := uc.scheduleRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - checkReleased")
+	}
+	if !schedule.IsLiveAt(time.Now().UTC()) {
+		return entityError.ErrChallengeNotFound
+	}
+	return nil
+}
+
+func utcOrNil(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
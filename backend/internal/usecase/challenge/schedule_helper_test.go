@@ -0,0 +1,57 @@
+package challenge
+
+import (
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+)
+
+type releaseRecorder struct {
+	released []uuid.UUID
+}
+
+func (r *releaseRecorder) NotifySolve(uuid.UUID, string, int, bool) {}
+
+func (r *releaseRecorder) NotifySolveRevoked(uuid.UUID, string, int, *uuid.UUID) {}
+
+func (r *releaseRecorder) NotifyNotification(string, string) {}
+
+func (r *releaseRecorder) NotifyUnlock(uuid.UUID, []uuid.UUID) {}
+
+func (r *releaseRecorder) NotifyRelease(challengeID uuid.UUID, _, _ string, _ int) {
+	r.released = append(r.released, challengeID)
+}
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithSchedule(recorder *releaseRecorder) (*ChallengeUseCase, redismock.ClientMock) {
+	h.t.Helper()
+	client, redis := redismock.NewClientMock()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		WithSolveRepo(h.deps.solveRepo),
+		WithTxRepo(h.deps.txRepo),
+		WithCompetitionRepo(h.deps.compRepo),
+		WithTeamRepo(h.deps.teamRepo),
+		WithRedis(client),
+		WithScheduleRepo(h.deps.scheduleRepo),
+		WithNotificationRepo(h.deps.notifRepo),
+		WithBroadcaster(recorder),
+	), redis
+}
+
+func (h *ChallengeTestHelper) NewScheduledChallenge(challengeID uuid.UUID, title string, releaseAt, hideAt *time.Time, notify bool) *repo.ScheduledChallenge {
+	h.t.Helper()
+	return &repo.ScheduledChallenge{
+		Schedule: &entity.ChallengeSchedule{
+			ChallengeID:     challengeID,
+			ReleaseAt:       releaseAt,
+			HideAt:          hideAt,
+			NotifyOnRelease: notify,
+		},
+		Title:    title,
+		Category: "Web",
+		Points:   100,
+	}
+}
@@ -0,0 +1,159 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeUseCase_SetSchedule_FutureRelease(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithSchedule(nil)
+
+	challengeID := uuid.New()
+	releaseAt := time.Now().Add(time.Hour)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Later", "Web", 100, ""), nil)
+	deps.scheduleRepo.On("Set", mock.Anything, mock.MatchedBy(func(s *entity.ChallengeSchedule) bool {
+		return s.ChallengeID == challengeID && s.ReleaseAt != nil && s.AnnouncedAt == nil && s.NotifyOnRelease
+	})).Return(nil)
+
+	schedule, err := uc.SetSchedule(context.Background(), challengeID, &releaseAt, nil, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.ChallengeScheduleStatusScheduled, schedule.StatusAt(time.Now()))
+}
+
+func TestChallengeUseCase_SetSchedule_PastReleaseNotAnnounced(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithSchedule(nil)
+
+	challengeID := uuid.New()
+	releaseAt, hideAt := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Now", "Web", 100, ""), nil)
+	deps.scheduleRepo.On("Set", mock.Anything, mock.MatchedBy(func(s *entity.ChallengeSchedule) bool {
+		return s.AnnouncedAt != nil
+	})).Return(nil)
+
+	_, err := uc.SetSchedule(context.Background(), challengeID, &releaseAt, &hideAt, true)
+
+	require.NoError(t, err)
+}
+
+func TestChallengeUseCase_SetSchedule_Clear(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithSchedule(nil)
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Any", "Web", 100, ""), nil)
+	deps.scheduleRepo.On("Delete", mock.Anything, challengeID).Return(nil)
+
+	schedule, err := uc.SetSchedule(context.Background(), challengeID, nil, nil, false)
+
+	require.NoError(t, err)
+	assert.True(t, schedule.IsEmpty())
+}
+
+func TestChallengeUseCase_SetSchedule_HideBeforeRelease(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc, _ := h.CreateChallengeUseCaseWithSchedule(nil)
+
+	releaseAt := time.Now().Add(2 * time.Hour)
+	hideAt := releaseAt.Add(-time.Hour)
+
+	_, err := uc.SetSchedule(context.Background(), uuid.New(), &releaseAt, &hideAt, false)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidChallengeSchedule)
+}
+
+func TestChallengeUseCase_GetAll_AnnouncesDueReleases(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	recorder := &releaseRecorder{}
+	uc, _ := h.CreateChallengeUseCaseWithSchedule(recorder)
+
+	quietID, loudID := uuid.New(), uuid.New()
+	releasedAt := time.Now().Add(-time.Minute)
+	deps.scheduleRepo.On("ClaimDueReleases", mock.Anything).Return([]*repo.ScheduledChallenge{
+		h.NewScheduledChallenge(quietID, "Quiet", &releasedAt, nil, false),
+		h.NewScheduledChallenge(loudID, "Loud", &releasedAt, nil, true),
+	}, nil)
+	deps.notifRepo.On("Create", mock.Anything, mock.MatchedBy(func(n *entity.Notification) bool {
+		return n.IsGlobal && n.Type == entity.NotificationInfo && n.Content != ""
+	})).Return(nil).Once()
+	deps.challengeRepo.On("GetAll", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return([]*repo.ChallengeWithSolved{
+		h.NewChallengeWithSolved(h.NewChallenge(quietID, "Quiet", "Web", 100, ""), false),
+		h.NewChallengeWithSolved(h.NewChallenge(loudID, "Loud", "Web", 100, ""), false),
+	}, nil)
+
+	result, err := uc.GetAll(context.Background(), nil, nil)
+
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, []uuid.UUID{quietID, loudID}, recorder.released)
+}
+
+func TestChallengeUseCase_SubmitFlag_NotReleased(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithSchedule(nil)
+
+	teamID, challengeID := uuid.New(), uuid.New()
+	releaseAt := time.Now().Add(time.Hour)
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Later", "Web", 100, h.Sha256Hash("flag{later}")), nil)
+	deps.scheduleRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(&entity.ChallengeSchedule{ChallengeID: challengeID, ReleaseAt: &releaseAt}, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challengeID, "flag{later}", uuid.New(), &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+	assert.False(t, valid)
+}
+
+func TestChallengeUseCase_CheckAccess_NotReleased(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	access, _ := h.CreateChallengeUseCaseWithSchedule(nil)
+	uc := NewFileUseCase(deps.fileRepo, deps.s3Provider, time.Hour, access)
+
+	teamID, challengeID, fileID := uuid.New(), uuid.New(), uuid.New()
+	releaseAt := time.Now().Add(time.Hour)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Later", "Web", 100, ""), nil)
+	deps.scheduleRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(&entity.ChallengeSchedule{ChallengeID: challengeID, ReleaseAt: &releaseAt}, nil)
+	deps.fileRepo.On("GetByID", mock.Anything, fileID).Return(&entity.File{ID: fileID, ChallengeID: challengeID, Location: "files/a.zip"}, nil)
+
+	files, err := uc.GetByChallengeID(context.Background(), challengeID, &teamID, entity.FileTypeChallenge)
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+	assert.Nil(t, files)
+
+	url, err := uc.GetDownloadURL(context.Background(), fileID, &teamID)
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+	assert.Empty(t, url)
+	deps.s3Provider.AssertNotCalled(t, "GetPresignedURL", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_GetReleaseTimeline(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithSchedule(nil)
+
+	releaseAt := time.Now().Add(time.Hour)
+	entry := h.NewScheduledChallenge(uuid.New(), "Later", &releaseAt, nil, false)
+	deps.scheduleRepo.On("ClaimDueReleases", mock.Anything).Return([]*repo.ScheduledChallenge{}, nil)
+	deps.scheduleRepo.On("GetAll", mock.Anything).Return([]*repo.ScheduledChallenge{entry}, nil)
+
+	timeline, err := uc.GetReleaseTimeline(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []*repo.ScheduledChallenge{entry}, timeline)
+}
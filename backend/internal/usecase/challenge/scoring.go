@@ -0,0 +1,174 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/scoring"
+	"github.com/skr1ms/CTFBoard/internal/usecase"
+	"github.com/skr1ms/CTFBoard/internal/usecase/competition"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// MaxScoringPreviewSolves bounds the point curve PreviewScoring computes.
+const MaxScoringPreviewSolves = 500
+
+func (uc *ChallengeUseCase) ListScoringFunctions() []*scoring.Function {
+	return scoring.Functions()
+}
+
+// PreviewScoring returns the value of a challenge after each of the first solves solves under
+// the given function, elapsed being the share of the event that has passed.
+func (uc *ChallengeUseCase) PreviewScoring(function string, params map[string]float64, solves int, elapsed float64) ([]int, error) {
+	if solves < 1 || solves > MaxScoringPreviewSolves || elapsed < 0 || elapsed > 1 {
+		return nil, entityError.ErrInvalidScoringPreview
+	}
+	f, err := scoring.Lookup(function)
+	if err != nil {
+		return nil, entityError.ErrUnknownScoringFunction
+	}
+	if err := f.Validate(params); err != nil {
+		return nil, entityError.InvalidScoringParams(err)
+	}
+	return f.Curve(params, solves, elapsed), nil
+}
+
+func (uc *ChallengeUseCase) GetScoring(ctx context.Context, challengeID uuid.UUID) (*usecase.ChallengeScoringState, error) {
+	c, err := uc.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetScoring - GetByID")
+	}
+	s, err := uc.scoringRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetScoring")
+	}
+	if s == nil {
+		return &usecase.ChallengeScoringState{ChallengeScoring: competition.DefaultScoring(c), IsDefault: true, Points: c.Points}, nil
+	}
+	return &usecase.ChallengeScoringState{ChallengeScoring: s, Points: c.Points}, nil
+}
+
+// SetScoring switches a challenge to the given scoring function and revalues it right away.
+// Teams that already solved the challenge gain or lose the difference, except under a
+// time-based function: its solves keep the value they were made at, so switching to one pins
+// the existing solves at the current value first.
+func (uc *ChallengeUseCase) SetScoring(ctx context.Context, challengeID uuid.UUID, function string, params map[string]float64) (*usecase.ChallengeScoringState, error) {
+	s := &entity.ChallengeScoring{ChallengeID: challengeID, Function: function, Params: params}
+	if err := competition.ValidateScoring(s); err != nil {
+		return nil, err
+	}
+	c, err := uc.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetScoring - GetByID")
+	}
+	if f, _ := scoring.Lookup(function); f.TimeBased {
+		if err := uc.solveRepo.PinPoints(ctx, challengeID, c.Points); err != nil {
+			return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetScoring - PinPoints")
+		}
+	}
+	if err := uc.scoringRepo.Set(ctx, s); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetScoring")
+	}
+	points, err := uc.revalue(ctx, c, s)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetScoring")
+	}
+	return &usecase.ChallengeScoringState{ChallengeScoring: s, Points: points}, nil
+}
+
+// ResetScoring returns a challenge to the default decay. A challenge without one keeps its
+// current value.
+func (uc *ChallengeUseCase) ResetScoring(ctx context.Context, challengeID uuid.UUID) (*usecase.ChallengeScoringState, error) {
+	c, err := uc.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ResetScoring - GetByID")
+	}
+	if err := uc.scoringRepo.Delete(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ResetScoring")
+	}
+	s := competition.DefaultScoring(c)
+	points, err := uc.revalue(ctx, c, s)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ResetScoring")
+	}
+	return &usecase.ChallengeScoringState{ChallengeScoring: s, IsDefault: true, Points: points}, nil
+}
+
+// revalue stores what c is worth under s and returns it.
+func (uc *ChallengeUseCase) revalue(ctx context.Context, c *entity.Challenge, s *entity.ChallengeScoring) (int, error) {
+	elapsed, err := competition.ScoringElapsed(ctx, uc.compRepo, s, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	points := competition.ScoreChallenge(s, c.SolveCount, elapsed)
+	if points == c.Points {
+		return points, nil
+	}
+	if err := uc.challengeRepo.UpdatePoints(ctx, c.ID, points); err != nil {
+		return 0, usecaseutil.Wrap(err, "UpdatePoints")
+	}
+	uc.submitInvalidateCache(ctx)
+	return points, nil
+}
+
+// RunScoringRefresh revalues time-based challenges every interval until ctx is cancelled.
+func (uc *ChallengeUseCase) RunScoringRefresh(ctx context.Context, interval time.Duration) {
+	if uc.scoringRepo == nil || interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.RefreshTimeScoring(ctx); err != nil && uc.logger != nil {
+				uc.logger.WithError(err).Error("ChallengeUseCase - RunScoringRefresh")
+			}
+		}
+	}
+}
+
+// RefreshTimeScoring revalues every challenge scored by a time-based function and returns how
+// many changed value. Solves of these challenges are pinned at the value they were made at,
+// so the refresh only changes what the next solver gets: solving early pays off.
+func (uc *ChallengeUseCase) RefreshTimeScoring(ctx context.Context) (int, error) {
+	var names []string
+	for _, f := range scoring.Functions() {
+		if f.TimeBased {
+			names = append(names, f.Name)
+		}
+	}
+	scored, err := uc.scoringRepo.GetByFunctions(ctx, names)
+	if err != nil {
+		return 0, usecaseutil.Wrap(err, "ChallengeUseCase - RefreshTimeScoring - GetByFunctions")
+	}
+	if len(scored) == 0 {
+		return 0, nil
+	}
+	comp, err := uc.compRepo.Get(ctx)
+	if err != nil && !errors.Is(err, entityError.ErrCompetitionNotFound) {
+		return 0, usecaseutil.Wrap(err, "ChallengeUseCase - RefreshTimeScoring - GetCompetition")
+	}
+	elapsed := competition.EventElapsed(comp, time.Now().UTC())
+	updated := 0
+	for _, sc := range scored {
+		points := competition.ScoreChallenge(sc.Scoring, sc.SolveCount, elapsed)
+		if points == sc.Points {
+			continue
+		}
+		if err := uc.challengeRepo.UpdatePoints(ctx, sc.Scoring.ChallengeID, points); err != nil {
+			return updated, usecaseutil.Wrap(err, "ChallengeUseCase - RefreshTimeScoring - UpdatePoints")
+		}
+		updated++
+	}
+	if updated > 0 {
+		uc.submitInvalidateCache(ctx)
+	}
+	return updated, nil
+}
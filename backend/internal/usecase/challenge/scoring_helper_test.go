@@ -0,0 +1,35 @@
+package challenge
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+)
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithScoring() *ChallengeUseCase {
+	h.t.Helper()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		WithCompetitionRepo(h.deps.compRepo),
+		WithScoringRepo(h.deps.scoringRepo),
+		WithSolveRepo(h.deps.solveRepo),
+	)
+}
+
+// NewDecayingChallenge returns a challenge with the default decay from 500 to 100 over 10 solves.
+func (h *ChallengeTestHelper) NewDecayingChallenge(points, solveCount int) *entity.Challenge {
+	h.t.Helper()
+	c := h.NewChallenge(uuid.New(), "Decaying", "Web", points, "hash")
+	c.InitialValue, c.MinValue, c.Decay = 500, 100, 10
+	c.SolveCount = solveCount
+	return c
+}
+
+// NewRunningCompetition returns a competition that is halfway through.
+func (h *ChallengeTestHelper) NewRunningCompetition() *entity.Competition {
+	h.t.Helper()
+	now := time.Now().UTC()
+	start, end := now.Add(-time.Hour), now.Add(time.Hour)
+	return &entity.Competition{StartTime: &start, EndTime: &end}
+}
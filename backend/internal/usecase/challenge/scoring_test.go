@@ -0,0 +1,193 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/scoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeUseCase_PreviewScoring_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithScoring()
+
+	points, err := uc.PreviewScoring(scoring.Linear, map[string]float64{"initial": 500, "minimum": 200, "decay": 100}, 5, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{500, 400, 300, 200, 200}, points)
+}
+
+func TestChallengeUseCase_PreviewScoring_UnknownFunction(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithScoring()
+
+	_, err := uc.PreviewScoring("sigmoid", map[string]float64{}, 5, 0)
+
+	assert.ErrorIs(t, err, entityError.ErrUnknownScoringFunction)
+}
+
+func TestChallengeUseCase_PreviewScoring_InvalidParams(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithScoring()
+
+	_, err := uc.PreviewScoring(scoring.Exponential, map[string]float64{"initial": 100, "minimum": 200, "half_life": 5}, 5, 0)
+
+	var httpErr *entityError.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, "INVALID_SCORING_PARAMS", httpErr.Code)
+	assert.Contains(t, httpErr.Error(), "minimum must not exceed initial")
+}
+
+func TestChallengeUseCase_PreviewScoring_TooManySolves(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithScoring()
+
+	_, err := uc.PreviewScoring(scoring.Static, map[string]float64{"initial": 100}, MaxScoringPreviewSolves+1, 0)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidScoringPreview)
+}
+
+func TestChallengeUseCase_GetScoring_Default(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithScoring()
+	c := h.NewDecayingChallenge(400, 3)
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.scoringRepo.On("GetByChallengeID", mock.Anything, c.ID).Return(nil, nil)
+
+	state, err := uc.GetScoring(context.Background(), c.ID)
+
+	require.NoError(t, err)
+	assert.True(t, state.IsDefault)
+	assert.Equal(t, scoring.Quadratic, state.Function)
+	assert.Equal(t, map[string]float64{"initial": 500, "minimum": 100, "decay": 10}, state.Params)
+	assert.Equal(t, 400, state.Points)
+}
+
+func TestChallengeUseCase_GetScoring_NotFound(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithScoring()
+	id := uuid.New()
+	h.Deps().challengeRepo.On("GetByID", mock.Anything, id).Return(nil, entityError.ErrChallengeNotFound)
+
+	_, err := uc.GetScoring(context.Background(), id)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+}
+
+func TestChallengeUseCase_SetScoring_SameValue(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithScoring()
+	c := h.NewDecayingChallenge(400, 3)
+	params := map[string]float64{"initial": 500, "minimum": 100, "decay": 50}
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.scoringRepo.On("Set", mock.Anything, mock.MatchedBy(func(s *entity.ChallengeScoring) bool {
+		return s.ChallengeID == c.ID && s.Function == scoring.Linear
+	})).Return(nil)
+
+	state, err := uc.SetScoring(context.Background(), c.ID, scoring.Linear, params)
+
+	require.NoError(t, err)
+	assert.False(t, state.IsDefault)
+	assert.Equal(t, 400, state.Points)
+	deps.challengeRepo.AssertNotCalled(t, "UpdatePoints", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SetScoring_TimeBased(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithScoring()
+	c := h.NewDecayingChallenge(400, 3)
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.solveRepo.On("PinPoints", mock.Anything, c.ID, 400).Return(nil)
+	deps.scoringRepo.On("Set", mock.Anything, mock.Anything).Return(nil)
+	deps.compRepo.On("Get", mock.Anything).Return(h.NewRunningCompetition(), nil)
+	deps.challengeRepo.On("UpdatePoints", mock.Anything, c.ID, 300).Return(nil)
+
+	state, err := uc.SetScoring(context.Background(), c.ID, scoring.Time, map[string]float64{"initial": 500, "minimum": 100})
+
+	require.NoError(t, err)
+	assert.Equal(t, 300, state.Points)
+}
+
+func TestChallengeUseCase_SetScoring_InvalidParams(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithScoring()
+
+	_, err := uc.SetScoring(context.Background(), uuid.New(), scoring.Logarithmic, map[string]float64{"initial": 500})
+
+	var httpErr *entityError.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Contains(t, httpErr.Error(), "decay is required")
+	h.Deps().scoringRepo.AssertNotCalled(t, "Set", mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_ResetScoring(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithScoring()
+	c := h.NewDecayingChallenge(250, 3)
+	deps.challengeRepo.On("GetByID", mock.Anything, c.ID).Return(c, nil)
+	deps.scoringRepo.On("Delete", mock.Anything, c.ID).Return(nil)
+	deps.challengeRepo.On("UpdatePoints", mock.Anything, c.ID, 484).Return(nil)
+
+	state, err := uc.ResetScoring(context.Background(), c.ID)
+
+	require.NoError(t, err)
+	assert.True(t, state.IsDefault)
+	assert.Equal(t, 484, state.Points)
+}
+
+func TestChallengeUseCase_RefreshTimeScoring(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithScoring()
+	params := map[string]float64{"initial": 500, "minimum": 100}
+	stale := &repo.ScoredChallenge{Scoring: &entity.ChallengeScoring{ChallengeID: uuid.New(), Function: scoring.Time, Params: params}, Points: 500}
+	fresh := &repo.ScoredChallenge{Scoring: &entity.ChallengeScoring{ChallengeID: uuid.New(), Function: scoring.Time, Params: params}, Points: 300}
+	deps.scoringRepo.On("GetByFunctions", mock.Anything, []string{scoring.Time}).Return([]*repo.ScoredChallenge{stale, fresh}, nil)
+	deps.compRepo.On("Get", mock.Anything).Return(h.NewRunningCompetition(), nil)
+	deps.challengeRepo.On("UpdatePoints", mock.Anything, stale.Scoring.ChallengeID, 300).Return(nil)
+
+	updated, err := uc.RefreshTimeScoring(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+}
+
+func TestChallengeUseCase_RefreshTimeScoring_SolvedChallenge(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateChallengeUseCaseWithScoring()
+	params := map[string]float64{"initial": 500, "minimum": 100}
+	solved := &repo.ScoredChallenge{Scoring: &entity.ChallengeScoring{ChallengeID: uuid.New(), Function: scoring.Time, Params: params}, Points: 500, SolveCount: 3}
+	deps.scoringRepo.On("GetByFunctions", mock.Anything, []string{scoring.Time}).Return([]*repo.ScoredChallenge{solved}, nil)
+	deps.compRepo.On("Get", mock.Anything).Return(h.NewRunningCompetition(), nil)
+	deps.challengeRepo.On("UpdatePoints", mock.Anything, solved.Scoring.ChallengeID, 300).Return(nil)
+
+	updated, err := uc.RefreshTimeScoring(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	deps.solveRepo.AssertNotCalled(t, "PinPoints", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_RefreshTimeScoring_Nothing(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateChallengeUseCaseWithScoring()
+	h.Deps().scoringRepo.On("GetByFunctions", mock.Anything, mock.Anything).Return(nil, nil)
+
+	updated, err := uc.RefreshTimeScoring(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+	h.Deps().compRepo.AssertNotCalled(t, "Get", mock.Anything)
+}
@@ -0,0 +1,1026 @@
+package challenge
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/challengespec"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/usecase/competition"
+	"github.com/skr1ms/CTFBoard/pkg/cache"
+	"github.com/skr1ms/CTFBoard/pkg/crypto"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+type SpecDeps struct {
+	SpecRepo        repo.ChallengeSpecRepository
+	ChallengeRepo   repo.ChallengeRepository
+	TagRepo         repo.TagRepository
+	HintRepo        repo.HintRepository
+	FlagRepo        repo.ChallengeFlagRepository
+	RequirementRepo repo.ChallengeRequirementRepository
+	FileRepo        repo.FileRepository
+	Crypto          crypto.Service
+	Challenges      *ChallengeUseCase
+	Files           *FileUseCase
+	Revisions       *RevisionUseCase
+	ScoreboardCache cache.ScoreboardCacheInvalidator
+}
+
+// SpecUseCase imports and exports challenges as ctfcli challenge.yml specs. Imported specs are
+// matched to challenges by their slug; a challenge that was never synced is adopted by the
+// spec whose slug equals its slugified title.
+type SpecUseCase struct {
+	deps SpecDeps
+}
+
+func NewSpecUseCase(deps SpecDeps) *SpecUseCase {
+	return &SpecUseCase{deps: deps}
+}
+
+// Import syncs the challenges described by a zip of challenge directories or by a single
+// challenge.yml. Nothing is written when the bundle has problems or dryRun is set; the result
+// then shows what would be created or updated. Challenges are applied one by one, so a failing
+// challenge is reported without undoing the others and a later import picks up where it
+// stopped. authorID is nil for service tokens.
+func (uc *SpecUseCase) Import(ctx context.Context, r io.ReaderAt, size int64, dryRun bool, authorID *uuid.UUID) (*entity.SpecSyncResult, error) {
+	bundle, problems, err := challengespec.ReadBundle(r, size)
+	if err != nil {
+		return nil, entityError.ErrInvalidSpecBundle
+	}
+	result := &entity.SpecSyncResult{DryRun: dryRun, Items: []entity.SpecSyncItem{}, Problems: []entity.SpecProblem{}}
+	if len(problems) > 0 {
+		result.Problems = problems
+		return result, nil
+	}
+
+	state, err := uc.loadSpecState(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "SpecUseCase - Import")
+	}
+	plans, problems, err := uc.plan(ctx, bundle, state)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "SpecUseCase - Import - Plan")
+	}
+	if len(problems) > 0 {
+		result.Problems = problems
+		return result, nil
+	}
+
+	if !dryRun {
+		uc.applyPlans(ctx, plans, state, authorID)
+	}
+	summarizeSpecSync(result, plans)
+	if !dryRun && result.Created+result.Updated+result.Failed > 0 && uc.deps.ScoreboardCache != nil {
+		uc.deps.ScoreboardCache.InvalidateAll(ctx)
+	}
+	return result, nil
+}
+
+func summarizeSpecSync(result *entity.SpecSyncResult, plans []*specPlan) {
+	for _, p := range plans {
+		result.Items = append(result.Items, p.item)
+		switch p.item.Action {
+		case entity.SpecActionCreate:
+			result.Created++
+		case entity.SpecActionUpdate:
+			result.Updated++
+		case entity.SpecActionUnchanged:
+			result.Unchanged++
+		case entity.SpecActionFailed:
+			result.Failed++
+		}
+	}
+}
+
+// Export writes the challenges matching filter as a zip of challenge directories with their
+// attachments. Static flags are exported under flag_hashes because only their hash is stored;
+// ctfcli ignores that key, so it only sees the regex flags.
+func (uc *SpecUseCase) Export(ctx context.Context, filter entity.SpecExportFilter) (io.ReadCloser, error) {
+	state, err := uc.loadSpecState(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "SpecUseCase - Export")
+	}
+	slugs := exportSlugs(state.challenges)
+
+	var specs []*exportSpec
+	for _, lc := range state.challenges {
+		c := lc.Challenge
+		if filter.ChallengeID != nil && c.ID != *filter.ChallengeID {
+			continue
+		}
+		if filter.Category != "" && c.Category != filter.Category {
+			continue
+		}
+		spec, err := uc.buildSpec(ctx, c, slugs, state)
+		if err != nil {
+			return nil, usecaseutil.Wrap(err, "SpecUseCase - Export - BuildSpec")
+		}
+		files, err := uc.deps.FileRepo.GetByChallengeID(ctx, c.ID, entity.FileTypeChallenge)
+		if err != nil {
+			return nil, usecaseutil.Wrap(err, "SpecUseCase - Export - GetFiles")
+		}
+		specs = append(specs, &exportSpec{spec: spec, files: files})
+	}
+	if len(specs) == 0 {
+		return nil, entityError.ErrChallengeNotFound
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(uc.writeSpecs(ctx, pw, specs))
+	}()
+	return pr, nil
+}
+
+type exportSpec struct {
+	spec  *challengespec.Spec
+	files []*entity.File
+}
+
+func (uc *SpecUseCase) writeSpecs(ctx context.Context, w io.Writer, specs []*exportSpec) error {
+	zw := challengespec.NewWriter(w)
+	for _, es := range specs {
+		for _, f := range es.files {
+			rc, err := uc.deps.Files.Download(ctx, f.Location)
+			if err != nil {
+				return usecaseutil.Wrap(err, "SpecUseCase - Export - Download")
+			}
+			err = zw.AddFile(es.spec, f.Filename, rc)
+			_ = rc.Close()
+			if err != nil {
+				return usecaseutil.Wrap(err, "SpecUseCase - Export - AddFile")
+			}
+		}
+		if err := zw.AddSpec(es.spec); err != nil {
+			return usecaseutil.Wrap(err, "SpecUseCase - Export - AddSpec")
+		}
+	}
+	return zw.Close()
+}
+
+// specState is what an import is compared against, loaded once per request.
+type specState struct {
+	challenges   []*entity.LinkedChallenge
+	bySlug       map[string]*entity.LinkedChallenge
+	byTitleSlug  map[string][]*entity.LinkedChallenge
+	byTitle      map[string][]*entity.LinkedChallenge
+	tags         map[string]*entity.Tag
+	requirements map[uuid.UUID]*entity.ChallengeRequirements
+}
+
+func (uc *SpecUseCase) loadSpecState(ctx context.Context) (*specState, error) {
+	challenges, err := uc.deps.SpecRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetChallenges")
+	}
+	tags, err := uc.deps.TagRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetTags")
+	}
+	requirements, err := uc.deps.RequirementRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetRequirements")
+	}
+
+	s := &specState{
+		challenges:   challenges,
+		bySlug:       make(map[string]*entity.LinkedChallenge, len(challenges)),
+		byTitleSlug:  make(map[string][]*entity.LinkedChallenge),
+		byTitle:      make(map[string][]*entity.LinkedChallenge),
+		tags:         make(map[string]*entity.Tag, len(tags)),
+		requirements: make(map[uuid.UUID]*entity.ChallengeRequirements, len(requirements)),
+	}
+	for _, lc := range challenges {
+		s.byTitle[lc.Challenge.Title] = append(s.byTitle[lc.Challenge.Title], lc)
+		if lc.Slug != "" {
+			s.bySlug[lc.Slug] = lc
+			continue
+		}
+		titleSlug := challengespec.Slugify(lc.Challenge.Title)
+		s.byTitleSlug[titleSlug] = append(s.byTitleSlug[titleSlug], lc)
+	}
+	for _, tag := range tags {
+		s.tags[tag.Name] = tag
+	}
+	for _, req := range requirements {
+		s.requirements[req.ChallengeID] = req
+	}
+	return s, nil
+}
+
+// match finds the challenge a slug refers to: the one linked to it, or else the only
+// never-synced challenge whose title slugifies to it.
+func (s *specState) match(slug string) (*entity.LinkedChallenge, error) {
+	if lc, ok := s.bySlug[slug]; ok {
+		return lc, nil
+	}
+	switch candidates := s.byTitleSlug[slug]; len(candidates) {
+	case 0:
+		return nil, nil
+	case 1:
+		return candidates[0], nil
+	default:
+		return nil, fmt.Errorf("slug %q matches %d existing challenges by title; rename all but one", slug, len(candidates))
+	}
+}
+
+// specPlan is the desired state of one challenge as described by its spec.
+type specPlan struct {
+	entry   *challengespec.Entry
+	slug    string
+	target  *entity.LinkedChallenge
+	flags   []specFlag
+	tags    []string
+	files   []specFile
+	prereqs []specRef
+	changed map[string]bool
+	item    entity.SpecSyncItem
+}
+
+// specFlag is a flag the way it is compared: static flags by hash, regex flags by pattern.
+type specFlag struct {
+	isRegex         bool
+	value           string
+	caseInsensitive bool
+}
+
+func (f specFlag) key() string {
+	return fmt.Sprintf("%t|%t|%s", f.isRegex, f.caseInsensitive, f.value)
+}
+
+type specFile struct {
+	name   string
+	sha256 string
+	size   int64
+	zf     *zip.File
+}
+
+// specRef is a prerequisite: either another spec of the same import or an existing challenge.
+type specRef struct {
+	plan *specPlan
+	id   uuid.UUID
+}
+
+func (p *specPlan) challengeID() (uuid.UUID, bool) {
+	if p.item.ChallengeID == nil {
+		return uuid.Nil, false
+	}
+	return *p.item.ChallengeID, true
+}
+
+func (p *specPlan) creates() bool {
+	return p.target == nil
+}
+
+// plan matches every spec of the bundle to a challenge and works out what it would change.
+func (uc *SpecUseCase) plan(ctx context.Context, bundle *challengespec.Bundle, state *specState) ([]*specPlan, []entity.SpecProblem, error) {
+	var problems []entity.SpecProblem
+	plans := make([]*specPlan, 0, len(bundle.Entries))
+	claimed := make(map[uuid.UUID]string)
+	for _, e := range bundle.Entries {
+		p, err := newSpecPlan(bundle, e)
+		if err != nil {
+			return nil, nil, err
+		}
+		target, err := state.match(p.slug)
+		if err != nil {
+			problems = append(problems, entity.SpecProblem{Path: e.Path, Message: err.Error()})
+		}
+		if target != nil {
+			if other, ok := claimed[target.Challenge.ID]; ok {
+				problems = append(problems, entity.SpecProblem{Path: e.Path, Message: fmt.Sprintf("matches the same challenge as %s", other)})
+			}
+			claimed[target.Challenge.ID] = e.Path
+			p.target = target
+			p.item.ChallengeID = &target.Challenge.ID
+		}
+		plans = append(plans, p)
+	}
+	problems = append(problems, resolveRequirements(plans, state)...)
+	if len(problems) > 0 {
+		return nil, problems, nil
+	}
+	for _, p := range plans {
+		if err := uc.planChanges(ctx, p, state); err != nil {
+			return nil, nil, err
+		}
+	}
+	return plans, nil, nil
+}
+
+func newSpecPlan(bundle *challengespec.Bundle, e *challengespec.Entry) (*specPlan, error) {
+	s := e.Spec
+	p := &specPlan{
+		entry:   e,
+		slug:    s.StableSlug(),
+		tags:    specTagNames(s.Tags),
+		changed: map[string]bool{},
+		item: entity.SpecSyncItem{
+			Path:          e.Path,
+			Slug:          s.StableSlug(),
+			Name:          strings.TrimSpace(s.Name),
+			Category:      strings.TrimSpace(s.Category),
+			ChangedFields: []string{},
+		},
+	}
+	for _, f := range s.AllFlags() {
+		p.flags = append(p.flags, toSpecFlag(f))
+	}
+	for _, name := range s.Files {
+		zf, _ := bundle.Attachment(e, name)
+		sum, size, err := hashZipFile(zf)
+		if err != nil {
+			return nil, usecaseutil.Wrap(err, "HashFile")
+		}
+		p.files = append(p.files, specFile{name: path.Base(zf.Name), sha256: sum, size: size, zf: zf})
+	}
+	return p, nil
+}
+
+func resolveRequirements(plans []*specPlan, state *specState) []entity.SpecProblem {
+	var problems []entity.SpecProblem
+	plansBySlug := make(map[string]*specPlan, len(plans))
+	plansByName := make(map[string]*specPlan, len(plans))
+	for _, p := range plans {
+		plansBySlug[p.slug] = p
+		if _, ok := plansByName[p.item.Name]; !ok {
+			plansByName[p.item.Name] = p
+		}
+	}
+	for _, p := range plans {
+		for _, name := range p.entry.Spec.Requirements {
+			ref, err := resolveRequirement(strings.TrimSpace(name), plansBySlug, plansByName, state)
+			if err != nil {
+				problems = append(problems, entity.SpecProblem{Path: p.entry.Path, Message: err.Error()})
+				continue
+			}
+			if ref.plan == p || (p.target != nil && ref.id == p.target.Challenge.ID) {
+				problems = append(problems, entity.SpecProblem{Path: p.entry.Path, Message: fmt.Sprintf("requirement %q refers to the challenge itself", name)})
+				continue
+			}
+			p.prereqs = append(p.prereqs, ref)
+		}
+	}
+	return problems
+}
+
+// resolveRequirement looks a requirement up by slug, then by name, first among the imported
+// specs and then among existing challenges.
+func resolveRequirement(name string, plansBySlug, plansByName map[string]*specPlan, state *specState) (specRef, error) {
+	if p, ok := plansBySlug[name]; ok {
+		return specRef{plan: p}, nil
+	}
+	if p, ok := plansByName[name]; ok {
+		return specRef{plan: p}, nil
+	}
+	lc, err := state.match(name)
+	if err != nil {
+		return specRef{}, fmt.Errorf("requirement: %w", err)
+	}
+	if lc == nil {
+		switch byTitle := state.byTitle[name]; len(byTitle) {
+		case 0:
+		case 1:
+			lc = byTitle[0]
+		default:
+			return specRef{}, fmt.Errorf("requirement %q matches %d challenges by title", name, len(byTitle))
+		}
+	}
+	if lc == nil {
+		return specRef{}, fmt.Errorf("requirement %q matches no challenge", name)
+	}
+	return specRef{id: lc.Challenge.ID}, nil
+}
+
+// planChanges decides whether the spec creates, updates or leaves its challenge alone and
+// which fields it changes.
+func (uc *SpecUseCase) planChanges(ctx context.Context, p *specPlan, state *specState) error {
+	if p.creates() {
+		p.item.Action = entity.SpecActionCreate
+		p.changed[entity.SpecFieldRequirements] = len(p.prereqs) > 0
+		return nil
+	}
+	c := p.target.Challenge
+	want := *c
+	p.applyTo(&want)
+	p.mark(entity.RevisionFieldTitle, c.Title != want.Title)
+	p.mark(entity.RevisionFieldDescription, c.Description != want.Description)
+	p.mark(entity.RevisionFieldCategory, c.Category != want.Category)
+	p.mark(entity.RevisionFieldPoints, c.Points != want.Points)
+	p.mark(entity.RevisionFieldInitialValue, c.InitialValue != want.InitialValue)
+	p.mark(entity.RevisionFieldMinValue, c.MinValue != want.MinValue)
+	p.mark(entity.RevisionFieldDecay, c.Decay != want.Decay)
+	p.mark(entity.RevisionFieldIsHidden, c.IsHidden != want.IsHidden)
+	if err := uc.planRelationChanges(ctx, p); err != nil {
+		return err
+	}
+	p.mark(entity.SpecFieldRequirements, !p.requirementsMatch(state.requirements[c.ID]))
+	p.mark(entity.SpecFieldSlug, p.target.Slug != p.slug)
+
+	p.item.Action = entity.SpecActionUnchanged
+	if len(p.item.ChangedFields) > 0 {
+		p.item.Action = entity.SpecActionUpdate
+	}
+	return nil
+}
+
+func (uc *SpecUseCase) planRelationChanges(ctx context.Context, p *specPlan) error {
+	c := p.target.Challenge
+	flagKeys, err := uc.currentFlagKeys(ctx, c)
+	if err != nil {
+		return err
+	}
+	wantFlagKeys := make([]string, len(p.flags))
+	for i, f := range p.flags {
+		wantFlagKeys[i] = f.key()
+	}
+	// Flags compare as a set: hashed flags are exported apart from the others, so the primary
+	// flag can come back in another position.
+	slices.Sort(flagKeys)
+	slices.Sort(wantFlagKeys)
+	p.mark(entity.SpecFieldFlags, !slices.Equal(flagKeys, wantFlagKeys))
+
+	tags, err := uc.deps.TagRepo.GetByChallengeID(ctx, c.ID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetTags")
+	}
+	tagNames := make([]string, len(tags))
+	for i, tag := range tags {
+		tagNames[i] = tag.Name
+	}
+	p.mark(entity.RevisionFieldTags, !slices.Equal(specTagNames(tagNames), p.tags))
+
+	hints, err := uc.deps.HintRepo.GetByChallengeID(ctx, c.ID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetHints")
+	}
+	p.mark(entity.RevisionFieldHints, !hintsMatchSpec(hints, p.entry.Spec.Hints))
+
+	files, err := uc.deps.FileRepo.GetByChallengeID(ctx, c.ID, entity.FileTypeChallenge)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetFiles")
+	}
+	p.mark(entity.SpecFieldFiles, !filesMatchSpec(files, p.files))
+	return nil
+}
+
+func (p *specPlan) mark(field string, changed bool) {
+	if changed {
+		p.changed[field] = true
+		p.item.ChangedFields = append(p.item.ChangedFields, field)
+	}
+}
+
+// applyTo copies the scalar fields of the spec onto c. Dynamic challenges keep the value their
+// solves have decayed them to.
+func (p *specPlan) applyTo(c *entity.Challenge) {
+	s := p.entry.Spec
+	c.Title = strings.TrimSpace(s.Name)
+	c.Description = s.Description
+	c.Category = strings.TrimSpace(s.Category)
+	c.InitialValue, c.MinValue, c.Decay = s.Scoring()
+	c.Points = s.Value
+	if c.Decay > 0 {
+		c.Points = competition.CalculateDynamicScore(c.InitialValue, c.MinValue, c.Decay, c.SolveCount)
+	}
+	c.IsHidden = s.IsHidden()
+}
+
+func (p *specPlan) requirementsMatch(current *entity.ChallengeRequirements) bool {
+	want := make(map[uuid.UUID]bool, len(p.prereqs))
+	for _, ref := range p.prereqs {
+		id := ref.id
+		if ref.plan != nil {
+			if ref.plan.creates() {
+				return false
+			}
+			id = ref.plan.target.Challenge.ID
+		}
+		want[id] = true
+	}
+	have := map[uuid.UUID]bool{}
+	if current != nil {
+		for _, id := range current.Prerequisites {
+			have[id] = true
+		}
+	}
+	if len(want) != len(have) {
+		return false
+	}
+	for id := range want {
+		if !have[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func (uc *SpecUseCase) applyPlans(ctx context.Context, plans []*specPlan, state *specState, authorID *uuid.UUID) {
+	fail := func(p *specPlan, err error) {
+		p.item.Action = entity.SpecActionFailed
+		p.item.Error = err.Error()
+	}
+	for _, p := range plans {
+		if p.item.Action == entity.SpecActionUnchanged {
+			continue
+		}
+		if err := uc.applyChallenge(ctx, p, state); err != nil {
+			fail(p, err)
+		}
+	}
+	for _, p := range plans {
+		if p.item.Action == entity.SpecActionFailed || !p.changed[entity.SpecFieldRequirements] {
+			continue
+		}
+		if err := uc.applyRequirements(ctx, p, state); err != nil {
+			fail(p, err)
+		}
+	}
+	for _, p := range plans {
+		if p.item.Action != entity.SpecActionCreate && p.item.Action != entity.SpecActionUpdate {
+			continue
+		}
+		id, _ := p.challengeID()
+		if _, err := uc.deps.Revisions.Record(ctx, id, authorID); err != nil {
+			fail(p, err)
+		}
+	}
+}
+
+// applyChallenge writes the challenge row and whatever the plan changes around it.
+// Requirements are applied separately once every challenge of the import exists.
+func (uc *SpecUseCase) applyChallenge(ctx context.Context, p *specPlan, state *specState) error {
+	c, err := uc.saveChallenge(ctx, p)
+	if err != nil {
+		return err
+	}
+	steps := []struct {
+		field string
+		apply func() error
+	}{
+		{entity.RevisionFieldTags, func() error { return uc.applyTags(ctx, c.ID, p.tags, state) }},
+		{entity.RevisionFieldHints, func() error { return uc.applyHints(ctx, c.ID, p.entry.Spec.Hints) }},
+		{entity.SpecFieldFlags, func() error { return uc.applyExtraFlags(ctx, c.ID, p.flags[1:]) }},
+		{entity.SpecFieldFiles, func() error { return uc.applyFiles(ctx, c.ID, p.files) }},
+		{entity.SpecFieldSlug, func() error { return uc.applySlug(ctx, c.ID, p.slug) }},
+	}
+	for _, step := range steps {
+		if !p.creates() && !p.changed[step.field] {
+			continue
+		}
+		if err := step.apply(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *SpecUseCase) saveChallenge(ctx context.Context, p *specPlan) (*entity.Challenge, error) {
+	c := &entity.Challenge{}
+	if !p.creates() {
+		current := *p.target.Challenge
+		c = &current
+	}
+	p.applyTo(c)
+	if p.creates() || p.changed[entity.SpecFieldFlags] {
+		if err := uc.applyPrimaryFlag(c, p.flags[0]); err != nil {
+			return nil, err
+		}
+	}
+	if p.creates() {
+		if err := uc.deps.ChallengeRepo.Create(ctx, c); err != nil {
+			return nil, usecaseutil.Wrap(err, "SpecUseCase - Import - Create")
+		}
+		p.item.ChallengeID = &c.ID
+		return c, nil
+	}
+	if err := uc.deps.ChallengeRepo.Update(ctx, c); err != nil {
+		return nil, usecaseutil.Wrap(err, "SpecUseCase - Import - Update")
+	}
+	return c, nil
+}
+
+func (uc *SpecUseCase) applySlug(ctx context.Context, challengeID uuid.UUID, slug string) error {
+	if err := uc.deps.SpecRepo.Link(ctx, challengeID, slug); err != nil {
+		return usecaseutil.Wrap(err, "SpecUseCase - Import - Link")
+	}
+	return nil
+}
+
+func (uc *SpecUseCase) applyPrimaryFlag(c *entity.Challenge, f specFlag) error {
+	c.IsRegex = f.isRegex
+	c.IsCaseInsensitive = f.caseInsensitive
+	if !f.isRegex {
+		c.FlagHash = f.value
+		c.FlagRegex = ""
+		return nil
+	}
+	encrypted, err := uc.encrypt(f.value)
+	if err != nil {
+		return err
+	}
+	c.FlagHash = "REGEX_CHALLENGE"
+	c.FlagRegex = encrypted
+	return nil
+}
+
+func (uc *SpecUseCase) applyExtraFlags(ctx context.Context, challengeID uuid.UUID, flags []specFlag) error {
+	existing, err := uc.deps.FlagRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "SpecUseCase - Import - GetFlags")
+	}
+	for _, f := range existing {
+		if err := uc.deps.FlagRepo.Delete(ctx, f.ID); err != nil {
+			return usecaseutil.Wrap(err, "SpecUseCase - Import - DeleteFlag")
+		}
+	}
+	for _, f := range flags {
+		flag := &entity.ChallengeFlag{ChallengeID: challengeID, Type: entity.FlagTypeStatic, FlagHash: f.value, IsCaseInsensitive: f.caseInsensitive}
+		if f.isRegex {
+			encrypted, err := uc.encrypt(f.value)
+			if err != nil {
+				return err
+			}
+			flag.Type = entity.FlagTypeRegex
+			flag.FlagHash = ""
+			flag.FlagRegex = encrypted
+		}
+		if err := uc.deps.FlagRepo.Create(ctx, flag); err != nil {
+			return usecaseutil.Wrap(err, "SpecUseCase - Import - CreateFlag")
+		}
+	}
+	return nil
+}
+
+func (uc *SpecUseCase) applyTags(ctx context.Context, challengeID uuid.UUID, names []string, state *specState) error {
+	ids := make([]uuid.UUID, 0, len(names))
+	for _, name := range names {
+		tag, ok := state.tags[name]
+		if !ok {
+			tag = &entity.Tag{Name: name}
+			if err := uc.deps.TagRepo.Create(ctx, tag); err != nil {
+				return usecaseutil.Wrap(err, "SpecUseCase - Import - CreateTag")
+			}
+			state.tags[name] = tag
+		}
+		ids = append(ids, tag.ID)
+	}
+	if err := uc.deps.TagRepo.SetChallengeTags(ctx, challengeID, ids); err != nil {
+		return usecaseutil.Wrap(err, "SpecUseCase - Import - SetTags")
+	}
+	return nil
+}
+
+// applyHints matches hints by position, so teams keep their unlocks for hints that were only
+// reworded.
+func (uc *SpecUseCase) applyHints(ctx context.Context, challengeID uuid.UUID, hints []challengespec.Hint) error {
+	existing, err := uc.deps.HintRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "SpecUseCase - Import - GetHints")
+	}
+	slices.SortStableFunc(existing, func(a, b *entity.Hint) int { return a.OrderIndex - b.OrderIndex })
+	for i, h := range hints {
+		if i < len(existing) {
+			hint := existing[i]
+			if hint.Content == h.Content && hint.Cost == h.Cost && hint.OrderIndex == i {
+				continue
+			}
+			hint.Content, hint.Cost, hint.OrderIndex = h.Content, h.Cost, i
+			if err := uc.deps.HintRepo.Update(ctx, hint); err != nil {
+				return usecaseutil.Wrap(err, "SpecUseCase - Import - UpdateHint")
+			}
+			continue
+		}
+		hint := &entity.Hint{ChallengeID: challengeID, Content: h.Content, Cost: h.Cost, OrderIndex: i}
+		if err := uc.deps.HintRepo.Create(ctx, hint); err != nil {
+			return usecaseutil.Wrap(err, "SpecUseCase - Import - CreateHint")
+		}
+	}
+	for _, hint := range existing[min(len(hints), len(existing)):] {
+		if err := uc.deps.HintRepo.Delete(ctx, hint.ID); err != nil {
+			return usecaseutil.Wrap(err, "SpecUseCase - Import - DeleteHint")
+		}
+	}
+	return nil
+}
+
+// applyFiles uploads attachments that are new or whose content changed and deletes the ones
+// the spec no longer lists.
+func (uc *SpecUseCase) applyFiles(ctx context.Context, challengeID uuid.UUID, files []specFile) error {
+	existing, err := uc.deps.FileRepo.GetByChallengeID(ctx, challengeID, entity.FileTypeChallenge)
+	if err != nil {
+		return usecaseutil.Wrap(err, "SpecUseCase - Import - GetFiles")
+	}
+	wanted := make(map[string]string, len(files))
+	for _, f := range files {
+		wanted[f.name] = f.sha256
+	}
+	kept := make(map[string]bool, len(existing))
+	for _, f := range existing {
+		if sum, ok := wanted[f.Filename]; ok && sum == f.SHA256 && !kept[f.Filename] {
+			kept[f.Filename] = true
+			continue
+		}
+		if err := uc.deps.Files.Delete(ctx, f.ID); err != nil {
+			return usecaseutil.Wrap(err, "SpecUseCase - Import - DeleteFile")
+		}
+	}
+	for _, f := range files {
+		if kept[f.name] {
+			continue
+		}
+		if err := uc.uploadFile(ctx, challengeID, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *SpecUseCase) uploadFile(ctx context.Context, challengeID uuid.UUID, f specFile) error {
+	rc, err := f.zf.Open()
+	if err != nil {
+		return usecaseutil.Wrap(err, "SpecUseCase - Import - OpenFile")
+	}
+	defer rc.Close()
+	contentType := mime.TypeByExtension(path.Ext(f.name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	if _, err := uc.deps.Files.Upload(ctx, challengeID, entity.FileTypeChallenge, f.name, rc, f.size, contentType); err != nil {
+		return usecaseutil.Wrap(err, "SpecUseCase - Import - Upload")
+	}
+	return nil
+}
+
+func (uc *SpecUseCase) applyRequirements(ctx context.Context, p *specPlan, state *specState) error {
+	id, _ := p.challengeID()
+	prereqs := make([]uuid.UUID, 0, len(p.prereqs))
+	for _, ref := range p.prereqs {
+		if ref.plan == nil {
+			prereqs = append(prereqs, ref.id)
+			continue
+		}
+		refID, ok := ref.plan.challengeID()
+		if !ok || ref.plan.item.Action == entity.SpecActionFailed {
+			return fmt.Errorf("requirement %s was not imported", ref.plan.slug)
+		}
+		prereqs = append(prereqs, refID)
+	}
+	minScore := 0
+	if current := state.requirements[id]; current != nil {
+		minScore = current.MinScore
+	}
+	if _, err := uc.deps.Challenges.SetRequirements(ctx, id, prereqs, minScore); err != nil {
+		return usecaseutil.Wrap(err, "SpecUseCase - Import - SetRequirements")
+	}
+	return nil
+}
+
+// buildSpec describes an existing challenge as a spec; attachments are added while writing.
+func (uc *SpecUseCase) buildSpec(ctx context.Context, c *entity.Challenge, slugs map[uuid.UUID]string, state *specState) (*challengespec.Spec, error) {
+	s := &challengespec.Spec{
+		Name:        c.Title,
+		Slug:        slugs[c.ID],
+		Category:    c.Category,
+		Description: c.Description,
+		Value:       c.Points,
+		Type:        challengespec.TypeStandard,
+		State:       challengespec.StateVisible,
+	}
+	if c.IsHidden {
+		s.State = challengespec.StateHidden
+	}
+	if c.InitialValue > 0 && c.Decay > 0 {
+		s.Type = challengespec.TypeDynamic
+		s.Value = c.InitialValue
+		s.Extra = &challengespec.Extra{Initial: c.InitialValue, Decay: c.Decay, Minimum: c.MinValue}
+	}
+
+	flags, err := uc.exportFlags(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range flags {
+		s.AddFlag(f)
+	}
+
+	tags, err := uc.deps.TagRepo.GetByChallengeID(ctx, c.ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetTags")
+	}
+	for _, tag := range tags {
+		s.Tags = append(s.Tags, tag.Name)
+	}
+	s.Tags = specTagNames(s.Tags)
+
+	hints, err := uc.deps.HintRepo.GetByChallengeID(ctx, c.ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetHints")
+	}
+	slices.SortStableFunc(hints, func(a, b *entity.Hint) int { return a.OrderIndex - b.OrderIndex })
+	for _, h := range hints {
+		s.Hints = append(s.Hints, challengespec.Hint{Content: h.Content, Cost: h.Cost})
+	}
+
+	if req := state.requirements[c.ID]; req != nil {
+		for _, id := range req.Prerequisites {
+			if slug, ok := slugs[id]; ok {
+				s.Requirements = append(s.Requirements, slug)
+			}
+		}
+		slices.Sort(s.Requirements)
+	}
+	return s, nil
+}
+
+func (uc *SpecUseCase) exportFlags(ctx context.Context, c *entity.Challenge) ([]challengespec.Flag, error) {
+	primary, err := uc.exportFlag(c.IsRegex, c.FlagHash, c.FlagRegex, c.IsCaseInsensitive)
+	if err != nil {
+		return nil, err
+	}
+	flags := []challengespec.Flag{primary}
+	extra, err := uc.deps.FlagRepo.GetByChallengeID(ctx, c.ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetFlags")
+	}
+	for _, f := range extra {
+		flag, err := uc.exportFlag(f.Type == entity.FlagTypeRegex, f.FlagHash, f.FlagRegex, f.IsCaseInsensitive)
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
+func (uc *SpecUseCase) exportFlag(isRegex bool, flagHash, flagRegex string, caseInsensitive bool) (challengespec.Flag, error) {
+	f := challengespec.Flag{Type: challengespec.FlagTypeHash, Content: flagHash}
+	if caseInsensitive {
+		f.Data = challengespec.FlagDataCaseInsensitive
+	}
+	if !isRegex {
+		return f, nil
+	}
+	pattern, err := uc.decrypt(flagRegex)
+	if err != nil {
+		return challengespec.Flag{}, err
+	}
+	f.Type = challengespec.FlagTypeRegex
+	f.Content = pattern
+	return f, nil
+}
+
+func (uc *SpecUseCase) currentFlagKeys(ctx context.Context, c *entity.Challenge) ([]string, error) {
+	keys := []string{uc.storedFlag(c.IsRegex, c.FlagHash, c.FlagRegex, c.IsCaseInsensitive).key()}
+	extra, err := uc.deps.FlagRepo.GetByChallengeID(ctx, c.ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetFlags")
+	}
+	for _, f := range extra {
+		keys = append(keys, uc.storedFlag(f.Type == entity.FlagTypeRegex, f.FlagHash, f.FlagRegex, f.IsCaseInsensitive).key())
+	}
+	return keys, nil
+}
+
+// storedFlag turns a stored flag into its comparable form. A regex that cannot be decrypted
+// never matches a spec, so the import rewrites it.
+func (uc *SpecUseCase) storedFlag(isRegex bool, flagHash, flagRegex string, caseInsensitive bool) specFlag {
+	if !isRegex {
+		return specFlag{value: flagHash, caseInsensitive: caseInsensitive}
+	}
+	pattern, err := uc.decrypt(flagRegex)
+	if err != nil {
+		pattern = flagRegex
+	}
+	return specFlag{isRegex: true, value: pattern, caseInsensitive: caseInsensitive}
+}
+
+func toSpecFlag(f challengespec.Flag) specFlag {
+	if f.Type == challengespec.FlagTypeRegex {
+		return specFlag{isRegex: true, value: f.Content, caseInsensitive: f.IsCaseInsensitive()}
+	}
+	value := f.Content
+	if f.Type != challengespec.FlagTypeHash {
+		value = hashFlag(f.Content, f.IsCaseInsensitive())
+	}
+	return specFlag{value: value, caseInsensitive: f.IsCaseInsensitive()}
+}
+
+func (uc *SpecUseCase) encrypt(value string) (string, error) {
+	if uc.deps.Crypto == nil {
+		return "", usecaseutil.Wrap(crypto.ErrServiceNotConfigured, "SpecUseCase - Encrypt")
+	}
+	encrypted, err := uc.deps.Crypto.Encrypt(value)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "SpecUseCase - Encrypt")
+	}
+	return encrypted, nil
+}
+
+func (uc *SpecUseCase) decrypt(value string) (string, error) {
+	if uc.deps.Crypto == nil {
+		return "", usecaseutil.Wrap(crypto.ErrServiceNotConfigured, "SpecUseCase - Decrypt")
+	}
+	pattern, err := uc.deps.Crypto.Decrypt(value)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "SpecUseCase - Decrypt")
+	}
+	return pattern, nil
+}
+
+// specTagNames trims, deduplicates and sorts tag names so they compare independent of order.
+func specTagNames(names []string) []string {
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			out = append(out, name)
+		}
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+func hintsMatchSpec(hints []*entity.Hint, want []challengespec.Hint) bool {
+	if len(hints) != len(want) {
+		return false
+	}
+	sorted := slices.Clone(hints)
+	slices.SortStableFunc(sorted, func(a, b *entity.Hint) int { return a.OrderIndex - b.OrderIndex })
+	for i, h := range sorted {
+		if h.Content != want[i].Content || h.Cost != want[i].Cost || h.OrderIndex != i {
+			return false
+		}
+	}
+	return true
+}
+
+func filesMatchSpec(files []*entity.File, want []specFile) bool {
+	if len(files) != len(want) {
+		return false
+	}
+	have := make(map[string]string, len(files))
+	for _, f := range files {
+		have[f.Filename] = f.SHA256
+	}
+	for _, f := range want {
+		if sum, ok := have[f.name]; !ok || sum != f.sha256 {
+			return false
+		}
+	}
+	return true
+}
+
+func hashZipFile(zf *zip.File) (sum string, size int64, err error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return "", 0, err
+	}
+	defer rc.Close()
+	hash := sha256.New()
+	size, err = io.Copy(hash, rc)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// exportSlugs gives every challenge the slug it is linked to, or a slug derived from its
+// title that no other challenge uses.
+func exportSlugs(challenges []*entity.LinkedChallenge) map[uuid.UUID]string {
+	slugs := make(map[uuid.UUID]string, len(challenges))
+	used := make(map[string]bool, len(challenges))
+	for _, lc := range challenges {
+		if lc.Slug != "" {
+			slugs[lc.Challenge.ID] = lc.Slug
+			used[lc.Slug] = true
+		}
+	}
+	for _, lc := range challenges {
+		if lc.Slug != "" {
+			continue
+		}
+		base := challengespec.Slugify(lc.Challenge.Title)
+		if base == "" {
+			base = "challenge"
+		}
+		slug := base
+		for n := 2; used[slug]; n++ {
+			slug = fmt.Sprintf("%s-%d", base, n)
+		}
+		slugs[lc.Challenge.ID] = slug
+		used[slug] = true
+	}
+	return slugs
+}
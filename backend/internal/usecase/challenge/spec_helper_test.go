@@ -0,0 +1,41 @@
+package challenge
+
+import (
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+func (h *ChallengeTestHelper) CreateSpecUseCase() *SpecUseCase {
+	h.t.Helper()
+	challengeUC, _ := h.CreateChallengeUseCase()
+	return NewSpecUseCase(SpecDeps{
+		SpecRepo: h.deps.specRepo, ChallengeRepo: h.deps.challengeRepo, TagRepo: h.deps.tagRepo,
+		HintRepo: h.deps.hintRepo, FlagRepo: h.deps.flagRepo, RequirementRepo: h.deps.requirementRepo,
+		FileRepo: h.deps.fileRepo, Crypto: h.deps.crypto, Challenges: challengeUC,
+		Files: h.CreateFileUseCase(), Revisions: h.CreateRevisionUseCase(), ScoreboardCache: nil,
+	})
+}
+
+func (h *ChallengeTestHelper) NewLinkedChallenge(c *entity.Challenge, slug string) *entity.LinkedChallenge {
+	h.t.Helper()
+	return &entity.LinkedChallenge{Challenge: c, Slug: slug}
+}
+
+// ExpectSpecState makes an import or export see the given challenges, with no tags and no
+// requirements yet.
+func (h *ChallengeTestHelper) ExpectSpecState(challenges ...*entity.LinkedChallenge) {
+	h.t.Helper()
+	h.deps.specRepo.On("GetAll", mock.Anything).Return(challenges, nil)
+	h.deps.tagRepo.On("GetAll", mock.Anything).Return([]*entity.Tag{}, nil)
+	h.deps.requirementRepo.On("GetAll", mock.Anything).Return([]*entity.ChallengeRequirements{}, nil)
+}
+
+// ExpectSpecRelations makes c have no extra flags, tags, hints or files.
+func (h *ChallengeTestHelper) ExpectSpecRelations(challengeID uuid.UUID) {
+	h.t.Helper()
+	h.deps.flagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeFlag{}, nil)
+	h.deps.tagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.Tag{}, nil)
+	h.deps.hintRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.Hint{}, nil)
+	h.deps.fileRepo.On("GetByChallengeID", mock.Anything, challengeID, entity.FileTypeChallenge).Return([]*entity.File{}, nil)
+}
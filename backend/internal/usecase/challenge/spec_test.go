@@ -0,0 +1,223 @@
+package challenge
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/challengespec"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const warmupSpec = `name: Warmup
+category: Misc
+description: Description
+value: 50
+flags:
+  - flag{w}
+`
+
+func importSpec(uc *SpecUseCase, yml string, dryRun bool) (*entity.SpecSyncResult, error) {
+	return uc.Import(context.Background(), bytes.NewReader([]byte(yml)), int64(len(yml)), dryRun, nil)
+}
+
+func TestSpecUseCase_Import_DryRunCreate(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	h.ExpectSpecState()
+
+	result, err := importSpec(uc, warmupSpec, true)
+
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 1, result.Created)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, entity.SpecActionCreate, result.Items[0].Action)
+	assert.Equal(t, "warmup", result.Items[0].Slug)
+	h.Deps().challengeRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestSpecUseCase_Import_DryRunUpdate(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	c := h.NewChallenge(uuid.New(), "Warmup", "Misc", 30, h.Sha256Hash("flag{w}"))
+	c.InitialValue, c.MinValue = 30, 30
+	h.ExpectSpecState(h.NewLinkedChallenge(c, ""))
+	h.ExpectSpecRelations(c.ID)
+
+	result, err := importSpec(uc, warmupSpec, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Updated)
+	assert.Equal(t, c.ID, *result.Items[0].ChallengeID)
+	assert.Equal(t, []string{"points", "initial_value", "min_value", "slug"}, result.Items[0].ChangedFields)
+	h.Deps().challengeRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestSpecUseCase_Import_Unchanged(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	c := h.NewChallenge(uuid.New(), "Warmup", "Misc", 50, h.Sha256Hash("flag{w}"))
+	c.InitialValue, c.MinValue = 50, 50
+	h.ExpectSpecState(h.NewLinkedChallenge(c, "warmup"))
+	h.ExpectSpecRelations(c.ID)
+
+	result, err := importSpec(uc, warmupSpec, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Unchanged)
+	assert.Empty(t, result.Items[0].ChangedFields)
+	h.Deps().challengeRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	h.Deps().specRepo.AssertNotCalled(t, "Link", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSpecUseCase_Import_Create(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateSpecUseCase()
+	h.ExpectSpecState()
+
+	newID, tagID := uuid.New(), uuid.New()
+	deps.challengeRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *entity.Challenge) bool {
+		return c.Title == "Warmup" && c.Points == 50 && c.FlagHash == h.Sha256Hash("flag{w}") && !c.IsHidden
+	})).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Challenge).ID = newID
+	})
+	deps.tagRepo.On("Create", mock.Anything, mock.MatchedBy(func(tag *entity.Tag) bool {
+		return tag.Name == "easy"
+	})).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Tag).ID = tagID
+	})
+	deps.tagRepo.On("SetChallengeTags", mock.Anything, newID, []uuid.UUID{tagID}).Return(nil)
+	h.ExpectSpecRelations(newID)
+	deps.hintRepo.On("Create", mock.Anything, mock.MatchedBy(func(hint *entity.Hint) bool {
+		return hint.ChallengeID == newID && hint.Content == "try harder" && hint.Cost == 0
+	})).Return(nil)
+	deps.specRepo.On("Link", mock.Anything, newID, "warmup").Return(nil)
+	h.RunTransactions()
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, newID).Return(h.NewChallenge(newID, "Warmup", "Misc", 50, "hash"), nil)
+	deps.revisionRepo.On("GetLatestTx", mock.Anything, mock.Anything, newID).Return(nil, entityError.ErrRevisionNotFound)
+	deps.revisionRepo.On("CreateTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	result, err := importSpec(uc, warmupSpec+"tags: [easy]\nhints: [try harder]\n", false)
+
+	require.NoError(t, err)
+	assert.False(t, result.DryRun)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, newID, *result.Items[0].ChallengeID)
+}
+
+func TestSpecUseCase_Import_Failed(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	h.ExpectSpecState()
+	h.Deps().challengeRepo.On("Create", mock.Anything, mock.Anything).Return(assert.AnError)
+
+	result, err := importSpec(uc, warmupSpec, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, entity.SpecActionFailed, result.Items[0].Action)
+	assert.NotEmpty(t, result.Items[0].Error)
+}
+
+func TestSpecUseCase_Import_ValidationProblems(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+
+	result, err := importSpec(uc, "name: Warmup\ncategory: Misc\n", false)
+
+	require.NoError(t, err)
+	require.Len(t, result.Problems, 1)
+	assert.Equal(t, "at least one flag is required", result.Problems[0].Message)
+	assert.Empty(t, result.Items)
+}
+
+func TestSpecUseCase_Import_UnknownRequirement(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	h.ExpectSpecState()
+
+	result, err := importSpec(uc, warmupSpec+"requirements: [missing]\n", true)
+
+	require.NoError(t, err)
+	require.Len(t, result.Problems, 1)
+	assert.Contains(t, result.Problems[0].Message, `requirement "missing" matches no challenge`)
+}
+
+func TestSpecUseCase_Import_AmbiguousTitle(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	h.ExpectSpecState(
+		h.NewLinkedChallenge(h.NewChallenge(uuid.New(), "Warmup", "Misc", 50, "a"), ""),
+		h.NewLinkedChallenge(h.NewChallenge(uuid.New(), "warmup", "Web", 50, "b"), ""),
+	)
+
+	result, err := importSpec(uc, warmupSpec, true)
+
+	require.NoError(t, err)
+	require.Len(t, result.Problems, 1)
+	assert.Contains(t, result.Problems[0].Message, "matches 2 existing challenges")
+}
+
+func TestSpecUseCase_Import_InvalidBundle(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	_, err := zw.Create("readme.md")
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	_, err = uc.Import(context.Background(), bytes.NewReader(buf.Bytes()), int64(buf.Len()), false, nil)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidSpecBundle)
+}
+
+func TestSpecUseCase_Export_NotFound(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	h.ExpectSpecState()
+
+	_, err := uc.Export(context.Background(), entity.SpecExportFilter{Category: "web"})
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+}
+
+func TestSpecUseCase_Export_HashedFlagsStayCtfcliCompatible(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc := h.CreateSpecUseCase()
+	c := h.NewChallenge(uuid.New(), "Warmup", "Misc", 50, h.Sha256Hash("flag{w}"))
+	c.InitialValue, c.MinValue = 50, 50
+	h.ExpectSpecState(h.NewLinkedChallenge(c, "warmup"))
+	h.ExpectSpecRelations(c.ID)
+
+	rc, err := uc.Export(context.Background(), entity.SpecExportFilter{})
+	require.NoError(t, err)
+	archive, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 1)
+	f, err := zr.File[0].Open()
+	require.NoError(t, err)
+	yml, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	s, err := challengespec.Parse(yml)
+	require.NoError(t, err)
+	assert.Empty(t, s.Validate())
+	assert.Empty(t, s.Flags, "ctfcli only knows static and regex flags")
+	assert.Equal(t, []challengespec.FlagHash{{SHA256: c.FlagHash}}, s.FlagHashes)
+
+	result, err := uc.Import(context.Background(), bytes.NewReader(archive), int64(len(archive)), false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Unchanged)
+}
@@ -0,0 +1,303 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/usecase"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// StageFlag is a flag value a stage accepts, before it is hashed or encrypted.
+type StageFlag struct {
+	Type              entity.FlagType
+	Value             string
+	IsCaseInsensitive bool
+}
+
+// ListStages returns the stages of a challenge in order, flags included.
+func (uc *ChallengeUseCase) ListStages(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeStage, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ListStages - GetByID")
+	}
+	stages, err := uc.stageRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - ListStages")
+	}
+	return stages, nil
+}
+
+func (uc *ChallengeUseCase) GetStage(ctx context.Context, ID uuid.UUID) (*entity.ChallengeStage, error) {
+	stage, err := uc.stageRepo.GetByID(ctx, ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetStage")
+	}
+	return stage, nil
+}
+
+// CreateStage appends a stage to a challenge. Once a challenge has stages, submissions are
+// checked against the flags of the next stage instead of the challenge flags.
+func (uc *ChallengeUseCase) CreateStage(ctx context.Context, challengeID uuid.UUID, title string, points int, flags []StageFlag) (*entity.ChallengeStage, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - CreateStage - GetByID")
+	}
+	stage := &entity.ChallengeStage{ChallengeID: challengeID}
+	if err := applyStageHeader(stage, title, points); err != nil {
+		return nil, err
+	}
+	var err error
+	if stage.Flags, err = uc.stageFlags(challengeID, flags); err != nil {
+		return nil, err
+	}
+	if err := uc.stageRepo.Create(ctx, stage); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - CreateStage")
+	}
+	return stage, nil
+}
+
+// UpdateStage changes the title and points of a stage. A non-nil orderIndex moves the stage
+// to that position and non-nil flags replace its flags.
+func (uc *ChallengeUseCase) UpdateStage(ctx context.Context, ID uuid.UUID, title string, points int, orderIndex *int, flags []StageFlag) (*entity.ChallengeStage, error) {
+	stage, err := uc.stageRepo.GetByID(ctx, ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - UpdateStage - GetByID")
+	}
+	if err := applyStageHeader(stage, title, points); err != nil {
+		return nil, err
+	}
+	keep := stage.Flags
+	stage.Flags = nil
+	if flags != nil {
+		if stage.Flags, err = uc.stageFlags(stage.ChallengeID, flags); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.stageRepo.Update(ctx, stage); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - UpdateStage")
+	}
+	if flags == nil {
+		stage.Flags = keep
+	}
+	if orderIndex != nil && *orderIndex != stage.OrderIndex {
+		if err := uc.moveStage(ctx, stage, *orderIndex); err != nil {
+			return nil, err
+		}
+	}
+	return stage, nil
+}
+
+func (uc *ChallengeUseCase) DeleteStage(ctx context.Context, ID uuid.UUID) error {
+	stage, err := uc.stageRepo.GetByID(ctx, ID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - DeleteStage - GetByID")
+	}
+	if err := uc.stageRepo.Delete(ctx, ID); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - DeleteStage")
+	}
+	stages, err := uc.stageRepo.GetByChallengeID(ctx, stage.ChallengeID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - DeleteStage - GetByChallengeID")
+	}
+	if err := uc.stageRepo.Reorder(ctx, stageIDs(stages)); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - DeleteStage - Reorder")
+	}
+	return nil
+}
+
+// GetStages returns the stages of a challenge teamID may access, as the team sees them. Flags are left out.
+func (uc *ChallengeUseCase) GetStages(ctx context.Context, challengeID uuid.UUID, teamID *uuid.UUID) ([]*usecase.StageWithProgress, error) {
+	if err := uc.CheckAccess(ctx, challengeID, teamID); err != nil {
+		return nil, err
+	}
+	stages, err := uc.stageRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetStages")
+	}
+	completed := map[uuid.UUID]bool{}
+	if teamID != nil {
+		if completed, err = uc.completedStages(ctx, *teamID, challengeID); err != nil {
+			return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetStages")
+		}
+	}
+	bloods, err := uc.stageRepo.GetFirstBloods(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetStages - GetFirstBloods")
+	}
+	firstBlood := make(map[uuid.UUID]*entity.StageFirstBlood, len(bloods))
+	for _, b := range bloods {
+		firstBlood[b.StageID] = b
+	}
+	out := make([]*usecase.StageWithProgress, len(stages))
+	for i, s := range stages {
+		s.Flags = nil
+		out[i] = &usecase.StageWithProgress{ChallengeStage: s, Completed: completed[s.ID], FirstBlood: firstBlood[s.ID]}
+	}
+	return out, nil
+}
+
+func applyStageHeader(stage *entity.ChallengeStage, title string, points int) error {
+	title = strings.TrimSpace(title)
+	if title == "" || points < 0 {
+		return entityError.ErrInvalidChallengeStage
+	}
+	stage.Title = title
+	stage.Points = points
+	return nil
+}
+
+// stageFlags hashes or encrypts the flag values of a stage the way applyFlagValue does for
+// challenge flags.
+func (uc *ChallengeUseCase) stageFlags(challengeID uuid.UUID, flags []StageFlag) ([]*entity.ChallengeFlag, error) {
+	if len(flags) == 0 {
+		return nil, entityError.ErrInvalidChallengeStage
+	}
+	out := make([]*entity.ChallengeFlag, len(flags))
+	for i, f := range flags {
+		flag := &entity.ChallengeFlag{ChallengeID: challengeID}
+		if err := uc.applyFlagValue(flag, f.Type, f.Value, f.IsCaseInsensitive); err != nil {
+			return nil, err
+		}
+		out[i] = flag
+	}
+	return out, nil
+}
+
+func (uc *ChallengeUseCase) moveStage(ctx context.Context, stage *entity.ChallengeStage, orderIndex int) error {
+	stages, err := uc.stageRepo.GetByChallengeID(ctx, stage.ChallengeID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - UpdateStage - GetByChallengeID")
+	}
+	if orderIndex < 0 || orderIndex >= len(stages) {
+		return entityError.ErrInvalidStageOrder
+	}
+	ids := make([]uuid.UUID, 0, len(stages))
+	for _, s := range stages {
+		if s.ID != stage.ID {
+			ids = append(ids, s.ID)
+		}
+	}
+	ids = append(ids[:orderIndex], append([]uuid.UUID{stage.ID}, ids[orderIndex:]...)...)
+	if err := uc.stageRepo.Reorder(ctx, ids); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - UpdateStage - Reorder")
+	}
+	stage.OrderIndex = orderIndex
+	return nil
+}
+
+func stageIDs(stages []*entity.ChallengeStage) []uuid.UUID {
+	ids := make([]uuid.UUID, len(stages))
+	for i, s := range stages {
+		ids[i] = s.ID
+	}
+	return ids
+}
+
+func (uc *ChallengeUseCase) completedStages(ctx context.Context, teamID, challengeID uuid.UUID) (map[uuid.UUID]bool, error) {
+	ids, err := uc.stageRepo.GetCompletedStageIDs(ctx, teamID, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetCompletedStageIDs")
+	}
+	completed := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		completed[id] = true
+	}
+	return completed, nil
+}
+
+// loadStageProgress returns the stage progress of teamID keyed by challenge; challenges with a
+// single flag are absent.
+func (uc *ChallengeUseCase) loadStageProgress(ctx context.Context, teamID *uuid.UUID) (map[uuid.UUID]*entity.StageProgress, error) {
+	if uc.stageRepo == nil {
+		return nil, nil
+	}
+	rows, err := uc.stageRepo.GetProgress(ctx, teamID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "GetProgress")
+	}
+	progress := make(map[uuid.UUID]*entity.StageProgress, len(rows))
+	for _, p := range rows {
+		progress[p.ChallengeID] = p
+	}
+	return progress, nil
+}
+
+// submitStage checks a submission to a multi-stage challenge against the flags of the first
+// stage the team has not completed. Completing any stage but the last records a stage solve
+// and reports ErrStageCompleted; completing the last one solves the challenge. handled is
+// false for challenges without stages.
+func (uc *ChallengeUseCase) submitStage(sc *submitContext, challenge *entity.Challenge, state *unlockState) (handled, correct bool, err error) {
+	if uc.stageRepo == nil {
+		return false, false, nil
+	}
+	stages, err := uc.stageRepo.GetByChallengeID(sc.ctx, challenge.ID)
+	if err != nil {
+		return true, false, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - GetStages")
+	}
+	if len(stages) == 0 {
+		return false, false, nil
+	}
+	if _, err := uc.solveRepo.GetByTeamAndChallenge(sc.ctx, sc.teamID, challenge.ID); err == nil {
+		return true, true, entityError.ErrAlreadySolved
+	} else if !errors.Is(err, entityError.ErrSolveNotFound) {
+		return true, false, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - GetSolve")
+	}
+	completed, err := uc.completedStages(sc.ctx, sc.teamID, challenge.ID)
+	if err != nil {
+		return true, false, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag")
+	}
+	next := stages[len(stages)-1]
+	for _, s := range stages {
+		if !completed[s.ID] {
+			next = s
+			break
+		}
+	}
+	if !uc.submitMatchFlags(sc, next.Flags) {
+		return true, false, nil
+	}
+	if next.ID != stages[len(stages)-1].ID {
+		return true, true, uc.submitCompleteStage(sc, challenge, next, state)
+	}
+	return true, true, uc.submitCompleteLastStage(sc, next, completed[next.ID], state)
+}
+
+func (uc *ChallengeUseCase) submitCompleteStage(sc *submitContext, challenge *entity.Challenge, stage *entity.ChallengeStage, state *unlockState) error {
+	solve := &entity.StageSolve{StageID: stage.ID, TeamID: sc.teamID, UserID: sc.userID}
+	solveCount, err := uc.stageRepo.CreateSolve(sc.ctx, solve)
+	if err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - CreateStageSolve")
+	}
+	uc.submitInvalidateCache(sc.ctx)
+	if uc.broadcaster != nil {
+		uc.broadcaster.NotifySolve(sc.teamID, challenge.Title+" — "+stage.Title, stage.Points, solveCount == 1)
+	}
+	uc.notifyUnlocks(sc.ctx, sc.teamID, state)
+	return entityError.ErrStageCompleted
+}
+
+// submitCompleteLastStage records the solve of the challenge together with its last stage.
+// recorded is set when the team completed the last stage before it became the last one.
+func (uc *ChallengeUseCase) submitCompleteLastStage(sc *submitContext, stage *entity.ChallengeStage, recorded bool, state *unlockState) error {
+	solvedChallenge, solveCount, err := uc.submitRecordSolve(sc, func(ctx context.Context, tx repo.Transaction, _ *entity.Challenge) error {
+		if recorded {
+			return nil
+		}
+		solve := &entity.StageSolve{StageID: stage.ID, TeamID: sc.teamID, UserID: sc.userID}
+		if err := uc.stageRepo.CreateSolveTx(ctx, tx, solve); err != nil {
+			return usecaseutil.Wrap(err, "CreateStageSolveTx")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	uc.submitInvalidateCache(sc.ctx)
+	uc.submitNotifySolve(sc.teamID, solvedChallenge, solveCount == 1)
+	uc.notifyUnlocks(sc.ctx, sc.teamID, state)
+	return nil
+}
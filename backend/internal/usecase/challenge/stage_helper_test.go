@@ -0,0 +1,70 @@
+package challenge
+
+import (
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/mock"
+)
+
+type firstBloodRecorder struct {
+	firstBloods []bool
+}
+
+func (r *firstBloodRecorder) NotifySolve(_ uuid.UUID, _ string, _ int, isFirstBlood bool) {
+	r.firstBloods = append(r.firstBloods, isFirstBlood)
+}
+
+func (r *firstBloodRecorder) NotifySolveRevoked(uuid.UUID, string, int, *uuid.UUID) {}
+
+func (r *firstBloodRecorder) NotifyNotification(string, string) {}
+
+func (r *firstBloodRecorder) NotifyUnlock(uuid.UUID, []uuid.UUID) {}
+
+func (r *firstBloodRecorder) NotifyRelease(uuid.UUID, string, string, int) {}
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithStages(opts ...ChallengeUCOption) (*ChallengeUseCase, redismock.ClientMock) {
+	h.t.Helper()
+	client, redis := redismock.NewClientMock()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		append([]ChallengeUCOption{
+			WithSolveRepo(h.deps.solveRepo),
+			WithTxRepo(h.deps.txRepo),
+			WithCompetitionRepo(h.deps.compRepo),
+			WithTeamRepo(h.deps.teamRepo),
+			WithRedis(client),
+			WithStageRepo(h.deps.stageRepo),
+		}, opts...)...,
+	), redis
+}
+
+func (h *ChallengeTestHelper) NewStage(challengeID uuid.UUID, orderIndex, points int, flag string) *entity.ChallengeStage {
+	h.t.Helper()
+	return &entity.ChallengeStage{
+		ID:          uuid.New(),
+		ChallengeID: challengeID,
+		OrderIndex:  orderIndex,
+		Title:       "Stage",
+		Points:      points,
+		Flags: []*entity.ChallengeFlag{
+			{ChallengeID: challengeID, Type: entity.FlagTypeStatic, FlagHash: hashFlag(flag, false)},
+		},
+	}
+}
+
+// ExpectStageSubmit sets up a submission by teamID to a staged challenge up to the point where
+// the flag is matched against the next stage.
+func (h *ChallengeTestHelper) ExpectStageSubmit(challenge *entity.Challenge, teamID uuid.UUID, stages []*entity.ChallengeStage, completed ...uuid.UUID) {
+	h.t.Helper()
+	h.deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	h.deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	h.deps.challengeRepo.On("GetByID", mock.Anything, challenge.ID).Return(challenge, nil)
+	h.deps.stageRepo.On("GetByChallengeID", mock.Anything, challenge.ID).Return(stages, nil)
+	h.deps.solveRepo.On("GetByTeamAndChallenge", mock.Anything, teamID, challenge.ID).Return(nil, entityError.ErrSolveNotFound)
+	if completed == nil {
+		completed = []uuid.UUID{}
+	}
+	h.deps.stageRepo.On("GetCompletedStageIDs", mock.Anything, teamID, challenge.ID).Return(completed, nil)
+}
@@ -0,0 +1,296 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeUseCase_CreateStage_Success(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challengeID := uuid.New()
+
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Chain", "Pwn", 300, "hash"), nil)
+	deps.stageRepo.On("Create", mock.Anything, mock.MatchedBy(func(s *entity.ChallengeStage) bool {
+		return s.ChallengeID == challengeID && s.Title == "Leak" && s.Points == 50 &&
+			len(s.Flags) == 1 && s.Flags[0].FlagHash == h.Sha256Hash("flag{leak}")
+	})).Return(nil)
+
+	stage, err := uc.CreateStage(context.Background(), challengeID, " Leak ", 50, []StageFlag{{Type: entity.FlagTypeStatic, Value: "flag{leak}"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Leak", stage.Title)
+}
+
+func TestChallengeUseCase_CreateStage_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		title  string
+		points int
+		flags  []StageFlag
+		want   error
+	}{
+		{"EmptyTitle", " ", 10, []StageFlag{{Type: entity.FlagTypeStatic, Value: "x"}}, entityError.ErrInvalidChallengeStage},
+		{"NegativePoints", "Leak", -1, []StageFlag{{Type: entity.FlagTypeStatic, Value: "x"}}, entityError.ErrInvalidChallengeStage},
+		{"NoFlags", "Leak", 10, nil, entityError.ErrInvalidChallengeStage},
+		{"EmptyFlag", "Leak", 10, []StageFlag{{Type: entity.FlagTypeStatic}}, entityError.ErrInvalidChallengeFlag},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewChallengeTestHelper(t)
+			uc, _ := h.CreateChallengeUseCaseWithStages()
+			challengeID := uuid.New()
+			h.Deps().challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Chain", "Pwn", 300, "hash"), nil)
+
+			_, err := uc.CreateStage(context.Background(), challengeID, tt.title, tt.points, tt.flags)
+
+			assert.ErrorIs(t, err, tt.want)
+			h.Deps().stageRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestChallengeUseCase_UpdateStage_Move(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challengeID := uuid.New()
+	first, second := h.NewStage(challengeID, 0, 10, "a"), h.NewStage(challengeID, 1, 20, "b")
+	flags := second.Flags
+
+	deps.stageRepo.On("GetByID", mock.Anything, second.ID).Return(second, nil)
+	deps.stageRepo.On("Update", mock.Anything, mock.MatchedBy(func(s *entity.ChallengeStage) bool {
+		return s.ID == second.ID && s.Title == "Renamed" && s.Flags == nil
+	})).Return(nil)
+	deps.stageRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeStage{first, second}, nil)
+	deps.stageRepo.On("Reorder", mock.Anything, []uuid.UUID{second.ID, first.ID}).Return(nil)
+	orderIndex := 0
+
+	stage, err := uc.UpdateStage(context.Background(), second.ID, "Renamed", 25, &orderIndex, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, stage.OrderIndex)
+	assert.Equal(t, flags, stage.Flags)
+}
+
+func TestChallengeUseCase_UpdateStage_InvalidOrder(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challengeID := uuid.New()
+	stage := h.NewStage(challengeID, 0, 10, "a")
+
+	deps.stageRepo.On("GetByID", mock.Anything, stage.ID).Return(stage, nil)
+	deps.stageRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	deps.stageRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeStage{stage}, nil)
+	orderIndex := 3
+
+	_, err := uc.UpdateStage(context.Background(), stage.ID, "Leak", 10, &orderIndex, nil)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidStageOrder)
+	deps.stageRepo.AssertNotCalled(t, "Reorder", mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_DeleteStage_Reorders(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challengeID := uuid.New()
+	first, last := h.NewStage(challengeID, 0, 10, "a"), h.NewStage(challengeID, 2, 30, "c")
+	deleted := h.NewStage(challengeID, 1, 20, "b")
+
+	deps.stageRepo.On("GetByID", mock.Anything, deleted.ID).Return(deleted, nil)
+	deps.stageRepo.On("Delete", mock.Anything, deleted.ID).Return(nil)
+	deps.stageRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeStage{first, last}, nil)
+	deps.stageRepo.On("Reorder", mock.Anything, []uuid.UUID{first.ID, last.ID}).Return(nil)
+
+	err := uc.DeleteStage(context.Background(), deleted.ID)
+
+	assert.NoError(t, err)
+}
+
+func TestChallengeUseCase_SubmitFlag_StageCompleted(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challenge := h.NewChallenge(uuid.New(), "Chain", "Pwn", 300, h.Sha256Hash("unused"))
+	teamID, userID := uuid.New(), uuid.New()
+	first, second := h.NewStage(challenge.ID, 0, 50, "flag{one}"), h.NewStage(challenge.ID, 1, 50, "flag{two}")
+
+	h.ExpectStageSubmit(challenge, teamID, []*entity.ChallengeStage{first, second})
+	deps.stageRepo.On("CreateSolve", mock.Anything, &entity.StageSolve{StageID: first.ID, TeamID: teamID, UserID: userID}).Return(1, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challenge.ID, "flag{one}", userID, &teamID)
+
+	assert.True(t, valid)
+	assert.ErrorIs(t, err, entityError.ErrStageCompleted)
+	deps.txRepo.AssertNotCalled(t, "RunTransaction", mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SubmitFlag_StageFirstBloodUsesRecordedCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		solveCount int
+		firstBlood bool
+	}{
+		{name: "first", solveCount: 1, firstBlood: true},
+		{name: "raced", solveCount: 2, firstBlood: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewChallengeTestHelper(t)
+			deps := h.Deps()
+			recorder := &firstBloodRecorder{}
+			uc, _ := h.CreateChallengeUseCaseWithStages(WithBroadcaster(recorder))
+			challenge := h.NewChallenge(uuid.New(), "Chain", "Pwn", 300, h.Sha256Hash("unused"))
+			teamID := uuid.New()
+			first, second := h.NewStage(challenge.ID, 0, 50, "flag{one}"), h.NewStage(challenge.ID, 1, 50, "flag{two}")
+
+			h.ExpectStageSubmit(challenge, teamID, []*entity.ChallengeStage{first, second})
+			deps.stageRepo.On("CreateSolve", mock.Anything, mock.Anything).Return(tt.solveCount, nil)
+
+			_, err := uc.SubmitFlag(context.Background(), challenge.ID, "flag{one}", uuid.New(), &teamID)
+
+			assert.ErrorIs(t, err, entityError.ErrStageCompleted)
+			assert.Equal(t, []bool{tt.firstBlood}, recorder.firstBloods)
+		})
+	}
+}
+
+func TestChallengeUseCase_SubmitFlag_StageOutOfOrder(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challenge := h.NewChallenge(uuid.New(), "Chain", "Pwn", 300, h.Sha256Hash("unused"))
+	teamID := uuid.New()
+	first, second := h.NewStage(challenge.ID, 0, 50, "flag{one}"), h.NewStage(challenge.ID, 1, 50, "flag{two}")
+
+	h.ExpectStageSubmit(challenge, teamID, []*entity.ChallengeStage{first, second})
+
+	valid, err := uc.SubmitFlag(context.Background(), challenge.ID, "flag{two}", uuid.New(), &teamID)
+
+	assert.NoError(t, err)
+	assert.False(t, valid)
+	h.Deps().stageRepo.AssertNotCalled(t, "CreateSolve", mock.Anything, mock.Anything)
+}
+
+func TestChallengeUseCase_SubmitFlag_LastStageSolves(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challenge := h.NewChallenge(uuid.New(), "Chain", "Pwn", 300, h.Sha256Hash("unused"))
+	teamID, userID := uuid.New(), uuid.New()
+	first, second := h.NewStage(challenge.ID, 0, 50, "flag{one}"), h.NewStage(challenge.ID, 1, 50, "flag{two}")
+
+	h.ExpectStageSubmit(challenge, teamID, []*entity.ChallengeStage{first, second}, first.ID)
+	h.RunTransactions()
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challenge.ID).Return(nil, entityError.ErrSolveNotFound)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.txRepo.On("CreateSolveTx", mock.Anything, mock.Anything, mock.MatchedBy(func(s *entity.Solve) bool {
+		return s.ChallengeID == challenge.ID && s.TeamID == teamID
+	})).Return(nil)
+	deps.txRepo.On("IncrementChallengeSolveCountTx", mock.Anything, mock.Anything, challenge.ID).Return(1, nil)
+	deps.stageRepo.On("CreateSolveTx", mock.Anything, mock.Anything, &entity.StageSolve{StageID: second.ID, TeamID: teamID, UserID: userID}).Return(nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challenge.ID, "flag{two}", userID, &teamID)
+
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_StagedAlreadySolved(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challenge := h.NewChallenge(uuid.New(), "Chain", "Pwn", 300, h.Sha256Hash("unused"))
+	teamID := uuid.New()
+
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.stageRepo.On("GetByChallengeID", mock.Anything, challenge.ID).Return([]*entity.ChallengeStage{h.NewStage(challenge.ID, 0, 50, "flag{one}")}, nil)
+	deps.solveRepo.On("GetByTeamAndChallenge", mock.Anything, teamID, challenge.ID).Return(&entity.Solve{}, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challenge.ID, "flag{one}", uuid.New(), &teamID)
+
+	assert.True(t, valid)
+	assert.ErrorIs(t, err, entityError.ErrAlreadySolved)
+}
+
+func TestChallengeUseCase_GetStages_HiddenChallenge(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challenge := h.NewChallenge(uuid.New(), "Chain", "Pwn", 300, "hash")
+	challenge.IsHidden = true
+	h.Deps().challengeRepo.On("GetByID", mock.Anything, challenge.ID).Return(challenge, nil)
+
+	_, err := uc.GetStages(context.Background(), challenge.ID, nil)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeNotFound)
+}
+
+func TestChallengeUseCase_GetStages_Locked(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc, _ := h.CreateChallengeUseCaseWithStages(WithRequirementRepo(h.Deps().requirementRepo))
+	challengeID, teamID := uuid.New(), uuid.New()
+	h.ExpectLockedChallenge(challengeID, teamID)
+
+	_, err := uc.GetStages(context.Background(), challengeID, &teamID)
+
+	assert.ErrorIs(t, err, entityError.ErrChallengeLocked)
+}
+
+func TestChallengeUseCase_GetStages_Progress(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	challenge := h.NewChallenge(uuid.New(), "Chain", "Pwn", 300, "hash")
+	teamID := uuid.New()
+	first, second := h.NewStage(challenge.ID, 0, 50, "flag{one}"), h.NewStage(challenge.ID, 1, 50, "flag{two}")
+	blood := &entity.StageFirstBlood{StageID: first.ID, TeamID: teamID, TeamName: "Test Team"}
+
+	deps.challengeRepo.On("GetByID", mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.stageRepo.On("GetByChallengeID", mock.Anything, challenge.ID).Return([]*entity.ChallengeStage{first, second}, nil)
+	deps.stageRepo.On("GetCompletedStageIDs", mock.Anything, teamID, challenge.ID).Return([]uuid.UUID{first.ID}, nil)
+	deps.stageRepo.On("GetFirstBloods", mock.Anything, challenge.ID).Return([]*entity.StageFirstBlood{blood}, nil)
+
+	stages, err := uc.GetStages(context.Background(), challenge.ID, &teamID)
+
+	require.NoError(t, err)
+	require.Len(t, stages, 2)
+	assert.True(t, stages[0].Completed)
+	assert.Equal(t, blood, stages[0].FirstBlood)
+	assert.False(t, stages[1].Completed)
+	assert.Nil(t, stages[1].FirstBlood)
+	assert.Nil(t, stages[0].Flags)
+}
+
+func TestChallengeUseCase_GetAll_StageProgress(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithStages()
+	teamID := uuid.New()
+	staged := h.NewChallenge(uuid.New(), "Chain", "Pwn", 300, "hash")
+	plain := h.NewChallenge(uuid.New(), "Warmup", "Misc", 50, "hash")
+	progress := &entity.StageProgress{ChallengeID: staged.ID, Completed: 1, Total: 3}
+
+	deps.challengeRepo.On("GetAll", mock.Anything, &teamID, mock.Anything).Return([]*repo.ChallengeWithSolved{
+		h.NewChallengeWithSolved(staged, false),
+		h.NewChallengeWithSolved(plain, false),
+	}, nil)
+	deps.stageRepo.On("GetProgress", mock.Anything, &teamID).Return([]*entity.StageProgress{progress}, nil)
+
+	result, err := uc.GetAll(context.Background(), &teamID, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, progress, result[0].Stages)
+	assert.Nil(t, result[1].Stages)
+}
@@ -0,0 +1,163 @@
+package challenge
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/pkg/crypto"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// TeamFlagPlaceholder is replaced with the team's flag by RenderTeamFlag.
+const TeamFlagPlaceholder = "{{flag}}"
+
+func (uc *ChallengeUseCase) GetTeamFlagConfig(ctx context.Context, challengeID uuid.UUID) (*entity.TeamFlagConfig, error) {
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetTeamFlagConfig - GetByID")
+	}
+	cfg, err := uc.teamFlagRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - GetTeamFlagConfig")
+	}
+	return cfg, nil
+}
+
+// SetTeamFlag switches a challenge to per-team flags built from template. The secret of an
+// existing configuration is kept so flags already handed out stay valid, unless rotateSecret
+// is set.
+func (uc *ChallengeUseCase) SetTeamFlag(ctx context.Context, challengeID uuid.UUID, template string, rotateSecret bool) (*entity.TeamFlagConfig, error) {
+	template = strings.TrimSpace(template)
+	if !entity.IsValidTeamFlagTemplate(template) {
+		return nil, entityError.ErrInvalidTeamFlagTemplate
+	}
+	if _, err := uc.challengeRepo.GetByID(ctx, challengeID); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetTeamFlag - GetByID")
+	}
+	cfg, err := uc.teamFlagRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil && !errors.Is(err, entityError.ErrTeamFlagNotFound) {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetTeamFlag - GetByChallengeID")
+	}
+	if cfg == nil || rotateSecret {
+		secret, err := uc.newTeamFlagSecret()
+		if err != nil {
+			return nil, err
+		}
+		cfg = &entity.TeamFlagConfig{ChallengeID: challengeID, Secret: secret}
+	}
+	cfg.Template = template
+	if err := uc.teamFlagRepo.Upsert(ctx, cfg); err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetTeamFlag")
+	}
+	saved, err := uc.teamFlagRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "ChallengeUseCase - SetTeamFlag - Reload")
+	}
+	return saved, nil
+}
+
+// DeleteTeamFlag switches a challenge back to its shared flags.
+func (uc *ChallengeUseCase) DeleteTeamFlag(ctx context.Context, challengeID uuid.UUID) error {
+	if err := uc.teamFlagRepo.Delete(ctx, challengeID); err != nil {
+		return usecaseutil.Wrap(err, "ChallengeUseCase - DeleteTeamFlag")
+	}
+	return nil
+}
+
+// GetTeamFlag returns the flag teamID has to submit for a per-team challenge.
+func (uc *ChallengeUseCase) GetTeamFlag(ctx context.Context, challengeID, teamID uuid.UUID) (string, error) {
+	cfg, err := uc.GetTeamFlagConfig(ctx, challengeID)
+	if err != nil {
+		return "", err
+	}
+	if uc.teamRepo != nil {
+		if _, err := uc.teamRepo.GetByID(ctx, teamID); err != nil {
+			return "", usecaseutil.Wrap(err, "ChallengeUseCase - GetTeamFlag - GetTeam")
+		}
+	}
+	secret, err := uc.decryptTeamFlagSecret(cfg)
+	if err != nil {
+		return "", err
+	}
+	return entity.RenderTeamFlag(cfg.Template, secret, teamID), nil
+}
+
+// RenderTeamFlag returns the flag of teamID and text with every {{flag}} replaced by it, so
+// challenge files and descriptions can be built per team.
+func (uc *ChallengeUseCase) RenderTeamFlag(ctx context.Context, challengeID, teamID uuid.UUID, text string) (flag, rendered string, err error) {
+	flag, err = uc.GetTeamFlag(ctx, challengeID, teamID)
+	if err != nil {
+		return "", "", err
+	}
+	return flag, strings.ReplaceAll(text, TeamFlagPlaceholder, flag), nil
+}
+
+// submitCheckTeamFlag accepts only the submitting team's own flag. A flag derived for another
+// team is recorded as a shared_flag incident and still rejected.
+func (uc *ChallengeUseCase) submitCheckTeamFlag(sc *submitContext, cfg *entity.TeamFlagConfig) (bool, error) {
+	secret, err := uc.decryptTeamFlagSecret(cfg)
+	if err != nil {
+		return false, err
+	}
+	expected := entity.RenderTeamFlag(cfg.Template, secret, sc.teamID)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(sc.flag)) == 1 {
+		return true, nil
+	}
+	if uc.cheatRepo == nil || uc.teamRepo == nil {
+		return false, nil
+	}
+	teams, err := uc.teamRepo.GetAll(sc.ctx)
+	if err != nil {
+		return false, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - GetTeams")
+	}
+	for _, team := range teams {
+		if team.ID == sc.teamID || entity.RenderTeamFlag(cfg.Template, secret, team.ID) != sc.flag {
+			continue
+		}
+		sourceTeamID, userID, challengeID := team.ID, sc.userID, sc.challengeID
+		incident := &entity.CheatIncident{
+			Kind:         entity.CheatIncidentSharedFlag,
+			ChallengeID:  &challengeID,
+			TeamID:       sc.teamID,
+			UserID:       &userID,
+			SourceTeamID: &sourceTeamID,
+		}
+		if err := uc.cheatRepo.Create(sc.ctx, incident); err != nil {
+			return false, usecaseutil.Wrap(err, "ChallengeUseCase - SubmitFlag - CreateCheatIncident")
+		}
+		break
+	}
+	return false, nil
+}
+
+func (uc *ChallengeUseCase) newTeamFlagSecret() (string, error) {
+	if uc.crypto == nil {
+		return "", usecaseutil.Wrap(crypto.ErrServiceNotConfigured, "ChallengeUseCase - newTeamFlagSecret")
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", usecaseutil.Wrap(err, "ChallengeUseCase - newTeamFlagSecret - Read")
+	}
+	encrypted, err := uc.crypto.Encrypt(hex.EncodeToString(b))
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "ChallengeUseCase - newTeamFlagSecret - Encrypt")
+	}
+	return encrypted, nil
+}
+
+func (uc *ChallengeUseCase) decryptTeamFlagSecret(cfg *entity.TeamFlagConfig) (string, error) {
+	if uc.crypto == nil {
+		return "", usecaseutil.Wrap(crypto.ErrServiceNotConfigured, "ChallengeUseCase - decryptTeamFlagSecret")
+	}
+	secret, err := uc.crypto.Decrypt(cfg.Secret)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "ChallengeUseCase - decryptTeamFlagSecret")
+	}
+	return secret, nil
+}
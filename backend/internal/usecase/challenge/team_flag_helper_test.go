@@ -0,0 +1,28 @@
+package challenge
+
+import (
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+)
+
+func (h *ChallengeTestHelper) CreateChallengeUseCaseWithTeamFlags() (*ChallengeUseCase, redismock.ClientMock) {
+	h.t.Helper()
+	client, redis := redismock.NewClientMock()
+	return NewChallengeUseCase(
+		h.deps.challengeRepo,
+		WithSolveRepo(h.deps.solveRepo),
+		WithTxRepo(h.deps.txRepo),
+		WithCompetitionRepo(h.deps.compRepo),
+		WithTeamRepo(h.deps.teamRepo),
+		WithRedis(client),
+		WithCrypto(h.deps.crypto),
+		WithTeamFlagRepo(h.deps.teamFlagRepo),
+		WithCheatIncidentRepo(h.deps.cheatRepo),
+	), redis
+}
+
+func (h *ChallengeTestHelper) NewTeamFlagConfig(challengeID uuid.UUID, template string) *entity.TeamFlagConfig {
+	h.t.Helper()
+	return &entity.TeamFlagConfig{ChallengeID: challengeID, Template: template, Secret: "encrypted-secret"}
+}
@@ -0,0 +1,161 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testTeamFlagTemplate = "CTF{prefix_<hmac8>}"
+
+func TestChallengeUseCase_SetTeamFlag_InvalidTemplate(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	uc, _ := h.CreateChallengeUseCaseWithTeamFlags()
+
+	_, err := uc.SetTeamFlag(context.Background(), uuid.New(), "CTF{static}", false)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidTeamFlagTemplate)
+}
+
+func TestChallengeUseCase_SetTeamFlag_GeneratesSecret(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithTeamFlags()
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Test", "Web", 100, ""), nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(nil, entityError.ErrTeamFlagNotFound).Once()
+	deps.crypto.On("Encrypt", mock.MatchedBy(func(s string) bool { return len(s) == 64 })).Return("encrypted-secret", nil)
+	deps.teamFlagRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(cfg *entity.TeamFlagConfig) bool {
+		return cfg.Template == testTeamFlagTemplate && cfg.Secret == "encrypted-secret"
+	})).Return(nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(h.NewTeamFlagConfig(challengeID, testTeamFlagTemplate), nil).Once()
+
+	cfg, err := uc.SetTeamFlag(context.Background(), challengeID, " "+testTeamFlagTemplate+" ", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, testTeamFlagTemplate, cfg.Template)
+}
+
+func TestChallengeUseCase_SetTeamFlag_KeepsSecret(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithTeamFlags()
+
+	challengeID := uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Test", "Web", 100, ""), nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(h.NewTeamFlagConfig(challengeID, "CTF{old_<hmac8>}"), nil)
+	deps.teamFlagRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(cfg *entity.TeamFlagConfig) bool {
+		return cfg.Template == testTeamFlagTemplate && cfg.Secret == "encrypted-secret"
+	})).Return(nil)
+
+	_, err := uc.SetTeamFlag(context.Background(), challengeID, testTeamFlagTemplate, false)
+
+	assert.NoError(t, err)
+}
+
+func TestChallengeUseCase_RenderTeamFlag(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithTeamFlags()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(h.NewChallenge(challengeID, "Test", "Web", 100, ""), nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(h.NewTeamFlagConfig(challengeID, testTeamFlagTemplate), nil)
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.crypto.On("Decrypt", "encrypted-secret").Return("secret", nil)
+
+	flag, rendered, err := uc.RenderTeamFlag(context.Background(), challengeID, teamID, "echo {{flag}} > flag.txt")
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.RenderTeamFlag(testTeamFlagTemplate, "secret", teamID), flag)
+	assert.Equal(t, "echo "+flag+" > flag.txt", rendered)
+}
+
+func TestChallengeUseCase_SubmitFlag_TeamFlag_Correct(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithTeamFlags()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	challenge := h.NewChallenge(challengeID, "Test Challenge", "Web", 100, h.Sha256Hash("flag{primary}"))
+
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(challenge, nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(h.NewTeamFlagConfig(challengeID, testTeamFlagTemplate), nil)
+	deps.crypto.On("Decrypt", "encrypted-secret").Return("secret", nil)
+	deps.txRepo.On("RunTransaction", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		ctx, ok := args.Get(0).(context.Context)
+		if !ok {
+			return
+		}
+		fn, ok := args.Get(1).(func(context.Context, repo.Transaction) error)
+		if !ok {
+			return
+		}
+		_ = fn(ctx, nil) //nolint:errcheck
+	})
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challengeID).Return(nil, entityError.ErrSolveNotFound)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challengeID).Return(challenge, nil)
+	deps.txRepo.On("CreateSolveTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	deps.txRepo.On("IncrementChallengeSolveCountTx", mock.Anything, mock.Anything, challengeID).Return(1, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challengeID, entity.RenderTeamFlag(testTeamFlagTemplate, "secret", teamID), uuid.New(), &teamID)
+
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_TeamFlag_PrimaryRejected(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithTeamFlags()
+
+	challengeID, teamID := uuid.New(), uuid.New()
+	challenge := h.NewChallenge(challengeID, "Test Challenge", "Web", 100, h.Sha256Hash("flag{primary}"))
+
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(challenge, nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(h.NewTeamFlagConfig(challengeID, testTeamFlagTemplate), nil)
+	deps.crypto.On("Decrypt", "encrypted-secret").Return("secret", nil)
+	deps.teamRepo.On("GetAll", mock.Anything).Return([]*entity.Team{h.NewTeam(teamID), h.NewTeam(uuid.New())}, nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challengeID, "flag{primary}", uuid.New(), &teamID)
+
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestChallengeUseCase_SubmitFlag_TeamFlag_SharedFlagRecordsIncident(t *testing.T) {
+	h := NewChallengeTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateChallengeUseCaseWithTeamFlags()
+
+	challengeID, teamID, otherTeamID, userID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	challenge := h.NewChallenge(challengeID, "Test Challenge", "Web", 100, h.Sha256Hash("flag{primary}"))
+
+	deps.teamRepo.On("GetByID", mock.Anything, teamID).Return(h.NewTeam(teamID), nil)
+	deps.compRepo.On("Get", mock.Anything).Return(&entity.Competition{}, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, challengeID).Return(challenge, nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return(h.NewTeamFlagConfig(challengeID, testTeamFlagTemplate), nil)
+	deps.crypto.On("Decrypt", "encrypted-secret").Return("secret", nil)
+	deps.teamRepo.On("GetAll", mock.Anything).Return([]*entity.Team{h.NewTeam(teamID), h.NewTeam(otherTeamID)}, nil)
+	deps.cheatRepo.On("Create", mock.Anything, mock.MatchedBy(func(i *entity.CheatIncident) bool {
+		return i.Kind == entity.CheatIncidentSharedFlag && *i.ChallengeID == challengeID && i.TeamID == teamID &&
+			*i.UserID == userID && *i.SourceTeamID == otherTeamID
+	})).Return(nil)
+
+	valid, err := uc.SubmitFlag(context.Background(), challengeID, entity.RenderTeamFlag(testTeamFlagTemplate, "secret", otherTeamID), userID, &teamID)
+
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
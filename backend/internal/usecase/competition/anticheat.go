@@ -0,0 +1,287 @@
+package competition
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// TeamBanner bans the team of an escalated incident; the team use case implements it.
+type TeamBanner interface {
+	BanTeam(ctx context.Context, teamID uuid.UUID, reason string) error
+}
+
+type AntiCheatDeps struct {
+	CheatRepo      repo.CheatIncidentRepository
+	AnalyticsRepo  repo.CheatAnalyticsRepository
+	SubmissionRepo repo.SubmissionRepository
+	Teams          TeamBanner
+}
+
+// AntiCheatUseCase turns suspicious submission patterns into cheat incidents and lets admins
+// dismiss them or ban the team behind them.
+type AntiCheatUseCase struct {
+	deps AntiCheatDeps
+}
+
+func NewAntiCheatUseCase(deps AntiCheatDeps) *AntiCheatUseCase {
+	return &AntiCheatUseCase{deps: deps}
+}
+
+// Analyze scans every submission and records an incident for each new finding. Zero options
+// fall back to the defaults.
+func (uc *AntiCheatUseCase) Analyze(ctx context.Context, opts entity.CheatAnalysisOptions) (*entity.CheatAnalysisResult, error) {
+	if opts.SolveWindow == 0 {
+		opts.SolveWindow = entity.DefaultCheatSolveWindow
+	}
+	if opts.MinTeams == 0 {
+		opts.MinTeams = entity.DefaultCheatMinTeams
+	}
+	if opts.SolveWindow < time.Second || opts.SolveWindow > time.Hour || opts.MinTeams < 2 || opts.MinTeams > 100 {
+		return nil, entityError.ErrInvalidCheatAnalysis
+	}
+
+	result := &entity.CheatAnalysisResult{}
+	var err error
+	if result.SharedIP, err = uc.analyzeSharedIPs(ctx); err != nil {
+		return nil, err
+	}
+	if result.SharedWrongFlag, err = uc.analyzeSharedWrongFlags(ctx, opts.MinTeams); err != nil {
+		return nil, err
+	}
+	if result.CloseSolve, err = uc.analyzeCloseSolves(ctx, opts.SolveWindow); err != nil {
+		return nil, err
+	}
+	if result.NoDownload, err = uc.analyzeMissingDownloads(ctx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// analyzeSharedIPs flags every team that submitted from an IP after another team did.
+func (uc *AntiCheatUseCase) analyzeSharedIPs(ctx context.Context) (int, error) {
+	subs, err := uc.deps.AnalyticsRepo.GetSharedIPSubmissions(ctx)
+	if err != nil {
+		return 0, usecaseutil.Wrap(err, "AntiCheatUseCase - Analyze - GetSharedIPSubmissions")
+	}
+	created := 0
+	for _, group := range groupSubmissions(subs, func(s *entity.Submission) string { return s.IP }) {
+		first := group[0]
+		for _, sub := range group[1:] {
+			ok, err := uc.record(ctx, &entity.CheatIncident{
+				Kind:         entity.CheatIncidentSharedIP,
+				TeamID:       *sub.TeamID,
+				UserID:       &sub.UserID,
+				SourceTeamID: first.TeamID,
+				Evidence:     entity.CheatEvidence{SubmissionIDs: []uuid.UUID{first.ID, sub.ID}, IP: sub.IP},
+				Fingerprint:  fmt.Sprintf("%s:%s:%s", entity.CheatIncidentSharedIP, sub.IP, sub.TeamID),
+			})
+			if err != nil {
+				return 0, usecaseutil.Wrap(err, "AntiCheatUseCase - Analyze - SharedIP")
+			}
+			if ok {
+				created++
+			}
+		}
+	}
+	return created, nil
+}
+
+// analyzeSharedWrongFlags flags every team that submitted a wrong flag another team had
+// submitted to the same challenge before it.
+func (uc *AntiCheatUseCase) analyzeSharedWrongFlags(ctx context.Context, minTeams int) (int, error) {
+	subs, err := uc.deps.AnalyticsRepo.GetSharedWrongFlagSubmissions(ctx, minTeams)
+	if err != nil {
+		return 0, usecaseutil.Wrap(err, "AntiCheatUseCase - Analyze - GetSharedWrongFlagSubmissions")
+	}
+	created := 0
+	groups := groupSubmissions(subs, func(s *entity.Submission) string {
+		return s.ChallengeID.String() + ":" + s.SubmittedFlag
+	})
+	for _, group := range groups {
+		first := group[0]
+		flagHash := sha256.Sum256([]byte(first.SubmittedFlag))
+		for _, sub := range group[1:] {
+			ok, err := uc.record(ctx, &entity.CheatIncident{
+				Kind:         entity.CheatIncidentSharedWrongFlag,
+				ChallengeID:  &sub.ChallengeID,
+				TeamID:       *sub.TeamID,
+				UserID:       &sub.UserID,
+				SourceTeamID: first.TeamID,
+				Evidence:     entity.CheatEvidence{SubmissionIDs: []uuid.UUID{first.ID, sub.ID}, Flag: sub.SubmittedFlag},
+				Fingerprint: fmt.Sprintf("%s:%s:%s:%s", entity.CheatIncidentSharedWrongFlag,
+					sub.ChallengeID, hex.EncodeToString(flagHash[:]), sub.TeamID),
+			})
+			if err != nil {
+				return 0, usecaseutil.Wrap(err, "AntiCheatUseCase - Analyze - SharedWrongFlag")
+			}
+			if ok {
+				created++
+			}
+		}
+	}
+	return created, nil
+}
+
+func (uc *AntiCheatUseCase) analyzeCloseSolves(ctx context.Context, window time.Duration) (int, error) {
+	pairs, err := uc.deps.AnalyticsRepo.GetCloseSolves(ctx, window)
+	if err != nil {
+		return 0, usecaseutil.Wrap(err, "AntiCheatUseCase - Analyze - GetCloseSolves")
+	}
+	created := 0
+	for _, pair := range pairs {
+		solve, prev := pair.Solve, pair.Previous
+		apart := solve.CreatedAt.Sub(prev.CreatedAt).Seconds()
+		ok, err := uc.record(ctx, &entity.CheatIncident{
+			Kind:         entity.CheatIncidentCloseSolve,
+			ChallengeID:  &solve.ChallengeID,
+			TeamID:       *solve.TeamID,
+			UserID:       &solve.UserID,
+			SourceTeamID: prev.TeamID,
+			Evidence:     entity.CheatEvidence{SubmissionIDs: []uuid.UUID{prev.ID, solve.ID}, SecondsApart: &apart},
+			Fingerprint: fmt.Sprintf("%s:%s:%s:%s", entity.CheatIncidentCloseSolve,
+				solve.ChallengeID, solve.TeamID, prev.TeamID),
+		})
+		if err != nil {
+			return 0, usecaseutil.Wrap(err, "AntiCheatUseCase - Analyze - CloseSolve")
+		}
+		if ok {
+			created++
+		}
+	}
+	return created, nil
+}
+
+func (uc *AntiCheatUseCase) analyzeMissingDownloads(ctx context.Context) (int, error) {
+	subs, err := uc.deps.AnalyticsRepo.GetSolvesWithoutDownload(ctx)
+	if err != nil {
+		return 0, usecaseutil.Wrap(err, "AntiCheatUseCase - Analyze - GetSolvesWithoutDownload")
+	}
+	created := 0
+	for _, sub := range subs {
+		ok, err := uc.record(ctx, &entity.CheatIncident{
+			Kind:        entity.CheatIncidentNoDownload,
+			ChallengeID: &sub.ChallengeID,
+			TeamID:      *sub.TeamID,
+			UserID:      &sub.UserID,
+			Evidence:    entity.CheatEvidence{SubmissionIDs: []uuid.UUID{sub.ID}},
+			Fingerprint: fmt.Sprintf("%s:%s:%s", entity.CheatIncidentNoDownload, sub.ChallengeID, sub.UserID),
+		})
+		if err != nil {
+			return 0, usecaseutil.Wrap(err, "AntiCheatUseCase - Analyze - NoDownload")
+		}
+		if ok {
+			created++
+		}
+	}
+	return created, nil
+}
+
+// record reports false when an earlier analysis already recorded the finding.
+func (uc *AntiCheatUseCase) record(ctx context.Context, incident *entity.CheatIncident) (bool, error) {
+	if err := uc.deps.CheatRepo.Create(ctx, incident); err != nil {
+		if errors.Is(err, entityError.ErrCheatIncidentExists) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// groupSubmissions splits submissions by key, keeping the order keys first appear in, and
+// sorts each group oldest first.
+func groupSubmissions(subs []*entity.Submission, key func(*entity.Submission) string) [][]*entity.Submission {
+	index := make(map[string]int)
+	var groups [][]*entity.Submission
+	for _, sub := range subs {
+		k := key(sub)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], sub)
+	}
+	for _, group := range groups {
+		sort.SliceStable(group, func(a, b int) bool {
+			return group[a].CreatedAt.Before(group[b].CreatedAt)
+		})
+	}
+	return groups
+}
+
+// GetIncident returns an incident with the submissions its evidence points at.
+func (uc *AntiCheatUseCase) GetIncident(ctx context.Context, id uuid.UUID) (*entity.CheatIncidentWithDetails, []*entity.SubmissionWithDetails, error) {
+	incident, err := uc.deps.CheatRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, usecaseutil.Wrap(err, "AntiCheatUseCase - GetIncident")
+	}
+	if len(incident.Evidence.SubmissionIDs) == 0 {
+		return incident, []*entity.SubmissionWithDetails{}, nil
+	}
+	subs, err := uc.deps.SubmissionRepo.GetByIDs(ctx, incident.Evidence.SubmissionIDs)
+	if err != nil {
+		return nil, nil, usecaseutil.Wrap(err, "AntiCheatUseCase - GetIncident - GetByIDs")
+	}
+	return incident, subs, nil
+}
+
+// Dismiss closes an open incident as a false positive.
+func (uc *AntiCheatUseCase) Dismiss(ctx context.Context, id uuid.UUID, resolvedBy *uuid.UUID) (*entity.CheatIncidentWithDetails, error) {
+	if _, err := uc.openIncident(ctx, id); err != nil {
+		return nil, usecaseutil.Wrap(err, "AntiCheatUseCase - Dismiss")
+	}
+	if err := uc.deps.CheatRepo.Resolve(ctx, id, entity.CheatIncidentDismissed, resolvedBy); err != nil {
+		return nil, usecaseutil.Wrap(err, "AntiCheatUseCase - Dismiss - Resolve")
+	}
+	return uc.reload(ctx, id, "Dismiss")
+}
+
+// Escalate bans the team of an open incident and closes the incident. An empty reason is
+// replaced by one naming the kind of incident.
+func (uc *AntiCheatUseCase) Escalate(ctx context.Context, id uuid.UUID, resolvedBy *uuid.UUID, reason string) (*entity.CheatIncidentWithDetails, error) {
+	incident, err := uc.openIncident(ctx, id)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "AntiCheatUseCase - Escalate")
+	}
+	if reason == "" {
+		reason = fmt.Sprintf("cheating incident: %s", incident.Kind)
+	}
+	if err := uc.deps.Teams.BanTeam(ctx, incident.TeamID, reason); err != nil {
+		return nil, usecaseutil.Wrap(err, "AntiCheatUseCase - Escalate - BanTeam")
+	}
+	if err := uc.deps.CheatRepo.Resolve(ctx, id, entity.CheatIncidentEscalated, resolvedBy); err != nil {
+		return nil, usecaseutil.Wrap(err, "AntiCheatUseCase - Escalate - Resolve")
+	}
+	return uc.reload(ctx, id, "Escalate")
+}
+
+func (uc *AntiCheatUseCase) openIncident(ctx context.Context, id uuid.UUID) (*entity.CheatIncidentWithDetails, error) {
+	incident, err := uc.deps.CheatRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if incident.Status != entity.CheatIncidentOpen {
+		return nil, entityError.ErrCheatIncidentResolved
+	}
+	return incident, nil
+}
+
+func (uc *AntiCheatUseCase) reload(ctx context.Context, id uuid.UUID, op string) (*entity.CheatIncidentWithDetails, error) {
+	incident, err := uc.deps.CheatRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "AntiCheatUseCase - "+op+" - GetByID")
+	}
+	return incident, nil
+}
@@ -0,0 +1,40 @@
+package competition
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+)
+
+func (h *CompetitionTestHelper) CreateAntiCheatUseCase() *AntiCheatUseCase {
+	h.t.Helper()
+	return NewAntiCheatUseCase(AntiCheatDeps{
+		CheatRepo:      h.deps.cheatRepo,
+		AnalyticsRepo:  h.deps.analyticsRepo,
+		SubmissionRepo: h.deps.submissionRepo,
+		Teams:          h.deps.teamBanner,
+	})
+}
+
+// NewTeamSubmission is a submission of teamID made offset after a fixed point in time.
+func (h *CompetitionTestHelper) NewTeamSubmission(teamID uuid.UUID, offset time.Duration) *entity.Submission {
+	h.t.Helper()
+	sub := h.NewSubmission(uuid.New(), &teamID, uuid.New(), "flag{x}", false)
+	sub.CreatedAt = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC).Add(offset)
+	return sub
+}
+
+func (h *CompetitionTestHelper) NewCheatIncident(status entity.CheatIncidentStatus) *entity.CheatIncidentWithDetails {
+	h.t.Helper()
+	return &entity.CheatIncidentWithDetails{
+		CheatIncident: entity.CheatIncident{
+			ID:       uuid.New(),
+			Kind:     entity.CheatIncidentCloseSolve,
+			TeamID:   uuid.New(),
+			Status:   status,
+			Evidence: entity.CheatEvidence{SubmissionIDs: []uuid.UUID{uuid.New(), uuid.New()}},
+		},
+		TeamName: "Copycats",
+	}
+}
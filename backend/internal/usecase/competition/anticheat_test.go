@@ -0,0 +1,199 @@
+package competition
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAntiCheatUseCase_Analyze_RecordsFindings(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateAntiCheatUseCase()
+
+	teamA, teamB, teamC := uuid.New(), uuid.New(), uuid.New()
+	ipA := h.NewTeamSubmission(teamA, 0)
+	ipB := h.NewTeamSubmission(teamB, time.Minute)
+	ipC := h.NewTeamSubmission(teamC, 2*time.Minute)
+	for _, sub := range []*entity.Submission{ipA, ipB, ipC} {
+		sub.IP = "10.0.0.1"
+	}
+	wrongA := h.NewTeamSubmission(teamA, time.Minute)
+	wrongB := h.NewTeamSubmission(teamB, 0)
+	wrongB.ChallengeID = wrongA.ChallengeID
+	solve := h.NewTeamSubmission(teamB, 5*time.Second)
+	prev := h.NewTeamSubmission(teamA, 0)
+	blind := h.NewTeamSubmission(teamC, 0)
+
+	// Sorted by IP and team as the repository returns them, not by time.
+	deps.analyticsRepo.On("GetSharedIPSubmissions", mock.Anything).Return([]*entity.Submission{ipC, ipA, ipB}, nil)
+	deps.analyticsRepo.On("GetSharedWrongFlagSubmissions", mock.Anything, entity.DefaultCheatMinTeams).
+		Return([]*entity.Submission{wrongA, wrongB}, nil)
+	deps.analyticsRepo.On("GetCloseSolves", mock.Anything, entity.DefaultCheatSolveWindow).
+		Return([]*repo.CloseSolve{{Solve: solve, Previous: prev}}, nil)
+	deps.analyticsRepo.On("GetSolvesWithoutDownload", mock.Anything).Return([]*entity.Submission{blind}, nil)
+
+	var recorded []*entity.CheatIncident
+	deps.cheatRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		recorded = append(recorded, args.Get(1).(*entity.CheatIncident))
+	})
+
+	result, err := uc.Analyze(context.Background(), entity.CheatAnalysisOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, &entity.CheatAnalysisResult{SharedIP: 2, SharedWrongFlag: 1, CloseSolve: 1, NoDownload: 1}, result)
+	require.Len(t, recorded, 5)
+
+	assert.Equal(t, teamB, recorded[0].TeamID)
+	assert.Equal(t, teamA, *recorded[0].SourceTeamID)
+	assert.Equal(t, []uuid.UUID{ipA.ID, ipB.ID}, recorded[0].Evidence.SubmissionIDs)
+	assert.Equal(t, "10.0.0.1", recorded[0].Evidence.IP)
+	assert.Nil(t, recorded[0].ChallengeID)
+	assert.Equal(t, teamC, recorded[1].TeamID)
+
+	assert.Equal(t, entity.CheatIncidentSharedWrongFlag, recorded[2].Kind)
+	assert.Equal(t, teamA, recorded[2].TeamID)
+	assert.Equal(t, teamB, *recorded[2].SourceTeamID)
+	assert.Equal(t, "flag{x}", recorded[2].Evidence.Flag)
+
+	assert.Equal(t, entity.CheatIncidentCloseSolve, recorded[3].Kind)
+	assert.InDelta(t, 5.0, *recorded[3].Evidence.SecondsApart, 0.001)
+	assert.Equal(t, []uuid.UUID{prev.ID, solve.ID}, recorded[3].Evidence.SubmissionIDs)
+
+	assert.Equal(t, entity.CheatIncidentNoDownload, recorded[4].Kind)
+	assert.Equal(t, blind.UserID, *recorded[4].UserID)
+	for _, incident := range recorded {
+		assert.NotEmpty(t, incident.Fingerprint)
+	}
+}
+
+func TestAntiCheatUseCase_Analyze_SkipsRecordedFindings(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateAntiCheatUseCase()
+
+	blind := h.NewTeamSubmission(uuid.New(), 0)
+	opts := entity.CheatAnalysisOptions{SolveWindow: 30 * time.Second, MinTeams: 3}
+
+	deps.analyticsRepo.On("GetSharedIPSubmissions", mock.Anything).Return(nil, nil)
+	deps.analyticsRepo.On("GetSharedWrongFlagSubmissions", mock.Anything, 3).Return(nil, nil)
+	deps.analyticsRepo.On("GetCloseSolves", mock.Anything, 30*time.Second).Return(nil, nil)
+	deps.analyticsRepo.On("GetSolvesWithoutDownload", mock.Anything).Return([]*entity.Submission{blind}, nil)
+	deps.cheatRepo.On("Create", mock.Anything, mock.Anything).Return(entityError.ErrCheatIncidentExists)
+
+	result, err := uc.Analyze(context.Background(), opts)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Total())
+}
+
+func TestAntiCheatUseCase_Analyze_InvalidOptions(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	uc := h.CreateAntiCheatUseCase()
+
+	for _, opts := range []entity.CheatAnalysisOptions{
+		{SolveWindow: 2 * time.Hour},
+		{SolveWindow: -time.Second},
+		{MinTeams: 1},
+		{MinTeams: 101},
+	} {
+		_, err := uc.Analyze(context.Background(), opts)
+		assert.ErrorIs(t, err, entityError.ErrInvalidCheatAnalysis)
+	}
+}
+
+func TestAntiCheatUseCase_Analyze_Error(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateAntiCheatUseCase()
+
+	deps.analyticsRepo.On("GetSharedIPSubmissions", mock.Anything).Return(nil, assert.AnError)
+
+	_, err := uc.Analyze(context.Background(), entity.CheatAnalysisOptions{})
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestAntiCheatUseCase_GetIncident_LoadsEvidence(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateAntiCheatUseCase()
+
+	incident := h.NewCheatIncident(entity.CheatIncidentOpen)
+	subs := []*entity.SubmissionWithDetails{{Submission: entity.Submission{ID: incident.Evidence.SubmissionIDs[0]}}}
+	deps.cheatRepo.On("GetByID", mock.Anything, incident.ID).Return(incident, nil)
+	deps.submissionRepo.On("GetByIDs", mock.Anything, incident.Evidence.SubmissionIDs).Return(subs, nil)
+
+	got, gotSubs, err := uc.GetIncident(context.Background(), incident.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, incident, got)
+	assert.Equal(t, subs, gotSubs)
+}
+
+func TestAntiCheatUseCase_Dismiss_Success(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateAntiCheatUseCase()
+
+	incident := h.NewCheatIncident(entity.CheatIncidentOpen)
+	adminID := uuid.New()
+	deps.cheatRepo.On("GetByID", mock.Anything, incident.ID).Return(incident, nil)
+	deps.cheatRepo.On("Resolve", mock.Anything, incident.ID, entity.CheatIncidentDismissed, &adminID).Return(nil)
+
+	_, err := uc.Dismiss(context.Background(), incident.ID, &adminID)
+
+	require.NoError(t, err)
+	deps.teamBanner.AssertNotCalled(t, "BanTeam", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAntiCheatUseCase_Dismiss_AlreadyResolved(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateAntiCheatUseCase()
+
+	incident := h.NewCheatIncident(entity.CheatIncidentEscalated)
+	deps.cheatRepo.On("GetByID", mock.Anything, incident.ID).Return(incident, nil)
+
+	_, err := uc.Dismiss(context.Background(), incident.ID, nil)
+
+	assert.ErrorIs(t, err, entityError.ErrCheatIncidentResolved)
+}
+
+func TestAntiCheatUseCase_Escalate_BansTeam(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateAntiCheatUseCase()
+
+	incident := h.NewCheatIncident(entity.CheatIncidentOpen)
+	deps.cheatRepo.On("GetByID", mock.Anything, incident.ID).Return(incident, nil)
+	deps.teamBanner.On("BanTeam", mock.Anything, incident.TeamID, "cheating incident: close_solve").Return(nil)
+	deps.cheatRepo.On("Resolve", mock.Anything, incident.ID, entity.CheatIncidentEscalated, (*uuid.UUID)(nil)).Return(nil)
+
+	_, err := uc.Escalate(context.Background(), incident.ID, nil, "")
+
+	require.NoError(t, err)
+}
+
+func TestAntiCheatUseCase_Escalate_BanError(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateAntiCheatUseCase()
+
+	incident := h.NewCheatIncident(entity.CheatIncidentOpen)
+	deps.cheatRepo.On("GetByID", mock.Anything, incident.ID).Return(incident, nil)
+	deps.teamBanner.On("BanTeam", mock.Anything, incident.TeamID, "shared account").Return(entityError.ErrTeamNotFound)
+
+	_, err := uc.Escalate(context.Background(), incident.ID, nil, "shared account")
+
+	assert.ErrorIs(t, err, entityError.ErrTeamNotFound)
+	deps.cheatRepo.AssertNotCalled(t, "Resolve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/internal/storage"
 	"github.com/skr1ms/CTFBoard/pkg/logger"
@@ -25,6 +27,16 @@ type BackupDeps struct {
 	CompetitionRepo repo.CompetitionRepository
 	ChallengeRepo   repo.ChallengeRepository
 	HintRepo        repo.HintRepository
+	FlagRepo        repo.ChallengeFlagRepository
+	DecoyRepo       repo.DecoyFlagRepository
+	RequirementRepo repo.ChallengeRequirementRepository
+	ScheduleRepo    repo.ChallengeScheduleRepository
+	TeamFlagRepo    repo.TeamFlagRepository
+	InstanceRepo    repo.InstanceRepository
+	ReviewRepo      repo.ReviewRepository
+	StageRepo       repo.ChallengeStageRepository
+	ScoringRepo     repo.ChallengeScoringRepository
+	AttemptRepo     repo.AttemptLimitRepository
 	TeamRepo        repo.TeamRepository
 	UserRepo        repo.UserRepository
 	AwardRepo       repo.AwardRepository
@@ -96,6 +108,9 @@ func (uc *BackupUseCase) exportOptional(
 	uc.exportOptionalUsers(ctx, backup, opts, mu, g)
 	uc.exportOptionalAwards(ctx, backup, opts, mu, g)
 	uc.exportOptionalSolves(ctx, backup, opts, mu, g)
+	uc.exportOptionalReviews(ctx, backup, opts, mu, g)
+	uc.exportOptionalStageSolves(ctx, backup, opts, mu, g)
+	uc.exportOptionalAttemptResets(ctx, backup, opts, mu, g)
 	uc.exportOptionalFiles(ctx, backup, opts, mu, g)
 }
 
@@ -163,6 +178,62 @@ func (uc *BackupUseCase) exportOptionalSolves(ctx context.Context, backup *entit
 	})
 }
 
+func (uc *BackupUseCase) exportOptionalReviews(ctx context.Context, backup *entity.BackupData, opts entity.ExportOptions, mu *sync.Mutex, g *errgroup.Group) {
+	if !opts.IncludeSolves || uc.deps.ReviewRepo == nil {
+		return
+	}
+	g.Go(func() error {
+		reviews, err := uc.fetchReviews(ctx)
+		if err != nil {
+			return usecaseutil.Wrap(err, "BackupUseCase - Export - fetchReviews")
+		}
+		mu.Lock()
+		backup.Reviews = reviews
+		mu.Unlock()
+		return nil
+	})
+}
+
+func (uc *BackupUseCase) exportOptionalStageSolves(ctx context.Context, backup *entity.BackupData, opts entity.ExportOptions, mu *sync.Mutex, g *errgroup.Group) {
+	if !opts.IncludeSolves || uc.deps.StageRepo == nil {
+		return
+	}
+	g.Go(func() error {
+		solves, err := uc.deps.StageRepo.GetAllSolves(ctx)
+		if err != nil {
+			return usecaseutil.Wrap(err, "BackupUseCase - Export - GetAllStageSolves")
+		}
+		out := make([]entity.StageSolve, len(solves))
+		for i, s := range solves {
+			out[i] = *s
+		}
+		mu.Lock()
+		backup.StageSolves = out
+		mu.Unlock()
+		return nil
+	})
+}
+
+func (uc *BackupUseCase) exportOptionalAttemptResets(ctx context.Context, backup *entity.BackupData, opts entity.ExportOptions, mu *sync.Mutex, g *errgroup.Group) {
+	if !opts.IncludeSolves || uc.deps.AttemptRepo == nil {
+		return
+	}
+	g.Go(func() error {
+		resets, err := uc.deps.AttemptRepo.GetAllResets(ctx)
+		if err != nil {
+			return usecaseutil.Wrap(err, "BackupUseCase - Export - GetAllAttemptResets")
+		}
+		out := make([]entity.AttemptReset, len(resets))
+		for i, r := range resets {
+			out[i] = *r
+		}
+		mu.Lock()
+		backup.AttemptResets = out
+		mu.Unlock()
+		return nil
+	})
+}
+
 func (uc *BackupUseCase) exportOptionalFiles(ctx context.Context, backup *entity.BackupData, opts entity.ExportOptions, mu *sync.Mutex, g *errgroup.Group) {
 	if !opts.IncludeFiles {
 		return
@@ -185,6 +256,25 @@ func (uc *BackupUseCase) fetchChallengesWithHints(ctx context.Context) ([]entity
 		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengesWithHints - GetAll")
 	}
 
+	requirements, err := uc.fetchChallengeRequirements(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := uc.fetchChallengeSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attemptLimits, err := uc.fetchChallengeAttemptLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	challengesWithSolved, err = uc.appendUnreleasedChallenges(ctx, challengesWithSolved, schedules)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make([]entity.ChallengeExport, 0, len(challengesWithSolved))
 	for _, cws := range challengesWithSolved {
 		hints, err := uc.deps.HintRepo.GetByChallengeID(ctx, cws.Challenge.ID)
@@ -197,15 +287,221 @@ func (uc *BackupUseCase) fetchChallengesWithHints(ctx context.Context) ([]entity
 			hintsCopy[i] = *h
 		}
 
-		result = append(result, entity.ChallengeExport{
-			Challenge: *cws.Challenge,
-			Hints:     hintsCopy,
-		})
+		flags, err := uc.fetchChallengeFlags(ctx, cws.Challenge.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		decoys, err := uc.fetchChallengeDecoys(ctx, cws.Challenge.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		export := entity.ChallengeExport{
+			Challenge:    *cws.Challenge,
+			Hints:        hintsCopy,
+			Flags:        flags,
+			Decoys:       decoys,
+			Requirements: requirements[cws.Challenge.ID],
+			Schedule:     schedules[cws.Challenge.ID],
+			AttemptLimit: attemptLimits[cws.Challenge.ID],
+		}
+		if err := uc.fetchChallengeConfigs(ctx, &export); err != nil {
+			return nil, err
+		}
+		result = append(result, export)
 	}
 
 	return result, nil
 }
 
+// fetchChallengeConfigs fills in the optional per-challenge settings of export: team flags,
+// instances, manual review, stages and scoring.
+func (uc *BackupUseCase) fetchChallengeConfigs(ctx context.Context, export *entity.ChallengeExport) error {
+	var err error
+	if export.TeamFlag, err = uc.fetchChallengeTeamFlag(ctx, export.ID); err != nil {
+		return err
+	}
+	if export.Instance, err = uc.fetchChallengeInstanceConfig(ctx, export.ID); err != nil {
+		return err
+	}
+	if export.ManualReview, err = uc.fetchChallengeManualReview(ctx, export.ID); err != nil {
+		return err
+	}
+	if export.Stages, err = uc.fetchChallengeStages(ctx, export.ID); err != nil {
+		return err
+	}
+	if export.Scoring, err = uc.fetchChallengeScoring(ctx, export.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (uc *BackupUseCase) fetchChallengeFlags(ctx context.Context, challengeID uuid.UUID) ([]entity.ChallengeFlag, error) {
+	if uc.deps.FlagRepo == nil {
+		return nil, nil
+	}
+	flags, err := uc.deps.FlagRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeFlags")
+	}
+	out := make([]entity.ChallengeFlag, len(flags))
+	for i, f := range flags {
+		out[i] = *f
+	}
+	return out, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeDecoys(ctx context.Context, challengeID uuid.UUID) ([]entity.DecoyFlag, error) {
+	if uc.deps.DecoyRepo == nil {
+		return nil, nil
+	}
+	decoys, err := uc.deps.DecoyRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeDecoys")
+	}
+	out := make([]entity.DecoyFlag, len(decoys))
+	for i, d := range decoys {
+		out[i] = *d
+	}
+	return out, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeTeamFlag(ctx context.Context, challengeID uuid.UUID) (*entity.TeamFlagExport, error) {
+	if uc.deps.TeamFlagRepo == nil {
+		return nil, nil
+	}
+	cfg, err := uc.deps.TeamFlagRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrTeamFlagNotFound) {
+			return nil, nil
+		}
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeTeamFlag")
+	}
+	return &entity.TeamFlagExport{Template: cfg.Template, Secret: cfg.Secret, CreatedAt: cfg.CreatedAt}, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeInstanceConfig(ctx context.Context, challengeID uuid.UUID) (*entity.InstanceConfig, error) {
+	if uc.deps.InstanceRepo == nil {
+		return nil, nil
+	}
+	cfg, err := uc.deps.InstanceRepo.GetConfig(ctx, challengeID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrInstanceNotConfigured) {
+			return nil, nil
+		}
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeInstanceConfig")
+	}
+	return cfg, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeManualReview(ctx context.Context, challengeID uuid.UUID) (bool, error) {
+	if uc.deps.ReviewRepo == nil {
+		return false, nil
+	}
+	if _, err := uc.deps.ReviewRepo.GetConfig(ctx, challengeID); err != nil {
+		if errors.Is(err, entityError.ErrManualReviewNotConfigured) {
+			return false, nil
+		}
+		return false, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeManualReview")
+	}
+	return true, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeStages(ctx context.Context, challengeID uuid.UUID) ([]entity.ChallengeStage, error) {
+	if uc.deps.StageRepo == nil {
+		return nil, nil
+	}
+	stages, err := uc.deps.StageRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeStages")
+	}
+	out := make([]entity.ChallengeStage, len(stages))
+	for i, s := range stages {
+		out[i] = *s
+	}
+	return out, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeScoring(ctx context.Context, challengeID uuid.UUID) (*entity.ChallengeScoring, error) {
+	if uc.deps.ScoringRepo == nil {
+		return nil, nil
+	}
+	s, err := uc.deps.ScoringRepo.GetByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeScoring")
+	}
+	return s, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeRequirements(ctx context.Context) (map[uuid.UUID]*entity.ChallengeRequirements, error) {
+	if uc.deps.RequirementRepo == nil {
+		return nil, nil
+	}
+	all, err := uc.deps.RequirementRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeRequirements")
+	}
+	out := make(map[uuid.UUID]*entity.ChallengeRequirements, len(all))
+	for _, r := range all {
+		out[r.ChallengeID] = r
+	}
+	return out, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeSchedules(ctx context.Context) (map[uuid.UUID]*entity.ChallengeSchedule, error) {
+	if uc.deps.ScheduleRepo == nil {
+		return nil, nil
+	}
+	all, err := uc.deps.ScheduleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeSchedules")
+	}
+	out := make(map[uuid.UUID]*entity.ChallengeSchedule, len(all))
+	for _, s := range all {
+		out[s.Schedule.ChallengeID] = s.Schedule
+	}
+	return out, nil
+}
+
+func (uc *BackupUseCase) fetchChallengeAttemptLimits(ctx context.Context) (map[uuid.UUID]*entity.AttemptLimit, error) {
+	if uc.deps.AttemptRepo == nil {
+		return nil, nil
+	}
+	all, err := uc.deps.AttemptRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchChallengeAttemptLimits")
+	}
+	out := make(map[uuid.UUID]*entity.AttemptLimit, len(all))
+	for _, l := range all {
+		out[l.ChallengeID] = l
+	}
+	return out, nil
+}
+
+// appendUnreleasedChallenges adds the scheduled challenges the public listing leaves out because
+// they are not released yet or already hidden again.
+func (uc *BackupUseCase) appendUnreleasedChallenges(ctx context.Context, listed []*repo.ChallengeWithSolved, schedules map[uuid.UUID]*entity.ChallengeSchedule) ([]*repo.ChallengeWithSolved, error) {
+	seen := make(map[uuid.UUID]bool, len(listed))
+	for _, cws := range listed {
+		seen[cws.Challenge.ID] = true
+	}
+	for id := range schedules {
+		if seen[id] {
+			continue
+		}
+		c, err := uc.deps.ChallengeRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, usecaseutil.Wrap(err, "BackupUseCase - appendUnreleasedChallenges")
+		}
+		if c.IsHidden {
+			continue
+		}
+		listed = append(listed, &repo.ChallengeWithSolved{Challenge: c})
+	}
+	return listed, nil
+}
+
 func (uc *BackupUseCase) fetchTeamsWithMembers(ctx context.Context) ([]entity.TeamExport, error) {
 	teams, err := uc.deps.TeamRepo.GetAll(ctx)
 	if err != nil {
@@ -280,6 +576,25 @@ func (uc *BackupUseCase) fetchSolves(ctx context.Context) ([]entity.Solve, error
 	return result, nil
 }
 
+// backupReviewPageSize is how many submission reviews fetchReviews reads per query.
+const backupReviewPageSize = 500
+
+func (uc *BackupUseCase) fetchReviews(ctx context.Context) ([]entity.SubmissionReview, error) {
+	var result []entity.SubmissionReview
+	for offset := 0; ; offset += backupReviewPageSize {
+		page, err := uc.deps.ReviewRepo.GetAll(ctx, nil, backupReviewPageSize, offset)
+		if err != nil {
+			return nil, usecaseutil.Wrap(err, "BackupUseCase - fetchReviews - GetAll")
+		}
+		for _, r := range page {
+			result = append(result, r.SubmissionReview)
+		}
+		if len(page) < backupReviewPageSize {
+			return result, nil
+		}
+	}
+}
+
 func (uc *BackupUseCase) fetchFiles(ctx context.Context) ([]entity.File, error) {
 	files, err := uc.deps.FileRepo.GetAll(ctx)
 	if err != nil {
@@ -19,6 +19,7 @@ func (h *CompetitionTestHelper) CreateBackupUseCase() *BackupUseCase {
 		CompetitionRepo: h.deps.competitionRepo,
 		ChallengeRepo:   h.deps.challengeRepo,
 		HintRepo:        h.deps.hintRepo,
+		FlagRepo:        h.deps.flagRepo,
 		TeamRepo:        h.deps.teamRepo,
 		UserRepo:        h.deps.userRepo,
 		AwardRepo:       h.deps.awardRepo,
@@ -31,11 +32,68 @@ func (h *CompetitionTestHelper) CreateBackupUseCase() *BackupUseCase {
 	})
 }
 
+func (h *CompetitionTestHelper) CreateBackupUseCaseWithSchedules() *BackupUseCase {
+	h.t.Helper()
+	uc := h.CreateBackupUseCase()
+	uc.deps.ScheduleRepo = h.deps.scheduleRepo
+	return uc
+}
+
+func (h *CompetitionTestHelper) CreateBackupUseCaseWithDecoys() *BackupUseCase {
+	h.t.Helper()
+	uc := h.CreateBackupUseCase()
+	uc.deps.DecoyRepo = h.deps.decoyRepo
+	return uc
+}
+
+func (h *CompetitionTestHelper) CreateBackupUseCaseWithTeamFlags() *BackupUseCase {
+	h.t.Helper()
+	uc := h.CreateBackupUseCase()
+	uc.deps.TeamFlagRepo = h.deps.teamFlagRepo
+	return uc
+}
+
+func (h *CompetitionTestHelper) CreateBackupUseCaseWithInstances() *BackupUseCase {
+	h.t.Helper()
+	uc := h.CreateBackupUseCase()
+	uc.deps.InstanceRepo = h.deps.instanceRepo
+	return uc
+}
+
+func (h *CompetitionTestHelper) CreateBackupUseCaseWithReviews() *BackupUseCase {
+	h.t.Helper()
+	uc := h.CreateBackupUseCase()
+	uc.deps.ReviewRepo = h.deps.reviewRepo
+	return uc
+}
+
+func (h *CompetitionTestHelper) CreateBackupUseCaseWithStages() *BackupUseCase {
+	h.t.Helper()
+	uc := h.CreateBackupUseCase()
+	uc.deps.StageRepo = h.deps.stageRepo
+	return uc
+}
+
+func (h *CompetitionTestHelper) CreateBackupUseCaseWithScoring() *BackupUseCase {
+	h.t.Helper()
+	uc := h.CreateBackupUseCase()
+	uc.deps.ScoringRepo = h.deps.scoringRepo
+	return uc
+}
+
+func (h *CompetitionTestHelper) CreateBackupUseCaseWithAttemptLimits() *BackupUseCase {
+	h.t.Helper()
+	uc := h.CreateBackupUseCase()
+	uc.deps.AttemptRepo = h.deps.attemptRepo
+	return uc
+}
+
 func (h *CompetitionTestHelper) SetupBackupExportMocks(comp *entity.Competition, challenges []*repo.ChallengeWithSolved, challengeID uuid.UUID) {
 	h.t.Helper()
 	h.deps.competitionRepo.On("Get", mock.Anything).Return(comp, nil)
 	h.deps.challengeRepo.On("GetAll", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return(challenges, nil)
 	h.deps.hintRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.Hint{}, nil)
+	h.deps.flagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeFlag{}, nil)
 }
 
 func (h *CompetitionTestHelper) NewMinimalBackupData() *entity.BackupData {
@@ -7,8 +7,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/scoring"
 	"github.com/skr1ms/CTFBoard/internal/usecase/competition/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -78,6 +81,221 @@ func TestBackupUseCase_Export_Success(t *testing.T) {
 	assert.Empty(t, data.Challenges)
 }
 
+func TestBackupUseCase_Export_IncludesChallengeFlags(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCase()
+
+	challengeID := uuid.New()
+	comp := h.NewCompetition("CTF", "flexible", true)
+	deps.competitionRepo.On("Get", mock.Anything).Return(comp, nil)
+	deps.challengeRepo.On("GetAll", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return([]*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(challengeID, "Chall", 100)},
+	}, nil)
+	deps.hintRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.Hint{}, nil)
+	deps.flagRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeFlag{
+		{ID: uuid.New(), ChallengeID: challengeID, Type: entity.FlagTypeStatic, FlagHash: "hash"},
+	}, nil)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 1)
+	assert.Len(t, data.Challenges[0].Flags, 1)
+	assert.Equal(t, "hash", data.Challenges[0].Flags[0].FlagHash)
+}
+
+func TestBackupUseCase_Export_IncludesDecoys(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCaseWithDecoys()
+
+	challengeID := uuid.New()
+	h.SetupBackupExportMocks(h.NewCompetition("CTF", "flexible", true), []*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(challengeID, "Chall", 100)},
+	}, challengeID)
+	deps.decoyRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.DecoyFlag{
+		{ID: uuid.New(), ChallengeID: challengeID, FlagHash: "decoy-hash", Note: "pastebin"},
+	}, nil)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 1)
+	assert.Len(t, data.Challenges[0].Decoys, 1)
+	assert.Equal(t, "decoy-hash", data.Challenges[0].Decoys[0].FlagHash)
+}
+
+func TestBackupUseCase_Export_IncludesTeamFlags(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCaseWithTeamFlags()
+
+	teamFlagID, plainID := uuid.New(), uuid.New()
+	deps.competitionRepo.On("Get", mock.Anything).Return(h.NewCompetition("CTF", "flexible", true), nil)
+	deps.challengeRepo.On("GetAll", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return([]*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(teamFlagID, "Team", 100)},
+		{Challenge: h.NewChallenge(plainID, "Plain", 100)},
+	}, nil)
+	deps.hintRepo.On("GetByChallengeID", mock.Anything, mock.Anything).Return([]*entity.Hint{}, nil)
+	deps.flagRepo.On("GetByChallengeID", mock.Anything, mock.Anything).Return([]*entity.ChallengeFlag{}, nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, teamFlagID).Return(&entity.TeamFlagConfig{
+		ChallengeID: teamFlagID, Template: "CTF{a_<hmac8>}", Secret: "encrypted-secret",
+	}, nil)
+	deps.teamFlagRepo.On("GetByChallengeID", mock.Anything, plainID).Return(nil, entityError.ErrTeamFlagNotFound)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 2)
+	assert.Equal(t, &entity.TeamFlagExport{Template: "CTF{a_<hmac8>}", Secret: "encrypted-secret"}, data.Challenges[0].TeamFlag)
+	assert.Nil(t, data.Challenges[1].TeamFlag)
+}
+
+func TestBackupUseCase_Export_IncludesInstanceConfigs(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCaseWithInstances()
+
+	challengeID := uuid.New()
+	h.SetupBackupExportMocks(h.NewCompetition("CTF", "flexible", true), []*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(challengeID, "Chall", 100)},
+	}, challengeID)
+	cfg := &entity.InstanceConfig{ChallengeID: challengeID, Image: "ctf/pwn:latest", TTLSeconds: 1800}
+	deps.instanceRepo.On("GetConfig", mock.Anything, challengeID).Return(cfg, nil)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 1)
+	assert.Equal(t, cfg, data.Challenges[0].Instance)
+}
+
+func TestBackupUseCase_Export_IncludesManualReviews(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCaseWithReviews()
+
+	challengeID := uuid.New()
+	h.SetupBackupExportMocks(h.NewCompetition("CTF", "flexible", true), []*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(challengeID, "Essay", 100)},
+	}, challengeID)
+	deps.reviewRepo.On("GetConfig", mock.Anything, challengeID).Return(&entity.ManualReviewConfig{ChallengeID: challengeID}, nil)
+	review := entity.SubmissionReview{ID: uuid.New(), ChallengeID: challengeID, TeamID: uuid.New(), Answer: "essay", Status: entity.ReviewStatusPending}
+	deps.reviewRepo.On("GetAll", mock.Anything, (*entity.ReviewStatus)(nil), backupReviewPageSize, 0).
+		Return([]*entity.SubmissionReviewWithDetails{{SubmissionReview: review}}, nil)
+	deps.solveRepo.On("GetAll", mock.Anything).Return([]*entity.Solve{}, nil)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{IncludeSolves: true})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 1)
+	assert.True(t, data.Challenges[0].ManualReview)
+	assert.Equal(t, []entity.SubmissionReview{review}, data.Reviews)
+}
+
+func TestBackupUseCase_Export_IncludesStages(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCaseWithStages()
+
+	challengeID := uuid.New()
+	h.SetupBackupExportMocks(h.NewCompetition("CTF", "flexible", true), []*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(challengeID, "Chain", 300)},
+	}, challengeID)
+	stage := entity.ChallengeStage{
+		ID: uuid.New(), ChallengeID: challengeID, Title: "leak", Points: 50,
+		Flags: []*entity.ChallengeFlag{{ID: uuid.New(), ChallengeID: challengeID, Type: entity.FlagTypeStatic, FlagHash: "hash"}},
+	}
+	deps.stageRepo.On("GetByChallengeID", mock.Anything, challengeID).Return([]*entity.ChallengeStage{&stage}, nil)
+	solve := entity.StageSolve{ID: uuid.New(), StageID: stage.ID, TeamID: uuid.New(), UserID: uuid.New()}
+	deps.stageRepo.On("GetAllSolves", mock.Anything).Return([]*entity.StageSolve{&solve}, nil)
+	deps.solveRepo.On("GetAll", mock.Anything).Return([]*entity.Solve{}, nil)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{IncludeSolves: true})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 1)
+	assert.Equal(t, []entity.ChallengeStage{stage}, data.Challenges[0].Stages)
+	assert.Equal(t, []entity.StageSolve{solve}, data.StageSolves)
+}
+
+func TestBackupUseCase_Export_IncludesScoring(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCaseWithScoring()
+
+	scoredID, defaultID := uuid.New(), uuid.New()
+	deps.competitionRepo.On("Get", mock.Anything).Return(h.NewCompetition("CTF", "flexible", true), nil)
+	deps.challengeRepo.On("GetAll", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return([]*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(scoredID, "Scored", 500)},
+		{Challenge: h.NewChallenge(defaultID, "Default", 100)},
+	}, nil)
+	deps.hintRepo.On("GetByChallengeID", mock.Anything, mock.Anything).Return([]*entity.Hint{}, nil)
+	deps.flagRepo.On("GetByChallengeID", mock.Anything, mock.Anything).Return([]*entity.ChallengeFlag{}, nil)
+	s := &entity.ChallengeScoring{ChallengeID: scoredID, Function: scoring.Time, Params: map[string]float64{"initial": 500, "minimum": 100}}
+	deps.scoringRepo.On("GetByChallengeID", mock.Anything, scoredID).Return(s, nil)
+	deps.scoringRepo.On("GetByChallengeID", mock.Anything, defaultID).Return(nil, nil)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 2)
+	assert.Equal(t, s, data.Challenges[0].Scoring)
+	assert.Nil(t, data.Challenges[1].Scoring)
+}
+
+func TestBackupUseCase_Export_IncludesAttemptLimits(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCaseWithAttemptLimits()
+
+	challengeID := uuid.New()
+	h.SetupBackupExportMocks(h.NewCompetition("CTF", "flexible", true), []*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(challengeID, "Limited", 100)},
+	}, challengeID)
+	maxAttempts := 3
+	limit := &entity.AttemptLimit{ChallengeID: challengeID, MaxAttempts: &maxAttempts, WrongPenalty: 10}
+	deps.attemptRepo.On("GetAll", mock.Anything).Return([]*entity.AttemptLimit{limit}, nil)
+	reset := entity.AttemptReset{ChallengeID: challengeID, TeamID: uuid.New(), ResetAt: time.Now()}
+	deps.attemptRepo.On("GetAllResets", mock.Anything).Return([]*entity.AttemptReset{&reset}, nil)
+	deps.solveRepo.On("GetAll", mock.Anything).Return([]*entity.Solve{}, nil)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{IncludeSolves: true})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 1)
+	assert.Equal(t, limit, data.Challenges[0].AttemptLimit)
+	assert.Equal(t, []entity.AttemptReset{reset}, data.AttemptResets)
+}
+
+func TestBackupUseCase_Export_IncludesUnreleasedChallenges(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateBackupUseCaseWithSchedules()
+
+	liveID, laterID := uuid.New(), uuid.New()
+	releaseAt := time.Now().Add(time.Hour)
+	schedule := &entity.ChallengeSchedule{ChallengeID: laterID, ReleaseAt: &releaseAt}
+	comp := h.NewCompetition("CTF", "flexible", true)
+	deps.competitionRepo.On("Get", mock.Anything).Return(comp, nil)
+	deps.challengeRepo.On("GetAll", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return([]*repo.ChallengeWithSolved{
+		{Challenge: h.NewChallenge(liveID, "Live", 100)},
+	}, nil)
+	deps.scheduleRepo.On("GetAll", mock.Anything).Return([]*repo.ScheduledChallenge{{Schedule: schedule, Title: "Later"}}, nil)
+	deps.challengeRepo.On("GetByID", mock.Anything, laterID).Return(h.NewChallenge(laterID, "Later", 200), nil)
+	deps.hintRepo.On("GetByChallengeID", mock.Anything, mock.Anything).Return([]*entity.Hint{}, nil)
+	deps.flagRepo.On("GetByChallengeID", mock.Anything, mock.Anything).Return([]*entity.ChallengeFlag{}, nil)
+
+	data, err := uc.Export(context.Background(), entity.ExportOptions{})
+
+	assert.NoError(t, err)
+	assert.Len(t, data.Challenges, 2)
+	assert.Nil(t, data.Challenges[0].Schedule)
+	assert.Equal(t, laterID, data.Challenges[1].ID)
+	assert.Equal(t, schedule, data.Challenges[1].Schedule)
+}
+
 func TestBackupUseCase_Export_CompetitionRepoError(t *testing.T) {
 	compRepo := mocks.NewMockCompetitionRepository(t)
 	challRepo := mocks.NewMockChallengeRepository(t)
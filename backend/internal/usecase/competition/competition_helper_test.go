@@ -29,6 +29,14 @@ type competitionTestDeps struct {
 	statsRepo       *mocks.MockStatisticsRepository
 	appSettingsRepo *mocks.MockAppSettingsRepository
 	hintRepo        *challengeMocks.MockHintRepository
+	flagRepo        *challengeMocks.MockChallengeFlagRepository
+	scheduleRepo    *challengeMocks.MockChallengeScheduleRepository
+	teamFlagRepo    *challengeMocks.MockTeamFlagRepository
+	instanceRepo    *challengeMocks.MockInstanceRepository
+	reviewRepo      *challengeMocks.MockReviewRepository
+	stageRepo       *challengeMocks.MockChallengeStageRepository
+	scoringRepo     *challengeMocks.MockChallengeScoringRepository
+	attemptRepo     *challengeMocks.MockAttemptLimitRepository
 	teamRepo        *teamMocks.MockTeamRepository
 	awardRepo       *teamMocks.MockAwardRepository
 	backupRepo      *mocks.MockBackupRepository
@@ -37,6 +45,11 @@ type competitionTestDeps struct {
 	configRepo      *mocks.MockConfigRepository
 	ratingRepo      *mocks.MockRatingRepository
 	submissionRepo  *mocks.MockSubmissionRepository
+	cheatRepo       *mocks.MockCheatIncidentRepository
+	decoyRepo       *mocks.MockDecoyFlagRepository
+	rescoreRepo     *mocks.MockRescoreRepository
+	analyticsRepo   *mocks.MockCheatAnalyticsRepository
+	teamBanner      *mocks.MockTeamBanner
 }
 
 func NewCompetitionTestHelper(t *testing.T) *CompetitionTestHelper {
@@ -60,6 +73,14 @@ func NewCompetitionTestHelper(t *testing.T) *CompetitionTestHelper {
 			statsRepo:       mocks.NewMockStatisticsRepository(t),
 			appSettingsRepo: mocks.NewMockAppSettingsRepository(t),
 			hintRepo:        challengeMocks.NewMockHintRepository(t),
+			flagRepo:        challengeMocks.NewMockChallengeFlagRepository(t),
+			scheduleRepo:    challengeMocks.NewMockChallengeScheduleRepository(t),
+			teamFlagRepo:    challengeMocks.NewMockTeamFlagRepository(t),
+			instanceRepo:    challengeMocks.NewMockInstanceRepository(t),
+			reviewRepo:      challengeMocks.NewMockReviewRepository(t),
+			stageRepo:       challengeMocks.NewMockChallengeStageRepository(t),
+			scoringRepo:     challengeMocks.NewMockChallengeScoringRepository(t),
+			attemptRepo:     challengeMocks.NewMockAttemptLimitRepository(t),
 			teamRepo:        teamMocks.NewMockTeamRepository(t),
 			awardRepo:       teamMocks.NewMockAwardRepository(t),
 			backupRepo:      mocks.NewMockBackupRepository(t),
@@ -68,6 +89,11 @@ func NewCompetitionTestHelper(t *testing.T) *CompetitionTestHelper {
 			configRepo:      mocks.NewMockConfigRepository(t),
 			ratingRepo:      mocks.NewMockRatingRepository(t),
 			submissionRepo:  mocks.NewMockSubmissionRepository(t),
+			cheatRepo:       mocks.NewMockCheatIncidentRepository(t),
+			decoyRepo:       mocks.NewMockDecoyFlagRepository(t),
+			rescoreRepo:     mocks.NewMockRescoreRepository(t),
+			analyticsRepo:   mocks.NewMockCheatAnalyticsRepository(t),
+			teamBanner:      mocks.NewMockTeamBanner(t),
 		},
 	}
 }
@@ -370,6 +370,146 @@ func (_c *MockSubmissionRepository_CountFailedByIP_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CountFailedByTeam provides a mock function for the type MockSubmissionRepository
+func (_mock *MockSubmissionRepository) CountFailedByTeam(ctx context.Context, teamID uuid.UUID) (map[uuid.UUID]int, error) {
+	ret := _mock.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFailedByTeam")
+	}
+
+	var r0 map[uuid.UUID]int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (map[uuid.UUID]int, error)); ok {
+		return returnFunc(ctx, teamID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) map[uuid.UUID]int); ok {
+		r0 = returnFunc(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubmissionRepository_CountFailedByTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFailedByTeam'
+type MockSubmissionRepository_CountFailedByTeam_Call struct {
+	*mock.Call
+}
+
+// CountFailedByTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+func (_e *MockSubmissionRepository_Expecter) CountFailedByTeam(ctx interface{}, teamID interface{}) *MockSubmissionRepository_CountFailedByTeam_Call {
+	return &MockSubmissionRepository_CountFailedByTeam_Call{Call: _e.mock.On("CountFailedByTeam", ctx, teamID)}
+}
+
+func (_c *MockSubmissionRepository_CountFailedByTeam_Call) Run(run func(ctx context.Context, teamID uuid.UUID)) *MockSubmissionRepository_CountFailedByTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubmissionRepository_CountFailedByTeam_Call) Return(uUIDToN map[uuid.UUID]int, err error) *MockSubmissionRepository_CountFailedByTeam_Call {
+	_c.Call.Return(uUIDToN, err)
+	return _c
+}
+
+func (_c *MockSubmissionRepository_CountFailedByTeam_Call) RunAndReturn(run func(ctx context.Context, teamID uuid.UUID) (map[uuid.UUID]int, error)) *MockSubmissionRepository_CountFailedByTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFailedByTeamAndChallenge provides a mock function for the type MockSubmissionRepository
+func (_mock *MockSubmissionRepository) CountFailedByTeamAndChallenge(ctx context.Context, teamID uuid.UUID, challengeID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, teamID, challengeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFailedByTeamAndChallenge")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, teamID, challengeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, teamID, challengeID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, teamID, challengeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubmissionRepository_CountFailedByTeamAndChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFailedByTeamAndChallenge'
+type MockSubmissionRepository_CountFailedByTeamAndChallenge_Call struct {
+	*mock.Call
+}
+
+// CountFailedByTeamAndChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID uuid.UUID
+//   - challengeID uuid.UUID
+func (_e *MockSubmissionRepository_Expecter) CountFailedByTeamAndChallenge(ctx interface{}, teamID interface{}, challengeID interface{}) *MockSubmissionRepository_CountFailedByTeamAndChallenge_Call {
+	return &MockSubmissionRepository_CountFailedByTeamAndChallenge_Call{Call: _e.mock.On("CountFailedByTeamAndChallenge", ctx, teamID, challengeID)}
+}
+
+func (_c *MockSubmissionRepository_CountFailedByTeamAndChallenge_Call) Run(run func(ctx context.Context, teamID uuid.UUID, challengeID uuid.UUID)) *MockSubmissionRepository_CountFailedByTeamAndChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubmissionRepository_CountFailedByTeamAndChallenge_Call) Return(n int64, err error) *MockSubmissionRepository_CountFailedByTeamAndChallenge_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSubmissionRepository_CountFailedByTeamAndChallenge_Call) RunAndReturn(run func(ctx context.Context, teamID uuid.UUID, challengeID uuid.UUID) (int64, error)) *MockSubmissionRepository_CountFailedByTeamAndChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockSubmissionRepository
 func (_mock *MockSubmissionRepository) Create(ctx context.Context, sub *entity.Submission) error {
 	ret := _mock.Called(ctx, sub)
@@ -581,6 +721,74 @@ func (_c *MockSubmissionRepository_GetByChallenge_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetByIDs provides a mock function for the type MockSubmissionRepository
+func (_mock *MockSubmissionRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.SubmissionWithDetails, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*entity.SubmissionWithDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*entity.SubmissionWithDetails, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*entity.SubmissionWithDetails); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SubmissionWithDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubmissionRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockSubmissionRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *MockSubmissionRepository_Expecter) GetByIDs(ctx interface{}, ids interface{}) *MockSubmissionRepository_GetByIDs_Call {
+	return &MockSubmissionRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids)}
+}
+
+func (_c *MockSubmissionRepository_GetByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockSubmissionRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubmissionRepository_GetByIDs_Call) Return(submissionWithDetailss []*entity.SubmissionWithDetails, err error) *MockSubmissionRepository_GetByIDs_Call {
+	_c.Call.Return(submissionWithDetailss, err)
+	return _c
+}
+
+func (_c *MockSubmissionRepository_GetByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID) ([]*entity.SubmissionWithDetails, error)) *MockSubmissionRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByTeam provides a mock function for the type MockSubmissionRepository
 func (_mock *MockSubmissionRepository) GetByTeam(ctx context.Context, teamID uuid.UUID, limit int, offset int) ([]*entity.SubmissionWithDetails, error) {
 	ret := _mock.Called(ctx, teamID, limit, offset)
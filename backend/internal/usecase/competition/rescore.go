@@ -0,0 +1,123 @@
+package competition
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// CheckConsistency recounts the solves of every challenge and reports those whose stored solve
+// count or value disagree with the count. Nothing is written.
+func (uc *SolveUseCase) CheckConsistency(ctx context.Context, opts entity.RescoreOptions) (*entity.RescoreReport, error) {
+	tallies, err := uc.deps.RescoreRepo.ListSolveTallies(ctx, opts)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "SolveUseCase - CheckConsistency - ListSolveTallies")
+	}
+	report := &entity.RescoreReport{Options: opts, Checked: len(tallies), Drifts: []*entity.ScoreDrift{}}
+	for _, t := range tallies {
+		drift, err := uc.scoreDrift(ctx, t.Challenge, t.Solves)
+		if err != nil {
+			return nil, usecaseutil.Wrap(err, "SolveUseCase - CheckConsistency")
+		}
+		if drift != nil {
+			report.Drifts = append(report.Drifts, drift)
+		}
+	}
+	return report, nil
+}
+
+// Rescore recounts every challenge like CheckConsistency and writes the recounted solve count
+// and value back in a single transaction. Each challenge row is locked while it is recounted so
+// concurrent solves cannot slip in between. actorID is nil when run from the command line.
+func (uc *SolveUseCase) Rescore(ctx context.Context, opts entity.RescoreOptions, actorID *uuid.UUID, clientIP string) (*entity.RescoreReport, error) {
+	tallies, err := uc.deps.RescoreRepo.ListSolveTallies(ctx, opts)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "SolveUseCase - Rescore - ListSolveTallies")
+	}
+	report := &entity.RescoreReport{Options: opts, Drifts: []*entity.ScoreDrift{}, Fixed: true}
+	err = uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		for _, t := range tallies {
+			c, err := uc.deps.TxRepo.GetChallengeByIDTx(ctx, tx, t.Challenge.ID)
+			if errors.Is(err, entityError.ErrChallengeNotFound) {
+				continue
+			}
+			if err != nil {
+				return usecaseutil.Wrap(err, "GetChallengeByIDTx")
+			}
+			report.Checked++
+			solves, err := uc.deps.RescoreRepo.CountSolvesTx(ctx, tx, c.ID, opts)
+			if err != nil {
+				return usecaseutil.Wrap(err, "CountSolvesTx")
+			}
+			drift, err := uc.scoreDrift(ctx, c, solves)
+			if err != nil {
+				return err
+			}
+			if drift == nil {
+				continue
+			}
+			if err := uc.deps.RescoreRepo.UpdateChallengeScoreTx(ctx, tx, c.ID, drift.SolveCount, drift.Points); err != nil {
+				return usecaseutil.Wrap(err, "UpdateChallengeScoreTx")
+			}
+			report.Drifts = append(report.Drifts, drift)
+		}
+		if len(report.Drifts) == 0 {
+			return nil
+		}
+		return uc.auditRescoreTx(ctx, tx, report, actorID, clientIP)
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "SolveUseCase - Rescore - Transaction")
+	}
+	if len(report.Drifts) > 0 && uc.deps.ScoreboardCache != nil {
+		uc.deps.ScoreboardCache.InvalidateAll(ctx)
+	}
+	return report, nil
+}
+
+// scoreDrift returns how c drifted from its solves solves, or nil when it did not.
+func (uc *SolveUseCase) scoreDrift(ctx context.Context, c *entity.Challenge, solves int) (*entity.ScoreDrift, error) {
+	points, err := ChallengeValue(ctx, uc.deps.ScoringRepo, uc.deps.CompetitionRepo, c, solves)
+	if err != nil {
+		return nil, err
+	}
+	if c.SolveCount == solves && c.Points == points {
+		return nil, nil
+	}
+	return &entity.ScoreDrift{
+		ChallengeID:      c.ID,
+		Title:            c.Title,
+		StoredSolveCount: c.SolveCount,
+		SolveCount:       solves,
+		StoredPoints:     c.Points,
+		Points:           points,
+	}, nil
+}
+
+func (uc *SolveUseCase) auditRescoreTx(ctx context.Context, tx repo.Transaction, report *entity.RescoreReport, actorID *uuid.UUID, clientIP string) error {
+	challengeIDs := make([]string, 0, len(report.Drifts))
+	for _, d := range report.Drifts {
+		challengeIDs = append(challengeIDs, d.ChallengeID.String())
+	}
+	auditLog := &entity.AuditLog{
+		UserID:     actorID,
+		Action:     entity.AuditActionRescore,
+		EntityType: entity.AuditEntityCompetition,
+		EntityID:   "scoring",
+		IP:         clientIP,
+		Details: map[string]any{
+			"exclude_banned": report.Options.ExcludeBanned,
+			"exclude_hidden": report.Options.ExcludeHidden,
+			"challenge_ids":  challengeIDs,
+		},
+	}
+	if err := uc.deps.TxRepo.CreateAuditLogTx(ctx, tx, auditLog); err != nil {
+		return usecaseutil.Wrap(err, "CreateAuditLogTx")
+	}
+	return nil
+}
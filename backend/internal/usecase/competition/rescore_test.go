@@ -0,0 +1,142 @@
+package competition
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolveUseCase_CheckConsistency_ReportsDrift(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateSolveUseCase()
+
+	opts := entity.RescoreOptions{ExcludeBanned: true}
+	static := h.NewChallenge(uuid.New(), "Static", 100)
+	static.SolveCount = 3
+	dynamic := h.NewDynamicChallenge("Dynamic", 2, 500)
+	consistent := h.NewDynamicChallenge("Consistent", 1, CalculateDynamicScore(500, 100, 10, 1))
+
+	deps.rescoreRepo.On("ListSolveTallies", mock.Anything, opts).Return([]*repo.ChallengeSolveTally{
+		{Challenge: static, Solves: 2},
+		{Challenge: dynamic, Solves: 2},
+		{Challenge: consistent, Solves: 1},
+	}, nil)
+
+	report, err := uc.CheckConsistency(context.Background(), opts)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Checked)
+	assert.False(t, report.Fixed)
+	assert.Equal(t, opts, report.Options)
+	require.Len(t, report.Drifts, 2)
+	assert.Equal(t, &entity.ScoreDrift{
+		ChallengeID: static.ID, Title: "Static", StoredSolveCount: 3, SolveCount: 2, StoredPoints: 100, Points: 100,
+	}, report.Drifts[0])
+	assert.Equal(t, dynamic.ID, report.Drifts[1].ChallengeID)
+	assert.Equal(t, 2, report.Drifts[1].SolveCount)
+	assert.Equal(t, CalculateDynamicScore(500, 100, 10, 2), report.Drifts[1].Points)
+}
+
+func TestSolveUseCase_CheckConsistency_Error(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateSolveUseCase()
+
+	deps.rescoreRepo.On("ListSolveTallies", mock.Anything, entity.RescoreOptions{}).Return(nil, errors.New("db error"))
+
+	_, err := uc.CheckConsistency(context.Background(), entity.RescoreOptions{})
+
+	assert.Error(t, err)
+}
+
+func TestSolveUseCase_Rescore_FixesDrift(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateSolveUseCase()
+
+	opts := entity.RescoreOptions{ExcludeHidden: true}
+	drifted := h.NewDynamicChallenge("Drifted", 4, CalculateDynamicScore(500, 100, 10, 4))
+	consistent := h.NewChallenge(uuid.New(), "Consistent", 100)
+	deleted := h.NewChallenge(uuid.New(), "Deleted", 100)
+	actorID := uuid.New()
+	wantPoints := CalculateDynamicScore(500, 100, 10, 1)
+
+	deps.rescoreRepo.On("ListSolveTallies", mock.Anything, opts).Return([]*repo.ChallengeSolveTally{
+		{Challenge: consistent, Solves: 0},
+		{Challenge: deleted, Solves: 0},
+		{Challenge: drifted, Solves: 4},
+	}, nil)
+	h.ExpectSolveTransaction(nil)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, consistent.ID).Return(consistent, nil)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, deleted.ID).Return(nil, entityError.ErrChallengeNotFound)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, drifted.ID).Return(drifted, nil)
+	deps.rescoreRepo.On("CountSolvesTx", mock.Anything, mock.Anything, consistent.ID, opts).Return(0, nil)
+	deps.rescoreRepo.On("CountSolvesTx", mock.Anything, mock.Anything, drifted.ID, opts).Return(1, nil)
+	deps.rescoreRepo.On("UpdateChallengeScoreTx", mock.Anything, mock.Anything, drifted.ID, 1, wantPoints).Return(nil)
+	deps.txRepo.On("CreateAuditLogTx", mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionRescore && *l.UserID == actorID &&
+			assert.ObjectsAreEqual([]string{drifted.ID.String()}, l.Details["challenge_ids"])
+	})).Return(nil)
+
+	report, err := uc.Rescore(context.Background(), opts, &actorID, "127.0.0.1")
+
+	require.NoError(t, err)
+	assert.True(t, report.Fixed)
+	assert.Equal(t, 2, report.Checked)
+	require.Len(t, report.Drifts, 1)
+	assert.Equal(t, 4, report.Drifts[0].StoredSolveCount)
+	assert.Equal(t, 1, report.Drifts[0].SolveCount)
+	assert.Equal(t, wantPoints, report.Drifts[0].Points)
+}
+
+func TestSolveUseCase_Rescore_NothingToFix(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateSolveUseCase()
+
+	consistent := h.NewChallenge(uuid.New(), "Consistent", 100)
+
+	deps.rescoreRepo.On("ListSolveTallies", mock.Anything, entity.RescoreOptions{}).Return([]*repo.ChallengeSolveTally{
+		{Challenge: consistent, Solves: 0},
+	}, nil)
+	h.ExpectSolveTransaction(nil)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, consistent.ID).Return(consistent, nil)
+	deps.rescoreRepo.On("CountSolvesTx", mock.Anything, mock.Anything, consistent.ID, entity.RescoreOptions{}).Return(0, nil)
+
+	report, err := uc.Rescore(context.Background(), entity.RescoreOptions{}, nil, "")
+
+	require.NoError(t, err)
+	assert.Empty(t, report.Drifts)
+	deps.rescoreRepo.AssertNotCalled(t, "UpdateChallengeScoreTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	deps.txRepo.AssertNotCalled(t, "CreateAuditLogTx", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSolveUseCase_Rescore_TransactionError(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateSolveUseCase()
+
+	drifted := h.NewChallenge(uuid.New(), "Drifted", 100)
+	drifted.SolveCount = 2
+
+	deps.rescoreRepo.On("ListSolveTallies", mock.Anything, entity.RescoreOptions{}).Return([]*repo.ChallengeSolveTally{
+		{Challenge: drifted, Solves: 1},
+	}, nil)
+	h.ExpectSolveTransaction(errors.New("update failed"))
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, drifted.ID).Return(drifted, nil)
+	deps.rescoreRepo.On("CountSolvesTx", mock.Anything, mock.Anything, drifted.ID, entity.RescoreOptions{}).Return(1, nil)
+	deps.rescoreRepo.On("UpdateChallengeScoreTx", mock.Anything, mock.Anything, drifted.ID, 1, 100).Return(errors.New("update failed"))
+
+	_, err := uc.Rescore(context.Background(), entity.RescoreOptions{}, nil, "")
+
+	assert.Error(t, err)
+}
@@ -1,33 +1,142 @@
 package competition
 
-import "math"
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/scoring"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
 
 func CalculateDynamicScore(initial, minScore, decay, solves int) int {
 	if solves <= 0 {
 		return initial
 	}
-	// First solver gets max points. Decay starts from 2nd solve.
-	// solve_count for formula = solves - 1
-	solveCount := float64(solves - 1)
+	// Decay 0 means the value drops to the minimum right after the first solve.
+	return ScoreChallenge(&entity.ChallengeScoring{
+		Function: scoring.Quadratic,
+		Params:   map[string]float64{"initial": float64(initial), "minimum": float64(minScore), "decay": float64(max(decay, 1))},
+	}, solves, 0)
+}
+
+// DefaultScoring is how a challenge without a scoring function is valued: by the quadratic
+// decay when it has one and at its current points otherwise.
+func DefaultScoring(c *entity.Challenge) *entity.ChallengeScoring {
+	if c.InitialValue > 0 && c.Decay > 0 {
+		return &entity.ChallengeScoring{
+			ChallengeID: c.ID,
+			Function:    scoring.Quadratic,
+			Params:      map[string]float64{"initial": float64(c.InitialValue), "minimum": float64(c.MinValue), "decay": float64(c.Decay)},
+		}
+	}
+	return &entity.ChallengeScoring{
+		ChallengeID: c.ID,
+		Function:    scoring.Static,
+		Params:      map[string]float64{"initial": float64(c.Points)},
+	}
+}
+
+// ScoreChallenge returns what a challenge scored by s is worth after solves solves, elapsed
+// being the share of the event that has passed. An unknown function keeps the initial value.
+func ScoreChallenge(s *entity.ChallengeScoring, solves int, elapsed float64) int {
+	f, err := scoring.Lookup(s.Function)
+	if err != nil {
+		return int(s.Params["initial"])
+	}
+	return f.Value(s.Params, scoring.Input{Solves: solves, Elapsed: elapsed})
+}
+
+// ValidateScoring checks the function name and parameters of s.
+func ValidateScoring(s *entity.ChallengeScoring) error {
+	f, err := scoring.Lookup(s.Function)
+	if err != nil {
+		return entityError.ErrUnknownScoringFunction
+	}
+	if err := f.Validate(s.Params); err != nil {
+		return entityError.InvalidScoringParams(err)
+	}
+	return nil
+}
+
+// EventElapsed returns the share of the event that has passed at now. Events without both a
+// start and an end never progress.
+func EventElapsed(comp *entity.Competition, now time.Time) float64 {
+	if comp == nil || comp.StartTime == nil || comp.EndTime == nil || !comp.EndTime.After(*comp.StartTime) {
+		return 0
+	}
+	elapsed := float64(now.Sub(*comp.StartTime)) / float64(comp.EndTime.Sub(*comp.StartTime))
+	return min(max(elapsed, 0), 1)
+}
 
-	if decay == 0 {
-		decay = 1
+// ScoringElapsed returns the share of the event that has passed when s is time-based and 0
+// otherwise, so the competition is only read when it matters.
+func ScoringElapsed(ctx context.Context, compRepo repo.CompetitionRepository, s *entity.ChallengeScoring, now time.Time) (float64, error) {
+	f, err := scoring.Lookup(s.Function)
+	if err != nil || !f.TimeBased || compRepo == nil {
+		return 0, nil
 	}
+	comp, err := compRepo.Get(ctx)
+	if err != nil && !errors.Is(err, entityError.ErrCompetitionNotFound) {
+		return 0, usecaseutil.Wrap(err, "ScoringElapsed - GetCompetition")
+	}
+	return EventElapsed(comp, now), nil
+}
 
-	// Formula: Initial + ((Min - Initial) / (Decay^2)) * (SolveCount^2)
-	// Value drops quadratically until 'decay' solves is reached.
+// ChallengeValue returns what c is worth after solves solves: by its scoring function when
+// scoringRepo has one for it and by DefaultScoring otherwise. scoringRepo may be nil.
+func ChallengeValue(ctx context.Context, scoringRepo repo.ChallengeScoringRepository, compRepo repo.CompetitionRepository, c *entity.Challenge, solves int) (int, error) {
+	s, err := challengeScoring(ctx, scoringRepo, c)
+	if err != nil {
+		return 0, usecaseutil.Wrap(err, "ChallengeValue - GetScoring")
+	}
+	elapsed, err := ScoringElapsed(ctx, compRepo, s, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	return ScoreChallenge(s, solves, elapsed), nil
+}
 
-	// If solves >= decay, value is minimum (to avoid values going below min or back up if parabola)
-	if solves > decay {
-		return minScore
+// SolveValue returns the points to pin on the solves-th solve of c, made at solvedAt. Only
+// time-based functions are pinned, so a team keeps what the challenge was worth when it
+// solved; for the others it returns nil and the solve follows the challenge's points.
+func SolveValue(ctx context.Context, scoringRepo repo.ChallengeScoringRepository, compRepo repo.CompetitionRepository, c *entity.Challenge, solves int, solvedAt time.Time) (*int, error) {
+	s, err := challengeScoring(ctx, scoringRepo, c)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "SolveValue - GetScoring")
 	}
+	if f, err := scoring.Lookup(s.Function); err != nil || !f.TimeBased {
+		return nil, nil
+	}
+	elapsed, err := ScoringElapsed(ctx, compRepo, s, solvedAt)
+	if err != nil {
+		return nil, err
+	}
+	value := ScoreChallenge(s, solves, elapsed)
+	return &value, nil
+}
 
-	decayFloat := float64(decay)
-	value := float64(initial) + ((float64(minScore)-float64(initial))/(decayFloat*decayFloat))*(solveCount*solveCount)
+// SolvePoints returns what solve of c counts on the scoreboard.
+func SolvePoints(solve *entity.Solve, c *entity.Challenge) int {
+	if solve.Points != nil {
+		return *solve.Points
+	}
+	return c.Points
+}
 
-	score := int(math.Ceil(value))
-	if score < minScore {
-		return minScore
+func challengeScoring(ctx context.Context, scoringRepo repo.ChallengeScoringRepository, c *entity.Challenge) (*entity.ChallengeScoring, error) {
+	if scoringRepo == nil {
+		return DefaultScoring(c), nil
+	}
+	custom, err := scoringRepo.GetByChallengeID(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	if custom == nil {
+		return DefaultScoring(c), nil
 	}
-	return score
+	return custom, nil
 }
@@ -21,6 +21,8 @@ type SolveDeps struct {
 	UserRepo        repo.UserRepository
 	TeamRepo        repo.TeamRepository
 	TxRepo          repo.TxRepository
+	ScoringRepo     repo.ChallengeScoringRepository
+	RescoreRepo     repo.RescoreRepository
 	Cache           *cache.Cache
 	ScoreboardCache cache.ScoreboardCacheInvalidator
 	Broadcaster     websocket.SolveBroadcaster
@@ -90,6 +92,14 @@ func (uc *SolveUseCase) solveCreateUpsertInTx(ctx context.Context, tx repo.Trans
 		return nil, false, entityError.ErrAlreadySolved
 	}
 	isFirstBlood := challenge.SolveCount == 0
+	solvedAt := solve.SolvedAt
+	if solvedAt.IsZero() {
+		solvedAt = time.Now()
+	}
+	solve.Points, err = SolveValue(ctx, uc.deps.ScoringRepo, uc.deps.CompetitionRepo, challenge, challenge.SolveCount+1, solvedAt)
+	if err != nil {
+		return nil, false, usecaseutil.Wrap(err, "SolveUseCase - Create")
+	}
 	if err := uc.deps.TxRepo.CreateSolveTx(ctx, tx, solve); err != nil {
 		return nil, false, usecaseutil.Wrap(err, "SolveUseCase - Create - CreateSolveTx")
 	}
@@ -97,14 +107,8 @@ func (uc *SolveUseCase) solveCreateUpsertInTx(ctx context.Context, tx repo.Trans
 	if err != nil {
 		return nil, false, usecaseutil.Wrap(err, "SolveUseCase - Create - IncrementChallengeSolveCountTx")
 	}
-	if challenge.Decay > 0 {
-		newPoints := CalculateDynamicScore(challenge.InitialValue, challenge.MinValue, challenge.Decay, newCount)
-		if newPoints != challenge.Points {
-			if err := uc.deps.TxRepo.UpdateChallengePointsTx(ctx, tx, challenge.ID, newPoints); err != nil {
-				return nil, false, usecaseutil.Wrap(err, "SolveUseCase - Create - UpdateChallengePointsTx")
-			}
-			challenge.Points = newPoints
-		}
+	if err := uc.updateChallengeValueTx(ctx, tx, challenge, newCount); err != nil {
+		return nil, false, usecaseutil.Wrap(err, "SolveUseCase - Create")
 	}
 	return challenge, isFirstBlood, nil
 }
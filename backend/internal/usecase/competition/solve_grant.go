@@ -0,0 +1,163 @@
+package competition
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// Grant credits the team of solve with a solve of its challenge on behalf of an admin. The
+// solver defaults to the team captain and must belong to the team; a zero SolvedAt means now,
+// anything earlier backdates the solve and may take first blood.
+func (uc *SolveUseCase) Grant(ctx context.Context, solve *entity.Solve, actorID uuid.UUID, clientIP string) (bool, error) {
+	var challenge *entity.Challenge
+	err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.grantResolveSolver(ctx, tx, solve); err != nil {
+			return err
+		}
+		c, _, err := uc.solveCreateUpsertInTx(ctx, tx, solve)
+		if err != nil {
+			return err
+		}
+		challenge = c
+		return uc.auditSolveTx(ctx, tx, entity.AuditActionGrantSolve, solve, SolvePoints(solve, challenge), actorID, clientIP)
+	})
+	if err != nil {
+		return false, usecaseutil.Wrap(err, "SolveUseCase - Grant - Transaction")
+	}
+
+	isFirstBlood, err := uc.holdsFirstBlood(ctx, solve.ChallengeID, solve.TeamID)
+	if err != nil {
+		return false, usecaseutil.Wrap(err, "SolveUseCase - Grant")
+	}
+	uc.invalidateScoreboardCache(ctx, solve.TeamID)
+	if uc.deps.Broadcaster != nil {
+		uc.deps.Broadcaster.NotifySolve(solve.TeamID, challenge.Title, SolvePoints(solve, challenge), isFirstBlood)
+	}
+	return isFirstBlood, nil
+}
+
+// Revoke removes the solve of challengeID by teamID, lowers the solve count and lets the
+// challenge value recover accordingly. Stage credit of the team is not touched. When the solve
+// held first blood, it passes to the next solver.
+func (uc *SolveUseCase) Revoke(ctx context.Context, challengeID, teamID, actorID uuid.UUID, clientIP string) error {
+	wasFirstBlood, err := uc.holdsFirstBlood(ctx, challengeID, teamID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "SolveUseCase - Revoke")
+	}
+
+	var challenge *entity.Challenge
+	err = uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		c, err := uc.deps.TxRepo.GetChallengeByIDTx(ctx, tx, challengeID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetChallengeByIDTx")
+		}
+		solve, err := uc.deps.TxRepo.GetSolveByTeamAndChallengeTx(ctx, tx, teamID, challengeID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetSolveByTeamAndChallengeTx")
+		}
+		if err := uc.deps.TxRepo.DeleteSolveTx(ctx, tx, solve.ID); err != nil {
+			return usecaseutil.Wrap(err, "DeleteSolveTx")
+		}
+		newCount, err := uc.deps.TxRepo.DecrementChallengeSolveCountTx(ctx, tx, challengeID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "DecrementChallengeSolveCountTx")
+		}
+		if err := uc.updateChallengeValueTx(ctx, tx, c, newCount); err != nil {
+			return err
+		}
+		challenge = c
+		return uc.auditSolveTx(ctx, tx, entity.AuditActionRevokeSolve, solve, c.Points, actorID, clientIP)
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "SolveUseCase - Revoke - Transaction")
+	}
+
+	var newFirstBlood *uuid.UUID
+	if wasFirstBlood {
+		entry, err := uc.deps.SolveRepo.GetFirstBlood(ctx, challengeID)
+		if err != nil && !errors.Is(err, entityError.ErrSolveNotFound) {
+			return usecaseutil.Wrap(err, "SolveUseCase - Revoke - GetFirstBlood")
+		}
+		if entry != nil {
+			newFirstBlood = &entry.TeamID
+		}
+	}
+	uc.invalidateScoreboardCache(ctx, teamID)
+	if uc.deps.Broadcaster != nil {
+		uc.deps.Broadcaster.NotifySolveRevoked(teamID, challenge.Title, challenge.Points, newFirstBlood)
+	}
+	return nil
+}
+
+func (uc *SolveUseCase) grantResolveSolver(ctx context.Context, tx repo.Transaction, solve *entity.Solve) error {
+	team, err := uc.deps.TxRepo.GetTeamByIDTx(ctx, tx, solve.TeamID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetTeamByIDTx")
+	}
+	if solve.UserID == uuid.Nil {
+		solve.UserID = team.CaptainID
+		return nil
+	}
+	user, err := uc.deps.UserRepo.GetByID(ctx, solve.UserID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetUser")
+	}
+	if user.TeamID == nil || *user.TeamID != solve.TeamID {
+		return entityError.ErrSolverNotInTeam
+	}
+	return nil
+}
+
+// updateChallengeValueTx stores what challenge is worth after solves solves.
+func (uc *SolveUseCase) updateChallengeValueTx(ctx context.Context, tx repo.Transaction, challenge *entity.Challenge, solves int) error {
+	newPoints, err := ChallengeValue(ctx, uc.deps.ScoringRepo, uc.deps.CompetitionRepo, challenge, solves)
+	if err != nil {
+		return err
+	}
+	if newPoints == challenge.Points {
+		return nil
+	}
+	if err := uc.deps.TxRepo.UpdateChallengePointsTx(ctx, tx, challenge.ID, newPoints); err != nil {
+		return usecaseutil.Wrap(err, "UpdateChallengePointsTx")
+	}
+	challenge.Points = newPoints
+	return nil
+}
+
+func (uc *SolveUseCase) auditSolveTx(ctx context.Context, tx repo.Transaction, action entity.AuditAction, solve *entity.Solve, points int, actorID uuid.UUID, clientIP string) error {
+	auditLog := &entity.AuditLog{
+		UserID:     &actorID,
+		Action:     action,
+		EntityType: entity.AuditEntityChallenge,
+		EntityID:   solve.ChallengeID.String(),
+		IP:         clientIP,
+		Details: map[string]any{
+			"team_id":          solve.TeamID.String(),
+			"user_id":          solve.UserID.String(),
+			"solved_at":        solve.SolvedAt,
+			"challenge_points": points,
+		},
+	}
+	if err := uc.deps.TxRepo.CreateAuditLogTx(ctx, tx, auditLog); err != nil {
+		return usecaseutil.Wrap(err, "CreateAuditLogTx")
+	}
+	return nil
+}
+
+// holdsFirstBlood reports whether teamID has the earliest solve of challengeID.
+func (uc *SolveUseCase) holdsFirstBlood(ctx context.Context, challengeID, teamID uuid.UUID) (bool, error) {
+	entry, err := uc.deps.SolveRepo.GetFirstBlood(ctx, challengeID)
+	if errors.Is(err, entityError.ErrSolveNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, usecaseutil.Wrap(err, "GetFirstBlood")
+	}
+	return entry.TeamID == teamID, nil
+}
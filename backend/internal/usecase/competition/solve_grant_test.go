@@ -0,0 +1,199 @@
+package competition
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/scoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolveUseCase_Grant_DefaultsToCaptain(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	recorder := &revokeRecorder{}
+	uc := h.CreateSolveUseCaseWithBroadcaster(recorder)
+
+	teamID, captainID, actorID := uuid.New(), uuid.New(), uuid.New()
+	challenge := h.NewChallenge(uuid.New(), "Checker Bug", 200)
+	solve := &entity.Solve{TeamID: teamID, ChallengeID: challenge.ID}
+
+	h.ExpectSolveTransaction(nil)
+	deps.txRepo.On("GetTeamByIDTx", mock.Anything, mock.Anything, teamID).Return(&entity.Team{ID: teamID, CaptainID: captainID}, nil)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challenge.ID).Return(nil, entityError.ErrSolveNotFound)
+	deps.txRepo.On("CreateSolveTx", mock.Anything, mock.Anything, solve).Return(nil)
+	deps.txRepo.On("IncrementChallengeSolveCountTx", mock.Anything, mock.Anything, challenge.ID).Return(1, nil)
+	deps.txRepo.On("CreateAuditLogTx", mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionGrantSolve && *l.UserID == actorID && l.EntityID == challenge.ID.String()
+	})).Return(nil)
+	deps.solveRepo.On("GetFirstBlood", mock.Anything, challenge.ID).Return(h.NewFirstBlood(teamID), nil)
+
+	firstBlood, err := uc.Grant(context.Background(), solve, actorID, "127.0.0.1")
+
+	require.NoError(t, err)
+	assert.True(t, firstBlood)
+	assert.Equal(t, captainID, solve.UserID)
+	assert.Equal(t, []uuid.UUID{teamID}, recorder.solved)
+	assert.True(t, recorder.firstBlood)
+}
+
+func TestSolveUseCase_Grant_SolverNotInTeam(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateSolveUseCase()
+
+	teamID, userID, otherTeamID := uuid.New(), uuid.New(), uuid.New()
+	solve := &entity.Solve{TeamID: teamID, UserID: userID, ChallengeID: uuid.New()}
+
+	h.ExpectSolveTransaction(entityError.ErrSolverNotInTeam)
+	deps.txRepo.On("GetTeamByIDTx", mock.Anything, mock.Anything, teamID).Return(&entity.Team{ID: teamID, CaptainID: uuid.New()}, nil)
+	deps.userRepo.On("GetByID", mock.Anything, userID).Return(h.NewUser(userID, &otherTeamID), nil)
+
+	_, err := uc.Grant(context.Background(), solve, uuid.New(), "127.0.0.1")
+
+	assert.ErrorIs(t, err, entityError.ErrSolverNotInTeam)
+	deps.txRepo.AssertNotCalled(t, "CreateSolveTx", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSolveUseCase_Grant_AlreadySolved(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateSolveUseCase()
+
+	teamID := uuid.New()
+	challenge := h.NewChallenge(uuid.New(), "Solved", 100)
+	solve := &entity.Solve{TeamID: teamID, ChallengeID: challenge.ID}
+
+	h.ExpectSolveTransaction(entityError.ErrAlreadySolved)
+	deps.txRepo.On("GetTeamByIDTx", mock.Anything, mock.Anything, teamID).Return(&entity.Team{ID: teamID, CaptainID: uuid.New()}, nil)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challenge.ID).Return(&entity.Solve{}, nil)
+
+	_, err := uc.Grant(context.Background(), solve, uuid.New(), "127.0.0.1")
+
+	assert.ErrorIs(t, err, entityError.ErrAlreadySolved)
+}
+
+func TestSolveUseCase_Grant_PinsTimeValue(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc := h.CreateSolveUseCaseWithScoring()
+
+	start := time.Now().Add(-time.Hour)
+	end := start.Add(2 * time.Hour)
+	challenge := h.NewChallenge(uuid.New(), "Timed", 500)
+	early := &entity.Solve{TeamID: uuid.New(), ChallengeID: challenge.ID, SolvedAt: start.Add(30 * time.Minute)}
+	late := &entity.Solve{TeamID: uuid.New(), ChallengeID: challenge.ID, SolvedAt: start.Add(90 * time.Minute)}
+
+	h.ExpectSolveTransaction(nil)
+	deps.scoringRepo.On("GetByChallengeID", mock.Anything, challenge.ID).Return(&entity.ChallengeScoring{
+		ChallengeID: challenge.ID,
+		Function:    scoring.Time,
+		Params:      map[string]float64{"initial": 500, "minimum": 100},
+	}, nil)
+	deps.competitionRepo.On("Get", mock.Anything).Return(h.NewCompetitionWithTimes("Timed", &start, &end), nil)
+	deps.txRepo.On("GetTeamByIDTx", mock.Anything, mock.Anything, mock.Anything).Return(&entity.Team{CaptainID: uuid.New()}, nil)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, mock.Anything, challenge.ID).Return(nil, entityError.ErrSolveNotFound)
+	deps.txRepo.On("CreateSolveTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	deps.txRepo.On("IncrementChallengeSolveCountTx", mock.Anything, mock.Anything, challenge.ID).Return(1, nil)
+	deps.txRepo.On("UpdateChallengePointsTx", mock.Anything, mock.Anything, challenge.ID, 300).Return(nil)
+	deps.txRepo.On("CreateAuditLogTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	deps.solveRepo.On("GetFirstBlood", mock.Anything, challenge.ID).Return(h.NewFirstBlood(early.TeamID), nil)
+
+	_, err := uc.Grant(context.Background(), early, uuid.New(), "127.0.0.1")
+	require.NoError(t, err)
+	_, err = uc.Grant(context.Background(), late, uuid.New(), "127.0.0.1")
+	require.NoError(t, err)
+
+	require.NotNil(t, early.Points)
+	require.NotNil(t, late.Points)
+	assert.Equal(t, 400, *early.Points)
+	assert.Equal(t, 200, *late.Points)
+	assert.Equal(t, 300, challenge.Points)
+}
+
+func TestSolveUseCase_Revoke_PassesFirstBloodAndRecoversValue(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	recorder := &revokeRecorder{}
+	uc := h.CreateSolveUseCaseWithBroadcaster(recorder)
+
+	teamID, nextTeamID, actorID := uuid.New(), uuid.New(), uuid.New()
+	challenge := h.NewChallenge(uuid.New(), "Dynamic", 484)
+	challenge.InitialValue, challenge.MinValue, challenge.Decay, challenge.SolveCount = 500, 100, 10, 2
+	solve := &entity.Solve{ID: uuid.New(), TeamID: teamID, UserID: uuid.New(), ChallengeID: challenge.ID}
+
+	deps.solveRepo.On("GetFirstBlood", mock.Anything, challenge.ID).Return(h.NewFirstBlood(teamID), nil).Once()
+	h.ExpectSolveTransaction(nil)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challenge.ID).Return(solve, nil)
+	deps.txRepo.On("DeleteSolveTx", mock.Anything, mock.Anything, solve.ID).Return(nil)
+	deps.txRepo.On("DecrementChallengeSolveCountTx", mock.Anything, mock.Anything, challenge.ID).Return(1, nil)
+	deps.txRepo.On("UpdateChallengePointsTx", mock.Anything, mock.Anything, challenge.ID, 500).Return(nil)
+	deps.txRepo.On("CreateAuditLogTx", mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionRevokeSolve && l.Details["team_id"] == teamID.String()
+	})).Return(nil)
+	deps.solveRepo.On("GetFirstBlood", mock.Anything, challenge.ID).Return(h.NewFirstBlood(nextTeamID), nil).Once()
+
+	err := uc.Revoke(context.Background(), challenge.ID, teamID, actorID, "127.0.0.1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{teamID}, recorder.revoked)
+	assert.Equal(t, 500, recorder.points)
+	require.NotNil(t, recorder.newFirstBlood)
+	assert.Equal(t, nextTeamID, *recorder.newFirstBlood)
+}
+
+func TestSolveUseCase_Revoke_LastSolve(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	recorder := &revokeRecorder{}
+	uc := h.CreateSolveUseCaseWithBroadcaster(recorder)
+
+	teamID := uuid.New()
+	challenge := h.NewChallenge(uuid.New(), "Static", 100)
+	challenge.SolveCount = 1
+	solve := &entity.Solve{ID: uuid.New(), TeamID: teamID, ChallengeID: challenge.ID}
+
+	deps.solveRepo.On("GetFirstBlood", mock.Anything, challenge.ID).Return(h.NewFirstBlood(teamID), nil).Once()
+	h.ExpectSolveTransaction(nil)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challenge.ID).Return(solve, nil)
+	deps.txRepo.On("DeleteSolveTx", mock.Anything, mock.Anything, solve.ID).Return(nil)
+	deps.txRepo.On("DecrementChallengeSolveCountTx", mock.Anything, mock.Anything, challenge.ID).Return(0, nil)
+	deps.txRepo.On("CreateAuditLogTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	deps.solveRepo.On("GetFirstBlood", mock.Anything, challenge.ID).Return(nil, entityError.ErrSolveNotFound).Once()
+
+	err := uc.Revoke(context.Background(), challenge.ID, teamID, uuid.New(), "127.0.0.1")
+
+	require.NoError(t, err)
+	assert.Nil(t, recorder.newFirstBlood)
+	deps.txRepo.AssertNotCalled(t, "UpdateChallengePointsTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSolveUseCase_Revoke_NotSolved(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	uc, _ := h.CreateSolveUseCase()
+
+	teamID := uuid.New()
+	challenge := h.NewChallenge(uuid.New(), "Unsolved", 100)
+
+	deps.solveRepo.On("GetFirstBlood", mock.Anything, challenge.ID).Return(nil, entityError.ErrSolveNotFound)
+	h.ExpectSolveTransaction(entityError.ErrSolveNotFound)
+	deps.txRepo.On("GetChallengeByIDTx", mock.Anything, mock.Anything, challenge.ID).Return(challenge, nil)
+	deps.txRepo.On("GetSolveByTeamAndChallengeTx", mock.Anything, mock.Anything, teamID, challenge.ID).Return(nil, entityError.ErrSolveNotFound)
+
+	err := uc.Revoke(context.Background(), challenge.ID, teamID, uuid.New(), "127.0.0.1")
+
+	assert.ErrorIs(t, err, entityError.ErrSolveNotFound)
+	deps.txRepo.AssertNotCalled(t, "DeleteSolveTx", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -1,8 +1,15 @@
 package competition
 
 import (
+	"context"
+	"time"
+
 	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/pkg/cache"
+	"github.com/stretchr/testify/mock"
 )
 
 func (h *CompetitionTestHelper) CreateSolveUseCase() (*SolveUseCase, redismock.ClientMock) {
@@ -15,8 +22,79 @@ func (h *CompetitionTestHelper) CreateSolveUseCase() (*SolveUseCase, redismock.C
 		UserRepo:        h.deps.userRepo,
 		TeamRepo:        h.deps.teamRepo,
 		TxRepo:          h.deps.txRepo,
+		RescoreRepo:     h.deps.rescoreRepo,
 		Cache:           cache.New(client),
 		ScoreboardCache: nil,
 		Broadcaster:     nil,
 	}), redis
 }
+
+type revokeRecorder struct {
+	solved        []uuid.UUID
+	firstBlood    bool
+	revoked       []uuid.UUID
+	points        int
+	newFirstBlood *uuid.UUID
+}
+
+func (r *revokeRecorder) NotifySolve(teamID uuid.UUID, _ string, _ int, isFirstBlood bool) {
+	r.solved = append(r.solved, teamID)
+	r.firstBlood = isFirstBlood
+}
+
+func (r *revokeRecorder) NotifySolveRevoked(teamID uuid.UUID, _ string, points int, newFirstBlood *uuid.UUID) {
+	r.revoked = append(r.revoked, teamID)
+	r.points = points
+	r.newFirstBlood = newFirstBlood
+}
+
+func (r *revokeRecorder) NotifyNotification(string, string) {}
+
+func (r *revokeRecorder) NotifyUnlock(uuid.UUID, []uuid.UUID) {}
+
+func (r *revokeRecorder) NotifyRelease(uuid.UUID, string, string, int) {}
+
+func (h *CompetitionTestHelper) CreateSolveUseCaseWithBroadcaster(recorder *revokeRecorder) *SolveUseCase {
+	h.t.Helper()
+	uc, _ := h.CreateSolveUseCase()
+	uc.deps.Broadcaster = recorder
+	return uc
+}
+
+func (h *CompetitionTestHelper) CreateSolveUseCaseWithScoring() *SolveUseCase {
+	h.t.Helper()
+	uc, _ := h.CreateSolveUseCase()
+	uc.deps.ScoringRepo = h.deps.scoringRepo
+	return uc
+}
+
+// ExpectSolveTransaction runs the transaction body against the tx mocks and makes
+// RunTransaction return err.
+func (h *CompetitionTestHelper) ExpectSolveTransaction(err error) {
+	h.t.Helper()
+	h.deps.txRepo.On("RunTransaction", mock.Anything, mock.Anything).Return(err).Run(func(args mock.Arguments) {
+		fn, ok := args.Get(1).(func(context.Context, repo.Transaction) error)
+		if !ok {
+			return
+		}
+		_ = fn(context.Background(), nil) //nolint:errcheck
+	})
+}
+
+func (h *CompetitionTestHelper) NewFirstBlood(teamID uuid.UUID) *repo.FirstBloodEntry {
+	h.t.Helper()
+	return &repo.FirstBloodEntry{UserID: uuid.New(), TeamID: teamID, SolvedAt: time.Now()}
+}
+
+func (h *CompetitionTestHelper) NewDynamicChallenge(title string, solveCount, points int) *entity.Challenge {
+	h.t.Helper()
+	return &entity.Challenge{
+		ID:           uuid.New(),
+		Title:        title,
+		Points:       points,
+		InitialValue: 500,
+		MinValue:     100,
+		Decay:        10,
+		SolveCount:   solveCount,
+	}
+}
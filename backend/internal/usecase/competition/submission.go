@@ -5,17 +5,20 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
 )
 
 type SubmissionUseCase struct {
 	submissionRepo repo.SubmissionRepository
+	cheatRepo      repo.CheatIncidentRepository
 }
 
-func NewSubmissionUseCase(submissionRepo repo.SubmissionRepository) *SubmissionUseCase {
+func NewSubmissionUseCase(submissionRepo repo.SubmissionRepository, cheatRepo repo.CheatIncidentRepository) *SubmissionUseCase {
 	return &SubmissionUseCase{
 		submissionRepo: submissionRepo,
+		cheatRepo:      cheatRepo,
 	}
 }
 
@@ -121,3 +124,30 @@ func (uc *SubmissionUseCase) GetStats(ctx context.Context, challengeID uuid.UUID
 	}
 	return stats, nil
 }
+
+// GetCheatIncidents lists recorded cheating incidents newest first, optionally filtered by
+// status and kind.
+func (uc *SubmissionUseCase) GetCheatIncidents(ctx context.Context, filter entity.CheatIncidentFilter, page, perPage int) ([]*entity.CheatIncidentWithDetails, int64, error) {
+	if (filter.Status != nil && !filter.Status.IsValid()) || (filter.Kind != nil && !filter.Kind.IsValid()) {
+		return nil, 0, entityError.ErrInvalidCheatIncidentFilter
+	}
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 || perPage > 100 {
+		perPage = 20
+	}
+	offset := (page - 1) * perPage
+
+	incidents, err := uc.cheatRepo.GetAll(ctx, filter, perPage, offset)
+	if err != nil {
+		return nil, 0, usecaseutil.Wrap(err, "SubmissionUseCase - GetCheatIncidents")
+	}
+
+	total, err := uc.cheatRepo.CountAll(ctx, filter)
+	if err != nil {
+		return nil, 0, usecaseutil.Wrap(err, "SubmissionUseCase - GetCheatIncidents count")
+	}
+
+	return incidents, total, nil
+}
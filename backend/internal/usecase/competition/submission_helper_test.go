@@ -7,7 +7,7 @@ import (
 
 func (h *CompetitionTestHelper) CreateSubmissionUseCase() *SubmissionUseCase {
 	h.t.Helper()
-	return NewSubmissionUseCase(h.deps.submissionRepo)
+	return NewSubmissionUseCase(h.deps.submissionRepo, h.deps.cheatRepo)
 }
 
 func (h *CompetitionTestHelper) NewSubmission(userID uuid.UUID, teamID *uuid.UUID, challengeID uuid.UUID, flag string, isCorrect bool) *entity.Submission {
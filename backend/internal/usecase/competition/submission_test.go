@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -218,3 +219,48 @@ func TestSubmissionUseCase_GetStats_Error(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, got)
 }
+
+func TestSubmissionUseCase_GetCheatIncidents_Success(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+	list := []*entity.CheatIncidentWithDetails{{CheatIncident: entity.CheatIncident{Kind: entity.CheatIncidentSharedFlag}}}
+
+	open := entity.CheatIncidentOpen
+	filter := entity.CheatIncidentFilter{Status: &open}
+
+	deps.cheatRepo.EXPECT().GetAll(mock.Anything, filter, 20, 20).Return(list, nil)
+	deps.cheatRepo.EXPECT().CountAll(mock.Anything, filter).Return(int64(21), nil)
+
+	uc := h.CreateSubmissionUseCase()
+	got, total, err := uc.GetCheatIncidents(ctx, filter, 2, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, list, got)
+	assert.Equal(t, int64(21), total)
+}
+
+func TestSubmissionUseCase_GetCheatIncidents_Error(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+
+	deps.cheatRepo.EXPECT().GetAll(mock.Anything, entity.CheatIncidentFilter{}, 20, 0).Return(nil, assert.AnError)
+
+	uc := h.CreateSubmissionUseCase()
+	got, total, err := uc.GetCheatIncidents(ctx, entity.CheatIncidentFilter{}, 1, 20)
+
+	assert.Error(t, err)
+	assert.Nil(t, got)
+	assert.Equal(t, int64(0), total)
+}
+
+func TestSubmissionUseCase_GetCheatIncidents_InvalidFilter(t *testing.T) {
+	h := NewCompetitionTestHelper(t)
+	kind := entity.CheatIncidentKind("bogus")
+
+	uc := h.CreateSubmissionUseCase()
+	_, _, err := uc.GetCheatIncidents(context.Background(), entity.CheatIncidentFilter{Kind: &kind}, 1, 20)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidCheatIncidentFilter)
+}
@@ -41,9 +41,38 @@ type (
 		SetBracket(ctx context.Context, teamID uuid.UUID, bracketID *uuid.UUID) error
 	}
 
+	// ChallengeWithTags carries the stage progress of the team for multi-stage challenges;
+	// Stages is nil for challenges with a single flag. Attempts is nil for challenges without an
+	// attempt limit.
 	ChallengeWithTags struct {
 		*repo.ChallengeWithSolved
-		Tags []*entity.Tag
+		Tags     []*entity.Tag
+		Locked   bool
+		Stages   *entity.StageProgress
+		Attempts *AttemptState
+	}
+
+	// AttemptState is the attempt limit of a challenge as one team sees it. Remaining is nil
+	// when the number of attempts is unlimited.
+	AttemptState struct {
+		*entity.AttemptLimit
+		Remaining *int
+	}
+
+	// StageWithProgress is a stage as one team sees it: whether the team completed it and who
+	// completed it first.
+	StageWithProgress struct {
+		*entity.ChallengeStage
+		Completed  bool
+		FirstBlood *entity.StageFirstBlood
+	}
+
+	// ChallengeScoringState is the scoring function a challenge is valued by and what it is
+	// worth now. IsDefault is set for challenges that keep the default decay.
+	ChallengeScoringState struct {
+		*entity.ChallengeScoring
+		IsDefault bool
+		Points    int
 	}
 
 	ChallengeUseCase interface {
@@ -79,8 +108,8 @@ type (
 	FileUseCase interface {
 		Upload(ctx context.Context, challengeID uuid.UUID, fileType entity.FileType, filename string, reader io.Reader, size int64, contentType string) (*entity.File, error)
 		Download(ctx context.Context, path string) (io.ReadCloser, error)
-		GetDownloadURL(ctx context.Context, fileID uuid.UUID) (string, error)
-		GetByChallengeID(ctx context.Context, challengeID uuid.UUID, fileType entity.FileType) ([]*entity.File, error)
+		GetDownloadURL(ctx context.Context, fileID uuid.UUID, teamID *uuid.UUID) (string, error)
+		GetByChallengeID(ctx context.Context, challengeID uuid.UUID, teamID *uuid.UUID, fileType entity.FileType) ([]*entity.File, error)
 		Delete(ctx context.Context, fileID uuid.UUID) error
 	}
 
@@ -173,10 +202,16 @@ type (
 
 	APITokenUseCase interface {
 		List(ctx context.Context, userID uuid.UUID) ([]*entity.APIToken, error)
-		Create(ctx context.Context, userID uuid.UUID, description string, expiresAt *time.Time) (plaintext string, token *entity.APIToken, err error)
+		Create(ctx context.Context, userID uuid.UUID, description string, scopes []string, expiresAt *time.Time) (plaintext string, token *entity.APIToken, err error)
 		Delete(ctx context.Context, id, userID uuid.UUID) error
+		ListRequests(ctx context.Context, id, userID uuid.UUID) ([]*entity.APITokenRequest, error)
+		ListService(ctx context.Context) ([]*entity.APIToken, error)
+		CreateService(ctx context.Context, actorID uuid.UUID, description string, scopes []string, expiresAt *time.Time, clientIP string) (plaintext string, token *entity.APIToken, err error)
+		DeleteService(ctx context.Context, id, actorID uuid.UUID, clientIP string) error
+		ListTokenRequests(ctx context.Context, id uuid.UUID) ([]*entity.APITokenRequest, error)
 		GetByTokenHash(ctx context.Context, tokenHash string) (*entity.APIToken, error)
 		UpdateLastUsedAt(ctx context.Context, id uuid.UUID) error
+		RecordRequest(ctx context.Context, req *entity.APITokenRequest) error
 		ValidateToken(t *entity.APIToken) bool
 	}
 
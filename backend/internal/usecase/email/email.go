@@ -223,6 +223,98 @@ func (uc *EmailUseCase) ResetPassword(ctx context.Context, tokenStr, newPassword
 	return nil
 }
 
+// SendEmailChangeEmail mails a confirmation link to newEmail. The address of the account only
+// changes once the link is confirmed via ConfirmEmailChange.
+func (uc *EmailUseCase) SendEmailChangeEmail(ctx context.Context, user *entity.User, newEmail string) error {
+	if !uc.deps.Enabled {
+		return nil
+	}
+
+	if err := uc.deps.TokenRepo.DeleteByUserAndType(ctx, user.ID, entity.TokenTypeEmailChange); err != nil {
+		return usecaseutil.Wrap(err, "EmailUseCase - SendEmailChangeEmail - DeleteByUserAndType")
+	}
+
+	token, err := generateToken(32)
+	if err != nil {
+		return usecaseutil.Wrap(err, "EmailUseCase - SendEmailChangeEmail - generateToken")
+	}
+
+	vt := &entity.VerificationToken{
+		UserID:    user.ID,
+		Token:     hashToken(token),
+		Type:      entity.TokenTypeEmailChange,
+		ExpiresAt: time.Now().Add(uc.deps.VerifyTTL),
+		NewEmail:  &newEmail,
+	}
+
+	if err := uc.deps.TokenRepo.Create(ctx, vt); err != nil {
+		return usecaseutil.Wrap(err, "EmailUseCase - SendEmailChangeEmail - Create")
+	}
+
+	confirmURL := fmt.Sprintf("%s/confirm-email?token=%s", uc.deps.FrontendURL, token)
+
+	body, err := mailer.RenderEmailChangeEmail(mailer.EmailChangeData{
+		Username:  user.Username,
+		NewEmail:  newEmail,
+		ActionURL: confirmURL,
+		AppName:   "CTFBoard",
+	}, true)
+	if err != nil {
+		return usecaseutil.Wrap(err, "EmailUseCase - SendEmailChangeEmail - RenderEmailChangeEmail")
+	}
+
+	msg := mailer.Message{
+		To:      newEmail,
+		Subject: "Confirm your new email - CTFBoard",
+		Body:    body,
+		IsHTML:  true,
+	}
+
+	if err := uc.deps.Mailer.Send(ctx, msg); err != nil {
+		return usecaseutil.Wrap(err, "EmailUseCase - SendEmailChangeEmail - Send")
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange applies the address carried by an email change token. Having received the
+// link, the new address counts as verified.
+func (uc *EmailUseCase) ConfirmEmailChange(ctx context.Context, tokenStr string) error {
+	hashedToken := hashToken(tokenStr)
+	token, err := uc.deps.TokenRepo.GetByToken(ctx, hashedToken)
+	if err != nil {
+		if errors.Is(err, entityError.ErrTokenNotFound) {
+			return entityError.ErrTokenNotFound
+		}
+		return usecaseutil.Wrap(err, "EmailUseCase - ConfirmEmailChange - GetByToken")
+	}
+
+	if token.Type != entity.TokenTypeEmailChange || token.NewEmail == nil {
+		return entityError.ErrTokenNotFound
+	}
+
+	if token.IsExpired() {
+		return entityError.ErrTokenExpired
+	}
+
+	if token.IsUsed() {
+		return entityError.ErrTokenAlreadyUsed
+	}
+
+	if err := uc.deps.UserRepo.UpdateEmail(ctx, token.UserID, *token.NewEmail); err != nil {
+		if errors.Is(err, entityError.ErrUserAlreadyExists) {
+			return fmt.Errorf("%w: email", entityError.ErrUserAlreadyExists)
+		}
+		return usecaseutil.Wrap(err, "EmailUseCase - ConfirmEmailChange - UpdateEmail")
+	}
+
+	if err := uc.deps.TokenRepo.DeleteByUserAndType(ctx, token.UserID, entity.TokenTypeEmailChange); err != nil {
+		return usecaseutil.Wrap(err, "EmailUseCase - ConfirmEmailChange - DeleteByUserAndType")
+	}
+
+	return nil
+}
+
 func (uc *EmailUseCase) ResendVerification(ctx context.Context, userID uuid.UUID) error {
 	user, err := uc.deps.UserRepo.GetByID(ctx, userID)
 	if err != nil {
@@ -184,3 +184,71 @@ func TestEmailUseCase_ResendVerification_UserNotFound(t *testing.T) {
 	err := h.CreateUseCase().ResendVerification(context.Background(), uuid.Nil)
 	assert.ErrorIs(t, err, entityError.ErrUserNotFound)
 }
+
+func TestEmailUseCase_SendEmailChangeEmail_Success(t *testing.T) {
+	h := NewEmailTestHelper(t)
+	deps := h.Deps()
+
+	user := h.NewUser(uuid.New(), "testuser", "old@example.com")
+	newEmail := "new@example.com"
+
+	deps.tokenRepo.On("DeleteByUserAndType", mock.Anything, user.ID, entity.TokenTypeEmailChange).Return(nil)
+	deps.tokenRepo.On("Create", mock.Anything, mock.MatchedBy(func(vt *entity.VerificationToken) bool {
+		return vt.Type == entity.TokenTypeEmailChange && vt.NewEmail != nil && *vt.NewEmail == newEmail
+	})).Return(nil)
+	deps.mailer.On("Send", mock.Anything, mock.MatchedBy(func(msg mailer.Message) bool {
+		return msg.To == newEmail && strings.Contains(msg.Body, "/confirm-email?token=")
+	})).Return(nil)
+
+	err := h.CreateUseCase().SendEmailChangeEmail(context.Background(), user, newEmail)
+	assert.NoError(t, err)
+}
+
+func TestEmailUseCase_ConfirmEmailChange_Success(t *testing.T) {
+	h := NewEmailTestHelper(t)
+	deps := h.Deps()
+
+	rawToken := "changeTOKEN123"
+	hashedToken := h.HashToken(rawToken)
+	tokenEntity := h.NewVerificationToken(uuid.New(), hashedToken, entity.TokenTypeEmailChange)
+	newEmail := "new@example.com"
+	tokenEntity.NewEmail = &newEmail
+
+	deps.tokenRepo.On("GetByToken", mock.Anything, hashedToken).Return(tokenEntity, nil)
+	deps.userRepo.On("UpdateEmail", mock.Anything, tokenEntity.UserID, newEmail).Return(nil)
+	deps.tokenRepo.On("DeleteByUserAndType", mock.Anything, tokenEntity.UserID, entity.TokenTypeEmailChange).Return(nil)
+
+	err := h.CreateUseCase().ConfirmEmailChange(context.Background(), rawToken)
+	assert.NoError(t, err)
+}
+
+func TestEmailUseCase_ConfirmEmailChange_WrongType(t *testing.T) {
+	h := NewEmailTestHelper(t)
+	deps := h.Deps()
+
+	rawToken := "verifyTOKEN123"
+	hashedToken := h.HashToken(rawToken)
+	tokenEntity := h.NewVerificationToken(uuid.New(), hashedToken, entity.TokenTypeEmailVerification)
+
+	deps.tokenRepo.On("GetByToken", mock.Anything, hashedToken).Return(tokenEntity, nil)
+
+	err := h.CreateUseCase().ConfirmEmailChange(context.Background(), rawToken)
+	assert.ErrorIs(t, err, entityError.ErrTokenNotFound)
+}
+
+func TestEmailUseCase_ConfirmEmailChange_EmailTaken(t *testing.T) {
+	h := NewEmailTestHelper(t)
+	deps := h.Deps()
+
+	rawToken := "changeTOKEN456"
+	hashedToken := h.HashToken(rawToken)
+	tokenEntity := h.NewVerificationToken(uuid.New(), hashedToken, entity.TokenTypeEmailChange)
+	newEmail := "taken@example.com"
+	tokenEntity.NewEmail = &newEmail
+
+	deps.tokenRepo.On("GetByToken", mock.Anything, hashedToken).Return(tokenEntity, nil)
+	deps.userRepo.On("UpdateEmail", mock.Anything, tokenEntity.UserID, newEmail).Return(entityError.ErrUserAlreadyExists)
+
+	err := h.CreateUseCase().ConfirmEmailChange(context.Background(), rawToken)
+	assert.ErrorIs(t, err, entityError.ErrUserAlreadyExists)
+}
@@ -16,6 +16,11 @@ import (
 
 const DefaultMaxTeamSize = 10
 
+// BracketAssigner picks the bracket of a new team from its captain's email address.
+type BracketAssigner interface {
+	BracketForEmail(ctx context.Context, email string) (*uuid.UUID, error)
+}
+
 type TeamUseCase struct {
 	teamRepo        repo.TeamRepository
 	userRepo        repo.UserRepository
@@ -23,6 +28,7 @@ type TeamUseCase struct {
 	txRepo          repo.TxRepository
 	guard           *competition.Guard
 	scoreboardCache cache.ScoreboardCacheInvalidator
+	brackets        BracketAssigner
 	maxTeamSize     int
 }
 
@@ -32,6 +38,7 @@ func NewTeamUseCase(
 	compRepo repo.CompetitionRepository,
 	txRepo repo.TxRepository,
 	scoreboardCache cache.ScoreboardCacheInvalidator,
+	brackets BracketAssigner,
 ) *TeamUseCase {
 	return &TeamUseCase{
 		teamRepo:        teamRepo,
@@ -40,6 +47,7 @@ func NewTeamUseCase(
 		txRepo:          txRepo,
 		guard:           competition.NewGuard(compRepo),
 		scoreboardCache: scoreboardCache,
+		brackets:        brackets,
 		maxTeamSize:     DefaultMaxTeamSize,
 	}
 }
@@ -50,6 +58,7 @@ func NewTeamUseCaseWithSize(
 	compRepo repo.CompetitionRepository,
 	txRepo repo.TxRepository,
 	scoreboardCache cache.ScoreboardCacheInvalidator,
+	brackets BracketAssigner,
 	maxTeamSize int,
 ) *TeamUseCase {
 	return &TeamUseCase{
@@ -59,6 +68,7 @@ func NewTeamUseCaseWithSize(
 		txRepo:          txRepo,
 		guard:           competition.NewGuard(compRepo),
 		scoreboardCache: scoreboardCache,
+		brackets:        brackets,
 		maxTeamSize:     maxTeamSize,
 	}
 }
@@ -96,10 +106,15 @@ func (uc *TeamUseCase) createTx(ctx context.Context, tx repo.Transaction, name s
 			return nil, err
 		}
 	}
+	bracketID, err := uc.bracketFor(ctx, user)
+	if err != nil {
+		return nil, err
+	}
 	team := &entity.Team{
 		Name:        name,
 		InviteToken: uuid.New(),
 		CaptainID:   captainID,
+		BracketID:   bracketID,
 		IsSolo:      isSolo,
 	}
 	if err := uc.txRepo.CreateTeamTx(ctx, tx, team); err != nil {
@@ -115,6 +130,18 @@ func (uc *TeamUseCase) createTx(ctx context.Context, tx repo.Transaction, name s
 	return team, nil
 }
 
+// bracketFor returns the bracket assigned to teams created by user, or nil without an assigner.
+func (uc *TeamUseCase) bracketFor(ctx context.Context, user *entity.User) (*uuid.UUID, error) {
+	if uc.brackets == nil {
+		return nil, nil
+	}
+	bracketID, err := uc.brackets.BracketForEmail(ctx, user.Email)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "BracketForEmail")
+	}
+	return bracketID, nil
+}
+
 func (uc *TeamUseCase) validateTeamNameAvailableTx(ctx context.Context, tx repo.Transaction, name string) error {
 	_, err := uc.txRepo.GetTeamByNameTx(ctx, tx, name)
 	if err == nil {
@@ -446,9 +473,13 @@ func (uc *TeamUseCase) createSoloTeamTx(ctx context.Context, tx repo.Transaction
 			return nil, err
 		}
 	}
+	bracketID, err := uc.bracketFor(ctx, user)
+	if err != nil {
+		return nil, err
+	}
 	team := &entity.Team{
 		Name: user.Username, InviteToken: uuid.New(), CaptainID: userID,
-		IsSolo: true, IsAutoCreated: false,
+		BracketID: bracketID, IsSolo: true, IsAutoCreated: false,
 	}
 	if _, err := uc.txRepo.GetTeamByNameTx(ctx, tx, team.Name); err == nil {
 		team.Name = fmt.Sprintf("%s (Solo)", user.Username)
@@ -18,6 +18,7 @@ type teamTestDeps struct {
 	userRepo *mocks.MockUserRepository
 	compRepo *mocks.MockCompetitionRepository
 	txRepo   *mocks.MockTxRepository
+	brackets *mocks.MockBracketAssigner
 }
 
 func NewTeamTestHelper(t *testing.T) *TeamTestHelper {
@@ -29,6 +30,7 @@ func NewTeamTestHelper(t *testing.T) *TeamTestHelper {
 			userRepo: mocks.NewMockUserRepository(t),
 			compRepo: mocks.NewMockCompetitionRepository(t),
 			txRepo:   mocks.NewMockTxRepository(t),
+			brackets: mocks.NewMockBracketAssigner(t),
 		},
 	}
 }
@@ -41,6 +43,19 @@ func (h *TeamTestHelper) CreateUseCase() *TeamUseCase {
 		h.deps.compRepo,
 		h.deps.txRepo,
 		nil,
+		nil,
+	)
+}
+
+func (h *TeamTestHelper) CreateUseCaseWithBrackets() *TeamUseCase {
+	h.t.Helper()
+	return NewTeamUseCase(
+		h.deps.teamRepo,
+		h.deps.userRepo,
+		h.deps.compRepo,
+		h.deps.txRepo,
+		nil,
+		h.deps.brackets,
 	)
 }
 
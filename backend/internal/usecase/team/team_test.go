@@ -47,6 +47,36 @@ func TestTeamUseCase_Create_Success(t *testing.T) {
 	assert.NotEmpty(t, team.InviteToken)
 }
 
+func TestTeamUseCase_Create_AssignsBracketByEmail(t *testing.T) {
+	h := NewTeamTestHelper(t)
+	deps := h.Deps()
+
+	captainID := uuid.New()
+	bracketID := uuid.New()
+	user := h.NewUser(captainID, nil, "captain", "captain@uni.edu")
+
+	deps.txRepo.EXPECT().RunTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context, repo.Transaction) error) error {
+		return fn(ctx, nil)
+	}).Once()
+	deps.compRepo.EXPECT().Get(mock.Anything).Return(&entity.Competition{Mode: "flexible", AllowTeamSwitch: true}, nil).Once()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, captainID).Return(nil).Once()
+	deps.txRepo.EXPECT().GetTeamByNameTx(mock.Anything, mock.Anything, "TestTeam").Return(nil, entityError.ErrTeamNotFound).Once()
+	deps.userRepo.EXPECT().GetByID(mock.Anything, captainID).Return(user, nil).Once()
+	deps.brackets.EXPECT().BracketForEmail(mock.Anything, "captain@uni.edu").Return(&bracketID, nil).Once()
+	deps.txRepo.EXPECT().CreateTeamTx(mock.Anything, mock.Anything, mock.MatchedBy(func(t *entity.Team) bool {
+		return t.BracketID != nil && *t.BracketID == bracketID
+	})).Return(nil).Run(func(_ context.Context, _ repo.Transaction, team *entity.Team) {
+		team.ID = uuid.New()
+	}).Once()
+	deps.txRepo.EXPECT().UpdateUserTeamIDTx(mock.Anything, mock.Anything, captainID, mock.Anything).Return(nil).Once()
+	deps.txRepo.EXPECT().CreateTeamAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	team, err := h.CreateUseCaseWithBrackets().Create(context.Background(), "TestTeam", captainID, false, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &bracketID, team.BracketID)
+}
+
 func TestTeamUseCase_Create_WithSoloTeam_Success(t *testing.T) {
 	h := NewTeamTestHelper(t)
 	deps := h.Deps()
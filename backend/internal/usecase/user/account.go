@@ -0,0 +1,258 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/cache"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const maxUsernameLength = 50
+
+// EmailChangeSender mails the confirmation link for a pending email change.
+type EmailChangeSender interface {
+	IsEnabled() bool
+	SendEmailChangeEmail(ctx context.Context, user *entity.User, newEmail string) error
+}
+
+type AccountDeps struct {
+	UserRepo        repo.UserRepository
+	TxRepo          repo.TxRepository
+	SessionRepo     repo.SessionRepository
+	Email           EmailChangeSender
+	ScoreboardCache cache.ScoreboardCacheInvalidator
+}
+
+// AccountUseCase lets users manage their own account. Changes that need the current password
+// accept any password for accounts created through an external login, which have none.
+type AccountUseCase struct {
+	deps AccountDeps
+}
+
+func NewAccountUseCase(deps AccountDeps) *AccountUseCase {
+	return &AccountUseCase{deps: deps}
+}
+
+// ChangePassword replaces the password and signs out every session except keepSessionID.
+func (uc *AccountUseCase) ChangePassword(
+	ctx context.Context,
+	userID, keepSessionID uuid.UUID,
+	currentPassword, newPassword, clientIP string,
+) error {
+	if len(newPassword) < minPasswordLength {
+		return entityError.ErrWeakPassword
+	}
+	user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "AccountUseCase - ChangePassword - GetByID")
+	}
+	if err := verifyPassword(user, currentPassword); err != nil {
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return usecaseutil.Wrap(err, "AccountUseCase - ChangePassword - GenerateFromPassword")
+	}
+	err = uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.UpdateUserPasswordTx(ctx, tx, userID, string(hash)); err != nil {
+			return usecaseutil.Wrap(err, "UpdateUserPasswordTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionChangePassword, userID, clientIP, nil)
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "AccountUseCase - ChangePassword")
+	}
+	if err := uc.revokeOtherSessions(ctx, userID, keepSessionID); err != nil {
+		return usecaseutil.Wrap(err, "AccountUseCase - ChangePassword - revokeOtherSessions")
+	}
+	return nil
+}
+
+func (uc *AccountUseCase) ChangeUsername(ctx context.Context, userID uuid.UUID, username, clientIP string) (*entity.User, error) {
+	username = strings.TrimSpace(username)
+	if username == "" || utf8.RuneCountInString(username) > maxUsernameLength {
+		return nil, entityError.ErrInvalidUsername
+	}
+	var user *entity.User
+	err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		var err error
+		user, err = uc.deps.UserRepo.GetByID(ctx, userID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetByID")
+		}
+		if user.Username == username {
+			return nil
+		}
+		if err := checkAvailable(ctx, userID, "username", username, uc.deps.UserRepo.GetByUsername); err != nil {
+			return err
+		}
+		changes := map[string]any{"username": map[string]any{"from": user.Username, "to": username}}
+		user.Username = username
+		if err := uc.deps.TxRepo.UpdateUserTx(ctx, tx, user); err != nil {
+			return usecaseutil.Wrap(err, "UpdateUserTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionUpdate, userID, clientIP, changes)
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "AccountUseCase - ChangeUsername")
+	}
+	uc.invalidateScoreboardCache(ctx)
+	return user, nil
+}
+
+// RequestEmailChange starts an email change. With email delivery enabled the new address is
+// applied only after it is confirmed through the mailed link and pending is true; otherwise
+// it is applied right away.
+func (uc *AccountUseCase) RequestEmailChange(ctx context.Context, userID uuid.UUID, email, password, clientIP string) (pending bool, err error) {
+	email = strings.TrimSpace(email)
+	user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return false, usecaseutil.Wrap(err, "AccountUseCase - RequestEmailChange - GetByID")
+	}
+	if err := verifyPassword(user, password); err != nil {
+		return false, err
+	}
+	if strings.EqualFold(user.Email, email) {
+		return false, nil
+	}
+	if err := checkAvailable(ctx, userID, "email", email, uc.deps.UserRepo.GetByEmail); err != nil {
+		return false, err
+	}
+
+	if uc.deps.Email != nil && uc.deps.Email.IsEnabled() {
+		if err := uc.deps.Email.SendEmailChangeEmail(ctx, user, email); err != nil {
+			return false, usecaseutil.Wrap(err, "AccountUseCase - RequestEmailChange - SendEmailChangeEmail")
+		}
+		return true, nil
+	}
+
+	err = uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetByID")
+		}
+		changes := map[string]any{"email": map[string]any{"from": user.Email, "to": email}}
+		user.Email = email
+		if err := uc.deps.TxRepo.UpdateUserTx(ctx, tx, user); err != nil {
+			return usecaseutil.Wrap(err, "UpdateUserTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionUpdate, userID, clientIP, changes)
+	})
+	if err != nil {
+		return false, usecaseutil.Wrap(err, "AccountUseCase - RequestEmailChange")
+	}
+	return false, nil
+}
+
+// Delete anonymizes the account instead of removing it, so its solves keep counting for the
+// team. The user leaves a team that has other members; as the last member it stays so the team
+// keeps its place on the scoreboard.
+func (uc *AccountUseCase) Delete(ctx context.Context, userID uuid.UUID, password, clientIP string) error {
+	user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "AccountUseCase - Delete - GetByID")
+	}
+	if err := verifyPassword(user, password); err != nil {
+		return err
+	}
+	err = uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetByID")
+		}
+		if user.TeamID != nil {
+			if err := detachTx(ctx, uc.deps.TxRepo, tx, user, userID, "account_deleted", true); err != nil {
+				return err
+			}
+		}
+		username, email := anonymizedIdentity(userID)
+		if err := uc.deps.TxRepo.AnonymizeUserTx(ctx, tx, userID, username, email); err != nil {
+			return usecaseutil.Wrap(err, "AnonymizeUserTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionDelete, userID, clientIP, map[string]any{"anonymized": true})
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "AccountUseCase - Delete")
+	}
+	if _, err := uc.deps.SessionRepo.RevokeAllByUserID(ctx, userID); err != nil {
+		return usecaseutil.Wrap(err, "AccountUseCase - Delete - RevokeAllByUserID")
+	}
+	uc.invalidateScoreboardCache(ctx)
+	return nil
+}
+
+func (uc *AccountUseCase) revokeOtherSessions(ctx context.Context, userID, keepSessionID uuid.UUID) error {
+	sessions, err := uc.deps.SessionRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		if s.ID == keepSessionID {
+			continue
+		}
+		if err := uc.deps.SessionRepo.Revoke(ctx, s.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *AccountUseCase) auditTx(
+	ctx context.Context,
+	tx repo.Transaction,
+	action entity.AuditAction,
+	userID uuid.UUID,
+	clientIP string,
+	details map[string]any,
+) error {
+	if err := uc.deps.TxRepo.CreateAuditLogTx(ctx, tx, &entity.AuditLog{
+		UserID:     &userID,
+		Action:     action,
+		EntityType: entity.AuditEntityUser,
+		EntityID:   userID.String(),
+		IP:         clientIP,
+		Details:    details,
+	}); err != nil {
+		return usecaseutil.Wrap(err, "CreateAuditLogTx")
+	}
+	return nil
+}
+
+func (uc *AccountUseCase) invalidateScoreboardCache(ctx context.Context) {
+	if uc.deps.ScoreboardCache != nil {
+		uc.deps.ScoreboardCache.InvalidateAll(ctx)
+	}
+}
+
+// verifyPassword checks the current password of user; accounts without one always pass.
+func verifyPassword(user *entity.User, password string) error {
+	if user.PasswordHash == "" {
+		return nil
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+		return entityError.ErrInvalidCurrentPassword
+	}
+	return nil
+}
+
+// anonymizedIdentity derives a unique placeholder username and an undeliverable email address.
+func anonymizedIdentity(userID uuid.UUID) (username, email string) {
+	id := strings.ReplaceAll(userID.String(), "-", "")
+	return "deleted_" + id, "deleted_" + id + "@invalid"
+}
@@ -0,0 +1,219 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAccountUseCase_ChangePassword_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", h.HashPassword("OldPassword1"))
+	current, other := uuid.New(), uuid.New()
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().UpdateUserPasswordTx(mock.Anything, mock.Anything, user.ID, mock.MatchedBy(func(hash string) bool {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte("NewPassword1")) == nil
+	})).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionChangePassword && *l.UserID == user.ID
+	})).Return(nil)
+	deps.sessionRepo.EXPECT().GetActiveByUserID(mock.Anything, user.ID).Return([]*entity.Session{{ID: current}, {ID: other}}, nil)
+	deps.sessionRepo.EXPECT().Revoke(mock.Anything, other).Return(nil)
+
+	err := h.CreateAccountUseCase().ChangePassword(context.Background(), user.ID, current, "OldPassword1", "NewPassword1", "")
+
+	assert.NoError(t, err)
+}
+
+func TestAccountUseCase_ChangePassword_WrongCurrent(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", h.HashPassword("OldPassword1"))
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+
+	err := h.CreateAccountUseCase().ChangePassword(context.Background(), user.ID, uuid.Nil, "wrong", "NewPassword1", "")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidCurrentPassword)
+}
+
+func TestAccountUseCase_ChangePassword_Weak(t *testing.T) {
+	h := NewUserTestHelper(t)
+
+	err := h.CreateAccountUseCase().ChangePassword(context.Background(), uuid.New(), uuid.Nil, "OldPassword1", "short", "")
+
+	assert.ErrorIs(t, err, entityError.ErrWeakPassword)
+}
+
+func TestAccountUseCase_ChangeUsername_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.userRepo.EXPECT().GetByUsername(mock.Anything, "alice2").Return(nil, entityError.ErrUserNotFound)
+	deps.txRepo.EXPECT().UpdateUserTx(mock.Anything, mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+		return u.Username == "alice2"
+	})).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	got, err := h.CreateAccountUseCase().ChangeUsername(context.Background(), user.ID, " alice2 ", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "alice2", got.Username)
+}
+
+func TestAccountUseCase_ChangeUsername_Taken(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	other := h.NewUser("bob", "bob@example.com", "")
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.userRepo.EXPECT().GetByUsername(mock.Anything, "bob").Return(other, nil)
+
+	_, err := h.CreateAccountUseCase().ChangeUsername(context.Background(), user.ID, "bob", "")
+
+	assert.ErrorIs(t, err, entityError.ErrUserAlreadyExists)
+}
+
+func TestAccountUseCase_ChangeUsername_Invalid(t *testing.T) {
+	h := NewUserTestHelper(t)
+
+	_, err := h.CreateAccountUseCase().ChangeUsername(context.Background(), uuid.New(), "   ", "")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidUsername)
+}
+
+func TestAccountUseCase_RequestEmailChange_Pending(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", h.HashPassword("Password1"))
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, "new@example.com").Return(nil, entityError.ErrUserNotFound)
+	deps.emailSender.EXPECT().IsEnabled().Return(true)
+	deps.emailSender.EXPECT().SendEmailChangeEmail(mock.Anything, user, "new@example.com").Return(nil)
+
+	pending, err := h.CreateAccountUseCase().RequestEmailChange(context.Background(), user.ID, "new@example.com", "Password1", "")
+
+	require.NoError(t, err)
+	assert.True(t, pending)
+}
+
+func TestAccountUseCase_RequestEmailChange_EmailDisabled(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, "new@example.com").Return(nil, entityError.ErrUserNotFound)
+	deps.emailSender.EXPECT().IsEnabled().Return(false)
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.txRepo.EXPECT().UpdateUserTx(mock.Anything, mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+		return u.Email == "new@example.com"
+	})).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	pending, err := h.CreateAccountUseCase().RequestEmailChange(context.Background(), user.ID, "new@example.com", "", "")
+
+	require.NoError(t, err)
+	assert.False(t, pending)
+}
+
+func TestAccountUseCase_RequestEmailChange_WrongPassword(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", h.HashPassword("Password1"))
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+
+	_, err := h.CreateAccountUseCase().RequestEmailChange(context.Background(), user.ID, "new@example.com", "wrong", "")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidCurrentPassword)
+}
+
+func TestAccountUseCase_Delete_LastMemberKeepsTeam(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	teamID := uuid.New()
+	user := h.NewUser("alice", "alice@example.com", "")
+	user.TeamID = &teamID
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.txRepo.EXPECT().LockTeamTx(mock.Anything, mock.Anything, teamID).Return(nil)
+	deps.txRepo.EXPECT().GetTeamByIDTx(mock.Anything, mock.Anything, teamID).Return(&entity.Team{ID: teamID, CaptainID: user.ID}, nil)
+	deps.txRepo.EXPECT().GetUsersByTeamIDTx(mock.Anything, mock.Anything, teamID).Return([]*entity.User{user}, nil)
+	deps.txRepo.EXPECT().AnonymizeUserTx(mock.Anything, mock.Anything, user.ID, mock.Anything, mock.Anything).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionDelete && l.EntityID == user.ID.String()
+	})).Return(nil)
+	deps.sessionRepo.EXPECT().RevokeAllByUserID(mock.Anything, user.ID).Return(int64(1), nil)
+
+	err := h.CreateAccountUseCase().Delete(context.Background(), user.ID, "", "")
+
+	assert.NoError(t, err)
+}
+
+func TestAccountUseCase_Delete_CaptainHandsOver(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	teamID := uuid.New()
+	user := h.NewUser("alice", "alice@example.com", h.HashPassword("Password1"))
+	user.TeamID = &teamID
+	mate := h.NewUser("bob", "bob@example.com", "")
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.txRepo.EXPECT().LockTeamTx(mock.Anything, mock.Anything, teamID).Return(nil)
+	deps.txRepo.EXPECT().GetTeamByIDTx(mock.Anything, mock.Anything, teamID).Return(&entity.Team{ID: teamID, CaptainID: user.ID}, nil)
+	deps.txRepo.EXPECT().GetUsersByTeamIDTx(mock.Anything, mock.Anything, teamID).Return([]*entity.User{user, mate}, nil)
+	deps.txRepo.EXPECT().UpdateUserTeamIDTx(mock.Anything, mock.Anything, user.ID, (*uuid.UUID)(nil)).Return(nil)
+	deps.txRepo.EXPECT().UpdateTeamCaptainTx(mock.Anything, mock.Anything, teamID, mate.ID).Return(nil)
+	deps.txRepo.EXPECT().CreateTeamAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+	deps.txRepo.EXPECT().AnonymizeUserTx(mock.Anything, mock.Anything, user.ID, mock.Anything, mock.Anything).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	deps.sessionRepo.EXPECT().RevokeAllByUserID(mock.Anything, user.ID).Return(int64(1), nil)
+
+	err := h.CreateAccountUseCase().Delete(context.Background(), user.ID, "Password1", "")
+
+	assert.NoError(t, err)
+}
+
+func TestAccountUseCase_Delete_WrongPassword(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", h.HashPassword("Password1"))
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+
+	err := h.CreateAccountUseCase().Delete(context.Background(), user.ID, "wrong", "")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidCurrentPassword)
+}
+
+func TestAnonymizedIdentity_FitsColumns(t *testing.T) {
+	username, email := anonymizedIdentity(uuid.New())
+
+	assert.LessOrEqual(t, len(username), 50)
+	assert.LessOrEqual(t, len(email), 50)
+	assert.NotEqual(t, username, email)
+}
@@ -0,0 +1,417 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/cache"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const minPasswordLength = 8
+
+type AdminUserDeps struct {
+	UserRepo        repo.UserRepository
+	TxRepo          repo.TxRepository
+	SessionRepo     repo.SessionRepository
+	ScoreboardCache cache.ScoreboardCacheInvalidator
+}
+
+// AdminUserUpdate holds the profile fields an admin may change; nil fields are kept.
+type AdminUserUpdate struct {
+	Username *string
+	Email    *string
+	Verified *bool
+}
+
+// AdminUserUseCase backs the admin user-management API. Every mutation runs in a transaction
+// together with its audit log entry.
+type AdminUserUseCase struct {
+	deps AdminUserDeps
+}
+
+func NewAdminUserUseCase(deps AdminUserDeps) *AdminUserUseCase {
+	return &AdminUserUseCase{deps: deps}
+}
+
+func (uc *AdminUserUseCase) List(ctx context.Context, filter *entity.UserFilter, page, perPage int) ([]*entity.User, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 || perPage > 100 {
+		perPage = 20
+	}
+	offset := (page - 1) * perPage
+
+	users, err := uc.deps.UserRepo.List(ctx, filter, perPage, offset)
+	if err != nil {
+		return nil, 0, usecaseutil.Wrap(err, "AdminUserUseCase - List")
+	}
+
+	total, err := uc.deps.UserRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, usecaseutil.Wrap(err, "AdminUserUseCase - List count")
+	}
+
+	return users, total, nil
+}
+
+func (uc *AdminUserUseCase) Get(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "AdminUserUseCase - Get")
+	}
+	return user, nil
+}
+
+func (uc *AdminUserUseCase) Update(ctx context.Context, userID uuid.UUID, upd AdminUserUpdate, actorID uuid.UUID, clientIP string) (*entity.User, error) {
+	var user *entity.User
+	err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		var err error
+		user, err = uc.deps.UserRepo.GetByID(ctx, userID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetByID")
+		}
+		changes, err := uc.applyUpdate(ctx, user, upd)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		if err := uc.deps.TxRepo.UpdateUserTx(ctx, tx, user); err != nil {
+			return usecaseutil.Wrap(err, "UpdateUserTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionUpdate, userID, actorID, clientIP, changes)
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "AdminUserUseCase - Update")
+	}
+	return user, nil
+}
+
+// applyUpdate changes user in place and returns the changed fields for the audit log.
+func (uc *AdminUserUseCase) applyUpdate(ctx context.Context, user *entity.User, upd AdminUserUpdate) (map[string]any, error) {
+	changes := map[string]any{}
+	if upd.Username != nil && *upd.Username != user.Username {
+		username := strings.TrimSpace(*upd.Username)
+		if err := checkAvailable(ctx, user.ID, "username", username, uc.deps.UserRepo.GetByUsername); err != nil {
+			return nil, err
+		}
+		changes["username"] = map[string]any{"from": user.Username, "to": username}
+		user.Username = username
+	}
+	if upd.Email != nil && *upd.Email != user.Email {
+		email := strings.TrimSpace(*upd.Email)
+		if err := checkAvailable(ctx, user.ID, "email", email, uc.deps.UserRepo.GetByEmail); err != nil {
+			return nil, err
+		}
+		changes["email"] = map[string]any{"from": user.Email, "to": email}
+		user.Email = email
+	}
+	if upd.Verified != nil && *upd.Verified != user.IsVerified {
+		changes["is_verified"] = *upd.Verified
+		user.IsVerified = *upd.Verified
+		user.VerifiedAt = nil
+		if user.IsVerified {
+			now := time.Now()
+			user.VerifiedAt = &now
+		}
+	}
+	return changes, nil
+}
+
+// checkAvailable fails when value is already taken by an account other than userID.
+func checkAvailable(
+	ctx context.Context,
+	userID uuid.UUID,
+	field, value string,
+	lookup func(context.Context, string) (*entity.User, error),
+) error {
+	other, err := lookup(ctx, value)
+	if err == nil {
+		if other.ID != userID {
+			return fmt.Errorf("%w: %s", entityError.ErrUserAlreadyExists, field)
+		}
+		return nil
+	}
+	if !errors.Is(err, entityError.ErrUserNotFound) {
+		return usecaseutil.Wrap(err, "lookup "+field)
+	}
+	return nil
+}
+
+// Ban blocks the account from logging in and revokes all of its sessions.
+func (uc *AdminUserUseCase) Ban(ctx context.Context, userID uuid.UUID, reason string, actorID uuid.UUID, clientIP string) error {
+	if userID == actorID {
+		return entityError.ErrCannotModifySelf
+	}
+	err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		if err := uc.deps.TxRepo.BanUserTx(ctx, tx, userID, reason); err != nil {
+			return usecaseutil.Wrap(err, "BanUserTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionBan, userID, actorID, clientIP, map[string]any{"reason": reason})
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "AdminUserUseCase - Ban")
+	}
+	if _, err := uc.deps.SessionRepo.RevokeAllByUserID(ctx, userID); err != nil {
+		return usecaseutil.Wrap(err, "AdminUserUseCase - Ban - RevokeAllByUserID")
+	}
+	return nil
+}
+
+func (uc *AdminUserUseCase) Unban(ctx context.Context, userID, actorID uuid.UUID, clientIP string) error {
+	err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.UnbanUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "UnbanUserTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionUnban, userID, actorID, clientIP, nil)
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "AdminUserUseCase - Unban")
+	}
+	return nil
+}
+
+// ResetPassword sets a new password chosen by the admin and signs the user out everywhere.
+func (uc *AdminUserUseCase) ResetPassword(ctx context.Context, userID uuid.UUID, password string, actorID uuid.UUID, clientIP string) error {
+	if len(password) < minPasswordLength {
+		return entityError.ErrWeakPassword
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return usecaseutil.Wrap(err, "AdminUserUseCase - ResetPassword - GenerateFromPassword")
+	}
+	err = uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		if err := uc.deps.TxRepo.UpdateUserPasswordTx(ctx, tx, userID, string(hash)); err != nil {
+			return usecaseutil.Wrap(err, "UpdateUserPasswordTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionResetPassword, userID, actorID, clientIP, nil)
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "AdminUserUseCase - ResetPassword")
+	}
+	if _, err := uc.deps.SessionRepo.RevokeAllByUserID(ctx, userID); err != nil {
+		return usecaseutil.Wrap(err, "AdminUserUseCase - ResetPassword - RevokeAllByUserID")
+	}
+	return nil
+}
+
+// MoveTeam moves the user into teamID, or out of any team when teamID is nil. Unlike joining
+// by invite it ignores the team size limit and the competition's team-switch rules.
+func (uc *AdminUserUseCase) MoveTeam(ctx context.Context, userID uuid.UUID, teamID *uuid.UUID, actorID uuid.UUID, clientIP string) (*entity.User, error) {
+	var user *entity.User
+	err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		var err error
+		user, err = uc.deps.UserRepo.GetByID(ctx, userID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetByID")
+		}
+		if sameTeam(user.TeamID, teamID) {
+			return nil
+		}
+		from := user.TeamID
+		if user.TeamID != nil {
+			if err := detachTx(ctx, uc.deps.TxRepo, tx, user, actorID, "moved_by_admin", false); err != nil {
+				return err
+			}
+		}
+		if teamID != nil {
+			if err := uc.attachTx(ctx, tx, user, *teamID, actorID); err != nil {
+				return err
+			}
+		}
+		user.TeamID = teamID
+		return uc.auditTx(ctx, tx, entity.AuditActionMoveTeam, userID, actorID, clientIP, map[string]any{
+			"from": uuidPtrString(from), "to": uuidPtrString(teamID),
+		})
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "AdminUserUseCase - MoveTeam")
+	}
+	uc.invalidateScoreboardCache(ctx)
+	return user, nil
+}
+
+// Delete removes the account. A captain's team passes to another member first; a team left
+// without members is removed together with the account.
+func (uc *AdminUserUseCase) Delete(ctx context.Context, userID, actorID uuid.UUID, clientIP string) error {
+	if userID == actorID {
+		return entityError.ErrCannotModifySelf
+	}
+	err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetByID")
+		}
+		if user.TeamID != nil {
+			if err := detachTx(ctx, uc.deps.TxRepo, tx, user, actorID, "moved_by_admin", false); err != nil {
+				return err
+			}
+		}
+		if err := uc.deps.TxRepo.DeleteUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "DeleteUserTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionDelete, userID, actorID, clientIP, map[string]any{
+			"username": user.Username, "email": user.Email,
+		})
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "AdminUserUseCase - Delete")
+	}
+	uc.invalidateScoreboardCache(ctx)
+	return nil
+}
+
+// detachTx takes user out of its current team, handing the captaincy to another member. When
+// user is the last member the team is soft-deleted, or, with keepIfLast, user stays in it so the
+// team and its solves remain on the scoreboard.
+func detachTx(
+	ctx context.Context,
+	txRepo repo.TxRepository,
+	tx repo.Transaction,
+	user *entity.User,
+	actorID uuid.UUID,
+	reason string,
+	keepIfLast bool,
+) error {
+	teamID := *user.TeamID
+	if err := txRepo.LockTeamTx(ctx, tx, teamID); err != nil {
+		return usecaseutil.Wrap(err, "LockTeamTx")
+	}
+	team, err := txRepo.GetTeamByIDTx(ctx, tx, teamID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetTeamByIDTx")
+	}
+	members, err := txRepo.GetUsersByTeamIDTx(ctx, tx, teamID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetUsersByTeamIDTx")
+	}
+	var successor *entity.User
+	for _, m := range members {
+		if m.ID != user.ID {
+			successor = m
+			break
+		}
+	}
+	if successor == nil && keepIfLast {
+		return nil
+	}
+
+	if err := txRepo.UpdateUserTeamIDTx(ctx, tx, user.ID, nil); err != nil {
+		return usecaseutil.Wrap(err, "UpdateUserTeamIDTx")
+	}
+	user.TeamID = nil
+	if err := txRepo.CreateTeamAuditLogTx(ctx, tx, &entity.TeamAuditLog{
+		TeamID: teamID, UserID: actorID, Action: entity.TeamActionLeft,
+		Details: map[string]any{"user_id": user.ID.String(), "reason": reason},
+	}); err != nil {
+		return usecaseutil.Wrap(err, "CreateTeamAuditLogTx left")
+	}
+
+	switch {
+	case successor == nil:
+		if err := txRepo.SoftDeleteTeamTx(ctx, tx, teamID); err != nil {
+			return usecaseutil.Wrap(err, "SoftDeleteTeamTx")
+		}
+		return txRepo.CreateTeamAuditLogTx(ctx, tx, &entity.TeamAuditLog{
+			TeamID: teamID, UserID: actorID, Action: entity.TeamActionDeleted,
+			Details: map[string]any{"reason": "last_member_removed_by_admin"},
+		})
+	case team.CaptainID == user.ID:
+		if err := txRepo.UpdateTeamCaptainTx(ctx, tx, teamID, successor.ID); err != nil {
+			return usecaseutil.Wrap(err, "UpdateTeamCaptainTx")
+		}
+		return txRepo.CreateTeamAuditLogTx(ctx, tx, &entity.TeamAuditLog{
+			TeamID: teamID, UserID: actorID, Action: entity.TeamActionCaptainTransfer,
+			Details: map[string]any{"from": user.ID.String(), "to": successor.ID.String()},
+		})
+	}
+	return nil
+}
+
+func (uc *AdminUserUseCase) attachTx(ctx context.Context, tx repo.Transaction, user *entity.User, teamID, actorID uuid.UUID) error {
+	if err := uc.deps.TxRepo.LockTeamTx(ctx, tx, teamID); err != nil {
+		return usecaseutil.Wrap(err, "LockTeamTx target")
+	}
+	team, err := uc.deps.TxRepo.GetTeamByIDTx(ctx, tx, teamID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "GetTeamByIDTx target")
+	}
+	if team.IsSolo {
+		return entityError.ErrTeamFull
+	}
+	if err := uc.deps.TxRepo.UpdateUserTeamIDTx(ctx, tx, user.ID, &teamID); err != nil {
+		return usecaseutil.Wrap(err, "UpdateUserTeamIDTx target")
+	}
+	return uc.deps.TxRepo.CreateTeamAuditLogTx(ctx, tx, &entity.TeamAuditLog{
+		TeamID: teamID, UserID: actorID, Action: entity.TeamActionJoined,
+		Details: map[string]any{"user_id": user.ID.String(), "reason": "moved_by_admin"},
+	})
+}
+
+func (uc *AdminUserUseCase) auditTx(
+	ctx context.Context,
+	tx repo.Transaction,
+	action entity.AuditAction,
+	userID, actorID uuid.UUID,
+	clientIP string,
+	details map[string]any,
+) error {
+	if err := uc.deps.TxRepo.CreateAuditLogTx(ctx, tx, &entity.AuditLog{
+		UserID:     &actorID,
+		Action:     action,
+		EntityType: entity.AuditEntityUser,
+		EntityID:   userID.String(),
+		IP:         clientIP,
+		Details:    details,
+	}); err != nil {
+		return usecaseutil.Wrap(err, "CreateAuditLogTx")
+	}
+	return nil
+}
+
+func (uc *AdminUserUseCase) invalidateScoreboardCache(ctx context.Context) {
+	if uc.deps.ScoreboardCache != nil {
+		uc.deps.ScoreboardCache.InvalidateAll(ctx)
+	}
+}
+
+func sameTeam(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func uuidPtrString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
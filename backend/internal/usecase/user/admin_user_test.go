@@ -0,0 +1,252 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func auditWith(action entity.AuditAction, userID uuid.UUID) any {
+	return mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == action && l.EntityType == entity.AuditEntityUser && l.EntityID == userID.String()
+	})
+}
+
+func TestAdminUserUseCase_List_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	filter := &entity.UserFilter{Search: "alice"}
+	users := []*entity.User{h.NewUser("alice", "alice@example.com", "")}
+
+	deps.userRepo.EXPECT().List(mock.Anything, filter, 20, 20).Return(users, nil)
+	deps.userRepo.EXPECT().Count(mock.Anything, filter).Return(int64(21), nil)
+
+	got, total, err := h.CreateAdminUserUseCase().List(context.Background(), filter, 2, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, users, got)
+	assert.Equal(t, int64(21), total)
+}
+
+func TestAdminUserUseCase_List_Error(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.userRepo.EXPECT().List(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	_, _, err := h.CreateAdminUserUseCase().List(context.Background(), nil, 1, 20)
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestAdminUserUseCase_Update_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	actorID := uuid.New()
+	user := h.NewUser("alice", "alice@example.com", "")
+	newName := "alice2"
+	verified := true
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.userRepo.EXPECT().GetByUsername(mock.Anything, newName).Return(nil, entityError.ErrUserNotFound)
+	deps.txRepo.EXPECT().UpdateUserTx(mock.Anything, mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+		return u.Username == newName && u.IsVerified && u.VerifiedAt != nil
+	})).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		_, hasName := l.Details["username"]
+		_, hasEmail := l.Details["email"]
+		return l.Action == entity.AuditActionUpdate && hasName && !hasEmail && *l.UserID == actorID
+	})).Return(nil)
+
+	got, err := h.CreateAdminUserUseCase().Update(context.Background(), user.ID, AdminUserUpdate{
+		Username: &newName, Verified: &verified,
+	}, actorID, "127.0.0.1")
+
+	require.NoError(t, err)
+	assert.Equal(t, newName, got.Username)
+}
+
+func TestAdminUserUseCase_Update_Error(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	other := h.NewUser("bob", "bob@example.com", "")
+	email := other.Email
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, email).Return(other, nil)
+
+	_, err := h.CreateAdminUserUseCase().Update(context.Background(), user.ID, AdminUserUpdate{Email: &email}, uuid.New(), "")
+
+	assert.ErrorIs(t, err, entityError.ErrUserAlreadyExists)
+}
+
+func TestAdminUserUseCase_Update_NoChanges(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	same := user.Username
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+
+	_, err := h.CreateAdminUserUseCase().Update(context.Background(), user.ID, AdminUserUpdate{Username: &same}, uuid.New(), "")
+
+	assert.NoError(t, err)
+}
+
+func TestAdminUserUseCase_Ban_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, userID).Return(nil)
+	deps.txRepo.EXPECT().BanUserTx(mock.Anything, mock.Anything, userID, "spam").Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, auditWith(entity.AuditActionBan, userID)).Return(nil)
+	deps.sessionRepo.EXPECT().RevokeAllByUserID(mock.Anything, userID).Return(int64(2), nil)
+
+	err := h.CreateAdminUserUseCase().Ban(context.Background(), userID, "spam", uuid.New(), "")
+
+	assert.NoError(t, err)
+}
+
+func TestAdminUserUseCase_Ban_Self(t *testing.T) {
+	h := NewUserTestHelper(t)
+	userID := uuid.New()
+
+	err := h.CreateAdminUserUseCase().Ban(context.Background(), userID, "oops", userID, "")
+
+	assert.ErrorIs(t, err, entityError.ErrCannotModifySelf)
+}
+
+func TestAdminUserUseCase_Unban_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().UnbanUserTx(mock.Anything, mock.Anything, userID).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, auditWith(entity.AuditActionUnban, userID)).Return(nil)
+
+	err := h.CreateAdminUserUseCase().Unban(context.Background(), userID, uuid.New(), "")
+
+	assert.NoError(t, err)
+}
+
+func TestAdminUserUseCase_ResetPassword_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, userID).Return(nil)
+	deps.txRepo.EXPECT().UpdateUserPasswordTx(mock.Anything, mock.Anything, userID, mock.Anything).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, auditWith(entity.AuditActionResetPassword, userID)).Return(nil)
+	deps.sessionRepo.EXPECT().RevokeAllByUserID(mock.Anything, userID).Return(int64(1), nil)
+
+	err := h.CreateAdminUserUseCase().ResetPassword(context.Background(), userID, "NewPassword1", uuid.New(), "")
+
+	assert.NoError(t, err)
+}
+
+func TestAdminUserUseCase_ResetPassword_Weak(t *testing.T) {
+	h := NewUserTestHelper(t)
+
+	err := h.CreateAdminUserUseCase().ResetPassword(context.Background(), uuid.New(), "short", uuid.New(), "")
+
+	assert.ErrorIs(t, err, entityError.ErrWeakPassword)
+}
+
+func TestAdminUserUseCase_MoveTeam_CaptainHandsOver(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	oldTeamID, newTeamID := uuid.New(), uuid.New()
+	user := h.NewUser("alice", "alice@example.com", "")
+	user.TeamID = &oldTeamID
+	mate := h.NewUser("bob", "bob@example.com", "")
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.txRepo.EXPECT().LockTeamTx(mock.Anything, mock.Anything, oldTeamID).Return(nil)
+	deps.txRepo.EXPECT().GetTeamByIDTx(mock.Anything, mock.Anything, oldTeamID).Return(&entity.Team{ID: oldTeamID, CaptainID: user.ID}, nil)
+	deps.txRepo.EXPECT().GetUsersByTeamIDTx(mock.Anything, mock.Anything, oldTeamID).Return([]*entity.User{user, mate}, nil)
+	deps.txRepo.EXPECT().UpdateUserTeamIDTx(mock.Anything, mock.Anything, user.ID, (*uuid.UUID)(nil)).Return(nil)
+	deps.txRepo.EXPECT().UpdateTeamCaptainTx(mock.Anything, mock.Anything, oldTeamID, mate.ID).Return(nil)
+	deps.txRepo.EXPECT().LockTeamTx(mock.Anything, mock.Anything, newTeamID).Return(nil)
+	deps.txRepo.EXPECT().GetTeamByIDTx(mock.Anything, mock.Anything, newTeamID).Return(&entity.Team{ID: newTeamID}, nil)
+	deps.txRepo.EXPECT().UpdateUserTeamIDTx(mock.Anything, mock.Anything, user.ID, &newTeamID).Return(nil)
+	deps.txRepo.EXPECT().CreateTeamAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, auditWith(entity.AuditActionMoveTeam, user.ID)).Return(nil)
+
+	got, err := h.CreateAdminUserUseCase().MoveTeam(context.Background(), user.ID, &newTeamID, uuid.New(), "")
+
+	require.NoError(t, err)
+	assert.Equal(t, &newTeamID, got.TeamID)
+}
+
+func TestAdminUserUseCase_MoveTeam_SoloTarget(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	soloTeamID := uuid.New()
+	user := h.NewUser("alice", "alice@example.com", "")
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.txRepo.EXPECT().LockTeamTx(mock.Anything, mock.Anything, soloTeamID).Return(nil)
+	deps.txRepo.EXPECT().GetTeamByIDTx(mock.Anything, mock.Anything, soloTeamID).Return(&entity.Team{ID: soloTeamID, IsSolo: true}, nil)
+
+	_, err := h.CreateAdminUserUseCase().MoveTeam(context.Background(), user.ID, &soloTeamID, uuid.New(), "")
+
+	assert.ErrorIs(t, err, entityError.ErrTeamFull)
+}
+
+func TestAdminUserUseCase_Delete_LastMember(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	teamID := uuid.New()
+	user := h.NewUser("spam", "spam@example.com", "")
+	user.TeamID = &teamID
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.txRepo.EXPECT().LockTeamTx(mock.Anything, mock.Anything, teamID).Return(nil)
+	deps.txRepo.EXPECT().GetTeamByIDTx(mock.Anything, mock.Anything, teamID).Return(&entity.Team{ID: teamID, CaptainID: user.ID}, nil)
+	deps.txRepo.EXPECT().GetUsersByTeamIDTx(mock.Anything, mock.Anything, teamID).Return([]*entity.User{user}, nil)
+	deps.txRepo.EXPECT().UpdateUserTeamIDTx(mock.Anything, mock.Anything, user.ID, (*uuid.UUID)(nil)).Return(nil)
+	deps.txRepo.EXPECT().SoftDeleteTeamTx(mock.Anything, mock.Anything, teamID).Return(nil)
+	deps.txRepo.EXPECT().CreateTeamAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+	deps.txRepo.EXPECT().DeleteUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, auditWith(entity.AuditActionDelete, user.ID)).Return(nil)
+
+	err := h.CreateAdminUserUseCase().Delete(context.Background(), user.ID, uuid.New(), "")
+
+	assert.NoError(t, err)
+}
+
+func TestAdminUserUseCase_Delete_Error(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, userID).Return(entityError.ErrUserNotFound)
+
+	err := h.CreateAdminUserUseCase().Delete(context.Background(), userID, uuid.New(), "")
+
+	assert.ErrorIs(t, err, entityError.ErrUserNotFound)
+}
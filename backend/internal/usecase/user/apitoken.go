@@ -5,45 +5,69 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
 )
 
+// apiTokenRequestLimit caps how many recent requests are returned for a token.
+const apiTokenRequestLimit = 100
+
 type APITokenUseCase struct {
-	repo repo.APITokenRepository
+	repo         repo.APITokenRepository
+	userRepo     repo.UserRepository
+	auditLogRepo repo.AuditLogRepository
 }
 
 func NewAPITokenUseCase(
 	repo repo.APITokenRepository,
+	userRepo repo.UserRepository,
+	auditLogRepo repo.AuditLogRepository,
 ) *APITokenUseCase {
-	return &APITokenUseCase{repo: repo}
+	return &APITokenUseCase{
+		repo:         repo,
+		userRepo:     userRepo,
+		auditLogRepo: auditLogRepo,
+	}
 }
 
 func (uc *APITokenUseCase) List(ctx context.Context, userID uuid.UUID) ([]*entity.APIToken, error) {
 	return uc.repo.GetByUserID(ctx, userID)
 }
 
-func (uc *APITokenUseCase) Create(ctx context.Context, userID uuid.UUID, description string, expiresAt *time.Time) (plaintext string, token *entity.APIToken, err error) {
-	b := make([]byte, 32)
-	if _, err := rand.Read(b); err != nil {
-		return "", nil, usecaseutil.Wrap(err, "APITokenUseCase - Create - rand")
+// Create issues a personal token. Without scopes the token gets every user scope; admin scopes
+// are only granted to staff roles; what the token can reach is still limited by the role's permissions.
+func (uc *APITokenUseCase) Create(ctx context.Context, userID uuid.UUID, description string, scopes []string, expiresAt *time.Time) (plaintext string, token *entity.APIToken, err error) {
+	if len(scopes) == 0 {
+		scopes = entity.UserScopes
+	}
+	scopes, err = normalizeScopes(scopes)
+	if err != nil {
+		return "", nil, err
+	}
+	if slices.ContainsFunc(scopes, entity.IsAdminScope) {
+		user, err := uc.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return "", nil, usecaseutil.Wrap(err, "APITokenUseCase - Create - GetByID")
+		}
+		if !entity.IsStaffRole(user.Role) {
+			return "", nil, entityError.ErrInvalidTokenScope
+		}
 	}
-	plaintext = hex.EncodeToString(b)
-	hash := sha256.Sum256([]byte(plaintext))
-	tokenHash := hex.EncodeToString(hash[:])
 
 	token = &entity.APIToken{
-		UserID:      userID,
-		TokenHash:   tokenHash,
+		UserID:      &userID,
 		Description: description,
+		Scopes:      scopes,
 		ExpiresAt:   expiresAt,
-		CreatedAt:   time.Now(),
 	}
-	if err := uc.repo.Create(ctx, token); err != nil {
+	plaintext, err = uc.issue(ctx, token)
+	if err != nil {
 		return "", nil, usecaseutil.Wrap(err, "APITokenUseCase - Create")
 	}
 	return plaintext, token, nil
@@ -53,6 +77,78 @@ func (uc *APITokenUseCase) Delete(ctx context.Context, id, userID uuid.UUID) err
 	return uc.repo.Delete(ctx, id, userID)
 }
 
+// ListRequests returns the recent requests of a token owned by userID.
+func (uc *APITokenUseCase) ListRequests(ctx context.Context, id, userID uuid.UUID) ([]*entity.APITokenRequest, error) {
+	token, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "APITokenUseCase - ListRequests - GetByID")
+	}
+	if token.UserID == nil || *token.UserID != userID {
+		return nil, entityError.ErrAPITokenNotFound
+	}
+	return uc.listRequests(ctx, id)
+}
+
+func (uc *APITokenUseCase) ListService(ctx context.Context) ([]*entity.APIToken, error) {
+	tokens, err := uc.repo.GetServiceTokens(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "APITokenUseCase - ListService")
+	}
+	return tokens, nil
+}
+
+// CreateService issues a service token that is not tied to a user, e.g. for bots. Service tokens
+// only carry admin scopes since they have no user to act as.
+func (uc *APITokenUseCase) CreateService(
+	ctx context.Context,
+	actorID uuid.UUID,
+	description string,
+	scopes []string,
+	expiresAt *time.Time,
+	clientIP string,
+) (plaintext string, token *entity.APIToken, err error) {
+	scopes, err = normalizeScopes(scopes)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(scopes) == 0 || slices.ContainsFunc(scopes, func(s string) bool { return !entity.IsAdminScope(s) }) {
+		return "", nil, entityError.ErrInvalidTokenScope
+	}
+
+	token = &entity.APIToken{
+		Description: description,
+		Scopes:      scopes,
+		CreatedBy:   &actorID,
+		ExpiresAt:   expiresAt,
+	}
+	plaintext, err = uc.issue(ctx, token)
+	if err != nil {
+		return "", nil, usecaseutil.Wrap(err, "APITokenUseCase - CreateService")
+	}
+	if err := uc.audit(ctx, entity.AuditActionCreate, token.ID, actorID, clientIP, map[string]any{"scopes": scopes}); err != nil {
+		return "", nil, usecaseutil.Wrap(err, "APITokenUseCase - CreateService - Create audit")
+	}
+	return plaintext, token, nil
+}
+
+func (uc *APITokenUseCase) DeleteService(ctx context.Context, id, actorID uuid.UUID, clientIP string) error {
+	if err := uc.repo.DeleteServiceToken(ctx, id); err != nil {
+		return usecaseutil.Wrap(err, "APITokenUseCase - DeleteService")
+	}
+	if err := uc.audit(ctx, entity.AuditActionDelete, id, actorID, clientIP, nil); err != nil {
+		return usecaseutil.Wrap(err, "APITokenUseCase - DeleteService - Create audit")
+	}
+	return nil
+}
+
+// ListTokenRequests returns the recent requests of any token, for admins.
+func (uc *APITokenUseCase) ListTokenRequests(ctx context.Context, id uuid.UUID) ([]*entity.APITokenRequest, error) {
+	if _, err := uc.repo.GetByID(ctx, id); err != nil {
+		return nil, usecaseutil.Wrap(err, "APITokenUseCase - ListTokenRequests - GetByID")
+	}
+	return uc.listRequests(ctx, id)
+}
+
 func (uc *APITokenUseCase) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.APIToken, error) {
 	return uc.repo.GetByTokenHash(ctx, tokenHash)
 }
@@ -61,6 +157,13 @@ func (uc *APITokenUseCase) UpdateLastUsedAt(ctx context.Context, id uuid.UUID) e
 	return uc.repo.UpdateLastUsedAt(ctx, id, time.Now())
 }
 
+func (uc *APITokenUseCase) RecordRequest(ctx context.Context, req *entity.APITokenRequest) error {
+	if err := uc.repo.CreateRequest(ctx, req); err != nil {
+		return usecaseutil.Wrap(err, "APITokenUseCase - RecordRequest")
+	}
+	return nil
+}
+
 func (uc *APITokenUseCase) ValidateToken(t *entity.APIToken) bool {
 	if t == nil {
 		return false
@@ -70,3 +173,51 @@ func (uc *APITokenUseCase) ValidateToken(t *entity.APIToken) bool {
 	}
 	return true
 }
+
+// issue generates the secret for token, stores its hash and returns the plaintext.
+func (uc *APITokenUseCase) issue(ctx context.Context, token *entity.APIToken) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	plaintext := hex.EncodeToString(b)
+	hash := sha256.Sum256([]byte(plaintext))
+	token.TokenHash = hex.EncodeToString(hash[:])
+	token.CreatedAt = time.Now()
+	if err := uc.repo.Create(ctx, token); err != nil {
+		return "", err
+	}
+	return plaintext, nil
+}
+
+func (uc *APITokenUseCase) listRequests(ctx context.Context, id uuid.UUID) ([]*entity.APITokenRequest, error) {
+	reqs, err := uc.repo.GetRequests(ctx, id, apiTokenRequestLimit)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "APITokenUseCase - GetRequests")
+	}
+	return reqs, nil
+}
+
+func (uc *APITokenUseCase) audit(ctx context.Context, action entity.AuditAction, id, actorID uuid.UUID, clientIP string, details map[string]any) error {
+	return uc.auditLogRepo.Create(ctx, &entity.AuditLog{
+		UserID:     &actorID,
+		Action:     action,
+		EntityType: entity.AuditEntityAPIToken,
+		EntityID:   id.String(),
+		IP:         clientIP,
+		Details:    details,
+	})
+}
+
+// normalizeScopes rejects unknown scopes and returns the rest sorted without duplicates.
+func normalizeScopes(scopes []string) ([]string, error) {
+	out := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		if !entity.IsValidScope(s) {
+			return nil, entityError.ErrInvalidTokenScope
+		}
+		out = append(out, s)
+	}
+	slices.Sort(out)
+	return slices.Compact(out), nil
+}
@@ -2,11 +2,13 @@ package user
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -52,14 +54,15 @@ func TestAPITokenUseCase_Create_Success(t *testing.T) {
 	var exp *time.Time
 
 	deps.apiTokenRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Run(func(_ context.Context, token *entity.APIToken) {
-		assert.Equal(t, userID, token.UserID)
+		assert.Equal(t, &userID, token.UserID)
 		assert.Equal(t, desc, token.Description)
 		assert.Equal(t, exp, token.ExpiresAt)
+		assert.ElementsMatch(t, entity.UserScopes, token.Scopes)
 		assert.NotEmpty(t, token.TokenHash)
 	})
 
 	uc := h.CreateAPITokenUseCase()
-	plaintext, token, err := uc.Create(ctx, userID, desc, exp)
+	plaintext, token, err := uc.Create(ctx, userID, desc, nil, exp)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, plaintext)
@@ -75,13 +78,142 @@ func TestAPITokenUseCase_Create_Error(t *testing.T) {
 	deps.apiTokenRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(assert.AnError)
 
 	uc := h.CreateAPITokenUseCase()
-	plaintext, token, err := uc.Create(ctx, userID, "desc", nil)
+	plaintext, token, err := uc.Create(ctx, userID, "desc", nil, nil)
 
 	assert.Error(t, err)
 	assert.Empty(t, plaintext)
 	assert.Nil(t, token)
 }
 
+func TestAPITokenUseCase_Create_Scopes(t *testing.T) {
+	userID := uuid.New()
+	tests := []struct {
+		name       string
+		role       string
+		scopes     []string
+		wantScopes []string
+		wantErr    error
+	}{
+		{"UserScopesDeduplicated", entity.RoleUser, []string{entity.ScopeSubmit, entity.ScopeChallengesRead, entity.ScopeSubmit}, []string{entity.ScopeChallengesRead, entity.ScopeSubmit}, nil},
+		{"AdminScopeForAdmin", entity.RoleAdmin, []string{entity.ScopeAdminBackup}, []string{entity.ScopeAdminBackup}, nil},
+		{"AdminScopeForUser", entity.RoleUser, []string{entity.ScopeAdmin}, nil, entityError.ErrInvalidTokenScope},
+		{"UnknownScope", entity.RoleUser, []string{"everything"}, nil, entityError.ErrInvalidTokenScope},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserTestHelper(t)
+			deps := h.Deps()
+			if slices.ContainsFunc(tt.scopes, entity.IsAdminScope) {
+				deps.userRepo.EXPECT().GetByID(mock.Anything, userID).Return(&entity.User{ID: userID, Role: tt.role}, nil)
+			}
+			if tt.wantErr == nil {
+				deps.apiTokenRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+			}
+
+			_, token, err := h.CreateAPITokenUseCase().Create(context.Background(), userID, "", tt.scopes, nil)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantScopes, token.Scopes)
+		})
+	}
+}
+
+func TestAPITokenUseCase_ListRequests_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+	userID := uuid.New()
+	token := h.NewAPIToken(userID, "h", "d", nil)
+	reqs := []*entity.APITokenRequest{{TokenID: token.ID, Method: "GET", Path: "/api/v1/challenges", Status: 200}}
+
+	deps.apiTokenRepo.EXPECT().GetByID(mock.Anything, token.ID).Return(token, nil)
+	deps.apiTokenRepo.EXPECT().GetRequests(mock.Anything, token.ID, apiTokenRequestLimit).Return(reqs, nil)
+
+	got, err := h.CreateAPITokenUseCase().ListRequests(ctx, token.ID, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reqs, got)
+}
+
+func TestAPITokenUseCase_ListRequests_ForeignToken(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+	token := h.NewAPIToken(uuid.New(), "h", "d", nil)
+
+	deps.apiTokenRepo.EXPECT().GetByID(mock.Anything, token.ID).Return(token, nil)
+
+	_, err := h.CreateAPITokenUseCase().ListRequests(ctx, token.ID, uuid.New())
+
+	assert.ErrorIs(t, err, entityError.ErrAPITokenNotFound)
+}
+
+func TestAPITokenUseCase_CreateService_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+	actorID := uuid.New()
+
+	deps.apiTokenRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Run(func(_ context.Context, token *entity.APIToken) {
+		assert.Nil(t, token.UserID)
+		assert.Equal(t, &actorID, token.CreatedBy)
+		assert.Equal(t, []string{entity.ScopeAdminBackup, entity.ScopeAdminRead}, token.Scopes)
+	})
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionCreate && l.EntityType == entity.AuditEntityAPIToken && *l.UserID == actorID
+	})).Return(nil)
+
+	plaintext, token, err := h.CreateAPITokenUseCase().CreateService(ctx, actorID, "ctftime bot", []string{entity.ScopeAdminRead, entity.ScopeAdminBackup}, nil, "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, plaintext)
+	assert.True(t, token.IsService())
+}
+
+func TestAPITokenUseCase_CreateService_InvalidScopes(t *testing.T) {
+	h := NewUserTestHelper(t)
+	uc := h.CreateAPITokenUseCase()
+	ctx := context.Background()
+
+	for _, scopes := range [][]string{nil, {entity.ScopeSubmit}, {entity.ScopeAdminRead, entity.ScopeProfileRead}} {
+		_, _, err := uc.CreateService(ctx, uuid.New(), "", scopes, nil, "")
+		assert.ErrorIs(t, err, entityError.ErrInvalidTokenScope)
+	}
+}
+
+func TestAPITokenUseCase_DeleteService_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+	id, actorID := uuid.New(), uuid.New()
+
+	deps.apiTokenRepo.EXPECT().DeleteServiceToken(mock.Anything, id).Return(nil)
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionDelete && l.EntityID == id.String()
+	})).Return(nil)
+
+	err := h.CreateAPITokenUseCase().DeleteService(ctx, id, actorID, "")
+
+	assert.NoError(t, err)
+}
+
+func TestAPITokenUseCase_DeleteService_NotFound(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+	id := uuid.New()
+
+	deps.apiTokenRepo.EXPECT().DeleteServiceToken(mock.Anything, id).Return(entityError.ErrAPITokenNotFound)
+
+	err := h.CreateAPITokenUseCase().DeleteService(ctx, id, uuid.New(), "")
+
+	assert.ErrorIs(t, err, entityError.ErrAPITokenNotFound)
+}
+
 func TestAPITokenUseCase_Delete_Success(t *testing.T) {
 	h := NewUserTestHelper(t)
 	deps := h.Deps()
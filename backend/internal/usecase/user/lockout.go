@@ -0,0 +1,273 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+const (
+	lockoutKeyPrefix = "lockout:"
+	// lockoutLevelTTL is how long past lockouts are remembered for escalating the next one.
+	lockoutLevelTTL = 24 * time.Hour
+)
+
+// LockoutPolicy configures failed-login tracking. Each lockout of the same account or address
+// doubles the previous duration, starting at BaseLockout and capped at MaxLockout.
+type LockoutPolicy struct {
+	MaxAttempts   int
+	IPMaxAttempts int
+	Window        time.Duration
+	BaseLockout   time.Duration
+	MaxLockout    time.Duration
+}
+
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		MaxAttempts:   5,
+		IPMaxAttempts: 30,
+		Window:        15 * time.Minute,
+		BaseLockout:   time.Minute,
+		MaxLockout:    time.Hour,
+	}
+}
+
+type LockoutStatus struct {
+	Locked         bool
+	LockedUntil    *time.Time
+	FailedAttempts int
+}
+
+// LockoutUseCase tracks failed logins per account (email) and per client IP in Redis.
+// Unknown emails are tracked the same way as existing ones so lockouts do not reveal accounts.
+type LockoutUseCase struct {
+	redis        *redis.Client
+	userRepo     repo.UserRepository
+	auditLogRepo repo.AuditLogRepository
+	policy       LockoutPolicy
+}
+
+func NewLockoutUseCase(
+	redis *redis.Client,
+	userRepo repo.UserRepository,
+	auditLogRepo repo.AuditLogRepository,
+	policy LockoutPolicy,
+) *LockoutUseCase {
+	return &LockoutUseCase{
+		redis:        redis,
+		userRepo:     userRepo,
+		auditLogRepo: auditLogRepo,
+		policy:       policy,
+	}
+}
+
+// Check rejects the attempt while the account or the client IP is locked out.
+func (uc *LockoutUseCase) Check(ctx context.Context, email, clientIP string) error {
+	locked, err := uc.lockedFor(ctx, accountScope(email))
+	if err != nil {
+		return usecaseutil.Wrap(err, "LockoutUseCase - Check - account")
+	}
+	if locked > 0 {
+		return entityError.ErrAccountLocked
+	}
+	if clientIP == "" {
+		return nil
+	}
+	locked, err = uc.lockedFor(ctx, ipScope(clientIP))
+	if err != nil {
+		return usecaseutil.Wrap(err, "LockoutUseCase - Check - ip")
+	}
+	if locked > 0 {
+		return entityError.ErrTooManyLoginAttempts
+	}
+	return nil
+}
+
+// RecordFailure counts a failed attempt and locks the account or IP once its threshold is reached.
+// user is nil when the email does not belong to an account.
+func (uc *LockoutUseCase) RecordFailure(ctx context.Context, email, clientIP string, user *entity.User) error {
+	scope := accountScope(email)
+	n, err := uc.countFailure(ctx, scope)
+	if err != nil {
+		return usecaseutil.Wrap(err, "LockoutUseCase - RecordFailure - account")
+	}
+	if n >= int64(uc.policy.MaxAttempts) {
+		until, err := uc.lock(ctx, scope)
+		if err != nil {
+			return usecaseutil.Wrap(err, "LockoutUseCase - RecordFailure - lock account")
+		}
+		if user != nil {
+			if err := uc.audit(ctx, entity.AuditActionLock, entity.AuditEntityUser, user.ID.String(), nil, clientIP, until); err != nil {
+				return usecaseutil.Wrap(err, "LockoutUseCase - RecordFailure - Create audit")
+			}
+		}
+	}
+
+	if clientIP == "" {
+		return nil
+	}
+	scope = ipScope(clientIP)
+	n, err = uc.countFailure(ctx, scope)
+	if err != nil {
+		return usecaseutil.Wrap(err, "LockoutUseCase - RecordFailure - ip")
+	}
+	if n >= int64(uc.policy.IPMaxAttempts) {
+		until, err := uc.lock(ctx, scope)
+		if err != nil {
+			return usecaseutil.Wrap(err, "LockoutUseCase - RecordFailure - lock ip")
+		}
+		if err := uc.audit(ctx, entity.AuditActionLock, entity.AuditEntityIP, clientIP, nil, clientIP, until); err != nil {
+			return usecaseutil.Wrap(err, "LockoutUseCase - RecordFailure - Create audit")
+		}
+	}
+	return nil
+}
+
+// RecordSuccess clears the failure counter of the account. The lockout level is kept so
+// repeated lockouts keep escalating within lockoutLevelTTL.
+func (uc *LockoutUseCase) RecordSuccess(ctx context.Context, email string) error {
+	if err := uc.redis.Del(ctx, failKey(accountScope(email))).Err(); err != nil {
+		return usecaseutil.Wrap(err, "LockoutUseCase - RecordSuccess")
+	}
+	return nil
+}
+
+func (uc *LockoutUseCase) Status(ctx context.Context, userID uuid.UUID) (*LockoutStatus, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "LockoutUseCase - Status - GetByID")
+	}
+	scope := accountScope(user.Email)
+	locked, err := uc.lockedFor(ctx, scope)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "LockoutUseCase - Status - TTL")
+	}
+	failed, err := uc.redis.Get(ctx, failKey(scope)).Int()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, usecaseutil.Wrap(err, "LockoutUseCase - Status - Get")
+	}
+
+	status := &LockoutStatus{FailedAttempts: failed}
+	if locked > 0 {
+		until := time.Now().Add(locked)
+		status.Locked = true
+		status.LockedUntil = &until
+	}
+	return status, nil
+}
+
+// Unlock lifts an account lockout and forgets its failures and lockout history.
+func (uc *LockoutUseCase) Unlock(ctx context.Context, userID, actorID uuid.UUID, clientIP string) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "LockoutUseCase - Unlock - GetByID")
+	}
+	scope := accountScope(user.Email)
+	if err := uc.redis.Del(ctx, lockKey(scope), failKey(scope), levelKey(scope)).Err(); err != nil {
+		return usecaseutil.Wrap(err, "LockoutUseCase - Unlock - Del")
+	}
+	if err := uc.audit(ctx, entity.AuditActionUnlock, entity.AuditEntityUser, userID.String(), &actorID, clientIP, time.Time{}); err != nil {
+		return usecaseutil.Wrap(err, "LockoutUseCase - Unlock - Create audit")
+	}
+	return nil
+}
+
+func (uc *LockoutUseCase) lockedFor(ctx context.Context, scope string) (time.Duration, error) {
+	ttl, err := uc.redis.TTL(ctx, lockKey(scope)).Result()
+	if err != nil {
+		return 0, err
+	}
+	return ttl, nil
+}
+
+func (uc *LockoutUseCase) countFailure(ctx context.Context, scope string) (int64, error) {
+	key := failKey(scope)
+	n, err := uc.redis.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		if err := uc.redis.Expire(ctx, key, uc.policy.Window).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// lock starts the next lockout for scope and resets its failure counter.
+func (uc *LockoutUseCase) lock(ctx context.Context, scope string) (time.Time, error) {
+	level, err := uc.redis.Incr(ctx, levelKey(scope)).Result()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := uc.redis.Expire(ctx, levelKey(scope), lockoutLevelTTL).Err(); err != nil {
+		return time.Time{}, err
+	}
+	d := uc.policy.lockoutDuration(level)
+	if err := uc.redis.Set(ctx, lockKey(scope), level, d).Err(); err != nil {
+		return time.Time{}, err
+	}
+	if err := uc.redis.Del(ctx, failKey(scope)).Err(); err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(d), nil
+}
+
+func (uc *LockoutUseCase) audit(
+	ctx context.Context,
+	action entity.AuditAction,
+	entityType entity.AuditEntityType,
+	entityID string,
+	actorID *uuid.UUID,
+	clientIP string,
+	until time.Time,
+) error {
+	log := &entity.AuditLog{
+		UserID:     actorID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		IP:         clientIP,
+	}
+	if !until.IsZero() {
+		log.Details = map[string]any{"locked_until": until.UTC().Format(time.RFC3339)}
+	}
+	return uc.auditLogRepo.Create(ctx, log)
+}
+
+// lockoutDuration returns BaseLockout * 2^(level-1), capped at MaxLockout.
+func (p LockoutPolicy) lockoutDuration(level int64) time.Duration {
+	d := p.BaseLockout
+	for i := int64(1); i < level && d < p.MaxLockout; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxLockout)
+}
+
+func accountScope(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipScope(ip string) string {
+	return "ip:" + ip
+}
+
+func failKey(scope string) string {
+	return lockoutKeyPrefix + "fail:" + scope
+}
+
+func lockKey(scope string) string {
+	return lockoutKeyPrefix + "lock:" + scope
+}
+
+func levelKey(scope string) string {
+	return lockoutKeyPrefix + "level:" + scope
+}
@@ -0,0 +1,302 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	lockoutEmail = "alice@example.com"
+	lockoutIP    = "10.0.0.1"
+)
+
+func TestLockoutPolicy_LockoutDuration(t *testing.T) {
+	p := LockoutPolicy{BaseLockout: time.Minute, MaxLockout: 10 * time.Minute}
+	assert.Equal(t, time.Minute, p.lockoutDuration(1))
+	assert.Equal(t, 2*time.Minute, p.lockoutDuration(2))
+	assert.Equal(t, 8*time.Minute, p.lockoutDuration(4))
+	assert.Equal(t, 10*time.Minute, p.lockoutDuration(5))
+	assert.Equal(t, 10*time.Minute, p.lockoutDuration(60))
+}
+
+func TestLockoutUseCase_Check(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountTTL time.Duration
+		ipTTL      time.Duration
+		wantErr    error
+	}{
+		{"NotLocked", -2 * time.Nanosecond, -2 * time.Nanosecond, nil},
+		{"AccountLocked", 30 * time.Second, 0, entityError.ErrAccountLocked},
+		{"IPLocked", -2 * time.Nanosecond, time.Minute, entityError.ErrTooManyLoginAttempts},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserTestHelper(t)
+			redisClient, redisMock := redismock.NewClientMock()
+			uc := h.CreateLockoutUseCase(redisClient)
+
+			redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(tt.accountTTL)
+			if tt.accountTTL <= 0 {
+				redisMock.ExpectTTL("lockout:lock:ip:" + lockoutIP).SetVal(tt.ipTTL)
+			}
+
+			err := uc.Check(context.Background(), "Alice@Example.com", lockoutIP)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, redisMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestLockoutUseCase_RecordFailure_BelowThreshold(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateLockoutUseCase(redisClient)
+
+	redisMock.ExpectIncr("lockout:fail:account:" + lockoutEmail).SetVal(1)
+	redisMock.ExpectExpire("lockout:fail:account:"+lockoutEmail, 15*time.Minute).SetVal(true)
+	redisMock.ExpectIncr("lockout:fail:ip:" + lockoutIP).SetVal(4)
+
+	err := uc.RecordFailure(context.Background(), lockoutEmail, lockoutIP, nil)
+
+	require.NoError(t, err)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
+
+func TestLockoutUseCase_RecordFailure_LocksAccountWithBackoff(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateLockoutUseCase(redisClient)
+	user := h.NewUser("alice", lockoutEmail, "hash")
+
+	redisMock.ExpectIncr("lockout:fail:account:" + lockoutEmail).SetVal(3)
+	redisMock.ExpectIncr("lockout:level:account:" + lockoutEmail).SetVal(3)
+	redisMock.ExpectExpire("lockout:level:account:"+lockoutEmail, lockoutLevelTTL).SetVal(true)
+	redisMock.ExpectSet("lockout:lock:account:"+lockoutEmail, int64(3), 4*time.Minute).SetVal("OK")
+	redisMock.ExpectDel("lockout:fail:account:" + lockoutEmail).SetVal(1)
+	redisMock.ExpectIncr("lockout:fail:ip:" + lockoutIP).SetVal(5)
+	h.Deps().auditLogRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionLock && l.EntityType == entity.AuditEntityUser &&
+			l.EntityID == user.ID.String() && l.UserID == nil && l.Details["locked_until"] != nil
+	})).Return(nil)
+
+	err := uc.RecordFailure(context.Background(), lockoutEmail, lockoutIP, user)
+
+	require.NoError(t, err)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
+
+func TestLockoutUseCase_RecordFailure_LocksIP(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateLockoutUseCase(redisClient)
+
+	redisMock.ExpectIncr("lockout:fail:account:" + lockoutEmail).SetVal(2)
+	redisMock.ExpectIncr("lockout:fail:ip:" + lockoutIP).SetVal(10)
+	redisMock.ExpectIncr("lockout:level:ip:" + lockoutIP).SetVal(1)
+	redisMock.ExpectExpire("lockout:level:ip:"+lockoutIP, lockoutLevelTTL).SetVal(true)
+	redisMock.ExpectSet("lockout:lock:ip:"+lockoutIP, int64(1), time.Minute).SetVal("OK")
+	redisMock.ExpectDel("lockout:fail:ip:" + lockoutIP).SetVal(1)
+	h.Deps().auditLogRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionLock && l.EntityType == entity.AuditEntityIP && l.EntityID == lockoutIP
+	})).Return(nil)
+
+	err := uc.RecordFailure(context.Background(), lockoutEmail, lockoutIP, nil)
+
+	require.NoError(t, err)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
+
+func TestLockoutUseCase_RecordFailure_RedisError(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateLockoutUseCase(redisClient)
+
+	redisMock.ExpectIncr("lockout:fail:account:" + lockoutEmail).SetErr(errors.New("redis down"))
+
+	err := uc.RecordFailure(context.Background(), lockoutEmail, lockoutIP, nil)
+
+	assert.Error(t, err)
+}
+
+func TestLockoutUseCase_Unlock(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateLockoutUseCase(redisClient)
+	user := h.NewUser("alice", lockoutEmail, "hash")
+	actorID := uuid.New()
+
+	h.Deps().userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	redisMock.ExpectDel("lockout:lock:account:"+lockoutEmail, "lockout:fail:account:"+lockoutEmail, "lockout:level:account:"+lockoutEmail).SetVal(2)
+	h.Deps().auditLogRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionUnlock && l.EntityID == user.ID.String() && *l.UserID == actorID
+	})).Return(nil)
+
+	err := uc.Unlock(context.Background(), user.ID, actorID, "127.0.0.1")
+
+	require.NoError(t, err)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
+
+func TestLockoutUseCase_Unlock_UserNotFound(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, _ := redismock.NewClientMock()
+	uc := h.CreateLockoutUseCase(redisClient)
+	userID := uuid.New()
+
+	h.Deps().userRepo.EXPECT().GetByID(mock.Anything, userID).Return(nil, entityError.ErrUserNotFound)
+
+	err := uc.Unlock(context.Background(), userID, uuid.New(), "127.0.0.1")
+
+	assert.ErrorIs(t, err, entityError.ErrUserNotFound)
+}
+
+func TestLockoutUseCase_Status(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateLockoutUseCase(redisClient)
+	user := h.NewUser("alice", lockoutEmail, "hash")
+
+	h.Deps().userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(2 * time.Minute)
+	redisMock.ExpectGet("lockout:fail:account:" + lockoutEmail).RedisNil()
+
+	status, err := uc.Status(context.Background(), user.ID)
+
+	require.NoError(t, err)
+	assert.True(t, status.Locked)
+	require.NotNil(t, status.LockedUntil)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), *status.LockedUntil, 5*time.Second)
+	assert.Zero(t, status.FailedAttempts)
+}
+
+func TestUserUseCase_Login_Locked(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateUseCaseWithLockout(h.CreateLockoutUseCase(redisClient))
+
+	redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(time.Minute)
+
+	_, err := uc.Login(context.Background(), lockoutEmail, "password", lockoutIP, "agent")
+
+	assert.ErrorIs(t, err, entityError.ErrAccountLocked)
+}
+
+func TestUserUseCase_Login_WrongPasswordRecordsFailure(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateUseCaseWithLockout(h.CreateLockoutUseCase(redisClient))
+	user := h.NewUser("alice", lockoutEmail, h.HashPassword("correct"))
+
+	redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(-2)
+	redisMock.ExpectTTL("lockout:lock:ip:" + lockoutIP).SetVal(-2)
+	h.Deps().userRepo.EXPECT().GetByEmail(mock.Anything, lockoutEmail).Return(user, nil)
+	redisMock.ExpectIncr("lockout:fail:account:" + lockoutEmail).SetVal(2)
+	redisMock.ExpectIncr("lockout:fail:ip:" + lockoutIP).SetVal(2)
+
+	_, err := uc.Login(context.Background(), lockoutEmail, "wrong", lockoutIP, "agent")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidCredentials)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
+
+func TestUserUseCase_Login_SuccessClearsFailures(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateUseCaseWithLockout(h.CreateLockoutUseCase(redisClient))
+	user := h.NewUser("alice", lockoutEmail, h.HashPassword("correct"))
+
+	redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(-2)
+	redisMock.ExpectTTL("lockout:lock:ip:" + lockoutIP).SetVal(-2)
+	h.Deps().userRepo.EXPECT().GetByEmail(mock.Anything, lockoutEmail).Return(user, nil)
+	redisMock.ExpectDel("lockout:fail:account:" + lockoutEmail).SetVal(1)
+	h.expectOpenSession(user.ID)
+
+	res, err := uc.Login(context.Background(), lockoutEmail, "correct", lockoutIP, "agent")
+
+	require.NoError(t, err)
+	assert.NotNil(t, res.Tokens)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
+
+// A correct password on an account with 2FA only yields a challenge, so it must not clear the
+// failures that wrong second factors have recorded.
+func TestUserUseCase_Login_TwoFactorKeepsFailures(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateUseCaseWithLockout(h.CreateLockoutUseCase(redisClient))
+	user := h.NewUser("alice", lockoutEmail, h.HashPassword("correct"))
+	tf, _ := h.NewTwoFactor(user.ID, true)
+	claims := &jwt.CustomClaims{UserID: user.ID.String(), TokenType: jwt.TokenTypeMFA}
+
+	deps.jwtService.EXPECT().ValidateMFAToken("challenge").Return(claims, nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, lockoutEmail).Return(user, nil)
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().UseRecoveryCode(mock.Anything, user.ID, mock.Anything).Return(false, nil)
+	deps.jwtService.EXPECT().GenerateMFAToken(user.ID).Return("challenge", int64(1234), nil)
+
+	redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(-2)
+	redisMock.ExpectTTL("lockout:lock:ip:" + lockoutIP).SetVal(-2)
+	redisMock.ExpectIncr("lockout:fail:account:" + lockoutEmail).SetVal(1)
+	redisMock.ExpectExpire("lockout:fail:account:"+lockoutEmail, 15*time.Minute).SetVal(true)
+	redisMock.ExpectIncr("lockout:fail:ip:" + lockoutIP).SetVal(1)
+	redisMock.ExpectExpire("lockout:fail:ip:"+lockoutIP, 15*time.Minute).SetVal(true)
+	redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(-2)
+	redisMock.ExpectTTL("lockout:lock:ip:" + lockoutIP).SetVal(-2)
+	redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(-2)
+	redisMock.ExpectTTL("lockout:lock:ip:" + lockoutIP).SetVal(-2)
+	redisMock.ExpectIncr("lockout:fail:account:" + lockoutEmail).SetVal(2)
+	redisMock.ExpectIncr("lockout:fail:ip:" + lockoutIP).SetVal(2)
+
+	_, err := uc.LoginTwoFactor(context.Background(), "challenge", "not-a-code", lockoutIP, "agent")
+	require.ErrorIs(t, err, entityError.ErrInvalidTwoFactorCode)
+	res, err := uc.Login(context.Background(), lockoutEmail, "correct", lockoutIP, "agent")
+	require.NoError(t, err)
+	require.Equal(t, "challenge", res.ChallengeToken)
+	_, err = uc.LoginTwoFactor(context.Background(), "challenge", "not-a-code", lockoutIP, "agent")
+	require.ErrorIs(t, err, entityError.ErrInvalidTwoFactorCode)
+
+	assert.NoError(t, redisMock.ExpectationsWereMet(), "the password login must not delete the failure counter")
+}
+
+func TestUserUseCase_LoginTwoFactor_SuccessClearsFailures(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateUseCaseWithLockout(h.CreateLockoutUseCase(redisClient))
+	user := h.NewUser("alice", lockoutEmail, "")
+	tf, secret := h.NewTwoFactor(user.ID, true)
+
+	deps.jwtService.EXPECT().ValidateMFAToken("challenge").Return(&jwt.CustomClaims{UserID: user.ID.String(), TokenType: jwt.TokenTypeMFA}, nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().ConsumeStep(mock.Anything, user.ID, mock.Anything).Return(true, nil)
+	deps.jwtService.EXPECT().GenerateTokenPair(user.ID, user.Email, user.Username, user.Role).Return(&jwt.TokenPair{AccessToken: "access", FamilyID: uuid.New()}, nil)
+	deps.sessionRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	redisMock.ExpectTTL("lockout:lock:account:" + lockoutEmail).SetVal(-2)
+	redisMock.ExpectTTL("lockout:lock:ip:" + lockoutIP).SetVal(-2)
+	redisMock.ExpectDel("lockout:fail:account:" + lockoutEmail).SetVal(1)
+
+	_, err := uc.LoginTwoFactor(context.Background(), "challenge", h.CurrentCode(secret), lockoutIP, "agent")
+
+	require.NoError(t, err)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
@@ -97,6 +97,63 @@ func (_c *MockAPITokenRepository_Create_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// CreateRequest provides a mock function for the type MockAPITokenRepository
+func (_mock *MockAPITokenRepository) CreateRequest(ctx context.Context, req *entity.APITokenRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.APITokenRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPITokenRepository_CreateRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRequest'
+type MockAPITokenRepository_CreateRequest_Call struct {
+	*mock.Call
+}
+
+// CreateRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *entity.APITokenRequest
+func (_e *MockAPITokenRepository_Expecter) CreateRequest(ctx interface{}, req interface{}) *MockAPITokenRepository_CreateRequest_Call {
+	return &MockAPITokenRepository_CreateRequest_Call{Call: _e.mock.On("CreateRequest", ctx, req)}
+}
+
+func (_c *MockAPITokenRepository_CreateRequest_Call) Run(run func(ctx context.Context, req *entity.APITokenRequest)) *MockAPITokenRepository_CreateRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entity.APITokenRequest
+		if args[1] != nil {
+			arg1 = args[1].(*entity.APITokenRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPITokenRepository_CreateRequest_Call) Return(err error) *MockAPITokenRepository_CreateRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPITokenRepository_CreateRequest_Call) RunAndReturn(run func(ctx context.Context, req *entity.APITokenRequest) error) *MockAPITokenRepository_CreateRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type MockAPITokenRepository
 func (_mock *MockAPITokenRepository) Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
 	ret := _mock.Called(ctx, id, userID)
@@ -160,6 +217,131 @@ func (_c *MockAPITokenRepository_Delete_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// DeleteServiceToken provides a mock function for the type MockAPITokenRepository
+func (_mock *MockAPITokenRepository) DeleteServiceToken(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServiceToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPITokenRepository_DeleteServiceToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceToken'
+type MockAPITokenRepository_DeleteServiceToken_Call struct {
+	*mock.Call
+}
+
+// DeleteServiceToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPITokenRepository_Expecter) DeleteServiceToken(ctx interface{}, id interface{}) *MockAPITokenRepository_DeleteServiceToken_Call {
+	return &MockAPITokenRepository_DeleteServiceToken_Call{Call: _e.mock.On("DeleteServiceToken", ctx, id)}
+}
+
+func (_c *MockAPITokenRepository_DeleteServiceToken_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPITokenRepository_DeleteServiceToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPITokenRepository_DeleteServiceToken_Call) Return(err error) *MockAPITokenRepository_DeleteServiceToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPITokenRepository_DeleteServiceToken_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockAPITokenRepository_DeleteServiceToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockAPITokenRepository
+func (_mock *MockAPITokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.APIToken, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *entity.APIToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.APIToken, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.APIToken); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.APIToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPITokenRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockAPITokenRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPITokenRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockAPITokenRepository_GetByID_Call {
+	return &MockAPITokenRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockAPITokenRepository_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPITokenRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPITokenRepository_GetByID_Call) Return(aPIToken *entity.APIToken, err error) *MockAPITokenRepository_GetByID_Call {
+	_c.Call.Return(aPIToken, err)
+	return _c
+}
+
+func (_c *MockAPITokenRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*entity.APIToken, error)) *MockAPITokenRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByTokenHash provides a mock function for the type MockAPITokenRepository
 func (_mock *MockAPITokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.APIToken, error) {
 	ret := _mock.Called(ctx, tokenHash)
@@ -296,6 +478,142 @@ func (_c *MockAPITokenRepository_GetByUserID_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetRequests provides a mock function for the type MockAPITokenRepository
+func (_mock *MockAPITokenRepository) GetRequests(ctx context.Context, tokenID uuid.UUID, limit int) ([]*entity.APITokenRequest, error) {
+	ret := _mock.Called(ctx, tokenID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequests")
+	}
+
+	var r0 []*entity.APITokenRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]*entity.APITokenRequest, error)); ok {
+		return returnFunc(ctx, tokenID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []*entity.APITokenRequest); ok {
+		r0 = returnFunc(ctx, tokenID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.APITokenRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, tokenID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPITokenRepository_GetRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequests'
+type MockAPITokenRepository_GetRequests_Call struct {
+	*mock.Call
+}
+
+// GetRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID uuid.UUID
+//   - limit int
+func (_e *MockAPITokenRepository_Expecter) GetRequests(ctx interface{}, tokenID interface{}, limit interface{}) *MockAPITokenRepository_GetRequests_Call {
+	return &MockAPITokenRepository_GetRequests_Call{Call: _e.mock.On("GetRequests", ctx, tokenID, limit)}
+}
+
+func (_c *MockAPITokenRepository_GetRequests_Call) Run(run func(ctx context.Context, tokenID uuid.UUID, limit int)) *MockAPITokenRepository_GetRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPITokenRepository_GetRequests_Call) Return(aPITokenRequests []*entity.APITokenRequest, err error) *MockAPITokenRepository_GetRequests_Call {
+	_c.Call.Return(aPITokenRequests, err)
+	return _c
+}
+
+func (_c *MockAPITokenRepository_GetRequests_Call) RunAndReturn(run func(ctx context.Context, tokenID uuid.UUID, limit int) ([]*entity.APITokenRequest, error)) *MockAPITokenRepository_GetRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceTokens provides a mock function for the type MockAPITokenRepository
+func (_mock *MockAPITokenRepository) GetServiceTokens(ctx context.Context) ([]*entity.APIToken, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceTokens")
+	}
+
+	var r0 []*entity.APIToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*entity.APIToken, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*entity.APIToken); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.APIToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPITokenRepository_GetServiceTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceTokens'
+type MockAPITokenRepository_GetServiceTokens_Call struct {
+	*mock.Call
+}
+
+// GetServiceTokens is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPITokenRepository_Expecter) GetServiceTokens(ctx interface{}) *MockAPITokenRepository_GetServiceTokens_Call {
+	return &MockAPITokenRepository_GetServiceTokens_Call{Call: _e.mock.On("GetServiceTokens", ctx)}
+}
+
+func (_c *MockAPITokenRepository_GetServiceTokens_Call) Run(run func(ctx context.Context)) *MockAPITokenRepository_GetServiceTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPITokenRepository_GetServiceTokens_Call) Return(aPITokens []*entity.APIToken, err error) *MockAPITokenRepository_GetServiceTokens_Call {
+	_c.Call.Return(aPITokens, err)
+	return _c
+}
+
+func (_c *MockAPITokenRepository_GetServiceTokens_Call) RunAndReturn(run func(ctx context.Context) ([]*entity.APIToken, error)) *MockAPITokenRepository_GetServiceTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateLastUsedAt provides a mock function for the type MockAPITokenRepository
 func (_mock *MockAPITokenRepository) UpdateLastUsedAt(ctx context.Context, id uuid.UUID, at time.Time) error {
 	ret := _mock.Called(ctx, id, at)
@@ -37,6 +37,72 @@ func (_m *MockJWTService) EXPECT() *MockJWTService_Expecter {
 	return &MockJWTService_Expecter{mock: &_m.Mock}
 }
 
+// GenerateMFAToken provides a mock function for the type MockJWTService
+func (_mock *MockJWTService) GenerateMFAToken(userID uuid.UUID) (string, int64, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateMFAToken")
+	}
+
+	var r0 string
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) (string, int64, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) string); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) int64); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(uuid.UUID) error); ok {
+		r2 = returnFunc(userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockJWTService_GenerateMFAToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateMFAToken'
+type MockJWTService_GenerateMFAToken_Call struct {
+	*mock.Call
+}
+
+// GenerateMFAToken is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockJWTService_Expecter) GenerateMFAToken(userID interface{}) *MockJWTService_GenerateMFAToken_Call {
+	return &MockJWTService_GenerateMFAToken_Call{Call: _e.mock.On("GenerateMFAToken", userID)}
+}
+
+func (_c *MockJWTService_GenerateMFAToken_Call) Run(run func(userID uuid.UUID)) *MockJWTService_GenerateMFAToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uuid.UUID
+		if args[0] != nil {
+			arg0 = args[0].(uuid.UUID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJWTService_GenerateMFAToken_Call) Return(s string, n int64, err error) *MockJWTService_GenerateMFAToken_Call {
+	_c.Call.Return(s, n, err)
+	return _c
+}
+
+func (_c *MockJWTService_GenerateMFAToken_Call) RunAndReturn(run func(userID uuid.UUID) (string, int64, error)) *MockJWTService_GenerateMFAToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateTokenPair provides a mock function for the type MockJWTService
 func (_mock *MockJWTService) GenerateTokenPair(userID uuid.UUID, email string, name string, role string) (*jwt.TokenPair, error) {
 	ret := _mock.Called(userID, email, name, role)
@@ -117,6 +183,92 @@ func (_c *MockJWTService_GenerateTokenPair_Call) RunAndReturn(run func(userID uu
 	return _c
 }
 
+// GenerateTokenPairForFamily provides a mock function for the type MockJWTService
+func (_mock *MockJWTService) GenerateTokenPairForFamily(userID uuid.UUID, email string, name string, role string, familyID uuid.UUID) (*jwt.TokenPair, error) {
+	ret := _mock.Called(userID, email, name, role, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateTokenPairForFamily")
+	}
+
+	var r0 *jwt.TokenPair
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string, string, uuid.UUID) (*jwt.TokenPair, error)); ok {
+		return returnFunc(userID, email, name, role, familyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string, string, string, uuid.UUID) *jwt.TokenPair); ok {
+		r0 = returnFunc(userID, email, name, role, familyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jwt.TokenPair)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uuid.UUID, string, string, string, uuid.UUID) error); ok {
+		r1 = returnFunc(userID, email, name, role, familyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJWTService_GenerateTokenPairForFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateTokenPairForFamily'
+type MockJWTService_GenerateTokenPairForFamily_Call struct {
+	*mock.Call
+}
+
+// GenerateTokenPairForFamily is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - email string
+//   - name string
+//   - role string
+//   - familyID uuid.UUID
+func (_e *MockJWTService_Expecter) GenerateTokenPairForFamily(userID interface{}, email interface{}, name interface{}, role interface{}, familyID interface{}) *MockJWTService_GenerateTokenPairForFamily_Call {
+	return &MockJWTService_GenerateTokenPairForFamily_Call{Call: _e.mock.On("GenerateTokenPairForFamily", userID, email, name, role, familyID)}
+}
+
+func (_c *MockJWTService_GenerateTokenPairForFamily_Call) Run(run func(userID uuid.UUID, email string, name string, role string, familyID uuid.UUID)) *MockJWTService_GenerateTokenPairForFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uuid.UUID
+		if args[0] != nil {
+			arg0 = args[0].(uuid.UUID)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 uuid.UUID
+		if args[4] != nil {
+			arg4 = args[4].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJWTService_GenerateTokenPairForFamily_Call) Return(tokenPair *jwt.TokenPair, err error) *MockJWTService_GenerateTokenPairForFamily_Call {
+	_c.Call.Return(tokenPair, err)
+	return _c
+}
+
+func (_c *MockJWTService_GenerateTokenPairForFamily_Call) RunAndReturn(run func(userID uuid.UUID, email string, name string, role string, familyID uuid.UUID) (*jwt.TokenPair, error)) *MockJWTService_GenerateTokenPairForFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshTokens provides a mock function for the type MockJWTService
 func (_mock *MockJWTService) RefreshTokens(refreshTokenString string) (*jwt.TokenPair, error) {
 	ret := _mock.Called(refreshTokenString)
@@ -241,6 +393,68 @@ func (_c *MockJWTService_ValidateAccessToken_Call) RunAndReturn(run func(tokenSt
 	return _c
 }
 
+// ValidateMFAToken provides a mock function for the type MockJWTService
+func (_mock *MockJWTService) ValidateMFAToken(tokenString string) (*jwt.CustomClaims, error) {
+	ret := _mock.Called(tokenString)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateMFAToken")
+	}
+
+	var r0 *jwt.CustomClaims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*jwt.CustomClaims, error)); ok {
+		return returnFunc(tokenString)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *jwt.CustomClaims); ok {
+		r0 = returnFunc(tokenString)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jwt.CustomClaims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(tokenString)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJWTService_ValidateMFAToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateMFAToken'
+type MockJWTService_ValidateMFAToken_Call struct {
+	*mock.Call
+}
+
+// ValidateMFAToken is a helper method to define mock.On call
+//   - tokenString string
+func (_e *MockJWTService_Expecter) ValidateMFAToken(tokenString interface{}) *MockJWTService_ValidateMFAToken_Call {
+	return &MockJWTService_ValidateMFAToken_Call{Call: _e.mock.On("ValidateMFAToken", tokenString)}
+}
+
+func (_c *MockJWTService_ValidateMFAToken_Call) Run(run func(tokenString string)) *MockJWTService_ValidateMFAToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJWTService_ValidateMFAToken_Call) Return(customClaims *jwt.CustomClaims, err error) *MockJWTService_ValidateMFAToken_Call {
+	_c.Call.Return(customClaims, err)
+	return _c
+}
+
+func (_c *MockJWTService_ValidateMFAToken_Call) RunAndReturn(run func(tokenString string) (*jwt.CustomClaims, error)) *MockJWTService_ValidateMFAToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateRefreshToken provides a mock function for the type MockJWTService
 func (_mock *MockJWTService) ValidateRefreshToken(tokenString string) (*jwt.CustomClaims, error) {
 	ret := _mock.Called(tokenString)
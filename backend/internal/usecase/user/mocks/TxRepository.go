@@ -40,6 +40,150 @@ func (_m *MockTxRepository) EXPECT() *MockTxRepository_Expecter {
 	return &MockTxRepository_Expecter{mock: &_m.Mock}
 }
 
+// AnonymizeUserTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) AnonymizeUserTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, username string, email string) error {
+	ret := _mock.Called(ctx, tx, userID, username, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnonymizeUserTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID, string, string) error); ok {
+		r0 = returnFunc(ctx, tx, userID, username, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_AnonymizeUserTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnonymizeUserTx'
+type MockTxRepository_AnonymizeUserTx_Call struct {
+	*mock.Call
+}
+
+// AnonymizeUserTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - userID uuid.UUID
+//   - username string
+//   - email string
+func (_e *MockTxRepository_Expecter) AnonymizeUserTx(ctx interface{}, tx interface{}, userID interface{}, username interface{}, email interface{}) *MockTxRepository_AnonymizeUserTx_Call {
+	return &MockTxRepository_AnonymizeUserTx_Call{Call: _e.mock.On("AnonymizeUserTx", ctx, tx, userID, username, email)}
+}
+
+func (_c *MockTxRepository_AnonymizeUserTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID, username string, email string)) *MockTxRepository_AnonymizeUserTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_AnonymizeUserTx_Call) Return(err error) *MockTxRepository_AnonymizeUserTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_AnonymizeUserTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID, username string, email string) error) *MockTxRepository_AnonymizeUserTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BanUserTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) BanUserTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, reason string) error {
+	ret := _mock.Called(ctx, tx, userID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BanUserTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, tx, userID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_BanUserTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BanUserTx'
+type MockTxRepository_BanUserTx_Call struct {
+	*mock.Call
+}
+
+// BanUserTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - userID uuid.UUID
+//   - reason string
+func (_e *MockTxRepository_Expecter) BanUserTx(ctx interface{}, tx interface{}, userID interface{}, reason interface{}) *MockTxRepository_BanUserTx_Call {
+	return &MockTxRepository_BanUserTx_Call{Call: _e.mock.On("BanUserTx", ctx, tx, userID, reason)}
+}
+
+func (_c *MockTxRepository_BanUserTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID, reason string)) *MockTxRepository_BanUserTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_BanUserTx_Call) Return(err error) *MockTxRepository_BanUserTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_BanUserTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID, reason string) error) *MockTxRepository_BanUserTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BeginSerializableTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) BeginSerializableTx(ctx context.Context) (repo.Transaction, error) {
 	ret := _mock.Called(ctx)
@@ -164,6 +308,80 @@ func (_c *MockTxRepository_BeginTx_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// ConsumeRegistrationInviteTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) ConsumeRegistrationInviteTx(ctx context.Context, tx repo.Transaction, code string) (*entity.RegistrationInvite, error) {
+	ret := _mock.Called(ctx, tx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeRegistrationInviteTx")
+	}
+
+	var r0 *entity.RegistrationInvite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, string) (*entity.RegistrationInvite, error)); ok {
+		return returnFunc(ctx, tx, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, string) *entity.RegistrationInvite); ok {
+		r0 = returnFunc(ctx, tx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.RegistrationInvite)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Transaction, string) error); ok {
+		r1 = returnFunc(ctx, tx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTxRepository_ConsumeRegistrationInviteTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeRegistrationInviteTx'
+type MockTxRepository_ConsumeRegistrationInviteTx_Call struct {
+	*mock.Call
+}
+
+// ConsumeRegistrationInviteTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - code string
+func (_e *MockTxRepository_Expecter) ConsumeRegistrationInviteTx(ctx interface{}, tx interface{}, code interface{}) *MockTxRepository_ConsumeRegistrationInviteTx_Call {
+	return &MockTxRepository_ConsumeRegistrationInviteTx_Call{Call: _e.mock.On("ConsumeRegistrationInviteTx", ctx, tx, code)}
+}
+
+func (_c *MockTxRepository_ConsumeRegistrationInviteTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, code string)) *MockTxRepository_ConsumeRegistrationInviteTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_ConsumeRegistrationInviteTx_Call) Return(registrationInvite *entity.RegistrationInvite, err error) *MockTxRepository_ConsumeRegistrationInviteTx_Call {
+	_c.Call.Return(registrationInvite, err)
+	return _c
+}
+
+func (_c *MockTxRepository_ConsumeRegistrationInviteTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, code string) (*entity.RegistrationInvite, error)) *MockTxRepository_ConsumeRegistrationInviteTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAuditLogTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) CreateAuditLogTx(ctx context.Context, tx repo.Transaction, log *entity.AuditLog) error {
 	ret := _mock.Called(ctx, tx, log)
@@ -359,6 +577,69 @@ func (_c *MockTxRepository_CreateHintUnlockTx_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// CreateRoleTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) CreateRoleTx(ctx context.Context, tx repo.Transaction, role *entity.Role) error {
+	ret := _mock.Called(ctx, tx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRoleTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, *entity.Role) error); ok {
+		r0 = returnFunc(ctx, tx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_CreateRoleTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoleTx'
+type MockTxRepository_CreateRoleTx_Call struct {
+	*mock.Call
+}
+
+// CreateRoleTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - role *entity.Role
+func (_e *MockTxRepository_Expecter) CreateRoleTx(ctx interface{}, tx interface{}, role interface{}) *MockTxRepository_CreateRoleTx_Call {
+	return &MockTxRepository_CreateRoleTx_Call{Call: _e.mock.On("CreateRoleTx", ctx, tx, role)}
+}
+
+func (_c *MockTxRepository_CreateRoleTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, role *entity.Role)) *MockTxRepository_CreateRoleTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 *entity.Role
+		if args[2] != nil {
+			arg2 = args[2].(*entity.Role)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_CreateRoleTx_Call) Return(err error) *MockTxRepository_CreateRoleTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_CreateRoleTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, role *entity.Role) error) *MockTxRepository_CreateRoleTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSolveTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) CreateSolveTx(ctx context.Context, tx repo.Transaction, solve *entity.Solve) error {
 	ret := _mock.Called(ctx, tx, solve)
@@ -548,6 +829,69 @@ func (_c *MockTxRepository_CreateTeamTx_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// CreateUserIdentityTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) CreateUserIdentityTx(ctx context.Context, tx repo.Transaction, identity *entity.UserIdentity) error {
+	ret := _mock.Called(ctx, tx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserIdentityTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, *entity.UserIdentity) error); ok {
+		r0 = returnFunc(ctx, tx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_CreateUserIdentityTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserIdentityTx'
+type MockTxRepository_CreateUserIdentityTx_Call struct {
+	*mock.Call
+}
+
+// CreateUserIdentityTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - identity *entity.UserIdentity
+func (_e *MockTxRepository_Expecter) CreateUserIdentityTx(ctx interface{}, tx interface{}, identity interface{}) *MockTxRepository_CreateUserIdentityTx_Call {
+	return &MockTxRepository_CreateUserIdentityTx_Call{Call: _e.mock.On("CreateUserIdentityTx", ctx, tx, identity)}
+}
+
+func (_c *MockTxRepository_CreateUserIdentityTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, identity *entity.UserIdentity)) *MockTxRepository_CreateUserIdentityTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 *entity.UserIdentity
+		if args[2] != nil {
+			arg2 = args[2].(*entity.UserIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_CreateUserIdentityTx_Call) Return(err error) *MockTxRepository_CreateUserIdentityTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_CreateUserIdentityTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, identity *entity.UserIdentity) error) *MockTxRepository_CreateUserIdentityTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUserTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) CreateUserTx(ctx context.Context, tx repo.Transaction, user *entity.User) error {
 	ret := _mock.Called(ctx, tx, user)
@@ -611,6 +955,78 @@ func (_c *MockTxRepository_CreateUserTx_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// DecrementChallengeSolveCountTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) DecrementChallengeSolveCountTx(ctx context.Context, tx repo.Transaction, ID uuid.UUID) (int, error) {
+	ret := _mock.Called(ctx, tx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecrementChallengeSolveCountTx")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID) (int, error)); ok {
+		return returnFunc(ctx, tx, ID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID) int); ok {
+		r0 = returnFunc(ctx, tx, ID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Transaction, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, tx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTxRepository_DecrementChallengeSolveCountTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecrementChallengeSolveCountTx'
+type MockTxRepository_DecrementChallengeSolveCountTx_Call struct {
+	*mock.Call
+}
+
+// DecrementChallengeSolveCountTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - ID uuid.UUID
+func (_e *MockTxRepository_Expecter) DecrementChallengeSolveCountTx(ctx interface{}, tx interface{}, ID interface{}) *MockTxRepository_DecrementChallengeSolveCountTx_Call {
+	return &MockTxRepository_DecrementChallengeSolveCountTx_Call{Call: _e.mock.On("DecrementChallengeSolveCountTx", ctx, tx, ID)}
+}
+
+func (_c *MockTxRepository_DecrementChallengeSolveCountTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, ID uuid.UUID)) *MockTxRepository_DecrementChallengeSolveCountTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_DecrementChallengeSolveCountTx_Call) Return(n int, err error) *MockTxRepository_DecrementChallengeSolveCountTx_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTxRepository_DecrementChallengeSolveCountTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, ID uuid.UUID) (int, error)) *MockTxRepository_DecrementChallengeSolveCountTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteChallengeTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) DeleteChallengeTx(ctx context.Context, tx repo.Transaction, challengeID uuid.UUID) error {
 	ret := _mock.Called(ctx, tx, challengeID)
@@ -674,6 +1090,132 @@ func (_c *MockTxRepository_DeleteChallengeTx_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// DeleteRoleTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) DeleteRoleTx(ctx context.Context, tx repo.Transaction, name string) error {
+	ret := _mock.Called(ctx, tx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoleTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, string) error); ok {
+		r0 = returnFunc(ctx, tx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_DeleteRoleTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleTx'
+type MockTxRepository_DeleteRoleTx_Call struct {
+	*mock.Call
+}
+
+// DeleteRoleTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - name string
+func (_e *MockTxRepository_Expecter) DeleteRoleTx(ctx interface{}, tx interface{}, name interface{}) *MockTxRepository_DeleteRoleTx_Call {
+	return &MockTxRepository_DeleteRoleTx_Call{Call: _e.mock.On("DeleteRoleTx", ctx, tx, name)}
+}
+
+func (_c *MockTxRepository_DeleteRoleTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, name string)) *MockTxRepository_DeleteRoleTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_DeleteRoleTx_Call) Return(err error) *MockTxRepository_DeleteRoleTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_DeleteRoleTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, name string) error) *MockTxRepository_DeleteRoleTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSolveTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) DeleteSolveTx(ctx context.Context, tx repo.Transaction, solveID uuid.UUID) error {
+	ret := _mock.Called(ctx, tx, solveID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSolveTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, tx, solveID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_DeleteSolveTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSolveTx'
+type MockTxRepository_DeleteSolveTx_Call struct {
+	*mock.Call
+}
+
+// DeleteSolveTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - solveID uuid.UUID
+func (_e *MockTxRepository_Expecter) DeleteSolveTx(ctx interface{}, tx interface{}, solveID interface{}) *MockTxRepository_DeleteSolveTx_Call {
+	return &MockTxRepository_DeleteSolveTx_Call{Call: _e.mock.On("DeleteSolveTx", ctx, tx, solveID)}
+}
+
+func (_c *MockTxRepository_DeleteSolveTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, solveID uuid.UUID)) *MockTxRepository_DeleteSolveTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_DeleteSolveTx_Call) Return(err error) *MockTxRepository_DeleteSolveTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_DeleteSolveTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, solveID uuid.UUID) error) *MockTxRepository_DeleteSolveTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteSolvesByTeamIDTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) DeleteSolvesByTeamIDTx(ctx context.Context, tx repo.Transaction, teamID uuid.UUID) error {
 	ret := _mock.Called(ctx, tx, teamID)
@@ -790,12 +1332,75 @@ func (_c *MockTxRepository_DeleteTeamTx_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockTxRepository_DeleteTeamTx_Call) Return(err error) *MockTxRepository_DeleteTeamTx_Call {
+func (_c *MockTxRepository_DeleteTeamTx_Call) Return(err error) *MockTxRepository_DeleteTeamTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_DeleteTeamTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, teamID uuid.UUID) error) *MockTxRepository_DeleteTeamTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) DeleteUserTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, tx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, tx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_DeleteUserTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserTx'
+type MockTxRepository_DeleteUserTx_Call struct {
+	*mock.Call
+}
+
+// DeleteUserTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - userID uuid.UUID
+func (_e *MockTxRepository_Expecter) DeleteUserTx(ctx interface{}, tx interface{}, userID interface{}) *MockTxRepository_DeleteUserTx_Call {
+	return &MockTxRepository_DeleteUserTx_Call{Call: _e.mock.On("DeleteUserTx", ctx, tx, userID)}
+}
+
+func (_c *MockTxRepository_DeleteUserTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID)) *MockTxRepository_DeleteUserTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_DeleteUserTx_Call) Return(err error) *MockTxRepository_DeleteUserTx_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockTxRepository_DeleteTeamTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, teamID uuid.UUID) error) *MockTxRepository_DeleteTeamTx_Call {
+func (_c *MockTxRepository_DeleteUserTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID) error) *MockTxRepository_DeleteUserTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1794,6 +2399,69 @@ func (_c *MockTxRepository_SoftDeleteTeamTx_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// UnbanUserTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) UnbanUserTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, tx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnbanUserTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, tx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_UnbanUserTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnbanUserTx'
+type MockTxRepository_UnbanUserTx_Call struct {
+	*mock.Call
+}
+
+// UnbanUserTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - userID uuid.UUID
+func (_e *MockTxRepository_Expecter) UnbanUserTx(ctx interface{}, tx interface{}, userID interface{}) *MockTxRepository_UnbanUserTx_Call {
+	return &MockTxRepository_UnbanUserTx_Call{Call: _e.mock.On("UnbanUserTx", ctx, tx, userID)}
+}
+
+func (_c *MockTxRepository_UnbanUserTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID)) *MockTxRepository_UnbanUserTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_UnbanUserTx_Call) Return(err error) *MockTxRepository_UnbanUserTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_UnbanUserTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID) error) *MockTxRepository_UnbanUserTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChallengePointsTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) UpdateChallengePointsTx(ctx context.Context, tx repo.Transaction, ID uuid.UUID, points int) error {
 	ret := _mock.Called(ctx, tx, ID, points)
@@ -1863,6 +2531,69 @@ func (_c *MockTxRepository_UpdateChallengePointsTx_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// UpdateRoleTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) UpdateRoleTx(ctx context.Context, tx repo.Transaction, role *entity.Role) error {
+	ret := _mock.Called(ctx, tx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRoleTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, *entity.Role) error); ok {
+		r0 = returnFunc(ctx, tx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_UpdateRoleTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoleTx'
+type MockTxRepository_UpdateRoleTx_Call struct {
+	*mock.Call
+}
+
+// UpdateRoleTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - role *entity.Role
+func (_e *MockTxRepository_Expecter) UpdateRoleTx(ctx interface{}, tx interface{}, role interface{}) *MockTxRepository_UpdateRoleTx_Call {
+	return &MockTxRepository_UpdateRoleTx_Call{Call: _e.mock.On("UpdateRoleTx", ctx, tx, role)}
+}
+
+func (_c *MockTxRepository_UpdateRoleTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, role *entity.Role)) *MockTxRepository_UpdateRoleTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 *entity.Role
+		if args[2] != nil {
+			arg2 = args[2].(*entity.Role)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_UpdateRoleTx_Call) Return(err error) *MockTxRepository_UpdateRoleTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_UpdateRoleTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, role *entity.Role) error) *MockTxRepository_UpdateRoleTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTeamCaptainTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) UpdateTeamCaptainTx(ctx context.Context, tx repo.Transaction, teamID uuid.UUID, newCaptainID uuid.UUID) error {
 	ret := _mock.Called(ctx, tx, teamID, newCaptainID)
@@ -1932,6 +2663,144 @@ func (_c *MockTxRepository_UpdateTeamCaptainTx_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// UpdateUserPasswordTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) UpdateUserPasswordTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, passwordHash string) error {
+	ret := _mock.Called(ctx, tx, userID, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserPasswordTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, tx, userID, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_UpdateUserPasswordTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPasswordTx'
+type MockTxRepository_UpdateUserPasswordTx_Call struct {
+	*mock.Call
+}
+
+// UpdateUserPasswordTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - userID uuid.UUID
+//   - passwordHash string
+func (_e *MockTxRepository_Expecter) UpdateUserPasswordTx(ctx interface{}, tx interface{}, userID interface{}, passwordHash interface{}) *MockTxRepository_UpdateUserPasswordTx_Call {
+	return &MockTxRepository_UpdateUserPasswordTx_Call{Call: _e.mock.On("UpdateUserPasswordTx", ctx, tx, userID, passwordHash)}
+}
+
+func (_c *MockTxRepository_UpdateUserPasswordTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID, passwordHash string)) *MockTxRepository_UpdateUserPasswordTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_UpdateUserPasswordTx_Call) Return(err error) *MockTxRepository_UpdateUserPasswordTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_UpdateUserPasswordTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID, passwordHash string) error) *MockTxRepository_UpdateUserPasswordTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserRoleTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) UpdateUserRoleTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, role string) error {
+	ret := _mock.Called(ctx, tx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRoleTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, tx, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_UpdateUserRoleTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRoleTx'
+type MockTxRepository_UpdateUserRoleTx_Call struct {
+	*mock.Call
+}
+
+// UpdateUserRoleTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - userID uuid.UUID
+//   - role string
+func (_e *MockTxRepository_Expecter) UpdateUserRoleTx(ctx interface{}, tx interface{}, userID interface{}, role interface{}) *MockTxRepository_UpdateUserRoleTx_Call {
+	return &MockTxRepository_UpdateUserRoleTx_Call{Call: _e.mock.On("UpdateUserRoleTx", ctx, tx, userID, role)}
+}
+
+func (_c *MockTxRepository_UpdateUserRoleTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID, role string)) *MockTxRepository_UpdateUserRoleTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_UpdateUserRoleTx_Call) Return(err error) *MockTxRepository_UpdateUserRoleTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_UpdateUserRoleTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, userID uuid.UUID, role string) error) *MockTxRepository_UpdateUserRoleTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUserTeamIDTx provides a mock function for the type MockTxRepository
 func (_mock *MockTxRepository) UpdateUserTeamIDTx(ctx context.Context, tx repo.Transaction, userID uuid.UUID, teamID *uuid.UUID) error {
 	ret := _mock.Called(ctx, tx, userID, teamID)
@@ -2000,3 +2869,66 @@ func (_c *MockTxRepository_UpdateUserTeamIDTx_Call) RunAndReturn(run func(ctx co
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateUserTx provides a mock function for the type MockTxRepository
+func (_mock *MockTxRepository) UpdateUserTx(ctx context.Context, tx repo.Transaction, user *entity.User) error {
+	ret := _mock.Called(ctx, tx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Transaction, *entity.User) error); ok {
+		r0 = returnFunc(ctx, tx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTxRepository_UpdateUserTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserTx'
+type MockTxRepository_UpdateUserTx_Call struct {
+	*mock.Call
+}
+
+// UpdateUserTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repo.Transaction
+//   - user *entity.User
+func (_e *MockTxRepository_Expecter) UpdateUserTx(ctx interface{}, tx interface{}, user interface{}) *MockTxRepository_UpdateUserTx_Call {
+	return &MockTxRepository_UpdateUserTx_Call{Call: _e.mock.On("UpdateUserTx", ctx, tx, user)}
+}
+
+func (_c *MockTxRepository_UpdateUserTx_Call) Run(run func(ctx context.Context, tx repo.Transaction, user *entity.User)) *MockTxRepository_UpdateUserTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repo.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(repo.Transaction)
+		}
+		var arg2 *entity.User
+		if args[2] != nil {
+			arg2 = args[2].(*entity.User)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTxRepository_UpdateUserTx_Call) Return(err error) *MockTxRepository_UpdateUserTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTxRepository_UpdateUserTx_Call) RunAndReturn(run func(ctx context.Context, tx repo.Transaction, user *entity.User) error) *MockTxRepository_UpdateUserTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
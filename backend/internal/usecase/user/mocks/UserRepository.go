@@ -39,6 +39,72 @@ func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
 	return &MockUserRepository_Expecter{mock: &_m.Mock}
 }
 
+// Count provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Count(ctx context.Context, filter *entity.UserFilter) (int64, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.UserFilter) (int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.UserFilter) int64); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entity.UserFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockUserRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *entity.UserFilter
+func (_e *MockUserRepository_Expecter) Count(ctx interface{}, filter interface{}) *MockUserRepository_Count_Call {
+	return &MockUserRepository_Count_Call{Call: _e.mock.On("Count", ctx, filter)}
+}
+
+func (_c *MockUserRepository_Count_Call) Run(run func(ctx context.Context, filter *entity.UserFilter)) *MockUserRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entity.UserFilter
+		if args[1] != nil {
+			arg1 = args[1].(*entity.UserFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Count_Call) Return(n int64, err error) *MockUserRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockUserRepository_Count_Call) RunAndReturn(run func(ctx context.Context, filter *entity.UserFilter) (int64, error)) *MockUserRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockUserRepository
 func (_mock *MockUserRepository) Create(ctx context.Context, user *entity.User) error {
 	ret := _mock.Called(ctx, user)
@@ -430,6 +496,86 @@ func (_c *MockUserRepository_GetByUsername_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// List provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) List(ctx context.Context, filter *entity.UserFilter, limit int, offset int) ([]*entity.User, error) {
+	ret := _mock.Called(ctx, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*entity.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.UserFilter, int, int) ([]*entity.User, error)); ok {
+		return returnFunc(ctx, filter, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.UserFilter, int, int) []*entity.User); ok {
+		r0 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entity.UserFilter, int, int) error); ok {
+		r1 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *entity.UserFilter
+//   - limit int
+//   - offset int
+func (_e *MockUserRepository_Expecter) List(ctx interface{}, filter interface{}, limit interface{}, offset interface{}) *MockUserRepository_List_Call {
+	return &MockUserRepository_List_Call{Call: _e.mock.On("List", ctx, filter, limit, offset)}
+}
+
+func (_c *MockUserRepository_List_Call) Run(run func(ctx context.Context, filter *entity.UserFilter, limit int, offset int)) *MockUserRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entity.UserFilter
+		if args[1] != nil {
+			arg1 = args[1].(*entity.UserFilter)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_List_Call) Return(users []*entity.User, err error) *MockUserRepository_List_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockUserRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter *entity.UserFilter, limit int, offset int) ([]*entity.User, error)) *MockUserRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetVerified provides a mock function for the type MockUserRepository
 func (_mock *MockUserRepository) SetVerified(ctx context.Context, userID uuid.UUID) error {
 	ret := _mock.Called(ctx, userID)
@@ -487,6 +633,69 @@ func (_c *MockUserRepository_SetVerified_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// UpdateEmail provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdateEmail(ctx context.Context, userID uuid.UUID, email string) error {
+	ret := _mock.Called(ctx, userID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdateEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmail'
+type MockUserRepository_UpdateEmail_Call struct {
+	*mock.Call
+}
+
+// UpdateEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - email string
+func (_e *MockUserRepository_Expecter) UpdateEmail(ctx interface{}, userID interface{}, email interface{}) *MockUserRepository_UpdateEmail_Call {
+	return &MockUserRepository_UpdateEmail_Call{Call: _e.mock.On("UpdateEmail", ctx, userID, email)}
+}
+
+func (_c *MockUserRepository_UpdateEmail_Call) Run(run func(ctx context.Context, userID uuid.UUID, email string)) *MockUserRepository_UpdateEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateEmail_Call) Return(err error) *MockUserRepository_UpdateEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateEmail_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, email string) error) *MockUserRepository_UpdateEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePassword provides a mock function for the type MockUserRepository
 func (_mock *MockUserRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
 	ret := _mock.Called(ctx, userID, passwordHash)
@@ -550,6 +759,69 @@ func (_c *MockUserRepository_UpdatePassword_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// UpdateRole provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) UpdateRole(ctx context.Context, userID uuid.UUID, role string) error {
+	ret := _mock.Called(ctx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockUserRepository_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - role string
+func (_e *MockUserRepository_Expecter) UpdateRole(ctx interface{}, userID interface{}, role interface{}) *MockUserRepository_UpdateRole_Call {
+	return &MockUserRepository_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, userID, role)}
+}
+
+func (_c *MockUserRepository_UpdateRole_Call) Run(run func(ctx context.Context, userID uuid.UUID, role string)) *MockUserRepository_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateRole_Call) Return(err error) *MockUserRepository_UpdateRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateRole_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, role string) error) *MockUserRepository_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTeamID provides a mock function for the type MockUserRepository
 func (_mock *MockUserRepository) UpdateTeamID(ctx context.Context, userID uuid.UUID, teamID *uuid.UUID) error {
 	ret := _mock.Called(ctx, userID, teamID)
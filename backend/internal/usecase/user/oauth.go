@@ -0,0 +1,591 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/crypto"
+	"github.com/skr1ms/CTFBoard/pkg/oidc"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+const (
+	oauthStateTTL       = 10 * time.Minute
+	oauthStateKeyPrefix = "oauth:state:"
+	maxUsernameLen      = 32
+)
+
+var (
+	providerNameRe     = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+	usernameDisallowed = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+	defaultOIDCScopes  = []string{"openid", "email", "profile"}
+)
+
+// oauthState is kept in Redis between Authorize and Callback/Link; UserID is set for the link flow.
+type oauthState struct {
+	Provider string     `json:"provider"`
+	Verifier string     `json:"verifier"`
+	Nonce    string     `json:"nonce,omitempty"`
+	UserID   *uuid.UUID `json:"user_id,omitempty"`
+}
+
+type oauthEndpoints struct {
+	issuer   string
+	auth     string
+	token    string
+	userinfo string
+	jwks     string
+}
+
+type OAuthDeps struct {
+	ConfigRepo     repo.ConfigRepository
+	IdentityRepo   repo.UserIdentityRepository
+	UserRepo       repo.UserRepository
+	TxRepo         repo.TxRepository
+	FieldValueRepo repo.FieldValueRepository
+	AuditLogRepo   repo.AuditLogRepository
+	Crypto         crypto.Service
+	Redis          *redis.Client
+	OIDC           *oidc.Client
+	Users          *UserUseCase
+}
+
+type OAuthUseCase struct {
+	deps OAuthDeps
+}
+
+func NewOAuthUseCase(deps OAuthDeps) *OAuthUseCase {
+	return &OAuthUseCase{deps: deps}
+}
+
+// ListProviders returns every configured provider sorted by name; enabledOnly hides disabled ones.
+func (uc *OAuthUseCase) ListProviders(ctx context.Context, enabledOnly bool) ([]*entity.OAuthProvider, error) {
+	configs, err := uc.deps.ConfigRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - ListProviders - GetAll")
+	}
+	providers := make([]*entity.OAuthProvider, 0)
+	for _, cfg := range configs {
+		if !strings.HasPrefix(cfg.Key, entity.OAuthProviderConfigPrefix) {
+			continue
+		}
+		var p entity.OAuthProvider
+		if err := json.Unmarshal([]byte(cfg.Value), &p); err != nil {
+			continue
+		}
+		if enabledOnly && !p.Enabled {
+			continue
+		}
+		providers = append(providers, &p)
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].Name < providers[j].Name })
+	return providers, nil
+}
+
+func (uc *OAuthUseCase) GetProvider(ctx context.Context, name string) (*entity.OAuthProvider, error) {
+	cfg, err := uc.deps.ConfigRepo.GetByKey(ctx, entity.OAuthProviderConfigPrefix+name)
+	if err != nil {
+		if errors.Is(err, entityError.ErrConfigNotFound) {
+			return nil, entityError.ErrOAuthProviderNotFound
+		}
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - GetProvider - GetByKey")
+	}
+	var p entity.OAuthProvider
+	if err := json.Unmarshal([]byte(cfg.Value), &p); err != nil {
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - GetProvider - Unmarshal")
+	}
+	return &p, nil
+}
+
+// SaveProvider validates and stores a provider config. An empty clientSecret keeps the stored one.
+func (uc *OAuthUseCase) SaveProvider(ctx context.Context, p *entity.OAuthProvider, clientSecret string, actorID uuid.UUID, clientIP string) error {
+	if err := normalizeProvider(p); err != nil {
+		return err
+	}
+	if clientSecret != "" {
+		if uc.deps.Crypto == nil {
+			return usecaseutil.Wrap(crypto.ErrServiceNotConfigured, "OAuthUseCase - SaveProvider")
+		}
+		enc, err := uc.deps.Crypto.Encrypt(clientSecret)
+		if err != nil {
+			return usecaseutil.Wrap(err, "OAuthUseCase - SaveProvider - Encrypt")
+		}
+		p.ClientSecretEncrypted = enc
+	} else {
+		existing, err := uc.GetProvider(ctx, p.Name)
+		switch {
+		case err == nil:
+			p.ClientSecretEncrypted = existing.ClientSecretEncrypted
+		case !errors.Is(err, entityError.ErrOAuthProviderNotFound):
+			return usecaseutil.Wrap(err, "OAuthUseCase - SaveProvider")
+		}
+	}
+
+	value, err := json.Marshal(p)
+	if err != nil {
+		return usecaseutil.Wrap(err, "OAuthUseCase - SaveProvider - Marshal")
+	}
+	if err := uc.deps.ConfigRepo.Upsert(ctx, &entity.Config{
+		Key:         p.ConfigKey(),
+		Value:       string(value),
+		ValueType:   entity.ConfigTypeJSON,
+		Description: "Login provider " + p.DisplayName,
+	}); err != nil {
+		return usecaseutil.Wrap(err, "OAuthUseCase - SaveProvider - Upsert")
+	}
+
+	return uc.audit(ctx, entity.AuditActionUpdate, p.Name, actorID, clientIP, "SaveProvider")
+}
+
+func (uc *OAuthUseCase) DeleteProvider(ctx context.Context, name string, actorID uuid.UUID, clientIP string) error {
+	if _, err := uc.GetProvider(ctx, name); err != nil {
+		return err
+	}
+	if err := uc.deps.ConfigRepo.Delete(ctx, entity.OAuthProviderConfigPrefix+name); err != nil {
+		return usecaseutil.Wrap(err, "OAuthUseCase - DeleteProvider - Delete")
+	}
+	return uc.audit(ctx, entity.AuditActionDelete, name, actorID, clientIP, "DeleteProvider")
+}
+
+// Authorize starts an authorization-code flow with PKCE and returns the URL to send the browser to.
+// linkUserID is set when an authenticated user links the provider to their existing account.
+func (uc *OAuthUseCase) Authorize(ctx context.Context, name string, linkUserID *uuid.UUID) (string, error) {
+	p, err := uc.enabledProvider(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	ep, err := uc.endpoints(ctx, p)
+	if err != nil {
+		return "", err
+	}
+
+	verifier, challenge, err := oidc.NewPKCE()
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "OAuthUseCase - Authorize - NewPKCE")
+	}
+	state, err := oidc.RandomString(24)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "OAuthUseCase - Authorize - state")
+	}
+	st := oauthState{Provider: p.Name, Verifier: verifier, UserID: linkUserID}
+	if p.IsOIDC() {
+		if st.Nonce, err = oidc.RandomString(16); err != nil {
+			return "", usecaseutil.Wrap(err, "OAuthUseCase - Authorize - nonce")
+		}
+	}
+
+	raw, err := json.Marshal(st)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "OAuthUseCase - Authorize - Marshal")
+	}
+	if err := uc.deps.Redis.Set(ctx, oauthStateKeyPrefix+state, raw, oauthStateTTL).Err(); err != nil {
+		return "", usecaseutil.Wrap(err, "OAuthUseCase - Authorize - Set state")
+	}
+
+	authURL, err := oidc.AuthCodeURL(ep.auth, oidc.AuthParams{
+		ClientID:      p.ClientID,
+		RedirectURI:   p.RedirectURL,
+		Scopes:        p.Scopes,
+		State:         state,
+		Nonce:         st.Nonce,
+		CodeChallenge: challenge,
+	})
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "OAuthUseCase - Authorize")
+	}
+	return authURL, nil
+}
+
+// Callback completes a login: the identity is resolved by (provider, subject), auto-provisioning
+// a new user when the provider allows it. The result follows the password login contract,
+// including the 2FA challenge for accounts that have it enabled.
+func (uc *OAuthUseCase) Callback(ctx context.Context, name, code, state, clientIP, userAgent string) (*LoginResult, error) {
+	st, err := uc.consumeState(ctx, name, state)
+	if err != nil {
+		return nil, err
+	}
+	if st.UserID != nil {
+		return nil, entityError.ErrInvalidOAuthState
+	}
+	p, err := uc.enabledProvider(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	claims, subject, err := uc.fetchIdentity(ctx, p, code, st)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *entity.User
+	identity, err := uc.deps.IdentityRepo.GetByProviderSubject(ctx, p.Name, subject)
+	switch {
+	case err == nil:
+		if user, err = uc.deps.UserRepo.GetByID(ctx, identity.UserID); err != nil {
+			return nil, usecaseutil.Wrap(err, "OAuthUseCase - Callback - GetByID")
+		}
+		if err := uc.deps.IdentityRepo.TouchLogin(ctx, identity.ID, time.Now()); err != nil {
+			return nil, usecaseutil.Wrap(err, "OAuthUseCase - Callback - TouchLogin")
+		}
+	case errors.Is(err, entityError.ErrIdentityNotFound):
+		if !p.AutoProvision {
+			return nil, entityError.ErrOAuthAccountNotLinked
+		}
+		if user, err = uc.provision(ctx, p, subject, claims); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - Callback - GetByProviderSubject")
+	}
+
+	res, err := uc.deps.Users.completeLogin(ctx, user, clientIP, userAgent)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - Callback")
+	}
+	return res, nil
+}
+
+// Link attaches the provider identity to an already authenticated user.
+func (uc *OAuthUseCase) Link(ctx context.Context, userID uuid.UUID, name, code, state string) (*entity.UserIdentity, error) {
+	st, err := uc.consumeState(ctx, name, state)
+	if err != nil {
+		return nil, err
+	}
+	if st.UserID == nil || *st.UserID != userID {
+		return nil, entityError.ErrInvalidOAuthState
+	}
+	p, err := uc.enabledProvider(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	claims, subject, err := uc.fetchIdentity(ctx, p, code, st)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &entity.UserIdentity{
+		UserID:   userID,
+		Provider: p.Name,
+		Subject:  subject,
+		Email:    claims.String(p.EmailClaim),
+	}
+	if err := uc.deps.IdentityRepo.Create(ctx, identity); err != nil {
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - Link - Create")
+	}
+	return identity, nil
+}
+
+func (uc *OAuthUseCase) Identities(ctx context.Context, userID uuid.UUID) ([]*entity.UserIdentity, error) {
+	identities, err := uc.deps.IdentityRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - Identities")
+	}
+	return identities, nil
+}
+
+// Unlink removes a linked identity unless it is the only way the user can log in.
+func (uc *OAuthUseCase) Unlink(ctx context.Context, userID uuid.UUID, provider string) error {
+	user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "OAuthUseCase - Unlink - GetByID")
+	}
+	if user.PasswordHash == "" {
+		identities, err := uc.deps.IdentityRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "OAuthUseCase - Unlink - GetByUserID")
+		}
+		if !slices.ContainsFunc(identities, func(i *entity.UserIdentity) bool { return i.Provider == provider }) {
+			return entityError.ErrIdentityNotFound
+		}
+		if len(identities) == 1 {
+			return entityError.ErrLastLoginMethod
+		}
+	}
+	if err := uc.deps.IdentityRepo.Delete(ctx, userID, provider); err != nil {
+		return usecaseutil.Wrap(err, "OAuthUseCase - Unlink - Delete")
+	}
+	return nil
+}
+
+func (uc *OAuthUseCase) enabledProvider(ctx context.Context, name string) (*entity.OAuthProvider, error) {
+	p, err := uc.GetProvider(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Enabled {
+		return nil, entityError.ErrOAuthProviderNotFound
+	}
+	return p, nil
+}
+
+func (uc *OAuthUseCase) endpoints(ctx context.Context, p *entity.OAuthProvider) (*oauthEndpoints, error) {
+	if !p.IsOIDC() {
+		return &oauthEndpoints{auth: p.AuthURL, token: p.TokenURL, userinfo: p.UserInfoURL}, nil
+	}
+	md, err := uc.deps.OIDC.Discover(ctx, p.Issuer)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - Discover")
+	}
+	return &oauthEndpoints{
+		issuer:   md.Issuer,
+		auth:     md.AuthorizationEndpoint,
+		token:    md.TokenEndpoint,
+		userinfo: md.UserinfoEndpoint,
+		jwks:     md.JWKSURI,
+	}, nil
+}
+
+func (uc *OAuthUseCase) consumeState(ctx context.Context, name, state string) (*oauthState, error) {
+	if state == "" {
+		return nil, entityError.ErrInvalidOAuthState
+	}
+	raw, err := uc.deps.Redis.GetDel(ctx, oauthStateKeyPrefix+state).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, entityError.ErrInvalidOAuthState
+		}
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - consumeState")
+	}
+	var st oauthState
+	if err := json.Unmarshal(raw, &st); err != nil || st.Provider != name {
+		return nil, entityError.ErrInvalidOAuthState
+	}
+	return &st, nil
+}
+
+// fetchIdentity redeems the code and returns the user's claims and stable subject.
+// OIDC providers are trusted via the verified id_token (topped up from userinfo); plain OAuth2
+// providers via their userinfo endpoint.
+func (uc *OAuthUseCase) fetchIdentity(ctx context.Context, p *entity.OAuthProvider, code string, st *oauthState) (oidc.Claims, string, error) {
+	ep, err := uc.endpoints(ctx, p)
+	if err != nil {
+		return nil, "", err
+	}
+	secret := ""
+	if p.ClientSecretEncrypted != "" {
+		if uc.deps.Crypto == nil {
+			return nil, "", usecaseutil.Wrap(crypto.ErrServiceNotConfigured, "OAuthUseCase - Decrypt client secret")
+		}
+		if secret, err = uc.deps.Crypto.Decrypt(p.ClientSecretEncrypted); err != nil {
+			return nil, "", usecaseutil.Wrap(err, "OAuthUseCase - Decrypt client secret")
+		}
+	}
+
+	tok, err := uc.deps.OIDC.Exchange(ctx, ep.token, oidc.ExchangeParams{
+		ClientID:     p.ClientID,
+		ClientSecret: secret,
+		Code:         code,
+		RedirectURI:  p.RedirectURL,
+		CodeVerifier: st.Verifier,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", entityError.ErrOAuthExchangeFailed, err)
+	}
+
+	var claims oidc.Claims
+	if p.IsOIDC() {
+		claims, err = uc.deps.OIDC.VerifyIDToken(ctx, tok.IDToken, oidc.VerifyParams{
+			JWKSURI:  ep.jwks,
+			Issuer:   ep.issuer,
+			ClientID: p.ClientID,
+			Nonce:    st.Nonce,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %w", entityError.ErrOAuthExchangeFailed, err)
+		}
+		if ep.userinfo != "" && tok.AccessToken != "" {
+			if info, err := uc.deps.OIDC.UserInfo(ctx, ep.userinfo, tok.AccessToken); err == nil {
+				for k, v := range info {
+					if _, ok := claims[k]; !ok {
+						claims[k] = v
+					}
+				}
+			}
+		}
+	} else {
+		if claims, err = uc.deps.OIDC.UserInfo(ctx, ep.userinfo, tok.AccessToken); err != nil {
+			return nil, "", fmt.Errorf("%w: %w", entityError.ErrOAuthExchangeFailed, err)
+		}
+	}
+
+	subject := claims.String(p.SubjectClaim)
+	if subject == "" {
+		return nil, "", fmt.Errorf("%w: missing %q claim", entityError.ErrOAuthExchangeFailed, p.SubjectClaim)
+	}
+	return claims, subject, nil
+}
+
+// provision creates a user and its identity in one transaction, then fills custom fields from claims.
+// It follows the registration rules; there is no way to pass an invite code through the provider.
+func (uc *OAuthUseCase) provision(ctx context.Context, p *entity.OAuthProvider, subject string, claims oidc.Claims) (*entity.User, error) {
+	email := strings.TrimSpace(claims.String(p.EmailClaim))
+	if email == "" {
+		return nil, fmt.Errorf("%w: missing %q claim", entityError.ErrOAuthExchangeFailed, p.EmailClaim)
+	}
+	if _, err := uc.deps.UserRepo.GetByEmail(ctx, email); err == nil {
+		return nil, entityError.ErrOAuthEmailInUse
+	} else if !errors.Is(err, entityError.ErrUserNotFound) {
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - provision - GetByEmail")
+	}
+	if _, err := uc.deps.Users.admit(ctx, email, ""); err != nil {
+		return nil, err
+	}
+	username, err := uc.availableUsername(ctx, p, claims, email)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user := &entity.User{
+		Username: username,
+		Email:    email,
+		Role:     entity.RoleUser,
+	}
+	identity := &entity.UserIdentity{
+		Provider:    p.Name,
+		Subject:     subject,
+		Email:       email,
+		LastLoginAt: &now,
+	}
+	if err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.deps.TxRepo.CreateUserTx(ctx, tx, user); err != nil {
+			return err
+		}
+		identity.UserID = user.ID
+		return uc.deps.TxRepo.CreateUserIdentityTx(ctx, tx, identity)
+	}); err != nil {
+		return nil, usecaseutil.Wrap(err, "OAuthUseCase - provision - Transaction")
+	}
+
+	if claims.Bool("email_verified") {
+		if err := uc.deps.UserRepo.SetVerified(ctx, user.ID); err != nil {
+			return nil, usecaseutil.Wrap(err, "OAuthUseCase - provision - SetVerified")
+		}
+		user.IsVerified = true
+		user.VerifiedAt = &now
+	}
+
+	values := make(map[string]string, len(p.FieldClaims))
+	for fieldID, claim := range p.FieldClaims {
+		if v := claims.String(claim); v != "" {
+			values[fieldID] = v
+		}
+	}
+	if len(values) > 0 && uc.deps.FieldValueRepo != nil {
+		if err := uc.deps.FieldValueRepo.SetValues(ctx, user.ID, values); err != nil {
+			return nil, usecaseutil.Wrap(err, "OAuthUseCase - provision - SetValues")
+		}
+	}
+	return user, nil
+}
+
+// availableUsername derives a username from the configured claim, falling back to the email
+// local part, and appends a random suffix until it is unique.
+func (uc *OAuthUseCase) availableUsername(ctx context.Context, p *entity.OAuthProvider, claims oidc.Claims, email string) (string, error) {
+	base := claims.String(p.UsernameClaim)
+	if base == "" {
+		base, _, _ = strings.Cut(email, "@")
+	}
+	base = usernameDisallowed.ReplaceAllString(base, "_")
+	if base == "" {
+		base = p.Name
+	}
+	if len(base) > maxUsernameLen-5 {
+		base = base[:maxUsernameLen-5]
+	}
+
+	candidate := base
+	for range 5 {
+		_, err := uc.deps.UserRepo.GetByUsername(ctx, candidate)
+		if errors.Is(err, entityError.ErrUserNotFound) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", usecaseutil.Wrap(err, "OAuthUseCase - availableUsername - GetByUsername")
+		}
+		suffix := make([]byte, 2)
+		if _, err := rand.Read(suffix); err != nil {
+			return "", usecaseutil.Wrap(err, "OAuthUseCase - availableUsername")
+		}
+		candidate = base + "_" + hex.EncodeToString(suffix)
+	}
+	return "", fmt.Errorf("%w: username", entityError.ErrUserAlreadyExists)
+}
+
+func (uc *OAuthUseCase) audit(ctx context.Context, action entity.AuditAction, name string, actorID uuid.UUID, clientIP, op string) error {
+	auditLog := &entity.AuditLog{
+		UserID:     &actorID,
+		Action:     action,
+		EntityType: entity.AuditEntityOAuth,
+		EntityID:   name,
+		IP:         clientIP,
+	}
+	if err := uc.deps.AuditLogRepo.Create(ctx, auditLog); err != nil {
+		return usecaseutil.Wrap(err, "OAuthUseCase - "+op+" - Create audit")
+	}
+	return nil
+}
+
+// normalizeProvider validates a provider config and fills in claim and scope defaults.
+func normalizeProvider(p *entity.OAuthProvider) error {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: %s", entityError.ErrInvalidOAuthProvider, reason)
+	}
+	p.Name = strings.ToLower(strings.TrimSpace(p.Name))
+	if !providerNameRe.MatchString(p.Name) {
+		return invalid("name must be 1-32 lowercase letters, digits, '-' or '_'")
+	}
+	if p.DisplayName == "" {
+		p.DisplayName = p.Name
+	}
+	if p.ClientID == "" {
+		return invalid("client_id is required")
+	}
+	if !isHTTPURL(p.RedirectURL) {
+		return invalid("redirect_url must be an absolute http(s) URL")
+	}
+	if p.IsOIDC() {
+		if !isHTTPURL(p.Issuer) {
+			return invalid("issuer must be an absolute http(s) URL")
+		}
+		if len(p.Scopes) == 0 {
+			p.Scopes = defaultOIDCScopes
+		}
+	} else if !isHTTPURL(p.AuthURL) || !isHTTPURL(p.TokenURL) || !isHTTPURL(p.UserInfoURL) {
+		return invalid("either issuer or auth_url, token_url and userinfo_url are required")
+	}
+	for fieldID := range p.FieldClaims {
+		if _, err := uuid.Parse(fieldID); err != nil {
+			return invalid("field_claims keys must be field IDs")
+		}
+	}
+	if p.SubjectClaim == "" {
+		p.SubjectClaim = "sub"
+	}
+	if p.EmailClaim == "" {
+		p.EmailClaim = "email"
+	}
+	if p.UsernameClaim == "" {
+		p.UsernameClaim = "preferred_username"
+	}
+	return nil
+}
+
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
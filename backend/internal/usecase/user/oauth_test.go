@@ -0,0 +1,398 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/jwt"
+	"github.com/skr1ms/CTFBoard/pkg/oidc/oidctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// authorizeAtIssuer runs Authorize, captures the state stored in Redis and lets the issuer
+// consent, returning the code and the state payload for the callback.
+func authorizeAtIssuer(t *testing.T, uc *OAuthUseCase, redisMock redismock.ClientMock, iss *oidctest.Issuer, linkUserID *uuid.UUID) (code, state, payload string) {
+	t.Helper()
+	redisMock.CustomMatch(func(_, actual []any) error {
+		payload = string(actual[2].([]byte)) //nolint:errcheck // test
+		return nil
+	}).ExpectSet("", "", oauthStateTTL).SetVal("OK")
+
+	authURL, err := uc.Authorize(context.Background(), "uni", linkUserID)
+	require.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+
+	code, state, err = iss.Authorize(authURL)
+	require.NoError(t, err)
+	redisMock.ExpectGetDel(oauthStateKeyPrefix + state).SetVal(payload)
+	return code, state, payload
+}
+
+func (h *UserTestHelper) expectOpenSession(userID uuid.UUID) {
+	h.t.Helper()
+	h.deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(nil, entityError.ErrTwoFactorNotEnrolled)
+	h.deps.jwtService.EXPECT().GenerateTokenPair(userID, mock.Anything, mock.Anything, mock.Anything).
+		Return(&jwt.TokenPair{AccessToken: "access", RefreshToken: "refresh", FamilyID: uuid.New()}, nil)
+	h.deps.sessionRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+}
+
+func TestOAuthUseCase_Callback_LinkedIdentity(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	iss := h.NewOIDCIssuer()
+	iss.SetClaims(map[string]any{"sub": "sub-1", "email": "alice@uni.edu"})
+	_, cfg := h.NewOAuthProviderConfig(iss, false, nil)
+	user := h.NewUser("alice", "alice@uni.edu", "")
+	identity := &entity.UserIdentity{ID: uuid.New(), UserID: user.ID, Provider: "uni", Subject: "sub-1"}
+
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+	deps.configRepo.EXPECT().GetByKey(mock.Anything, cfg.Key).Return(cfg, nil)
+	code, state, _ := authorizeAtIssuer(t, uc, redisMock, iss, nil)
+
+	deps.identityRepo.EXPECT().GetByProviderSubject(mock.Anything, "uni", "sub-1").Return(identity, nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.identityRepo.EXPECT().TouchLogin(mock.Anything, identity.ID, mock.Anything).Return(nil)
+	h.expectOpenSession(user.ID)
+
+	res, err := uc.Callback(context.Background(), "uni", code, state, "127.0.0.1", "test-agent")
+
+	require.NoError(t, err)
+	require.NotNil(t, res.Tokens)
+	assert.Equal(t, "access", res.Tokens.AccessToken)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+}
+
+func TestOAuthUseCase_Callback_AutoProvision(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	iss := h.NewOIDCIssuer()
+	fieldID := uuid.New().String()
+	iss.SetClaims(map[string]any{
+		"sub": "sub-2", "email": "bob@uni.edu", "email_verified": true,
+		"preferred_username": "bob smith", "student_id": "S-42",
+	})
+	_, cfg := h.NewOAuthProviderConfig(iss, true, map[string]string{fieldID: "student_id"})
+
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+	deps.configRepo.EXPECT().GetByKey(mock.Anything, cfg.Key).Return(cfg, nil)
+	code, state, _ := authorizeAtIssuer(t, uc, redisMock, iss, nil)
+
+	newID := uuid.New()
+	var created *entity.User
+	var linked *entity.UserIdentity
+	deps.identityRepo.EXPECT().GetByProviderSubject(mock.Anything, "uni", "sub-2").Return(nil, entityError.ErrIdentityNotFound)
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, "bob@uni.edu").Return(nil, entityError.ErrUserNotFound)
+	deps.userRepo.EXPECT().GetByUsername(mock.Anything, "bob_smith").Return(nil, entityError.ErrUserNotFound)
+	deps.txRepo.EXPECT().RunTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context, repo.Transaction) error) error {
+		return fn(ctx, nil)
+	})
+	deps.txRepo.EXPECT().CreateUserTx(mock.Anything, mock.Anything, mock.Anything).Run(func(_ context.Context, _ repo.Transaction, u *entity.User) {
+		u.ID = newID
+		created = u
+	}).Return(nil)
+	deps.txRepo.EXPECT().CreateUserIdentityTx(mock.Anything, mock.Anything, mock.Anything).Run(func(_ context.Context, _ repo.Transaction, i *entity.UserIdentity) {
+		linked = i
+	}).Return(nil)
+	deps.userRepo.EXPECT().SetVerified(mock.Anything, newID).Return(nil)
+	deps.fieldValueRepo.EXPECT().SetValues(mock.Anything, newID, map[string]string{fieldID: "S-42"}).Return(nil)
+	h.expectOpenSession(newID)
+
+	res, err := uc.Callback(context.Background(), "uni", code, state, "127.0.0.1", "test-agent")
+
+	require.NoError(t, err)
+	require.NotNil(t, res.Tokens)
+	require.NotNil(t, created)
+	assert.Equal(t, "bob_smith", created.Username)
+	assert.Equal(t, entity.RoleUser, created.Role)
+	assert.Empty(t, created.PasswordHash)
+	require.NotNil(t, linked)
+	assert.Equal(t, newID, linked.UserID)
+	assert.Equal(t, "sub-2", linked.Subject)
+}
+
+func TestOAuthUseCase_Callback_NotLinked(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	iss := h.NewOIDCIssuer()
+	iss.SetClaims(map[string]any{"sub": "sub-3", "email": "carol@uni.edu"})
+	_, cfg := h.NewOAuthProviderConfig(iss, false, nil)
+
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+	deps.configRepo.EXPECT().GetByKey(mock.Anything, cfg.Key).Return(cfg, nil)
+	code, state, _ := authorizeAtIssuer(t, uc, redisMock, iss, nil)
+	deps.identityRepo.EXPECT().GetByProviderSubject(mock.Anything, "uni", "sub-3").Return(nil, entityError.ErrIdentityNotFound)
+
+	res, err := uc.Callback(context.Background(), "uni", code, state, "127.0.0.1", "test-agent")
+
+	assert.ErrorIs(t, err, entityError.ErrOAuthAccountNotLinked)
+	assert.Nil(t, res)
+}
+
+func TestOAuthUseCase_Callback_EmailInUse(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	iss := h.NewOIDCIssuer()
+	iss.SetClaims(map[string]any{"sub": "sub-4", "email": "dave@uni.edu"})
+	_, cfg := h.NewOAuthProviderConfig(iss, true, nil)
+
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+	deps.configRepo.EXPECT().GetByKey(mock.Anything, cfg.Key).Return(cfg, nil)
+	code, state, _ := authorizeAtIssuer(t, uc, redisMock, iss, nil)
+	deps.identityRepo.EXPECT().GetByProviderSubject(mock.Anything, "uni", "sub-4").Return(nil, entityError.ErrIdentityNotFound)
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, "dave@uni.edu").Return(h.NewUser("dave", "dave@uni.edu", "hash"), nil)
+
+	_, err := uc.Callback(context.Background(), "uni", code, state, "127.0.0.1", "test-agent")
+
+	assert.ErrorIs(t, err, entityError.ErrOAuthEmailInUse)
+}
+
+func TestOAuthUseCase_Callback_InvalidState(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+
+	redisMock.ExpectGetDel(oauthStateKeyPrefix + "unknown").RedisNil()
+	_, err := uc.Callback(context.Background(), "uni", "code", "unknown", "127.0.0.1", "test-agent")
+	assert.ErrorIs(t, err, entityError.ErrInvalidOAuthState)
+
+	_, err = uc.Callback(context.Background(), "uni", "code", "", "127.0.0.1", "test-agent")
+	assert.ErrorIs(t, err, entityError.ErrInvalidOAuthState)
+}
+
+func TestOAuthUseCase_Callback_RejectsLinkState(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	iss := h.NewOIDCIssuer()
+	iss.SetClaims(map[string]any{"sub": "sub-5"})
+	_, cfg := h.NewOAuthProviderConfig(iss, true, nil)
+	userID := uuid.New()
+
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+	deps.configRepo.EXPECT().GetByKey(mock.Anything, cfg.Key).Return(cfg, nil)
+	code, state, _ := authorizeAtIssuer(t, uc, redisMock, iss, &userID)
+
+	_, err := uc.Callback(context.Background(), "uni", code, state, "127.0.0.1", "test-agent")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidOAuthState)
+}
+
+func TestOAuthUseCase_Link_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	iss := h.NewOIDCIssuer()
+	iss.SetClaims(map[string]any{"sub": "sub-6", "email": "erin@uni.edu"})
+	_, cfg := h.NewOAuthProviderConfig(iss, false, nil)
+	userID := uuid.New()
+
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+	deps.configRepo.EXPECT().GetByKey(mock.Anything, cfg.Key).Return(cfg, nil)
+	code, state, _ := authorizeAtIssuer(t, uc, redisMock, iss, &userID)
+	deps.identityRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(i *entity.UserIdentity) bool {
+		return i.UserID == userID && i.Provider == "uni" && i.Subject == "sub-6" && i.Email == "erin@uni.edu"
+	})).Return(nil)
+
+	identity, err := uc.Link(context.Background(), userID, "uni", code, state)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sub-6", identity.Subject)
+}
+
+func TestOAuthUseCase_Link_OtherUsersState(t *testing.T) {
+	h := NewUserTestHelper(t)
+	redisClient, redisMock := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+	owner := uuid.New()
+	payload, err := json.Marshal(oauthState{Provider: "uni", Verifier: "v", UserID: &owner})
+	require.NoError(t, err)
+
+	redisMock.ExpectGetDel(oauthStateKeyPrefix + "s").SetVal(string(payload))
+	_, err = uc.Link(context.Background(), uuid.New(), "uni", "code", "s")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidOAuthState)
+}
+
+func TestOAuthUseCase_Authorize_DisabledProvider(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	iss := h.NewOIDCIssuer()
+	p, _ := h.NewOAuthProviderConfig(iss, false, nil)
+	p.Enabled = false
+	raw, err := json.Marshal(p)
+	require.NoError(t, err)
+	deps.configRepo.EXPECT().GetByKey(mock.Anything, p.ConfigKey()).Return(&entity.Config{Key: p.ConfigKey(), Value: string(raw)}, nil)
+
+	redisClient, _ := redismock.NewClientMock()
+	_, err = h.CreateOAuthUseCase(redisClient).Authorize(context.Background(), "uni", nil)
+
+	assert.ErrorIs(t, err, entityError.ErrOAuthProviderNotFound)
+}
+
+func TestOAuthUseCase_GetProvider_NotFound(t *testing.T) {
+	h := NewUserTestHelper(t)
+	h.Deps().configRepo.EXPECT().GetByKey(mock.Anything, "oauth_provider.missing").Return(nil, entityError.ErrConfigNotFound)
+
+	redisClient, _ := redismock.NewClientMock()
+	_, err := h.CreateOAuthUseCase(redisClient).GetProvider(context.Background(), "missing")
+
+	assert.ErrorIs(t, err, entityError.ErrOAuthProviderNotFound)
+}
+
+func TestOAuthUseCase_ListProviders(t *testing.T) {
+	h := NewUserTestHelper(t)
+	iss := h.NewOIDCIssuer()
+	_, enabled := h.NewOAuthProviderConfig(iss, false, nil)
+	disabled := &entity.Config{Key: "oauth_provider.old", Value: `{"name":"old","enabled":false}`}
+	other := &entity.Config{Key: "max_team_size", Value: "4"}
+	h.Deps().configRepo.EXPECT().GetAll(mock.Anything).Return([]*entity.Config{other, disabled, enabled}, nil)
+
+	redisClient, _ := redismock.NewClientMock()
+	uc := h.CreateOAuthUseCase(redisClient)
+
+	all, err := uc.ListProviders(context.Background(), false)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "old", all[0].Name)
+
+	public, err := uc.ListProviders(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, public, 1)
+	assert.Equal(t, "uni", public[0].Name)
+}
+
+func TestOAuthUseCase_SaveProvider_EncryptsSecret(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	actorID := uuid.New()
+	p := &entity.OAuthProvider{
+		Name: "CTFtime", Enabled: true, ClientID: "id", RedirectURL: "https://board.example.com/oauth/callback",
+		AuthURL: "https://oauth.ctftime.org/authorize", TokenURL: "https://oauth.ctftime.org/token",
+		UserInfoURL: "https://oauth.ctftime.org/user", SubjectClaim: "id",
+	}
+
+	var stored *entity.Config
+	deps.configRepo.EXPECT().Upsert(mock.Anything, mock.Anything).Run(func(_ context.Context, cfg *entity.Config) {
+		stored = cfg
+	}).Return(nil)
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.EntityType == entity.AuditEntityOAuth && l.EntityID == "ctftime" && *l.UserID == actorID
+	})).Return(nil)
+
+	redisClient, _ := redismock.NewClientMock()
+	err := h.CreateOAuthUseCase(redisClient).SaveProvider(context.Background(), p, "s3cret", actorID, "127.0.0.1")
+
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, "oauth_provider.ctftime", stored.Key)
+	assert.Equal(t, entity.ConfigTypeJSON, stored.ValueType)
+	assert.NotContains(t, stored.Value, "s3cret")
+	secret, err := h.crypto.Decrypt(p.ClientSecretEncrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", secret)
+	assert.Equal(t, "email", p.EmailClaim)
+}
+
+func TestOAuthUseCase_SaveProvider_KeepsExistingSecret(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	iss := h.NewOIDCIssuer()
+	existing, cfg := h.NewOAuthProviderConfig(iss, false, nil)
+	deps.configRepo.EXPECT().GetByKey(mock.Anything, cfg.Key).Return(cfg, nil)
+	deps.configRepo.EXPECT().Upsert(mock.Anything, mock.Anything).Return(nil)
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	update := *existing
+	update.ClientSecretEncrypted = ""
+	update.DisplayName = "Renamed"
+	redisClient, _ := redismock.NewClientMock()
+	err := h.CreateOAuthUseCase(redisClient).SaveProvider(context.Background(), &update, "", uuid.New(), "127.0.0.1")
+
+	require.NoError(t, err)
+	assert.Equal(t, existing.ClientSecretEncrypted, update.ClientSecretEncrypted)
+}
+
+func TestNormalizeProvider_Invalid(t *testing.T) {
+	valid := func() *entity.OAuthProvider {
+		return &entity.OAuthProvider{
+			Name: "uni", ClientID: "id", Issuer: "https://idp.example.com",
+			RedirectURL: "https://board.example.com/oauth/callback",
+		}
+	}
+	tests := []struct {
+		name   string
+		mutate func(p *entity.OAuthProvider)
+	}{
+		{"BadName", func(p *entity.OAuthProvider) { p.Name = "bad name!" }},
+		{"NoClientID", func(p *entity.OAuthProvider) { p.ClientID = "" }},
+		{"RelativeRedirect", func(p *entity.OAuthProvider) { p.RedirectURL = "/callback" }},
+		{"NoEndpoints", func(p *entity.OAuthProvider) { p.Issuer = "" }},
+		{"FieldClaimNotUUID", func(p *entity.OAuthProvider) { p.FieldClaims = map[string]string{"student": "sid"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid()
+			tt.mutate(p)
+			assert.ErrorIs(t, normalizeProvider(p), entityError.ErrInvalidOAuthProvider)
+		})
+	}
+
+	p := valid()
+	require.NoError(t, normalizeProvider(p))
+	assert.Equal(t, defaultOIDCScopes, p.Scopes)
+	assert.Equal(t, "sub", p.SubjectClaim)
+}
+
+func TestOAuthUseCase_Unlink(t *testing.T) {
+	tests := []struct {
+		name         string
+		passwordHash string
+		identities   int
+		wantErr      error
+	}{
+		{"PasswordUser", "hash", 1, nil},
+		{"SSOOnlyLastIdentity", "", 1, entityError.ErrLastLoginMethod},
+		{"SSOOnlyTwoIdentities", "", 2, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserTestHelper(t)
+			deps := h.Deps()
+			user := h.NewUser("frank", "frank@uni.edu", tt.passwordHash)
+			deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+			if tt.passwordHash == "" {
+				identities := []*entity.UserIdentity{{Provider: "uni"}, {Provider: "github"}}[:tt.identities]
+				deps.identityRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(identities, nil)
+			}
+			if tt.wantErr == nil {
+				deps.identityRepo.EXPECT().Delete(mock.Anything, user.ID, "uni").Return(nil)
+			}
+
+			redisClient, _ := redismock.NewClientMock()
+			err := h.CreateOAuthUseCase(redisClient).Unlink(context.Background(), user.ID, "uni")
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
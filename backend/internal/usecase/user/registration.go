@@ -0,0 +1,226 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+const maxInviteCodeLength = 64
+
+type RegistrationStatus struct {
+	Open     bool
+	OpensAt  *time.Time
+	ClosesAt *time.Time
+}
+
+// RegistrationUseCase decides who may sign up. Registration is open when it is switched on in the
+// app settings and the current time is inside the optional window; while it is closed only users
+// with a valid invite code can register. Email-domain rules apply in both cases.
+type RegistrationUseCase struct {
+	appSettingsRepo  repo.AppSettingsRepository
+	registrationRepo repo.RegistrationRepository
+	bracketRepo      repo.BracketRepository
+	auditLogRepo     repo.AuditLogRepository
+}
+
+func NewRegistrationUseCase(
+	appSettingsRepo repo.AppSettingsRepository,
+	registrationRepo repo.RegistrationRepository,
+	bracketRepo repo.BracketRepository,
+	auditLogRepo repo.AuditLogRepository,
+) *RegistrationUseCase {
+	return &RegistrationUseCase{
+		appSettingsRepo:  appSettingsRepo,
+		registrationRepo: registrationRepo,
+		bracketRepo:      bracketRepo,
+		auditLogRepo:     auditLogRepo,
+	}
+}
+
+func (uc *RegistrationUseCase) Status(ctx context.Context) (*RegistrationStatus, error) {
+	settings, err := uc.appSettingsRepo.Get(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RegistrationUseCase - Status")
+	}
+	return &RegistrationStatus{
+		Open:     settings.IsRegistrationOpen(time.Now()),
+		OpensAt:  settings.RegistrationOpensAt,
+		ClosesAt: settings.RegistrationClosesAt,
+	}, nil
+}
+
+// Admit checks whether email may register and returns the invite code the caller must consume
+// in the transaction creating the user, or "" when registration is open and no code is needed.
+func (uc *RegistrationUseCase) Admit(ctx context.Context, email, inviteCode string) (string, error) {
+	rules, err := uc.registrationRepo.GetDomainRules(ctx)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "RegistrationUseCase - Admit - GetDomainRules")
+	}
+	if !entity.DomainAllowed(rules, email) {
+		return "", entityError.ErrEmailDomainNotAllowed
+	}
+	settings, err := uc.appSettingsRepo.Get(ctx)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "RegistrationUseCase - Admit - Get settings")
+	}
+	if settings.IsRegistrationOpen(time.Now()) {
+		return "", nil
+	}
+	inviteCode = strings.TrimSpace(inviteCode)
+	if inviteCode == "" {
+		return "", entityError.ErrRegistrationClosed
+	}
+	return inviteCode, nil
+}
+
+// BracketForEmail returns the bracket of the most specific allow rule matching email, if any.
+func (uc *RegistrationUseCase) BracketForEmail(ctx context.Context, email string) (*uuid.UUID, error) {
+	rules, err := uc.registrationRepo.GetDomainRules(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RegistrationUseCase - BracketForEmail")
+	}
+	rule := entity.MatchDomainRule(rules, email)
+	if rule == nil || rule.Action != entity.DomainRuleAllow {
+		return nil, nil
+	}
+	return rule.BracketID, nil
+}
+
+// CreateInvite stores a new invite; an empty code is replaced by a random one.
+func (uc *RegistrationUseCase) CreateInvite(ctx context.Context, invite *entity.RegistrationInvite, actorID uuid.UUID, clientIP string) error {
+	invite.Code = strings.TrimSpace(invite.Code)
+	if invite.Code == "" {
+		code, err := randomInviteCode()
+		if err != nil {
+			return usecaseutil.Wrap(err, "RegistrationUseCase - CreateInvite - randomInviteCode")
+		}
+		invite.Code = code
+	}
+	if len(invite.Code) > maxInviteCodeLength || strings.ContainsAny(invite.Code, " \t\r\n") {
+		return entityError.ErrInvalidInvite
+	}
+	if invite.MaxUses != nil && *invite.MaxUses < 1 {
+		return entityError.ErrInvalidInvite
+	}
+	if invite.ExpiresAt != nil && !invite.ExpiresAt.After(time.Now()) {
+		return entityError.ErrInvalidInvite
+	}
+	invite.Uses = 0
+	invite.CreatedBy = &actorID
+	if err := uc.registrationRepo.CreateInvite(ctx, invite); err != nil {
+		return usecaseutil.Wrap(err, "RegistrationUseCase - CreateInvite")
+	}
+	if err := uc.audit(ctx, entity.AuditActionCreate, entity.AuditEntityInvite, invite.ID, actorID, clientIP, map[string]any{"max_uses": invite.MaxUses}); err != nil {
+		return usecaseutil.Wrap(err, "RegistrationUseCase - CreateInvite - Create audit")
+	}
+	return nil
+}
+
+func (uc *RegistrationUseCase) ListInvites(ctx context.Context) ([]*entity.RegistrationInvite, error) {
+	invites, err := uc.registrationRepo.GetInvites(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RegistrationUseCase - ListInvites")
+	}
+	return invites, nil
+}
+
+func (uc *RegistrationUseCase) DeleteInvite(ctx context.Context, id, actorID uuid.UUID, clientIP string) error {
+	if err := uc.registrationRepo.DeleteInvite(ctx, id); err != nil {
+		return usecaseutil.Wrap(err, "RegistrationUseCase - DeleteInvite")
+	}
+	if err := uc.audit(ctx, entity.AuditActionDelete, entity.AuditEntityInvite, id, actorID, clientIP, nil); err != nil {
+		return usecaseutil.Wrap(err, "RegistrationUseCase - DeleteInvite - Create audit")
+	}
+	return nil
+}
+
+// CreateDomainRule stores a rule for a normalized domain. Only allow rules may assign a bracket.
+func (uc *RegistrationUseCase) CreateDomainRule(ctx context.Context, rule *entity.RegistrationDomainRule, actorID uuid.UUID, clientIP string) error {
+	rule.Domain = normalizeDomain(rule.Domain)
+	if !isValidDomain(rule.Domain) || !rule.Action.IsValid() {
+		return entityError.ErrInvalidDomainRule
+	}
+	if rule.BracketID != nil {
+		if rule.Action != entity.DomainRuleAllow {
+			return entityError.ErrInvalidDomainRule
+		}
+		if _, err := uc.bracketRepo.GetByID(ctx, *rule.BracketID); err != nil {
+			return usecaseutil.Wrap(err, "RegistrationUseCase - CreateDomainRule - GetBracket")
+		}
+	}
+	if err := uc.registrationRepo.CreateDomainRule(ctx, rule); err != nil {
+		return usecaseutil.Wrap(err, "RegistrationUseCase - CreateDomainRule")
+	}
+	details := map[string]any{"domain": rule.Domain, "action": rule.Action}
+	if err := uc.audit(ctx, entity.AuditActionCreate, entity.AuditEntityDomainRule, rule.ID, actorID, clientIP, details); err != nil {
+		return usecaseutil.Wrap(err, "RegistrationUseCase - CreateDomainRule - Create audit")
+	}
+	return nil
+}
+
+func (uc *RegistrationUseCase) ListDomainRules(ctx context.Context) ([]*entity.RegistrationDomainRule, error) {
+	rules, err := uc.registrationRepo.GetDomainRules(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RegistrationUseCase - ListDomainRules")
+	}
+	return rules, nil
+}
+
+func (uc *RegistrationUseCase) DeleteDomainRule(ctx context.Context, id, actorID uuid.UUID, clientIP string) error {
+	if err := uc.registrationRepo.DeleteDomainRule(ctx, id); err != nil {
+		return usecaseutil.Wrap(err, "RegistrationUseCase - DeleteDomainRule")
+	}
+	if err := uc.audit(ctx, entity.AuditActionDelete, entity.AuditEntityDomainRule, id, actorID, clientIP, nil); err != nil {
+		return usecaseutil.Wrap(err, "RegistrationUseCase - DeleteDomainRule - Create audit")
+	}
+	return nil
+}
+
+func (uc *RegistrationUseCase) audit(
+	ctx context.Context,
+	action entity.AuditAction,
+	entityType entity.AuditEntityType,
+	id, actorID uuid.UUID,
+	clientIP string,
+	details map[string]any,
+) error {
+	return uc.auditLogRepo.Create(ctx, &entity.AuditLog{
+		UserID:     &actorID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   id.String(),
+		IP:         clientIP,
+		Details:    details,
+	})
+}
+
+func randomInviteCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// normalizeDomain lower-cases domain and strips a leading "@" or "." so "@Uni.edu" and ".uni.edu"
+// both become "uni.edu".
+func normalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	return strings.TrimLeft(domain, "@.")
+}
+
+func isValidDomain(domain string) bool {
+	if len(domain) > 255 || !strings.Contains(domain, ".") || strings.HasSuffix(domain, ".") {
+		return false
+	}
+	return !strings.ContainsAny(domain, "@ \t\r\n/")
+}
@@ -0,0 +1,187 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationUseCase_Admit_Open(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.registrationRepo.EXPECT().GetDomainRules(mock.Anything).Return(nil, nil)
+	deps.appSettingsRepo.EXPECT().Get(mock.Anything).Return(&entity.AppSettings{RegistrationOpen: true}, nil)
+
+	code, err := h.CreateRegistrationUseCase().Admit(context.Background(), "alice@example.com", "ignored")
+
+	require.NoError(t, err)
+	assert.Empty(t, code)
+}
+
+func TestRegistrationUseCase_Admit_ClosedWithoutCode(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.registrationRepo.EXPECT().GetDomainRules(mock.Anything).Return(nil, nil)
+	deps.appSettingsRepo.EXPECT().Get(mock.Anything).Return(&entity.AppSettings{RegistrationOpen: false}, nil)
+
+	_, err := h.CreateRegistrationUseCase().Admit(context.Background(), "alice@example.com", " ")
+
+	assert.ErrorIs(t, err, entityError.ErrRegistrationClosed)
+}
+
+func TestRegistrationUseCase_Admit_OutsideWindowWithCode(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	opensAt := time.Now().Add(time.Hour)
+
+	deps.registrationRepo.EXPECT().GetDomainRules(mock.Anything).Return(nil, nil)
+	deps.appSettingsRepo.EXPECT().Get(mock.Anything).Return(&entity.AppSettings{RegistrationOpen: true, RegistrationOpensAt: &opensAt}, nil)
+
+	code, err := h.CreateRegistrationUseCase().Admit(context.Background(), "alice@example.com", " early ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "early", code)
+}
+
+func TestRegistrationUseCase_Admit_DomainDenied(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.registrationRepo.EXPECT().GetDomainRules(mock.Anything).Return([]*entity.RegistrationDomainRule{
+		{Domain: "spam.example", Action: entity.DomainRuleDeny},
+	}, nil)
+
+	_, err := h.CreateRegistrationUseCase().Admit(context.Background(), "bot@mail.spam.example", "code")
+
+	assert.ErrorIs(t, err, entityError.ErrEmailDomainNotAllowed)
+}
+
+func TestRegistrationUseCase_BracketForEmail(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	bracketID := uuid.New()
+
+	deps.registrationRepo.EXPECT().GetDomainRules(mock.Anything).Return([]*entity.RegistrationDomainRule{
+		{Domain: "edu", Action: entity.DomainRuleAllow},
+		{Domain: "uni.edu", Action: entity.DomainRuleAllow, BracketID: &bracketID},
+	}, nil)
+
+	got, err := h.CreateRegistrationUseCase().BracketForEmail(context.Background(), "alice@cs.uni.edu")
+
+	require.NoError(t, err)
+	assert.Equal(t, &bracketID, got)
+}
+
+func TestRegistrationUseCase_CreateInvite_GeneratesCode(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	actorID := uuid.New()
+	maxUses := 5
+
+	deps.registrationRepo.EXPECT().CreateInvite(mock.Anything, mock.MatchedBy(func(i *entity.RegistrationInvite) bool {
+		return len(i.Code) == 16 && *i.CreatedBy == actorID
+	})).Return(nil)
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.EntityType == entity.AuditEntityInvite && l.Action == entity.AuditActionCreate
+	})).Return(nil)
+
+	invite := &entity.RegistrationInvite{MaxUses: &maxUses}
+	err := h.CreateRegistrationUseCase().CreateInvite(context.Background(), invite, actorID, "")
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, invite.Code)
+}
+
+func TestRegistrationUseCase_CreateInvite_Invalid(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	zero := 0
+	for name, invite := range map[string]*entity.RegistrationInvite{
+		"expired":   {Code: "abc", ExpiresAt: &past},
+		"zero uses": {Code: "abc", MaxUses: &zero},
+		"space":     {Code: "a b"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			h := NewUserTestHelper(t)
+
+			err := h.CreateRegistrationUseCase().CreateInvite(context.Background(), invite, uuid.New(), "")
+
+			assert.ErrorIs(t, err, entityError.ErrInvalidInvite)
+		})
+	}
+}
+
+func TestRegistrationUseCase_CreateDomainRule_Normalizes(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	bracketID := uuid.New()
+
+	deps.bracketRepo.EXPECT().GetByID(mock.Anything, bracketID).Return(&entity.Bracket{ID: bracketID}, nil)
+	deps.registrationRepo.EXPECT().CreateDomainRule(mock.Anything, mock.MatchedBy(func(r *entity.RegistrationDomainRule) bool {
+		return r.Domain == "uni.edu"
+	})).Return(nil)
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	rule := &entity.RegistrationDomainRule{Domain: " @Uni.EDU ", Action: entity.DomainRuleAllow, BracketID: &bracketID}
+	err := h.CreateRegistrationUseCase().CreateDomainRule(context.Background(), rule, uuid.New(), "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "uni.edu", rule.Domain)
+}
+
+func TestRegistrationUseCase_CreateDomainRule_DenyWithBracket(t *testing.T) {
+	h := NewUserTestHelper(t)
+	bracketID := uuid.New()
+
+	rule := &entity.RegistrationDomainRule{Domain: "spam.example", Action: entity.DomainRuleDeny, BracketID: &bracketID}
+	err := h.CreateRegistrationUseCase().CreateDomainRule(context.Background(), rule, uuid.New(), "")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidDomainRule)
+}
+
+func TestUserUseCase_Register_ConsumesInvite(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.registrationRepo.EXPECT().GetDomainRules(mock.Anything).Return(nil, nil)
+	deps.appSettingsRepo.EXPECT().Get(mock.Anything).Return(&entity.AppSettings{RegistrationOpen: false}, nil)
+	deps.userRepo.EXPECT().GetByUsername(mock.Anything, "alice").Return(nil, entityError.ErrUserNotFound)
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, "alice@example.com").Return(nil, entityError.ErrUserNotFound)
+	deps.txRepo.EXPECT().RunTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context, repo.Transaction) error) error {
+		return fn(ctx, nil)
+	})
+	deps.txRepo.EXPECT().ConsumeRegistrationInviteTx(mock.Anything, mock.Anything, "invite").Return(&entity.RegistrationInvite{Code: "invite", Uses: 1}, nil)
+	deps.txRepo.EXPECT().CreateUserTx(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	user, err := h.CreateUseCaseWithRegistration().Register(context.Background(), "alice", "alice@example.com", "password123", "invite", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+}
+
+func TestUserUseCase_Register_InvalidInvite(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.registrationRepo.EXPECT().GetDomainRules(mock.Anything).Return(nil, nil)
+	deps.appSettingsRepo.EXPECT().Get(mock.Anything).Return(&entity.AppSettings{RegistrationOpen: false}, nil)
+	deps.userRepo.EXPECT().GetByUsername(mock.Anything, "alice").Return(nil, entityError.ErrUserNotFound)
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, "alice@example.com").Return(nil, entityError.ErrUserNotFound)
+	deps.txRepo.EXPECT().RunTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context, repo.Transaction) error) error {
+		return fn(ctx, nil)
+	})
+	deps.txRepo.EXPECT().ConsumeRegistrationInviteTx(mock.Anything, mock.Anything, "used-up").Return(nil, entityError.ErrInvalidInviteCode)
+
+	_, err := h.CreateUseCaseWithRegistration().Register(context.Background(), "alice", "alice@example.com", "password123", "used-up", nil)
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidInviteCode)
+}
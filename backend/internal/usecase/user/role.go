@@ -0,0 +1,235 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// RoleUseCase resolves permissions of built-in and custom roles, manages custom roles and role
+// assignment, and tracks which users authored which challenges.
+type RoleUseCase struct {
+	roleRepo            repo.RoleRepository
+	userRepo            repo.UserRepository
+	challengeAuthorRepo repo.ChallengeAuthorRepository
+	txRepo              repo.TxRepository
+}
+
+func NewRoleUseCase(
+	roleRepo repo.RoleRepository,
+	userRepo repo.UserRepository,
+	challengeAuthorRepo repo.ChallengeAuthorRepository,
+	txRepo repo.TxRepository,
+) *RoleUseCase {
+	return &RoleUseCase{
+		roleRepo:            roleRepo,
+		userRepo:            userRepo,
+		challengeAuthorRepo: challengeAuthorRepo,
+		txRepo:              txRepo,
+	}
+}
+
+// List returns the built-in roles followed by the custom ones.
+func (uc *RoleUseCase) List(ctx context.Context) ([]*entity.Role, error) {
+	custom, err := uc.roleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RoleUseCase - List")
+	}
+	return append(slices.Clone(entity.BuiltInRoles), custom...), nil
+}
+
+func (uc *RoleUseCase) Get(ctx context.Context, name string) (*entity.Role, error) {
+	if role, ok := entity.BuiltInRole(name); ok {
+		return role, nil
+	}
+	role, err := uc.roleRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RoleUseCase - Get")
+	}
+	return role, nil
+}
+
+// HasPermission reports whether role grants permission. Unknown roles grant nothing.
+func (uc *RoleUseCase) HasPermission(ctx context.Context, role, permission string) (bool, error) {
+	r, err := uc.Get(ctx, role)
+	if err != nil {
+		if errors.Is(err, entityError.ErrRoleNotFound) {
+			return false, nil
+		}
+		return false, usecaseutil.Wrap(err, "RoleUseCase - HasPermission")
+	}
+	return r.HasPermission(permission), nil
+}
+
+func (uc *RoleUseCase) Create(ctx context.Context, role *entity.Role, actorID uuid.UUID, clientIP string) error {
+	if !entity.IsValidRoleName(role.Name) {
+		return entityError.ErrInvalidRoleName
+	}
+	if _, ok := entity.BuiltInRole(role.Name); ok {
+		return entityError.ErrRoleAlreadyExists
+	}
+	permissions, err := normalizePermissions(role.Permissions)
+	if err != nil {
+		return err
+	}
+	role.Permissions = permissions
+	err = uc.txRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.txRepo.CreateRoleTx(ctx, tx, role); err != nil {
+			return usecaseutil.Wrap(err, "CreateRoleTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionCreate, role.Name, actorID, clientIP, map[string]any{"permissions": role.Permissions})
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "RoleUseCase - Create")
+	}
+	return nil
+}
+
+func (uc *RoleUseCase) Update(ctx context.Context, name, description string, permissions []string, actorID uuid.UUID, clientIP string) (*entity.Role, error) {
+	if _, ok := entity.BuiltInRole(name); ok {
+		return nil, entityError.ErrBuiltInRole
+	}
+	permissions, err := normalizePermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	role, err := uc.roleRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RoleUseCase - Update - GetByName")
+	}
+	role.Description = description
+	role.Permissions = permissions
+	err = uc.txRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.txRepo.UpdateRoleTx(ctx, tx, role); err != nil {
+			return usecaseutil.Wrap(err, "UpdateRoleTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionUpdate, name, actorID, clientIP, map[string]any{"permissions": permissions})
+	})
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "RoleUseCase - Update")
+	}
+	return role, nil
+}
+
+// Delete removes a custom role; its users fall back to the regular user role.
+func (uc *RoleUseCase) Delete(ctx context.Context, name string, actorID uuid.UUID, clientIP string) error {
+	if _, ok := entity.BuiltInRole(name); ok {
+		return entityError.ErrBuiltInRole
+	}
+	err := uc.txRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.txRepo.DeleteRoleTx(ctx, tx, name); err != nil {
+			return usecaseutil.Wrap(err, "DeleteRoleTx")
+		}
+		return uc.auditTx(ctx, tx, entity.AuditActionDelete, name, actorID, clientIP, nil)
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "RoleUseCase - Delete")
+	}
+	return nil
+}
+
+// SetUserRole assigns role to the user. Users cannot change their own role so an admin
+// cannot lock themselves out. The user row is locked so the audited "from" role is the one
+// being replaced.
+func (uc *RoleUseCase) SetUserRole(ctx context.Context, userID uuid.UUID, role string, actorID uuid.UUID, clientIP string) error {
+	if userID == actorID {
+		return entityError.ErrCannotChangeOwnRole
+	}
+	if _, err := uc.Get(ctx, role); err != nil {
+		return usecaseutil.Wrap(err, "RoleUseCase - SetUserRole - Get")
+	}
+	err := uc.txRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if err := uc.txRepo.LockUserTx(ctx, tx, userID); err != nil {
+			return usecaseutil.Wrap(err, "LockUserTx")
+		}
+		user, err := uc.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return usecaseutil.Wrap(err, "GetByID")
+		}
+		if err := uc.txRepo.UpdateUserRoleTx(ctx, tx, userID, role); err != nil {
+			return usecaseutil.Wrap(err, "UpdateUserRoleTx")
+		}
+		if err := uc.txRepo.CreateAuditLogTx(ctx, tx, &entity.AuditLog{
+			UserID:     &actorID,
+			Action:     entity.AuditActionSetRole,
+			EntityType: entity.AuditEntityUser,
+			EntityID:   userID.String(),
+			IP:         clientIP,
+			Details:    map[string]any{"from": user.Role, "to": role},
+		}); err != nil {
+			return usecaseutil.Wrap(err, "CreateAuditLogTx")
+		}
+		return nil
+	})
+	if err != nil {
+		return usecaseutil.Wrap(err, "RoleUseCase - SetUserRole")
+	}
+	return nil
+}
+
+// AddChallengeAuthor records userID as an author of the challenge.
+func (uc *RoleUseCase) AddChallengeAuthor(ctx context.Context, challengeID, userID uuid.UUID) error {
+	if err := uc.challengeAuthorRepo.Add(ctx, challengeID, userID); err != nil {
+		return usecaseutil.Wrap(err, "RoleUseCase - AddChallengeAuthor")
+	}
+	return nil
+}
+
+// AuthorizeChallenge lets roles with challenges.manage change any challenge and roles with
+// challenges.author only the challenges the user authored.
+func (uc *RoleUseCase) AuthorizeChallenge(ctx context.Context, role string, userID, challengeID uuid.UUID) error {
+	r, err := uc.Get(ctx, role)
+	if err != nil {
+		if errors.Is(err, entityError.ErrRoleNotFound) {
+			return entityError.ErrPermissionDenied
+		}
+		return usecaseutil.Wrap(err, "RoleUseCase - AuthorizeChallenge - Get")
+	}
+	if r.HasPermission(entity.PermChallengesManage) {
+		return nil
+	}
+	if !r.HasPermission(entity.PermChallengesAuthor) {
+		return entityError.ErrPermissionDenied
+	}
+	ok, err := uc.challengeAuthorRepo.IsAuthor(ctx, challengeID, userID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "RoleUseCase - AuthorizeChallenge - IsAuthor")
+	}
+	if !ok {
+		return entityError.ErrNotChallengeAuthor
+	}
+	return nil
+}
+
+func (uc *RoleUseCase) auditTx(ctx context.Context, tx repo.Transaction, action entity.AuditAction, name string, actorID uuid.UUID, clientIP string, details map[string]any) error {
+	if err := uc.txRepo.CreateAuditLogTx(ctx, tx, &entity.AuditLog{
+		UserID:     &actorID,
+		Action:     action,
+		EntityType: entity.AuditEntityRole,
+		EntityID:   name,
+		IP:         clientIP,
+		Details:    details,
+	}); err != nil {
+		return usecaseutil.Wrap(err, "CreateAuditLogTx")
+	}
+	return nil
+}
+
+// normalizePermissions rejects unknown permissions and returns the rest sorted without duplicates.
+func normalizePermissions(permissions []string) ([]string, error) {
+	out := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		if !entity.IsValidPermission(p) {
+			return nil, entityError.ErrInvalidPermission
+		}
+		out = append(out, p)
+	}
+	slices.Sort(out)
+	return slices.Compact(out), nil
+}
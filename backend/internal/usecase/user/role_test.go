@@ -0,0 +1,240 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRoleUseCase_List_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	custom := &entity.Role{Name: "judge", Permissions: []string{entity.PermSubmissionsRead}}
+
+	deps.roleRepo.EXPECT().GetAll(mock.Anything).Return([]*entity.Role{custom}, nil)
+
+	roles, err := h.CreateRoleUseCase().List(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, roles, len(entity.BuiltInRoles)+1)
+	assert.Equal(t, entity.RoleAdmin, roles[0].Name)
+	assert.Equal(t, custom, roles[len(roles)-1])
+}
+
+func TestRoleUseCase_HasPermission(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	ctx := context.Background()
+
+	deps.roleRepo.EXPECT().GetByName(mock.Anything, "judge").
+		Return(&entity.Role{Name: "judge", Permissions: []string{entity.PermSubmissionsRead}}, nil)
+	deps.roleRepo.EXPECT().GetByName(mock.Anything, "ghost").Return(nil, entityError.ErrRoleNotFound)
+	deps.roleRepo.EXPECT().GetByName(mock.Anything, "broken").Return(nil, assert.AnError)
+
+	uc := h.CreateRoleUseCase()
+	tests := []struct {
+		name       string
+		role       string
+		permission string
+		want       bool
+		wantErr    bool
+	}{
+		{"AdminHasAll", entity.RoleAdmin, entity.PermBackupManage, true, false},
+		{"SupportReadsSubmissions", entity.RoleSupport, entity.PermSubmissionsRead, true, false},
+		{"SupportCannotReadFlags", entity.RoleSupport, entity.PermFlagsRead, false, false},
+		{"ModeratorComments", entity.RoleModerator, entity.PermCommentsModerate, true, false},
+		{"AuthorCannotImport", entity.RoleAuthor, entity.PermBackupManage, false, false},
+		{"UserNothing", entity.RoleUser, entity.PermSubmissionsRead, false, false},
+		{"CustomRole", "judge", entity.PermSubmissionsRead, true, false},
+		{"UnknownRole", "ghost", entity.PermSubmissionsRead, false, false},
+		{"RepoError", "broken", entity.PermSubmissionsRead, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.HasPermission(ctx, tt.role, tt.permission)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRoleUseCase_Create_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	actorID := uuid.New()
+	role := &entity.Role{
+		Name:        "judge",
+		Permissions: []string{entity.PermSubmissionsRead, entity.PermFlagsRead, entity.PermSubmissionsRead},
+	}
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().CreateRoleTx(mock.Anything, mock.Anything, role).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionCreate && l.EntityType == entity.AuditEntityRole && l.EntityID == "judge"
+	})).Return(nil)
+
+	err := h.CreateRoleUseCase().Create(context.Background(), role, actorID, "127.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{entity.PermFlagsRead, entity.PermSubmissionsRead}, role.Permissions)
+}
+
+func TestRoleUseCase_Create_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    *entity.Role
+		wantErr error
+	}{
+		{"BuiltInName", &entity.Role{Name: entity.RoleModerator}, entityError.ErrRoleAlreadyExists},
+		{"InvalidName", &entity.Role{Name: "Bad Name"}, entityError.ErrInvalidRoleName},
+		{"InvalidPermission", &entity.Role{Name: "judge", Permissions: []string{"everything"}}, entityError.ErrInvalidPermission},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserTestHelper(t)
+			err := h.CreateRoleUseCase().Create(context.Background(), tt.role, uuid.New(), "")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRoleUseCase_Update_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.roleRepo.EXPECT().GetByName(mock.Anything, "judge").Return(&entity.Role{Name: "judge"}, nil)
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().UpdateRoleTx(mock.Anything, mock.Anything, mock.MatchedBy(func(r *entity.Role) bool {
+		return r.Description == "reads flags" && len(r.Permissions) == 1 && r.Permissions[0] == entity.PermFlagsRead
+	})).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	role, err := h.CreateRoleUseCase().Update(context.Background(), "judge", "reads flags", []string{entity.PermFlagsRead}, uuid.New(), "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "reads flags", role.Description)
+}
+
+func TestRoleUseCase_Update_BuiltIn(t *testing.T) {
+	h := NewUserTestHelper(t)
+
+	_, err := h.CreateRoleUseCase().Update(context.Background(), entity.RoleAdmin, "", nil, uuid.New(), "")
+
+	assert.ErrorIs(t, err, entityError.ErrBuiltInRole)
+}
+
+func TestRoleUseCase_Delete_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().DeleteRoleTx(mock.Anything, mock.Anything, "judge").Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionDelete && l.EntityID == "judge"
+	})).Return(nil)
+
+	assert.NoError(t, h.CreateRoleUseCase().Delete(context.Background(), "judge", uuid.New(), ""))
+}
+
+func TestRoleUseCase_Delete_BuiltIn(t *testing.T) {
+	h := NewUserTestHelper(t)
+
+	err := h.CreateRoleUseCase().Delete(context.Background(), entity.RoleUser, uuid.New(), "")
+
+	assert.ErrorIs(t, err, entityError.ErrBuiltInRole)
+}
+
+func TestRoleUseCase_SetUserRole_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "hash")
+	user.Role = entity.RoleUser
+	actorID := uuid.New()
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.txRepo.EXPECT().UpdateUserRoleTx(mock.Anything, mock.Anything, user.ID, entity.RoleAuthor).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionSetRole && l.EntityID == user.ID.String() &&
+			l.Details["from"] == entity.RoleUser && l.Details["to"] == entity.RoleAuthor
+	})).Return(nil)
+
+	err := h.CreateRoleUseCase().SetUserRole(context.Background(), user.ID, entity.RoleAuthor, actorID, "")
+
+	assert.NoError(t, err)
+}
+
+func TestRoleUseCase_SetUserRole_Error(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	actorID := uuid.New()
+
+	deps.roleRepo.EXPECT().GetByName(mock.Anything, "ghost").Return(nil, entityError.ErrRoleNotFound)
+
+	uc := h.CreateRoleUseCase()
+	assert.ErrorIs(t, uc.SetUserRole(context.Background(), actorID, entity.RoleUser, actorID, ""), entityError.ErrCannotChangeOwnRole)
+	assert.ErrorIs(t, uc.SetUserRole(context.Background(), uuid.New(), "ghost", actorID, ""), entityError.ErrRoleNotFound)
+}
+
+func TestRoleUseCase_SetUserRole_AuditFailureRollsBack(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "hash")
+
+	h.ExpectTransaction()
+	deps.txRepo.EXPECT().LockUserTx(mock.Anything, mock.Anything, user.ID).Return(nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.txRepo.EXPECT().UpdateUserRoleTx(mock.Anything, mock.Anything, user.ID, entity.RoleAuthor).Return(nil)
+	deps.txRepo.EXPECT().CreateAuditLogTx(mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError)
+
+	err := h.CreateRoleUseCase().SetUserRole(context.Background(), user.ID, entity.RoleAuthor, uuid.New(), "")
+
+	assert.ErrorIs(t, err, assert.AnError)
+	deps.userRepo.AssertNotCalled(t, "UpdateRole", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRoleUseCase_AuthorizeChallenge(t *testing.T) {
+	userID := uuid.New()
+	challengeID := uuid.New()
+	tests := []struct {
+		name     string
+		role     string
+		isAuthor *bool
+		wantErr  error
+	}{
+		{"AdminAnyChallenge", entity.RoleAdmin, nil, nil},
+		{"AuthorOwnChallenge", entity.RoleAuthor, ptrBool(true), nil},
+		{"AuthorForeignChallenge", entity.RoleAuthor, ptrBool(false), entityError.ErrNotChallengeAuthor},
+		{"ModeratorDenied", entity.RoleModerator, nil, entityError.ErrPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserTestHelper(t)
+			if tt.isAuthor != nil {
+				h.Deps().challengeAuthorRepo.EXPECT().IsAuthor(mock.Anything, challengeID, userID).Return(*tt.isAuthor, nil)
+			}
+
+			err := h.CreateRoleUseCase().AuthorizeChallenge(context.Background(), tt.role, userID, challengeID)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func ptrBool(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,120 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+// sessionTouchInterval limits how often last_seen_at is written for an active session.
+const sessionTouchInterval = time.Minute
+
+type SessionUseCase struct {
+	sessionRepo  repo.SessionRepository
+	userRepo     repo.UserRepository
+	teamRepo     repo.TeamRepository
+	auditLogRepo repo.AuditLogRepository
+}
+
+func NewSessionUseCase(
+	sessionRepo repo.SessionRepository,
+	userRepo repo.UserRepository,
+	teamRepo repo.TeamRepository,
+	auditLogRepo repo.AuditLogRepository,
+) *SessionUseCase {
+	return &SessionUseCase{
+		sessionRepo:  sessionRepo,
+		userRepo:     userRepo,
+		teamRepo:     teamRepo,
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+// ValidateAccess checks that an access token belongs to a live session and is the latest one issued for it.
+func (uc *SessionUseCase) ValidateAccess(ctx context.Context, sessionID, userID uuid.UUID, accessJTI string) error {
+	session, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrSessionNotFound) {
+			return entityError.ErrSessionRevoked
+		}
+		return usecaseutil.Wrap(err, "SessionUseCase - ValidateAccess - GetByID")
+	}
+	if session.UserID != userID || session.AccessJTI != accessJTI || !session.IsActive() {
+		return entityError.ErrSessionRevoked
+	}
+	if now := time.Now(); now.Sub(session.LastSeenAt) >= sessionTouchInterval {
+		if err := uc.sessionRepo.Touch(ctx, session.ID, now); err != nil {
+			return usecaseutil.Wrap(err, "SessionUseCase - ValidateAccess - Touch")
+		}
+	}
+	return nil
+}
+
+func (uc *SessionUseCase) List(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	sessions, err := uc.sessionRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "SessionUseCase - List")
+	}
+	return sessions, nil
+}
+
+func (uc *SessionUseCase) Revoke(ctx context.Context, id, userID uuid.UUID) error {
+	session, err := uc.sessionRepo.GetByID(ctx, id)
+	if err != nil {
+		return usecaseutil.Wrap(err, "SessionUseCase - Revoke - GetByID")
+	}
+	if session.UserID != userID || !session.IsActive() {
+		return entityError.ErrSessionNotFound
+	}
+	if err := uc.sessionRepo.Revoke(ctx, id); err != nil {
+		return usecaseutil.Wrap(err, "SessionUseCase - Revoke")
+	}
+	return nil
+}
+
+func (uc *SessionUseCase) RevokeAllForUser(ctx context.Context, userID, actorID uuid.UUID, clientIP string) (int64, error) {
+	if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+		return 0, usecaseutil.Wrap(err, "SessionUseCase - RevokeAllForUser - GetByID")
+	}
+	n, err := uc.sessionRepo.RevokeAllByUserID(ctx, userID)
+	if err != nil {
+		return 0, usecaseutil.Wrap(err, "SessionUseCase - RevokeAllForUser")
+	}
+	if err := uc.audit(ctx, entity.AuditEntityUser, userID, actorID, clientIP, n); err != nil {
+		return 0, usecaseutil.Wrap(err, "SessionUseCase - RevokeAllForUser - Create audit")
+	}
+	return n, nil
+}
+
+func (uc *SessionUseCase) RevokeAllForTeam(ctx context.Context, teamID, actorID uuid.UUID, clientIP string) (int64, error) {
+	if _, err := uc.teamRepo.GetByID(ctx, teamID); err != nil {
+		return 0, usecaseutil.Wrap(err, "SessionUseCase - RevokeAllForTeam - GetByID")
+	}
+	n, err := uc.sessionRepo.RevokeAllByTeamID(ctx, teamID)
+	if err != nil {
+		return 0, usecaseutil.Wrap(err, "SessionUseCase - RevokeAllForTeam")
+	}
+	if err := uc.audit(ctx, entity.AuditEntityTeam, teamID, actorID, clientIP, n); err != nil {
+		return 0, usecaseutil.Wrap(err, "SessionUseCase - RevokeAllForTeam - Create audit")
+	}
+	return n, nil
+}
+
+func (uc *SessionUseCase) audit(ctx context.Context, entityType entity.AuditEntityType, entityID, actorID uuid.UUID, clientIP string, revoked int64) error {
+	return uc.auditLogRepo.Create(ctx, &entity.AuditLog{
+		UserID:     &actorID,
+		Action:     entity.AuditActionRevokeSessions,
+		EntityType: entityType,
+		EntityID:   entityID.String(),
+		IP:         clientIP,
+		Details: map[string]any{
+			"revoked": revoked,
+		},
+	})
+}
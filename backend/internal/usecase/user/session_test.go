@@ -0,0 +1,181 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSessionUseCase_ValidateAccess_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	session := h.NewSession(uuid.New(), "jti-1")
+
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+
+	err := h.CreateSessionUseCase().ValidateAccess(context.Background(), session.ID, session.UserID, session.AccessJTI)
+
+	assert.NoError(t, err)
+}
+
+func TestSessionUseCase_ValidateAccess_TouchesStaleSession(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	session := h.NewSession(uuid.New(), "jti-1")
+	session.LastSeenAt = time.Now().Add(-time.Hour)
+
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+	deps.sessionRepo.EXPECT().Touch(mock.Anything, session.ID, mock.Anything).Return(nil).Once()
+
+	err := h.CreateSessionUseCase().ValidateAccess(context.Background(), session.ID, session.UserID, session.AccessJTI)
+
+	assert.NoError(t, err)
+}
+
+func TestSessionUseCase_ValidateAccess_Revoked(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	session := h.NewSession(uuid.New(), "jti-1")
+	revokedAt := time.Now()
+	session.RevokedAt = &revokedAt
+
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+
+	err := h.CreateSessionUseCase().ValidateAccess(context.Background(), session.ID, session.UserID, session.AccessJTI)
+
+	assert.ErrorIs(t, err, entityError.ErrSessionRevoked)
+}
+
+func TestSessionUseCase_ValidateAccess_StaleAccessToken(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	session := h.NewSession(uuid.New(), "jti-2")
+
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+
+	err := h.CreateSessionUseCase().ValidateAccess(context.Background(), session.ID, session.UserID, "access-jti-1")
+
+	assert.ErrorIs(t, err, entityError.ErrSessionRevoked)
+}
+
+func TestSessionUseCase_ValidateAccess_NotFound(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	sessionID := uuid.New()
+
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, sessionID).Return(nil, entityError.ErrSessionNotFound)
+
+	err := h.CreateSessionUseCase().ValidateAccess(context.Background(), sessionID, uuid.New(), "jti")
+
+	assert.ErrorIs(t, err, entityError.ErrSessionRevoked)
+}
+
+func TestSessionUseCase_List_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+	sessions := []*entity.Session{h.NewSession(userID, "jti-1"), h.NewSession(userID, "jti-2")}
+
+	deps.sessionRepo.EXPECT().GetActiveByUserID(mock.Anything, userID).Return(sessions, nil)
+
+	list, err := h.CreateSessionUseCase().List(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+}
+
+func TestSessionUseCase_Revoke_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	session := h.NewSession(uuid.New(), "jti-1")
+
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+	deps.sessionRepo.EXPECT().Revoke(mock.Anything, session.ID).Return(nil).Once()
+
+	err := h.CreateSessionUseCase().Revoke(context.Background(), session.ID, session.UserID)
+
+	assert.NoError(t, err)
+}
+
+func TestSessionUseCase_Revoke_ForeignSession(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	session := h.NewSession(uuid.New(), "jti-1")
+
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+
+	err := h.CreateSessionUseCase().Revoke(context.Background(), session.ID, uuid.New())
+
+	assert.ErrorIs(t, err, entityError.ErrSessionNotFound)
+}
+
+func TestSessionUseCase_RevokeAllForUser_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("testuser", "test@example.com", "")
+	adminID := uuid.New()
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.sessionRepo.EXPECT().RevokeAllByUserID(mock.Anything, user.ID).Return(int64(3), nil)
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Run(func(_ context.Context, log *entity.AuditLog) {
+		assert.Equal(t, entity.AuditActionRevokeSessions, log.Action)
+		assert.Equal(t, entity.AuditEntityUser, log.EntityType)
+		assert.Equal(t, user.ID.String(), log.EntityID)
+		assert.Equal(t, &adminID, log.UserID)
+	})
+
+	n, err := h.CreateSessionUseCase().RevokeAllForUser(context.Background(), user.ID, adminID, "127.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+}
+
+func TestSessionUseCase_RevokeAllForUser_UserNotFound(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, userID).Return(nil, entityError.ErrUserNotFound)
+
+	n, err := h.CreateSessionUseCase().RevokeAllForUser(context.Background(), userID, uuid.New(), "127.0.0.1")
+
+	assert.ErrorIs(t, err, entityError.ErrUserNotFound)
+	assert.Zero(t, n)
+}
+
+func TestSessionUseCase_RevokeAllForTeam_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	team := &entity.Team{ID: uuid.New(), Name: "team"}
+
+	deps.teamRepo.EXPECT().GetByID(mock.Anything, team.ID).Return(team, nil)
+	deps.sessionRepo.EXPECT().RevokeAllByTeamID(mock.Anything, team.ID).Return(int64(5), nil)
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Run(func(_ context.Context, log *entity.AuditLog) {
+		assert.Equal(t, entity.AuditEntityTeam, log.EntityType)
+		assert.Equal(t, team.ID.String(), log.EntityID)
+	})
+
+	n, err := h.CreateSessionUseCase().RevokeAllForTeam(context.Background(), team.ID, uuid.New(), "127.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), n)
+}
+
+func TestSessionUseCase_RevokeAllForTeam_TeamNotFound(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	teamID := uuid.New()
+
+	deps.teamRepo.EXPECT().GetByID(mock.Anything, teamID).Return(nil, entityError.ErrTeamNotFound)
+
+	n, err := h.CreateSessionUseCase().RevokeAllForTeam(context.Background(), teamID, uuid.New(), "127.0.0.1")
+
+	assert.ErrorIs(t, err, entityError.ErrTeamNotFound)
+	assert.Zero(t, n)
+}
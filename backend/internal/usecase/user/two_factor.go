@@ -0,0 +1,304 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/pkg/crypto"
+	"github.com/skr1ms/CTFBoard/pkg/totp"
+	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
+)
+
+const recoveryCodeCount = 10
+
+type TwoFactorStatus struct {
+	Enabled           bool
+	Required          bool
+	RecoveryCodesLeft int
+}
+
+type TwoFactorSetup struct {
+	Secret string
+	URL    string
+}
+
+type TwoFactorUseCase struct {
+	twoFactorRepo   repo.TwoFactorRepository
+	userRepo        repo.UserRepository
+	appSettingsRepo repo.AppSettingsRepository
+	auditLogRepo    repo.AuditLogRepository
+	crypto          crypto.Service
+}
+
+func NewTwoFactorUseCase(
+	twoFactorRepo repo.TwoFactorRepository,
+	userRepo repo.UserRepository,
+	appSettingsRepo repo.AppSettingsRepository,
+	auditLogRepo repo.AuditLogRepository,
+	cryptoService crypto.Service,
+) *TwoFactorUseCase {
+	return &TwoFactorUseCase{
+		twoFactorRepo:   twoFactorRepo,
+		userRepo:        userRepo,
+		appSettingsRepo: appSettingsRepo,
+		auditLogRepo:    auditLogRepo,
+		crypto:          cryptoService,
+	}
+}
+
+func (uc *TwoFactorUseCase) Status(ctx context.Context, user *entity.User) (*TwoFactorStatus, error) {
+	required, err := uc.isRequired(ctx, user)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Status")
+	}
+	status := &TwoFactorStatus{Required: required}
+	tf, err := uc.twoFactorRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrTwoFactorNotEnrolled) {
+			return status, nil
+		}
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Status - GetByUserID")
+	}
+	if !tf.IsEnabled() {
+		return status, nil
+	}
+	status.Enabled = true
+	status.RecoveryCodesLeft, err = uc.twoFactorRepo.CountRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Status - CountRecoveryCodes")
+	}
+	return status, nil
+}
+
+// Setup generates a new pending secret. It replaces any unfinished enrollment but never an enabled one.
+func (uc *TwoFactorUseCase) Setup(ctx context.Context, user *entity.User) (*TwoFactorSetup, error) {
+	if uc.crypto == nil {
+		return nil, usecaseutil.Wrap(crypto.ErrServiceNotConfigured, "TwoFactorUseCase - Setup")
+	}
+	tf, err := uc.twoFactorRepo.GetByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, entityError.ErrTwoFactorNotEnrolled) {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Setup - GetByUserID")
+	}
+	if err == nil && tf.IsEnabled() {
+		return nil, entityError.ErrTwoFactorAlreadyEnabled
+	}
+
+	settings, err := uc.appSettingsRepo.Get(ctx)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Setup - GetAppSettings")
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Setup - GenerateSecret")
+	}
+	encrypted, err := uc.crypto.Encrypt(secret)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Setup - Encrypt")
+	}
+	if err := uc.twoFactorRepo.Upsert(ctx, &entity.TwoFactor{UserID: user.ID, SecretEncrypted: encrypted}); err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Setup - Upsert")
+	}
+
+	return &TwoFactorSetup{
+		Secret: secret,
+		URL:    totp.URL(settings.AppName, user.Email, secret),
+	}, nil
+}
+
+// Enable confirms a pending enrollment with a code from the authenticator and returns the plaintext
+// recovery codes; they are stored hashed and cannot be shown again.
+func (uc *TwoFactorUseCase) Enable(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	tf, err := uc.twoFactorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Enable - GetByUserID")
+	}
+	if tf.IsEnabled() {
+		return nil, entityError.ErrTwoFactorAlreadyEnabled
+	}
+	secret, err := decryptSecret(uc.crypto, tf)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Enable")
+	}
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return nil, entityError.ErrInvalidTwoFactorCode
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Enable - generateRecoveryCodes")
+	}
+	if err := uc.twoFactorRepo.Enable(ctx, userID, step, hashes); err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - Enable")
+	}
+	return codes, nil
+}
+
+func (uc *TwoFactorUseCase) Disable(ctx context.Context, userID uuid.UUID, code string) error {
+	tf, err := uc.getEnabled(ctx, userID)
+	if err != nil {
+		return usecaseutil.Wrap(err, "TwoFactorUseCase - Disable")
+	}
+	if err := verifySecondFactor(ctx, uc.twoFactorRepo, uc.crypto, tf, code); err != nil {
+		return err
+	}
+	if err := uc.twoFactorRepo.Delete(ctx, userID); err != nil {
+		return usecaseutil.Wrap(err, "TwoFactorUseCase - Disable - Delete")
+	}
+	return nil
+}
+
+// RegenerateRecoveryCodes invalidates every previous recovery code and returns a fresh set.
+func (uc *TwoFactorUseCase) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	tf, err := uc.getEnabled(ctx, userID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - RegenerateRecoveryCodes")
+	}
+	if err := verifySecondFactor(ctx, uc.twoFactorRepo, uc.crypto, tf, code); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - RegenerateRecoveryCodes - generateRecoveryCodes")
+	}
+	if err := uc.twoFactorRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, usecaseutil.Wrap(err, "TwoFactorUseCase - RegenerateRecoveryCodes - ReplaceRecoveryCodes")
+	}
+	return codes, nil
+}
+
+// Reset removes a user's enrollment on behalf of an admin, e.g. after a lost device.
+func (uc *TwoFactorUseCase) Reset(ctx context.Context, userID, actorID uuid.UUID, clientIP string) error {
+	if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+		return usecaseutil.Wrap(err, "TwoFactorUseCase - Reset - GetByID")
+	}
+	if _, err := uc.twoFactorRepo.GetByUserID(ctx, userID); err != nil {
+		return usecaseutil.Wrap(err, "TwoFactorUseCase - Reset - GetByUserID")
+	}
+	if err := uc.twoFactorRepo.Delete(ctx, userID); err != nil {
+		return usecaseutil.Wrap(err, "TwoFactorUseCase - Reset - Delete")
+	}
+	auditLog := &entity.AuditLog{
+		UserID:     &actorID,
+		Action:     entity.AuditActionResetTwoFactor,
+		EntityType: entity.AuditEntityUser,
+		EntityID:   userID.String(),
+		IP:         clientIP,
+	}
+	if err := uc.auditLogRepo.Create(ctx, auditLog); err != nil {
+		return usecaseutil.Wrap(err, "TwoFactorUseCase - Reset - Create audit")
+	}
+	return nil
+}
+
+// RequiredButMissing reports whether the policy demands 2FA from the user who has not enabled it yet.
+func (uc *TwoFactorUseCase) RequiredButMissing(ctx context.Context, user *entity.User) (bool, error) {
+	required, err := uc.isRequired(ctx, user)
+	if err != nil || !required {
+		return false, usecaseutil.Wrap(err, "TwoFactorUseCase - RequiredButMissing")
+	}
+	tf, err := uc.twoFactorRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrTwoFactorNotEnrolled) {
+			return true, nil
+		}
+		return false, usecaseutil.Wrap(err, "TwoFactorUseCase - RequiredButMissing - GetByUserID")
+	}
+	return !tf.IsEnabled(), nil
+}
+
+// isRequired applies the require_2fa_for_admins setting to every staff role, not only admins.
+func (uc *TwoFactorUseCase) isRequired(ctx context.Context, user *entity.User) (bool, error) {
+	if !entity.IsStaffRole(user.Role) {
+		return false, nil
+	}
+	settings, err := uc.appSettingsRepo.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	return settings.Require2FAForAdmins, nil
+}
+
+func (uc *TwoFactorUseCase) getEnabled(ctx context.Context, userID uuid.UUID) (*entity.TwoFactor, error) {
+	tf, err := uc.twoFactorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !tf.IsEnabled() {
+		return nil, entityError.ErrTwoFactorNotEnrolled
+	}
+	return tf, nil
+}
+
+// verifySecondFactor accepts either a TOTP code, which may be used only once, or an unused recovery code.
+func verifySecondFactor(ctx context.Context, twoFactorRepo repo.TwoFactorRepository, cryptoService crypto.Service, tf *entity.TwoFactor, code string) error {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		secret, err := decryptSecret(cryptoService, tf)
+		if err != nil {
+			return usecaseutil.Wrap(err, "verifySecondFactor")
+		}
+		step, ok := totp.Validate(secret, code, time.Now())
+		if !ok {
+			return entityError.ErrInvalidTwoFactorCode
+		}
+		consumed, err := twoFactorRepo.ConsumeStep(ctx, tf.UserID, step)
+		if err != nil {
+			return usecaseutil.Wrap(err, "verifySecondFactor - ConsumeStep")
+		}
+		if !consumed {
+			return entityError.ErrInvalidTwoFactorCode
+		}
+		return nil
+	}
+
+	used, err := twoFactorRepo.UseRecoveryCode(ctx, tf.UserID, hashRecoveryCode(code))
+	if err != nil {
+		return usecaseutil.Wrap(err, "verifySecondFactor - UseRecoveryCode")
+	}
+	if !used {
+		return entityError.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func decryptSecret(cryptoService crypto.Service, tf *entity.TwoFactor) (string, error) {
+	if cryptoService == nil {
+		return "", crypto.ErrServiceNotConfigured
+	}
+	secret, err := cryptoService.Decrypt(tf.SecretEncrypted)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "Decrypt")
+	}
+	return secret, nil
+}
+
+// generateRecoveryCodes returns codes formatted as xxxxx-xxxxx together with their hashes.
+func generateRecoveryCodes() (codes, hashes []string, err error) {
+	codes = make([]string, 0, recoveryCodeCount)
+	hashes = make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := hex.EncodeToString(b)
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+		hashes = append(hashes, hashRecoveryCode(raw))
+	}
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,359 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/skr1ms/CTFBoard/internal/entity"
+	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
+	"github.com/skr1ms/CTFBoard/pkg/crypto"
+	"github.com/skr1ms/CTFBoard/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoFactorUseCase_Setup_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(nil, entityError.ErrTwoFactorNotEnrolled)
+	deps.appSettingsRepo.EXPECT().Get(mock.Anything).Return(&entity.AppSettings{AppName: "CTFBoard"}, nil)
+	var stored *entity.TwoFactor
+	deps.twoFactorRepo.EXPECT().Upsert(mock.Anything, mock.Anything).Run(func(_ context.Context, tf *entity.TwoFactor) {
+		stored = tf
+	}).Return(nil).Once()
+
+	setup, err := h.CreateTwoFactorUseCase().Setup(context.Background(), user)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, setup.Secret)
+	assert.True(t, strings.HasPrefix(setup.URL, "otpauth://totp/CTFBoard:alice@example.com?"))
+	require.NotNil(t, stored)
+	assert.Equal(t, user.ID, stored.UserID)
+	assert.NotEqual(t, setup.Secret, stored.SecretEncrypted)
+}
+
+func TestTwoFactorUseCase_Setup_AlreadyEnabled(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	tf, _ := h.NewTwoFactor(user.ID, true)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+
+	setup, err := h.CreateTwoFactorUseCase().Setup(context.Background(), user)
+
+	assert.ErrorIs(t, err, entityError.ErrTwoFactorAlreadyEnabled)
+	assert.Nil(t, setup)
+}
+
+func TestTwoFactorUseCase_Setup_NoCrypto(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	uc := NewTwoFactorUseCase(deps.twoFactorRepo, deps.userRepo, deps.appSettingsRepo, deps.auditLogRepo, nil)
+
+	setup, err := uc.Setup(context.Background(), h.NewUser("alice", "alice@example.com", ""))
+
+	assert.ErrorIs(t, err, crypto.ErrServiceNotConfigured)
+	assert.Nil(t, setup)
+}
+
+func TestTwoFactorUseCase_Enable_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+	tf, secret := h.NewTwoFactor(userID, false)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(tf, nil)
+	var storedHashes []string
+	deps.twoFactorRepo.EXPECT().Enable(mock.Anything, userID, mock.Anything, mock.Anything).Run(func(_ context.Context, _ uuid.UUID, _ int64, hashes []string) {
+		storedHashes = hashes
+	}).Return(nil).Once()
+
+	codes, err := h.CreateTwoFactorUseCase().Enable(context.Background(), userID, h.CurrentCode(secret))
+
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+	require.Len(t, storedHashes, recoveryCodeCount)
+	for i, code := range codes {
+		assert.Equal(t, hashRecoveryCode(code), storedHashes[i])
+		assert.Equal(t, hashRecoveryCode(strings.ToUpper(code)), storedHashes[i])
+	}
+}
+
+func TestTwoFactorUseCase_Enable_InvalidCode(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+	tf, _ := h.NewTwoFactor(userID, false)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(tf, nil)
+
+	codes, err := h.CreateTwoFactorUseCase().Enable(context.Background(), userID, "000000")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidTwoFactorCode)
+	assert.Nil(t, codes)
+}
+
+func TestTwoFactorUseCase_Enable_NotEnrolled(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(nil, entityError.ErrTwoFactorNotEnrolled)
+
+	codes, err := h.CreateTwoFactorUseCase().Enable(context.Background(), userID, "123456")
+
+	assert.ErrorIs(t, err, entityError.ErrTwoFactorNotEnrolled)
+	assert.Nil(t, codes)
+}
+
+func TestTwoFactorUseCase_Disable_TOTP(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+	tf, secret := h.NewTwoFactor(userID, true)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().ConsumeStep(mock.Anything, userID, mock.Anything).Return(true, nil).Once()
+	deps.twoFactorRepo.EXPECT().Delete(mock.Anything, userID).Return(nil).Once()
+
+	err := h.CreateTwoFactorUseCase().Disable(context.Background(), userID, h.CurrentCode(secret))
+
+	assert.NoError(t, err)
+}
+
+func TestTwoFactorUseCase_Disable_ReplayedCode(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+	tf, secret := h.NewTwoFactor(userID, true)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().ConsumeStep(mock.Anything, userID, mock.Anything).Return(false, nil).Once()
+
+	err := h.CreateTwoFactorUseCase().Disable(context.Background(), userID, h.CurrentCode(secret))
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidTwoFactorCode)
+}
+
+func TestTwoFactorUseCase_Disable_RecoveryCode(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+	tf, _ := h.NewTwoFactor(userID, true)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().UseRecoveryCode(mock.Anything, userID, hashRecoveryCode("abcde12345")).Return(true, nil).Once()
+	deps.twoFactorRepo.EXPECT().Delete(mock.Anything, userID).Return(nil).Once()
+
+	err := h.CreateTwoFactorUseCase().Disable(context.Background(), userID, "ABCDE-12345")
+
+	assert.NoError(t, err)
+}
+
+func TestTwoFactorUseCase_Disable_NotEnabled(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+	tf, _ := h.NewTwoFactor(userID, false)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(tf, nil)
+
+	err := h.CreateTwoFactorUseCase().Disable(context.Background(), userID, "123456")
+
+	assert.ErrorIs(t, err, entityError.ErrTwoFactorNotEnrolled)
+}
+
+func TestTwoFactorUseCase_RegenerateRecoveryCodes_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	userID := uuid.New()
+	tf, secret := h.NewTwoFactor(userID, true)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, userID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().ConsumeStep(mock.Anything, userID, mock.Anything).Return(true, nil).Once()
+	deps.twoFactorRepo.EXPECT().ReplaceRecoveryCodes(mock.Anything, userID, mock.Anything).Return(nil).Once()
+
+	codes, err := h.CreateTwoFactorUseCase().RegenerateRecoveryCodes(context.Background(), userID, h.CurrentCode(secret))
+
+	require.NoError(t, err)
+	assert.Len(t, codes, recoveryCodeCount)
+}
+
+func TestTwoFactorUseCase_Status_Enabled(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	tf, _ := h.NewTwoFactor(user.ID, true)
+
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().CountRecoveryCodes(mock.Anything, user.ID).Return(7, nil)
+
+	status, err := h.CreateTwoFactorUseCase().Status(context.Background(), user)
+
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	assert.False(t, status.Required)
+	assert.Equal(t, 7, status.RecoveryCodesLeft)
+}
+
+func TestTwoFactorUseCase_Reset_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	tf, _ := h.NewTwoFactor(user.ID, true)
+	actorID := uuid.New()
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().Delete(mock.Anything, user.ID).Return(nil).Once()
+	deps.auditLogRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entity.AuditLog) bool {
+		return l.Action == entity.AuditActionResetTwoFactor && l.EntityID == user.ID.String() && *l.UserID == actorID
+	})).Return(nil).Once()
+
+	err := h.CreateTwoFactorUseCase().Reset(context.Background(), user.ID, actorID, "127.0.0.1")
+
+	assert.NoError(t, err)
+}
+
+func TestTwoFactorUseCase_Reset_NotEnrolled(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(nil, entityError.ErrTwoFactorNotEnrolled)
+
+	err := h.CreateTwoFactorUseCase().Reset(context.Background(), user.ID, uuid.New(), "127.0.0.1")
+
+	assert.ErrorIs(t, err, entityError.ErrTwoFactorNotEnrolled)
+}
+
+func TestTwoFactorUseCase_RequiredButMissing(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		policy   bool
+		enrolled bool
+		enabled  bool
+		expected bool
+	}{
+		{name: "regular user", role: entity.RoleUser, policy: true, expected: false},
+		{name: "admin, policy off", role: entity.RoleAdmin, policy: false, expected: false},
+		{name: "admin, not enrolled", role: entity.RoleAdmin, policy: true, expected: true},
+		{name: "admin, pending enrollment", role: entity.RoleAdmin, policy: true, enrolled: true, expected: true},
+		{name: "admin, enabled", role: entity.RoleAdmin, policy: true, enrolled: true, enabled: true, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewUserTestHelper(t)
+			deps := h.Deps()
+			user := h.NewUser("alice", "alice@example.com", "")
+			user.Role = tt.role
+
+			if tt.role == entity.RoleAdmin {
+				deps.appSettingsRepo.EXPECT().Get(mock.Anything).Return(&entity.AppSettings{Require2FAForAdmins: tt.policy}, nil)
+			}
+			if tt.role == entity.RoleAdmin && tt.policy {
+				if tt.enrolled {
+					tf, _ := h.NewTwoFactor(user.ID, tt.enabled)
+					deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+				} else {
+					deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(nil, entityError.ErrTwoFactorNotEnrolled)
+				}
+			}
+
+			missing, err := h.CreateTwoFactorUseCase().RequiredButMissing(context.Background(), user)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, missing)
+		})
+	}
+}
+
+func TestUserUseCase_Login_TwoFactorChallenge(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", h.HashPassword("password123"))
+	tf, _ := h.NewTwoFactor(user.ID, true)
+
+	deps.userRepo.EXPECT().GetByEmail(mock.Anything, user.Email).Return(user, nil)
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+	deps.jwtService.EXPECT().GenerateMFAToken(user.ID).Return("challenge", int64(1234), nil)
+
+	res, err := h.CreateUseCase().Login(context.Background(), user.Email, "password123", "127.0.0.1", "test-agent")
+
+	require.NoError(t, err)
+	assert.Nil(t, res.Tokens)
+	assert.Equal(t, "challenge", res.ChallengeToken)
+	assert.Equal(t, int64(1234), res.ChallengeExpiresAt)
+}
+
+func TestUserUseCase_LoginTwoFactor_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	tf, secret := h.NewTwoFactor(user.ID, true)
+
+	deps.jwtService.EXPECT().ValidateMFAToken("challenge").Return(&jwt.CustomClaims{UserID: user.ID.String(), TokenType: jwt.TokenTypeMFA}, nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().ConsumeStep(mock.Anything, user.ID, mock.Anything).Return(true, nil).Once()
+	deps.jwtService.EXPECT().GenerateTokenPair(user.ID, user.Email, user.Username, user.Role).Return(&jwt.TokenPair{AccessToken: "access", FamilyID: uuid.New()}, nil)
+	deps.sessionRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+
+	tokenPair, err := h.CreateUseCase().LoginTwoFactor(context.Background(), "challenge", h.CurrentCode(secret), "127.0.0.1", "test-agent")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access", tokenPair.AccessToken)
+}
+
+func TestUserUseCase_LoginTwoFactor_BannedSinceChallenge(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	user.IsBanned = true
+
+	deps.jwtService.EXPECT().ValidateMFAToken("challenge").Return(&jwt.CustomClaims{UserID: user.ID.String(), TokenType: jwt.TokenTypeMFA}, nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+
+	tokenPair, err := h.CreateUseCase().LoginTwoFactor(context.Background(), "challenge", "123456", "127.0.0.1", "test-agent")
+
+	assert.ErrorIs(t, err, entityError.ErrUserBanned)
+	assert.Nil(t, tokenPair)
+	deps.sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestUserUseCase_LoginTwoFactor_InvalidChallenge(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.jwtService.EXPECT().ValidateMFAToken("bad").Return(nil, assert.AnError)
+
+	tokenPair, err := h.CreateUseCase().LoginTwoFactor(context.Background(), "bad", "123456", "127.0.0.1", "test-agent")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidChallengeToken)
+	assert.Nil(t, tokenPair)
+}
+
+func TestUserUseCase_LoginTwoFactor_InvalidCode(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+	user := h.NewUser("alice", "alice@example.com", "")
+	tf, _ := h.NewTwoFactor(user.ID, true)
+
+	deps.jwtService.EXPECT().ValidateMFAToken("challenge").Return(&jwt.CustomClaims{UserID: user.ID.String(), TokenType: jwt.TokenTypeMFA}, nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.twoFactorRepo.EXPECT().GetByUserID(mock.Anything, user.ID).Return(tf, nil)
+	deps.twoFactorRepo.EXPECT().UseRecoveryCode(mock.Anything, user.ID, mock.Anything).Return(false, nil).Once()
+
+	tokenPair, err := h.CreateUseCase().LoginTwoFactor(context.Background(), "challenge", "not-a-code", "127.0.0.1", "test-agent")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidTwoFactorCode)
+	assert.Nil(t, tokenPair)
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
 	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/internal/usecase/settings"
+	"github.com/skr1ms/CTFBoard/pkg/crypto"
 	"github.com/skr1ms/CTFBoard/pkg/jwt"
 	"github.com/skr1ms/CTFBoard/pkg/usecaseutil"
 	"golang.org/x/crypto/bcrypt"
@@ -23,6 +25,13 @@ type UserDeps struct {
 	JWTService     jwt.Service
 	FieldValidator *settings.FieldValidator
 	FieldValueRepo repo.FieldValueRepository
+	SessionRepo    repo.SessionRepository
+	TwoFactorRepo  repo.TwoFactorRepository
+	Crypto         crypto.Service
+	// Lockout is optional; without it failed logins are not tracked.
+	Lockout *LockoutUseCase
+	// Registration is optional; without it anyone may register.
+	Registration *RegistrationUseCase
 }
 
 type UserUseCase struct {
@@ -33,7 +42,12 @@ func NewUserUseCase(deps UserDeps) *UserUseCase {
 	return &UserUseCase{deps: deps}
 }
 
-func (uc *UserUseCase) Register(ctx context.Context, username, email, password string, customFields map[string]string) (*entity.User, error) {
+// Register creates a user. inviteCode is only required, and only consumed, while registration is closed.
+func (uc *UserUseCase) Register(ctx context.Context, username, email, password, inviteCode string, customFields map[string]string) (*entity.User, error) {
+	inviteCode, err := uc.admit(ctx, email, inviteCode)
+	if err != nil {
+		return nil, err
+	}
 	if err := uc.registerValidateCustomFields(ctx, customFields); err != nil {
 		return nil, err
 	}
@@ -54,6 +68,11 @@ func (uc *UserUseCase) Register(ctx context.Context, username, email, password s
 		Role:         entity.RoleUser,
 	}
 	if err := uc.deps.TxRepo.RunTransaction(ctx, func(ctx context.Context, tx repo.Transaction) error {
+		if inviteCode != "" {
+			if _, err := uc.deps.TxRepo.ConsumeRegistrationInviteTx(ctx, tx, inviteCode); err != nil {
+				return err
+			}
+		}
 		return uc.deps.TxRepo.CreateUserTx(ctx, tx, user)
 	}); err != nil {
 		return nil, usecaseutil.Wrap(err, "UserUseCase - Register - Transaction")
@@ -64,6 +83,18 @@ func (uc *UserUseCase) Register(ctx context.Context, username, email, password s
 	return user, nil
 }
 
+// admit applies the registration rules to email and returns the invite code to consume, if any.
+func (uc *UserUseCase) admit(ctx context.Context, email, inviteCode string) (string, error) {
+	if uc.deps.Registration == nil {
+		return "", nil
+	}
+	code, err := uc.deps.Registration.Admit(ctx, email, inviteCode)
+	if err != nil {
+		return "", usecaseutil.Wrap(err, "UserUseCase - Register - Admit")
+	}
+	return code, nil
+}
+
 func (uc *UserUseCase) registerValidateCustomFields(ctx context.Context, customFields map[string]string) error {
 	if len(customFields) == 0 || uc.deps.FieldValidator == nil {
 		return nil
@@ -114,28 +145,258 @@ func (uc *UserUseCase) registerSetCustomFields(ctx context.Context, userID uuid.
 	return nil
 }
 
-func (uc *UserUseCase) Login(ctx context.Context, email, password string) (*jwt.TokenPair, error) {
+// LoginResult carries either a token pair or, for accounts with 2FA enabled, a challenge token
+// that must be exchanged together with a second factor via LoginTwoFactor.
+type LoginResult struct {
+	Tokens             *jwt.TokenPair
+	ChallengeToken     string
+	ChallengeExpiresAt int64
+}
+
+// Login checks credentials and opens a new session recording the client's IP and user agent.
+func (uc *UserUseCase) Login(ctx context.Context, email, password, clientIP, userAgent string) (*LoginResult, error) {
+	if err := uc.checkLockout(ctx, email, clientIP); err != nil {
+		return nil, err
+	}
+
 	user, err := uc.deps.UserRepo.GetByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, entityError.ErrUserNotFound) {
-			return nil, entityError.ErrInvalidCredentials
+			return nil, uc.loginFailed(ctx, email, clientIP, nil, entityError.ErrInvalidCredentials)
 		}
 		return nil, usecaseutil.Wrap(err, "UserUseCase - Login - GetByEmail")
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
 	if err != nil {
-		return nil, entityError.ErrInvalidCredentials
+		return nil, uc.loginFailed(ctx, email, clientIP, user, entityError.ErrInvalidCredentials)
+	}
+
+	res, err := uc.completeLogin(ctx, user, clientIP, userAgent)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "UserUseCase - Login")
+	}
+	// With 2FA the password alone proves nothing yet; failures are cleared by LoginTwoFactor.
+	if res.Tokens != nil {
+		if err := uc.loginSucceeded(ctx, email); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// completeLogin is the shared tail of every first-factor login (password or external provider):
+// it rejects banned accounts, issues a 2FA challenge when the account has 2FA enabled and opens a
+// session otherwise.
+func (uc *UserUseCase) completeLogin(ctx context.Context, user *entity.User, clientIP, userAgent string) (*LoginResult, error) {
+	if user.IsBanned {
+		return nil, entityError.ErrUserBanned
+	}
+	tf, err := uc.deps.TwoFactorRepo.GetByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, entityError.ErrTwoFactorNotEnrolled) {
+		return nil, usecaseutil.Wrap(err, "GetTwoFactor")
+	}
+	if err == nil && tf.IsEnabled() {
+		challengeToken, expiresAt, err := uc.deps.JWTService.GenerateMFAToken(user.ID)
+		if err != nil {
+			return nil, usecaseutil.Wrap(err, "GenerateMFAToken")
+		}
+		return &LoginResult{ChallengeToken: challengeToken, ChallengeExpiresAt: expiresAt}, nil
+	}
+
+	tokenPair, err := uc.openSession(ctx, user, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{Tokens: tokenPair}, nil
+}
+
+// LoginTwoFactor completes a two-step login: the challenge token from Login plus a TOTP or recovery code.
+func (uc *UserUseCase) LoginTwoFactor(ctx context.Context, challengeToken, code, clientIP, userAgent string) (*jwt.TokenPair, error) {
+	claims, err := uc.deps.JWTService.ValidateMFAToken(challengeToken)
+	if err != nil {
+		return nil, entityError.ErrInvalidChallengeToken
+	}
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return nil, entityError.ErrInvalidChallengeToken
+	}
+
+	user, err := uc.deps.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrUserNotFound) {
+			return nil, entityError.ErrInvalidChallengeToken
+		}
+		return nil, usecaseutil.Wrap(err, "UserUseCase - LoginTwoFactor - GetByID")
+	}
+	// The account may have been banned since the password step issued the challenge token.
+	if user.IsBanned {
+		return nil, entityError.ErrUserBanned
+	}
+
+	tf, err := uc.deps.TwoFactorRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrTwoFactorNotEnrolled) {
+			return nil, entityError.ErrInvalidChallengeToken
+		}
+		return nil, usecaseutil.Wrap(err, "UserUseCase - LoginTwoFactor - GetTwoFactor")
+	}
+	if !tf.IsEnabled() {
+		return nil, entityError.ErrInvalidChallengeToken
+	}
+	if err := uc.checkLockout(ctx, user.Email, clientIP); err != nil {
+		return nil, err
+	}
+	if err := verifySecondFactor(ctx, uc.deps.TwoFactorRepo, uc.deps.Crypto, tf, code); err != nil {
+		if errors.Is(err, entityError.ErrInvalidTwoFactorCode) {
+			return nil, uc.loginFailed(ctx, user.Email, clientIP, user, err)
+		}
+		return nil, err
+	}
+
+	tokenPair, err := uc.openSession(ctx, user, clientIP, userAgent)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "UserUseCase - LoginTwoFactor")
+	}
+	if err := uc.loginSucceeded(ctx, user.Email); err != nil {
+		return nil, err
+	}
+	return tokenPair, nil
+}
+
+func (uc *UserUseCase) checkLockout(ctx context.Context, email, clientIP string) error {
+	if uc.deps.Lockout == nil {
+		return nil
+	}
+	return uc.deps.Lockout.Check(ctx, email, clientIP)
+}
+
+// loginFailed records a failed attempt and returns cause, or the tracking error if recording failed.
+func (uc *UserUseCase) loginFailed(ctx context.Context, email, clientIP string, user *entity.User, cause error) error {
+	if uc.deps.Lockout == nil {
+		return cause
+	}
+	if err := uc.deps.Lockout.RecordFailure(ctx, email, clientIP, user); err != nil {
+		return usecaseutil.Wrap(err, "UserUseCase - RecordFailure")
 	}
+	return cause
+}
+
+// loginSucceeded clears the failure counter of email once a session has been issued.
+func (uc *UserUseCase) loginSucceeded(ctx context.Context, email string) error {
+	if uc.deps.Lockout == nil {
+		return nil
+	}
+	if err := uc.deps.Lockout.RecordSuccess(ctx, email); err != nil {
+		return usecaseutil.Wrap(err, "UserUseCase - RecordSuccess")
+	}
+	return nil
+}
 
+func (uc *UserUseCase) openSession(ctx context.Context, user *entity.User, clientIP, userAgent string) (*jwt.TokenPair, error) {
 	tokenPair, err := uc.deps.JWTService.GenerateTokenPair(user.ID, user.Email, user.Username, user.Role)
 	if err != nil {
-		return nil, usecaseutil.Wrap(err, "UserUseCase - Login - GenerateTokenPair")
+		return nil, usecaseutil.Wrap(err, "GenerateTokenPair")
+	}
+
+	session := &entity.Session{
+		ID:         tokenPair.FamilyID,
+		UserID:     user.ID,
+		RefreshJTI: tokenPair.RefreshTokenID,
+		AccessJTI:  tokenPair.AccessTokenID,
+		IP:         clientIP,
+		UserAgent:  userAgent,
+		ExpiresAt:  time.Unix(tokenPair.RefreshExpiresAt, 0),
+	}
+	if err := uc.deps.SessionRepo.Create(ctx, session); err != nil {
+		return nil, usecaseutil.Wrap(err, "CreateSession")
 	}
 
 	return tokenPair, nil
 }
 
+// Refresh rotates the token pair of a session. Presenting a refresh token that is no longer current
+// means it was stolen or replayed, so the whole session is revoked.
+func (uc *UserUseCase) Refresh(ctx context.Context, refreshToken string) (*jwt.TokenPair, error) {
+	claims, session, err := uc.resolveSession(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsActive() {
+		return nil, entityError.ErrInvalidRefreshToken
+	}
+	if session.RefreshJTI != claims.ID {
+		return nil, uc.revokeReusedSession(ctx, session.ID)
+	}
+
+	user, err := uc.deps.UserRepo.GetByID(ctx, session.UserID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrUserNotFound) {
+			return nil, entityError.ErrInvalidRefreshToken
+		}
+		return nil, usecaseutil.Wrap(err, "UserUseCase - Refresh - GetByID")
+	}
+	if user.IsBanned {
+		return nil, entityError.ErrUserBanned
+	}
+
+	tokenPair, err := uc.deps.JWTService.GenerateTokenPairForFamily(user.ID, user.Email, user.Username, user.Role, session.ID)
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "UserUseCase - Refresh - GenerateTokenPairForFamily")
+	}
+
+	rotated, err := uc.deps.SessionRepo.Rotate(ctx, session.ID, claims.ID, tokenPair.RefreshTokenID, tokenPair.AccessTokenID, time.Unix(tokenPair.RefreshExpiresAt, 0))
+	if err != nil {
+		return nil, usecaseutil.Wrap(err, "UserUseCase - Refresh - Rotate")
+	}
+	if !rotated {
+		return nil, uc.revokeReusedSession(ctx, session.ID)
+	}
+
+	return tokenPair, nil
+}
+
+// Logout revokes the session of the given refresh token, invalidating every token issued from the same login.
+func (uc *UserUseCase) Logout(ctx context.Context, refreshToken string) error {
+	_, session, err := uc.resolveSession(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+	if err := uc.deps.SessionRepo.Revoke(ctx, session.ID); err != nil {
+		return usecaseutil.Wrap(err, "UserUseCase - Logout - Revoke")
+	}
+	return nil
+}
+
+func (uc *UserUseCase) resolveSession(ctx context.Context, refreshToken string) (*jwt.CustomClaims, *entity.Session, error) {
+	claims, err := uc.deps.JWTService.ValidateRefreshToken(refreshToken)
+	if err != nil {
+		return nil, nil, entityError.ErrInvalidRefreshToken
+	}
+	sessionID, err := uuid.Parse(claims.FamilyID)
+	if err != nil {
+		return nil, nil, entityError.ErrInvalidRefreshToken
+	}
+	session, err := uc.deps.SessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, entityError.ErrSessionNotFound) {
+			return nil, nil, entityError.ErrInvalidRefreshToken
+		}
+		return nil, nil, usecaseutil.Wrap(err, "UserUseCase - resolveSession - GetByID")
+	}
+	if session.UserID.String() != claims.UserID {
+		return nil, nil, entityError.ErrInvalidRefreshToken
+	}
+	return claims, session, nil
+}
+
+func (uc *UserUseCase) revokeReusedSession(ctx context.Context, sessionID uuid.UUID) error {
+	if err := uc.deps.SessionRepo.Revoke(ctx, sessionID); err != nil {
+		return usecaseutil.Wrap(err, "UserUseCase - Refresh - Revoke")
+	}
+	return entityError.ErrRefreshTokenReused
+}
+
 func (uc *UserUseCase) GetByID(ctx context.Context, ID uuid.UUID) (*entity.User, error) {
 	user, err := uc.deps.UserRepo.GetByID(ctx, ID)
 	if err != nil {
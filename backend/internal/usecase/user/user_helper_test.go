@@ -2,22 +2,31 @@ package user
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/skr1ms/CTFBoard/internal/entity"
 	entityError "github.com/skr1ms/CTFBoard/internal/entity/error"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/internal/usecase/user/mocks"
+	"github.com/skr1ms/CTFBoard/pkg/crypto"
 	"github.com/skr1ms/CTFBoard/pkg/jwt"
+	"github.com/skr1ms/CTFBoard/pkg/oidc"
+	"github.com/skr1ms/CTFBoard/pkg/oidc/oidctest"
+	"github.com/skr1ms/CTFBoard/pkg/totp"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const testEncryptionKey = "1234567890123456789012345678901212345678901234567890123456789012"
+
 type UserTestHelper struct {
-	t    *testing.T
-	deps *testDependencies
+	t      *testing.T
+	deps   *testDependencies
+	crypto crypto.Service
 }
 
 type testDependencies struct {
@@ -27,12 +36,34 @@ type testDependencies struct {
 	txRepo       *mocks.MockTxRepository
 	jwtService   *mocks.MockJWTService
 	apiTokenRepo *mocks.MockAPITokenRepository
+	sessionRepo  *mocks.MockSessionRepository
+	auditLogRepo *mocks.MockAuditLogRepository
+
+	twoFactorRepo   *mocks.MockTwoFactorRepository
+	appSettingsRepo *mocks.MockAppSettingsRepository
+
+	identityRepo   *mocks.MockUserIdentityRepository
+	configRepo     *mocks.MockConfigRepository
+	fieldValueRepo *mocks.MockFieldValueRepository
+
+	roleRepo            *mocks.MockRoleRepository
+	challengeAuthorRepo *mocks.MockChallengeAuthorRepository
+
+	emailSender *mocks.MockEmailChangeSender
+
+	registrationRepo *mocks.MockRegistrationRepository
+	bracketRepo      *mocks.MockBracketRepository
 }
 
 func NewUserTestHelper(t *testing.T) *UserTestHelper {
 	t.Helper()
+	cryptoService, err := crypto.NewCryptoService(testEncryptionKey)
+	if err != nil {
+		t.Fatalf("NewCryptoService: %v", err)
+	}
 	return &UserTestHelper{
-		t: t,
+		t:      t,
+		crypto: cryptoService,
 		deps: &testDependencies{
 			userRepo:     mocks.NewMockUserRepository(t),
 			teamRepo:     mocks.NewMockTeamRepository(t),
@@ -40,6 +71,23 @@ func NewUserTestHelper(t *testing.T) *UserTestHelper {
 			txRepo:       mocks.NewMockTxRepository(t),
 			jwtService:   mocks.NewMockJWTService(t),
 			apiTokenRepo: mocks.NewMockAPITokenRepository(t),
+			sessionRepo:  mocks.NewMockSessionRepository(t),
+			auditLogRepo: mocks.NewMockAuditLogRepository(t),
+
+			twoFactorRepo:   mocks.NewMockTwoFactorRepository(t),
+			appSettingsRepo: mocks.NewMockAppSettingsRepository(t),
+
+			identityRepo:   mocks.NewMockUserIdentityRepository(t),
+			configRepo:     mocks.NewMockConfigRepository(t),
+			fieldValueRepo: mocks.NewMockFieldValueRepository(t),
+
+			roleRepo:            mocks.NewMockRoleRepository(t),
+			challengeAuthorRepo: mocks.NewMockChallengeAuthorRepository(t),
+
+			emailSender: mocks.NewMockEmailChangeSender(t),
+
+			registrationRepo: mocks.NewMockRegistrationRepository(t),
+			bracketRepo:      mocks.NewMockBracketRepository(t),
 		},
 	}
 }
@@ -49,6 +97,88 @@ func (h *UserTestHelper) CreateUseCase() *UserUseCase {
 	return NewUserUseCase(UserDeps{
 		UserRepo: h.deps.userRepo, TeamRepo: h.deps.teamRepo, SolveRepo: h.deps.solveRepo,
 		TxRepo: h.deps.txRepo, JWTService: h.deps.jwtService, FieldValidator: nil, FieldValueRepo: nil,
+		SessionRepo: h.deps.sessionRepo, TwoFactorRepo: h.deps.twoFactorRepo, Crypto: h.crypto,
+	})
+}
+
+func (h *UserTestHelper) CreateSessionUseCase() *SessionUseCase {
+	h.t.Helper()
+	return NewSessionUseCase(h.deps.sessionRepo, h.deps.userRepo, h.deps.teamRepo, h.deps.auditLogRepo)
+}
+
+func (h *UserTestHelper) CreateTwoFactorUseCase() *TwoFactorUseCase {
+	h.t.Helper()
+	return NewTwoFactorUseCase(h.deps.twoFactorRepo, h.deps.userRepo, h.deps.appSettingsRepo, h.deps.auditLogRepo, h.crypto)
+}
+
+func (h *UserTestHelper) CreateOAuthUseCase(redisClient *redis.Client) *OAuthUseCase {
+	h.t.Helper()
+	return NewOAuthUseCase(OAuthDeps{
+		ConfigRepo: h.deps.configRepo, IdentityRepo: h.deps.identityRepo, UserRepo: h.deps.userRepo,
+		TxRepo: h.deps.txRepo, FieldValueRepo: h.deps.fieldValueRepo, AuditLogRepo: h.deps.auditLogRepo,
+		Crypto: h.crypto, Redis: redisClient, OIDC: oidc.NewClient(nil), Users: h.CreateUseCase(),
+	})
+}
+
+func (h *UserTestHelper) CreateLockoutUseCase(redisClient *redis.Client) *LockoutUseCase {
+	h.t.Helper()
+	return NewLockoutUseCase(redisClient, h.deps.userRepo, h.deps.auditLogRepo, LockoutPolicy{
+		MaxAttempts:   3,
+		IPMaxAttempts: 10,
+		Window:        15 * time.Minute,
+		BaseLockout:   time.Minute,
+		MaxLockout:    10 * time.Minute,
+	})
+}
+
+func (h *UserTestHelper) CreateRoleUseCase() *RoleUseCase {
+	h.t.Helper()
+	return NewRoleUseCase(h.deps.roleRepo, h.deps.userRepo, h.deps.challengeAuthorRepo, h.deps.txRepo)
+}
+
+func (h *UserTestHelper) CreateAdminUserUseCase() *AdminUserUseCase {
+	h.t.Helper()
+	return NewAdminUserUseCase(AdminUserDeps{
+		UserRepo: h.deps.userRepo, TxRepo: h.deps.txRepo, SessionRepo: h.deps.sessionRepo,
+	})
+}
+
+func (h *UserTestHelper) CreateAccountUseCase() *AccountUseCase {
+	h.t.Helper()
+	return NewAccountUseCase(AccountDeps{
+		UserRepo: h.deps.userRepo, TxRepo: h.deps.txRepo, SessionRepo: h.deps.sessionRepo, Email: h.deps.emailSender,
+	})
+}
+
+func (h *UserTestHelper) CreateRegistrationUseCase() *RegistrationUseCase {
+	h.t.Helper()
+	return NewRegistrationUseCase(h.deps.appSettingsRepo, h.deps.registrationRepo, h.deps.bracketRepo, h.deps.auditLogRepo)
+}
+
+func (h *UserTestHelper) CreateUseCaseWithRegistration() *UserUseCase {
+	h.t.Helper()
+	return NewUserUseCase(UserDeps{
+		UserRepo: h.deps.userRepo, TeamRepo: h.deps.teamRepo, SolveRepo: h.deps.solveRepo,
+		TxRepo: h.deps.txRepo, JWTService: h.deps.jwtService, SessionRepo: h.deps.sessionRepo,
+		TwoFactorRepo: h.deps.twoFactorRepo, Crypto: h.crypto, Registration: h.CreateRegistrationUseCase(),
+	})
+}
+
+// ExpectTransaction makes RunTransaction run its callback without a real transaction.
+func (h *UserTestHelper) ExpectTransaction() {
+	h.t.Helper()
+	h.deps.txRepo.EXPECT().RunTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context, repo.Transaction) error) error {
+		return fn(ctx, nil)
+	})
+}
+
+// CreateUseCaseWithLockout returns a UserUseCase that tracks failed logins through lockout.
+func (h *UserTestHelper) CreateUseCaseWithLockout(lockout *LockoutUseCase) *UserUseCase {
+	h.t.Helper()
+	return NewUserUseCase(UserDeps{
+		UserRepo: h.deps.userRepo, TeamRepo: h.deps.teamRepo, SolveRepo: h.deps.solveRepo,
+		TxRepo: h.deps.txRepo, JWTService: h.deps.jwtService, SessionRepo: h.deps.sessionRepo,
+		TwoFactorRepo: h.deps.twoFactorRepo, Crypto: h.crypto, Lockout: lockout,
 	})
 }
 
@@ -120,6 +250,32 @@ func (h *UserTestHelper) SetupLoginMocks(email, password string) {
 	h.deps.jwtService.EXPECT().GenerateTokenPair(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tokenPair, nil)
 }
 
+func (h *UserTestHelper) NewSession(userID uuid.UUID, refreshJTI string) *entity.Session {
+	h.t.Helper()
+	return &entity.Session{
+		ID:         uuid.New(),
+		UserID:     userID,
+		RefreshJTI: refreshJTI,
+		AccessJTI:  "access-" + refreshJTI,
+		IP:         "127.0.0.1",
+		UserAgent:  "test-agent",
+		ExpiresAt:  time.Now().Add(time.Hour),
+		LastSeenAt: time.Now(),
+		CreatedAt:  time.Now(),
+	}
+}
+
+func (h *UserTestHelper) NewRefreshClaims(session *entity.Session, jti string) *jwt.CustomClaims {
+	h.t.Helper()
+	claims := &jwt.CustomClaims{
+		UserID:    session.UserID.String(),
+		TokenType: jwt.TokenTypeRefresh,
+		FamilyID:  session.ID.String(),
+	}
+	claims.ID = jti
+	return claims
+}
+
 func (h *UserTestHelper) SetupRegisterSuccessMocks(username, email string) {
 	h.t.Helper()
 	h.deps.userRepo.EXPECT().GetByUsername(mock.Anything, username).Return(nil, entityError.ErrUserNotFound)
@@ -134,17 +290,76 @@ func (h *UserTestHelper) SetupRegisterSuccessMocks(username, email string) {
 
 func (h *UserTestHelper) CreateAPITokenUseCase() *APITokenUseCase {
 	h.t.Helper()
-	return NewAPITokenUseCase(h.deps.apiTokenRepo)
+	return NewAPITokenUseCase(h.deps.apiTokenRepo, h.deps.userRepo, h.deps.auditLogRepo)
 }
 
 func (h *UserTestHelper) NewAPIToken(userID uuid.UUID, tokenHash, description string, expiresAt *time.Time) *entity.APIToken {
 	h.t.Helper()
 	return &entity.APIToken{
 		ID:          uuid.New(),
-		UserID:      userID,
+		UserID:      &userID,
 		TokenHash:   tokenHash,
 		Description: description,
 		ExpiresAt:   expiresAt,
 		CreatedAt:   time.Now(),
 	}
 }
+
+// NewTwoFactor returns an enrollment with a freshly generated secret, encrypted like the use case stores it.
+func (h *UserTestHelper) NewTwoFactor(userID uuid.UUID, enabled bool) (*entity.TwoFactor, string) {
+	h.t.Helper()
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		h.t.Fatalf("GenerateSecret: %v", err)
+	}
+	encrypted, err := h.crypto.Encrypt(secret)
+	if err != nil {
+		h.t.Fatalf("Encrypt: %v", err)
+	}
+	tf := &entity.TwoFactor{UserID: userID, SecretEncrypted: encrypted, CreatedAt: time.Now()}
+	if enabled {
+		enabledAt := time.Now()
+		tf.EnabledAt = &enabledAt
+	}
+	return tf, secret
+}
+
+func (h *UserTestHelper) CurrentCode(secret string) string {
+	h.t.Helper()
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	if err != nil {
+		h.t.Fatalf("totp.Code: %v", err)
+	}
+	return code
+}
+
+// NewOIDCIssuer starts an in-process OIDC issuer that is closed with the test.
+func (h *UserTestHelper) NewOIDCIssuer() *oidctest.Issuer {
+	h.t.Helper()
+	iss, err := oidctest.NewIssuer("ctfboard", "client-secret")
+	if err != nil {
+		h.t.Fatalf("NewIssuer: %v", err)
+	}
+	h.t.Cleanup(iss.Close)
+	return iss
+}
+
+// NewOAuthProviderConfig returns the stored config row for a provider pointing at iss.
+func (h *UserTestHelper) NewOAuthProviderConfig(iss *oidctest.Issuer, autoProvision bool, fieldClaims map[string]string) (*entity.OAuthProvider, *entity.Config) {
+	h.t.Helper()
+	secret, err := h.crypto.Encrypt(iss.ClientSecret)
+	if err != nil {
+		h.t.Fatalf("Encrypt: %v", err)
+	}
+	p := &entity.OAuthProvider{
+		Name: "uni", DisplayName: "University SSO", Enabled: true, Issuer: iss.URL,
+		ClientID: iss.ClientID, ClientSecretEncrypted: secret, RedirectURL: "http://localhost:3000/oauth/callback",
+		Scopes: []string{"openid", "email"}, SubjectClaim: "sub", EmailClaim: "email",
+		UsernameClaim: "preferred_username", FieldClaims: fieldClaims, AutoProvision: autoProvision,
+	}
+	raw, err := json.Marshal(p)
+	if err != nil {
+		h.t.Fatalf("Marshal: %v", err)
+	}
+	return p, &entity.Config{Key: p.ConfigKey(), Value: string(raw), ValueType: entity.ConfigTypeJSON}
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/skr1ms/CTFBoard/internal/entity"
@@ -87,7 +88,7 @@ func runRegisterTest(t *testing.T, tt registerTestCase) {
 	h := NewUserTestHelper(t)
 	tt.setupMocks(h.Deps().userRepo, h.Deps().txRepo)
 	uc := h.CreateUseCase()
-	user, err := uc.Register(context.Background(), tt.username, tt.email, tt.password, nil)
+	user, err := uc.Register(context.Background(), tt.username, tt.email, tt.password, "", nil)
 	if tt.expectedError {
 		assert.Error(t, err)
 		assert.Nil(t, user)
@@ -180,14 +181,23 @@ func runLoginTest(t *testing.T, tt loginTestCase) {
 	if tt.name == "successful login" {
 		h.SetupLoginMocks(tt.email, tt.password)
 	}
-	tokenPair, err := uc.Login(context.Background(), tt.email, tt.password)
+	h.Deps().twoFactorRepo.EXPECT().GetByUserID(mock.Anything, mock.Anything).Return(nil, entityError.ErrTwoFactorNotEnrolled).Maybe()
+	if !tt.expectedError {
+		h.Deps().sessionRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Run(func(_ context.Context, session *entity.Session) {
+			assert.Equal(t, "127.0.0.1", session.IP)
+			assert.Equal(t, "test-agent", session.UserAgent)
+		}).Once()
+	}
+	res, err := uc.Login(context.Background(), tt.email, tt.password, "127.0.0.1", "test-agent")
 	if tt.expectedError {
 		assert.Error(t, err)
-		assert.Nil(t, tokenPair)
+		assert.Nil(t, res)
 	} else {
 		assert.NoError(t, err)
-		assert.NotNil(t, tokenPair)
-		assert.NotEmpty(t, tokenPair.AccessToken)
+		require.NotNil(t, res)
+		require.NotNil(t, res.Tokens)
+		assert.NotEmpty(t, res.Tokens.AccessToken)
+		assert.Empty(t, res.ChallengeToken)
 	}
 }
 
@@ -303,3 +313,130 @@ func TestUserUseCase_GetProfile_GetByUserIDError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, profile)
 }
+
+func TestUserUseCase_Refresh_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	user := h.NewUser("testuser", "test@example.com", "")
+	session := h.NewSession(user.ID, "jti-1")
+	newPair := &jwt.TokenPair{AccessToken: "access", RefreshToken: "refresh-2", FamilyID: session.ID, AccessTokenID: "access-jti-2", RefreshTokenID: "jti-2"}
+
+	deps.jwtService.EXPECT().ValidateRefreshToken("refresh-1").Return(h.NewRefreshClaims(session, "jti-1"), nil)
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.jwtService.EXPECT().GenerateTokenPairForFamily(user.ID, user.Email, user.Username, user.Role, session.ID).Return(newPair, nil)
+	deps.sessionRepo.EXPECT().Rotate(mock.Anything, session.ID, "jti-1", "jti-2", "access-jti-2", mock.Anything).Return(true, nil)
+
+	pair, err := h.CreateUseCase().Refresh(context.Background(), "refresh-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-2", pair.RefreshToken)
+}
+
+func TestUserUseCase_Refresh_InvalidToken(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	deps.jwtService.EXPECT().ValidateRefreshToken("bad").Return(nil, assert.AnError)
+
+	pair, err := h.CreateUseCase().Refresh(context.Background(), "bad")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidRefreshToken)
+	assert.Nil(t, pair)
+}
+
+func TestUserUseCase_Refresh_SessionNotFound(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	session := h.NewSession(uuid.New(), "jti-1")
+	deps.jwtService.EXPECT().ValidateRefreshToken("refresh-1").Return(h.NewRefreshClaims(session, "jti-1"), nil)
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(nil, entityError.ErrSessionNotFound)
+
+	pair, err := h.CreateUseCase().Refresh(context.Background(), "refresh-1")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidRefreshToken)
+	assert.Nil(t, pair)
+}
+
+func TestUserUseCase_Refresh_RevokedSession(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	session := h.NewSession(uuid.New(), "jti-1")
+	revokedAt := time.Now()
+	session.RevokedAt = &revokedAt
+	deps.jwtService.EXPECT().ValidateRefreshToken("refresh-1").Return(h.NewRefreshClaims(session, "jti-1"), nil)
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+
+	pair, err := h.CreateUseCase().Refresh(context.Background(), "refresh-1")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidRefreshToken)
+	assert.Nil(t, pair)
+}
+
+func TestUserUseCase_Refresh_ReuseRevokesSession(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	session := h.NewSession(uuid.New(), "jti-2")
+	deps.jwtService.EXPECT().ValidateRefreshToken("refresh-1").Return(h.NewRefreshClaims(session, "jti-1"), nil)
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+	deps.sessionRepo.EXPECT().Revoke(mock.Anything, session.ID).Return(nil).Once()
+
+	pair, err := h.CreateUseCase().Refresh(context.Background(), "refresh-1")
+
+	assert.ErrorIs(t, err, entityError.ErrRefreshTokenReused)
+	assert.Nil(t, pair)
+}
+
+func TestUserUseCase_Refresh_ConcurrentRotationRevokesSession(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	user := h.NewUser("testuser", "test@example.com", "")
+	session := h.NewSession(user.ID, "jti-1")
+	newPair := &jwt.TokenPair{RefreshToken: "refresh-2", FamilyID: session.ID, RefreshTokenID: "jti-2"}
+
+	deps.jwtService.EXPECT().ValidateRefreshToken("refresh-1").Return(h.NewRefreshClaims(session, "jti-1"), nil)
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+	deps.userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil)
+	deps.jwtService.EXPECT().GenerateTokenPairForFamily(mock.Anything, mock.Anything, mock.Anything, mock.Anything, session.ID).Return(newPair, nil)
+	deps.sessionRepo.EXPECT().Rotate(mock.Anything, session.ID, "jti-1", "jti-2", mock.Anything, mock.Anything).Return(false, nil)
+	deps.sessionRepo.EXPECT().Revoke(mock.Anything, session.ID).Return(nil).Once()
+
+	pair, err := h.CreateUseCase().Refresh(context.Background(), "refresh-1")
+
+	assert.ErrorIs(t, err, entityError.ErrRefreshTokenReused)
+	assert.Nil(t, pair)
+}
+
+func TestUserUseCase_Logout_Success(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	session := h.NewSession(uuid.New(), "jti-1")
+	deps.jwtService.EXPECT().ValidateRefreshToken("refresh-1").Return(h.NewRefreshClaims(session, "jti-1"), nil)
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+	deps.sessionRepo.EXPECT().Revoke(mock.Anything, session.ID).Return(nil).Once()
+
+	err := h.CreateUseCase().Logout(context.Background(), "refresh-1")
+
+	assert.NoError(t, err)
+}
+
+func TestUserUseCase_Logout_ForeignSession(t *testing.T) {
+	h := NewUserTestHelper(t)
+	deps := h.Deps()
+
+	session := h.NewSession(uuid.New(), "jti-1")
+	claims := h.NewRefreshClaims(session, "jti-1")
+	claims.UserID = uuid.New().String()
+	deps.jwtService.EXPECT().ValidateRefreshToken("refresh-1").Return(claims, nil)
+	deps.sessionRepo.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+
+	err := h.CreateUseCase().Logout(context.Background(), "refresh-1")
+
+	assert.ErrorIs(t, err, entityError.ErrInvalidRefreshToken)
+}
@@ -4,9 +4,12 @@ import (
 	"net/http"
 
 	"github.com/skr1ms/CTFBoard/internal/repo"
+	"github.com/skr1ms/CTFBoard/internal/usecase/challenge"
 )
 
 type App struct {
-	Server   *http.Server
-	UserRepo repo.UserRepository
+	Server      *http.Server
+	UserRepo    repo.UserRepository
+	InstanceUC  *challenge.InstanceUseCase
+	ChallengeUC *challenge.ChallengeUseCase
 }
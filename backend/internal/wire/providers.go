@@ -20,6 +20,7 @@ import (
 	v1 "github.com/skr1ms/CTFBoard/internal/controller/restapi/v1"
 	"github.com/skr1ms/CTFBoard/internal/controller/restapi/v1/helper"
 	wsController "github.com/skr1ms/CTFBoard/internal/controller/websocket/v1"
+	"github.com/skr1ms/CTFBoard/internal/instance"
 	"github.com/skr1ms/CTFBoard/internal/openapi"
 	"github.com/skr1ms/CTFBoard/internal/repo"
 	"github.com/skr1ms/CTFBoard/internal/repo/persistent"
@@ -37,6 +38,7 @@ import (
 	"github.com/skr1ms/CTFBoard/pkg/jwt"
 	"github.com/skr1ms/CTFBoard/pkg/logger"
 	"github.com/skr1ms/CTFBoard/pkg/mailer"
+	"github.com/skr1ms/CTFBoard/pkg/oidc"
 	"github.com/skr1ms/CTFBoard/pkg/validator"
 	pkgWS "github.com/skr1ms/CTFBoard/pkg/websocket"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -66,6 +68,66 @@ func ProvideHintRepo(pool *pgxpool.Pool) *persistent.HintRepo {
 	return persistent.NewHintRepo(pool)
 }
 
+func ProvideChallengeFlagRepo(pool *pgxpool.Pool) *persistent.ChallengeFlagRepo {
+	return persistent.NewChallengeFlagRepo(pool)
+}
+
+func ProvideChallengeRequirementRepo(pool *pgxpool.Pool) *persistent.ChallengeRequirementRepo {
+	return persistent.NewChallengeRequirementRepo(pool)
+}
+
+func ProvideChallengeScheduleRepo(pool *pgxpool.Pool) *persistent.ChallengeScheduleRepo {
+	return persistent.NewChallengeScheduleRepo(pool)
+}
+
+func ProvideTeamFlagRepo(pool *pgxpool.Pool) *persistent.TeamFlagRepo {
+	return persistent.NewTeamFlagRepo(pool)
+}
+
+func ProvideCheatIncidentRepo(pool *pgxpool.Pool) *persistent.CheatIncidentRepo {
+	return persistent.NewCheatIncidentRepo(pool)
+}
+
+func ProvideDecoyFlagRepo(pool *pgxpool.Pool) *persistent.DecoyFlagRepo {
+	return persistent.NewDecoyFlagRepo(pool)
+}
+
+func ProvideInstanceRepo(pool *pgxpool.Pool) *persistent.InstanceRepo {
+	return persistent.NewInstanceRepo(pool)
+}
+
+func ProvideReviewRepo(pool *pgxpool.Pool) *persistent.ReviewRepo {
+	return persistent.NewReviewRepo(pool)
+}
+
+func ProvideRevisionRepo(pool *pgxpool.Pool) *persistent.RevisionRepo {
+	return persistent.NewRevisionRepo(pool)
+}
+
+func ProvideChallengeSpecRepo(pool *pgxpool.Pool) *persistent.ChallengeSpecRepo {
+	return persistent.NewChallengeSpecRepo(pool)
+}
+
+func ProvideChallengeStageRepo(pool *pgxpool.Pool) *persistent.ChallengeStageRepo {
+	return persistent.NewChallengeStageRepo(pool)
+}
+
+func ProvideChallengeScoringRepo(pool *pgxpool.Pool) *persistent.ChallengeScoringRepo {
+	return persistent.NewChallengeScoringRepo(pool)
+}
+
+func ProvideAttemptLimitRepo(pool *pgxpool.Pool) *persistent.AttemptLimitRepo {
+	return persistent.NewAttemptLimitRepo(pool)
+}
+
+func ProvideRescoreRepo(pool *pgxpool.Pool) *persistent.RescoreRepo {
+	return persistent.NewRescoreRepo(pool)
+}
+
+func ProvideCheatAnalyticsRepo(pool *pgxpool.Pool) *persistent.CheatAnalyticsRepo {
+	return persistent.NewCheatAnalyticsRepo(pool)
+}
+
 func ProvideHintUnlockRepo(pool *pgxpool.Pool) *persistent.HintUnlockRepo {
 	return persistent.NewHintUnlockRepo(pool)
 }
@@ -134,6 +196,30 @@ func ProvideVerificationTokenRepo(pool *pgxpool.Pool) *persistent.VerificationTo
 	return persistent.NewVerificationTokenRepo(pool)
 }
 
+func ProvideSessionRepo(pool *pgxpool.Pool) *persistent.SessionRepo {
+	return persistent.NewSessionRepo(pool)
+}
+
+func ProvideTwoFactorRepo(pool *pgxpool.Pool) *persistent.TwoFactorRepo {
+	return persistent.NewTwoFactorRepo(pool)
+}
+
+func ProvideUserIdentityRepo(pool *pgxpool.Pool) *persistent.UserIdentityRepo {
+	return persistent.NewUserIdentityRepo(pool)
+}
+
+func ProvideRoleRepo(pool *pgxpool.Pool) *persistent.RoleRepo {
+	return persistent.NewRoleRepo(pool)
+}
+
+func ProvideRegistrationRepo(pool *pgxpool.Pool) *persistent.RegistrationRepo {
+	return persistent.NewRegistrationRepo(pool)
+}
+
+func ProvideChallengeAuthorRepo(pool *pgxpool.Pool) *persistent.ChallengeAuthorRepo {
+	return persistent.NewChallengeAuthorRepo(pool)
+}
+
 func ProvideValidator() validator.Validator {
 	return validator.New()
 }
@@ -153,10 +239,80 @@ func ProvideUserUseCase(
 	jwtService *jwt.JWTService,
 	fieldValidator *settings.FieldValidator,
 	fieldValueRepo repo.FieldValueRepository,
+	sessionRepo repo.SessionRepository,
+	twoFactorRepo repo.TwoFactorRepository,
+	cryptoService crypto.Service,
+	lockoutUC *user.LockoutUseCase,
+	registrationUC *user.RegistrationUseCase,
 ) *user.UserUseCase {
 	return user.NewUserUseCase(user.UserDeps{
 		UserRepo: userRepo, TeamRepo: teamRepo, SolveRepo: solveRepo, TxRepo: txRepo,
 		JWTService: jwtService, FieldValidator: fieldValidator, FieldValueRepo: fieldValueRepo,
+		SessionRepo: sessionRepo, TwoFactorRepo: twoFactorRepo, Crypto: cryptoService,
+		Lockout: lockoutUC, Registration: registrationUC,
+	})
+}
+
+func ProvideRegistrationUseCase(
+	appSettingsRepo repo.AppSettingsRepository,
+	registrationRepo repo.RegistrationRepository,
+	bracketRepo repo.BracketRepository,
+	auditLogRepo repo.AuditLogRepository,
+) *user.RegistrationUseCase {
+	return user.NewRegistrationUseCase(appSettingsRepo, registrationRepo, bracketRepo, auditLogRepo)
+}
+
+func ProvideLockoutUseCase(
+	redis *redis.Client,
+	userRepo repo.UserRepository,
+	auditLogRepo repo.AuditLogRepository,
+	cfg *config.Config,
+) *user.LockoutUseCase {
+	return user.NewLockoutUseCase(redis, userRepo, auditLogRepo, user.LockoutPolicy{
+		MaxAttempts:   cfg.LoginAttempts,
+		IPMaxAttempts: cfg.LoginIPAttempts,
+		Window:        cfg.LoginWindow,
+		BaseLockout:   cfg.LoginLockout,
+		MaxLockout:    cfg.LoginMaxLockout,
+	})
+}
+
+func ProvideRoleUseCase(
+	roleRepo repo.RoleRepository,
+	userRepo repo.UserRepository,
+	challengeAuthorRepo repo.ChallengeAuthorRepository,
+	txRepo repo.TxRepository,
+) *user.RoleUseCase {
+	return user.NewRoleUseCase(roleRepo, userRepo, challengeAuthorRepo, txRepo)
+}
+
+func ProvideAdminUserUseCase(
+	userRepo repo.UserRepository,
+	txRepo repo.TxRepository,
+	sessionRepo repo.SessionRepository,
+	scoreboardCache *cache.ScoreboardCacheService,
+) *user.AdminUserUseCase {
+	return user.NewAdminUserUseCase(user.AdminUserDeps{
+		UserRepo:        userRepo,
+		TxRepo:          txRepo,
+		SessionRepo:     sessionRepo,
+		ScoreboardCache: scoreboardCache,
+	})
+}
+
+func ProvideAccountUseCase(
+	userRepo repo.UserRepository,
+	txRepo repo.TxRepository,
+	sessionRepo repo.SessionRepository,
+	emailUC *email.EmailUseCase,
+	scoreboardCache *cache.ScoreboardCacheService,
+) *user.AccountUseCase {
+	return user.NewAccountUseCase(user.AccountDeps{
+		UserRepo:        userRepo,
+		TxRepo:          txRepo,
+		SessionRepo:     sessionRepo,
+		Email:           emailUC,
+		ScoreboardCache: scoreboardCache,
 	})
 }
 
@@ -182,8 +338,9 @@ func ProvideTeamUseCase(
 	compRepo repo.CompetitionRepository,
 	txRepo repo.TxRepository,
 	scoreboardCache *cache.ScoreboardCacheService,
+	registrationUC *user.RegistrationUseCase,
 ) *team.TeamUseCase {
-	return team.NewTeamUseCase(teamRepo, userRepo, compRepo, txRepo, scoreboardCache)
+	return team.NewTeamUseCase(teamRepo, userRepo, compRepo, txRepo, scoreboardCache, registrationUC)
 }
 
 func ProvideAwardUseCase(
@@ -206,6 +363,21 @@ func ProvideChallengeUseCase(
 	broadcaster *pkgWS.Broadcaster,
 	auditLogRepo repo.AuditLogRepository,
 	cryptoService crypto.Service,
+	flagRepo repo.ChallengeFlagRepository,
+	requirementRepo repo.ChallengeRequirementRepository,
+	scheduleRepo repo.ChallengeScheduleRepository,
+	notifRepo repo.NotificationRepository,
+	teamFlagRepo repo.TeamFlagRepository,
+	cheatRepo repo.CheatIncidentRepository,
+	decoyRepo repo.DecoyFlagRepository,
+	configRepo repo.ConfigRepository,
+	reviewRepo repo.ReviewRepository,
+	stageRepo repo.ChallengeStageRepository,
+	scoringRepo repo.ChallengeScoringRepository,
+	attemptRepo repo.AttemptLimitRepository,
+	submissionRepo repo.SubmissionRepository,
+	awardRepo repo.AwardRepository,
+	l logger.Logger,
 ) *challenge.ChallengeUseCase {
 	return challenge.NewChallengeUseCase(
 		challengeRepo,
@@ -219,6 +391,22 @@ func ProvideChallengeUseCase(
 		challenge.WithBroadcaster(broadcaster),
 		challenge.WithAuditLogRepo(auditLogRepo),
 		challenge.WithCrypto(cryptoService),
+		challenge.WithFlagRepo(flagRepo),
+		challenge.WithRequirementRepo(requirementRepo),
+		challenge.WithScheduleRepo(scheduleRepo),
+		challenge.WithNotificationRepo(notifRepo),
+		challenge.WithTeamFlagRepo(teamFlagRepo),
+		challenge.WithCheatIncidentRepo(cheatRepo),
+		challenge.WithDecoyRepo(decoyRepo),
+		challenge.WithConfigRepo(configRepo),
+		challenge.WithAdminBroadcaster(broadcaster),
+		challenge.WithReviewRepo(reviewRepo),
+		challenge.WithStageRepo(stageRepo),
+		challenge.WithScoringRepo(scoringRepo),
+		challenge.WithAttemptLimitRepo(attemptRepo),
+		challenge.WithSubmissionRepo(submissionRepo),
+		challenge.WithAwardRepo(awardRepo),
+		challenge.WithLogger(l),
 	)
 }
 
@@ -229,10 +417,11 @@ func ProvideHintUseCase(
 	txRepo repo.TxRepository,
 	solveRepo repo.SolveRepository,
 	scoreboardCache *cache.ScoreboardCacheService,
+	challengeUC *challenge.ChallengeUseCase,
 ) *challenge.HintUseCase {
 	return challenge.NewHintUseCase(challenge.HintDeps{
 		HintRepo: hintRepo, HintUnlockRepo: hintUnlockRepo, AwardRepo: awardRepo,
-		TxRepo: txRepo, SolveRepo: solveRepo, ScoreboardCache: scoreboardCache,
+		TxRepo: txRepo, SolveRepo: solveRepo, ScoreboardCache: scoreboardCache, Access: challengeUC,
 	})
 }
 
@@ -251,6 +440,8 @@ func ProvideSolveUseCase(
 	userRepo repo.UserRepository,
 	teamRepo repo.TeamRepository,
 	txRepo repo.TxRepository,
+	scoringRepo repo.ChallengeScoringRepository,
+	rescoreRepo repo.RescoreRepository,
 	c *cache.Cache,
 	scoreboardCache *cache.ScoreboardCacheService,
 	broadcaster *pkgWS.Broadcaster,
@@ -262,6 +453,8 @@ func ProvideSolveUseCase(
 		UserRepo:        userRepo,
 		TeamRepo:        teamRepo,
 		TxRepo:          txRepo,
+		ScoringRepo:     scoringRepo,
+		RescoreRepo:     rescoreRepo,
 		Cache:           c,
 		ScoreboardCache: scoreboardCache,
 		Broadcaster:     broadcaster,
@@ -283,8 +476,22 @@ func ProvideStatisticsUseCase(
 	return competition.NewStatisticsUseCase(statsRepo, c)
 }
 
-func ProvideSubmissionUseCase(submissionRepo repo.SubmissionRepository) *competition.SubmissionUseCase {
-	return competition.NewSubmissionUseCase(submissionRepo)
+func ProvideSubmissionUseCase(submissionRepo repo.SubmissionRepository, cheatRepo repo.CheatIncidentRepository) *competition.SubmissionUseCase {
+	return competition.NewSubmissionUseCase(submissionRepo, cheatRepo)
+}
+
+func ProvideAntiCheatUseCase(
+	cheatRepo repo.CheatIncidentRepository,
+	analyticsRepo repo.CheatAnalyticsRepository,
+	submissionRepo repo.SubmissionRepository,
+	teamUC *team.TeamUseCase,
+) *competition.AntiCheatUseCase {
+	return competition.NewAntiCheatUseCase(competition.AntiCheatDeps{
+		CheatRepo:      cheatRepo,
+		AnalyticsRepo:  analyticsRepo,
+		SubmissionRepo: submissionRepo,
+		Teams:          teamUC,
+	})
 }
 
 func ProvideTagUseCase(tagRepo repo.TagRepository) *challenge.TagUseCase {
@@ -335,22 +542,125 @@ func ProvideAPITokenRepo(pool *pgxpool.Pool) *persistent.APITokenRepo {
 	return persistent.NewAPITokenRepo(pool)
 }
 
-func ProvideAPITokenUseCase(apiTokenRepo repo.APITokenRepository) *user.APITokenUseCase {
-	return user.NewAPITokenUseCase(apiTokenRepo)
+func ProvideAPITokenUseCase(
+	apiTokenRepo repo.APITokenRepository,
+	userRepo repo.UserRepository,
+	auditLogRepo repo.AuditLogRepository,
+) *user.APITokenUseCase {
+	return user.NewAPITokenUseCase(apiTokenRepo, userRepo, auditLogRepo)
+}
+
+func ProvideSessionUseCase(
+	sessionRepo repo.SessionRepository,
+	userRepo repo.UserRepository,
+	teamRepo repo.TeamRepository,
+	auditLogRepo repo.AuditLogRepository,
+) *user.SessionUseCase {
+	return user.NewSessionUseCase(sessionRepo, userRepo, teamRepo, auditLogRepo)
+}
+
+func ProvideTwoFactorUseCase(
+	twoFactorRepo repo.TwoFactorRepository,
+	userRepo repo.UserRepository,
+	appSettingsRepo repo.AppSettingsRepository,
+	auditLogRepo repo.AuditLogRepository,
+	cryptoService crypto.Service,
+) *user.TwoFactorUseCase {
+	return user.NewTwoFactorUseCase(twoFactorRepo, userRepo, appSettingsRepo, auditLogRepo, cryptoService)
+}
+
+func ProvideOIDCClient() *oidc.Client {
+	return oidc.NewClient(&http.Client{Timeout: 10 * time.Second})
+}
+
+func ProvideOAuthUseCase(
+	configRepo repo.ConfigRepository,
+	identityRepo repo.UserIdentityRepository,
+	userRepo repo.UserRepository,
+	txRepo repo.TxRepository,
+	fieldValueRepo repo.FieldValueRepository,
+	auditLogRepo repo.AuditLogRepository,
+	cryptoService crypto.Service,
+	redis *redis.Client,
+	oidcClient *oidc.Client,
+	userUC *user.UserUseCase,
+) *user.OAuthUseCase {
+	return user.NewOAuthUseCase(user.OAuthDeps{
+		ConfigRepo: configRepo, IdentityRepo: identityRepo, UserRepo: userRepo, TxRepo: txRepo,
+		FieldValueRepo: fieldValueRepo, AuditLogRepo: auditLogRepo, Crypto: cryptoService,
+		Redis: redis, OIDC: oidcClient, Users: userUC,
+	})
 }
 
 func ProvideFileUseCase(
 	fileRepo repo.FileRepository,
 	storageProvider storage.Provider,
 	cfg *config.Config,
+	challengeUC *challenge.ChallengeUseCase,
 ) *challenge.FileUseCase {
-	return challenge.NewFileUseCase(fileRepo, storageProvider, cfg.PresignedExpiry)
+	return challenge.NewFileUseCase(fileRepo, storageProvider, cfg.PresignedExpiry, challengeUC)
+}
+
+func ProvideInstanceUseCase(
+	challengeRepo repo.ChallengeRepository,
+	instanceRepo repo.InstanceRepository,
+	instanceProvider instance.Provider,
+	challengeUC *challenge.ChallengeUseCase,
+	cfg *config.Config,
+	l logger.Logger,
+) *challenge.InstanceUseCase {
+	return challenge.NewInstanceUseCase(challengeRepo, instanceRepo, instanceProvider, challengeUC, cfg.InstanceMaxPerTeam, l)
+}
+
+func ProvideRevisionUseCase(
+	challengeRepo repo.ChallengeRepository,
+	tagRepo repo.TagRepository,
+	hintRepo repo.HintRepository,
+	revisionRepo repo.RevisionRepository,
+	txRepo repo.TxRepository,
+	scoreboardCache *cache.ScoreboardCacheService,
+) *challenge.RevisionUseCase {
+	return challenge.NewRevisionUseCase(challenge.RevisionDeps{
+		ChallengeRepo: challengeRepo, TagRepo: tagRepo, HintRepo: hintRepo,
+		RevisionRepo: revisionRepo, TxRepo: txRepo, ScoreboardCache: scoreboardCache,
+	})
+}
+
+func ProvideSpecUseCase(
+	specRepo repo.ChallengeSpecRepository,
+	challengeRepo repo.ChallengeRepository,
+	tagRepo repo.TagRepository,
+	hintRepo repo.HintRepository,
+	flagRepo repo.ChallengeFlagRepository,
+	requirementRepo repo.ChallengeRequirementRepository,
+	fileRepo repo.FileRepository,
+	cryptoService crypto.Service,
+	challengeUC *challenge.ChallengeUseCase,
+	fileUC *challenge.FileUseCase,
+	revisionUC *challenge.RevisionUseCase,
+	scoreboardCache *cache.ScoreboardCacheService,
+) *challenge.SpecUseCase {
+	return challenge.NewSpecUseCase(challenge.SpecDeps{
+		SpecRepo: specRepo, ChallengeRepo: challengeRepo, TagRepo: tagRepo, HintRepo: hintRepo,
+		FlagRepo: flagRepo, RequirementRepo: requirementRepo, FileRepo: fileRepo, Crypto: cryptoService,
+		Challenges: challengeUC, Files: fileUC, Revisions: revisionUC, ScoreboardCache: scoreboardCache,
+	})
 }
 
 func ProvideBackupUseCase(
 	competitionRepo repo.CompetitionRepository,
 	challengeRepo repo.ChallengeRepository,
 	hintRepo repo.HintRepository,
+	flagRepo repo.ChallengeFlagRepository,
+	decoyRepo repo.DecoyFlagRepository,
+	requirementRepo repo.ChallengeRequirementRepository,
+	scheduleRepo repo.ChallengeScheduleRepository,
+	teamFlagRepo repo.TeamFlagRepository,
+	instanceRepo repo.InstanceRepository,
+	reviewRepo repo.ReviewRepository,
+	stageRepo repo.ChallengeStageRepository,
+	scoringRepo repo.ChallengeScoringRepository,
+	attemptRepo repo.AttemptLimitRepository,
 	teamRepo repo.TeamRepository,
 	userRepo repo.UserRepository,
 	awardRepo repo.AwardRepository,
@@ -365,6 +675,16 @@ func ProvideBackupUseCase(
 		CompetitionRepo: competitionRepo,
 		ChallengeRepo:   challengeRepo,
 		HintRepo:        hintRepo,
+		FlagRepo:        flagRepo,
+		DecoyRepo:       decoyRepo,
+		RequirementRepo: requirementRepo,
+		ScheduleRepo:    scheduleRepo,
+		TeamFlagRepo:    teamFlagRepo,
+		InstanceRepo:    instanceRepo,
+		ReviewRepo:      reviewRepo,
+		StageRepo:       stageRepo,
+		ScoringRepo:     scoringRepo,
+		AttemptRepo:     attemptRepo,
 		TeamRepo:        teamRepo,
 		UserRepo:        userRepo,
 		AwardRepo:       awardRepo,
@@ -420,6 +740,7 @@ func ProvideServerDeps(
 	awardUC *team.AwardUseCase,
 	statsUC *competition.StatisticsUseCase,
 	submissionUC *competition.SubmissionUseCase,
+	antiCheatUC *competition.AntiCheatUseCase,
 	tagUC *challenge.TagUseCase,
 	fieldUC *settings.FieldUseCase,
 	pageUC *page.PageUseCase,
@@ -427,10 +748,21 @@ func ProvideServerDeps(
 	ratingUC *competition.RatingUseCase,
 	notifUC *notification.NotificationUseCase,
 	apiTokenUC *user.APITokenUseCase,
+	sessionUC *user.SessionUseCase,
+	twoFactorUC *user.TwoFactorUseCase,
+	oauthUC *user.OAuthUseCase,
+	lockoutUC *user.LockoutUseCase,
+	roleUC *user.RoleUseCase,
+	adminUserUC *user.AdminUserUseCase,
+	accountUC *user.AccountUseCase,
+	registrationUC *user.RegistrationUseCase,
 	backupUC *competition.BackupUseCase,
 	settingsUC *settings.SettingsUseCase,
 	dynamicConfigUC *competition.DynamicConfigUseCase,
 	commentUC *challenge.CommentUseCase,
+	instanceUC *challenge.InstanceUseCase,
+	revisionUC *challenge.RevisionUseCase,
+	specUC *challenge.SpecUseCase,
 	jwtService *jwt.JWTService,
 	redisClient *redis.Client,
 	wsCtrl *wsController.Controller,
@@ -444,21 +776,33 @@ func ProvideServerDeps(
 			FileUC:      fileUC,
 			TagUC:       tagUC,
 			CommentUC:   commentUC,
+			InstanceUC:  instanceUC,
+			RevisionUC:  revisionUC,
+			SpecUC:      specUC,
 		},
 		Team: helper.TeamDeps{
 			TeamUC:  teamUC,
 			AwardUC: awardUC,
 		},
 		User: helper.UserDeps{
-			UserUC:     userUC,
-			EmailUC:    emailUC,
-			APITokenUC: apiTokenUC,
+			UserUC:         userUC,
+			EmailUC:        emailUC,
+			APITokenUC:     apiTokenUC,
+			SessionUC:      sessionUC,
+			TwoFactorUC:    twoFactorUC,
+			OAuthUC:        oauthUC,
+			LockoutUC:      lockoutUC,
+			RoleUC:         roleUC,
+			AdminUserUC:    adminUserUC,
+			AccountUC:      accountUC,
+			RegistrationUC: registrationUC,
 		},
 		Comp: helper.CompetitionDeps{
 			CompetitionUC: competitionUC,
 			SolveUC:       solveUC,
 			StatsUC:       statsUC,
 			SubmissionUC:  submissionUC,
+			AntiCheatUC:   antiCheatUC,
 			BracketUC:     bracketUC,
 			RatingUC:      ratingUC,
 		},
@@ -540,6 +884,6 @@ func ProvideServer(router chi.Router, cfg *config.Config) *http.Server {
 	}
 }
 
-func ProvideApp(server *http.Server, userRepo repo.UserRepository) *App {
-	return &App{Server: server, UserRepo: userRepo}
+func ProvideApp(server *http.Server, userRepo repo.UserRepository, instanceUC *challenge.InstanceUseCase, challengeUC *challenge.ChallengeUseCase) *App {
+	return &App{Server: server, UserRepo: userRepo, InstanceUC: instanceUC, ChallengeUC: challengeUC}
 }
@@ -17,6 +17,21 @@ var RepoSet = wire.NewSet(
 	ProvideTeamRepo,
 	ProvideCompetitionRepo,
 	ProvideHintRepo,
+	ProvideChallengeFlagRepo,
+	ProvideChallengeRequirementRepo,
+	ProvideChallengeScheduleRepo,
+	ProvideTeamFlagRepo,
+	ProvideInstanceRepo,
+	ProvideReviewRepo,
+	ProvideRevisionRepo,
+	ProvideChallengeSpecRepo,
+	ProvideChallengeStageRepo,
+	ProvideChallengeScoringRepo,
+	ProvideAttemptLimitRepo,
+	ProvideRescoreRepo,
+	ProvideCheatAnalyticsRepo,
+	ProvideCheatIncidentRepo,
+	ProvideDecoyFlagRepo,
 	ProvideHintUnlockRepo,
 	ProvideAwardRepo,
 	ProvideAuditLogRepo,
@@ -37,12 +52,33 @@ var RepoSet = wire.NewSet(
 	ProvideAppSettingsRepo,
 	ProvideConfigRepo,
 	ProvideVerificationTokenRepo,
+	ProvideSessionRepo,
+	ProvideTwoFactorRepo,
+	ProvideUserIdentityRepo,
+	ProvideRoleRepo,
+	ProvideRegistrationRepo,
+	ProvideChallengeAuthorRepo,
 	wire.Bind(new(repo.UserRepository), new(*persistent.UserRepo)),
 	wire.Bind(new(repo.TeamRepository), new(*persistent.TeamRepo)),
 	wire.Bind(new(repo.SolveRepository), new(*persistent.SolveRepo)),
 	wire.Bind(new(repo.CompetitionRepository), new(*persistent.CompetitionRepo)),
 	wire.Bind(new(repo.ChallengeRepository), new(*persistent.ChallengeRepo)),
 	wire.Bind(new(repo.HintRepository), new(*persistent.HintRepo)),
+	wire.Bind(new(repo.ChallengeFlagRepository), new(*persistent.ChallengeFlagRepo)),
+	wire.Bind(new(repo.ChallengeRequirementRepository), new(*persistent.ChallengeRequirementRepo)),
+	wire.Bind(new(repo.ChallengeScheduleRepository), new(*persistent.ChallengeScheduleRepo)),
+	wire.Bind(new(repo.TeamFlagRepository), new(*persistent.TeamFlagRepo)),
+	wire.Bind(new(repo.InstanceRepository), new(*persistent.InstanceRepo)),
+	wire.Bind(new(repo.ReviewRepository), new(*persistent.ReviewRepo)),
+	wire.Bind(new(repo.RevisionRepository), new(*persistent.RevisionRepo)),
+	wire.Bind(new(repo.ChallengeSpecRepository), new(*persistent.ChallengeSpecRepo)),
+	wire.Bind(new(repo.ChallengeStageRepository), new(*persistent.ChallengeStageRepo)),
+	wire.Bind(new(repo.ChallengeScoringRepository), new(*persistent.ChallengeScoringRepo)),
+	wire.Bind(new(repo.AttemptLimitRepository), new(*persistent.AttemptLimitRepo)),
+	wire.Bind(new(repo.RescoreRepository), new(*persistent.RescoreRepo)),
+	wire.Bind(new(repo.CheatIncidentRepository), new(*persistent.CheatIncidentRepo)),
+	wire.Bind(new(repo.DecoyFlagRepository), new(*persistent.DecoyFlagRepo)),
+	wire.Bind(new(repo.CheatAnalyticsRepository), new(*persistent.CheatAnalyticsRepo)),
 	wire.Bind(new(repo.HintUnlockRepository), new(*persistent.HintUnlockRepo)),
 	wire.Bind(new(repo.AwardRepository), new(*persistent.AwardRepo)),
 	wire.Bind(new(repo.AuditLogRepository), new(*persistent.AuditLogRepo)),
@@ -63,6 +99,12 @@ var RepoSet = wire.NewSet(
 	wire.Bind(new(repo.AppSettingsRepository), new(*persistent.AppSettingsRepo)),
 	wire.Bind(new(repo.ConfigRepository), new(*persistent.ConfigRepo)),
 	wire.Bind(new(repo.VerificationTokenRepository), new(*persistent.VerificationTokenRepo)),
+	wire.Bind(new(repo.SessionRepository), new(*persistent.SessionRepo)),
+	wire.Bind(new(repo.TwoFactorRepository), new(*persistent.TwoFactorRepo)),
+	wire.Bind(new(repo.UserIdentityRepository), new(*persistent.UserIdentityRepo)),
+	wire.Bind(new(repo.RoleRepository), new(*persistent.RoleRepo)),
+	wire.Bind(new(repo.RegistrationRepository), new(*persistent.RegistrationRepo)),
+	wire.Bind(new(repo.ChallengeAuthorRepository), new(*persistent.ChallengeAuthorRepo)),
 )
 
 var UseCaseSet = wire.NewSet(
@@ -76,6 +118,7 @@ var UseCaseSet = wire.NewSet(
 	ProvideSolveUseCase,
 	ProvideStatisticsUseCase,
 	ProvideSubmissionUseCase,
+	ProvideAntiCheatUseCase,
 	ProvideTagUseCase,
 	ProvideFieldUseCase,
 	ProvideFieldValidator,
@@ -85,7 +128,18 @@ var UseCaseSet = wire.NewSet(
 	ProvideBracketUseCase,
 	ProvideRatingUseCase,
 	ProvideAPITokenUseCase,
+	ProvideSessionUseCase,
+	ProvideTwoFactorUseCase,
+	ProvideOAuthUseCase,
+	ProvideLockoutUseCase,
+	ProvideRoleUseCase,
+	ProvideAdminUserUseCase,
+	ProvideAccountUseCase,
+	ProvideRegistrationUseCase,
 	ProvideFileUseCase,
+	ProvideInstanceUseCase,
+	ProvideRevisionUseCase,
+	ProvideSpecUseCase,
 	ProvideBackupUseCase,
 	ProvideSettingsUseCase,
 	ProvideDynamicConfigUseCase,
@@ -100,6 +154,7 @@ var UseCaseSet = wire.NewSet(
 var InfraSet = wire.NewSet(
 	ProvideValidator,
 	ProvideCrypto,
+	ProvideOIDCClient,
 	ProvideCache,
 	ProvideBroadcaster,
 	ProvideWsController,
@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"github.com/skr1ms/CTFBoard/config"
+	"github.com/skr1ms/CTFBoard/internal/instance"
 	"github.com/skr1ms/CTFBoard/internal/storage"
 	"github.com/skr1ms/CTFBoard/pkg/jwt"
 	"github.com/skr1ms/CTFBoard/pkg/logger"
@@ -20,6 +21,7 @@ func InitializeApp(
 	pool *pgxpool.Pool,
 	redisClient *redis.Client,
 	storageProvider storage.Provider,
+	instanceProvider instance.Provider,
 	jwtService *jwt.JWTService,
 	wsHub *pkgWS.Hub,
 	mailer mailer.Mailer,
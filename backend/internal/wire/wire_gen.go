@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"github.com/skr1ms/CTFBoard/config"
+	"github.com/skr1ms/CTFBoard/internal/instance"
 	"github.com/skr1ms/CTFBoard/internal/storage"
 	"github.com/skr1ms/CTFBoard/pkg/jwt"
 	"github.com/skr1ms/CTFBoard/pkg/logger"
@@ -19,7 +20,7 @@ import (
 
 // Injectors from wire.go:
 
-func InitializeApp(cfg *config.Config, l logger.Logger, pool *pgxpool.Pool, redisClient *redis.Client, storageProvider storage.Provider, jwtService *jwt.JWTService, wsHub *websocket.Hub, mailer2 mailer.Mailer) (*App, error) {
+func InitializeApp(cfg *config.Config, l logger.Logger, pool *pgxpool.Pool, redisClient *redis.Client, storageProvider storage.Provider, instanceProvider instance.Provider, jwtService *jwt.JWTService, wsHub *websocket.Hub, mailer2 mailer.Mailer) (*App, error) {
 	userRepo := ProvideUserRepo(pool)
 	teamRepo := ProvideTeamRepo(pool)
 	solveRepo := ProvideSolveRepo(pool)
@@ -27,60 +28,94 @@ func InitializeApp(cfg *config.Config, l logger.Logger, pool *pgxpool.Pool, redi
 	fieldRepo := ProvideFieldRepo(pool)
 	fieldValidator := ProvideFieldValidator(fieldRepo)
 	fieldValueRepo := ProvideFieldValueRepo(pool)
-	userUseCase := ProvideUserUseCase(userRepo, teamRepo, solveRepo, txRepo, jwtService, fieldValidator, fieldValueRepo)
+	sessionRepo := ProvideSessionRepo(pool)
+	twoFactorRepo := ProvideTwoFactorRepo(pool)
+	service, err := ProvideCrypto(cfg)
+	if err != nil {
+		return nil, err
+	}
+	auditLogRepo := ProvideAuditLogRepo(pool)
+	lockoutUseCase := ProvideLockoutUseCase(redisClient, userRepo, auditLogRepo, cfg)
+	appSettingsRepo := ProvideAppSettingsRepo(pool)
+	registrationRepo := ProvideRegistrationRepo(pool)
+	bracketRepo := ProvideBracketRepo(pool)
+	registrationUseCase := ProvideRegistrationUseCase(appSettingsRepo, registrationRepo, bracketRepo, auditLogRepo)
+	userUseCase := ProvideUserUseCase(userRepo, teamRepo, solveRepo, txRepo, jwtService, fieldValidator, fieldValueRepo, sessionRepo, twoFactorRepo, service, lockoutUseCase, registrationUseCase)
 	challengeRepo := ProvideChallengeRepo(pool)
 	tagRepo := ProvideTagRepo(pool)
 	competitionRepo := ProvideCompetitionRepo(pool)
 	cache := ProvideCache(redisClient)
 	scoreboardCacheService := ProvideScoreboardCacheService(cache, teamRepo)
 	broadcaster := ProvideBroadcaster(wsHub)
-	auditLogRepo := ProvideAuditLogRepo(pool)
-	service, err := ProvideCrypto(cfg)
-	if err != nil {
-		return nil, err
-	}
-	challengeUseCase := ProvideChallengeUseCase(challengeRepo, tagRepo, solveRepo, txRepo, competitionRepo, teamRepo, redisClient, scoreboardCacheService, broadcaster, auditLogRepo, service)
-	solveUseCase := ProvideSolveUseCase(solveRepo, challengeRepo, competitionRepo, userRepo, teamRepo, txRepo, cache, scoreboardCacheService, broadcaster)
-	teamUseCase := ProvideTeamUseCase(teamRepo, userRepo, competitionRepo, txRepo, scoreboardCacheService)
+	challengeFlagRepo := ProvideChallengeFlagRepo(pool)
+	challengeRequirementRepo := ProvideChallengeRequirementRepo(pool)
+	challengeScheduleRepo := ProvideChallengeScheduleRepo(pool)
+	notificationRepo := ProvideNotificationRepo(pool)
+	teamFlagRepo := ProvideTeamFlagRepo(pool)
+	cheatIncidentRepo := ProvideCheatIncidentRepo(pool)
+	decoyFlagRepo := ProvideDecoyFlagRepo(pool)
+	configRepo := ProvideConfigRepo(pool)
+	reviewRepo := ProvideReviewRepo(pool)
+	challengeStageRepo := ProvideChallengeStageRepo(pool)
+	challengeScoringRepo := ProvideChallengeScoringRepo(pool)
+	attemptLimitRepo := ProvideAttemptLimitRepo(pool)
+	submissionRepo := ProvideSubmissionRepo(pool)
+	awardRepo := ProvideAwardRepo(pool)
+	challengeUseCase := ProvideChallengeUseCase(challengeRepo, tagRepo, solveRepo, txRepo, competitionRepo, teamRepo, redisClient, scoreboardCacheService, broadcaster, auditLogRepo, service, challengeFlagRepo, challengeRequirementRepo, challengeScheduleRepo, notificationRepo, teamFlagRepo, cheatIncidentRepo, decoyFlagRepo, configRepo, reviewRepo, challengeStageRepo, challengeScoringRepo, attemptLimitRepo, submissionRepo, awardRepo, l)
+	rescoreRepo := ProvideRescoreRepo(pool)
+	solveUseCase := ProvideSolveUseCase(solveRepo, challengeRepo, competitionRepo, userRepo, teamRepo, txRepo, challengeScoringRepo, rescoreRepo, cache, scoreboardCacheService, broadcaster)
+	teamUseCase := ProvideTeamUseCase(teamRepo, userRepo, competitionRepo, txRepo, scoreboardCacheService, registrationUseCase)
 	competitionUseCase := ProvideCompetitionUseCase(competitionRepo, auditLogRepo, redisClient)
 	hintRepo := ProvideHintRepo(pool)
 	hintUnlockRepo := ProvideHintUnlockRepo(pool)
-	awardRepo := ProvideAwardRepo(pool)
-	hintUseCase := ProvideHintUseCase(hintRepo, hintUnlockRepo, awardRepo, txRepo, solveRepo, scoreboardCacheService)
+	hintUseCase := ProvideHintUseCase(hintRepo, hintUnlockRepo, awardRepo, txRepo, solveRepo, scoreboardCacheService, challengeUseCase)
 	verificationTokenRepo := ProvideVerificationTokenRepo(pool)
 	emailUseCase := ProvideEmailUseCase(userRepo, verificationTokenRepo, mailer2, cfg)
 	fileRepository := ProvideFileRepo(pool)
-	fileUseCase := ProvideFileUseCase(fileRepository, storageProvider, cfg)
+	fileUseCase := ProvideFileUseCase(fileRepository, storageProvider, cfg, challengeUseCase)
 	awardUseCase := ProvideAwardUseCase(awardRepo, txRepo, scoreboardCacheService)
 	statisticsRepository := ProvideStatisticsRepo(pool)
 	statisticsUseCase := ProvideStatisticsUseCase(statisticsRepository, cache)
-	submissionRepo := ProvideSubmissionRepo(pool)
-	submissionUseCase := ProvideSubmissionUseCase(submissionRepo)
+	submissionUseCase := ProvideSubmissionUseCase(submissionRepo, cheatIncidentRepo)
+	cheatAnalyticsRepo := ProvideCheatAnalyticsRepo(pool)
+	antiCheatUseCase := ProvideAntiCheatUseCase(cheatIncidentRepo, cheatAnalyticsRepo, submissionRepo, teamUseCase)
 	tagUseCase := ProvideTagUseCase(tagRepo)
 	fieldUseCase := ProvideFieldUseCase(fieldRepo)
 	pageRepo := ProvidePageRepo(pool)
 	pageUseCase := ProvidePageUseCase(pageRepo)
-	bracketRepo := ProvideBracketRepo(pool)
 	bracketUseCase := ProvideBracketUseCase(bracketRepo)
 	ratingRepo := ProvideRatingRepo(pool)
 	ratingUseCase := ProvideRatingUseCase(ratingRepo, solveRepo, teamRepo)
-	notificationRepo := ProvideNotificationRepo(pool)
 	notificationUseCase := ProvideNotificationUseCase(notificationRepo)
 	apiTokenRepo := ProvideAPITokenRepo(pool)
-	apiTokenUseCase := ProvideAPITokenUseCase(apiTokenRepo)
+	apiTokenUseCase := ProvideAPITokenUseCase(apiTokenRepo, userRepo, auditLogRepo)
+	sessionUseCase := ProvideSessionUseCase(sessionRepo, userRepo, teamRepo, auditLogRepo)
+	twoFactorUseCase := ProvideTwoFactorUseCase(twoFactorRepo, userRepo, appSettingsRepo, auditLogRepo, service)
+	instanceRepo := ProvideInstanceRepo(pool)
 	backupRepo := ProvideBackupRepo(pool)
-	backupUseCase := ProvideBackupUseCase(competitionRepo, challengeRepo, hintRepo, teamRepo, userRepo, awardRepo, solveRepo, fileRepository, backupRepo, storageProvider, txRepo, l)
-	appSettingsRepo := ProvideAppSettingsRepo(pool)
+	backupUseCase := ProvideBackupUseCase(competitionRepo, challengeRepo, hintRepo, challengeFlagRepo, decoyFlagRepo, challengeRequirementRepo, challengeScheduleRepo, teamFlagRepo, instanceRepo, reviewRepo, challengeStageRepo, challengeScoringRepo, attemptLimitRepo, teamRepo, userRepo, awardRepo, solveRepo, fileRepository, backupRepo, storageProvider, txRepo, l)
 	settingsUseCase := ProvideSettingsUseCase(appSettingsRepo, auditLogRepo, redisClient)
-	configRepo := ProvideConfigRepo(pool)
+	userIdentityRepo := ProvideUserIdentityRepo(pool)
+	client := ProvideOIDCClient()
+	oAuthUseCase := ProvideOAuthUseCase(configRepo, userIdentityRepo, userRepo, txRepo, fieldValueRepo, auditLogRepo, service, redisClient, client, userUseCase)
 	dynamicConfigUseCase := ProvideDynamicConfigUseCase(configRepo, auditLogRepo)
 	commentRepo := ProvideCommentRepo(pool)
 	commentUseCase := ProvideCommentUseCase(commentRepo, challengeRepo)
+	instanceUseCase := ProvideInstanceUseCase(challengeRepo, instanceRepo, instanceProvider, challengeUseCase, cfg, l)
+	revisionRepo := ProvideRevisionRepo(pool)
+	revisionUseCase := ProvideRevisionUseCase(challengeRepo, tagRepo, hintRepo, revisionRepo, txRepo, scoreboardCacheService)
+	challengeSpecRepo := ProvideChallengeSpecRepo(pool)
+	specUseCase := ProvideSpecUseCase(challengeSpecRepo, challengeRepo, tagRepo, hintRepo, challengeFlagRepo, challengeRequirementRepo, fileRepository, service, challengeUseCase, fileUseCase, revisionUseCase, scoreboardCacheService)
+	roleRepo := ProvideRoleRepo(pool)
+	challengeAuthorRepo := ProvideChallengeAuthorRepo(pool)
+	roleUseCase := ProvideRoleUseCase(roleRepo, userRepo, challengeAuthorRepo, txRepo)
+	adminUserUseCase := ProvideAdminUserUseCase(userRepo, txRepo, sessionRepo, scoreboardCacheService)
+	accountUseCase := ProvideAccountUseCase(userRepo, txRepo, sessionRepo, emailUseCase, scoreboardCacheService)
 	controller := ProvideWsController(wsHub, l, cfg)
 	validator := ProvideValidator()
-	serverDeps := ProvideServerDeps(userUseCase, challengeUseCase, solveUseCase, teamUseCase, competitionUseCase, hintUseCase, emailUseCase, fileUseCase, awardUseCase, statisticsUseCase, submissionUseCase, tagUseCase, fieldUseCase, pageUseCase, bracketUseCase, ratingUseCase, notificationUseCase, apiTokenUseCase, backupUseCase, settingsUseCase, dynamicConfigUseCase, commentUseCase, jwtService, redisClient, controller, validator, l)
+	serverDeps := ProvideServerDeps(userUseCase, challengeUseCase, solveUseCase, teamUseCase, competitionUseCase, hintUseCase, emailUseCase, fileUseCase, awardUseCase, statisticsUseCase, submissionUseCase, antiCheatUseCase, tagUseCase, fieldUseCase, pageUseCase, bracketUseCase, ratingUseCase, notificationUseCase, apiTokenUseCase, sessionUseCase, twoFactorUseCase, oAuthUseCase, lockoutUseCase, roleUseCase, adminUserUseCase, accountUseCase, registrationUseCase, backupUseCase, settingsUseCase, dynamicConfigUseCase, commentUseCase, instanceUseCase, revisionUseCase, specUseCase, jwtService, redisClient, controller, validator, l)
 	router := ProvideRouter(cfg, l, serverDeps)
 	server := ProvideServer(router, cfg)
-	app := ProvideApp(server, userRepo)
+	app := ProvideApp(server, userRepo, instanceUseCase, challengeUseCase)
 	return app, nil
 }
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_refresh_token_families_expires_at;
+DROP INDEX IF EXISTS idx_refresh_token_families_user_id;
+DROP TABLE IF EXISTS refresh_token_families;
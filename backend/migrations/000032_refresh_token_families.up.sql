@@ -0,0 +1,12 @@
+CREATE TABLE refresh_token_families (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    current_jti VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_refresh_token_families_user_id ON refresh_token_families (user_id);
+CREATE INDEX idx_refresh_token_families_expires_at ON refresh_token_families (expires_at);
@@ -0,0 +1,8 @@
+ALTER INDEX IF EXISTS idx_user_sessions_expires_at RENAME TO idx_refresh_token_families_expires_at;
+ALTER INDEX IF EXISTS idx_user_sessions_user_id RENAME TO idx_refresh_token_families_user_id;
+ALTER TABLE user_sessions DROP COLUMN IF EXISTS last_seen_at;
+ALTER TABLE user_sessions DROP COLUMN IF EXISTS user_agent;
+ALTER TABLE user_sessions DROP COLUMN IF EXISTS ip;
+ALTER TABLE user_sessions DROP COLUMN IF EXISTS access_jti;
+ALTER TABLE user_sessions RENAME COLUMN refresh_jti TO current_jti;
+ALTER TABLE user_sessions RENAME TO refresh_token_families;
@@ -0,0 +1,8 @@
+ALTER TABLE refresh_token_families RENAME TO user_sessions;
+ALTER TABLE user_sessions RENAME COLUMN current_jti TO refresh_jti;
+ALTER TABLE user_sessions ADD COLUMN access_jti VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE user_sessions ADD COLUMN ip VARCHAR(45) NOT NULL DEFAULT '';
+ALTER TABLE user_sessions ADD COLUMN user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE user_sessions ADD COLUMN last_seen_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+ALTER INDEX idx_refresh_token_families_user_id RENAME TO idx_user_sessions_user_id;
+ALTER INDEX idx_refresh_token_families_expires_at RENAME TO idx_user_sessions_expires_at;
@@ -0,0 +1,4 @@
+ALTER TABLE app_settings DROP COLUMN IF EXISTS require_2fa_for_admins;
+DROP INDEX IF EXISTS idx_user_recovery_codes_user_id;
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_two_factor;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_api_token_requests_token_id;
+DROP TABLE IF EXISTS api_token_requests;
+
+DELETE FROM api_tokens WHERE user_id IS NULL;
+ALTER TABLE api_tokens DROP COLUMN IF EXISTS created_by;
+ALTER TABLE api_tokens DROP COLUMN IF EXISTS scopes;
+ALTER TABLE api_tokens ALTER COLUMN user_id SET NOT NULL;
@@ -0,0 +1,19 @@
+ALTER TABLE api_tokens ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE api_tokens ADD COLUMN scopes TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE api_tokens ADD COLUMN created_by uuid REFERENCES users(id) ON DELETE SET NULL;
+
+-- Existing tokens keep user-level access; admin access now needs an explicit admin scope.
+UPDATE api_tokens
+SET scopes = ARRAY['profile:read', 'profile:write', 'teams:write', 'challenges:read', 'comments:write', 'submit'];
+
+CREATE TABLE api_token_requests (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    token_id uuid NOT NULL REFERENCES api_tokens(id) ON DELETE CASCADE,
+    method VARCHAR(10) NOT NULL,
+    path VARCHAR(512) NOT NULL,
+    status INTEGER NOT NULL,
+    ip VARCHAR(45),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_api_token_requests_token_id ON api_token_requests (token_id, created_at DESC);
@@ -0,0 +1,5 @@
+UPDATE users SET role = 'user' WHERE role NOT IN ('user', 'admin');
+DROP INDEX IF EXISTS idx_users_role;
+DROP INDEX IF EXISTS idx_challenge_authors_user_id;
+DROP TABLE IF EXISTS challenge_authors;
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,16 @@
+CREATE TABLE roles (
+    name VARCHAR(20) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE challenge_authors (
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (challenge_id, user_id)
+);
+
+CREATE INDEX idx_challenge_authors_user_id ON challenge_authors (user_id);
+CREATE INDEX idx_users_role ON users (role);
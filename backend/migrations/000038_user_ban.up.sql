@@ -0,0 +1,5 @@
+ALTER TABLE users ADD COLUMN is_banned BOOLEAN DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN banned_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN banned_reason TEXT;
+
+CREATE INDEX idx_users_created_at ON users (created_at DESC);
@@ -0,0 +1,8 @@
+DELETE FROM verification_tokens WHERE type = 'email_change';
+
+ALTER TABLE verification_tokens DROP CONSTRAINT IF EXISTS verification_tokens_type_check;
+ALTER TABLE verification_tokens ADD CONSTRAINT verification_tokens_type_check CHECK (
+    type IN ('email_verification', 'password_reset')
+);
+
+ALTER TABLE verification_tokens DROP COLUMN IF EXISTS new_email;
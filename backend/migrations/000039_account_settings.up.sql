@@ -0,0 +1,6 @@
+ALTER TABLE verification_tokens ADD COLUMN new_email VARCHAR(50) NULL;
+
+ALTER TABLE verification_tokens DROP CONSTRAINT IF EXISTS verification_tokens_type_check;
+ALTER TABLE verification_tokens ADD CONSTRAINT verification_tokens_type_check CHECK (
+    type IN ('email_verification', 'password_reset', 'email_change')
+);
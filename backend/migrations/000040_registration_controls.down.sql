@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS registration_domain_rules;
+DROP TABLE IF EXISTS registration_invites;
+
+ALTER TABLE app_settings DROP COLUMN IF EXISTS registration_closes_at;
+ALTER TABLE app_settings DROP COLUMN IF EXISTS registration_opens_at;
@@ -0,0 +1,20 @@
+ALTER TABLE app_settings ADD COLUMN registration_opens_at TIMESTAMP;
+ALTER TABLE app_settings ADD COLUMN registration_closes_at TIMESTAMP;
+
+CREATE TABLE registration_invites (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(64) NOT NULL UNIQUE,
+    max_uses INT CHECK (max_uses IS NULL OR max_uses > 0),
+    uses INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE registration_domain_rules (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    domain VARCHAR(255) NOT NULL UNIQUE,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('allow', 'deny')),
+    bracket_id uuid REFERENCES brackets(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
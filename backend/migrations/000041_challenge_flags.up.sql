@@ -0,0 +1,12 @@
+CREATE TABLE challenge_flags (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    flag_type VARCHAR(10) NOT NULL CHECK (flag_type IN ('static', 'regex')),
+    flag_hash VARCHAR(64),
+    flag_regex TEXT,
+    is_case_insensitive BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((flag_type = 'static' AND flag_hash IS NOT NULL) OR (flag_type = 'regex' AND flag_regex IS NOT NULL))
+);
+
+CREATE INDEX idx_challenge_flags_challenge_id ON challenge_flags (challenge_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS challenge_unlock_scores;
+DROP TABLE IF EXISTS challenge_prerequisites;
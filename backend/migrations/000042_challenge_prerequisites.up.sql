@@ -0,0 +1,13 @@
+CREATE TABLE challenge_prerequisites (
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    prerequisite_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    PRIMARY KEY (challenge_id, prerequisite_id),
+    CHECK (challenge_id <> prerequisite_id)
+);
+
+CREATE INDEX idx_challenge_prerequisites_prerequisite_id ON challenge_prerequisites (prerequisite_id);
+
+CREATE TABLE challenge_unlock_scores (
+    challenge_id uuid PRIMARY KEY REFERENCES challenges(id) ON DELETE CASCADE,
+    min_score INT NOT NULL CHECK (min_score > 0)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS challenge_schedules;
@@ -0,0 +1,10 @@
+CREATE TABLE challenge_schedules (
+    challenge_id uuid PRIMARY KEY REFERENCES challenges(id) ON DELETE CASCADE,
+    release_at TIMESTAMP,
+    hide_at TIMESTAMP,
+    notify_on_release BOOLEAN NOT NULL DEFAULT FALSE,
+    announced_at TIMESTAMP,
+    CHECK (release_at IS NULL OR hide_at IS NULL OR hide_at > release_at)
+);
+
+CREATE INDEX idx_challenge_schedules_pending_release ON challenge_schedules (release_at) WHERE announced_at IS NULL;
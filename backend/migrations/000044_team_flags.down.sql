@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS cheat_incidents;
+DROP TABLE IF EXISTS challenge_team_flags;
@@ -0,0 +1,18 @@
+CREATE TABLE challenge_team_flags (
+    challenge_id uuid PRIMARY KEY REFERENCES challenges(id) ON DELETE CASCADE,
+    template VARCHAR(255) NOT NULL,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE cheat_incidents (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind VARCHAR(32) NOT NULL,
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    team_id uuid NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id uuid REFERENCES users(id) ON DELETE SET NULL,
+    source_team_id uuid REFERENCES teams(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_cheat_incidents_created_at ON cheat_incidents (created_at DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS challenge_instances;
+DROP TABLE IF EXISTS challenge_instance_configs;
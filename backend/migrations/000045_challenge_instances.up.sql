@@ -0,0 +1,19 @@
+CREATE TABLE challenge_instance_configs (
+    challenge_id uuid PRIMARY KEY REFERENCES challenges(id) ON DELETE CASCADE,
+    image VARCHAR(255) NOT NULL,
+    ttl_seconds INT NOT NULL CHECK (ttl_seconds > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE challenge_instances (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    team_id uuid NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    connection_info TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (challenge_id, team_id)
+);
+
+CREATE INDEX idx_challenge_instances_team_id ON challenge_instances (team_id);
+CREATE INDEX idx_challenge_instances_expires_at ON challenge_instances (expires_at);
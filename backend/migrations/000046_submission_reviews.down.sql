@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS submission_reviews;
+DROP TABLE IF EXISTS challenge_manual_reviews;
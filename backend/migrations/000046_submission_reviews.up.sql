@@ -0,0 +1,21 @@
+CREATE TABLE challenge_manual_reviews (
+    challenge_id uuid PRIMARY KEY REFERENCES challenges(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE submission_reviews (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    team_id uuid NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    answer TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    points INT,
+    comment TEXT NOT NULL DEFAULT '',
+    reviewed_by uuid REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_submission_reviews_pending ON submission_reviews (challenge_id, team_id) WHERE status = 'pending';
+CREATE INDEX idx_submission_reviews_status_created_at ON submission_reviews (status, created_at);
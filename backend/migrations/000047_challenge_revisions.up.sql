@@ -0,0 +1,40 @@
+CREATE TABLE challenge_revisions (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    author_id uuid REFERENCES users(id) ON DELETE SET NULL,
+    snapshot JSONB NOT NULL,
+    changed_fields TEXT[] NOT NULL DEFAULT '{}',
+    restored_from INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (challenge_id, version)
+);
+
+-- Baseline revision for challenges created before revisions were recorded
+INSERT INTO challenge_revisions (challenge_id, version, snapshot)
+SELECT c.id, 1, jsonb_build_object(
+    'title', c.title,
+    'description', c.description,
+    'category', COALESCE(c.category, ''),
+    'points', COALESCE(c.points, 0),
+    'initial_value', c.initial_value,
+    'min_value', c.min_value,
+    'decay', c.decay,
+    'flag_hash', c.flag_hash,
+    'flag_regex', COALESCE(c.flag_regex, ''),
+    'is_hidden', COALESCE(c.is_hidden, FALSE),
+    'is_regex', COALESCE(c.is_regex, FALSE),
+    'is_case_insensitive', COALESCE(c.is_case_insensitive, FALSE),
+    'flag_format_regex', c.flag_format_regex,
+    'tag_ids', COALESCE((
+        SELECT jsonb_agg(ct.tag_id ORDER BY ct.tag_id)
+        FROM challenge_tags ct
+        WHERE ct.challenge_id = c.id
+    ), '[]'::jsonb),
+    'hints', COALESCE((
+        SELECT jsonb_agg(jsonb_build_object('id', h.id, 'content', h.content, 'cost', h.cost, 'order_index', h.order_index) ORDER BY h.order_index, h.id)
+        FROM hints h
+        WHERE h.challenge_id = c.id
+    ), '[]'::jsonb)
+)
+FROM challenges c;
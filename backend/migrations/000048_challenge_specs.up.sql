@@ -0,0 +1,5 @@
+CREATE TABLE challenge_specs (
+    challenge_id uuid PRIMARY KEY REFERENCES challenges(id) ON DELETE CASCADE,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    synced_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
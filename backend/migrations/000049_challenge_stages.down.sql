@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS challenge_stage_solves;
+DROP TABLE IF EXISTS challenge_stage_flags;
+DROP TABLE IF EXISTS challenge_stages;
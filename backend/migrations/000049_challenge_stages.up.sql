@@ -0,0 +1,32 @@
+CREATE TABLE challenge_stages (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    order_index INT NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    points INT NOT NULL DEFAULT 0 CHECK (points >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE challenge_stage_flags (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    stage_id uuid NOT NULL REFERENCES challenge_stages(id) ON DELETE CASCADE,
+    flag_type VARCHAR(10) NOT NULL CHECK (flag_type IN ('static', 'regex')),
+    flag_hash VARCHAR(64),
+    flag_regex TEXT,
+    is_case_insensitive BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((flag_type = 'static' AND flag_hash IS NOT NULL) OR (flag_type = 'regex' AND flag_regex IS NOT NULL))
+);
+
+CREATE TABLE challenge_stage_solves (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    stage_id uuid NOT NULL REFERENCES challenge_stages(id) ON DELETE CASCADE,
+    team_id uuid NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    solved_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (stage_id, team_id)
+);
+
+CREATE INDEX idx_challenge_stages_challenge_id ON challenge_stages (challenge_id, order_index);
+CREATE INDEX idx_challenge_stage_flags_stage_id ON challenge_stage_flags (stage_id);
+CREATE INDEX idx_challenge_stage_solves_team_id ON challenge_stage_solves (team_id);
@@ -0,0 +1,8 @@
+CREATE TABLE challenge_scoring (
+    challenge_id uuid PRIMARY KEY REFERENCES challenges(id) ON DELETE CASCADE,
+    function_name VARCHAR(32) NOT NULL,
+    params JSONB NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_challenge_scoring_function_name ON challenge_scoring (function_name);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_submissions_team_challenge_failed;
+DROP TABLE IF EXISTS challenge_attempt_resets;
+DROP TABLE IF EXISTS challenge_attempt_limits;
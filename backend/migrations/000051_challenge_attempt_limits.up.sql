@@ -0,0 +1,15 @@
+CREATE TABLE challenge_attempt_limits (
+    challenge_id uuid PRIMARY KEY REFERENCES challenges(id) ON DELETE CASCADE,
+    max_attempts INT CHECK (max_attempts IS NULL OR max_attempts > 0),
+    wrong_penalty INT NOT NULL DEFAULT 0 CHECK (wrong_penalty >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE challenge_attempt_resets (
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    team_id uuid NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    reset_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (challenge_id, team_id)
+);
+
+CREATE INDEX idx_submissions_team_challenge_failed ON submissions (team_id, challenge_id) WHERE is_correct = FALSE;
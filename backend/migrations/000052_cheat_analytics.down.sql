@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_submissions_ip;
+DROP TABLE IF EXISTS file_downloads;
+DROP INDEX IF EXISTS idx_cheat_incidents_status_created_at;
+DROP INDEX IF EXISTS idx_cheat_incidents_fingerprint;
+DELETE FROM cheat_incidents WHERE challenge_id IS NULL;
+ALTER TABLE cheat_incidents DROP COLUMN IF EXISTS resolved_at;
+ALTER TABLE cheat_incidents DROP COLUMN IF EXISTS resolved_by;
+ALTER TABLE cheat_incidents DROP COLUMN IF EXISTS fingerprint;
+ALTER TABLE cheat_incidents DROP COLUMN IF EXISTS evidence;
+ALTER TABLE cheat_incidents DROP COLUMN IF EXISTS status;
+ALTER TABLE cheat_incidents ALTER COLUMN challenge_id SET NOT NULL;
@@ -0,0 +1,22 @@
+ALTER TABLE cheat_incidents ALTER COLUMN challenge_id DROP NOT NULL;
+ALTER TABLE cheat_incidents ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'open';
+ALTER TABLE cheat_incidents ADD COLUMN evidence JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE cheat_incidents ADD COLUMN fingerprint TEXT;
+ALTER TABLE cheat_incidents ADD COLUMN resolved_by uuid REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE cheat_incidents ADD COLUMN resolved_at TIMESTAMP;
+
+CREATE UNIQUE INDEX idx_cheat_incidents_fingerprint ON cheat_incidents (fingerprint);
+CREATE INDEX idx_cheat_incidents_status_created_at ON cheat_incidents (status, created_at DESC);
+
+CREATE TABLE file_downloads (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    file_id uuid NOT NULL REFERENCES files(id) ON DELETE CASCADE,
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_id uuid REFERENCES teams(id) ON DELETE SET NULL,
+    ip VARCHAR(45),
+    downloaded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_file_downloads_user_challenge ON file_downloads (user_id, challenge_id);
+CREATE INDEX idx_submissions_ip ON submissions (ip) WHERE ip IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_cheat_incidents_team_kind;
+DELETE FROM cheat_incidents WHERE kind = 'decoy_flag';
+DROP TABLE IF EXISTS challenge_decoy_flags;
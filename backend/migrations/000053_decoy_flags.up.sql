@@ -0,0 +1,11 @@
+CREATE TABLE challenge_decoy_flags (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    flag_hash VARCHAR(64) NOT NULL,
+    is_case_insensitive BOOLEAN NOT NULL DEFAULT FALSE,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (challenge_id, flag_hash)
+);
+
+CREATE INDEX idx_cheat_incidents_team_kind ON cheat_incidents (team_id, kind);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_submission_reviews_submission_id;
+ALTER TABLE submission_reviews DROP COLUMN IF EXISTS submission_id;
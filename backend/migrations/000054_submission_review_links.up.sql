@@ -0,0 +1,15 @@
+ALTER TABLE submission_reviews
+ADD COLUMN submission_id uuid REFERENCES submissions(id) ON DELETE SET NULL;
+
+-- Link reviews queued before the column existed to the submission logged with them
+UPDATE submission_reviews sr
+SET submission_id = (
+    SELECT s.id FROM submissions s
+    WHERE s.challenge_id = sr.challenge_id AND s.team_id = sr.team_id AND s.user_id = sr.user_id
+      AND s.is_correct = FALSE AND btrim(s.submitted_flag, E' \t\n\r\f\x0b') = sr.answer
+      AND NOT EXISTS (SELECT 1 FROM submission_reviews o WHERE o.submission_id = s.id)
+    ORDER BY abs(extract(epoch FROM s.created_at - sr.created_at))
+    LIMIT 1
+);
+
+CREATE INDEX idx_submission_reviews_submission_id ON submission_reviews (submission_id) WHERE submission_id IS NOT NULL;
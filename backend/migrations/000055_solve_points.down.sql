@@ -0,0 +1 @@
+ALTER TABLE solves DROP COLUMN IF EXISTS points;
@@ -0,0 +1,9 @@
+-- Value awarded at solve time for time-scored challenges; NULL follows challenges.points
+ALTER TABLE solves ADD COLUMN points INT;
+
+-- Existing solves of time-scored challenges keep the value they hold now
+UPDATE solves s
+SET points = c.points
+FROM challenges c
+JOIN challenge_scoring cs ON cs.challenge_id = c.id
+WHERE s.challenge_id = c.id AND cs.function_name = 'time';
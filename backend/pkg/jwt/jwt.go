@@ -11,15 +11,22 @@ import (
 const (
 	TokenTypeAccess  = "access"
 	TokenTypeRefresh = "refresh"
+	TokenTypeMFA     = "mfa"
 	AccessMethod     = "HS256"
 	RefreshMethod    = "HS256"
+
+	// MFATokenTTL bounds the window between the password step and the second factor.
+	MFATokenTTL = 5 * time.Minute
 )
 
 type Service interface {
 	GenerateTokenPair(userID uuid.UUID, email, name, role string) (*TokenPair, error)
+	GenerateTokenPairForFamily(userID uuid.UUID, email, name, role string, familyID uuid.UUID) (*TokenPair, error)
 	ValidateAccessToken(tokenString string) (*CustomClaims, error)
 	ValidateRefreshToken(tokenString string) (*CustomClaims, error)
 	RefreshTokens(refreshTokenString string) (*TokenPair, error)
+	GenerateMFAToken(userID uuid.UUID) (string, int64, error)
+	ValidateMFAToken(tokenString string) (*CustomClaims, error)
 }
 
 type JWTService struct {
@@ -35,6 +42,7 @@ type CustomClaims struct {
 	FullName  string `json:"full_name"`
 	Role      string `json:"role,omitempty"`
 	TokenType string `json:"token_type"`
+	FamilyID  string `json:"fid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -43,6 +51,10 @@ type TokenPair struct {
 	RefreshToken     string `json:"refresh_token"`
 	AccessExpiresAt  int64  `json:"access_expires_at"`
 	RefreshExpiresAt int64  `json:"refresh_expires_at"`
+
+	FamilyID       uuid.UUID `json:"-"`
+	AccessTokenID  string    `json:"-"`
+	RefreshTokenID string    `json:"-"`
 }
 
 func NewJWTService(
@@ -60,6 +72,11 @@ func NewJWTService(
 }
 
 func (j *JWTService) GenerateTokenPair(userID uuid.UUID, email, name, role string) (*TokenPair, error) {
+	return j.GenerateTokenPairForFamily(userID, email, name, role, uuid.New())
+}
+
+// GenerateTokenPairForFamily issues a pair bound to an existing refresh token family; each token gets a fresh jti.
+func (j *JWTService) GenerateTokenPairForFamily(userID uuid.UUID, email, name, role string, familyID uuid.UUID) (*TokenPair, error) {
 	now := time.Now()
 	accessExpiry := now.Add(j.accessTTL)
 	refreshExpiry := now.Add(j.refreshTTL)
@@ -70,7 +87,9 @@ func (j *JWTService) GenerateTokenPair(userID uuid.UUID, email, name, role strin
 		FullName:  name,
 		Role:      role,
 		TokenType: TokenTypeAccess,
+		FamilyID:  familyID.String(),
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			ExpiresAt: jwt.NewNumericDate(accessExpiry),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -84,7 +103,9 @@ func (j *JWTService) GenerateTokenPair(userID uuid.UUID, email, name, role strin
 		FullName:  name,
 		Role:      role,
 		TokenType: TokenTypeRefresh,
+		FamilyID:  familyID.String(),
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			ExpiresAt: jwt.NewNumericDate(refreshExpiry),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -109,6 +130,9 @@ func (j *JWTService) GenerateTokenPair(userID uuid.UUID, email, name, role strin
 		RefreshToken:     refreshTokenString,
 		AccessExpiresAt:  accessExpiry.Unix(),
 		RefreshExpiresAt: refreshExpiry.Unix(),
+		FamilyID:         familyID,
+		AccessTokenID:    accessClaims.ID,
+		RefreshTokenID:   refreshClaims.ID,
 	}, nil
 }
 
@@ -154,6 +178,52 @@ func (j *JWTService) ValidateRefreshToken(tokenString string) (*CustomClaims, er
 	return nil, fmt.Errorf("invalid refresh token")
 }
 
+// GenerateMFAToken issues a short-lived challenge token proving the password step of a two-factor login succeeded.
+// It grants no access by itself: ValidateAccessToken rejects it by token type.
+func (j *JWTService) GenerateMFAToken(userID uuid.UUID) (string, int64, error) {
+	now := time.Now()
+	expiry := now.Add(MFATokenTTL)
+	claims := &CustomClaims{
+		UserID:    userID.String(),
+		TokenType: TokenTypeMFA,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(expiry),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    "skypost-delivery-orchestrator",
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(AccessMethod), claims)
+	tokenString, err := token.SignedString(j.accessSecret)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to generate mfa token: %w", err)
+	}
+	return tokenString, expiry.Unix(), nil
+}
+
+func (j *JWTService) ValidateMFAToken(tokenString string) (*CustomClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return j.accessSecret, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate mfa token: %w", err)
+	}
+
+	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid {
+		if claims.TokenType != TokenTypeMFA {
+			return nil, fmt.Errorf("invalid token type")
+		}
+		return claims, nil
+	}
+
+	return nil, fmt.Errorf("invalid mfa token")
+}
+
 func (j *JWTService) RefreshTokens(refreshTokenString string) (*TokenPair, error) {
 	claims, err := j.ValidateRefreshToken(refreshTokenString)
 	if err != nil {
@@ -165,5 +235,10 @@ func (j *JWTService) RefreshTokens(refreshTokenString string) (*TokenPair, error
 		return nil, fmt.Errorf("invalid user ID in token claims: %w", err)
 	}
 
-	return j.GenerateTokenPair(userID, claims.Email, claims.FullName, claims.Role)
+	familyID, err := uuid.Parse(claims.FamilyID)
+	if err != nil {
+		familyID = uuid.New()
+	}
+
+	return j.GenerateTokenPairForFamily(userID, claims.Email, claims.FullName, claims.Role, familyID)
 }
@@ -77,3 +77,71 @@ func TestJWTService_RefreshTokens_Success(t *testing.T) {
 	assert.NotEmpty(t, newPair.RefreshToken)
 	assert.NotEqual(t, pair.AccessToken, newPair.AccessToken)
 }
+
+func TestJWTService_GenerateTokenPairForFamily_Success(t *testing.T) {
+	service := jwt.NewJWTService("access-secret", "refresh-secret", time.Hour, time.Hour)
+	userID := uuid.New()
+	familyID := uuid.New()
+
+	pair, err := service.GenerateTokenPairForFamily(userID, "test@example.com", "Test User", entity.RoleUser, familyID)
+	require.NoError(t, err)
+	assert.Equal(t, familyID, pair.FamilyID)
+	assert.NotEmpty(t, pair.RefreshTokenID)
+
+	claims, err := service.ValidateRefreshToken(pair.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, familyID.String(), claims.FamilyID)
+	assert.Equal(t, pair.RefreshTokenID, claims.ID)
+
+	accessClaims, err := service.ValidateAccessToken(pair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, familyID.String(), accessClaims.FamilyID)
+	assert.Equal(t, pair.AccessTokenID, accessClaims.ID)
+}
+
+func TestJWTService_RefreshTokens_KeepsFamily(t *testing.T) {
+	service := jwt.NewJWTService("access-secret", "refresh-secret", time.Hour, time.Hour)
+	userID := uuid.New()
+
+	pair, err := service.GenerateTokenPair(userID, "test@example.com", "Test User", entity.RoleUser)
+	require.NoError(t, err)
+
+	newPair, err := service.RefreshTokens(pair.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, pair.FamilyID, newPair.FamilyID)
+	assert.NotEqual(t, pair.RefreshTokenID, newPair.RefreshTokenID)
+	assert.NotEqual(t, pair.RefreshToken, newPair.RefreshToken)
+}
+
+func TestJWTService_ValidateMFAToken_Success(t *testing.T) {
+	service := jwt.NewJWTService("access-secret", "refresh-secret", time.Hour, time.Hour)
+	userID := uuid.New()
+
+	token, expiresAt, err := service.GenerateMFAToken(userID)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, expiresAt, time.Now().Add(jwt.MFATokenTTL).Unix())
+
+	claims, err := service.ValidateMFAToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, userID.String(), claims.UserID)
+	assert.Equal(t, jwt.TokenTypeMFA, claims.TokenType)
+}
+
+func TestJWTService_MFAToken_NotAccessToken(t *testing.T) {
+	service := jwt.NewJWTService("access-secret", "refresh-secret", time.Hour, time.Hour)
+	userID := uuid.New()
+
+	token, _, err := service.GenerateMFAToken(userID)
+	require.NoError(t, err)
+
+	claims, err := service.ValidateAccessToken(token)
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+
+	pair, err := service.GenerateTokenPair(userID, "test@example.com", "Test User", entity.RoleUser)
+	require.NoError(t, err)
+
+	claims, err = service.ValidateMFAToken(pair.AccessToken)
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+}
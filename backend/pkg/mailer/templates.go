@@ -18,11 +18,20 @@ type PasswordResetData struct {
 	AppName   string
 }
 
+type EmailChangeData struct {
+	Username  string
+	NewEmail  string
+	ActionURL string
+	AppName   string
+}
+
 var (
 	verificationHTMLTmpl *template.Template
 	verificationTextTmpl *template.Template
 	resetHTMLTmpl        *template.Template
 	resetTextTmpl        *template.Template
+	emailChangeHTMLTmpl  *template.Template
+	emailChangeTextTmpl  *template.Template
 	tmplOnce             sync.Once
 )
 
@@ -84,6 +93,18 @@ const resetBodyHTML = `{{define "body"}}
 <p style="color: #8898aa; font-size: 14px;">This link expires in 1 hour. If you dIDn't request a password reset, you can safely ignore this email.</p>
 {{end}}`
 
+const emailChangeBodyHTML = `{{define "body"}}
+<h2>Confirm your new email address</h2>
+<p>Hi <strong>{{.Username}}</strong>,</p>
+<p>You asked to change the email address of your {{.AppName}} account to <strong>{{.NewEmail}}</strong>.</p>
+<div style="text-align: center; margin: 30px 0;">
+    <a href="{{.ActionURL}}" class="button">Confirm Email</a>
+</div>
+<p>Or paste this link into your browser:<br>
+<a href="{{.ActionURL}}" class="link-text">{{.ActionURL}}</a></p>
+<p style="color: #8898aa; font-size: 14px;">This link expires in 24 hours. Your current address stays active until you confirm.</p>
+{{end}}`
+
 const verificationTextTemplate = `Verify your email address
 
 Hi {{.Username}},
@@ -116,11 +137,29 @@ If you dIDn't request a password reset, you can safely ignore this email.
 --
 {{.AppName}} Team`
 
+const emailChangeTextTemplate = `Confirm your new email address
+
+Hi {{.Username}},
+
+You asked to change the email address of your {{.AppName}} account to {{.NewEmail}}.
+
+To confirm the change, visit the link below:
+{{.ActionURL}}
+
+This link expires in 24 hours. Your current address stays active until you confirm.
+
+If you didn't request this change, you can safely ignore this email.
+
+--
+{{.AppName}} Team`
+
 func initTemplates() {
 	verificationHTMLTmpl = template.Must(template.New("verification_html").Parse(baseLayout + verificationBodyHTML))
 	verificationTextTmpl = template.Must(template.New("verification_text").Parse(verificationTextTemplate))
 	resetHTMLTmpl = template.Must(template.New("reset_html").Parse(baseLayout + resetBodyHTML))
 	resetTextTmpl = template.Must(template.New("reset_text").Parse(resetTextTemplate))
+	emailChangeHTMLTmpl = template.Must(template.New("email_change_html").Parse(baseLayout + emailChangeBodyHTML))
+	emailChangeTextTmpl = template.Must(template.New("email_change_text").Parse(emailChangeTextTemplate))
 }
 
 func getTemplates() {
@@ -170,3 +209,25 @@ func RenderPasswordResetEmail(data PasswordResetData, html bool) (string, error)
 	}
 	return buf.String(), nil
 }
+
+func RenderEmailChangeEmail(data EmailChangeData, html bool) (string, error) {
+	getTemplates()
+
+	if data.AppName == "" {
+		data.AppName = "CTFBoard"
+	}
+
+	var buf bytes.Buffer
+	var err error
+
+	if html {
+		err = emailChangeHTMLTmpl.Execute(&buf, data)
+	} else {
+		err = emailChangeTextTmpl.Execute(&buf, data)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -62,3 +62,21 @@ func TestRenderPasswordResetEmail_DefaultAppName(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, html, "CTFBoard")
 }
+
+func TestRenderEmailChangeEmail_Success(t *testing.T) {
+	data := EmailChangeData{
+		Username:  "user1",
+		NewEmail:  "new@example.com",
+		ActionURL: "https://example.com/confirm-email?token=xyz",
+		AppName:   "TestApp",
+	}
+	html, err := RenderEmailChangeEmail(data, true)
+	require.NoError(t, err)
+	assert.Contains(t, html, "new@example.com")
+	assert.Contains(t, html, "https://example.com/confirm-email?token=xyz")
+	assert.Contains(t, html, "Confirm Email")
+	text, err := RenderEmailChangeEmail(data, false)
+	require.NoError(t, err)
+	assert.Contains(t, text, "user1")
+	assert.Contains(t, text, "new@example.com")
+}
@@ -0,0 +1,316 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	discoveryPath  = "/.well-known/openid-configuration"
+	maxBodyBytes   = 1 << 20
+	defaultTimeout = 10 * time.Second
+)
+
+var (
+	ErrNoIDToken         = errors.New("oidc: token response has no id_token")
+	ErrInvalidIDToken    = errors.New("oidc: invalid id_token")
+	ErrNonceMismatch     = errors.New("oidc: nonce mismatch")
+	ErrUnknownSigningKey = errors.New("oidc: unknown signing key")
+)
+
+// Metadata is the subset of the OpenID Provider discovery document used by the client.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Claims are the decoded claims of an ID token or a userinfo response.
+type Claims map[string]any
+
+// String returns the claim as a string; numeric claims (e.g. GitHub-style ids) are formatted without exponent.
+func (c Claims) String(name string) string {
+	switch v := c[name].(type) {
+	case string:
+		return v
+	case float64:
+		return big.NewFloat(v).Text('f', -1)
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+// Bool returns the claim as a bool, accepting the "true" string some providers send.
+func (c Claims) Bool(name string) bool {
+	switch v := c[name].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
+
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// AuthParams describe a single authorization-code request.
+type AuthParams struct {
+	ClientID      string
+	RedirectURI   string
+	Scopes        []string
+	State         string
+	Nonce         string
+	CodeChallenge string
+}
+
+type ExchangeParams struct {
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+}
+
+type VerifyParams struct {
+	JWKSURI  string
+	Issuer   string
+	ClientID string
+	Nonce    string
+}
+
+type Client struct {
+	http *http.Client
+}
+
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{http: httpClient}
+}
+
+// Discover fetches the provider metadata from {issuer}/.well-known/openid-configuration.
+func (c *Client) Discover(ctx context.Context, issuer string) (*Metadata, error) {
+	var md Metadata
+	if err := c.getJSON(ctx, strings.TrimSuffix(issuer, "/")+discoveryPath, "", &md); err != nil {
+		return nil, fmt.Errorf("oidc - Discover: %w", err)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" {
+		return nil, fmt.Errorf("oidc - Discover: incomplete discovery document")
+	}
+	return &md, nil
+}
+
+// AuthCodeURL builds the authorization endpoint URL for the code flow with an S256 PKCE challenge.
+func AuthCodeURL(endpoint string, p AuthParams) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("oidc - AuthCodeURL: %w", err)
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.ClientID)
+	q.Set("redirect_uri", p.RedirectURI)
+	q.Set("scope", strings.Join(p.Scopes, " "))
+	q.Set("state", p.State)
+	if p.Nonce != "" {
+		q.Set("nonce", p.Nonce)
+	}
+	q.Set("code_challenge", p.CodeChallenge)
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Exchange redeems an authorization code at the token endpoint.
+func (c *Client) Exchange(ctx context.Context, tokenURL string, p ExchangeParams) (*TokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", p.Code)
+	form.Set("redirect_uri", p.RedirectURI)
+	form.Set("client_id", p.ClientID)
+	form.Set("code_verifier", p.CodeVerifier)
+	if p.ClientSecret != "" {
+		form.Set("client_secret", p.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("oidc - Exchange: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var tok TokenResponse
+	if err := c.do(req, &tok); err != nil {
+		return nil, fmt.Errorf("oidc - Exchange: %w", err)
+	}
+	if tok.AccessToken == "" && tok.IDToken == "" {
+		return nil, fmt.Errorf("oidc - Exchange: empty token response")
+	}
+	return &tok, nil
+}
+
+// UserInfo fetches claims from the userinfo endpoint; used for plain OAuth2 providers without an id_token.
+func (c *Client) UserInfo(ctx context.Context, userinfoURL, accessToken string) (Claims, error) {
+	var claims Claims
+	if err := c.getJSON(ctx, userinfoURL, accessToken, &claims); err != nil {
+		return nil, fmt.Errorf("oidc - UserInfo: %w", err)
+	}
+	return claims, nil
+}
+
+// VerifyIDToken checks the signature against the provider JWKS (RS256) and validates iss, aud, exp and nonce.
+func (c *Client) VerifyIDToken(ctx context.Context, rawIDToken string, p VerifyParams) (Claims, error) {
+	if rawIDToken == "" {
+		return nil, ErrNoIDToken
+	}
+	keys, err := c.fetchKeys(ctx, p.JWKSURI)
+	if err != nil {
+		return nil, fmt.Errorf("oidc - VerifyIDToken: %w", err)
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string) //nolint:errcheck // absent kid handled below
+		if key, ok := keys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(keys) == 1 {
+			for _, key := range keys {
+				return key, nil
+			}
+		}
+		return nil, ErrUnknownSigningKey
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(p.Issuer),
+		jwt.WithAudience(p.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	out := Claims(claims)
+	if p.Nonce != "" && out.String("nonce") != p.Nonce {
+		return nil, ErrNonceMismatch
+	}
+	return out, nil
+}
+
+// NewPKCE returns a random code verifier and its S256 challenge.
+func NewPKCE() (verifier, challenge string, err error) {
+	verifier, err = RandomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	return verifier, S256Challenge(verifier), nil
+}
+
+func S256Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// RandomString returns n random bytes encoded as unpadded base64url.
+func RandomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("oidc - RandomString: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (c *Client) fetchKeys(ctx context.Context, jwksURI string) (map[string]*rsa.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := c.getJSON(ctx, jwksURI, "", &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, rawURL, bearer string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	return c.do(req, out)
+}
+
+func (c *Client) do(req *http.Request, out any) error {
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }() //nolint:errcheck // best-effort close
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: unexpected status %d", req.Method, req.URL.Path, resp.StatusCode)
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	return dec.Decode(out)
+}
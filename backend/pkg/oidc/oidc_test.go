@@ -0,0 +1,159 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/skr1ms/CTFBoard/pkg/oidc"
+	"github.com/skr1ms/CTFBoard/pkg/oidc/oidctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const redirectURI = "http://localhost:3000/oauth/callback"
+
+func newIssuer(t *testing.T) *oidctest.Issuer {
+	t.Helper()
+	iss, err := oidctest.NewIssuer("ctfboard", "secret")
+	require.NoError(t, err)
+	t.Cleanup(iss.Close)
+	return iss
+}
+
+func authorize(t *testing.T, iss *oidctest.Issuer, md *oidc.Metadata, nonce string) (code, verifier string) {
+	t.Helper()
+	verifier, challenge, err := oidc.NewPKCE()
+	require.NoError(t, err)
+	authURL, err := oidc.AuthCodeURL(md.AuthorizationEndpoint, oidc.AuthParams{
+		ClientID:      iss.ClientID,
+		RedirectURI:   redirectURI,
+		Scopes:        []string{"openid", "email"},
+		State:         "state-1",
+		Nonce:         nonce,
+		CodeChallenge: challenge,
+	})
+	require.NoError(t, err)
+	code, state, err := iss.Authorize(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "state-1", state)
+	return code, verifier
+}
+
+func TestAuthCodeURL_Params(t *testing.T) {
+	raw, err := oidc.AuthCodeURL("https://idp.example.com/authorize?prompt=login", oidc.AuthParams{
+		ClientID:      "client",
+		RedirectURI:   redirectURI,
+		Scopes:        []string{"openid", "profile"},
+		State:         "s",
+		Nonce:         "n",
+		CodeChallenge: "c",
+	})
+	require.NoError(t, err)
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	q := u.Query()
+	assert.Equal(t, "login", q.Get("prompt"))
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, "openid profile", q.Get("scope"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Equal(t, "c", q.Get("code_challenge"))
+	assert.Equal(t, "n", q.Get("nonce"))
+}
+
+func TestS256Challenge_RFC7636Vector(t *testing.T) {
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		oidc.S256Challenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
+
+func TestClient_CodeFlow_Success(t *testing.T) {
+	ctx := context.Background()
+	iss := newIssuer(t)
+	iss.SetClaims(map[string]any{"sub": "user-1", "email": "alice@uni.edu", "email_verified": true})
+	client := oidc.NewClient(nil)
+
+	md, err := client.Discover(ctx, iss.URL)
+	require.NoError(t, err)
+	assert.Equal(t, iss.URL, md.Issuer)
+
+	code, verifier := authorize(t, iss, md, "nonce-1")
+	tok, err := client.Exchange(ctx, md.TokenEndpoint, oidc.ExchangeParams{
+		ClientID:     iss.ClientID,
+		ClientSecret: iss.ClientSecret,
+		Code:         code,
+		RedirectURI:  redirectURI,
+		CodeVerifier: verifier,
+	})
+	require.NoError(t, err)
+
+	claims, err := client.VerifyIDToken(ctx, tok.IDToken, oidc.VerifyParams{
+		JWKSURI: md.JWKSURI, Issuer: md.Issuer, ClientID: iss.ClientID, Nonce: "nonce-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.String("sub"))
+	assert.True(t, claims.Bool("email_verified"))
+
+	info, err := client.UserInfo(ctx, md.UserinfoEndpoint, tok.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "alice@uni.edu", info.String("email"))
+}
+
+func TestClient_Exchange_WrongVerifier(t *testing.T) {
+	ctx := context.Background()
+	iss := newIssuer(t)
+	iss.SetClaims(map[string]any{"sub": "user-1"})
+	client := oidc.NewClient(nil)
+	md, err := client.Discover(ctx, iss.URL)
+	require.NoError(t, err)
+
+	code, _ := authorize(t, iss, md, "")
+	_, err = client.Exchange(ctx, md.TokenEndpoint, oidc.ExchangeParams{
+		ClientID:     iss.ClientID,
+		ClientSecret: iss.ClientSecret,
+		Code:         code,
+		RedirectURI:  redirectURI,
+		CodeVerifier: "not-the-verifier",
+	})
+	assert.Error(t, err)
+}
+
+func TestClient_VerifyIDToken_Errors(t *testing.T) {
+	ctx := context.Background()
+	iss := newIssuer(t)
+	iss.SetClaims(map[string]any{"sub": "user-1"})
+	client := oidc.NewClient(nil)
+	md, err := client.Discover(ctx, iss.URL)
+	require.NoError(t, err)
+
+	code, verifier := authorize(t, iss, md, "nonce-1")
+	tok, err := client.Exchange(ctx, md.TokenEndpoint, oidc.ExchangeParams{
+		ClientID: iss.ClientID, ClientSecret: iss.ClientSecret, Code: code, RedirectURI: redirectURI, CodeVerifier: verifier,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		params  oidc.VerifyParams
+		wantErr error
+	}{
+		{"WrongNonce", oidc.VerifyParams{JWKSURI: md.JWKSURI, Issuer: md.Issuer, ClientID: iss.ClientID, Nonce: "other"}, oidc.ErrNonceMismatch},
+		{"WrongAudience", oidc.VerifyParams{JWKSURI: md.JWKSURI, Issuer: md.Issuer, ClientID: "other"}, oidc.ErrInvalidIDToken},
+		{"WrongIssuer", oidc.VerifyParams{JWKSURI: md.JWKSURI, Issuer: "https://evil.example.com", ClientID: iss.ClientID}, oidc.ErrInvalidIDToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.VerifyIDToken(ctx, tok.IDToken, tt.params)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, err = client.VerifyIDToken(ctx, "", oidc.VerifyParams{})
+	assert.ErrorIs(t, err, oidc.ErrNoIDToken)
+}
+
+func TestClaims_String_Numeric(t *testing.T) {
+	claims := oidc.Claims{"id": float64(123456789)}
+	assert.Equal(t, "123456789", claims.String("id"))
+	assert.Equal(t, "", claims.String("missing"))
+}
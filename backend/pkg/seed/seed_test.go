@@ -35,10 +35,16 @@ func (m *mockUserRepo) GetByUsername(context.Context, string) (*entity.User, err
 func (m *mockUserRepo) GetByTeamID(context.Context, uuid.UUID) ([]*entity.User, error) {
 	return nil, nil
 }
-func (m *mockUserRepo) GetAll(context.Context) ([]*entity.User, error)            { return nil, nil }
+func (m *mockUserRepo) GetAll(context.Context) ([]*entity.User, error) { return nil, nil }
+func (m *mockUserRepo) List(context.Context, *entity.UserFilter, int, int) ([]*entity.User, error) {
+	return nil, nil
+}
+func (m *mockUserRepo) Count(context.Context, *entity.UserFilter) (int64, error)  { return 0, nil }
 func (m *mockUserRepo) UpdateTeamID(context.Context, uuid.UUID, *uuid.UUID) error { return nil }
 func (m *mockUserRepo) SetVerified(context.Context, uuid.UUID) error              { return nil }
 func (m *mockUserRepo) UpdatePassword(context.Context, uuid.UUID, string) error   { return nil }
+func (m *mockUserRepo) UpdateEmail(context.Context, uuid.UUID, string) error      { return nil }
+func (m *mockUserRepo) UpdateRole(context.Context, uuid.UUID, string) error       { return nil }
 
 var _ repo.UserRepository = (*mockUserRepo)(nil)
 
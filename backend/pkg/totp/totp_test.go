@@ -0,0 +1,75 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 seed from RFC 6238 Appendix B.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFC6238Vectors(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for ts, want := range vectors {
+		got, err := Code(rfcSecret, Step(time.Unix(ts, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "timestamp %d", ts)
+	}
+}
+
+func TestCode_InvalidSecret(t *testing.T) {
+	_, err := Code("not base32!", 1)
+	assert.Error(t, err)
+}
+
+func TestGenerateSecret_Success(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	other, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestValidate_AcceptsSkew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	prev, err := Code(rfcSecret, Step(now)-1)
+	require.NoError(t, err)
+
+	step, ok := Validate(rfcSecret, prev, now)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now)-1, step)
+}
+
+func TestValidate_RejectsOutsideWindow(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	old, err := Code(rfcSecret, Step(now)-5)
+	require.NoError(t, err)
+
+	_, ok := Validate(rfcSecret, old, now)
+	assert.False(t, ok)
+}
+
+func TestValidate_RejectsMalformed(t *testing.T) {
+	_, ok := Validate(rfcSecret, "12345", time.Now())
+	assert.False(t, ok)
+}
+
+func TestURL_Format(t *testing.T) {
+	u := URL("CTFBoard", "alice@example.com", "ABC")
+	assert.True(t, strings.HasPrefix(u, "otpauth://totp/CTFBoard:alice@example.com?"))
+	assert.Contains(t, u, "secret=ABC")
+	assert.Contains(t, u, "issuer=CTFBoard")
+}
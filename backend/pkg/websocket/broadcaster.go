@@ -8,7 +8,15 @@ import (
 
 type SolveBroadcaster interface {
 	NotifySolve(teamID uuid.UUID, challengeTitle string, points int, isFirstBlood bool)
+	NotifySolveRevoked(teamID uuid.UUID, challengeTitle string, points int, newFirstBlood *uuid.UUID)
 	NotifyNotification(message, level string)
+	NotifyUnlock(teamID uuid.UUID, challengeIDs []uuid.UUID)
+	NotifyRelease(challengeID uuid.UUID, title, category string, points int)
+}
+
+// AdminBroadcaster publishes events on the admin-only channel.
+type AdminBroadcaster interface {
+	NotifyCheatIncident(incidentID uuid.UUID, kind, severity string, teamID uuid.UUID, challengeID *uuid.UUID, action string)
 }
 
 type Broadcaster struct {
@@ -52,6 +60,42 @@ func (b *Broadcaster) NotifySolve(teamID uuid.UUID, challengeTitle string, point
 	}
 }
 
+// NotifySolveRevoked announces that an admin took a solve away from teamID. points is the new
+// value of the challenge; newFirstBlood is set when the revoked solve held first blood and
+// another team inherits it.
+func (b *Broadcaster) NotifySolveRevoked(teamID uuid.UUID, challengeTitle string, points int, newFirstBlood *uuid.UUID) {
+	if b == nil || b.hub == nil {
+		return
+	}
+
+	now := time.Now()
+	b.hub.BroadcastEvent(Event{
+		Type: "scoreboard_update",
+		Payload: ScoreboardUpdate{
+			Type:      EventTypeSolveRevoked,
+			TeamID:    teamID.String(),
+			Challenge: challengeTitle,
+			Points:    points,
+			Timestamp: now,
+		},
+		Timestamp: now,
+	})
+
+	if newFirstBlood != nil {
+		b.hub.BroadcastEvent(Event{
+			Type: "scoreboard_update",
+			Payload: ScoreboardUpdate{
+				Type:      EventTypeFirstBlood,
+				TeamID:    newFirstBlood.String(),
+				Challenge: challengeTitle,
+				Points:    points,
+				Timestamp: now,
+			},
+			Timestamp: now,
+		})
+	}
+}
+
 func (b *Broadcaster) NotifyNotification(message, level string) {
 	if b == nil || b.hub == nil {
 		return
@@ -70,4 +114,73 @@ func (b *Broadcaster) NotifyNotification(message, level string) {
 	})
 }
 
-var _ SolveBroadcaster = (*Broadcaster)(nil)
+func (b *Broadcaster) NotifyUnlock(teamID uuid.UUID, challengeIDs []uuid.UUID) {
+	if b == nil || b.hub == nil || len(challengeIDs) == 0 {
+		return
+	}
+
+	ids := make([]string, len(challengeIDs))
+	for i, id := range challengeIDs {
+		ids[i] = id.String()
+	}
+	now := time.Now()
+	b.hub.BroadcastEvent(Event{
+		Type: EventTypeUnlock,
+		Payload: ChallengeUnlock{
+			Type:         EventTypeUnlock,
+			TeamID:       teamID.String(),
+			ChallengeIDs: ids,
+			Timestamp:    now,
+		},
+		Timestamp: now,
+	})
+}
+
+func (b *Broadcaster) NotifyRelease(challengeID uuid.UUID, title, category string, points int) {
+	if b == nil || b.hub == nil {
+		return
+	}
+
+	now := time.Now()
+	b.hub.BroadcastEvent(Event{
+		Type: EventTypeRelease,
+		Payload: ChallengeRelease{
+			Type:        EventTypeRelease,
+			ChallengeID: challengeID.String(),
+			Title:       title,
+			Category:    category,
+			Points:      points,
+			Timestamp:   now,
+		},
+		Timestamp: now,
+	})
+}
+
+func (b *Broadcaster) NotifyCheatIncident(incidentID uuid.UUID, kind, severity string, teamID uuid.UUID, challengeID *uuid.UUID, action string) {
+	if b == nil || b.hub == nil {
+		return
+	}
+
+	alert := CheatAlert{
+		Type:       EventTypeCheatAlert,
+		IncidentID: incidentID.String(),
+		Kind:       kind,
+		Severity:   severity,
+		TeamID:     teamID.String(),
+		Action:     action,
+		Timestamp:  time.Now(),
+	}
+	if challengeID != nil {
+		alert.ChallengeID = challengeID.String()
+	}
+	b.hub.BroadcastAdminEvent(Event{
+		Type:      EventTypeCheatAlert,
+		Payload:   alert,
+		Timestamp: alert.Timestamp,
+	})
+}
+
+var (
+	_ SolveBroadcaster = (*Broadcaster)(nil)
+	_ AdminBroadcaster = (*Broadcaster)(nil)
+)
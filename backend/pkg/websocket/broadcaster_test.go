@@ -148,3 +148,178 @@ func TestBroadcaster_NotifyNotification_WithHub(t *testing.T) {
 		t.Fatal("timeout waiting for notification event")
 	}
 }
+
+func TestBroadcaster_NotifyUnlock_NilHub(t *testing.T) {
+	b := NewBroadcaster(nil)
+	b.NotifyUnlock(uuid.New(), []uuid.UUID{uuid.New()})
+}
+
+func TestBroadcaster_NotifyUnlock_WithHub(t *testing.T) {
+	hub := NewHub(nil, "")
+	go hub.Run(context.Background())
+
+	client := &Client{
+		hub:  hub,
+		send: make(chan []byte, 4),
+	}
+	hub.Register(client)
+
+	select {
+	case <-client.send:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for connected")
+	}
+
+	teamID := uuid.New()
+	challengeID := uuid.New()
+	b := NewBroadcaster(hub)
+	b.NotifyUnlock(teamID, nil)
+	b.NotifyUnlock(teamID, []uuid.UUID{challengeID})
+
+	select {
+	case data := <-client.send:
+		var ev Event
+		require.NoError(t, json.Unmarshal(data, &ev))
+		assert.Equal(t, EventTypeUnlock, ev.Type)
+		payload, ok := ev.Payload.(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, teamID.String(), payload["team_id"])
+		assert.Equal(t, []any{challengeID.String()}, payload["challenge_ids"])
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for unlock event")
+	}
+}
+
+func TestBroadcaster_NotifyRelease_NilHub(t *testing.T) {
+	b := NewBroadcaster(nil)
+	b.NotifyRelease(uuid.New(), "Released", "web", 100)
+}
+
+func TestBroadcaster_NotifyRelease_WithHub(t *testing.T) {
+	hub := NewHub(nil, "")
+	go hub.Run(context.Background())
+
+	client := &Client{
+		hub:  hub,
+		send: make(chan []byte, 4),
+	}
+	hub.Register(client)
+
+	select {
+	case <-client.send:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for connected")
+	}
+
+	challengeID := uuid.New()
+	b := NewBroadcaster(hub)
+	b.NotifyRelease(challengeID, "Released", "web", 300)
+
+	select {
+	case data := <-client.send:
+		var ev Event
+		require.NoError(t, json.Unmarshal(data, &ev))
+		assert.Equal(t, EventTypeRelease, ev.Type)
+		payload, ok := ev.Payload.(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, challengeID.String(), payload["challenge_id"])
+		assert.Equal(t, "Released", payload["title"])
+		assert.InDelta(t, 300, payload["points"], 0)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for release event")
+	}
+}
+
+func TestBroadcaster_NotifySolveRevoked_NilHub(t *testing.T) {
+	b := NewBroadcaster(nil)
+	b.NotifySolveRevoked(uuid.New(), "Revoked", 100, nil)
+}
+
+func TestBroadcaster_NotifySolveRevoked_WithHub_NewFirstBlood(t *testing.T) {
+	hub := NewHub(nil, "")
+	go hub.Run(context.Background())
+
+	client := &Client{
+		hub:  hub,
+		send: make(chan []byte, 4),
+	}
+	hub.Register(client)
+
+	select {
+	case <-client.send:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for connected")
+	}
+
+	teamID := uuid.New()
+	nextTeamID := uuid.New()
+	b := NewBroadcaster(hub)
+	b.NotifySolveRevoked(teamID, "Revoked", 450, &nextTeamID)
+
+	for _, want := range []struct {
+		eventType string
+		teamID    string
+	}{
+		{EventTypeSolveRevoked, teamID.String()},
+		{EventTypeFirstBlood, nextTeamID.String()},
+	} {
+		select {
+		case data := <-client.send:
+			var ev Event
+			require.NoError(t, json.Unmarshal(data, &ev))
+			assert.Equal(t, "scoreboard_update", ev.Type)
+			payload, ok := ev.Payload.(map[string]any)
+			require.True(t, ok)
+			assert.Equal(t, want.eventType, payload["type"])
+			assert.Equal(t, want.teamID, payload["team_id"])
+			assert.InDelta(t, 450, payload["points"], 0)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for " + want.eventType + " event")
+		}
+	}
+}
+
+func TestBroadcaster_NotifyCheatIncident_NilHub(t *testing.T) {
+	b := NewBroadcaster(nil)
+	b.NotifyCheatIncident(uuid.New(), "decoy_flag", "high", uuid.New(), nil, "")
+}
+
+func TestBroadcaster_NotifyCheatIncident_AdminsOnly(t *testing.T) {
+	hub := NewHub(nil, "")
+	go hub.Run(context.Background())
+
+	player := &Client{hub: hub, send: make(chan []byte, 4)}
+	admin := &Client{hub: hub, send: make(chan []byte, 4), admin: true}
+	for _, c := range []*Client{player, admin} {
+		hub.Register(c)
+		select {
+		case <-c.send:
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for connected")
+		}
+	}
+
+	incidentID, teamID, challengeID := uuid.New(), uuid.New(), uuid.New()
+	b := NewBroadcaster(hub)
+	b.NotifyCheatIncident(incidentID, "decoy_flag", "high", teamID, &challengeID, "ban")
+
+	select {
+	case data := <-admin.send:
+		var ev Event
+		require.NoError(t, json.Unmarshal(data, &ev))
+		assert.Equal(t, EventTypeCheatAlert, ev.Type)
+		payload, ok := ev.Payload.(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, incidentID.String(), payload["incident_id"])
+		assert.Equal(t, teamID.String(), payload["team_id"])
+		assert.Equal(t, challengeID.String(), payload["challenge_id"])
+		assert.Equal(t, "ban", payload["action"])
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for cheat alert")
+	}
+	select {
+	case <-player.send:
+		t.Fatal("cheat alert reached a non-admin client")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
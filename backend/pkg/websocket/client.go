@@ -25,6 +25,17 @@ func NewClient(
 	}
 }
 
+// NewAdminClient creates a client that also receives admin-only events; the caller must have
+// checked that the connection belongs to an admin.
+func NewAdminClient(
+	hub *Hub,
+	conn *websocket.Conn,
+) *Client {
+	client := NewClient(hub, conn)
+	client.admin = true
+	return client
+}
+
 func (c *Client) ReadPump() {
 	defer func() {
 		c.hub.Unregister(c)
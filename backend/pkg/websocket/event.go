@@ -5,7 +5,11 @@ import "time"
 const (
 	EventTypeSolve        = "solve"
 	EventTypeFirstBlood   = "first_blood"
+	EventTypeSolveRevoked = "solve_revoked"
 	EventTypeNotification = "notification"
+	EventTypeUnlock       = "challenge_unlocked"
+	EventTypeRelease      = "challenge_released"
+	EventTypeCheatAlert   = "cheat_incident"
 )
 
 type ScoreboardUpdate struct {
@@ -24,6 +28,37 @@ type Notification struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// ChallengeUnlock lists the challenges a solve unlocked for a team; clients show it only to that team.
+type ChallengeUnlock struct {
+	Type         string    `json:"type"`
+	TeamID       string    `json:"team_id"`
+	ChallengeIDs []string  `json:"challenge_ids"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// ChallengeRelease announces a scheduled challenge that has just gone live.
+type ChallengeRelease struct {
+	Type        string    `json:"type"`
+	ChallengeID string    `json:"challenge_id"`
+	Title       string    `json:"title"`
+	Category    string    `json:"category"`
+	Points      int       `json:"points"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// CheatAlert tells admins about a new cheat incident. Action is what was done to the team
+// automatically, e.g. "ban" or "hide", and empty when nothing was.
+type CheatAlert struct {
+	Type        string    `json:"type"`
+	IncidentID  string    `json:"incident_id"`
+	Kind        string    `json:"kind"`
+	Severity    string    `json:"severity"`
+	TeamID      string    `json:"team_id"`
+	ChallengeID string    `json:"challenge_id,omitempty"`
+	Action      string    `json:"action,omitempty"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
 type Event struct {
 	Type      string    `json:"type"`
 	Payload   any       `json:"payload"`
@@ -10,15 +10,21 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// adminChannelSuffix is appended to the Redis channel of a hub for events only admins receive.
+const adminChannelSuffix = ":admin"
+
 type Client struct {
 	hub  *Hub
 	conn *websocket.Conn
 	send chan []byte
+	// admin clients additionally receive the events published with BroadcastAdminEvent.
+	admin bool
 }
 
 type broadcastItem struct {
-	data []byte
-	done chan struct{}
+	data      []byte
+	done      chan struct{}
+	adminOnly bool
 }
 
 type Hub struct {
@@ -79,6 +85,9 @@ func (h *Hub) unregisterClient(client *Client) {
 
 func (h *Hub) broadcastToClients(item broadcastItem) {
 	for client := range h.clients {
+		if item.adminOnly && !client.admin {
+			continue
+		}
 		select {
 		case client.send <- item.data:
 		default:
@@ -102,13 +111,22 @@ func (h *Hub) Broadcast(data []byte) {
 }
 
 func (h *Hub) BroadcastEvent(event any) {
+	h.broadcastEvent(event, false)
+}
+
+// BroadcastAdminEvent sends event only to clients connected through the admin channel.
+func (h *Hub) BroadcastAdminEvent(event any) {
+	h.broadcastEvent(event, true)
+}
+
+func (h *Hub) broadcastEvent(event any, adminOnly bool) {
 	data, err := json.Marshal(event)
 	if err != nil {
 		return
 	}
 	done := make(chan struct{})
 	select {
-	case h.broadcast <- broadcastItem{data: data, done: done}:
+	case h.broadcast <- broadcastItem{data: data, done: done, adminOnly: adminOnly}:
 		select {
 		case <-done:
 		case <-time.After(5 * time.Second):
@@ -117,7 +135,11 @@ func (h *Hub) BroadcastEvent(event any) {
 		return
 	}
 	if h.redisClient != nil {
-		h.redisClient.Publish(context.Background(), h.redisChannel, data)
+		channel := h.redisChannel
+		if adminOnly {
+			channel += adminChannelSuffix
+		}
+		h.redisClient.Publish(context.Background(), channel, data)
 	}
 }
 
@@ -125,7 +147,8 @@ func (h *Hub) SubscribeToRedis(ctx context.Context) {
 	if h.redisClient == nil {
 		return
 	}
-	pubsub := h.redisClient.Subscribe(ctx, h.redisChannel)
+	adminChannel := h.redisChannel + adminChannelSuffix
+	pubsub := h.redisClient.Subscribe(ctx, h.redisChannel, adminChannel)
 	defer func() { _ = pubsub.Close() }()
 
 	ch := pubsub.Channel()
@@ -137,7 +160,7 @@ func (h *Hub) SubscribeToRedis(ctx context.Context) {
 			if !ok {
 				return
 			}
-			h.Broadcast([]byte(msg.Payload))
+			h.broadcast <- broadcastItem{data: []byte(msg.Payload), adminOnly: msg.Channel == adminChannel}
 		}
 	}
 }
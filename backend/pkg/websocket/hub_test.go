@@ -84,6 +84,27 @@ func TestHub_BroadcastEvent_Redis(t *testing.T) {
 	assert.NoError(t, redisClient.ExpectationsWereMet())
 }
 
+func TestHub_BroadcastAdminEvent_Redis(t *testing.T) {
+	db, redisClient := redismock.NewClientMock()
+	hub := NewHub(db, "test-channel")
+	go hub.Run(context.Background())
+
+	event := Event{
+		Type:      EventTypeCheatAlert,
+		Payload:   "payload",
+		Timestamp: time.Now(),
+	}
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	redisClient.ExpectPublish("test-channel:admin", data).SetVal(1)
+
+	hub.BroadcastAdminEvent(event)
+
+	assert.NoError(t, redisClient.ExpectationsWereMet())
+}
+
 func TestHub_Run_ExitsOnContextCancel(t *testing.T) {
 	hub := NewHub(nil, "")
 	ctx, cancel := context.WithCancel(context.Background())
@@ -1,20 +1,48 @@
 -- name: CreateAPIToken :exec
-INSERT INTO api_tokens (id, user_id, token_hash, description, expires_at, created_at)
-VALUES ($1, $2, $3, $4, $5, $6);
+INSERT INTO api_tokens (id, user_id, token_hash, description, scopes, created_by, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
 -- name: GetAPITokensByUserID :many
-SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at
+SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at, scopes, created_by
 FROM api_tokens
 WHERE user_id = $1
 ORDER BY created_at DESC;
 
+-- name: GetServiceAPITokens :many
+SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at, scopes, created_by
+FROM api_tokens
+WHERE user_id IS NULL
+ORDER BY created_at DESC;
+
+-- name: GetAPITokenByID :one
+SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at, scopes, created_by
+FROM api_tokens
+WHERE id = $1;
+
 -- name: GetAPITokenByHash :one
-SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at
+SELECT id, user_id, token_hash, description, expires_at, last_used_at, created_at, scopes, created_by
 FROM api_tokens
 WHERE token_hash = $1;
 
 -- name: DeleteAPIToken :exec
 DELETE FROM api_tokens WHERE id = $1 AND user_id = $2;
 
+-- name: DeleteAPITokensByUserID :exec
+DELETE FROM api_tokens WHERE user_id = $1;
+
+-- name: DeleteServiceAPIToken :execrows
+DELETE FROM api_tokens WHERE id = $1 AND user_id IS NULL;
+
 -- name: UpdateAPITokenLastUsed :exec
 UPDATE api_tokens SET last_used_at = $2 WHERE id = $1;
+
+-- name: CreateAPITokenRequest :exec
+INSERT INTO api_token_requests (id, token_id, method, path, status, ip, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetAPITokenRequests :many
+SELECT id, token_id, method, path, status, ip, created_at
+FROM api_token_requests
+WHERE token_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
@@ -3,7 +3,8 @@ SELECT id, app_name, verify_emails, frontend_url, cors_origins,
        resend_enabled, resend_from_email, resend_from_name,
        verify_ttl_hours, reset_ttl_hours,
        submit_limit_per_user, submit_limit_duration_min,
-       scoreboard_visible, registration_open, updated_at
+       scoreboard_visible, registration_open, require_2fa_for_admins, updated_at,
+       registration_opens_at, registration_closes_at
 FROM app_settings
 WHERE id = 1;
 
@@ -22,5 +23,8 @@ UPDATE app_settings SET
     submit_limit_duration_min = $11,
     scoreboard_visible = $12,
     registration_open = $13,
-    updated_at = $14
+    require_2fa_for_admins = $14,
+    updated_at = $15,
+    registration_opens_at = $16,
+    registration_closes_at = $17
 WHERE id = 1;
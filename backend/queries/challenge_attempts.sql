@@ -0,0 +1,31 @@
+-- name: GetChallengeAttemptLimit :one
+SELECT challenge_id, max_attempts, wrong_penalty, updated_at
+FROM challenge_attempt_limits
+WHERE challenge_id = $1;
+
+-- name: GetAllChallengeAttemptLimits :many
+SELECT challenge_id, max_attempts, wrong_penalty, updated_at
+FROM challenge_attempt_limits
+ORDER BY challenge_id;
+
+-- name: UpsertChallengeAttemptLimit :one
+INSERT INTO challenge_attempt_limits (challenge_id, max_attempts, wrong_penalty, updated_at)
+VALUES ($1, $2, $3, CURRENT_TIMESTAMP)
+ON CONFLICT (challenge_id) DO UPDATE SET
+    max_attempts = EXCLUDED.max_attempts,
+    wrong_penalty = EXCLUDED.wrong_penalty,
+    updated_at = EXCLUDED.updated_at
+RETURNING updated_at;
+
+-- name: DeleteChallengeAttemptLimit :exec
+DELETE FROM challenge_attempt_limits WHERE challenge_id = $1;
+
+-- name: UpsertChallengeAttemptReset :exec
+INSERT INTO challenge_attempt_resets (challenge_id, team_id, reset_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (challenge_id, team_id) DO UPDATE SET reset_at = EXCLUDED.reset_at;
+
+-- name: GetAllChallengeAttemptResets :many
+SELECT challenge_id, team_id, reset_at
+FROM challenge_attempt_resets
+ORDER BY challenge_id, team_id;
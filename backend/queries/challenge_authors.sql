@@ -0,0 +1,9 @@
+-- name: AddChallengeAuthor :exec
+INSERT INTO challenge_authors (challenge_id, user_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (challenge_id, user_id) DO NOTHING;
+
+-- name: IsChallengeAuthor :one
+SELECT EXISTS (
+    SELECT 1 FROM challenge_authors WHERE challenge_id = $1 AND user_id = $2
+);
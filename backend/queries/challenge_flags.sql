@@ -0,0 +1,23 @@
+-- name: CreateChallengeFlag :one
+INSERT INTO challenge_flags (id, challenge_id, flag_type, flag_hash, flag_regex, is_case_insensitive)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, challenge_id, flag_type, flag_hash, flag_regex, is_case_insensitive, created_at;
+
+-- name: GetChallengeFlagByID :one
+SELECT id, challenge_id, flag_type, flag_hash, flag_regex, is_case_insensitive, created_at
+FROM challenge_flags
+WHERE id = $1;
+
+-- name: GetChallengeFlagsByChallengeID :many
+SELECT id, challenge_id, flag_type, flag_hash, flag_regex, is_case_insensitive, created_at
+FROM challenge_flags
+WHERE challenge_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: UpdateChallengeFlag :execrows
+UPDATE challenge_flags
+SET flag_type = $2, flag_hash = $3, flag_regex = $4, is_case_insensitive = $5
+WHERE id = $1;
+
+-- name: DeleteChallengeFlag :execrows
+DELETE FROM challenge_flags WHERE id = $1;
@@ -0,0 +1,44 @@
+-- name: GetChallengeInstanceConfig :one
+SELECT challenge_id, image, ttl_seconds, created_at
+FROM challenge_instance_configs
+WHERE challenge_id = $1;
+
+-- name: UpsertChallengeInstanceConfig :exec
+INSERT INTO challenge_instance_configs (challenge_id, image, ttl_seconds)
+VALUES ($1, $2, $3)
+ON CONFLICT (challenge_id) DO UPDATE SET
+    image = EXCLUDED.image,
+    ttl_seconds = EXCLUDED.ttl_seconds;
+
+-- name: DeleteChallengeInstanceConfig :exec
+DELETE FROM challenge_instance_configs WHERE challenge_id = $1;
+
+-- name: GetChallengeInstance :one
+SELECT id, challenge_id, team_id, connection_info, expires_at, created_at
+FROM challenge_instances
+WHERE challenge_id = $1 AND team_id = $2;
+
+-- name: LockTeamChallengeInstances :exec
+SELECT pg_advisory_xact_lock(hashtext('challenge_instances:' || sqlc.arg('team_id')::uuid::text));
+
+-- name: CreateChallengeInstance :one
+INSERT INTO challenge_instances (challenge_id, team_id, connection_info, expires_at)
+SELECT sqlc.arg('challenge_id')::uuid, sqlc.arg('team_id')::uuid, sqlc.arg('connection_info')::text, sqlc.arg('expires_at')::timestamp
+WHERE (SELECT COUNT(*) FROM challenge_instances WHERE team_id = sqlc.arg('team_id')::uuid) < sqlc.arg('max_per_team')::int
+ON CONFLICT (challenge_id, team_id) DO NOTHING
+RETURNING id, created_at;
+
+-- name: UpdateChallengeInstanceConnectionInfo :exec
+UPDATE challenge_instances SET connection_info = $2 WHERE id = $1;
+
+-- name: UpdateChallengeInstanceExpiry :exec
+UPDATE challenge_instances SET expires_at = $2 WHERE id = $1;
+
+-- name: DeleteChallengeInstance :exec
+DELETE FROM challenge_instances WHERE id = $1;
+
+-- name: GetExpiredChallengeInstances :many
+SELECT id, challenge_id, team_id, connection_info, expires_at, created_at
+FROM challenge_instances
+WHERE expires_at <= $1
+ORDER BY expires_at;
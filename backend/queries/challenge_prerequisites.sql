@@ -0,0 +1,33 @@
+-- name: AddChallengePrerequisite :exec
+INSERT INTO challenge_prerequisites (challenge_id, prerequisite_id)
+VALUES ($1, $2);
+
+-- name: DeleteChallengePrerequisites :exec
+DELETE FROM challenge_prerequisites WHERE challenge_id = $1;
+
+-- name: GetChallengePrerequisiteIDs :many
+SELECT prerequisite_id
+FROM challenge_prerequisites
+WHERE challenge_id = $1
+ORDER BY prerequisite_id;
+
+-- name: ListChallengePrerequisites :many
+SELECT challenge_id, prerequisite_id
+FROM challenge_prerequisites
+ORDER BY challenge_id, prerequisite_id;
+
+-- name: GetChallengeUnlockScore :one
+SELECT min_score FROM challenge_unlock_scores WHERE challenge_id = $1;
+
+-- name: ListChallengeUnlockScores :many
+SELECT challenge_id, min_score
+FROM challenge_unlock_scores
+ORDER BY challenge_id;
+
+-- name: UpsertChallengeUnlockScore :exec
+INSERT INTO challenge_unlock_scores (challenge_id, min_score)
+VALUES ($1, $2)
+ON CONFLICT (challenge_id) DO UPDATE SET min_score = EXCLUDED.min_score;
+
+-- name: DeleteChallengeUnlockScore :exec
+DELETE FROM challenge_unlock_scores WHERE challenge_id = $1;
@@ -0,0 +1,35 @@
+-- name: CreateChallengeRevision :one
+INSERT INTO challenge_revisions (challenge_id, version, author_id, snapshot, changed_fields, restored_from)
+SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3, $4, $5
+FROM challenge_revisions
+WHERE challenge_id = $1
+RETURNING id, version, created_at;
+
+-- name: GetLatestChallengeRevision :one
+SELECT id, challenge_id, version, author_id, snapshot, changed_fields, restored_from, created_at
+FROM challenge_revisions
+WHERE challenge_id = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: GetChallengeRevisionByVersion :one
+SELECT id, challenge_id, version, author_id, snapshot, changed_fields, restored_from, created_at
+FROM challenge_revisions
+WHERE challenge_id = $1 AND version = $2;
+
+-- name: GetChallengeRevisions :many
+SELECT r.id, r.challenge_id, r.version, r.author_id, u.username AS author_name, r.snapshot, r.changed_fields, r.restored_from, r.created_at
+FROM challenge_revisions r
+LEFT JOIN users u ON u.id = r.author_id
+WHERE r.challenge_id = $1
+ORDER BY r.version DESC;
+
+-- name: DeleteChallengeHintsExcept :exec
+DELETE FROM hints
+WHERE challenge_id = $1 AND NOT (id = ANY(sqlc.arg(keep_ids)::uuid[]));
+
+-- name: RestoreChallengeHint :exec
+INSERT INTO hints (id, challenge_id, content, cost, order_index)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE
+SET content = EXCLUDED.content, cost = EXCLUDED.cost, order_index = EXCLUDED.order_index;
@@ -0,0 +1,34 @@
+-- name: GetChallengeSchedule :one
+SELECT challenge_id, release_at, hide_at, notify_on_release, announced_at
+FROM challenge_schedules
+WHERE challenge_id = $1;
+
+-- name: UpsertChallengeSchedule :exec
+INSERT INTO challenge_schedules (challenge_id, release_at, hide_at, notify_on_release, announced_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (challenge_id) DO UPDATE SET
+    release_at = EXCLUDED.release_at,
+    hide_at = EXCLUDED.hide_at,
+    notify_on_release = EXCLUDED.notify_on_release,
+    announced_at = EXCLUDED.announced_at;
+
+-- name: DeleteChallengeSchedule :exec
+DELETE FROM challenge_schedules WHERE challenge_id = $1;
+
+-- name: ListChallengeSchedules :many
+SELECT cs.challenge_id, cs.release_at, cs.hide_at, cs.notify_on_release, cs.announced_at,
+    c.title, c.category, c.points, c.is_hidden
+FROM challenge_schedules cs
+JOIN challenges c ON c.id = cs.challenge_id
+ORDER BY COALESCE(cs.release_at, cs.hide_at), c.title;
+
+-- name: ClaimDueChallengeReleases :many
+UPDATE challenge_schedules cs SET announced_at = NOW()
+FROM challenges c
+WHERE c.id = cs.challenge_id
+  AND c.is_hidden = false
+  AND cs.announced_at IS NULL
+  AND cs.release_at <= NOW()
+  AND (cs.hide_at IS NULL OR cs.hide_at > NOW())
+RETURNING cs.challenge_id, cs.release_at, cs.hide_at, cs.notify_on_release, cs.announced_at,
+    c.title, c.category, c.points, c.is_hidden;
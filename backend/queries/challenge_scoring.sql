@@ -0,0 +1,23 @@
+-- name: GetChallengeScoring :one
+SELECT challenge_id, function_name, params, updated_at
+FROM challenge_scoring
+WHERE challenge_id = $1;
+
+-- name: UpsertChallengeScoring :one
+INSERT INTO challenge_scoring (challenge_id, function_name, params, updated_at)
+VALUES ($1, $2, $3, CURRENT_TIMESTAMP)
+ON CONFLICT (challenge_id) DO UPDATE SET
+    function_name = EXCLUDED.function_name,
+    params = EXCLUDED.params,
+    updated_at = EXCLUDED.updated_at
+RETURNING updated_at;
+
+-- name: DeleteChallengeScoring :exec
+DELETE FROM challenge_scoring WHERE challenge_id = $1;
+
+-- name: ListChallengeScoringByFunctions :many
+SELECT cs.challenge_id, cs.function_name, cs.params, cs.updated_at, c.points, c.solve_count
+FROM challenge_scoring cs
+JOIN challenges c ON c.id = cs.challenge_id
+WHERE cs.function_name = ANY(sqlc.arg(function_names)::text[])
+ORDER BY cs.challenge_id;
@@ -0,0 +1,11 @@
+-- name: ListSpecChallenges :many
+SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.min_value, c.decay, c.solve_count, c.flag_hash, c.is_hidden, c.is_regex, c.is_case_insensitive, c.flag_regex, c.flag_format_regex, s.slug
+FROM challenges c
+LEFT JOIN challenge_specs s ON s.challenge_id = c.id
+ORDER BY c.category, c.title, c.id;
+
+-- name: UpsertChallengeSpec :exec
+INSERT INTO challenge_specs (challenge_id, slug)
+VALUES ($1, $2)
+ON CONFLICT (challenge_id) DO UPDATE
+SET slug = EXCLUDED.slug, synced_at = CURRENT_TIMESTAMP;
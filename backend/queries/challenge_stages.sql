@@ -0,0 +1,85 @@
+-- name: CreateChallengeStage :one
+INSERT INTO challenge_stages (id, challenge_id, order_index, title, points)
+VALUES ($1, $2, (SELECT COALESCE(MAX(order_index) + 1, 0) FROM challenge_stages WHERE challenge_id = $2), $3, $4)
+RETURNING id, challenge_id, order_index, title, points, created_at;
+
+-- name: GetChallengeStageByID :one
+SELECT st.id, st.challenge_id, st.order_index, st.title, st.points, st.created_at,
+    (SELECT COUNT(*) FROM challenge_stage_solves ss WHERE ss.stage_id = st.id)::int AS solve_count
+FROM challenge_stages st
+WHERE st.id = $1;
+
+-- name: GetChallengeStagesByChallengeID :many
+SELECT st.id, st.challenge_id, st.order_index, st.title, st.points, st.created_at,
+    (SELECT COUNT(*) FROM challenge_stage_solves ss WHERE ss.stage_id = st.id)::int AS solve_count
+FROM challenge_stages st
+WHERE st.challenge_id = $1
+ORDER BY st.order_index ASC, st.created_at ASC;
+
+-- name: UpdateChallengeStage :execrows
+UPDATE challenge_stages
+SET title = $2, points = $3
+WHERE id = $1;
+
+-- name: SetChallengeStageOrder :exec
+UPDATE challenge_stages SET order_index = $2 WHERE id = $1;
+
+-- name: DeleteChallengeStage :execrows
+DELETE FROM challenge_stages WHERE id = $1;
+
+-- name: CreateChallengeStageFlag :exec
+INSERT INTO challenge_stage_flags (stage_id, flag_type, flag_hash, flag_regex, is_case_insensitive)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetChallengeStageFlagsByStageID :many
+SELECT id, stage_id, flag_type, flag_hash, flag_regex, is_case_insensitive, created_at
+FROM challenge_stage_flags
+WHERE stage_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetChallengeStageFlagsByChallengeID :many
+SELECT f.id, f.stage_id, f.flag_type, f.flag_hash, f.flag_regex, f.is_case_insensitive, f.created_at
+FROM challenge_stage_flags f
+JOIN challenge_stages st ON st.id = f.stage_id
+WHERE st.challenge_id = $1
+ORDER BY f.created_at ASC, f.id ASC;
+
+-- name: DeleteChallengeStageFlags :exec
+DELETE FROM challenge_stage_flags WHERE stage_id = $1;
+
+-- name: CreateChallengeStageSolve :execrows
+INSERT INTO challenge_stage_solves (stage_id, team_id, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (stage_id, team_id) DO NOTHING;
+
+-- name: LockChallengeStage :one
+SELECT id FROM challenge_stages WHERE id = $1 FOR UPDATE;
+
+-- name: CountChallengeStageSolves :one
+SELECT COUNT(*)::int FROM challenge_stage_solves WHERE stage_id = $1;
+
+-- name: GetCompletedStageIDs :many
+SELECT ss.stage_id
+FROM challenge_stage_solves ss
+JOIN challenge_stages st ON st.id = ss.stage_id
+WHERE ss.team_id = $1 AND st.challenge_id = $2;
+
+-- name: GetStageProgress :many
+SELECT st.challenge_id, COUNT(*)::int AS total, COUNT(ss.id)::int AS completed
+FROM challenge_stages st
+LEFT JOIN challenge_stage_solves ss ON ss.stage_id = st.id AND ss.team_id = sqlc.narg('team_id')
+GROUP BY st.challenge_id;
+
+-- name: GetStageFirstBloods :many
+SELECT DISTINCT ON (ss.stage_id) ss.stage_id, ss.user_id, u.username, ss.team_id, t.name AS team_name, ss.solved_at
+FROM challenge_stage_solves ss
+JOIN challenge_stages st ON st.id = ss.stage_id
+JOIN users u ON u.id = ss.user_id
+JOIN teams t ON t.id = ss.team_id
+WHERE st.challenge_id = $1
+ORDER BY ss.stage_id, ss.solved_at ASC;
+
+-- name: GetAllChallengeStageSolves :many
+SELECT id, stage_id, team_id, user_id, solved_at
+FROM challenge_stage_solves
+ORDER BY solved_at ASC, id ASC;
@@ -0,0 +1,14 @@
+-- name: GetChallengeTeamFlag :one
+SELECT challenge_id, template, secret, created_at
+FROM challenge_team_flags
+WHERE challenge_id = $1;
+
+-- name: UpsertChallengeTeamFlag :exec
+INSERT INTO challenge_team_flags (challenge_id, template, secret)
+VALUES ($1, $2, $3)
+ON CONFLICT (challenge_id) DO UPDATE SET
+    template = EXCLUDED.template,
+    secret = EXCLUDED.secret;
+
+-- name: DeleteChallengeTeamFlag :exec
+DELETE FROM challenge_team_flags WHERE challenge_id = $1;
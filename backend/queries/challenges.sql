@@ -16,20 +16,32 @@ FOR UPDATE;
 -- name: ListChallenges :many
 SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.min_value, c.decay, c.solve_count, c.flag_hash, c.is_hidden, c.is_regex, c.is_case_insensitive, c.flag_regex, c.flag_format_regex, 0::int as solved
 FROM challenges c
-WHERE c.is_hidden = false;
+WHERE c.is_hidden = false
+  AND NOT EXISTS (
+      SELECT 1 FROM challenge_schedules cs
+      WHERE cs.challenge_id = c.id AND (cs.release_at > NOW() OR cs.hide_at <= NOW())
+  );
 
 -- name: ListChallengesByTag :many
 SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.min_value, c.decay, c.solve_count, c.flag_hash, c.is_hidden, c.is_regex, c.is_case_insensitive, c.flag_regex, c.flag_format_regex, 0::int as solved
 FROM challenges c
 JOIN challenge_tags ct ON ct.challenge_id = c.id AND ct.tag_id = $1
-WHERE c.is_hidden = false;
+WHERE c.is_hidden = false
+  AND NOT EXISTS (
+      SELECT 1 FROM challenge_schedules cs
+      WHERE cs.challenge_id = c.id AND (cs.release_at > NOW() OR cs.hide_at <= NOW())
+  );
 
 -- name: ListChallengesForTeam :many
 SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.min_value, c.decay, c.solve_count, c.flag_hash, c.is_hidden, c.is_regex, c.is_case_insensitive, c.flag_regex, c.flag_format_regex,
     (CASE WHEN s.id IS NOT NULL THEN 1 ELSE 0 END)::int AS solved
 FROM challenges c
 LEFT JOIN solves s ON s.challenge_id = c.id AND s.team_id = $1
-WHERE c.is_hidden = false;
+WHERE c.is_hidden = false
+  AND NOT EXISTS (
+      SELECT 1 FROM challenge_schedules cs
+      WHERE cs.challenge_id = c.id AND (cs.release_at > NOW() OR cs.hide_at <= NOW())
+  );
 
 -- name: ListChallengesForTeamByTag :many
 SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.min_value, c.decay, c.solve_count, c.flag_hash, c.is_hidden, c.is_regex, c.is_case_insensitive, c.flag_regex, c.flag_format_regex,
@@ -37,7 +49,11 @@ SELECT c.id, c.title, c.description, c.category, c.points, c.initial_value, c.mi
 FROM challenges c
 JOIN challenge_tags ct ON ct.challenge_id = c.id AND ct.tag_id = $1
 LEFT JOIN solves s ON s.challenge_id = c.id AND s.team_id = $2
-WHERE c.is_hidden = false;
+WHERE c.is_hidden = false
+  AND NOT EXISTS (
+      SELECT 1 FROM challenge_schedules cs
+      WHERE cs.challenge_id = c.id AND (cs.release_at > NOW() OR cs.hide_at <= NOW())
+  );
 
 -- name: UpdateChallenge :exec
 UPDATE challenges SET
@@ -51,5 +67,8 @@ DELETE FROM challenges WHERE id = $1 RETURNING id;
 -- name: IncrementChallengeSolveCount :one
 UPDATE challenges SET solve_count = solve_count + 1 WHERE id = $1 RETURNING solve_count;
 
+-- name: DecrementChallengeSolveCount :one
+UPDATE challenges SET solve_count = GREATEST(solve_count - 1, 0) WHERE id = $1 RETURNING solve_count;
+
 -- name: UpdateChallengePoints :one
 UPDATE challenges SET points = $2 WHERE id = $1 RETURNING id;
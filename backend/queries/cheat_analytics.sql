@@ -0,0 +1,51 @@
+-- name: GetCloseSolves :many
+SELECT a.id, a.user_id, a.team_id, a.challenge_id, a.created_at,
+       b.id AS source_submission_id, b.user_id AS source_user_id, b.team_id AS source_team_id,
+       b.created_at AS source_created_at
+FROM submissions a
+JOIN submissions b ON b.challenge_id = a.challenge_id
+    AND b.is_correct = TRUE
+    AND b.team_id IS NOT NULL
+    AND b.team_id <> a.team_id
+    AND (b.created_at, b.id) < (a.created_at, a.id)
+    AND a.created_at - b.created_at <= make_interval(secs => sqlc.arg(window_seconds)::int)
+WHERE a.is_correct = TRUE AND a.team_id IS NOT NULL
+ORDER BY a.created_at, a.id, b.created_at, b.id;
+
+-- name: GetSharedIPSubmissions :many
+SELECT DISTINCT ON (s.ip, s.team_id) s.id, s.user_id, s.team_id, s.challenge_id, s.ip, s.created_at
+FROM submissions s
+WHERE s.team_id IS NOT NULL AND s.ip IN (
+    SELECT shared.ip FROM submissions shared
+    WHERE shared.ip IS NOT NULL AND shared.ip <> '' AND shared.team_id IS NOT NULL
+    GROUP BY shared.ip
+    HAVING COUNT(DISTINCT shared.team_id) > 1
+)
+ORDER BY s.ip, s.team_id, s.created_at, s.id;
+
+-- name: GetSharedWrongFlagSubmissions :many
+SELECT DISTINCT ON (s.challenge_id, s.submitted_flag, s.team_id)
+       s.id, s.user_id, s.team_id, s.challenge_id, s.submitted_flag, s.created_at
+FROM submissions s
+WHERE s.is_correct = FALSE AND s.team_id IS NOT NULL
+  AND (s.challenge_id, s.submitted_flag) IN (
+      SELECT w.challenge_id, w.submitted_flag FROM submissions w
+      WHERE w.is_correct = FALSE AND w.team_id IS NOT NULL
+      GROUP BY w.challenge_id, w.submitted_flag
+      HAVING COUNT(DISTINCT w.team_id) >= sqlc.arg(min_teams)::int
+  )
+ORDER BY s.challenge_id, s.submitted_flag, s.team_id, s.created_at, s.id;
+
+-- name: GetSolvesWithoutDownload :many
+SELECT s.id, s.user_id, s.team_id, s.challenge_id, s.created_at
+FROM submissions s
+WHERE s.is_correct = TRUE AND s.team_id IS NOT NULL
+  AND EXISTS (
+      SELECT 1 FROM files f
+      WHERE f.challenge_id = s.challenge_id AND f.type = 'challenge' AND f.created_at <= s.created_at
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM file_downloads d
+      WHERE d.challenge_id = s.challenge_id AND d.user_id = s.user_id AND d.downloaded_at <= s.created_at
+  )
+ORDER BY s.created_at, s.id;
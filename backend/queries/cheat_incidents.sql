@@ -0,0 +1,45 @@
+-- name: CreateCheatIncident :one
+INSERT INTO cheat_incidents (kind, challenge_id, team_id, user_id, source_team_id, evidence, fingerprint)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (fingerprint) DO NOTHING
+RETURNING id, status, created_at;
+
+-- name: GetCheatIncidentByID :one
+SELECT ci.id, ci.kind, ci.challenge_id, ci.team_id, ci.user_id, ci.source_team_id, ci.status, ci.evidence,
+       ci.resolved_by, ci.resolved_at, ci.created_at,
+       t.name AS team_name, COALESCE(st.name, '') AS source_team_name,
+       COALESCE(u.username, '') AS username, COALESCE(c.title, '') AS challenge_title
+FROM cheat_incidents ci
+JOIN teams t ON t.id = ci.team_id
+LEFT JOIN teams st ON st.id = ci.source_team_id
+LEFT JOIN users u ON u.id = ci.user_id
+LEFT JOIN challenges c ON c.id = ci.challenge_id
+WHERE ci.id = $1;
+
+-- name: GetCheatIncidents :many
+SELECT ci.id, ci.kind, ci.challenge_id, ci.team_id, ci.user_id, ci.source_team_id, ci.status, ci.evidence,
+       ci.resolved_by, ci.resolved_at, ci.created_at,
+       t.name AS team_name, COALESCE(st.name, '') AS source_team_name,
+       COALESCE(u.username, '') AS username, COALESCE(c.title, '') AS challenge_title
+FROM cheat_incidents ci
+JOIN teams t ON t.id = ci.team_id
+LEFT JOIN teams st ON st.id = ci.source_team_id
+LEFT JOIN users u ON u.id = ci.user_id
+LEFT JOIN challenges c ON c.id = ci.challenge_id
+WHERE (sqlc.narg('status')::varchar IS NULL OR ci.status = sqlc.narg('status'))
+  AND (sqlc.narg('kind')::varchar IS NULL OR ci.kind = sqlc.narg('kind'))
+ORDER BY ci.created_at DESC, ci.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountCheatIncidents :one
+SELECT COUNT(*) FROM cheat_incidents
+WHERE (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.narg('kind')::varchar IS NULL OR kind = sqlc.narg('kind'));
+
+-- name: ResolveCheatIncident :execrows
+UPDATE cheat_incidents
+SET status = $2, resolved_by = $3, resolved_at = $4
+WHERE id = $1 AND status = 'open';
+
+-- name: CountCheatIncidentsByTeamAndKind :one
+SELECT COUNT(*) FROM cheat_incidents WHERE team_id = $1 AND kind = $2;
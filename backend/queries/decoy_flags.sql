@@ -0,0 +1,19 @@
+-- name: CreateDecoyFlag :one
+INSERT INTO challenge_decoy_flags (challenge_id, flag_hash, is_case_insensitive, note)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (challenge_id, flag_hash) DO NOTHING
+RETURNING id, created_at;
+
+-- name: GetDecoyFlagByID :one
+SELECT id, challenge_id, flag_hash, is_case_insensitive, note, created_at
+FROM challenge_decoy_flags
+WHERE id = $1;
+
+-- name: GetDecoyFlagsByChallengeID :many
+SELECT id, challenge_id, flag_hash, is_case_insensitive, note, created_at
+FROM challenge_decoy_flags
+WHERE challenge_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: DeleteDecoyFlag :execrows
+DELETE FROM challenge_decoy_flags WHERE id = $1;
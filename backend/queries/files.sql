@@ -20,3 +20,9 @@ ORDER BY created_at DESC;
 
 -- name: DeleteFile :one
 DELETE FROM files WHERE id = $1 RETURNING id;
+
+-- name: CreateFileDownload :exec
+INSERT INTO file_downloads (file_id, challenge_id, user_id, team_id, ip)
+SELECT f.id, f.challenge_id, sqlc.arg(user_id), sqlc.arg(team_id), sqlc.arg(ip)
+FROM files f
+WHERE f.id = sqlc.arg(file_id);
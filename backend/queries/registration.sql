@@ -0,0 +1,30 @@
+-- name: CreateRegistrationInvite :one
+INSERT INTO registration_invites (id, code, max_uses, expires_at, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, code, max_uses, uses, expires_at, created_by, created_at;
+
+-- name: GetRegistrationInvites :many
+SELECT id, code, max_uses, uses, expires_at, created_by, created_at
+FROM registration_invites ORDER BY created_at DESC;
+
+-- name: ConsumeRegistrationInvite :one
+UPDATE registration_invites SET uses = uses + 1
+WHERE code = $1
+  AND (max_uses IS NULL OR uses < max_uses)
+  AND (expires_at IS NULL OR expires_at > $2)
+RETURNING id, code, max_uses, uses, expires_at, created_by, created_at;
+
+-- name: DeleteRegistrationInvite :execrows
+DELETE FROM registration_invites WHERE id = $1;
+
+-- name: CreateRegistrationDomainRule :one
+INSERT INTO registration_domain_rules (id, domain, action, bracket_id, created_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, domain, action, bracket_id, created_at;
+
+-- name: GetRegistrationDomainRules :many
+SELECT id, domain, action, bracket_id, created_at
+FROM registration_domain_rules ORDER BY domain ASC;
+
+-- name: DeleteRegistrationDomainRule :execrows
+DELETE FROM registration_domain_rules WHERE id = $1;
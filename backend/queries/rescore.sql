@@ -0,0 +1,23 @@
+-- name: ListChallengeSolveTallies :many
+SELECT c.id, c.title, c.points, c.initial_value, c.min_value, c.decay, c.solve_count,
+    (
+        SELECT COUNT(*) FROM solves s
+        JOIN teams t ON t.id = s.team_id
+        WHERE s.challenge_id = c.id
+          AND t.deleted_at IS NULL
+          AND (NOT sqlc.arg(exclude_banned)::bool OR t.is_banned = false)
+          AND (NOT sqlc.arg(exclude_hidden)::bool OR t.is_hidden = false)
+    )::int AS solves
+FROM challenges c
+ORDER BY c.title, c.id;
+
+-- name: CountChallengeSolves :one
+SELECT COUNT(*)::int FROM solves s
+JOIN teams t ON t.id = s.team_id
+WHERE s.challenge_id = sqlc.arg(challenge_id)
+  AND t.deleted_at IS NULL
+  AND (NOT sqlc.arg(exclude_banned)::bool OR t.is_banned = false)
+  AND (NOT sqlc.arg(exclude_hidden)::bool OR t.is_hidden = false);
+
+-- name: UpdateChallengeScore :exec
+UPDATE challenges SET solve_count = $2, points = $3 WHERE id = $1;
@@ -0,0 +1,22 @@
+-- name: CreateRole :exec
+INSERT INTO roles (name, description, permissions, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetRoleByName :one
+SELECT name, description, permissions, created_at
+FROM roles
+WHERE name = $1;
+
+-- name: GetAllRoles :many
+SELECT name, description, permissions, created_at
+FROM roles
+ORDER BY name ASC;
+
+-- name: UpdateRole :execrows
+UPDATE roles SET description = $2, permissions = $3 WHERE name = $1;
+
+-- name: DeleteRole :execrows
+WITH reassigned AS (
+    UPDATE users SET role = 'user' WHERE users.role = $1
+)
+DELETE FROM roles WHERE name = $1;
@@ -1,34 +1,40 @@
 -- name: CreateSolve :exec
-INSERT INTO solves (id, user_id, team_id, challenge_id, solved_at)
-VALUES ($1, $2, $3, $4, $5);
+INSERT INTO solves (id, user_id, team_id, challenge_id, solved_at, points)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: GetSolveByID :one
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 WHERE id = $1;
 
 -- name: GetSolveByTeamAndChallenge :one
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 WHERE team_id = $1 AND challenge_id = $2;
 
 -- name: GetSolveByTeamAndChallengeForUpdate :one
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 WHERE team_id = $1 AND challenge_id = $2
 FOR UPDATE;
 
+-- name: DeleteSolve :exec
+DELETE FROM solves WHERE id = $1;
+
 -- name: DeleteSolvesByTeamID :exec
 DELETE FROM solves WHERE team_id = $1;
 
 -- name: GetSolvesByUserID :many
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 WHERE user_id = $1
 ORDER BY solved_at DESC;
 
+-- name: GetSolvedChallengeIDsByTeamID :many
+SELECT challenge_id FROM solves WHERE team_id = $1;
+
 -- name: GetAllSolves :many
-SELECT id, user_id, team_id, challenge_id, solved_at
+SELECT id, user_id, team_id, challenge_id, solved_at, points
 FROM solves
 ORDER BY solved_at ASC;
 
@@ -36,36 +42,48 @@ ORDER BY solved_at ASC;
 SELECT
     t.id AS team_id,
     t.name AS team_name,
-    COALESCE(solve_points.points, 0) + COALESCE(award_points.total, 0) AS points,
-    solve_points.last_solved AS solved_at
+    COALESCE(solve_points.points, 0) + COALESCE(stage_points.points, 0) + COALESCE(award_points.total, 0) AS points,
+    GREATEST(solve_points.last_solved, stage_points.last_solved) AS solved_at
 FROM teams t
 LEFT JOIN (
-    SELECT s.team_id, SUM(c.points)::int AS points, MAX(s.solved_at) AS last_solved
+    SELECT s.team_id, SUM(COALESCE(s.points, c.points))::int AS points, MAX(s.solved_at) AS last_solved
     FROM solves s
     JOIN challenges c ON c.id = s.challenge_id
     GROUP BY s.team_id
 ) solve_points ON solve_points.team_id = t.id
+LEFT JOIN (
+    SELECT ss.team_id, SUM(st.points)::int AS points, MAX(ss.solved_at) AS last_solved
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON st.id = ss.stage_id
+    GROUP BY ss.team_id
+) stage_points ON stage_points.team_id = t.id
 LEFT JOIN (
     SELECT team_id, SUM(value)::int AS total
     FROM awards
     GROUP BY team_id
 ) award_points ON award_points.team_id = t.id
 WHERE t.is_banned = false AND t.is_hidden = false AND t.deleted_at IS NULL
-ORDER BY points DESC, COALESCE(solve_points.last_solved, '9999-12-31'::timestamp) ASC;
+ORDER BY points DESC, COALESCE(GREATEST(solve_points.last_solved, stage_points.last_solved), '9999-12-31'::timestamp) ASC;
 
 -- name: GetScoreboardByBracket :many
 SELECT
     t.id AS team_id,
     t.name AS team_name,
-    COALESCE(solve_points.points, 0) + COALESCE(award_points.total, 0) AS points,
-    solve_points.last_solved AS solved_at
+    COALESCE(solve_points.points, 0) + COALESCE(stage_points.points, 0) + COALESCE(award_points.total, 0) AS points,
+    GREATEST(solve_points.last_solved, stage_points.last_solved) AS solved_at
 FROM teams t
 LEFT JOIN (
-    SELECT s.team_id, SUM(c.points)::int AS points, MAX(s.solved_at) AS last_solved
+    SELECT s.team_id, SUM(COALESCE(s.points, c.points))::int AS points, MAX(s.solved_at) AS last_solved
     FROM solves s
     JOIN challenges c ON c.id = s.challenge_id
     GROUP BY s.team_id
 ) solve_points ON solve_points.team_id = t.id
+LEFT JOIN (
+    SELECT ss.team_id, SUM(st.points)::int AS points, MAX(ss.solved_at) AS last_solved
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON st.id = ss.stage_id
+    GROUP BY ss.team_id
+) stage_points ON stage_points.team_id = t.id
 LEFT JOIN (
     SELECT team_id, SUM(value)::int AS total
     FROM awards
@@ -73,22 +91,29 @@ LEFT JOIN (
 ) award_points ON award_points.team_id = t.id
 WHERE t.is_banned = false AND t.is_hidden = false AND t.deleted_at IS NULL
   AND (sqlc.narg('bracket_id')::uuid IS NULL OR t.bracket_id = sqlc.narg('bracket_id'))
-ORDER BY points DESC, COALESCE(solve_points.last_solved, '9999-12-31'::timestamp) ASC;
+ORDER BY points DESC, COALESCE(GREATEST(solve_points.last_solved, stage_points.last_solved), '9999-12-31'::timestamp) ASC;
 
 -- name: GetScoreboardFrozen :many
 SELECT
     t.id AS team_id,
     t.name AS team_name,
-    COALESCE(solve_points.points, 0) + COALESCE(award_points.total, 0) AS points,
-    solve_points.last_solved AS solved_at
+    COALESCE(solve_points.points, 0) + COALESCE(stage_points.points, 0) + COALESCE(award_points.total, 0) AS points,
+    GREATEST(solve_points.last_solved, stage_points.last_solved) AS solved_at
 FROM teams t
 LEFT JOIN (
-    SELECT s.team_id, SUM(c.points)::int AS points, MAX(s.solved_at) AS last_solved
+    SELECT s.team_id, SUM(COALESCE(s.points, c.points))::int AS points, MAX(s.solved_at) AS last_solved
     FROM solves s
     JOIN challenges c ON c.id = s.challenge_id
     WHERE s.solved_at <= $1
     GROUP BY s.team_id
 ) solve_points ON solve_points.team_id = t.id
+LEFT JOIN (
+    SELECT ss.team_id, SUM(st.points)::int AS points, MAX(ss.solved_at) AS last_solved
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON st.id = ss.stage_id
+    WHERE ss.solved_at <= $1
+    GROUP BY ss.team_id
+) stage_points ON stage_points.team_id = t.id
 LEFT JOIN (
     SELECT team_id, SUM(value)::int AS total
     FROM awards
@@ -96,22 +121,29 @@ LEFT JOIN (
     GROUP BY team_id
 ) award_points ON award_points.team_id = t.id
 WHERE t.is_banned = false AND t.is_hidden = false AND t.deleted_at IS NULL
-ORDER BY points DESC, COALESCE(solve_points.last_solved, '9999-12-31'::timestamp) ASC;
+ORDER BY points DESC, COALESCE(GREATEST(solve_points.last_solved, stage_points.last_solved), '9999-12-31'::timestamp) ASC;
 
 -- name: GetScoreboardByBracketFrozen :many
 SELECT
     t.id AS team_id,
     t.name AS team_name,
-    COALESCE(solve_points.points, 0) + COALESCE(award_points.total, 0) AS points,
-    solve_points.last_solved AS solved_at
+    COALESCE(solve_points.points, 0) + COALESCE(stage_points.points, 0) + COALESCE(award_points.total, 0) AS points,
+    GREATEST(solve_points.last_solved, stage_points.last_solved) AS solved_at
 FROM teams t
 LEFT JOIN (
-    SELECT s.team_id, SUM(c.points)::int AS points, MAX(s.solved_at) AS last_solved
+    SELECT s.team_id, SUM(COALESCE(s.points, c.points))::int AS points, MAX(s.solved_at) AS last_solved
     FROM solves s
     JOIN challenges c ON c.id = s.challenge_id
     WHERE s.solved_at <= $1
     GROUP BY s.team_id
 ) solve_points ON solve_points.team_id = t.id
+LEFT JOIN (
+    SELECT ss.team_id, SUM(st.points)::int AS points, MAX(ss.solved_at) AS last_solved
+    FROM challenge_stage_solves ss
+    JOIN challenge_stages st ON st.id = ss.stage_id
+    WHERE ss.solved_at <= $1
+    GROUP BY ss.team_id
+) stage_points ON stage_points.team_id = t.id
 LEFT JOIN (
     SELECT team_id, SUM(value)::int AS total
     FROM awards
@@ -120,15 +152,20 @@ LEFT JOIN (
 ) award_points ON award_points.team_id = t.id
 WHERE t.is_banned = false AND t.is_hidden = false AND t.deleted_at IS NULL
   AND (sqlc.narg('bracket_id')::uuid IS NULL OR t.bracket_id = sqlc.narg('bracket_id'))
-ORDER BY points DESC, COALESCE(solve_points.last_solved, '9999-12-31'::timestamp) ASC;
+ORDER BY points DESC, COALESCE(GREATEST(solve_points.last_solved, stage_points.last_solved), '9999-12-31'::timestamp) ASC;
 
 -- name: GetTeamScore :one
 SELECT
     COALESCE((
-        SELECT SUM(c.points) FROM solves s
+        SELECT SUM(COALESCE(s.points, c.points)) FROM solves s
         JOIN challenges c ON c.id = s.challenge_id
         WHERE s.team_id = $1
     ), 0)::int +
+    COALESCE((
+        SELECT SUM(st.points) FROM challenge_stage_solves ss
+        JOIN challenge_stages st ON st.id = ss.stage_id
+        WHERE ss.team_id = $1
+    ), 0)::int +
     COALESCE((
         SELECT SUM(value) FROM awards WHERE team_id = $1
     ), 0)::int AS total;
@@ -141,3 +178,6 @@ JOIN teams t ON t.id = s.team_id
 WHERE s.challenge_id = $1
 ORDER BY s.solved_at ASC
 LIMIT 1;
+
+-- name: PinSolvePoints :exec
+UPDATE solves SET points = $2 WHERE challenge_id = $1 AND points IS NULL;
@@ -0,0 +1,48 @@
+-- name: GetChallengeManualReview :one
+SELECT challenge_id, created_at
+FROM challenge_manual_reviews
+WHERE challenge_id = $1;
+
+-- name: UpsertChallengeManualReview :exec
+INSERT INTO challenge_manual_reviews (challenge_id)
+VALUES ($1)
+ON CONFLICT (challenge_id) DO NOTHING;
+
+-- name: DeleteChallengeManualReview :exec
+DELETE FROM challenge_manual_reviews WHERE challenge_id = $1;
+
+-- name: CreateSubmissionReview :one
+INSERT INTO submission_reviews (challenge_id, team_id, user_id, answer)
+VALUES ($1, $2, $3, $4)
+RETURNING id, status, created_at;
+
+-- name: GetSubmissionReviewByID :one
+SELECT id, challenge_id, team_id, user_id, answer, status, points, comment, reviewed_by, reviewed_at, created_at, submission_id
+FROM submission_reviews
+WHERE id = $1;
+
+-- name: LinkPendingSubmissionReview :execrows
+UPDATE submission_reviews
+SET submission_id = $3
+WHERE challenge_id = $1 AND team_id = $2 AND status = 'pending' AND submission_id IS NULL;
+
+-- name: GetSubmissionReviews :many
+SELECT r.id, r.challenge_id, r.team_id, r.user_id, r.answer, r.status, r.points, r.comment, r.reviewed_by, r.reviewed_at, r.created_at,
+       c.title AS challenge_title, t.name AS team_name, u.username
+FROM submission_reviews r
+JOIN challenges c ON c.id = r.challenge_id
+JOIN teams t ON t.id = r.team_id
+JOIN users u ON u.id = r.user_id
+WHERE (sqlc.narg('status')::varchar IS NULL OR r.status = sqlc.narg('status'))
+ORDER BY r.created_at ASC, r.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountSubmissionReviews :one
+SELECT COUNT(*)
+FROM submission_reviews r
+WHERE (sqlc.narg('status')::varchar IS NULL OR r.status = sqlc.narg('status'));
+
+-- name: ResolveSubmissionReview :execrows
+UPDATE submission_reviews
+SET status = $2, points = $3, comment = $4, reviewed_by = $5, reviewed_at = $6
+WHERE id = $1 AND status = 'pending';
@@ -41,6 +41,16 @@ JOIN challenges c ON c.id = s.challenge_id
 ORDER BY s.created_at DESC
 LIMIT $1 OFFSET $2;
 
+-- name: GetSubmissionsByIDs :many
+SELECT s.id, s.user_id, s.team_id, s.challenge_id, s.submitted_flag, s.is_correct, s.ip, s.created_at,
+       u.username, COALESCE(t.name, '') AS team_name, c.title AS challenge_title, c.category AS challenge_category
+FROM submissions s
+JOIN users u ON u.id = s.user_id
+LEFT JOIN teams t ON t.id = s.team_id
+JOIN challenges c ON c.id = s.challenge_id
+WHERE s.id = ANY(sqlc.arg(ids)::uuid[])
+ORDER BY s.created_at, s.id;
+
 -- name: CountSubmissionsByChallenge :one
 SELECT COUNT(*) FROM submissions WHERE challenge_id = $1;
 
@@ -63,3 +73,24 @@ SELECT
     COUNT(*) FILTER (WHERE is_correct = FALSE) AS incorrect
 FROM submissions
 WHERE challenge_id = $1;
+
+-- name: CountFailedSubmissionsByTeamAndChallenge :one
+SELECT COUNT(*) FROM submissions s
+LEFT JOIN challenge_attempt_resets r ON r.challenge_id = s.challenge_id AND r.team_id = s.team_id
+WHERE s.team_id = $1 AND s.challenge_id = $2 AND s.is_correct = FALSE
+  AND (r.reset_at IS NULL OR s.created_at > r.reset_at)
+  AND NOT EXISTS (
+    SELECT 1 FROM submission_reviews sr
+    WHERE sr.submission_id = s.id AND sr.status IN ('pending', 'approved')
+  );
+
+-- name: CountFailedSubmissionsByTeam :many
+SELECT s.challenge_id, COUNT(*) AS failed FROM submissions s
+LEFT JOIN challenge_attempt_resets r ON r.challenge_id = s.challenge_id AND r.team_id = s.team_id
+WHERE s.team_id = $1 AND s.is_correct = FALSE
+  AND (r.reset_at IS NULL OR s.created_at > r.reset_at)
+  AND NOT EXISTS (
+    SELECT 1 FROM submission_reviews sr
+    WHERE sr.submission_id = s.id AND sr.status IN ('pending', 'approved')
+  )
+GROUP BY s.challenge_id;
@@ -3,8 +3,8 @@ INSERT INTO teams (id, name, invite_token, captain_id, is_solo, is_auto_created,
 VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: CreateTeamReturningID :one
-INSERT INTO teams (name, invite_token, captain_id, is_solo, is_auto_created, created_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO teams (name, invite_token, captain_id, is_solo, is_auto_created, created_at, bracket_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id;
 
 -- name: GetTeamByID :one
@@ -0,0 +1,33 @@
+-- name: UpsertUserTwoFactor :exec
+INSERT INTO user_two_factor (user_id, secret_encrypted, enabled_at, last_used_step, created_at)
+VALUES ($1, $2, NULL, 0, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET secret_encrypted = EXCLUDED.secret_encrypted, enabled_at = NULL, last_used_step = 0, created_at = EXCLUDED.created_at;
+
+-- name: GetUserTwoFactorByUserID :one
+SELECT user_id, secret_encrypted, enabled_at, last_used_step, created_at
+FROM user_two_factor
+WHERE user_id = $1;
+
+-- name: EnableUserTwoFactor :exec
+UPDATE user_two_factor SET enabled_at = $2, last_used_step = $3 WHERE user_id = $1;
+
+-- name: ConsumeUserTwoFactorStep :execrows
+UPDATE user_two_factor SET last_used_step = $2 WHERE user_id = $1 AND last_used_step < $2;
+
+-- name: DeleteUserTwoFactor :exec
+DELETE FROM user_two_factor WHERE user_id = $1;
+
+-- name: CreateUserRecoveryCode :exec
+INSERT INTO user_recovery_codes (id, user_id, code_hash, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: UseUserRecoveryCode :execrows
+UPDATE user_recovery_codes SET used_at = $3
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: CountUnusedUserRecoveryCodes :one
+SELECT COUNT(*) FROM user_recovery_codes WHERE user_id = $1 AND used_at IS NULL;
+
+-- name: DeleteUserRecoveryCodes :exec
+DELETE FROM user_recovery_codes WHERE user_id = $1;
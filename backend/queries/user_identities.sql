@@ -0,0 +1,23 @@
+-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (id, user_id, provider, subject, email, created_at, last_login_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetUserIdentityByProviderSubject :one
+SELECT id, user_id, provider, subject, email, created_at, last_login_at
+FROM user_identities
+WHERE provider = $1 AND subject = $2;
+
+-- name: GetUserIdentitiesByUserID :many
+SELECT id, user_id, provider, subject, email, created_at, last_login_at
+FROM user_identities
+WHERE user_id = $1
+ORDER BY created_at ASC;
+
+-- name: TouchUserIdentityLogin :exec
+UPDATE user_identities SET last_login_at = $2 WHERE id = $1;
+
+-- name: DeleteUserIdentity :execrows
+DELETE FROM user_identities WHERE user_id = $1 AND provider = $2;
+
+-- name: DeleteUserIdentitiesByUserID :exec
+DELETE FROM user_identities WHERE user_id = $1;
@@ -0,0 +1,33 @@
+-- name: CreateUserSession :exec
+INSERT INTO user_sessions (id, user_id, refresh_jti, access_jti, ip, user_agent, expires_at, created_at, updated_at, last_seen_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8, $8);
+
+-- name: GetUserSessionByID :one
+SELECT id, user_id, refresh_jti, expires_at, revoked_at, created_at, updated_at, access_jti, ip, user_agent, last_seen_at
+FROM user_sessions
+WHERE id = $1;
+
+-- name: GetActiveUserSessionsByUserID :many
+SELECT id, user_id, refresh_jti, expires_at, revoked_at, created_at, updated_at, access_jti, ip, user_agent, last_seen_at
+FROM user_sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2
+ORDER BY last_seen_at DESC;
+
+-- name: RotateUserSession :execrows
+UPDATE user_sessions
+SET refresh_jti = sqlc.arg('new_refresh_jti'), access_jti = sqlc.arg('access_jti'), expires_at = sqlc.arg('expires_at'),
+    updated_at = sqlc.arg('updated_at'), last_seen_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id') AND refresh_jti = sqlc.arg('old_refresh_jti') AND revoked_at IS NULL;
+
+-- name: TouchUserSession :exec
+UPDATE user_sessions SET last_seen_at = $2 WHERE id = $1;
+
+-- name: RevokeUserSession :exec
+UPDATE user_sessions SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeUserSessionsByUserID :execrows
+UPDATE user_sessions SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeUserSessionsByTeamID :execrows
+UPDATE user_sessions SET revoked_at = $2
+WHERE revoked_at IS NULL AND user_id IN (SELECT id FROM users WHERE team_id = $1);
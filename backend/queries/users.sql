@@ -8,17 +8,17 @@ VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING id;
 
 -- name: GetUserByID :one
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 WHERE id = $1;
 
 -- name: GetUserByEmail :one
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 WHERE email = $1;
 
 -- name: GetUserByUsername :one
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 WHERE username = $1;
 
@@ -29,14 +29,67 @@ UPDATE users SET team_id = $2 WHERE id = $1 RETURNING id;
 UPDATE users SET is_verified = $2, verified_at = $3 WHERE id = $1;
 
 -- name: ListUsersByTeamID :many
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 WHERE team_id = $1;
 
 -- name: GetAllUsers :many
-SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at
+SELECT id, team_id, username, email, password_hash, role, is_verified, verified_at, created_at, is_banned, banned_at, banned_reason
 FROM users
 ORDER BY created_at ASC;
 
 -- name: UpdatePassword :exec
 UPDATE users SET password_hash = $2 WHERE id = $1;
+
+-- name: UpdateUserEmail :execrows
+UPDATE users SET email = $2, is_verified = true, verified_at = $3 WHERE id = $1;
+
+-- name: UpdateUserRole :exec
+UPDATE users SET role = $2 WHERE id = $1;
+
+-- name: SearchUsers :many
+SELECT u.id, u.team_id, u.username, u.email, u.password_hash, u.role, u.is_verified, u.verified_at, u.created_at, u.is_banned, u.banned_at, u.banned_reason
+FROM users u
+WHERE (sqlc.narg('search')::text IS NULL
+       OR u.username ILIKE '%' || sqlc.narg('search') || '%'
+       OR u.email ILIKE '%' || sqlc.narg('search') || '%'
+       OR EXISTS (SELECT 1 FROM field_values fv WHERE fv.entity_id = u.id AND fv.value ILIKE '%' || sqlc.narg('search') || '%'))
+  AND (sqlc.narg('field_id')::uuid IS NULL
+       OR EXISTS (SELECT 1 FROM field_values fv WHERE fv.entity_id = u.id AND fv.field_id = sqlc.narg('field_id')
+                  AND fv.value ILIKE '%' || COALESCE(sqlc.narg('field_value')::text, '') || '%'))
+  AND (sqlc.narg('is_verified')::boolean IS NULL OR COALESCE(u.is_verified, false) = sqlc.narg('is_verified'))
+  AND (sqlc.narg('is_banned')::boolean IS NULL OR COALESCE(u.is_banned, false) = sqlc.narg('is_banned'))
+  AND (sqlc.narg('role')::text IS NULL OR u.role = sqlc.narg('role'))
+  AND (sqlc.narg('team_id')::uuid IS NULL OR u.team_id = sqlc.narg('team_id'))
+ORDER BY u.created_at DESC, u.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountSearchUsers :one
+SELECT COUNT(*)
+FROM users u
+WHERE (sqlc.narg('search')::text IS NULL
+       OR u.username ILIKE '%' || sqlc.narg('search') || '%'
+       OR u.email ILIKE '%' || sqlc.narg('search') || '%'
+       OR EXISTS (SELECT 1 FROM field_values fv WHERE fv.entity_id = u.id AND fv.value ILIKE '%' || sqlc.narg('search') || '%'))
+  AND (sqlc.narg('field_id')::uuid IS NULL
+       OR EXISTS (SELECT 1 FROM field_values fv WHERE fv.entity_id = u.id AND fv.field_id = sqlc.narg('field_id')
+                  AND fv.value ILIKE '%' || COALESCE(sqlc.narg('field_value')::text, '') || '%'))
+  AND (sqlc.narg('is_verified')::boolean IS NULL OR COALESCE(u.is_verified, false) = sqlc.narg('is_verified'))
+  AND (sqlc.narg('is_banned')::boolean IS NULL OR COALESCE(u.is_banned, false) = sqlc.narg('is_banned'))
+  AND (sqlc.narg('role')::text IS NULL OR u.role = sqlc.narg('role'))
+  AND (sqlc.narg('team_id')::uuid IS NULL OR u.team_id = sqlc.narg('team_id'));
+
+-- name: UpdateUserProfile :execrows
+UPDATE users SET username = $2, email = $3, is_verified = $4, verified_at = $5 WHERE id = $1;
+
+-- name: BanUser :execrows
+UPDATE users SET is_banned = true, banned_at = $2, banned_reason = $3 WHERE id = $1;
+
+-- name: UnbanUser :execrows
+UPDATE users SET is_banned = false, banned_at = NULL, banned_reason = NULL WHERE id = $1;
+
+-- name: AnonymizeUser :execrows
+UPDATE users SET username = $2, email = $3, password_hash = '', is_verified = false, verified_at = NULL WHERE id = $1;
+
+-- name: DeleteUser :execrows
+DELETE FROM users WHERE id = $1;
@@ -1,9 +1,9 @@
 -- name: CreateVerificationToken :exec
-INSERT INTO verification_tokens (id, user_id, token, type, expires_at)
-VALUES ($1, $2, $3, $4, $5);
+INSERT INTO verification_tokens (id, user_id, token, type, expires_at, new_email)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: GetVerificationTokenByToken :one
-SELECT id, user_id, token, type, expires_at, used_at, created_at
+SELECT id, user_id, token, type, expires_at, used_at, created_at, new_email
 FROM verification_tokens
 WHERE token = $1;
 
@@ -28,7 +28,10 @@ CREATE TABLE users (
     role VARCHAR(20) DEFAULT 'user',
     is_verified BOOLEAN DEFAULT FALSE,
     verified_at TIMESTAMP NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_banned BOOLEAN DEFAULT FALSE,
+    banned_at TIMESTAMP,
+    banned_reason TEXT
 );
 
 -- Teams
@@ -74,6 +77,7 @@ CREATE TABLE solves (
     team_id uuid NOT NULL,
     challenge_id uuid NOT NULL,
     solved_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    points INT,
     CONSTRAINT unique_team_solve UNIQUE (team_id, challenge_id)
 );
 
@@ -105,15 +109,16 @@ CREATE TABLE awards (
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
--- Verification tokens (email verification, password reset)
+-- Verification tokens (email verification, password reset, email change)
 CREATE TABLE verification_tokens (
     id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
     user_id uuid NOT NULL,
     token VARCHAR(64) NOT NULL UNIQUE,
-    type VARCHAR(20) NOT NULL CHECK (type IN ('email_verification', 'password_reset')),
+    type VARCHAR(20) NOT NULL CHECK (type IN ('email_verification', 'password_reset', 'email_change')),
     expires_at TIMESTAMP NOT NULL,
     used_at TIMESTAMP NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    new_email VARCHAR(50) NULL
 );
 
 -- Files (challenge attachments, writeups; stored externally, path in location)
@@ -166,7 +171,10 @@ CREATE TABLE app_settings (
     submit_limit_duration_min INT NOT NULL DEFAULT 1,
     scoreboard_visible VARCHAR(20) NOT NULL DEFAULT 'public' CHECK (scoreboard_visible IN ('public', 'hidden', 'admins_only')),
     registration_open BOOLEAN NOT NULL DEFAULT TRUE,
-    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    require_2fa_for_admins BOOLEAN NOT NULL DEFAULT FALSE,
+    registration_opens_at TIMESTAMP,
+    registration_closes_at TIMESTAMP
 );
 
 -- Tags (for challenge categorization)
@@ -261,11 +269,24 @@ CREATE TABLE field_values (
 
 CREATE TABLE api_tokens (
     id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
-    user_id uuid NOT NULL,
+    user_id uuid,
     token_hash VARCHAR(64) NOT NULL UNIQUE,
     description VARCHAR(255),
     expires_at TIMESTAMP,
     last_used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_by uuid
+);
+
+-- Requests made with an API token (audit trail)
+CREATE TABLE api_token_requests (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    token_id uuid NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    path VARCHAR(512) NOT NULL,
+    status INTEGER NOT NULL,
+    ip VARCHAR(45),
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
@@ -288,6 +309,282 @@ CREATE TABLE configs (
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+-- User sessions (one per login, refresh token rotated on every refresh)
+CREATE TABLE user_sessions (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    refresh_jti VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    access_jti VARCHAR(64) NOT NULL DEFAULT '',
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    last_seen_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Two-factor authentication (TOTP secret encrypted at rest, recovery codes stored as SHA-256 hashes)
+CREATE TABLE user_two_factor (
+    user_id uuid PRIMARY KEY,
+    secret_encrypted TEXT NOT NULL,
+    enabled_at TIMESTAMP,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE user_recovery_codes (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- External login identities (OIDC/OAuth2 subject linked to a local user)
+CREATE TABLE user_identities (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    provider VARCHAR(64) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP,
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
+
+-- Custom roles (built-in roles are defined in code)
+CREATE TABLE roles (
+    name VARCHAR(20) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Challenge ownership for the author role
+CREATE TABLE challenge_authors (
+    challenge_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (challenge_id, user_id)
+);
+
+-- Registration invite codes (admit sign-ups while registration is closed)
+CREATE TABLE registration_invites (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(64) NOT NULL UNIQUE,
+    max_uses INT CHECK (max_uses IS NULL OR max_uses > 0),
+    uses INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    created_by uuid,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Email-domain allow/deny rules for registration, optionally mapped to a bracket
+CREATE TABLE registration_domain_rules (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    domain VARCHAR(255) NOT NULL UNIQUE,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('allow', 'deny')),
+    bracket_id uuid,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Additional accepted flags per challenge (static hashed, regex encrypted)
+CREATE TABLE challenge_flags (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL,
+    flag_type VARCHAR(10) NOT NULL CHECK (flag_type IN ('static', 'regex')),
+    flag_hash VARCHAR(64),
+    flag_regex TEXT,
+    is_case_insensitive BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((flag_type = 'static' AND flag_hash IS NOT NULL) OR (flag_type = 'regex' AND flag_regex IS NOT NULL))
+);
+
+-- Unlock requirements per challenge: prerequisite challenges and a minimum team score
+CREATE TABLE challenge_prerequisites (
+    challenge_id uuid NOT NULL,
+    prerequisite_id uuid NOT NULL,
+    PRIMARY KEY (challenge_id, prerequisite_id),
+    CHECK (challenge_id <> prerequisite_id)
+);
+
+CREATE TABLE challenge_unlock_scores (
+    challenge_id uuid PRIMARY KEY,
+    min_score INT NOT NULL CHECK (min_score > 0)
+);
+
+-- Release schedule per challenge, evaluated at read time; announced_at marks a release already broadcast
+CREATE TABLE challenge_schedules (
+    challenge_id uuid PRIMARY KEY,
+    release_at TIMESTAMP,
+    hide_at TIMESTAMP,
+    notify_on_release BOOLEAN NOT NULL DEFAULT FALSE,
+    announced_at TIMESTAMP,
+    CHECK (release_at IS NULL OR hide_at IS NULL OR hide_at > release_at)
+);
+
+-- Per-team flags derived from an HMAC of the encrypted secret and the team ID
+CREATE TABLE challenge_team_flags (
+    challenge_id uuid PRIMARY KEY,
+    template VARCHAR(255) NOT NULL,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Cheating incidents, such as a team submitting another team's flag. Incidents found by the
+-- analytics carry a fingerprint so rerunning them does not record the same finding twice
+CREATE TABLE cheat_incidents (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind VARCHAR(32) NOT NULL,
+    challenge_id uuid,
+    team_id uuid NOT NULL,
+    user_id uuid,
+    source_team_id uuid,
+    status VARCHAR(16) NOT NULL DEFAULT 'open',
+    evidence JSONB NOT NULL DEFAULT '{}',
+    fingerprint TEXT,
+    resolved_by uuid,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Per-team challenge instances started through the configured instance provider
+CREATE TABLE challenge_instance_configs (
+    challenge_id uuid PRIMARY KEY,
+    image VARCHAR(255) NOT NULL,
+    ttl_seconds INT NOT NULL CHECK (ttl_seconds > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE challenge_instances (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL,
+    team_id uuid NOT NULL,
+    connection_info TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (challenge_id, team_id)
+);
+
+-- Manually graded challenges and their review queue
+CREATE TABLE challenge_manual_reviews (
+    challenge_id uuid PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE submission_reviews (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL,
+    team_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    answer TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    points INT,
+    comment TEXT NOT NULL DEFAULT '',
+    reviewed_by uuid,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    submission_id uuid
+);
+
+-- Immutable challenge revisions (challenge fields, tags and hints)
+CREATE TABLE challenge_revisions (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL,
+    version INT NOT NULL,
+    author_id uuid,
+    snapshot JSONB NOT NULL,
+    changed_fields TEXT[] NOT NULL DEFAULT '{}',
+    restored_from INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (challenge_id, version)
+);
+
+-- Challenges synced from challenge.yml specs, keyed by their stable slug
+CREATE TABLE challenge_specs (
+    challenge_id uuid PRIMARY KEY,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    synced_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Ordered stages of multi-stage challenges, their flags and the stages each team completed
+CREATE TABLE challenge_stages (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL,
+    order_index INT NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    points INT NOT NULL DEFAULT 0 CHECK (points >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE challenge_stage_flags (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    stage_id uuid NOT NULL,
+    flag_type VARCHAR(10) NOT NULL CHECK (flag_type IN ('static', 'regex')),
+    flag_hash VARCHAR(64),
+    flag_regex TEXT,
+    is_case_insensitive BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((flag_type = 'static' AND flag_hash IS NOT NULL) OR (flag_type = 'regex' AND flag_regex IS NOT NULL))
+);
+
+CREATE TABLE challenge_stage_solves (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    stage_id uuid NOT NULL,
+    team_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    solved_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (stage_id, team_id)
+);
+
+-- Scoring function and parameters of challenges that do not use the default decay
+CREATE TABLE challenge_scoring (
+    challenge_id uuid PRIMARY KEY,
+    function_name VARCHAR(32) NOT NULL,
+    params JSONB NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Challenge file downloads, so solves without a download can be spotted
+CREATE TABLE file_downloads (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    file_id uuid NOT NULL,
+    challenge_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    team_id uuid,
+    ip VARCHAR(45),
+    downloaded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Decoy flags planted to catch teams submitting leaked or guessed answers; never accepted
+CREATE TABLE challenge_decoy_flags (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    challenge_id uuid NOT NULL,
+    flag_hash VARCHAR(64) NOT NULL,
+    is_case_insensitive BOOLEAN NOT NULL DEFAULT FALSE,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (challenge_id, flag_hash)
+);
+
+-- Per-team wrong submission limit and penalty of a challenge
+CREATE TABLE challenge_attempt_limits (
+    challenge_id uuid PRIMARY KEY,
+    max_attempts INT CHECK (max_attempts IS NULL OR max_attempts > 0),
+    wrong_penalty INT NOT NULL DEFAULT 0 CHECK (wrong_penalty >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Wrong submissions made before reset_at no longer count towards the attempt limit of the team
+CREATE TABLE challenge_attempt_resets (
+    challenge_id uuid NOT NULL,
+    team_id uuid NOT NULL,
+    reset_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (challenge_id, team_id)
+);
+
 -- Ratings (CTF events and global team ratings)
 CREATE TABLE ctf_events (
     id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
@@ -322,6 +619,7 @@ CREATE INDEX idx_solves_user ON solves (user_id);
 CREATE INDEX idx_solves_challenge_date ON solves (challenge_id, solved_at);
 CREATE UNIQUE INDEX solves_team_challenge_idx ON solves (team_id, challenge_id);
 CREATE INDEX idx_users_team ON users (team_id);
+CREATE INDEX idx_users_created_at ON users (created_at DESC);
 CREATE INDEX idx_teams_invite ON teams (invite_token);
 CREATE INDEX idx_teams_bracket_id ON teams (bracket_id);
 CREATE INDEX idx_teams_active ON teams (id) WHERE deleted_at IS NULL;
@@ -360,6 +658,7 @@ CREATE INDEX idx_field_values_entity ON field_values (entity_id);
 CREATE INDEX idx_fields_entity_type ON fields (entity_type);
 CREATE INDEX idx_api_tokens_user_id ON api_tokens (user_id);
 CREATE INDEX idx_api_tokens_token_hash ON api_tokens (token_hash);
+CREATE INDEX idx_api_token_requests_token_id ON api_token_requests (token_id, created_at DESC);
 CREATE INDEX idx_comments_challenge_id ON comments (challenge_id);
 CREATE INDEX idx_comments_user_id ON comments (user_id);
 CREATE INDEX idx_comments_created_at ON comments (created_at);
@@ -367,6 +666,31 @@ CREATE INDEX idx_configs_updated_at ON configs (updated_at);
 CREATE INDEX idx_team_ratings_team_id ON team_ratings (team_id);
 CREATE INDEX idx_team_ratings_ctf_event_id ON team_ratings (ctf_event_id);
 CREATE INDEX idx_global_ratings_total_points ON global_ratings (total_points DESC);
+CREATE INDEX idx_user_sessions_user_id ON user_sessions (user_id);
+CREATE INDEX idx_user_sessions_expires_at ON user_sessions (expires_at);
+CREATE INDEX idx_user_recovery_codes_user_id ON user_recovery_codes (user_id);
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
+CREATE INDEX idx_challenge_authors_user_id ON challenge_authors (user_id);
+CREATE INDEX idx_users_role ON users (role);
+CREATE INDEX idx_challenge_flags_challenge_id ON challenge_flags (challenge_id);
+CREATE INDEX idx_challenge_prerequisites_prerequisite_id ON challenge_prerequisites (prerequisite_id);
+CREATE INDEX idx_challenge_schedules_pending_release ON challenge_schedules (release_at) WHERE announced_at IS NULL;
+CREATE INDEX idx_cheat_incidents_created_at ON cheat_incidents (created_at DESC);
+CREATE INDEX idx_challenge_instances_team_id ON challenge_instances (team_id);
+CREATE INDEX idx_challenge_instances_expires_at ON challenge_instances (expires_at);
+CREATE UNIQUE INDEX idx_submission_reviews_pending ON submission_reviews (challenge_id, team_id) WHERE status = 'pending';
+CREATE INDEX idx_submission_reviews_status_created_at ON submission_reviews (status, created_at);
+CREATE INDEX idx_submission_reviews_submission_id ON submission_reviews (submission_id) WHERE submission_id IS NOT NULL;
+CREATE INDEX idx_challenge_stages_challenge_id ON challenge_stages (challenge_id, order_index);
+CREATE INDEX idx_challenge_stage_flags_stage_id ON challenge_stage_flags (stage_id);
+CREATE INDEX idx_challenge_stage_solves_team_id ON challenge_stage_solves (team_id);
+CREATE INDEX idx_challenge_scoring_function_name ON challenge_scoring (function_name);
+CREATE INDEX idx_submissions_team_challenge_failed ON submissions (team_id, challenge_id) WHERE is_correct = FALSE;
+CREATE UNIQUE INDEX idx_cheat_incidents_fingerprint ON cheat_incidents (fingerprint);
+CREATE INDEX idx_cheat_incidents_status_created_at ON cheat_incidents (status, created_at DESC);
+CREATE INDEX idx_file_downloads_user_challenge ON file_downloads (user_id, challenge_id);
+CREATE INDEX idx_submissions_ip ON submissions (ip) WHERE ip IS NOT NULL;
+CREATE INDEX idx_cheat_incidents_team_kind ON cheat_incidents (team_id, kind);
 
 -- Foreign keys
 ALTER TABLE teams ADD CONSTRAINT fk_teams_captain FOREIGN KEY (captain_id) REFERENCES users (id) ON DELETE CASCADE;
@@ -394,8 +718,55 @@ ALTER TABLE user_notifications ADD CONSTRAINT fk_user_notifications_notification
 ALTER TABLE teams ADD CONSTRAINT fk_teams_bracket FOREIGN KEY (bracket_id) REFERENCES brackets (id) ON DELETE SET NULL;
 ALTER TABLE field_values ADD CONSTRAINT fk_field_values_field FOREIGN KEY (field_id) REFERENCES fields (id) ON DELETE CASCADE;
 ALTER TABLE api_tokens ADD CONSTRAINT fk_api_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE api_tokens ADD CONSTRAINT fk_api_tokens_created_by FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE SET NULL;
+ALTER TABLE api_token_requests ADD CONSTRAINT fk_api_token_requests_token FOREIGN KEY (token_id) REFERENCES api_tokens (id) ON DELETE CASCADE;
 ALTER TABLE comments ADD CONSTRAINT fk_comments_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
 ALTER TABLE comments ADD CONSTRAINT fk_comments_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE user_sessions ADD CONSTRAINT fk_user_sessions_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE user_two_factor ADD CONSTRAINT fk_user_two_factor_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE user_recovery_codes ADD CONSTRAINT fk_user_recovery_codes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE user_identities ADD CONSTRAINT fk_user_identities_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE challenge_authors ADD CONSTRAINT fk_challenge_authors_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_authors ADD CONSTRAINT fk_challenge_authors_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE registration_invites ADD CONSTRAINT fk_registration_invites_created_by FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE SET NULL;
+ALTER TABLE registration_domain_rules ADD CONSTRAINT fk_registration_domain_rules_bracket FOREIGN KEY (bracket_id) REFERENCES brackets (id) ON DELETE SET NULL;
+ALTER TABLE challenge_flags ADD CONSTRAINT fk_challenge_flags_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_prerequisites ADD CONSTRAINT fk_challenge_prerequisites_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_prerequisites ADD CONSTRAINT fk_challenge_prerequisites_prerequisite FOREIGN KEY (prerequisite_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_unlock_scores ADD CONSTRAINT fk_challenge_unlock_scores_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_schedules ADD CONSTRAINT fk_challenge_schedules_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_team_flags ADD CONSTRAINT fk_challenge_team_flags_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE cheat_incidents ADD CONSTRAINT fk_cheat_incidents_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE cheat_incidents ADD CONSTRAINT fk_cheat_incidents_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE;
+ALTER TABLE cheat_incidents ADD CONSTRAINT fk_cheat_incidents_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL;
+ALTER TABLE cheat_incidents ADD CONSTRAINT fk_cheat_incidents_source_team FOREIGN KEY (source_team_id) REFERENCES teams (id) ON DELETE SET NULL;
+ALTER TABLE cheat_incidents ADD CONSTRAINT fk_cheat_incidents_resolved_by FOREIGN KEY (resolved_by) REFERENCES users (id) ON DELETE SET NULL;
+ALTER TABLE challenge_instance_configs ADD CONSTRAINT fk_challenge_instance_configs_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_instances ADD CONSTRAINT fk_challenge_instances_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_instances ADD CONSTRAINT fk_challenge_instances_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE;
+ALTER TABLE challenge_manual_reviews ADD CONSTRAINT fk_challenge_manual_reviews_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE submission_reviews ADD CONSTRAINT fk_submission_reviews_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE submission_reviews ADD CONSTRAINT fk_submission_reviews_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE;
+ALTER TABLE submission_reviews ADD CONSTRAINT fk_submission_reviews_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE submission_reviews ADD CONSTRAINT fk_submission_reviews_reviewed_by FOREIGN KEY (reviewed_by) REFERENCES users (id) ON DELETE SET NULL;
+ALTER TABLE submission_reviews ADD CONSTRAINT fk_submission_reviews_submission FOREIGN KEY (submission_id) REFERENCES submissions (id) ON DELETE SET NULL;
+ALTER TABLE challenge_revisions ADD CONSTRAINT fk_challenge_revisions_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_revisions ADD CONSTRAINT fk_challenge_revisions_author FOREIGN KEY (author_id) REFERENCES users (id) ON DELETE SET NULL;
+ALTER TABLE challenge_specs ADD CONSTRAINT fk_challenge_specs_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_stages ADD CONSTRAINT fk_challenge_stages_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_stage_flags ADD CONSTRAINT fk_challenge_stage_flags_stage FOREIGN KEY (stage_id) REFERENCES challenge_stages (id) ON DELETE CASCADE;
+ALTER TABLE challenge_stage_solves ADD CONSTRAINT fk_challenge_stage_solves_stage FOREIGN KEY (stage_id) REFERENCES challenge_stages (id) ON DELETE CASCADE;
+ALTER TABLE challenge_stage_solves ADD CONSTRAINT fk_challenge_stage_solves_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE;
+ALTER TABLE challenge_stage_solves ADD CONSTRAINT fk_challenge_stage_solves_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE challenge_scoring ADD CONSTRAINT fk_challenge_scoring_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_attempt_limits ADD CONSTRAINT fk_challenge_attempt_limits_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_attempt_resets ADD CONSTRAINT fk_challenge_attempt_resets_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE challenge_attempt_resets ADD CONSTRAINT fk_challenge_attempt_resets_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE;
+ALTER TABLE file_downloads ADD CONSTRAINT fk_file_downloads_file FOREIGN KEY (file_id) REFERENCES files (id) ON DELETE CASCADE;
+ALTER TABLE file_downloads ADD CONSTRAINT fk_file_downloads_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
+ALTER TABLE file_downloads ADD CONSTRAINT fk_file_downloads_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE file_downloads ADD CONSTRAINT fk_file_downloads_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE SET NULL;
+ALTER TABLE challenge_decoy_flags ADD CONSTRAINT fk_challenge_decoy_flags_challenge FOREIGN KEY (challenge_id) REFERENCES challenges (id) ON DELETE CASCADE;
 
 -- Singleton rows (required for application)
 INSERT INTO competition (id, name) VALUES (1, 'CTF Competition');